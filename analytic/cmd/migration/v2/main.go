@@ -109,17 +109,21 @@ func (a *AnalyticMigration) setMigrationInSummary(analysisID uuid.UUID, err erro
 }
 
 func (a *AnalyticMigration) migrateAnalysis(analysis *analysisEntities.Analysis) {
-	a.setMigrationInSummary(analysis.ID, a.dashboardController.AddVulnerabilitiesByAuthor(analysis),
-		dashboardEnums.TableVulnerabilitiesByAuthor)
-
-	a.setMigrationInSummary(analysis.ID, a.dashboardController.AddVulnerabilitiesByLanguage(analysis),
-		dashboardEnums.TableVulnerabilitiesByLanguage)
-
-	a.setMigrationInSummary(analysis.ID, a.dashboardController.AddVulnerabilitiesByRepository(analysis),
-		dashboardEnums.TableVulnerabilitiesByRepository)
+	for table, migrate := range a.getMigrationByTable() {
+		a.setMigrationInSummary(analysis.ID, migrate(analysis), table)
+	}
+}
 
-	a.setMigrationInSummary(analysis.ID, a.dashboardController.AddVulnerabilitiesByTime(analysis),
-		dashboardEnums.TableVulnerabilitiesByTime)
+func (a *AnalyticMigration) getMigrationByTable() map[string]func(*analysisEntities.Analysis) error {
+	return map[string]func(*analysisEntities.Analysis) error{
+		dashboardEnums.TableVulnerabilitiesByAuthor:       a.dashboardController.AddVulnerabilitiesByAuthor,
+		dashboardEnums.TableVulnerabilitiesByLanguage:     a.dashboardController.AddVulnerabilitiesByLanguage,
+		dashboardEnums.TableVulnerabilitiesByRepository:   a.dashboardController.AddVulnerabilitiesByRepository,
+		dashboardEnums.TableVulnerabilitiesByTime:         a.dashboardController.AddVulnerabilitiesByTime,
+		dashboardEnums.TableVulnerabilitiesByWorkspace:    a.dashboardController.AddVulnerabilitiesByWorkspace,
+		dashboardEnums.TableVulnerabilitiesBySecurityTool: a.dashboardController.AddVulnerabilitiesBySecurityTool,
+		dashboardEnums.TableVulnerabilitiesByCWE:          a.dashboardController.AddVulnerabilitiesByCWE,
+	}
 }
 
 func (a *AnalyticMigration) printResults(total int) {
@@ -1,4 +1,5 @@
-//+build wireinject
+//go:build wireinject
+// +build wireinject
 
 package providers
 
@@ -16,13 +17,28 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
 	"github.com/ZupIT/horusec-platform/analytic/config/cors"
+	archiveController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/archive"
 	dashboardController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	metadataController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/metadata"
+	riskController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/risk"
+	transferController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/transfer"
+	archiveEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/archive"
 	dashboardEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	metadataEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/metadata"
+	notificationEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/notification"
+	riskEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/risk"
+	transferEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/transfer"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/risk"
+	archiveRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/archive"
 	dashboardRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	riskRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/risk"
 	"github.com/ZupIT/horusec-platform/analytic/internal/router"
+	"github.com/ZupIT/horusec-platform/analytic/internal/services/notifier"
 	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+	riskUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/risk"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 var devKitProviders = wire.NewSet(
@@ -39,33 +55,51 @@ var devKitProviders = wire.NewSet(
 
 var configProviders = wire.NewSet(
 	cors.NewCorsConfig,
+	permissionMiddleware.NewPermissionMiddleware,
 	router.NewHTTPRouter,
 )
 
 var repositoriesProviders = wire.NewSet(
 	dashboardRepository.NewRepoDashboard,
+	riskRepository.NewRepoRisk,
+	archiveRepository.NewRepoArchive,
 )
 
 var controllersProviders = wire.NewSet(
 	dashboardController.NewDashboardController,
+	riskController.NewRiskController,
+	archiveController.NewArchiveController,
+	metadataController.NewMetadataController,
+	transferController.NewTransferController,
 )
 
 var handlersProviders = wire.NewSet(
 	health.NewHealthHandler,
 	dashboard.NewDashboardHandler,
+	risk.NewRiskHandler,
 )
 
 var eventsProviders = wire.NewSet(
 	dashboardEvents.NewDashboardEvents,
+	riskEvents.NewRiskEvents,
+	archiveEvents.NewArchiveEvents,
+	metadataEvents.NewMetadataEvents,
+	transferEvents.NewTransferEvents,
+	notificationEvents.NewNotificationEvents,
+)
+
+var servicesProviders = wire.NewSet(
+	notifier.NewNotifier,
 )
 
 var useCasesProviders = wire.NewSet(
 	dashboardUseCases.NewUseCaseDashboard,
+	riskUseCases.NewUseCaseRisk,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
 	wire.Build(devKitProviders, configProviders, repositoriesProviders, controllersProviders,
-		handlersProviders, eventsProviders, useCasesProviders)
+		handlersProviders, eventsProviders, servicesProviders, useCasesProviders)
 
 	return &router.Router{}, nil
 }
@@ -1,7 +1,8 @@
 // Code generated by Wire. DO NOT EDIT.
 
 //go:generate go run github.com/google/wire/cmd/wire
-//+build !wireinject
+//go:build !wireinject
+// +build !wireinject
 
 package providers
 
@@ -18,13 +19,28 @@ import (
 	"github.com/google/wire"
 
 	"github.com/ZupIT/horusec-platform/analytic/config/cors"
+	archive2 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/archive"
 	dashboard3 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/metadata"
+	risk3 "github.com/ZupIT/horusec-platform/analytic/internal/controllers/risk"
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/transfer"
+	archive3 "github.com/ZupIT/horusec-platform/analytic/internal/events/archive"
 	dashboard5 "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	metadata2 "github.com/ZupIT/horusec-platform/analytic/internal/events/metadata"
+	"github.com/ZupIT/horusec-platform/analytic/internal/events/notification"
+	risk5 "github.com/ZupIT/horusec-platform/analytic/internal/events/risk"
+	transfer2 "github.com/ZupIT/horusec-platform/analytic/internal/events/transfer"
 	dashboard4 "github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	risk4 "github.com/ZupIT/horusec-platform/analytic/internal/handlers/risk"
+	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/archive"
 	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/repositories/risk"
 	"github.com/ZupIT/horusec-platform/analytic/internal/router"
+	"github.com/ZupIT/horusec-platform/analytic/internal/services/notifier"
 	dashboard2 "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+	risk2 "github.com/ZupIT/horusec-platform/analytic/internal/usecases/risk"
+	"github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 // Injectors from wire.go:
@@ -48,9 +64,25 @@ func Initialize(string2 string) (router.IRouter, error) {
 	iRepoDashboard := dashboard.NewRepoDashboard(connection)
 	iUseCases := dashboard2.NewUseCaseDashboard()
 	iController := dashboard3.NewDashboardController(iRepoDashboard, connection, iUseCases)
-	dashboardHandler := dashboard4.NewDashboardHandler(iController)
+	iNotifier := notifier.NewNotifier()
+	dashboardHandler := dashboard4.NewDashboardHandler(iController, iNotifier)
 	events := dashboard5.NewDashboardEvents(iBroker, iController)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, dashboardHandler, events)
+	iRepoRisk := risk.NewRepoRisk(connection)
+	riskIUseCases := risk2.NewUseCaseRisk()
+	riskIController := risk3.NewRiskController(iRepoRisk, connection, riskIUseCases)
+	riskHandler := risk4.NewRiskHandler(riskIController)
+	riskEvents := risk5.NewRiskEvents(iBroker, riskIController)
+	iRepoArchive := archive.NewRepoArchive(connection)
+	archiveIController := archive2.NewArchiveController(iRepoArchive, connection)
+	archiveEvents := archive3.NewArchiveEvents(iBroker, archiveIController)
+	metadataIController := metadata.NewMetadataController(connection)
+	metadataEvents := metadata2.NewMetadataEvents(iBroker, metadataIController)
+	transferIController := transfer.NewTransferController(connection)
+	transferEvents := transfer2.NewTransferEvents(iBroker, transferIController)
+	notificationEvents := notification.NewNotificationEvents(configIConfig, iNotifier)
+	authServiceClient := proto.NewAuthServiceClient(clientConnInterface)
+	iMiddleware := middleware.NewPermissionMiddleware(authServiceClient)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, dashboardHandler, events, riskHandler, riskEvents, archiveEvents, metadataEvents, transferEvents, notificationEvents, iMiddleware)
 	return routerIRouter, nil
 }
 
@@ -58,14 +90,16 @@ func Initialize(string2 string) (router.IRouter, error) {
 
 var devKitProviders = wire.NewSet(auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, app.NewAppConfig, config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, router2.NewHTTPRouter, middlewares.NewAuthzMiddleware)
 
-var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter)
+var configProviders = wire.NewSet(cors.NewCorsConfig, middleware.NewPermissionMiddleware, router.NewHTTPRouter)
 
-var repositoriesProviders = wire.NewSet(dashboard.NewRepoDashboard)
+var repositoriesProviders = wire.NewSet(dashboard.NewRepoDashboard, risk.NewRepoRisk, archive.NewRepoArchive)
 
-var controllersProviders = wire.NewSet(dashboard3.NewDashboardController)
+var controllersProviders = wire.NewSet(dashboard3.NewDashboardController, risk3.NewRiskController, archive2.NewArchiveController)
 
-var handlersProviders = wire.NewSet(health.NewHealthHandler, dashboard4.NewDashboardHandler)
+var handlersProviders = wire.NewSet(health.NewHealthHandler, dashboard4.NewDashboardHandler, risk4.NewRiskHandler)
 
-var eventsProviders = wire.NewSet(dashboard5.NewDashboardEvents)
+var eventsProviders = wire.NewSet(dashboard5.NewDashboardEvents, risk5.NewRiskEvents, archive3.NewArchiveEvents)
 
-var useCasesProviders = wire.NewSet(dashboard2.NewUseCaseDashboard)
+var servicesProviders = wire.NewSet(notifier.NewNotifier)
+
+var useCasesProviders = wire.NewSet(dashboard2.NewUseCaseDashboard, risk2.NewUseCaseRisk)
@@ -3,14 +3,18 @@ module github.com/ZupIT/horusec-platform/analytic
 go 1.16
 
 require (
+	github.com/360EntSecGroup-Skylar/excelize/v2 v2.3.2
 	github.com/ZupIT/horusec-devkit v1.0.3
+	github.com/ZupIT/horusec-platform/permission v0.0.0
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.2.0
 	github.com/go-ozzo/ozzo-validation/v4 v4.3.0
 	github.com/google/uuid v1.2.0
 	github.com/google/wire v0.5.0
-	github.com/pkg/errors v0.9.1 // indirect
+	github.com/jung-kurt/gofpdf v1.16.2
+	github.com/lib/pq v1.3.0
+	github.com/pkg/errors v0.9.1
 	github.com/streadway/amqp v1.0.0
 	github.com/stretchr/testify v1.7.0
 	github.com/swaggo/swag v1.7.0
@@ -19,3 +23,5 @@ require (
 	golang.org/x/sys v0.0.0-20210503173754-0981d6026fa6 // indirect
 	google.golang.org/genproto v0.0.0-20210504143626-3b2ad6ccc450 // indirect
 )
+
+replace github.com/ZupIT/horusec-platform/permission => ../permission
@@ -0,0 +1,129 @@
+package archive
+
+import (
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/archive"
+	archiveEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/archive"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	metadataEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/metadata"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+	repoArchive "github.com/ZupIT/horusec-platform/analytic/internal/repositories/archive"
+)
+
+type IController interface {
+	HandleArchiveEvent(event *archive.Event) error
+}
+
+type Controller struct {
+	repository    repoArchive.IRepoArchive
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewArchiveController(repository repoArchive.IRepoArchive, connection *database.Connection) IController {
+	return &Controller{
+		repository:    repository,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (c *Controller) HandleArchiveEvent(event *archive.Event) error {
+	switch event.Action {
+	case archiveEnums.ActionArchived:
+		return c.archive(event)
+	case archiveEnums.ActionRestored:
+		return c.restore(event)
+	case archiveEnums.ActionPurged:
+		return c.purge(event)
+	default:
+		return archiveEnums.ErrorUnknownAction
+	}
+}
+
+func (c *Controller) archive(event *archive.Event) error {
+	if event.IsWorkspaceEvent() {
+		return c.databaseWrite.CreateOrUpdate(event.ToArchivedWorkspace(), event.ToWorkspaceFilter(),
+			archiveEnums.TableArchivedWorkspaces).GetError()
+	}
+
+	return c.databaseWrite.CreateOrUpdate(event.ToArchivedRepository(), event.ToRepositoryFilter(),
+		archiveEnums.TableArchivedRepositories).GetError()
+}
+
+func (c *Controller) restore(event *archive.Event) error {
+	if event.IsWorkspaceEvent() {
+		return c.databaseWrite.Delete(event.ToWorkspaceFilter(), archiveEnums.TableArchivedWorkspaces).GetError()
+	}
+
+	return c.databaseWrite.Delete(event.ToRepositoryFilter(), archiveEnums.TableArchivedRepositories).GetError()
+}
+
+func (c *Controller) purge(event *archive.Event) error {
+	if event.IsWorkspaceEvent() {
+		return c.purgeWorkspace(event)
+	}
+
+	return c.deleteInTransaction(event.ToRepositoryFilter(), append(c.getTablesWithWorkspaceID(),
+		riskEnums.TableVulnerabilitiesFirstSeen, archiveEnums.TableArchivedRepositories))
+}
+
+// purgeWorkspace needs to delete the vulnerabilities first seen by the repositories ids of the workspace,
+// since this table does not store the workspace id
+func (c *Controller) purgeWorkspace(event *archive.Event) error {
+	repositoriesID, err := c.repository.ListWorkspaceRepositoriesID(event.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.deleteVulnerabilitiesFirstSeen(repositoriesID); err != nil {
+		return err
+	}
+
+	return c.deleteInTransaction(event.ToWorkspaceFilter(), append(c.getTablesWithWorkspaceID(),
+		archiveEnums.TableArchivedRepositories, archiveEnums.TableArchivedWorkspaces))
+}
+
+func (c *Controller) deleteVulnerabilitiesFirstSeen(repositoriesID []uuid.UUID) error {
+	if len(repositoriesID) == 0 {
+		return nil
+	}
+
+	return c.databaseWrite.Delete(map[string]interface{}{archiveEnums.ColumnRepositoryID: repositoriesID},
+		riskEnums.TableVulnerabilitiesFirstSeen).GetError()
+}
+
+func (c *Controller) deleteInTransaction(filter map[string]interface{}, tables []string) error {
+	transaction := c.databaseWrite.StartTransaction()
+
+	for _, table := range tables {
+		if err := transaction.Delete(filter, table).GetError(); err != nil {
+			logger.LogError(archiveEnums.MessageFailedToRollbackPurge, transaction.RollbackTransaction().GetError())
+			return err
+		}
+	}
+
+	if err := transaction.CommitTransaction().GetError(); err != nil {
+		return errors.Wrap(err, archiveEnums.MessageFailedToCommitPurge)
+	}
+
+	return nil
+}
+
+func (c *Controller) getTablesWithWorkspaceID() []string {
+	return []string{
+		dashboardEnums.TableVulnerabilitiesByAuthor,
+		dashboardEnums.TableVulnerabilitiesByLanguage,
+		dashboardEnums.TableVulnerabilitiesByRepository,
+		dashboardEnums.TableVulnerabilitiesByTime,
+		dashboardEnums.TableVulnerabilitiesByWorkspace,
+		dashboardEnums.TableVulnerabilitiesBySecurityTool,
+		dashboardEnums.TableVulnerabilitiesByCWE,
+		riskEnums.TableRiskScoreByRepository,
+		riskEnums.TableRepositoryCriticality,
+		metadataEnums.TableRepositoryLabels,
+	}
+}
@@ -0,0 +1,18 @@
+package archive
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/archive"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) HandleArchiveEvent(_ *archive.Event) error {
+	args := m.MethodCalled("HandleArchiveEvent")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
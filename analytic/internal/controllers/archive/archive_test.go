@@ -0,0 +1,116 @@
+package archive
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/archive"
+	archiveEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/archive"
+	archiveRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/archive"
+)
+
+func TestHandleArchiveEvent(t *testing.T) {
+	workspaceEvent := func(action archiveEnums.Action) *archive.Event {
+		return &archive.Event{Action: action, WorkspaceID: uuid.New()}
+	}
+
+	repositoryEvent := func(action archiveEnums.Action) *archive.Event {
+		return &archive.Event{Action: action, WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+	}
+
+	t.Run("should success archive workspace and repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("CreateOrUpdate").Return(&response.Response{})
+
+		controller := NewArchiveController(&archiveRepository.Mock{}, &database.Connection{Write: databaseMock})
+
+		assert.NoError(t, controller.HandleArchiveEvent(workspaceEvent(archiveEnums.ActionArchived)))
+		assert.NoError(t, controller.HandleArchiveEvent(repositoryEvent(archiveEnums.ActionArchived)))
+		databaseMock.AssertNumberOfCalls(t, "CreateOrUpdate", 2)
+	})
+
+	t.Run("should success restore workspace and repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		controller := NewArchiveController(&archiveRepository.Mock{}, &database.Connection{Write: databaseMock})
+
+		assert.NoError(t, controller.HandleArchiveEvent(workspaceEvent(archiveEnums.ActionRestored)))
+		assert.NoError(t, controller.HandleArchiveEvent(repositoryEvent(archiveEnums.ActionRestored)))
+		databaseMock.AssertNumberOfCalls(t, "Delete", 2)
+	})
+
+	t.Run("should success purge repository data from all tables", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		controller := NewArchiveController(&archiveRepository.Mock{}, &database.Connection{Write: databaseMock})
+
+		assert.NoError(t, controller.HandleArchiveEvent(repositoryEvent(archiveEnums.ActionPurged)))
+		databaseMock.AssertNumberOfCalls(t, "Delete", 12)
+	})
+
+	t.Run("should success purge workspace data and vulnerabilities first seen", func(t *testing.T) {
+		repositoryMock := &archiveRepository.Mock{}
+		repositoryMock.On("ListWorkspaceRepositoriesID").Return([]uuid.UUID{uuid.New()}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		controller := NewArchiveController(repositoryMock, &database.Connection{Write: databaseMock})
+
+		assert.NoError(t, controller.HandleArchiveEvent(workspaceEvent(archiveEnums.ActionPurged)))
+		databaseMock.AssertNumberOfCalls(t, "Delete", 13)
+	})
+
+	t.Run("should return error when failed to list workspace repositories", func(t *testing.T) {
+		repositoryMock := &archiveRepository.Mock{}
+		repositoryMock.On("ListWorkspaceRepositoriesID").Return([]uuid.UUID{}, errors.New("test"))
+
+		controller := NewArchiveController(repositoryMock, &database.Connection{Write: &database.Mock{}})
+
+		assert.Error(t, controller.HandleArchiveEvent(workspaceEvent(archiveEnums.ActionPurged)))
+	})
+
+	t.Run("should return error and rollback when failed to delete", func(t *testing.T) {
+		repositoryMock := &archiveRepository.Mock{}
+		repositoryMock.On("ListWorkspaceRepositoriesID").Return([]uuid.UUID{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		controller := NewArchiveController(repositoryMock, &database.Connection{Write: databaseMock})
+
+		assert.Error(t, controller.HandleArchiveEvent(workspaceEvent(archiveEnums.ActionPurged)))
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should return error when failed to commit", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewArchiveController(&archiveRepository.Mock{}, &database.Connection{Write: databaseMock})
+
+		assert.Error(t, controller.HandleArchiveEvent(repositoryEvent(archiveEnums.ActionPurged)))
+	})
+
+	t.Run("should return error when unknown action", func(t *testing.T) {
+		controller := NewArchiveController(&archiveRepository.Mock{}, &database.Connection{Write: &database.Mock{}})
+
+		assert.Equal(t, archiveEnums.ErrorUnknownAction, controller.HandleArchiveEvent(workspaceEvent("test")))
+	})
+}
@@ -16,6 +16,10 @@ type IController interface {
 	AddVulnerabilitiesByRepository(entity *analysisEntities.Analysis) error
 	AddVulnerabilitiesByLanguage(entity *analysisEntities.Analysis) error
 	AddVulnerabilitiesByTime(entity *analysisEntities.Analysis) error
+	AddVulnerabilitiesByWorkspace(entity *analysisEntities.Analysis) error
+	AddVulnerabilitiesBySecurityTool(entity *analysisEntities.Analysis) error
+	AddVulnerabilitiesByCWE(entity *analysisEntities.Analysis) error
+	GetAllApplicationAdminCharts(filter *dashboard.Filter) (*dashboard.Response, error)
 }
 
 type Controller struct {
@@ -53,6 +57,21 @@ func (c *Controller) AddVulnerabilitiesByTime(analysis *analysisEntities.Analysi
 		dashboardEnums.TableVulnerabilitiesByTime).GetError()
 }
 
+func (c *Controller) AddVulnerabilitiesByWorkspace(analysis *analysisEntities.Analysis) error {
+	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesByWorkspace(analysis),
+		dashboardEnums.TableVulnerabilitiesByWorkspace).GetError()
+}
+
+func (c *Controller) AddVulnerabilitiesBySecurityTool(analysis *analysisEntities.Analysis) error {
+	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesBySecurityTool(analysis),
+		dashboardEnums.TableVulnerabilitiesBySecurityTool).GetError()
+}
+
+func (c *Controller) AddVulnerabilitiesByCWE(analysis *analysisEntities.Analysis) error {
+	return c.databaseWrite.Create(c.useCases.ParseAnalysisToVulnerabilitiesByCWE(analysis),
+		dashboardEnums.TableVulnerabilitiesByCWE).GetError()
+}
+
 func (c *Controller) GetAllDashboardCharts(filter *dashboard.Filter) (*dashboard.Response, error) {
 	response := &dashboard.Response{}
 
@@ -99,5 +118,39 @@ func (c *Controller) getChartByTime(filter *dashboard.Filter,
 		return nil, err
 	}
 
+	return c.getChartsBySecurityToolAndClassification(filter, response)
+}
+
+func (c *Controller) getChartsBySecurityToolAndClassification(filter *dashboard.Filter,
+	response *dashboard.Response) (*dashboard.Response, error) {
+	if err := response.SetChartBySecurityTool(c.repository.GetDashboardVulnBySecurityTool(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := response.SetChartByCWE(c.repository.GetDashboardVulnByCWE(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := response.SetChartByOWASP(c.repository.GetDashboardVulnByOWASP(filter)); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (c *Controller) GetAllApplicationAdminCharts(filter *dashboard.Filter) (*dashboard.Response, error) {
+	response, err := c.GetAllDashboardCharts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := response.SetTotalWorkspaces(c.repository.GetDashboardTotalWorkspaces(filter)); err != nil {
+		return nil, err
+	}
+
+	if err := response.SetChartByWorkspace(c.repository.GetDashboardVulnByWorkspace(filter)); err != nil {
+		return nil, err
+	}
+
 	return response, nil
 }
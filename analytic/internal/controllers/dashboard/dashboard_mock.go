@@ -37,3 +37,23 @@ func (m *Mock) AddVulnerabilitiesByTime(_ *analysisEntities.Analysis) error {
 	args := m.MethodCalled("AddVulnerabilitiesByTime")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) AddVulnerabilitiesByWorkspace(_ *analysisEntities.Analysis) error {
+	args := m.MethodCalled("AddVulnerabilitiesByWorkspace")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetAllApplicationAdminCharts(_ *dashboard.Filter) (*dashboard.Response, error) {
+	args := m.MethodCalled("GetAllApplicationAdminCharts")
+	return args.Get(0).(*dashboard.Response), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) AddVulnerabilitiesBySecurityTool(_ *analysisEntities.Analysis) error {
+	args := m.MethodCalled("AddVulnerabilitiesBySecurityTool")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) AddVulnerabilitiesByCWE(_ *analysisEntities.Analysis) error {
+	args := m.MethodCalled("AddVulnerabilitiesByCWE")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
@@ -26,6 +26,9 @@ func TestGetAllDashboardCharts(t *testing.T) {
 		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
 		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
 		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return([]*dashboard.VulnerabilitiesBySecurityTool{}, nil)
+		repoMock.On("GetDashboardVulnByCWE").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardVulnByOWASP").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
 
 		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
 
@@ -34,6 +37,67 @@ func TestGetAllDashboardCharts(t *testing.T) {
 		assert.NotEmpty(t, result)
 	})
 
+	t.Run("should return error when getting vuln by owasp", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return([]*dashboard.VulnerabilitiesBySecurityTool{}, nil)
+		repoMock.On("GetDashboardVulnByCWE").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardVulnByOWASP").Return([]*dashboard.VulnerabilitiesByCWE{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllDashboardCharts(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when getting vuln by cwe", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return([]*dashboard.VulnerabilitiesBySecurityTool{}, nil)
+		repoMock.On("GetDashboardVulnByCWE").Return([]*dashboard.VulnerabilitiesByCWE{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllDashboardCharts(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when getting vuln by security tool", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return(
+			[]*dashboard.VulnerabilitiesBySecurityTool{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllDashboardCharts(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
 	t.Run("should return error when getting vuln by time", func(t *testing.T) {
 		repoMock := &dashboardRepository.Mock{}
 
@@ -199,3 +263,130 @@ func TestAddVulnerabilitiesByTime(t *testing.T) {
 		assert.NoError(t, controller.AddVulnerabilitiesByTime(&analysisEntities.Analysis{}))
 	})
 }
+
+func TestAddVulnerabilitiesByWorkspace(t *testing.T) {
+	t.Run("should success add vulnerabilities", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := NewDashboardController(repoMock, &database.Connection{Write: databaseMock, Read: databaseMock},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByWorkspace(&analysisEntities.Analysis{}))
+	})
+}
+
+func TestGetAllApplicationAdminCharts(t *testing.T) {
+	t.Run("should return all charts of all workspaces without errors", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return([]*dashboard.VulnerabilitiesBySecurityTool{}, nil)
+		repoMock.On("GetDashboardVulnByCWE").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardVulnByOWASP").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardTotalWorkspaces").Return(2, nil)
+		repoMock.On("GetDashboardVulnByWorkspace").Return(
+			[]*dashboard.VulnerabilitiesByWorkspace{{WorkspaceName: "test"}}, nil)
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllApplicationAdminCharts(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.TotalWorkspaces)
+		assert.Len(t, result.VulnerabilitiesByWorkspace, 1)
+	})
+
+	t.Run("should return error when getting vuln by workspace", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return([]*dashboard.VulnerabilitiesBySecurityTool{}, nil)
+		repoMock.On("GetDashboardVulnByCWE").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardVulnByOWASP").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardTotalWorkspaces").Return(0, nil)
+		repoMock.On("GetDashboardVulnByWorkspace").Return(
+			[]*dashboard.VulnerabilitiesByWorkspace{}, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllApplicationAdminCharts(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when getting total workspaces", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, nil)
+		repoMock.On("GetDashboardTotalRepositories").Return(0, nil)
+		repoMock.On("GetDashboardVulnBySeverity").Return(&dashboard.Vulnerability{}, nil)
+		repoMock.On("GetDashboardVulnByAuthor").Return([]*dashboard.VulnerabilitiesByAuthor{}, nil)
+		repoMock.On("GetDashboardVulnByRepository").Return([]*dashboard.VulnerabilitiesByRepository{}, nil)
+		repoMock.On("GetDashboardVulnByLanguage").Return([]*dashboard.VulnerabilitiesByLanguage{}, nil)
+		repoMock.On("GetDashboardVulnByTime").Return([]*dashboard.VulnerabilitiesByTime{}, nil)
+		repoMock.On("GetDashboardVulnBySecurityTool").Return([]*dashboard.VulnerabilitiesBySecurityTool{}, nil)
+		repoMock.On("GetDashboardVulnByCWE").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardVulnByOWASP").Return([]*dashboard.VulnerabilitiesByCWE{}, nil)
+		repoMock.On("GetDashboardTotalWorkspaces").Return(0, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllApplicationAdminCharts(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when getting default charts", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		repoMock.On("GetDashboardTotalDevelopers").Return(0, errors.New("test"))
+
+		controller := NewDashboardController(repoMock, &database.Connection{}, dashboardUseCases.NewUseCaseDashboard())
+
+		result, err := controller.GetAllApplicationAdminCharts(&dashboard.Filter{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestAddVulnerabilitiesBySecurityTool(t *testing.T) {
+	t.Run("should success add vulnerabilities", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := NewDashboardController(repoMock, &database.Connection{Write: databaseMock, Read: databaseMock},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesBySecurityTool(&analysisEntities.Analysis{}))
+	})
+}
+
+func TestAddVulnerabilitiesByCWE(t *testing.T) {
+	t.Run("should success add vulnerabilities", func(t *testing.T) {
+		repoMock := &dashboardRepository.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := NewDashboardController(repoMock, &database.Connection{Write: databaseMock, Read: databaseMock},
+			dashboardUseCases.NewUseCaseDashboard())
+
+		assert.NoError(t, controller.AddVulnerabilitiesByCWE(&analysisEntities.Analysis{}))
+	})
+}
@@ -0,0 +1,28 @@
+package metadata
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/metadata"
+	metadataEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/metadata"
+)
+
+type IController interface {
+	SetRepositoryLabels(labels *metadata.RepositoryLabels) error
+}
+
+type Controller struct {
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewMetadataController(connection *database.Connection) IController {
+	return &Controller{
+		databaseWrite: connection.Write,
+	}
+}
+
+// SetRepositoryLabels keeps a copy of the latest labels of each repository, used to filter the dashboards
+func (c *Controller) SetRepositoryLabels(labels *metadata.RepositoryLabels) error {
+	return c.databaseWrite.CreateOrUpdate(labels, labels.ToUpdateFilter(),
+		metadataEnums.TableRepositoryLabels).GetError()
+}
@@ -0,0 +1,18 @@
+package metadata
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/metadata"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) SetRepositoryLabels(_ *metadata.RepositoryLabels) error {
+	args := m.MethodCalled("SetRepositoryLabels")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
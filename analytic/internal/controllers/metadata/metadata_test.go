@@ -0,0 +1,41 @@
+package metadata
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/metadata"
+)
+
+func TestSetRepositoryLabels(t *testing.T) {
+	labels := &metadata.RepositoryLabels{
+		RepositoryID: uuid.New(),
+		WorkspaceID:  uuid.New(),
+		Labels:       pq.StringArray{"criticality=high"},
+	}
+
+	t.Run("should success set repository labels", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("CreateOrUpdate").Return(&response.Response{})
+
+		controller := NewMetadataController(&database.Connection{Write: databaseMock})
+
+		assert.NoError(t, controller.SetRepositoryLabels(labels))
+	})
+
+	t.Run("should return error when failed to set repository labels", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("CreateOrUpdate").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewMetadataController(&database.Connection{Write: databaseMock})
+
+		assert.Error(t, controller.SetRepositoryLabels(labels))
+	})
+}
@@ -0,0 +1,81 @@
+package risk
+
+import (
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+	repoRisk "github.com/ZupIT/horusec-platform/analytic/internal/repositories/risk"
+	riskUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/risk"
+)
+
+type IController interface {
+	AddRiskScore(analysis *analysisEntities.Analysis) error
+	SetRepositoryCriticality(criticality *risk.RepositoryCriticality) error
+	GetRiskScoreRanking(filter *dashboard.Filter) ([]*risk.RiskScore, error)
+	GetRiskScoreByTime(filter *dashboard.Filter) ([]*risk.ScoreByTime, error)
+}
+
+type Controller struct {
+	repository    repoRisk.IRepoRisk
+	useCases      riskUseCases.IUseCases
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewRiskController(repository repoRisk.IRepoRisk, connection *database.Connection,
+	useCases riskUseCases.IUseCases) IController {
+	return &Controller{
+		repository:    repository,
+		databaseWrite: connection.Write,
+		useCases:      useCases,
+	}
+}
+
+func (c *Controller) AddRiskScore(analysis *analysisEntities.Analysis) error {
+	criticality, err := c.repository.GetRepositoryCriticality(analysis.RepositoryID)
+	if err != nil {
+		return err
+	}
+
+	return c.addRiskScoreWithCriticality(analysis, criticality)
+}
+
+func (c *Controller) addRiskScoreWithCriticality(analysis *analysisEntities.Analysis,
+	criticality riskEnums.Criticality) error {
+	firstSeen, err := c.repository.GetVulnerabilitiesFirstSeen(analysis.RepositoryID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.addVulnerabilitiesFirstSeen(analysis, firstSeen); err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Create(c.useCases.ParseAnalysisToRiskScore(analysis, criticality, firstSeen),
+		riskEnums.TableRiskScoreByRepository).GetError()
+}
+
+func (c *Controller) addVulnerabilitiesFirstSeen(analysis *analysisEntities.Analysis,
+	firstSeen []*risk.VulnerabilityFirstSeen) error {
+	newFirstSeen := c.useCases.ParseAnalysisToVulnerabilitiesFirstSeen(analysis, firstSeen)
+	if len(newFirstSeen) == 0 {
+		return nil
+	}
+
+	return c.databaseWrite.Create(newFirstSeen, riskEnums.TableVulnerabilitiesFirstSeen).GetError()
+}
+
+func (c *Controller) SetRepositoryCriticality(criticality *risk.RepositoryCriticality) error {
+	return c.databaseWrite.CreateOrUpdate(criticality, criticality.ToUpdateFilter(),
+		riskEnums.TableRepositoryCriticality).GetError()
+}
+
+func (c *Controller) GetRiskScoreRanking(filter *dashboard.Filter) ([]*risk.RiskScore, error) {
+	return c.repository.GetRiskScoreRanking(filter)
+}
+
+func (c *Controller) GetRiskScoreByTime(filter *dashboard.Filter) ([]*risk.ScoreByTime, error) {
+	return c.repository.GetRiskScoreByTime(filter)
+}
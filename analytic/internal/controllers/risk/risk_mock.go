@@ -0,0 +1,35 @@
+package risk
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) AddRiskScore(_ *analysisEntities.Analysis) error {
+	args := m.MethodCalled("AddRiskScore")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) SetRepositoryCriticality(_ *risk.RepositoryCriticality) error {
+	args := m.MethodCalled("SetRepositoryCriticality")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetRiskScoreRanking(_ *dashboard.Filter) ([]*risk.RiskScore, error) {
+	args := m.MethodCalled("GetRiskScoreRanking")
+	return args.Get(0).([]*risk.RiskScore), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetRiskScoreByTime(_ *dashboard.Filter) ([]*risk.ScoreByTime, error) {
+	args := m.MethodCalled("GetRiskScoreByTime")
+	return args.Get(0).([]*risk.ScoreByTime), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,132 @@
+package risk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+	riskRepository "github.com/ZupIT/horusec-platform/analytic/internal/repositories/risk"
+	riskUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/risk"
+)
+
+func TestAddRiskScore(t *testing.T) {
+	analysis := &analysisEntities.Analysis{
+		RepositoryID: uuid.New(),
+		AnalysisVulnerabilities: []analysisEntities.AnalysisVulnerabilities{
+			{Vulnerability: vulnerability.Vulnerability{VulnHash: "test"}},
+		},
+	}
+
+	t.Run("should success add risk score and vulnerabilities first seen", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRepositoryCriticality").Return(riskEnums.CriticalityHigh, nil)
+		repoMock.On("GetVulnerabilitiesFirstSeen").Return([]*risk.VulnerabilityFirstSeen{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := NewRiskController(repoMock, &database.Connection{Write: databaseMock},
+			riskUseCases.NewUseCaseRisk())
+
+		assert.NoError(t, controller.AddRiskScore(analysis))
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("should not add vulnerabilities first seen when all already exists", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRepositoryCriticality").Return(riskEnums.CriticalityHigh, nil)
+		repoMock.On("GetVulnerabilitiesFirstSeen").Return(
+			[]*risk.VulnerabilityFirstSeen{{VulnHash: "test"}}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := NewRiskController(repoMock, &database.Connection{Write: databaseMock},
+			riskUseCases.NewUseCaseRisk())
+
+		assert.NoError(t, controller.AddRiskScore(analysis))
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("should return error when failed to create vulnerabilities first seen", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRepositoryCriticality").Return(riskEnums.CriticalityHigh, nil)
+		repoMock.On("GetVulnerabilitiesFirstSeen").Return([]*risk.VulnerabilityFirstSeen{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewRiskController(repoMock, &database.Connection{Write: databaseMock},
+			riskUseCases.NewUseCaseRisk())
+
+		assert.Error(t, controller.AddRiskScore(analysis))
+	})
+
+	t.Run("should return error when failed to get vulnerabilities first seen", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRepositoryCriticality").Return(riskEnums.CriticalityHigh, nil)
+		repoMock.On("GetVulnerabilitiesFirstSeen").Return(
+			[]*risk.VulnerabilityFirstSeen{}, errors.New("test"))
+
+		controller := NewRiskController(repoMock, &database.Connection{}, riskUseCases.NewUseCaseRisk())
+
+		assert.Error(t, controller.AddRiskScore(analysis))
+	})
+
+	t.Run("should return error when failed to get repository criticality", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRepositoryCriticality").Return(riskEnums.CriticalityMedium, errors.New("test"))
+
+		controller := NewRiskController(repoMock, &database.Connection{}, riskUseCases.NewUseCaseRisk())
+
+		assert.Error(t, controller.AddRiskScore(analysis))
+	})
+}
+
+func TestSetRepositoryCriticality(t *testing.T) {
+	t.Run("should success set repository criticality", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("CreateOrUpdate").Return(&response.Response{})
+
+		controller := NewRiskController(&riskRepository.Mock{}, &database.Connection{Write: databaseMock},
+			riskUseCases.NewUseCaseRisk())
+
+		assert.NoError(t, controller.SetRepositoryCriticality(&risk.RepositoryCriticality{}))
+	})
+}
+
+func TestGetRiskScoreRanking(t *testing.T) {
+	t.Run("should success get risk score ranking", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRiskScoreRanking").Return([]*risk.RiskScore{{}}, nil)
+
+		controller := NewRiskController(repoMock, &database.Connection{}, riskUseCases.NewUseCaseRisk())
+
+		result, err := controller.GetRiskScoreRanking(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestGetRiskScoreByTime(t *testing.T) {
+	t.Run("should success get risk score by time", func(t *testing.T) {
+		repoMock := &riskRepository.Mock{}
+		repoMock.On("GetRiskScoreByTime").Return([]*risk.ScoreByTime{{}}, nil)
+
+		controller := NewRiskController(repoMock, &database.Connection{}, riskUseCases.NewUseCaseRisk())
+
+		result, err := controller.GetRiskScoreByTime(&dashboard.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
@@ -0,0 +1,82 @@
+package transfer
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/transfer"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	metadataEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/metadata"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+	transferEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/transfer"
+)
+
+type IController interface {
+	TransferRepository(event *transfer.Event) error
+}
+
+type Controller struct {
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewTransferController(connection *database.Connection) IController {
+	return &Controller{
+		databaseWrite: connection.Write,
+	}
+}
+
+// TransferRepository moves all the dashboard data of the repository to the target workspace in a single transaction
+func (c *Controller) TransferRepository(event *transfer.Event) error {
+	transaction := c.databaseWrite.StartTransaction()
+
+	if err := c.updateTables(transaction, event); err != nil {
+		logger.LogError(transferEnums.MessageFailedToRollbackTransfer, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := transaction.CommitTransaction().GetError(); err != nil {
+		return errors.Wrap(err, transferEnums.MessageFailedToCommitTransfer)
+	}
+
+	return nil
+}
+
+func (c *Controller) updateTables(transaction database.IDatabaseWrite, event *transfer.Event) error {
+	for _, table := range c.getTablesWithRepositoryName() {
+		if err := transaction.Update(event.ToRepositoryUpdateMap(), event.ToRepositoryFilter(),
+			table).GetError(); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range c.getTablesWithWorkspaceID() {
+		if err := transaction.Update(event.ToWorkspaceUpdateMap(), event.ToRepositoryFilter(),
+			table).GetError(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) getTablesWithRepositoryName() []string {
+	return []string{
+		dashboardEnums.TableVulnerabilitiesByRepository,
+		riskEnums.TableRiskScoreByRepository,
+	}
+}
+
+func (c *Controller) getTablesWithWorkspaceID() []string {
+	return []string{
+		dashboardEnums.TableVulnerabilitiesByAuthor,
+		dashboardEnums.TableVulnerabilitiesByLanguage,
+		dashboardEnums.TableVulnerabilitiesByTime,
+		dashboardEnums.TableVulnerabilitiesByWorkspace,
+		dashboardEnums.TableVulnerabilitiesBySecurityTool,
+		dashboardEnums.TableVulnerabilitiesByCWE,
+		riskEnums.TableRepositoryCriticality,
+		metadataEnums.TableRepositoryLabels,
+	}
+}
@@ -0,0 +1,18 @@
+package transfer
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/transfer"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) TransferRepository(_ *transfer.Event) error {
+	args := m.MethodCalled("TransferRepository")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
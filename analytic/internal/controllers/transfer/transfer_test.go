@@ -0,0 +1,58 @@
+package transfer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/transfer"
+)
+
+func TestTransferRepository(t *testing.T) {
+	event := &transfer.Event{
+		RepositoryID:      uuid.New(),
+		SourceWorkspaceID: uuid.New(),
+		TargetWorkspaceID: uuid.New(),
+		Name:              "test",
+	}
+
+	t.Run("should success update all tables of the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		controller := NewTransferController(&database.Connection{Write: databaseMock})
+
+		assert.NoError(t, controller.TransferRepository(event))
+		databaseMock.AssertNumberOfCalls(t, "Update", 10)
+	})
+
+	t.Run("should return error and rollback when failed to update", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		controller := NewTransferController(&database.Connection{Write: databaseMock})
+
+		assert.Error(t, controller.TransferRepository(event))
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should return error when failed to commit", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewTransferController(&database.Connection{Write: databaseMock})
+
+		assert.Error(t, controller.TransferRepository(event))
+	})
+}
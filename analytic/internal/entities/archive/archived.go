@@ -0,0 +1,18 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ArchivedWorkspace struct {
+	WorkspaceID uuid.UUID `json:"workspaceID" gorm:"Column:workspace_id;primary_key"`
+	ArchivedAt  time.Time `json:"archivedAt" gorm:"Column:archived_at"`
+}
+
+type ArchivedRepository struct {
+	RepositoryID uuid.UUID `json:"repositoryID" gorm:"Column:repository_id;primary_key"`
+	WorkspaceID  uuid.UUID `json:"workspaceID" gorm:"Column:workspace_id"`
+	ArchivedAt   time.Time `json:"archivedAt" gorm:"Column:archived_at"`
+}
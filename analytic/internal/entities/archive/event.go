@@ -0,0 +1,44 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/archive"
+)
+
+// Event is received from the core archive exchange, the repository id is empty when it refers to the whole workspace
+type Event struct {
+	Action       archiveEnums.Action `json:"action"`
+	WorkspaceID  uuid.UUID           `json:"workspaceID"`
+	RepositoryID uuid.UUID           `json:"repositoryID"`
+	CreatedAt    time.Time           `json:"createdAt"`
+}
+
+func (e *Event) IsWorkspaceEvent() bool {
+	return e.RepositoryID == uuid.Nil
+}
+
+func (e *Event) ToArchivedWorkspace() *ArchivedWorkspace {
+	return &ArchivedWorkspace{
+		WorkspaceID: e.WorkspaceID,
+		ArchivedAt:  e.CreatedAt,
+	}
+}
+
+func (e *Event) ToArchivedRepository() *ArchivedRepository {
+	return &ArchivedRepository{
+		RepositoryID: e.RepositoryID,
+		WorkspaceID:  e.WorkspaceID,
+		ArchivedAt:   e.CreatedAt,
+	}
+}
+
+func (e *Event) ToWorkspaceFilter() map[string]interface{} {
+	return map[string]interface{}{archiveEnums.ColumnWorkspaceID: e.WorkspaceID}
+}
+
+func (e *Event) ToRepositoryFilter() map[string]interface{} {
+	return map[string]interface{}{archiveEnums.ColumnRepositoryID: e.RepositoryID}
+}
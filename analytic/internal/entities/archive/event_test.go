@@ -0,0 +1,62 @@
+package archive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/archive"
+)
+
+func TestIsWorkspaceEvent(t *testing.T) {
+	t.Run("should return true when repository id is empty", func(t *testing.T) {
+		event := &Event{WorkspaceID: uuid.New()}
+
+		assert.True(t, event.IsWorkspaceEvent())
+	})
+
+	t.Run("should return false when repository id is filled", func(t *testing.T) {
+		event := &Event{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		assert.False(t, event.IsWorkspaceEvent())
+	})
+}
+
+func TestToArchivedWorkspace(t *testing.T) {
+	t.Run("should parse event to archived workspace", func(t *testing.T) {
+		event := &Event{WorkspaceID: uuid.New(), CreatedAt: time.Now()}
+
+		archived := event.ToArchivedWorkspace()
+		assert.Equal(t, event.WorkspaceID, archived.WorkspaceID)
+		assert.Equal(t, event.CreatedAt, archived.ArchivedAt)
+	})
+}
+
+func TestToArchivedRepository(t *testing.T) {
+	t.Run("should parse event to archived repository", func(t *testing.T) {
+		event := &Event{WorkspaceID: uuid.New(), RepositoryID: uuid.New(), CreatedAt: time.Now()}
+
+		archived := event.ToArchivedRepository()
+		assert.Equal(t, event.WorkspaceID, archived.WorkspaceID)
+		assert.Equal(t, event.RepositoryID, archived.RepositoryID)
+		assert.Equal(t, event.CreatedAt, archived.ArchivedAt)
+	})
+}
+
+func TestToWorkspaceFilter(t *testing.T) {
+	t.Run("should return filter by workspace id", func(t *testing.T) {
+		event := &Event{WorkspaceID: uuid.New()}
+
+		assert.Equal(t, event.WorkspaceID, event.ToWorkspaceFilter()[archiveEnums.ColumnWorkspaceID])
+	})
+}
+
+func TestToRepositoryFilter(t *testing.T) {
+	t.Run("should return filter by repository id", func(t *testing.T) {
+		event := &Event{RepositoryID: uuid.New()}
+
+		assert.Equal(t, event.RepositoryID, event.ToRepositoryFilter()[archiveEnums.ColumnRepositoryID])
+	})
+}
@@ -0,0 +1,189 @@
+package dashboard
+
+import (
+	"strconv"
+
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+)
+
+type Chart struct {
+	Name   string
+	Header []string
+	Rows   [][]string
+}
+
+func newChartBySeverities(name, label string) *Chart {
+	header := append([]string{label}, chartSeverityLabels()...)
+
+	return &Chart{
+		Name:   name,
+		Header: append(header, "TOTAL"),
+	}
+}
+
+func (c *Chart) addRowBySeverities(label string, bySeverities *BySeverities) {
+	row := []string{label}
+	total := 0
+
+	for _, severity := range bySeverities.toSlice() {
+		row = append(row, strconv.Itoa(severity.Count))
+		total += severity.Count
+	}
+
+	c.Rows = append(c.Rows, append(row, strconv.Itoa(total)))
+}
+
+func (r *Response) ToCharts() (charts []*Chart) {
+	charts = append(charts, r.totalsToChart(), r.bySeverityToChart(), r.byAuthorToChart(),
+		r.byRepositoryToChart(), r.byLanguageToChart(), r.byTimeToChart(), r.bySecurityToolToChart(),
+		r.byCWEToChart(), r.byOWASPToChart())
+
+	if len(r.VulnerabilitiesByWorkspace) > 0 {
+		charts = append(charts, r.byWorkspaceToChart())
+	}
+
+	return charts
+}
+
+func (r *Response) GetChartByName(name string) *Chart {
+	for _, chart := range r.ToCharts() {
+		if chart.Name == name {
+			return chart
+		}
+	}
+
+	return nil
+}
+
+func (r *Response) totalsToChart() *Chart {
+	chart := &Chart{
+		Name:   dashboardEnums.ChartTotals,
+		Header: []string{"TOTAL", "VALUE"},
+		Rows: [][]string{
+			{"AUTHORS", strconv.Itoa(r.TotalAuthors)},
+			{"REPOSITORIES", strconv.Itoa(r.TotalRepositories)},
+		},
+	}
+
+	if r.TotalWorkspaces > 0 {
+		chart.Rows = append(chart.Rows, []string{"WORKSPACES", strconv.Itoa(r.TotalWorkspaces)})
+	}
+
+	return chart
+}
+
+func (r *Response) bySeverityToChart() *Chart {
+	chart := &Chart{
+		Name:   dashboardEnums.ChartVulnerabilityBySeverity,
+		Header: []string{"SEVERITY", "VULNERABILITY", "RISK ACCEPTED", "FALSE POSITIVE", "CORRECTED", "TOTAL"},
+	}
+
+	if r.VulnerabilityBySeverity == nil {
+		return chart
+	}
+
+	for index, severity := range r.VulnerabilityBySeverity.toSlice() {
+		chart.addRowByVulnerabilityTypes(chartSeverityLabels()[index], severity)
+	}
+
+	return chart
+}
+
+func (c *Chart) addRowByVulnerabilityTypes(label string, severity *BySeverity) {
+	c.Rows = append(c.Rows, []string{
+		label, strconv.Itoa(severity.Types.Vulnerability), strconv.Itoa(severity.Types.RiskAccepted),
+		strconv.Itoa(severity.Types.FalsePositive), strconv.Itoa(severity.Types.Corrected),
+		strconv.Itoa(severity.Count),
+	})
+}
+
+func (r *Response) byAuthorToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesByAuthor, "AUTHOR")
+
+	for index := range r.VulnerabilitiesByAuthor {
+		chart.addRowBySeverities(r.VulnerabilitiesByAuthor[index].Author,
+			r.VulnerabilitiesByAuthor[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) byRepositoryToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesByRepository, "REPOSITORY")
+
+	for index := range r.VulnerabilitiesByRepository {
+		chart.addRowBySeverities(r.VulnerabilitiesByRepository[index].RepositoryName,
+			r.VulnerabilitiesByRepository[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) byLanguageToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesByLanguage, "LANGUAGE")
+
+	for index := range r.VulnerabilitiesByLanguage {
+		chart.addRowBySeverities(r.VulnerabilitiesByLanguage[index].Language.ToString(),
+			r.VulnerabilitiesByLanguage[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) byTimeToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilityByTime, "DATE")
+
+	for index := range r.VulnerabilitiesByTime {
+		chart.addRowBySeverities(r.VulnerabilitiesByTime[index].Time.Format("2006-01-02"),
+			r.VulnerabilitiesByTime[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) byWorkspaceToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesByWorkspace, "WORKSPACE")
+
+	for index := range r.VulnerabilitiesByWorkspace {
+		chart.addRowBySeverities(r.VulnerabilitiesByWorkspace[index].WorkspaceName,
+			r.VulnerabilitiesByWorkspace[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) bySecurityToolToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesBySecurityTool, "SECURITY TOOL")
+
+	for index := range r.VulnerabilitiesBySecurityTool {
+		chart.addRowBySeverities(r.VulnerabilitiesBySecurityTool[index].SecurityTool.ToString(),
+			r.VulnerabilitiesBySecurityTool[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) byCWEToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesByCWE, "CWE")
+
+	for index := range r.VulnerabilitiesByCWE {
+		chart.addRowBySeverities(r.VulnerabilitiesByCWE[index].CWE, r.VulnerabilitiesByCWE[index].BySeverities)
+	}
+
+	return chart
+}
+
+func (r *Response) byOWASPToChart() *Chart {
+	chart := newChartBySeverities(dashboardEnums.ChartVulnerabilitiesByOWASP, "OWASP TOP 10")
+
+	for index := range r.VulnerabilitiesByOWASP {
+		chart.addRowBySeverities(r.VulnerabilitiesByOWASP[index].OWASPCategory,
+			r.VulnerabilitiesByOWASP[index].BySeverities)
+	}
+
+	return chart
+}
+
+func chartSeverityLabels() []string {
+	return []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFO", "UNKNOWN"}
+}
@@ -0,0 +1,94 @@
+package dashboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+)
+
+func getResponseMock() *Response {
+	vulnerability := &Vulnerability{CriticalVulnerability: 2, HighFalsePositive: 1}
+
+	return &Response{
+		TotalAuthors:            1,
+		TotalRepositories:       1,
+		VulnerabilityBySeverity: vulnerability.ToResponseBySeverities(),
+		VulnerabilitiesByAuthor: []ByAuthor{
+			{Author: "test@horusec.io", BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+		VulnerabilitiesByRepository: []ByRepository{
+			{RepositoryName: "test", BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+		VulnerabilitiesByLanguage: []ByLanguage{
+			{Language: languages.Go, BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+		VulnerabilitiesByTime: []ByTime{
+			{Time: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+		VulnerabilitiesBySecurityTool: []BySecurityTool{
+			{SecurityTool: tools.GoSec, BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+		VulnerabilitiesByCWE: []ByCWE{
+			{CWE: "CWE-89", BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+		VulnerabilitiesByOWASP: []ByOWASP{
+			{OWASPCategory: "A03:2021-Injection", BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+	}
+}
+
+func TestToCharts(t *testing.T) {
+	t.Run("should parse response to all charts", func(t *testing.T) {
+		charts := getResponseMock().ToCharts()
+
+		assert.Len(t, charts, 9)
+
+		for _, chart := range charts {
+			assert.NotEmpty(t, chart.Name)
+			assert.NotEmpty(t, chart.Header)
+			assert.NotEmpty(t, chart.Rows)
+
+			for _, row := range chart.Rows {
+				assert.Len(t, row, len(chart.Header))
+			}
+		}
+	})
+
+	t.Run("should add workspace chart when response contains workspaces", func(t *testing.T) {
+		response := getResponseMock()
+		response.TotalWorkspaces = 1
+		response.VulnerabilitiesByWorkspace = []ByWorkspace{
+			{WorkspaceName: "test", BySeverities: (&Vulnerability{}).ToResponseBySeverities()},
+		}
+
+		charts := response.ToCharts()
+
+		assert.Len(t, charts, 10)
+		assert.Len(t, charts[0].Rows, 3)
+	})
+
+	t.Run("should parse empty response without panics", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			assert.Len(t, (&Response{}).ToCharts(), 9)
+		})
+	})
+}
+
+func TestGetChartByName(t *testing.T) {
+	t.Run("should return chart by author with total by severity", func(t *testing.T) {
+		chart := getResponseMock().GetChartByName(dashboardEnums.ChartVulnerabilitiesByAuthor)
+
+		assert.NotNil(t, chart)
+		assert.Equal(t, []string{"test@horusec.io", "2", "1", "0", "0", "0", "0", "3"}, chart.Rows[0])
+	})
+
+	t.Run("should return nil when chart not exists", func(t *testing.T) {
+		assert.Nil(t, getResponseMock().GetChartByName("test"))
+	})
+}
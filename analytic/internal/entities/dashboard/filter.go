@@ -8,6 +8,7 @@ import (
 	"github.com/go-chi/chi"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
@@ -20,6 +21,7 @@ type Filter struct {
 	EndTime      time.Time
 	Page         int
 	Size         int
+	Labels       pq.StringArray
 }
 
 func (f *Filter) GetConditionFilter() (string, []interface{}) {
@@ -27,11 +29,16 @@ func (f *Filter) GetConditionFilter() (string, []interface{}) {
 	query, args = f.getRepositoryFilter(query, args)
 	query, args = f.getInitialDateFilter(query, args)
 	query, args = f.getFinalDateFilter(query, args)
+	query, args = f.getLabelsFilter(query, args)
 
-	return query, args
+	return f.getArchivedFilter(query), args
 }
 
 func (f *Filter) getWorkspaceFilter() (string, []interface{}) {
+	if f.WorkspaceID == uuid.Nil {
+		return "workspace_id IS NOT NULL ", []interface{}{}
+	}
+
 	return "workspace_id = ? ", []interface{}{f.WorkspaceID}
 }
 
@@ -62,6 +69,22 @@ func (f *Filter) getFinalDateFilter(query string, args []interface{}) (string, [
 	return query, args
 }
 
+// getLabelsFilter keeps only the repositories with all the informed labels, using the copy of the labels received
+// from core events
+func (f *Filter) getLabelsFilter(query string, args []interface{}) (string, []interface{}) {
+	if len(f.Labels) > 0 {
+		query += "AND repository_id IN (SELECT repository_id FROM repository_labels WHERE labels @> ?) "
+		args = append(args, f.Labels)
+	}
+
+	return query, args
+}
+
+func (f *Filter) getArchivedFilter(query string) string {
+	return query + "AND workspace_id NOT IN (SELECT workspace_id FROM archived_workspaces) " +
+		"AND repository_id NOT IN (SELECT repository_id FROM archived_repositories) "
+}
+
 func (f *Filter) Validate() error {
 	return validation.ValidateStruct(f,
 		validation.Field(&f.WorkspaceID, validation.Required, validation.NotIn(uuid.Nil)),
@@ -69,9 +92,24 @@ func (f *Filter) Validate() error {
 		validation.Field(&f.EndTime, validation.Required),
 		validation.Field(&f.Page, validation.Min(0)),
 		validation.Field(&f.Size, validation.Min(dashboardEnums.DefaultPaginationSize)),
+		validation.Field(&f.Labels, validation.Each(validation.Match(dashboardEnums.LabelRegex))),
+	)
+}
+
+func (f *Filter) ValidateApplicationAdmin() error {
+	return validation.ValidateStruct(f,
+		validation.Field(&f.StartTime, validation.Required),
+		validation.Field(&f.EndTime, validation.Required),
+		validation.Field(&f.Page, validation.Min(0)),
+		validation.Field(&f.Size, validation.Min(dashboardEnums.DefaultPaginationSize)),
+		validation.Field(&f.Labels, validation.Each(validation.Match(dashboardEnums.LabelRegex))),
 	)
 }
 
+func (f *Filter) GetPagination() (limit, offset int) {
+	return f.Size, f.Page * f.Size
+}
+
 func (f *Filter) SetDateRangeAndPagination(request *http.Request) error {
 	initialDate, err := f.parseDate(request.URL.Query().Get(dashboardEnums.InitialDateHeader))
 	if err != nil {
@@ -89,6 +127,10 @@ func (f *Filter) SetDateRangeAndPagination(request *http.Request) error {
 	return nil
 }
 
+func (f *Filter) SetLabels(request *http.Request) {
+	f.Labels = request.URL.Query()[dashboardEnums.LabelQuery]
+}
+
 func (f *Filter) parseDate(date string) (time.Time, error) {
 	if date != "" {
 		return time.Parse("2006-01-02T15:04:05Z", date)
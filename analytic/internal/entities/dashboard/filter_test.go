@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
@@ -29,7 +30,38 @@ func TestGetConditionFilter(t *testing.T) {
 
 		assert.NotEmpty(t, where)
 		assert.NotEmpty(t, args)
-		assert.Equal(t, "workspace_id = ? AND repository_id = ? AND created_at >= ? AND created_at <= ? ", where)
+		assert.Equal(t, "workspace_id = ? AND repository_id = ? AND created_at >= ? AND created_at <= ? "+
+			"AND workspace_id NOT IN (SELECT workspace_id FROM archived_workspaces) "+
+			"AND repository_id NOT IN (SELECT repository_id FROM archived_repositories) ", where)
+	})
+
+	t.Run("should get condition filter for all workspaces when workspace id is nil", func(t *testing.T) {
+		filter := &Filter{
+			StartTime: time.Now(),
+			EndTime:   time.Now(),
+		}
+
+		where, args := filter.GetConditionFilter()
+
+		assert.Len(t, args, 2)
+		assert.Equal(t, "workspace_id IS NOT NULL AND created_at >= ? AND created_at <= ? "+
+			"AND workspace_id NOT IN (SELECT workspace_id FROM archived_workspaces) "+
+			"AND repository_id NOT IN (SELECT repository_id FROM archived_repositories) ", where)
+	})
+
+	t.Run("should get condition filter by repository labels", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID: uuid.New(),
+			Labels:      pq.StringArray{"criticality=high"},
+		}
+
+		where, args := filter.GetConditionFilter()
+
+		assert.Len(t, args, 2)
+		assert.Equal(t, "workspace_id = ? "+
+			"AND repository_id IN (SELECT repository_id FROM repository_labels WHERE labels @> ?) "+
+			"AND workspace_id NOT IN (SELECT workspace_id FROM archived_workspaces) "+
+			"AND repository_id NOT IN (SELECT repository_id FROM archived_repositories) ", where)
 	})
 }
 
@@ -52,6 +84,59 @@ func TestValidate(t *testing.T) {
 
 		assert.Error(t, filter.Validate())
 	})
+
+	t.Run("should return error when invalid label", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID: uuid.New(),
+			StartTime:   time.Now(),
+			EndTime:     time.Now(),
+			Size:        10,
+			Labels:      pq.StringArray{"criticality"},
+		}
+
+		assert.Error(t, filter.Validate())
+	})
+}
+
+func TestSetLabels(t *testing.T) {
+	t.Run("should set labels from query", func(t *testing.T) {
+		filter := &Filter{}
+
+		request, _ := http.NewRequest(http.MethodGet, "test?label=criticality=high&label=team=payments", nil)
+		filter.SetLabels(request)
+
+		assert.Equal(t, pq.StringArray{"criticality=high", "team=payments"}, filter.Labels)
+	})
+}
+
+func TestValidateApplicationAdmin(t *testing.T) {
+	t.Run("should return no error when valid filter without workspace", func(t *testing.T) {
+		filter := &Filter{
+			StartTime: time.Now(),
+			EndTime:   time.Now(),
+			Page:      0,
+			Size:      10,
+		}
+
+		assert.NoError(t, filter.ValidateApplicationAdmin())
+	})
+
+	t.Run("should return error when invalid filter", func(t *testing.T) {
+		filter := &Filter{}
+
+		assert.Error(t, filter.ValidateApplicationAdmin())
+	})
+}
+
+func TestGetPagination(t *testing.T) {
+	t.Run("should return limit and offset by page and size", func(t *testing.T) {
+		filter := &Filter{Page: 2, Size: 10}
+
+		limit, offset := filter.GetPagination()
+
+		assert.Equal(t, 10, limit)
+		assert.Equal(t, 20, offset)
+	})
 }
 
 func TestSetDateRangeAndPagination(t *testing.T) {
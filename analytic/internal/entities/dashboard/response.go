@@ -1,13 +1,18 @@
 package dashboard
 
 type Response struct {
-	TotalAuthors                int            `json:"totalAuthors"`
-	TotalRepositories           int            `json:"totalRepositories"`
-	VulnerabilityBySeverity     *BySeverities  `json:"vulnerabilityBySeverity"`
-	VulnerabilitiesByAuthor     []ByAuthor     `json:"vulnerabilitiesByAuthor"`
-	VulnerabilitiesByRepository []ByRepository `json:"vulnerabilitiesByRepository"`
-	VulnerabilitiesByLanguage   []ByLanguage   `json:"vulnerabilitiesByLanguage"`
-	VulnerabilitiesByTime       []ByTime       `json:"vulnerabilityByTime"`
+	TotalAuthors                  int              `json:"totalAuthors"`
+	TotalRepositories             int              `json:"totalRepositories"`
+	TotalWorkspaces               int              `json:"totalWorkspaces,omitempty"`
+	VulnerabilityBySeverity       *BySeverities    `json:"vulnerabilityBySeverity"`
+	VulnerabilitiesByAuthor       []ByAuthor       `json:"vulnerabilitiesByAuthor"`
+	VulnerabilitiesByRepository   []ByRepository   `json:"vulnerabilitiesByRepository"`
+	VulnerabilitiesByLanguage     []ByLanguage     `json:"vulnerabilitiesByLanguage"`
+	VulnerabilitiesByTime         []ByTime         `json:"vulnerabilityByTime"`
+	VulnerabilitiesByWorkspace    []ByWorkspace    `json:"vulnerabilitiesByWorkspace,omitempty"`
+	VulnerabilitiesBySecurityTool []BySecurityTool `json:"vulnerabilitiesBySecurityTool"`
+	VulnerabilitiesByCWE          []ByCWE          `json:"vulnerabilitiesByCWE"`
+	VulnerabilitiesByOWASP        []ByOWASP        `json:"vulnerabilitiesByOWASP"`
 }
 
 func (r *Response) SetTotalAuthors(totalAuthors int, err error) error {
@@ -26,6 +31,14 @@ func (r *Response) SetTotalRepositories(totalRepositories int, err error) error
 	return err
 }
 
+func (r *Response) SetTotalWorkspaces(totalWorkspaces int, err error) error {
+	if err == nil {
+		r.TotalWorkspaces = totalWorkspaces
+	}
+
+	return err
+}
+
 func (r *Response) SetChartBySeverity(vulnerability *Vulnerability, err error) error {
 	if err == nil {
 		r.VulnerabilityBySeverity = vulnerability.ToResponseBySeverities()
@@ -73,3 +86,43 @@ func (r *Response) SetChartByTime(vulns []*VulnerabilitiesByTime, err error) err
 
 	return err
 }
+
+func (r *Response) SetChartByWorkspace(vulns []*VulnerabilitiesByWorkspace, err error) error {
+	if err == nil {
+		for index := range vulns {
+			r.VulnerabilitiesByWorkspace = append(r.VulnerabilitiesByWorkspace, vulns[index].ToResponseByWorkspace())
+		}
+	}
+
+	return err
+}
+
+func (r *Response) SetChartBySecurityTool(vulns []*VulnerabilitiesBySecurityTool, err error) error {
+	if err == nil {
+		for index := range vulns {
+			r.VulnerabilitiesBySecurityTool = append(r.VulnerabilitiesBySecurityTool, vulns[index].ToResponseBySecurityTool())
+		}
+	}
+
+	return err
+}
+
+func (r *Response) SetChartByCWE(vulns []*VulnerabilitiesByCWE, err error) error {
+	if err == nil {
+		for index := range vulns {
+			r.VulnerabilitiesByCWE = append(r.VulnerabilitiesByCWE, vulns[index].ToResponseByCWE())
+		}
+	}
+
+	return err
+}
+
+func (r *Response) SetChartByOWASP(vulns []*VulnerabilitiesByCWE, err error) error {
+	if err == nil {
+		for index := range vulns {
+			r.VulnerabilitiesByOWASP = append(r.VulnerabilitiesByOWASP, vulns[index].ToResponseByOWASP())
+		}
+	}
+
+	return err
+}
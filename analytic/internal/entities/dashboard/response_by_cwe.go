@@ -0,0 +1,6 @@
+package dashboard
+
+type ByCWE struct {
+	CWE string `json:"cwe"`
+	*BySeverities
+}
@@ -0,0 +1,6 @@
+package dashboard
+
+type ByOWASP struct {
+	OWASPCategory string `json:"owaspCategory"`
+	*BySeverities
+}
@@ -0,0 +1,10 @@
+package dashboard
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+)
+
+type BySecurityTool struct {
+	SecurityTool tools.Tool `json:"securityTool"`
+	*BySeverities
+}
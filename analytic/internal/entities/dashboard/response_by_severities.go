@@ -9,6 +9,10 @@ type BySeverities struct {
 	Unknown  *BySeverity `json:"unknown"`
 }
 
+func (s *BySeverities) toSlice() []*BySeverity {
+	return []*BySeverity{s.Critical, s.High, s.Medium, s.Low, s.Info, s.Unknown}
+}
+
 func (s *BySeverities) SumVulnerabilityCritical(vuln *Vulnerability) *BySeverities {
 	s.Critical.Count = vuln.CriticalVulnerability + vuln.CriticalRiskAccepted +
 		vuln.CriticalFalsePositive + vuln.CriticalCorrected
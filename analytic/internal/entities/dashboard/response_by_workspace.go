@@ -0,0 +1,11 @@
+package dashboard
+
+import (
+	"github.com/google/uuid"
+)
+
+type ByWorkspace struct {
+	WorkspaceID   uuid.UUID `json:"workspaceID"`
+	WorkspaceName string    `json:"workspaceName"`
+	*BySeverities
+}
@@ -37,6 +37,21 @@ func TestSetTotalRepositories(t *testing.T) {
 	})
 }
 
+func TestSetTotalWorkspaces(t *testing.T) {
+	t.Run("should success set total workspaces", func(t *testing.T) {
+		response := &Response{}
+
+		assert.NoError(t, response.SetTotalWorkspaces(18, nil))
+		assert.Equal(t, 18, response.TotalWorkspaces)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &Response{}
+
+		assert.Error(t, response.SetTotalWorkspaces(0, errors.New("test")))
+	})
+}
+
 func TestSetChartBySeverity(t *testing.T) {
 	t.Run("should success set chart by severity", func(t *testing.T) {
 		response := &Response{}
@@ -138,3 +153,91 @@ func TestSetChartByTime(t *testing.T) {
 		assert.Error(t, response.SetChartByTime(times, errors.New("test")))
 	})
 }
+
+func TestSetChartByWorkspace(t *testing.T) {
+	workspaces := []*VulnerabilitiesByWorkspace{
+		{
+			WorkspaceName: "test",
+			Vulnerability: Vulnerability{},
+		},
+	}
+
+	t.Run("should success set chart by workspace", func(t *testing.T) {
+		response := &Response{}
+
+		assert.NoError(t, response.SetChartByWorkspace(workspaces, nil))
+		assert.Len(t, response.VulnerabilitiesByWorkspace, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &Response{}
+
+		assert.Error(t, response.SetChartByWorkspace(workspaces, errors.New("test")))
+	})
+}
+
+func TestSetChartBySecurityTool(t *testing.T) {
+	vulns := []*VulnerabilitiesBySecurityTool{
+		{
+			SecurityTool:  "GoSec",
+			Vulnerability: Vulnerability{},
+		},
+	}
+
+	t.Run("should success set chart by security tool", func(t *testing.T) {
+		response := &Response{}
+
+		assert.NoError(t, response.SetChartBySecurityTool(vulns, nil))
+		assert.Len(t, response.VulnerabilitiesBySecurityTool, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &Response{}
+
+		assert.Error(t, response.SetChartBySecurityTool(vulns, errors.New("test")))
+	})
+}
+
+func TestSetChartByCWE(t *testing.T) {
+	vulns := []*VulnerabilitiesByCWE{
+		{
+			CWE:           "CWE-89",
+			Vulnerability: Vulnerability{},
+		},
+	}
+
+	t.Run("should success set chart by cwe", func(t *testing.T) {
+		response := &Response{}
+
+		assert.NoError(t, response.SetChartByCWE(vulns, nil))
+		assert.Len(t, response.VulnerabilitiesByCWE, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &Response{}
+
+		assert.Error(t, response.SetChartByCWE(vulns, errors.New("test")))
+	})
+}
+
+func TestSetChartByOWASP(t *testing.T) {
+	vulns := []*VulnerabilitiesByCWE{
+		{
+			OWASPCategory: "A03:2021-Injection",
+			Vulnerability: Vulnerability{},
+		},
+	}
+
+	t.Run("should success set chart by owasp", func(t *testing.T) {
+		response := &Response{}
+
+		assert.NoError(t, response.SetChartByOWASP(vulns, nil))
+		assert.Len(t, response.VulnerabilitiesByOWASP, 1)
+	})
+
+	t.Run("should return error when it is not nil", func(t *testing.T) {
+		response := &Response{}
+
+		assert.Error(t, response.SetChartByOWASP(vulns, errors.New("test")))
+	})
+}
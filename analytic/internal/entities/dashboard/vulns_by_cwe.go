@@ -0,0 +1,21 @@
+package dashboard
+
+type VulnerabilitiesByCWE struct {
+	CWE           string `json:"cwe" gorm:"Column:cwe"`
+	OWASPCategory string `json:"owasp_category" gorm:"Column:owasp_category"`
+	Vulnerability
+}
+
+func (v *VulnerabilitiesByCWE) ToResponseByCWE() ByCWE {
+	return ByCWE{
+		CWE:          v.CWE,
+		BySeverities: v.ToResponseBySeverities(),
+	}
+}
+
+func (v *VulnerabilitiesByCWE) ToResponseByOWASP() ByOWASP {
+	return ByOWASP{
+		OWASPCategory: v.OWASPCategory,
+		BySeverities:  v.ToResponseBySeverities(),
+	}
+}
@@ -0,0 +1,21 @@
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToResponseByCWE(t *testing.T) {
+	t.Run("should success parse", func(t *testing.T) {
+		assert.Equal(t, "CWE-89", (&VulnerabilitiesByCWE{CWE: "CWE-89"}).ToResponseByCWE().CWE)
+	})
+}
+
+func TestToResponseByOWASP(t *testing.T) {
+	t.Run("should success parse", func(t *testing.T) {
+		vulns := &VulnerabilitiesByCWE{OWASPCategory: "A03:2021-Injection"}
+
+		assert.Equal(t, "A03:2021-Injection", vulns.ToResponseByOWASP().OWASPCategory)
+	})
+}
@@ -0,0 +1,17 @@
+package dashboard
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
+)
+
+type VulnerabilitiesBySecurityTool struct {
+	SecurityTool tools.Tool `json:"security_tool" gorm:"Column:security_tool"`
+	Vulnerability
+}
+
+func (v *VulnerabilitiesBySecurityTool) ToResponseBySecurityTool() BySecurityTool {
+	return BySecurityTool{
+		SecurityTool: v.SecurityTool,
+		BySeverities: v.ToResponseBySeverities(),
+	}
+}
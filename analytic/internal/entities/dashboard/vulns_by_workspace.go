@@ -0,0 +1,14 @@
+package dashboard
+
+type VulnerabilitiesByWorkspace struct {
+	WorkspaceName string `json:"workspace_name" gorm:"Column:workspace_name"`
+	Vulnerability
+}
+
+func (v *VulnerabilitiesByWorkspace) ToResponseByWorkspace() ByWorkspace {
+	return ByWorkspace{
+		WorkspaceID:   v.WorkspaceID,
+		WorkspaceName: v.WorkspaceName,
+		BySeverities:  v.ToResponseBySeverities(),
+	}
+}
@@ -0,0 +1,13 @@
+package dashboard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToResponseByWorkspace(t *testing.T) {
+	t.Run("should success parse", func(t *testing.T) {
+		assert.NotNil(t, (&VulnerabilitiesByWorkspace{}).ToResponseByWorkspace())
+	})
+}
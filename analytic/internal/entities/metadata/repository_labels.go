@@ -0,0 +1,21 @@
+package metadata
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	metadataEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/metadata"
+)
+
+type RepositoryLabels struct {
+	RepositoryID uuid.UUID      `json:"repositoryID" gorm:"Column:repository_id;primary_key"`
+	WorkspaceID  uuid.UUID      `json:"workspaceID" gorm:"Column:workspace_id"`
+	Labels       pq.StringArray `json:"labels" gorm:"Column:labels;type:text[]"`
+	UpdatedAt    time.Time      `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+func (r *RepositoryLabels) ToUpdateFilter() map[string]interface{} {
+	return map[string]interface{}{metadataEnums.ColumnRepositoryID: r.RepositoryID}
+}
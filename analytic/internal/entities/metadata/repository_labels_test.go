@@ -0,0 +1,18 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	metadataEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/metadata"
+)
+
+func TestToUpdateFilter(t *testing.T) {
+	t.Run("should return filter by repository id", func(t *testing.T) {
+		labels := &RepositoryLabels{RepositoryID: uuid.New()}
+
+		assert.Equal(t, labels.RepositoryID, labels.ToUpdateFilter()[metadataEnums.ColumnRepositoryID])
+	})
+}
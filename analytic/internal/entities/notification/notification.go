@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+)
+
+type Notification struct {
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	AnalysisID   uuid.UUID `json:"analysisID"`
+	Chart        string    `json:"chart"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func NewNotificationFromAnalysis(analysis *analysisEntities.Analysis, chart string) *Notification {
+	return &Notification{
+		WorkspaceID:  analysis.WorkspaceID,
+		RepositoryID: analysis.RepositoryID,
+		AnalysisID:   analysis.ID,
+		Chart:        chart,
+		CreatedAt:    time.Now(),
+	}
+}
+
+func (n *Notification) ToBytes() []byte {
+	bytes, _ := json.Marshal(n)
+
+	return bytes
+}
@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+)
+
+func TestNewNotificationFromAnalysis(t *testing.T) {
+	t.Run("should success create notification from analysis", func(t *testing.T) {
+		analysis := &analysisEntities.Analysis{ID: uuid.New(), WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		notification := NewNotificationFromAnalysis(analysis, dashboardEnums.ChartVulnerabilitiesByAuthor)
+
+		assert.Equal(t, analysis.ID, notification.AnalysisID)
+		assert.Equal(t, analysis.WorkspaceID, notification.WorkspaceID)
+		assert.Equal(t, analysis.RepositoryID, notification.RepositoryID)
+		assert.Equal(t, dashboardEnums.ChartVulnerabilitiesByAuthor, notification.Chart)
+	})
+}
+
+func TestToBytesNotification(t *testing.T) {
+	t.Run("should success parse notification to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, (&Notification{}).ToBytes())
+	})
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"github.com/google/uuid"
+
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
+)
+
+type Subscription struct {
+	SubscriptionID uuid.UUID
+	WorkspaceID    uuid.UUID
+	RepositoryID   uuid.UUID
+	Notifications  chan *Notification
+}
+
+func NewSubscription(workspaceID, repositoryID uuid.UUID) *Subscription {
+	return &Subscription{
+		SubscriptionID: uuid.New(),
+		WorkspaceID:    workspaceID,
+		RepositoryID:   repositoryID,
+		Notifications:  make(chan *Notification, notificationEnums.SubscriptionBufferSize),
+	}
+}
+
+// IsSubscribedTo returns true when the notification belongs to the subscription workspace and, when the
+// subscription was made for a single repository, to that repository
+func (s *Subscription) IsSubscribedTo(notification *Notification) bool {
+	if s.WorkspaceID != notification.WorkspaceID {
+		return false
+	}
+
+	return s.RepositoryID == uuid.Nil || s.RepositoryID == notification.RepositoryID
+}
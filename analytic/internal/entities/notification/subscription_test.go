@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSubscribedTo(t *testing.T) {
+	workspaceID := uuid.New()
+	repositoryID := uuid.New()
+
+	t.Run("should return true when workspace subscription and same workspace", func(t *testing.T) {
+		subscription := NewSubscription(workspaceID, uuid.Nil)
+
+		assert.True(t, subscription.IsSubscribedTo(&Notification{WorkspaceID: workspaceID, RepositoryID: uuid.New()}))
+	})
+
+	t.Run("should return true when repository subscription and same repository", func(t *testing.T) {
+		subscription := NewSubscription(workspaceID, repositoryID)
+
+		assert.True(t, subscription.IsSubscribedTo(&Notification{WorkspaceID: workspaceID, RepositoryID: repositoryID}))
+	})
+
+	t.Run("should return false when repository subscription and other repository", func(t *testing.T) {
+		subscription := NewSubscription(workspaceID, repositoryID)
+
+		assert.False(t, subscription.IsSubscribedTo(&Notification{WorkspaceID: workspaceID, RepositoryID: uuid.New()}))
+	})
+
+	t.Run("should return false when other workspace", func(t *testing.T) {
+		subscription := NewSubscription(workspaceID, uuid.Nil)
+
+		assert.False(t, subscription.IsSubscribedTo(&Notification{WorkspaceID: uuid.New()}))
+	})
+}
@@ -0,0 +1,78 @@
+package risk
+
+import (
+	"math"
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+type Model struct {
+	SeverityWeights        map[severities.Severity]int
+	CriticalityPercentages map[riskEnums.Criticality]int
+	AgePercentage          int
+	MaxAgeInDays           int
+}
+
+func NewModel() *Model {
+	return &Model{
+		SeverityWeights:        getSeverityWeights(),
+		CriticalityPercentages: getCriticalityPercentages(),
+		AgePercentage:          env.GetEnvOrDefaultInt(riskEnums.EnvAgePercentage, riskEnums.DefaultAgePercentage),
+		MaxAgeInDays:           env.GetEnvOrDefaultInt(riskEnums.EnvMaxAgeInDays, riskEnums.DefaultMaxAgeInDays),
+	}
+}
+
+func getSeverityWeights() map[severities.Severity]int {
+	return map[severities.Severity]int{
+		severities.Critical: env.GetEnvOrDefaultInt(riskEnums.EnvWeightCritical, riskEnums.DefaultWeightCritical),
+		severities.High:     env.GetEnvOrDefaultInt(riskEnums.EnvWeightHigh, riskEnums.DefaultWeightHigh),
+		severities.Medium:   env.GetEnvOrDefaultInt(riskEnums.EnvWeightMedium, riskEnums.DefaultWeightMedium),
+		severities.Low:      env.GetEnvOrDefaultInt(riskEnums.EnvWeightLow, riskEnums.DefaultWeightLow),
+		severities.Info:     env.GetEnvOrDefaultInt(riskEnums.EnvWeightInfo, riskEnums.DefaultWeightInfo),
+		severities.Unknown:  env.GetEnvOrDefaultInt(riskEnums.EnvWeightUnknown, riskEnums.DefaultWeightUnknown),
+	}
+}
+
+func getCriticalityPercentages() map[riskEnums.Criticality]int {
+	return map[riskEnums.Criticality]int{
+		riskEnums.CriticalityLow: env.GetEnvOrDefaultInt(riskEnums.EnvCriticalityLowPercentage,
+			riskEnums.DefaultCriticalityLowPercentage),
+		riskEnums.CriticalityMedium: env.GetEnvOrDefaultInt(riskEnums.EnvCriticalityMediumPercentage,
+			riskEnums.DefaultCriticalityMediumPercentage),
+		riskEnums.CriticalityHigh: env.GetEnvOrDefaultInt(riskEnums.EnvCriticalityHighPercentage,
+			riskEnums.DefaultCriticalityHighPercentage),
+		riskEnums.CriticalityCritical: env.GetEnvOrDefaultInt(riskEnums.EnvCriticalityCriticalPercentage,
+			riskEnums.DefaultCriticalityCriticalPercentage),
+	}
+}
+
+// GetVulnerabilityScore returns the severity weight increased by the age percentage, proportionally to how
+// long the vulnerability has been open until the max age in days is reached
+func (m *Model) GetVulnerabilityScore(severity severities.Severity, age time.Duration) float64 {
+	weight := float64(m.SeverityWeights[severity])
+
+	return weight + weight*m.getAgeFactor(age)
+}
+
+func (m *Model) getAgeFactor(age time.Duration) float64 {
+	if m.MaxAgeInDays <= 0 || age <= 0 {
+		return 0
+	}
+
+	days := math.Min(age.Hours()/riskEnums.HoursInDay, float64(m.MaxAgeInDays))
+
+	return days / float64(m.MaxAgeInDays) * float64(m.AgePercentage) / riskEnums.PercentageBase
+}
+
+func (m *Model) ApplyCriticality(score float64, criticality riskEnums.Criticality) float64 {
+	percentage, ok := m.CriticalityPercentages[criticality]
+	if !ok {
+		percentage = m.CriticalityPercentages[riskEnums.CriticalityMedium]
+	}
+
+	return score * float64(percentage) / riskEnums.PercentageBase
+}
@@ -0,0 +1,75 @@
+package risk
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+func TestNewModel(t *testing.T) {
+	t.Run("should return model with default values", func(t *testing.T) {
+		model := NewModel()
+
+		assert.Equal(t, riskEnums.DefaultWeightCritical, model.SeverityWeights[severities.Critical])
+		assert.Equal(t, riskEnums.DefaultCriticalityHighPercentage,
+			model.CriticalityPercentages[riskEnums.CriticalityHigh])
+		assert.Equal(t, riskEnums.DefaultAgePercentage, model.AgePercentage)
+		assert.Equal(t, riskEnums.DefaultMaxAgeInDays, model.MaxAgeInDays)
+	})
+
+	t.Run("should return model with values from environment", func(t *testing.T) {
+		_ = os.Setenv(riskEnums.EnvWeightCritical, "20")
+		defer func() { _ = os.Unsetenv(riskEnums.EnvWeightCritical) }()
+
+		assert.Equal(t, 20, NewModel().SeverityWeights[severities.Critical])
+	})
+}
+
+func TestGetVulnerabilityScore(t *testing.T) {
+	t.Run("should return severity weight when vulnerability is new", func(t *testing.T) {
+		model := NewModel()
+
+		assert.Equal(t, float64(riskEnums.DefaultWeightHigh), model.GetVulnerabilityScore(severities.High, 0))
+	})
+
+	t.Run("should increase weight proportionally to the vulnerability age", func(t *testing.T) {
+		model := NewModel()
+		age := time.Duration(riskEnums.DefaultMaxAgeInDays/2*riskEnums.HoursInDay) * time.Hour
+
+		assert.Equal(t, float64(15), model.GetVulnerabilityScore(severities.Critical, age))
+	})
+
+	t.Run("should not increase weight more than max age", func(t *testing.T) {
+		model := NewModel()
+		age := time.Duration(riskEnums.DefaultMaxAgeInDays*2*riskEnums.HoursInDay) * time.Hour
+
+		assert.Equal(t, float64(20), model.GetVulnerabilityScore(severities.Critical, age))
+	})
+
+	t.Run("should ignore age when max age is disabled", func(t *testing.T) {
+		model := &Model{SeverityWeights: map[severities.Severity]int{severities.Critical: 10}}
+
+		assert.Equal(t, float64(10), model.GetVulnerabilityScore(severities.Critical, time.Hour))
+	})
+}
+
+func TestApplyCriticality(t *testing.T) {
+	t.Run("should apply criticality percentage", func(t *testing.T) {
+		model := NewModel()
+
+		assert.Equal(t, float64(5), model.ApplyCriticality(10, riskEnums.CriticalityLow))
+		assert.Equal(t, float64(20), model.ApplyCriticality(10, riskEnums.CriticalityCritical))
+	})
+
+	t.Run("should use medium percentage when unknown criticality", func(t *testing.T) {
+		model := NewModel()
+
+		assert.Equal(t, float64(10), model.ApplyCriticality(10, "test"))
+	})
+}
@@ -0,0 +1,28 @@
+package risk
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+type RepositoryCriticality struct {
+	RepositoryID uuid.UUID             `json:"repositoryID" gorm:"Column:repository_id;primary_key"`
+	WorkspaceID  uuid.UUID             `json:"workspaceID" gorm:"Column:workspace_id"`
+	Criticality  riskEnums.Criticality `json:"criticality" gorm:"Column:criticality"`
+	UpdatedAt    time.Time             `json:"updatedAt" gorm:"Column:updated_at"`
+}
+
+func (r *RepositoryCriticality) GetCriticality() riskEnums.Criticality {
+	if r.Criticality == "" {
+		return riskEnums.CriticalityMedium
+	}
+
+	return r.Criticality
+}
+
+func (r *RepositoryCriticality) ToUpdateFilter() map[string]interface{} {
+	return map[string]interface{}{riskEnums.ColumnRepositoryID: r.RepositoryID}
+}
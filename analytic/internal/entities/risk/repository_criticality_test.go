@@ -0,0 +1,32 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+func TestGetCriticality(t *testing.T) {
+	t.Run("should return medium when criticality is empty", func(t *testing.T) {
+		criticality := &RepositoryCriticality{}
+
+		assert.Equal(t, riskEnums.CriticalityMedium, criticality.GetCriticality())
+	})
+
+	t.Run("should return repository criticality", func(t *testing.T) {
+		criticality := &RepositoryCriticality{Criticality: riskEnums.CriticalityLow}
+
+		assert.Equal(t, riskEnums.CriticalityLow, criticality.GetCriticality())
+	})
+}
+
+func TestToUpdateFilter(t *testing.T) {
+	t.Run("should return filter by repository id", func(t *testing.T) {
+		criticality := &RepositoryCriticality{RepositoryID: uuid.New()}
+
+		assert.Equal(t, criticality.RepositoryID, criticality.ToUpdateFilter()[riskEnums.ColumnRepositoryID])
+	})
+}
@@ -0,0 +1,33 @@
+package risk
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+type RiskScore struct {
+	RiskScoreID           uuid.UUID             `json:"riskScoreID" gorm:"Column:risk_score_id"`
+	CreatedAt             time.Time             `json:"createdAt" gorm:"Column:created_at"`
+	WorkspaceID           uuid.UUID             `json:"workspaceID" gorm:"Column:workspace_id"`
+	RepositoryID          uuid.UUID             `json:"repositoryID" gorm:"Column:repository_id"`
+	RepositoryName        string                `json:"repositoryName" gorm:"Column:repository_name"`
+	Criticality           riskEnums.Criticality `json:"criticality" gorm:"Column:criticality"`
+	Score                 float64               `json:"score" gorm:"Column:score"`
+	ActiveVulnerabilities int                   `json:"activeVulnerabilities" gorm:"Column:active_vulnerabilities"`
+}
+
+func (r *RiskScore) AddVulnerabilityScore(score float64) {
+	r.Score += score
+	r.ActiveVulnerabilities++
+}
+
+func (r *RiskScore) SetScoreByModel(model *Model) *RiskScore {
+	r.Score = math.Round(model.ApplyCriticality(r.Score, r.Criticality)*riskEnums.PercentageBase) /
+		riskEnums.PercentageBase
+
+	return r
+}
@@ -0,0 +1,29 @@
+package risk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+func TestAddVulnerabilityScore(t *testing.T) {
+	t.Run("should sum score and count active vulnerabilities", func(t *testing.T) {
+		riskScore := &RiskScore{}
+
+		riskScore.AddVulnerabilityScore(10)
+		riskScore.AddVulnerabilityScore(5)
+
+		assert.Equal(t, float64(15), riskScore.Score)
+		assert.Equal(t, 2, riskScore.ActiveVulnerabilities)
+	})
+}
+
+func TestSetScoreByModel(t *testing.T) {
+	t.Run("should apply criticality and round score", func(t *testing.T) {
+		riskScore := &RiskScore{Score: 3.333, Criticality: riskEnums.CriticalityHigh}
+
+		assert.Equal(t, 5.0, riskScore.SetScoreByModel(NewModel()).Score)
+	})
+}
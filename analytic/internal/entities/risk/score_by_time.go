@@ -0,0 +1,8 @@
+package risk
+
+import "time"
+
+type ScoreByTime struct {
+	Time  time.Time `json:"time" gorm:"Column:created_at"`
+	Score float64   `json:"score" gorm:"Column:score"`
+}
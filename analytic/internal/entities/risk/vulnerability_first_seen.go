@@ -0,0 +1,13 @@
+package risk
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VulnerabilityFirstSeen struct {
+	RepositoryID uuid.UUID `json:"repositoryID" gorm:"Column:repository_id;primary_key"`
+	VulnHash     string    `json:"vulnHash" gorm:"Column:vuln_hash;primary_key"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"Column:created_at"`
+}
@@ -0,0 +1,35 @@
+package transfer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	transferEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/transfer"
+)
+
+// Event is received from the core repository transfer exchange when a repository moves to another workspace
+type Event struct {
+	RepositoryID      uuid.UUID `json:"repositoryID"`
+	SourceWorkspaceID uuid.UUID `json:"sourceWorkspaceID"`
+	TargetWorkspaceID uuid.UUID `json:"targetWorkspaceID"`
+	Name              string    `json:"name"`
+	TransferredAt     time.Time `json:"transferredAt"`
+}
+
+func (e *Event) ToRepositoryFilter() map[string]interface{} {
+	return map[string]interface{}{transferEnums.ColumnRepositoryID: e.RepositoryID}
+}
+
+func (e *Event) ToWorkspaceUpdateMap() map[string]interface{} {
+	return map[string]interface{}{transferEnums.ColumnWorkspaceID: e.TargetWorkspaceID}
+}
+
+// ToRepositoryUpdateMap is used on the tables that also keep a copy of the repository name, which may be changed
+// on transfer to avoid a name conflict in the target workspace
+func (e *Event) ToRepositoryUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		transferEnums.ColumnWorkspaceID:    e.TargetWorkspaceID,
+		transferEnums.ColumnRepositoryName: e.Name,
+	}
+}
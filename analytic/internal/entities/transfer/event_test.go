@@ -0,0 +1,34 @@
+package transfer
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRepositoryFilter(t *testing.T) {
+	t.Run("should success create a filter by repository id", func(t *testing.T) {
+		event := &Event{RepositoryID: uuid.New()}
+
+		assert.Equal(t, event.RepositoryID, event.ToRepositoryFilter()["repository_id"])
+	})
+}
+
+func TestToWorkspaceUpdateMap(t *testing.T) {
+	t.Run("should success create a map with the target workspace", func(t *testing.T) {
+		event := &Event{TargetWorkspaceID: uuid.New()}
+
+		assert.Equal(t, event.TargetWorkspaceID, event.ToWorkspaceUpdateMap()["workspace_id"])
+	})
+}
+
+func TestToRepositoryUpdateMap(t *testing.T) {
+	t.Run("should success create a map with the target workspace and repository name", func(t *testing.T) {
+		event := &Event{TargetWorkspaceID: uuid.New(), Name: "test"}
+
+		result := event.ToRepositoryUpdateMap()
+		assert.Equal(t, event.TargetWorkspaceID, result["workspace_id"])
+		assert.Equal(t, "test", result["repository_name"])
+	})
+}
@@ -0,0 +1,5 @@
+package archive
+
+import "errors"
+
+var ErrorUnknownAction = errors.New("{ARCHIVE} unknown archive event action")
@@ -0,0 +1,6 @@
+package archive
+
+const (
+	MessageFailedToRollbackPurge = "{ARCHIVE} failed to rollback purge transaction"
+	MessageFailedToCommitPurge   = "{ARCHIVE} failed to commit purge transaction"
+)
@@ -0,0 +1,17 @@
+package archive
+
+const (
+	ExchangeArchive           = "horusec-archive"
+	TableArchivedWorkspaces   = "archived_workspaces"
+	TableArchivedRepositories = "archived_repositories"
+	ColumnWorkspaceID         = "workspace_id"
+	ColumnRepositoryID        = "repository_id"
+)
+
+type Action string
+
+const (
+	ActionArchived Action = "ARCHIVED"
+	ActionRestored Action = "RESTORED"
+	ActionPurged   Action = "PURGED"
+)
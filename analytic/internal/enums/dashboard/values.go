@@ -1,15 +1,42 @@
 package dashboard
 
+import "regexp"
+
 const (
-	DefaultPaginationSize            = 10
-	TableVulnerabilitiesByAuthor     = "vulnerabilities_by_author"
-	TableVulnerabilitiesByLanguage   = "vulnerabilities_by_language"
-	TableVulnerabilitiesByRepository = "vulnerabilities_by_repository"
-	TableVulnerabilitiesByTime       = "vulnerabilities_by_time"
-	WorkspaceID                      = "workspaceID"
-	RepositoryID                     = "repositoryID"
-	SizeHeader                       = "size"
-	PageHeader                       = "page"
-	InitialDateHeader                = "initialDate"
-	FinalDateHeader                  = "finalDate"
+	DefaultPaginationSize              = 10
+	TableVulnerabilitiesByAuthor       = "vulnerabilities_by_author"
+	TableVulnerabilitiesByLanguage     = "vulnerabilities_by_language"
+	TableVulnerabilitiesByRepository   = "vulnerabilities_by_repository"
+	TableVulnerabilitiesByTime         = "vulnerabilities_by_time"
+	TableVulnerabilitiesByWorkspace    = "vulnerabilities_by_workspace"
+	TableVulnerabilitiesBySecurityTool = "vulnerabilities_by_security_tool"
+	TableVulnerabilitiesByCWE          = "vulnerabilities_by_cwe"
+	ColumnSecurityTool                 = "security_tool"
+	ColumnCWE                          = "cwe"
+	ColumnOWASPCategory                = "owasp_category"
+	UnclassifiedCWE                    = "UNCLASSIFIED"
+	PrefixCWE                          = "CWE-"
+	RegexCWE                           = `(?i)\bcwe[\s:/_-]*(\d+)`
+	RegexCWEMatchLength                = 2
+	WorkspaceID                        = "workspaceID"
+	RepositoryID                       = "repositoryID"
+	SizeHeader                         = "size"
+	PageHeader                         = "page"
+	InitialDateHeader                  = "initialDate"
+	FinalDateHeader                    = "finalDate"
+	ChartHeader                        = "chart"
+	LabelQuery                         = "label"
+	ChartTotals                        = "totals"
+	ChartVulnerabilityBySeverity       = "vulnerabilityBySeverity"
+	ChartVulnerabilitiesByAuthor       = "vulnerabilitiesByAuthor"
+	ChartVulnerabilitiesByRepository   = "vulnerabilitiesByRepository"
+	ChartVulnerabilitiesByLanguage     = "vulnerabilitiesByLanguage"
+	ChartVulnerabilityByTime           = "vulnerabilityByTime"
+	ChartVulnerabilitiesByWorkspace    = "vulnerabilitiesByWorkspace"
+	ChartVulnerabilitiesBySecurityTool = "vulnerabilitiesBySecurityTool"
+	ChartVulnerabilitiesByCWE          = "vulnerabilitiesByCWE"
+	ChartVulnerabilitiesByOWASP        = "vulnerabilitiesByOWASP"
 )
+
+// LabelRegex accepts labels in the key=value format, the same format used to label the repositories in core
+var LabelRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.\-/]{0,62}=[^=\s]{1,255}$`)
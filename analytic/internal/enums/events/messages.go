@@ -4,4 +4,11 @@ const (
 	MessageNewAnalysisReceivedAnalytic = "{ANALYTIC EVENTS} received a new analysis packet"
 	MessageFailedToParsePacket         = "{ANALYTIC EVENTS} failed to parse packet -> %v in queue -> %s"
 	MessageFailedToProcessPacket       = "{ANALYTIC EVENTS} failed to process packet -> %v in queue -> %s"
+	MessageCriticalityReceivedAnalytic = "{ANALYTIC EVENTS} received a new repository criticality packet"
+	MessageArchiveReceivedAnalytic     = "{ANALYTIC EVENTS} received a new archive packet"
+	MessageMetadataReceivedAnalytic    = "{ANALYTIC EVENTS} received a new repository metadata packet"
+	MessageTransferReceivedAnalytic    = "{ANALYTIC EVENTS} received a new repository transfer packet"
+	MessageFailedToPublishNotification = "{ANALYTIC EVENTS} failed to publish dashboard changed notification -> %v"
+	MessageFailedToConsumeNotification = "{ANALYTIC EVENTS} failed to consume dashboard changed notifications"
+	MessageFailedToParseNotification   = "{ANALYTIC EVENTS} failed to parse dashboard changed notification -> %s"
 )
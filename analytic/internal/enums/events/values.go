@@ -0,0 +1,14 @@
+package events
+
+import "github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+
+const (
+	QueueAnalyticNewAnalysisByWorkspace    queues.Queue = "horusec-analytic::new-analysis-by-workspace"
+	QueueAnalyticNewAnalysisBySecurityTool queues.Queue = "horusec-analytic::new-analysis-by-security-tool"
+	QueueAnalyticNewAnalysisByCWE          queues.Queue = "horusec-analytic::new-analysis-by-cwe"
+	QueueAnalyticNewAnalysisRiskScore      queues.Queue = "horusec-analytic::new-analysis-risk-score"
+	QueueAnalyticRepositoryCriticality     queues.Queue = "horusec-analytic::repository-criticality"
+	QueueAnalyticArchive                   queues.Queue = "horusec-analytic::archive"
+	QueueAnalyticRepositoryMetadata        queues.Queue = "horusec-analytic::repository-metadata"
+	QueueAnalyticRepositoryTransfer        queues.Queue = "horusec-analytic::repository-transfer"
+)
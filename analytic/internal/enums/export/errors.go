@@ -0,0 +1,6 @@
+package export
+
+import "errors"
+
+var ErrorInvalidExportFormat = errors.New("{EXPORT} invalid export format, accepted values are csv, xlsx and pdf")
+var ErrorInvalidChart = errors.New("{EXPORT} invalid or missing chart name for csv export")
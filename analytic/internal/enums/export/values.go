@@ -0,0 +1,67 @@
+package export
+
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+	PDF  Format = "pdf"
+)
+
+const (
+	FormatParam         = "format"
+	ContentTypeCSV      = "text/csv"
+	ContentTypeXLSX     = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	ContentTypePDF      = "application/pdf"
+	ContentDisposition  = "attachment; filename=\"horusec-dashboard-%s.%s\""
+	DefaultXLSXSheet    = "Sheet1"
+	ReportTitle         = "Horusec Dashboard Report"
+	PDFOrientation      = "L"
+	PDFUnit             = "mm"
+	PDFPageSize         = "A4"
+	PDFFontFamily       = "Helvetica"
+	PDFPageWidth        = 277
+	PDFTitleLineHeight  = 10
+	PDFTableLineHeight  = 7
+	PDFTitleFontSize    = 16
+	PDFSubTitleFontSize = 12
+	PDFTableFontSize    = 9
+	PDFHeaderFillColor  = 230
+	FormulaPrefixes     = "=+-@\t\r"
+	FormulaEscape       = "'"
+)
+
+func (f Format) ToString() string {
+	return string(f)
+}
+
+func (f Format) IsInvalid() bool {
+	for _, value := range Values() {
+		if value == f {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f Format) ContentType() string {
+	switch f {
+	case CSV:
+		return ContentTypeCSV
+	case XLSX:
+		return ContentTypeXLSX
+	case PDF:
+		return ContentTypePDF
+	}
+
+	return ""
+}
+
+func Values() []Format {
+	return []Format{
+		CSV,
+		XLSX,
+		PDF,
+	}
+}
@@ -0,0 +1,7 @@
+package metadata
+
+const (
+	ExchangeRepositoryMetadata = "horusec-repository-metadata"
+	TableRepositoryLabels      = "repository_labels"
+	ColumnRepositoryID         = "repository_id"
+)
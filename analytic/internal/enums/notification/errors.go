@@ -0,0 +1,7 @@
+package notification
+
+import "errors"
+
+var ErrorStreamingUnsupported = errors.New("{NOTIFICATION} streaming unsupported by the response writer")
+
+var ErrorNotificationsChannelClosed = errors.New("{NOTIFICATION} the broker channel of the notifications was closed")
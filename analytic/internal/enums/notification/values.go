@@ -0,0 +1,18 @@
+package notification
+
+import "time"
+
+const (
+	SubscriptionBufferSize   = 100
+	EventDashboardChanged    = "dashboard-changed"
+	RetryMilliseconds        = 1000
+	KeepAliveInterval        = 5 * time.Second
+	HeaderContentType        = "Content-Type"
+	HeaderCacheControl       = "Cache-Control"
+	HeaderConnection         = "Connection"
+	ContentTypeEventStream   = "text/event-stream"
+	CacheControlNoCache      = "no-cache"
+	ConnectionKeepAlive      = "keep-alive"
+	ExchangeDashboardChanged = "horusec-analytic-dashboard-changed"
+	ReconnectInterval        = 5 * time.Second
+)
@@ -0,0 +1,47 @@
+package risk
+
+const (
+	TableRiskScoreByRepository           = "risk_score_by_repository"
+	TableRepositoryCriticality           = "repository_criticality"
+	TableVulnerabilitiesFirstSeen        = "vulnerabilities_first_seen"
+	ColumnRepositoryID                   = "repository_id"
+	PercentageBase                       = 100
+	HoursInDay                           = 24
+	EnvWeightCritical                    = "HORUSEC_RISK_SCORE_WEIGHT_CRITICAL"
+	EnvWeightHigh                        = "HORUSEC_RISK_SCORE_WEIGHT_HIGH"
+	EnvWeightMedium                      = "HORUSEC_RISK_SCORE_WEIGHT_MEDIUM"
+	EnvWeightLow                         = "HORUSEC_RISK_SCORE_WEIGHT_LOW"
+	EnvWeightInfo                        = "HORUSEC_RISK_SCORE_WEIGHT_INFO"
+	EnvWeightUnknown                     = "HORUSEC_RISK_SCORE_WEIGHT_UNKNOWN"
+	EnvCriticalityLowPercentage          = "HORUSEC_RISK_SCORE_CRITICALITY_LOW_PERCENTAGE"
+	EnvCriticalityMediumPercentage       = "HORUSEC_RISK_SCORE_CRITICALITY_MEDIUM_PERCENTAGE"
+	EnvCriticalityHighPercentage         = "HORUSEC_RISK_SCORE_CRITICALITY_HIGH_PERCENTAGE"
+	EnvCriticalityCriticalPercentage     = "HORUSEC_RISK_SCORE_CRITICALITY_CRITICAL_PERCENTAGE"
+	EnvAgePercentage                     = "HORUSEC_RISK_SCORE_AGE_PERCENTAGE"
+	EnvMaxAgeInDays                      = "HORUSEC_RISK_SCORE_MAX_AGE_IN_DAYS"
+	DefaultWeightCritical                = 10
+	DefaultWeightHigh                    = 5
+	DefaultWeightMedium                  = 3
+	DefaultWeightLow                     = 1
+	DefaultWeightInfo                    = 0
+	DefaultWeightUnknown                 = 0
+	DefaultCriticalityLowPercentage      = 50
+	DefaultCriticalityMediumPercentage   = 100
+	DefaultCriticalityHighPercentage     = 150
+	DefaultCriticalityCriticalPercentage = 200
+	DefaultAgePercentage                 = 100
+	DefaultMaxAgeInDays                  = 90
+)
+
+type Criticality string
+
+const (
+	CriticalityLow      Criticality = "LOW"
+	CriticalityMedium   Criticality = "MEDIUM"
+	CriticalityHigh     Criticality = "HIGH"
+	CriticalityCritical Criticality = "CRITICAL"
+)
+
+func (c Criticality) ToString() string {
+	return string(c)
+}
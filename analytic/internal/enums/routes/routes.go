@@ -1,6 +1,8 @@
 package routes
 
 const (
-	HealthRouter             = "/analytic/health"
-	DashboardWorkspaceRouter = "/analytic/dashboard/{workspaceID}"
+	HealthRouter              = "/analytic/health"
+	DashboardWorkspaceRouter  = "/analytic/dashboard/{workspaceID}"
+	DashboardApplicationAdmin = "/analytic/application-admin/dashboard"
+	RiskScoreWorkspaceRouter  = "/analytic/risk-score/{workspaceID}"
 )
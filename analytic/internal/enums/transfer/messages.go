@@ -0,0 +1,6 @@
+package transfer
+
+const (
+	MessageFailedToRollbackTransfer = "{TRANSFER} failed to rollback repository transfer transaction"
+	MessageFailedToCommitTransfer   = "{TRANSFER} failed to commit repository transfer transaction"
+)
@@ -0,0 +1,8 @@
+package transfer
+
+const (
+	ExchangeRepositoryTransfer = "horusec-repository-transfer"
+	ColumnRepositoryID         = "repository_id"
+	ColumnWorkspaceID          = "workspace_id"
+	ColumnRepositoryName       = "repository_name"
+)
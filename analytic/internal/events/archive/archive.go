@@ -0,0 +1,54 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/archive"
+	archiveEntities "github.com/ZupIT/horusec-platform/analytic/internal/entities/archive"
+	archiveEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/archive"
+	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+)
+
+type Events struct {
+	broker     brokerLib.IBroker
+	controller archive.IController
+}
+
+func NewArchiveEvents(broker brokerLib.IBroker, controller archive.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(eventsEnums.QueueAnalyticArchive.ToString(), archiveEnums.ExchangeArchive,
+		exchange.Fanout, e.handleArchive)
+
+	return e
+}
+
+func (e *Events) handleArchive(archivePacket packet.IPacket) {
+	logger.LogInfo(eventsEnums.MessageArchiveReceivedAnalytic)
+	event := &archiveEntities.Event{}
+
+	if err := parser.ParsePacketToEntity(archivePacket, event); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, archivePacket.GetBody(),
+			eventsEnums.QueueAnalyticArchive), err)
+		_ = archivePacket.Ack()
+		return
+	}
+
+	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, archivePacket.GetBody(),
+		eventsEnums.QueueAnalyticArchive), e.controller.HandleArchiveEvent(event))
+
+	_ = archivePacket.Ack()
+}
@@ -0,0 +1,70 @@
+package archive
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+
+	archiveController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/archive"
+)
+
+func TestNewArchiveEvents(t *testing.T) {
+	t.Run("should start consumers and consume without errors", func(t *testing.T) {
+		controllerMock := &archiveController.Mock{}
+		brokerMock := &broker.Mock{}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"action": "ARCHIVED"}`))
+
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		controllerMock.On("HandleArchiveEvent").Return(nil)
+
+		assert.NotPanics(t, func() {
+			NewArchiveEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+		})
+	})
+}
+
+func TestHandleArchive(t *testing.T) {
+	t.Run("should not process when failed parse packet", func(t *testing.T) {
+		controllerMock := &archiveController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+
+		assert.NotPanics(t, func() {
+			events.handleArchive(packet)
+		})
+
+		controllerMock.AssertNotCalled(t, "HandleArchiveEvent")
+	})
+
+	t.Run("should log error when failed to process packet", func(t *testing.T) {
+		controllerMock := &archiveController.Mock{}
+		controllerMock.On("HandleArchiveEvent").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"action": "PURGED"}`))
+
+		assert.NotPanics(t, func() {
+			events.handleArchive(packet)
+		})
+
+		controllerMock.AssertCalled(t, "HandleArchiveEvent")
+	})
+}
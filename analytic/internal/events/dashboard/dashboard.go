@@ -12,7 +12,10 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
 	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
 )
 
 type Events struct {
@@ -30,21 +33,18 @@ func NewDashboardEvents(broker brokerLib.IBroker, controller dashboard.IControll
 }
 
 func (e *Events) startConsumers() *Events {
-	go e.broker.Consume(queues.HorusecAnalyticNewAnalysisByAuthors.ToString(), exchange.NewAnalysis, exchange.Fanout,
-		func(pack packet.IPacket) { e.handleNewAnalysis(pack, queues.HorusecAnalyticNewAnalysisByAuthors) })
-
-	go e.broker.Consume(queues.HorusecAnalyticNewAnalysisByRepository.ToString(), exchange.NewAnalysis, exchange.Fanout,
-		func(pack packet.IPacket) { e.handleNewAnalysis(pack, queues.HorusecAnalyticNewAnalysisByRepository) })
-
-	go e.broker.Consume(queues.HorusecAnalyticNewAnalysisByLanguage.ToString(), exchange.NewAnalysis, exchange.Fanout,
-		func(pack packet.IPacket) { e.handleNewAnalysis(pack, queues.HorusecAnalyticNewAnalysisByLanguage) })
-
-	go e.broker.Consume(queues.HorusecAnalyticNewAnalysisByTime.ToString(), exchange.NewAnalysis, exchange.Fanout,
-		func(pack packet.IPacket) { e.handleNewAnalysis(pack, queues.HorusecAnalyticNewAnalysisByTime) })
+	for queue := range e.getProcessByQueue() {
+		e.startConsumer(queue)
+	}
 
 	return e
 }
 
+func (e *Events) startConsumer(queue queues.Queue) {
+	go e.broker.Consume(queue.ToString(), exchange.NewAnalysis, exchange.Fanout,
+		func(pack packet.IPacket) { e.handleNewAnalysis(pack, queue) })
+}
+
 func (e *Events) handleNewAnalysis(analysisPacket packet.IPacket, queue queues.Queue) {
 	logger.LogInfo(eventsEnums.MessageNewAnalysisReceivedAnalytic)
 	analysis := &analysisEntities.Analysis{}
@@ -55,24 +55,52 @@ func (e *Events) handleNewAnalysis(analysisPacket packet.IPacket, queue queues.Q
 		return
 	}
 
-	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket,
-		analysisPacket.GetBody(), queue), e.processNewAnalysisPacketByQueue(queue)(analysis))
-
+	e.processNewAnalysis(analysis, queue)
 	_ = analysisPacket.Ack()
 }
 
-//nolint:exhaustive // no need of all constants
+func (e *Events) processNewAnalysis(analysis *analysisEntities.Analysis, queue queues.Queue) {
+	if err := e.processNewAnalysisPacketByQueue(queue)(analysis); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, analysis.ToString(), queue), err)
+		return
+	}
+
+	e.publishDashboardChanged(notification.NewNotificationFromAnalysis(analysis, e.getChartByQueue()[queue]))
+}
+
+// publishDashboardChanged sends the notification to all analytic instances, since only the instance that consumed
+// the analysis from the work queue knows that the dashboard changed
+func (e *Events) publishDashboardChanged(dashboardChanged *notification.Notification) {
+	if err := e.broker.Publish("", notificationEnums.ExchangeDashboardChanged, exchange.Fanout,
+		dashboardChanged.ToBytes()); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToPublishNotification, dashboardChanged), err)
+	}
+}
+
 func (e *Events) processNewAnalysisPacketByQueue(queue queues.Queue) func(*analysisEntities.Analysis) error {
-	switch queue {
-	case queues.HorusecAnalyticNewAnalysisByAuthors:
-		return e.controller.AddVulnerabilitiesByAuthor
-	case queues.HorusecAnalyticNewAnalysisByRepository:
-		return e.controller.AddVulnerabilitiesByRepository
-	case queues.HorusecAnalyticNewAnalysisByLanguage:
-		return e.controller.AddVulnerabilitiesByLanguage
-	case queues.HorusecAnalyticNewAnalysisByTime:
-		return e.controller.AddVulnerabilitiesByTime
+	return e.getProcessByQueue()[queue]
+}
+
+func (e *Events) getProcessByQueue() map[queues.Queue]func(*analysisEntities.Analysis) error {
+	return map[queues.Queue]func(*analysisEntities.Analysis) error{
+		queues.HorusecAnalyticNewAnalysisByAuthors:         e.controller.AddVulnerabilitiesByAuthor,
+		queues.HorusecAnalyticNewAnalysisByRepository:      e.controller.AddVulnerabilitiesByRepository,
+		queues.HorusecAnalyticNewAnalysisByLanguage:        e.controller.AddVulnerabilitiesByLanguage,
+		queues.HorusecAnalyticNewAnalysisByTime:            e.controller.AddVulnerabilitiesByTime,
+		eventsEnums.QueueAnalyticNewAnalysisByWorkspace:    e.controller.AddVulnerabilitiesByWorkspace,
+		eventsEnums.QueueAnalyticNewAnalysisBySecurityTool: e.controller.AddVulnerabilitiesBySecurityTool,
+		eventsEnums.QueueAnalyticNewAnalysisByCWE:          e.controller.AddVulnerabilitiesByCWE,
 	}
+}
 
-	return nil
+func (e *Events) getChartByQueue() map[queues.Queue]string {
+	return map[queues.Queue]string{
+		queues.HorusecAnalyticNewAnalysisByAuthors:         dashboardEnums.ChartVulnerabilitiesByAuthor,
+		queues.HorusecAnalyticNewAnalysisByRepository:      dashboardEnums.ChartVulnerabilitiesByRepository,
+		queues.HorusecAnalyticNewAnalysisByLanguage:        dashboardEnums.ChartVulnerabilitiesByLanguage,
+		queues.HorusecAnalyticNewAnalysisByTime:            dashboardEnums.ChartVulnerabilityByTime,
+		eventsEnums.QueueAnalyticNewAnalysisByWorkspace:    dashboardEnums.ChartVulnerabilitiesByWorkspace,
+		eventsEnums.QueueAnalyticNewAnalysisBySecurityTool: dashboardEnums.ChartVulnerabilitiesBySecurityTool,
+		eventsEnums.QueueAnalyticNewAnalysisByCWE:          dashboardEnums.ChartVulnerabilitiesByCWE,
+	}
 }
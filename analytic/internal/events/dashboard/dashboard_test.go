@@ -32,6 +32,11 @@ func TestNewDashboardEvent(t *testing.T) {
 		controllerMock.On("AddVulnerabilitiesByRepository").Return(nil)
 		controllerMock.On("AddVulnerabilitiesByLanguage").Return(nil)
 		controllerMock.On("AddVulnerabilitiesByTime").Return(nil)
+		controllerMock.On("AddVulnerabilitiesByWorkspace").Return(nil)
+		controllerMock.On("AddVulnerabilitiesBySecurityTool").Return(nil)
+		controllerMock.On("AddVulnerabilitiesByCWE").Return(nil)
+
+		brokerMock.On("Publish").Return(nil)
 
 		assert.NotPanics(t, func() {
 			NewDashboardEvents(brokerMock, controllerMock)
@@ -39,6 +44,7 @@ func TestNewDashboardEvent(t *testing.T) {
 			time.Sleep(1 * time.Second)
 
 			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+			brokerMock.AssertCalled(t, "Publish")
 		})
 	})
 
@@ -0,0 +1,54 @@
+package metadata
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/metadata"
+	metadataEntities "github.com/ZupIT/horusec-platform/analytic/internal/entities/metadata"
+	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+	metadataEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/metadata"
+)
+
+type Events struct {
+	broker     brokerLib.IBroker
+	controller metadata.IController
+}
+
+func NewMetadataEvents(broker brokerLib.IBroker, controller metadata.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(eventsEnums.QueueAnalyticRepositoryMetadata.ToString(),
+		metadataEnums.ExchangeRepositoryMetadata, exchange.Fanout, e.handleRepositoryMetadata)
+
+	return e
+}
+
+func (e *Events) handleRepositoryMetadata(metadataPacket packet.IPacket) {
+	logger.LogInfo(eventsEnums.MessageMetadataReceivedAnalytic)
+	labels := &metadataEntities.RepositoryLabels{}
+
+	if err := parser.ParsePacketToEntity(metadataPacket, labels); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, metadataPacket.GetBody(),
+			eventsEnums.QueueAnalyticRepositoryMetadata), err)
+		_ = metadataPacket.Ack()
+		return
+	}
+
+	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, metadataPacket.GetBody(),
+		eventsEnums.QueueAnalyticRepositoryMetadata), e.controller.SetRepositoryLabels(labels))
+
+	_ = metadataPacket.Ack()
+}
@@ -0,0 +1,70 @@
+package metadata
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+
+	metadataController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/metadata"
+)
+
+func TestNewMetadataEvents(t *testing.T) {
+	t.Run("should start consumers and consume without errors", func(t *testing.T) {
+		controllerMock := &metadataController.Mock{}
+		brokerMock := &broker.Mock{}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"labels": ["criticality=high"]}`))
+
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		controllerMock.On("SetRepositoryLabels").Return(nil)
+
+		assert.NotPanics(t, func() {
+			NewMetadataEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+		})
+	})
+}
+
+func TestHandleRepositoryMetadata(t *testing.T) {
+	t.Run("should not process when failed parse packet", func(t *testing.T) {
+		controllerMock := &metadataController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+
+		assert.NotPanics(t, func() {
+			events.handleRepositoryMetadata(packet)
+		})
+
+		controllerMock.AssertNotCalled(t, "SetRepositoryLabels")
+	})
+
+	t.Run("should log error when failed to process packet", func(t *testing.T) {
+		controllerMock := &metadataController.Mock{}
+		controllerMock.On("SetRepositoryLabels").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"labels": ["criticality=high"]}`))
+
+		assert.NotPanics(t, func() {
+			events.handleRepositoryMetadata(packet)
+		})
+
+		controllerMock.AssertCalled(t, "SetRepositoryLabels")
+	})
+}
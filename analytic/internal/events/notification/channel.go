@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"github.com/streadway/amqp"
+
+	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+)
+
+// connectionChannel owns the connection used only by the notifications consumer, closing the channel also closes
+// the connection, allowing the consumer to reconnect from scratch
+type connectionChannel struct {
+	*amqp.Channel
+	connection *amqp.Connection
+}
+
+func newChannelOpener(config brokerConfig.IConfig) func() (iChannel, error) {
+	return func() (iChannel, error) {
+		connection, err := amqp.Dial(config.GetConnectionString())
+		if err != nil {
+			return nil, err
+		}
+
+		channel, err := connection.Channel()
+		if err != nil {
+			_ = connection.Close()
+			return nil, err
+		}
+
+		return &connectionChannel{Channel: channel, connection: connection}, nil
+	}
+}
+
+func (c *connectionChannel) Close() error {
+	_ = c.Channel.Close()
+
+	return c.connection.Close()
+}
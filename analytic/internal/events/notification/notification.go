@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/streadway/amqp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	notificationEntities "github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
+	"github.com/ZupIT/horusec-platform/analytic/internal/services/notifier"
+)
+
+type iChannel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool,
+		args amqp.Table) (<-chan amqp.Delivery, error)
+	Close() error
+}
+
+// Events delivers the dashboard changed notifications published by any analytic instance to the subscribers of
+// this instance, each instance consumes from its own exclusive queue bound to the fanout exchange
+type Events struct {
+	notifier    notifier.INotifier
+	openChannel func() (iChannel, error)
+}
+
+func NewNotificationEvents(config brokerConfig.IConfig, dashboardNotifier notifier.INotifier) *Events {
+	events := &Events{
+		notifier:    dashboardNotifier,
+		openChannel: newChannelOpener(config),
+	}
+
+	go events.consume()
+
+	return events
+}
+
+func (e *Events) consume() {
+	for {
+		logger.LogError(eventsEnums.MessageFailedToConsumeNotification, e.consumeDeliveries())
+
+		time.Sleep(notificationEnums.ReconnectInterval)
+	}
+}
+
+func (e *Events) consumeDeliveries() error {
+	channel, err := e.openChannel()
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = channel.Close() }()
+
+	deliveries, err := e.setupQueue(channel)
+	if err != nil {
+		return err
+	}
+
+	return e.handleDeliveries(deliveries)
+}
+
+func (e *Events) setupQueue(channel iChannel) (<-chan amqp.Delivery, error) {
+	if err := channel.ExchangeDeclare(notificationEnums.ExchangeDashboardChanged, exchange.Fanout,
+		true, false, false, false, nil); err != nil {
+		return nil, err
+	}
+
+	queue, err := channel.QueueDeclare("", false, true, true, false, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := channel.QueueBind(queue.Name, "", notificationEnums.ExchangeDashboardChanged, false, nil); err != nil {
+		return nil, err
+	}
+
+	return channel.Consume(queue.Name, "", true, true, false, false, nil)
+}
+
+func (e *Events) handleDeliveries(deliveries <-chan amqp.Delivery) error {
+	for delivery := range deliveries {
+		e.handleDashboardChanged(delivery.Body)
+	}
+
+	return notificationEnums.ErrorNotificationsChannelClosed
+}
+
+func (e *Events) handleDashboardChanged(body []byte) {
+	dashboardChanged := &notificationEntities.Notification{}
+
+	if err := json.Unmarshal(body, dashboardChanged); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParseNotification, body), err)
+		return
+	}
+
+	e.notifier.Notify(dashboardChanged)
+}
@@ -0,0 +1,161 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
+
+	notificationEntities "github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
+	"github.com/ZupIT/horusec-platform/analytic/internal/services/notifier"
+)
+
+type channelMock struct {
+	mock.Mock
+	deliveries chan amqp.Delivery
+}
+
+func (m *channelMock) ExchangeDeclare(_, _ string, _, _, _, _ bool, _ amqp.Table) error {
+	return m.MethodCalled("ExchangeDeclare").Error(0)
+}
+
+func (m *channelMock) QueueDeclare(_ string, _, _, _, _ bool, _ amqp.Table) (amqp.Queue, error) {
+	args := m.MethodCalled("QueueDeclare")
+	return args.Get(0).(amqp.Queue), args.Error(1)
+}
+
+func (m *channelMock) QueueBind(_, _, _ string, _ bool, _ amqp.Table) error {
+	return m.MethodCalled("QueueBind").Error(0)
+}
+
+func (m *channelMock) Consume(_, _ string, _, _, _, _ bool, _ amqp.Table) (<-chan amqp.Delivery, error) {
+	return m.deliveries, m.MethodCalled("Consume").Error(0)
+}
+
+func (m *channelMock) Close() error {
+	return m.MethodCalled("Close").Error(0)
+}
+
+func newChannelMock() *channelMock {
+	channel := &channelMock{deliveries: make(chan amqp.Delivery, 1)}
+	channel.On("ExchangeDeclare").Return(nil)
+	channel.On("QueueDeclare").Return(amqp.Queue{Name: "amq.gen-test"}, nil)
+	channel.On("QueueBind").Return(nil)
+	channel.On("Consume").Return(nil)
+	channel.On("Close").Return(nil)
+	return channel
+}
+
+func newEventsWithChannel(notifierMock *notifier.Mock, channel iChannel, err error) *Events {
+	return &Events{
+		notifier:    notifierMock,
+		openChannel: func() (iChannel, error) { return channel, err },
+	}
+}
+
+func TestNewNotificationEvents(t *testing.T) {
+	t.Run("should start consuming without panics when broker is unavailable", func(t *testing.T) {
+		brokerConfig := config.NewBrokerConfig()
+		brokerConfig.SetPort("1")
+
+		assert.NotPanics(t, func() {
+			assert.NotNil(t, NewNotificationEvents(brokerConfig, &notifier.Mock{}))
+		})
+	})
+}
+
+func TestConsumeDeliveries(t *testing.T) {
+	t.Run("should notify dashboard changed received from the exclusive queue", func(t *testing.T) {
+		notifierMock := &notifier.Mock{}
+		notifierMock.On("Notify").Return()
+
+		channel := newChannelMock()
+		dashboardChanged := &notificationEntities.Notification{WorkspaceID: uuid.New()}
+		channel.deliveries <- amqp.Delivery{Body: dashboardChanged.ToBytes()}
+		close(channel.deliveries)
+
+		err := newEventsWithChannel(notifierMock, channel, nil).consumeDeliveries()
+
+		assert.Equal(t, notificationEnums.ErrorNotificationsChannelClosed, err)
+		notifierMock.AssertCalled(t, "Notify")
+		channel.AssertCalled(t, "Close")
+	})
+
+	t.Run("should not notify when invalid notification body", func(t *testing.T) {
+		notifierMock := &notifier.Mock{}
+
+		channel := newChannelMock()
+		channel.deliveries <- amqp.Delivery{Body: []byte("invalid")}
+		close(channel.deliveries)
+
+		err := newEventsWithChannel(notifierMock, channel, nil).consumeDeliveries()
+
+		assert.Equal(t, notificationEnums.ErrorNotificationsChannelClosed, err)
+		notifierMock.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("should return error when failed to open channel", func(t *testing.T) {
+		err := newEventsWithChannel(&notifier.Mock{}, nil, errors.New("test")).consumeDeliveries()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error and close channel when failed to declare queue", func(t *testing.T) {
+		channel := &channelMock{}
+		channel.On("ExchangeDeclare").Return(nil)
+		channel.On("QueueDeclare").Return(amqp.Queue{}, errors.New("test"))
+		channel.On("Close").Return(nil)
+
+		err := newEventsWithChannel(&notifier.Mock{}, channel, nil).consumeDeliveries()
+
+		assert.Error(t, err)
+		channel.AssertCalled(t, "Close")
+		channel.AssertNotCalled(t, "QueueBind")
+	})
+
+	t.Run("should return error when failed to declare exchange", func(t *testing.T) {
+		channel := &channelMock{}
+		channel.On("ExchangeDeclare").Return(errors.New("test"))
+		channel.On("Close").Return(nil)
+
+		assert.Error(t, newEventsWithChannel(&notifier.Mock{}, channel, nil).consumeDeliveries())
+	})
+
+	t.Run("should return error when failed to bind queue", func(t *testing.T) {
+		channel := &channelMock{}
+		channel.On("ExchangeDeclare").Return(nil)
+		channel.On("QueueDeclare").Return(amqp.Queue{Name: "amq.gen-test"}, nil)
+		channel.On("QueueBind").Return(errors.New("test"))
+		channel.On("Close").Return(nil)
+
+		assert.Error(t, newEventsWithChannel(&notifier.Mock{}, channel, nil).consumeDeliveries())
+	})
+}
+
+func TestConsume(t *testing.T) {
+	t.Run("should keep reconnecting after the consumer stops", func(t *testing.T) {
+		opened := make(chan bool, 1)
+		events := &Events{
+			notifier: &notifier.Mock{},
+			openChannel: func() (iChannel, error) {
+				opened <- true
+				return nil, errors.New("test")
+			},
+		}
+
+		go events.consume()
+
+		select {
+		case <-opened:
+		case <-time.After(time.Second):
+			t.Fatal("consumer did not try to open the channel")
+		}
+	})
+}
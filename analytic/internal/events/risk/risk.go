@@ -0,0 +1,74 @@
+package risk
+
+import (
+	"fmt"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/risk"
+	riskEntities "github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+)
+
+type Events struct {
+	broker     brokerLib.IBroker
+	controller risk.IController
+}
+
+func NewRiskEvents(broker brokerLib.IBroker, controller risk.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(eventsEnums.QueueAnalyticNewAnalysisRiskScore.ToString(), exchange.NewAnalysis,
+		exchange.Fanout, e.handleNewAnalysis)
+
+	go e.broker.Consume(eventsEnums.QueueAnalyticRepositoryCriticality.ToString(), "", "",
+		e.handleRepositoryCriticality)
+
+	return e
+}
+
+func (e *Events) handleNewAnalysis(analysisPacket packet.IPacket) {
+	logger.LogInfo(eventsEnums.MessageNewAnalysisReceivedAnalytic)
+	analysis := &analysisEntities.Analysis{}
+
+	if err := parser.ParsePacketToEntity(analysisPacket, analysis); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, analysisPacket.GetBody(),
+			eventsEnums.QueueAnalyticNewAnalysisRiskScore), err)
+		_ = analysisPacket.Ack()
+		return
+	}
+
+	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, analysisPacket.GetBody(),
+		eventsEnums.QueueAnalyticNewAnalysisRiskScore), e.controller.AddRiskScore(analysis))
+
+	_ = analysisPacket.Ack()
+}
+
+func (e *Events) handleRepositoryCriticality(criticalityPacket packet.IPacket) {
+	logger.LogInfo(eventsEnums.MessageCriticalityReceivedAnalytic)
+	criticality := &riskEntities.RepositoryCriticality{}
+
+	if err := parser.ParsePacketToEntity(criticalityPacket, criticality); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, criticalityPacket.GetBody(),
+			eventsEnums.QueueAnalyticRepositoryCriticality), err)
+		_ = criticalityPacket.Ack()
+		return
+	}
+
+	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, criticalityPacket.GetBody(),
+		eventsEnums.QueueAnalyticRepositoryCriticality), e.controller.SetRepositoryCriticality(criticality))
+
+	_ = criticalityPacket.Ack()
+}
@@ -0,0 +1,105 @@
+package risk
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+
+	riskController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/risk"
+)
+
+func TestNewRiskEvents(t *testing.T) {
+	t.Run("should start consumers and consume without errors", func(t *testing.T) {
+		controllerMock := &riskController.Mock{}
+		brokerMock := &broker.Mock{}
+
+		delivery := &amqp.Delivery{}
+		packet := brokerPacket.NewPacket(delivery)
+		packet.SetBody((&analysis.Analysis{}).ToBytes())
+
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		controllerMock.On("AddRiskScore").Return(nil)
+		controllerMock.On("SetRepositoryCriticality").Return(nil)
+
+		assert.NotPanics(t, func() {
+			NewRiskEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+		})
+	})
+}
+
+func TestHandleNewAnalysis(t *testing.T) {
+	t.Run("should not process when failed parse packet", func(t *testing.T) {
+		controllerMock := &riskController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+
+		assert.NotPanics(t, func() {
+			events.handleNewAnalysis(packet)
+		})
+
+		controllerMock.AssertNotCalled(t, "AddRiskScore")
+	})
+
+	t.Run("should log error when failed to process packet", func(t *testing.T) {
+		controllerMock := &riskController.Mock{}
+		controllerMock.On("AddRiskScore").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody((&analysis.Analysis{}).ToBytes())
+
+		assert.NotPanics(t, func() {
+			events.handleNewAnalysis(packet)
+		})
+
+		controllerMock.AssertCalled(t, "AddRiskScore")
+	})
+}
+
+func TestHandleRepositoryCriticality(t *testing.T) {
+	t.Run("should not process when failed parse packet", func(t *testing.T) {
+		controllerMock := &riskController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+
+		assert.NotPanics(t, func() {
+			events.handleRepositoryCriticality(packet)
+		})
+
+		controllerMock.AssertNotCalled(t, "SetRepositoryCriticality")
+	})
+
+	t.Run("should set repository criticality", func(t *testing.T) {
+		controllerMock := &riskController.Mock{}
+		controllerMock.On("SetRepositoryCriticality").Return(nil)
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"criticality": "HIGH"}`))
+
+		assert.NotPanics(t, func() {
+			events.handleRepositoryCriticality(packet)
+		})
+
+		controllerMock.AssertCalled(t, "SetRepositoryCriticality")
+	})
+}
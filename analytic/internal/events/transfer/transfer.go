@@ -0,0 +1,54 @@
+package transfer
+
+import (
+	"fmt"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerLib "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/controllers/transfer"
+	transferEntities "github.com/ZupIT/horusec-platform/analytic/internal/entities/transfer"
+	eventsEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/events"
+	transferEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/transfer"
+)
+
+type Events struct {
+	broker     brokerLib.IBroker
+	controller transfer.IController
+}
+
+func NewTransferEvents(broker brokerLib.IBroker, controller transfer.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(eventsEnums.QueueAnalyticRepositoryTransfer.ToString(),
+		transferEnums.ExchangeRepositoryTransfer, exchange.Fanout, e.handleRepositoryTransfer)
+
+	return e
+}
+
+func (e *Events) handleRepositoryTransfer(transferPacket packet.IPacket) {
+	logger.LogInfo(eventsEnums.MessageTransferReceivedAnalytic)
+	event := &transferEntities.Event{}
+
+	if err := parser.ParsePacketToEntity(transferPacket, event); err != nil {
+		logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToParsePacket, transferPacket.GetBody(),
+			eventsEnums.QueueAnalyticRepositoryTransfer), err)
+		_ = transferPacket.Ack()
+		return
+	}
+
+	logger.LogError(fmt.Sprintf(eventsEnums.MessageFailedToProcessPacket, transferPacket.GetBody(),
+		eventsEnums.QueueAnalyticRepositoryTransfer), e.controller.TransferRepository(event))
+
+	_ = transferPacket.Ack()
+}
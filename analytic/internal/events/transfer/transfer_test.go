@@ -0,0 +1,70 @@
+package transfer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+
+	transferController "github.com/ZupIT/horusec-platform/analytic/internal/controllers/transfer"
+)
+
+func TestNewTransferEvents(t *testing.T) {
+	t.Run("should start consumers and consume without errors", func(t *testing.T) {
+		controllerMock := &transferController.Mock{}
+		brokerMock := &broker.Mock{}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"name": "test"}`))
+
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		controllerMock.On("TransferRepository").Return(nil)
+
+		assert.NotPanics(t, func() {
+			NewTransferEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+		})
+	})
+}
+
+func TestHandleRepositoryTransfer(t *testing.T) {
+	t.Run("should not process when failed parse packet", func(t *testing.T) {
+		controllerMock := &transferController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+
+		assert.NotPanics(t, func() {
+			events.handleRepositoryTransfer(packet)
+		})
+
+		controllerMock.AssertNotCalled(t, "TransferRepository")
+	})
+
+	t.Run("should log error when failed to process packet", func(t *testing.T) {
+		controllerMock := &transferController.Mock{}
+		controllerMock.On("TransferRepository").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"name": "test"}`))
+
+		assert.NotPanics(t, func() {
+			events.handleRepositoryTransfer(packet)
+		})
+
+		controllerMock.AssertCalled(t, "TransferRepository")
+	})
+}
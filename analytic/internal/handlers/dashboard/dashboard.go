@@ -1,24 +1,38 @@
 package dashboard
 
 import (
+	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
 
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 
 	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
-	_ "github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard" // [swagger-usage]
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	exportEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/export"
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
+	"github.com/ZupIT/horusec-platform/analytic/internal/services/notifier"
 	useCase "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+	exportUseCase "github.com/ZupIT/horusec-platform/analytic/internal/usecases/export"
 )
 
 type Handler struct {
-	controller controller.IController
-	useCase    useCase.IUseCases
+	controller    controller.IController
+	useCase       useCase.IUseCases
+	exportUseCase exportUseCase.IUseCases
+	notifier      notifier.INotifier
 }
 
-func NewDashboardHandler(dashboardController controller.IController) *Handler {
+func NewDashboardHandler(dashboardController controller.IController, dashboardNotifier notifier.INotifier) *Handler {
 	return &Handler{
-		controller: dashboardController,
-		useCase:    useCase.NewUseCaseDashboard(),
+		controller:    dashboardController,
+		notifier:      dashboardNotifier,
+		useCase:       useCase.NewUseCaseDashboard(),
+		exportUseCase: exportUseCase.NewUseCaseExport(),
 	}
 }
 
@@ -63,6 +77,37 @@ func (h *Handler) GetAllChartsByRepository(w http.ResponseWriter, r *http.Reques
 	h.getAllCharts(w, r)
 }
 
+// GetAllChartsByApplicationAdmin
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Get all charts of dashboard screen aggregating all workspaces
+// @ID GetAllChartsByApplicationAdmin
+// @Accept  json
+// @Produce  json
+// @Param initialDate query string true "initialDate query string"
+// @Param finalDate query string true "finalDate query string"
+// @Param page query string false "page of the workspace ranking"
+// @Param size query string false "size of the workspace ranking"
+// @Success 200 {object} entities.Response{content=dashboard.Response} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/application-admin/dashboard [get]
+func (h *Handler) GetAllChartsByApplicationAdmin(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCase.ApplicationAdminFilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.GetAllApplicationAdminCharts(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
 func (h *Handler) getAllCharts(w http.ResponseWriter, r *http.Request) {
 	filter, err := h.useCase.FilterFromRequest(r)
 	if err != nil {
@@ -78,3 +123,196 @@ func (h *Handler) getAllCharts(w http.ResponseWriter, r *http.Request) {
 
 	httpUtil.StatusOK(w, result)
 }
+
+// ExportChartsByWorkspace
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Export all charts of dashboard screen as csv, xlsx or pdf
+// @ID ExportChartsByWorkspace
+// @Accept  json
+// @Produce  text/csv
+// @Produce  application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce  application/pdf
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param format path string true "export format" Enums(csv, xlsx, pdf)
+// @Param chart query string false "chart to export, required when format is csv"
+// @Param initialDate query string true "initialDate query string"
+// @Param finalDate query string true "finalDate query string"
+// @Success 200 {file} file "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/export/{format} [get]
+func (h *Handler) ExportChartsByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.exportCharts(w, r)
+}
+
+// ExportChartsByRepository
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Export all charts of dashboard screen as csv, xlsx or pdf
+// @ID ExportChartsByRepository
+// @Accept  json
+// @Produce  text/csv
+// @Produce  application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce  application/pdf
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param format path string true "export format" Enums(csv, xlsx, pdf)
+// @Param chart query string false "chart to export, required when format is csv"
+// @Param initialDate query string true "initialDate query string"
+// @Param finalDate query string true "finalDate query string"
+// @Success 200 {file} file "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/{repositoryID}/export/{format} [get]
+func (h *Handler) ExportChartsByRepository(w http.ResponseWriter, r *http.Request) {
+	h.exportCharts(w, r)
+}
+
+func (h *Handler) exportCharts(w http.ResponseWriter, r *http.Request) {
+	format := exportEnums.Format(chi.URLParam(r, exportEnums.FormatParam))
+	if format.IsInvalid() {
+		httpUtil.StatusBadRequest(w, exportEnums.ErrorInvalidExportFormat)
+		return
+	}
+
+	filter, err := h.useCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.exportChartsByFilter(w, r, format, filter)
+}
+
+func (h *Handler) exportChartsByFilter(w http.ResponseWriter, r *http.Request, format exportEnums.Format,
+	filter *dashboard.Filter) {
+	result, err := h.controller.GetAllDashboardCharts(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	content, err := h.exportUseCase.ExportCharts(format, result, filter,
+		r.URL.Query().Get(dashboardEnums.ChartHeader))
+	if err != nil {
+		h.checkExportChartsErrors(w, err)
+		return
+	}
+
+	h.writeExportedFile(w, format, content)
+}
+
+func (h *Handler) checkExportChartsErrors(w http.ResponseWriter, err error) {
+	if err == exportEnums.ErrorInvalidChart {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+func (h *Handler) writeExportedFile(w http.ResponseWriter, format exportEnums.Format, content []byte) {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(exportEnums.ContentDisposition,
+		time.Now().Format("2006-01-02"), format.ToString()))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
+// StreamByWorkspace
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Stream server-sent events notifying that the workspace dashboard changed
+// @ID StreamByWorkspace
+// @Produce  text/event-stream
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Success 200 {object} notification.Notification "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/events [get]
+func (h *Handler) StreamByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.stream(w, r)
+}
+
+// StreamByRepository
+// @Tags Dashboard
+// @Security ApiKeyAuth
+// @Description Stream server-sent events notifying that the repository dashboard changed
+// @ID StreamByRepository
+// @Produce  text/event-stream
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Success 200 {object} notification.Notification "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/dashboard/{workspaceID}/{repositoryID}/events [get]
+func (h *Handler) StreamByRepository(w http.ResponseWriter, r *http.Request) {
+	h.stream(w, r)
+}
+
+func (h *Handler) stream(w http.ResponseWriter, r *http.Request) {
+	filter := &dashboard.Filter{}
+	if err := filter.SetWorkspaceAndRepositoryID(r); err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httpUtil.StatusInternalServerError(w, notificationEnums.ErrorStreamingUnsupported)
+		return
+	}
+
+	h.startStream(w, flusher)
+	h.streamNotifications(w, r, flusher, filter)
+}
+
+func (h *Handler) startStream(w http.ResponseWriter, flusher http.Flusher) {
+	w.Header().Set(notificationEnums.HeaderContentType, notificationEnums.ContentTypeEventStream)
+	w.Header().Set(notificationEnums.HeaderCacheControl, notificationEnums.CacheControlNoCache)
+	w.Header().Set(notificationEnums.HeaderConnection, notificationEnums.ConnectionKeepAlive)
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = fmt.Fprintf(w, "retry: %d\n\n", notificationEnums.RetryMilliseconds)
+	flusher.Flush()
+}
+
+// streamNotifications writes the notifications until the client disconnects or the request times out, the retry
+// hint sent on start makes the browser reconnect automatically when the stream is closed by the server
+func (h *Handler) streamNotifications(w http.ResponseWriter, r *http.Request, flusher http.Flusher,
+	filter *dashboard.Filter) {
+	subscription := h.notifier.Subscribe(filter.WorkspaceID, filter.RepositoryID)
+	defer h.notifier.Unsubscribe(subscription)
+
+	keepAlive := time.NewTicker(notificationEnums.KeepAliveInterval)
+	defer keepAlive.Stop()
+
+	h.writeUntilDone(w, r, flusher, subscription, keepAlive)
+}
+
+func (h *Handler) writeUntilDone(w http.ResponseWriter, r *http.Request, flusher http.Flusher,
+	subscription *notification.Subscription, keepAlive *time.Ticker) {
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case newNotification := <-subscription.Notifications:
+			h.writeNotification(w, flusher, newNotification)
+		case <-keepAlive.C:
+			h.writeKeepAlive(w, flusher)
+		}
+	}
+}
+
+func (h *Handler) writeNotification(w http.ResponseWriter, flusher http.Flusher,
+	newNotification *notification.Notification) {
+	_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", notificationEnums.EventDashboardChanged,
+		newNotification.ToBytes())
+	flusher.Flush()
+}
+
+func (h *Handler) writeKeepAlive(w http.ResponseWriter, flusher http.Flusher) {
+	_, _ = fmt.Fprint(w, ": keep-alive\n\n")
+	flusher.Flush()
+}
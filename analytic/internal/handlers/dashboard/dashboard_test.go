@@ -15,6 +15,9 @@ import (
 
 	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/dashboard"
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
+	"github.com/ZupIT/horusec-platform/analytic/internal/services/notifier"
 )
 
 func TestOptions(t *testing.T) {
@@ -24,7 +27,7 @@ func TestOptions(t *testing.T) {
 		w := httptest.NewRecorder()
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		handler.Options(w, r)
 
@@ -41,7 +44,7 @@ func TestGetAllChartsByWorkspace(t *testing.T) {
 		controllerMock := &controller.Mock{}
 		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, nil)
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
 			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 18, 18)
@@ -62,7 +65,7 @@ func TestGetAllChartsByWorkspace(t *testing.T) {
 		controllerMock := &controller.Mock{}
 		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, errors.New("test"))
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
 			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 18, 18)
@@ -82,7 +85,7 @@ func TestGetAllChartsByWorkspace(t *testing.T) {
 	t.Run("should return 400 when invalid filter", func(t *testing.T) {
 		controllerMock := &controller.Mock{}
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		w := httptest.NewRecorder()
 		r, _ := http.NewRequest(http.MethodGet, "", nil)
@@ -106,7 +109,7 @@ func TestGetAllChartsByRepository(t *testing.T) {
 		controllerMock := &controller.Mock{}
 		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, nil)
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
 			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 18, 18)
@@ -128,7 +131,7 @@ func TestGetAllChartsByRepository(t *testing.T) {
 		controllerMock := &controller.Mock{}
 		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, errors.New("test"))
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
 			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 18, 18)
@@ -149,7 +152,7 @@ func TestGetAllChartsByRepository(t *testing.T) {
 	t.Run("should return 400 when invalid filter", func(t *testing.T) {
 		controllerMock := &controller.Mock{}
 
-		handler := NewDashboardHandler(controllerMock)
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
 
 		w := httptest.NewRecorder()
 		r, _ := http.NewRequest(http.MethodGet, "", nil)
@@ -163,3 +166,287 @@ func TestGetAllChartsByRepository(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 }
+
+func TestGetAllChartsByApplicationAdmin(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get all charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetAllApplicationAdminCharts").Return(&dashboard.Response{TotalWorkspaces: 2,
+			VulnerabilitiesByWorkspace: []dashboard.ByWorkspace{{WorkspaceName: "test"}}}, nil)
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 0, 18)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		handler.GetAllChartsByApplicationAdmin(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"totalWorkspaces":2`)
+		assert.Contains(t, w.Body.String(), `"workspaceName":"test"`)
+	})
+
+	t.Run("should return 500 when failed to get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetAllApplicationAdminCharts").Return(&dashboard.Response{}, errors.New("test"))
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		handler.GetAllChartsByApplicationAdmin(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		handler.GetAllChartsByApplicationAdmin(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestExportChartsByWorkspace(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success export charts to pdf", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, nil)
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("format", "pdf")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ExportChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("should return 400 when invalid chart to csv", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, nil)
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&chart=test",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("format", "csv")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ExportChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get charts", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, errors.New("test"))
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("format", "xlsx")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ExportChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid format", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("format", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ExportChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("format", "csv")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ExportChartsByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestExportChartsByRepository(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success export chart to csv", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetAllDashboardCharts").Return(&dashboard.Response{}, nil)
+
+		handler := NewDashboardHandler(controllerMock, &notifier.Mock{})
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&chart=totals",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		ctx.URLParams.Add("format", "csv")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ExportChartsByRepository(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	})
+}
+
+type notFlusherResponseWriter struct {
+	http.ResponseWriter
+}
+
+func TestStreamByWorkspace(t *testing.T) {
+	t.Run("should stream dashboard changed notifications until the request is done", func(t *testing.T) {
+		workspaceID := uuid.New()
+		subscription := notification.NewSubscription(workspaceID, uuid.Nil)
+		subscription.Notifications <- &notification.Notification{WorkspaceID: workspaceID}
+
+		notifierMock := &notifier.Mock{}
+		notifierMock.On("Subscribe").Return(subscription)
+		notifierMock.On("Unsubscribe").Return()
+
+		handler := NewDashboardHandler(&controller.Mock{}, notifierMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", workspaceID.String())
+		requestCtx, cancel := context.WithCancel(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = r.WithContext(requestCtx)
+
+		time.AfterFunc(100*time.Millisecond, cancel)
+		handler.StreamByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, notificationEnums.ContentTypeEventStream, w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "retry: 1000")
+		assert.Contains(t, w.Body.String(), "event: dashboard-changed")
+		notifierMock.AssertCalled(t, "Unsubscribe")
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewDashboardHandler(&controller.Mock{}, &notifier.Mock{})
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.StreamByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when response writer does not support streaming", func(t *testing.T) {
+		handler := NewDashboardHandler(&controller.Mock{}, &notifier.Mock{})
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.StreamByWorkspace(&notFlusherResponseWriter{ResponseWriter: w}, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestStreamByRepository(t *testing.T) {
+	t.Run("should start stream and stop when the request is done", func(t *testing.T) {
+		notifierMock := &notifier.Mock{}
+		notifierMock.On("Subscribe").Return(notification.NewSubscription(uuid.New(), uuid.New()))
+		notifierMock.On("Unsubscribe").Return()
+
+		handler := NewDashboardHandler(&controller.Mock{}, notifierMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		requestCtx, cancel := context.WithCancel(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		cancel()
+
+		handler.StreamByRepository(w, r.WithContext(requestCtx))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		notifierMock.AssertCalled(t, "Subscribe")
+	})
+}
@@ -0,0 +1,111 @@
+package risk
+
+import (
+	"net/http"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/risk"
+	dashboardUseCases "github.com/ZupIT/horusec-platform/analytic/internal/usecases/dashboard"
+)
+
+type Handler struct {
+	controller       controller.IController
+	dashboardUseCase dashboardUseCases.IUseCases
+}
+
+func NewRiskHandler(riskController controller.IController) *Handler {
+	return &Handler{
+		controller:       riskController,
+		dashboardUseCase: dashboardUseCases.NewUseCaseDashboard(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+// GetRiskScoreRanking
+// @Tags Risk
+// @Security ApiKeyAuth
+// @Description Get the latest risk score of each repository of the workspace ordered by the highest score
+// @ID GetRiskScoreRanking
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Param page query string false "page of the ranking"
+// @Param size query string false "size of the ranking"
+// @Success 200 {object} entities.Response{content=[]risk.RiskScore} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/risk-score/{workspaceID} [get]
+func (h *Handler) GetRiskScoreRanking(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.dashboardUseCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.GetRiskScoreRanking(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+// GetRiskScoreByTimeByWorkspace
+// @Tags Risk
+// @Security ApiKeyAuth
+// @Description Get the daily risk score of the workspace summing the score of its repositories
+// @ID GetRiskScoreByTimeByWorkspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=[]risk.ScoreByTime} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/risk-score/{workspaceID}/time-series [get]
+func (h *Handler) GetRiskScoreByTimeByWorkspace(w http.ResponseWriter, r *http.Request) {
+	h.getRiskScoreByTime(w, r)
+}
+
+// GetRiskScoreByTimeByRepository
+// @Tags Risk
+// @Security ApiKeyAuth
+// @Description Get the daily risk score of the repository
+// @ID GetRiskScoreByTimeByRepository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "workspaceID of the workspace"
+// @Param repositoryID path string true "repositoryID of the repository"
+// @Param initialDate query string false "initialDate query string"
+// @Param finalDate query string false "finalDate query string"
+// @Success 200 {object} entities.Response{content=[]risk.ScoreByTime} "OK"
+// @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
+// @Router /analytic/risk-score/{workspaceID}/{repositoryID}/time-series [get]
+func (h *Handler) GetRiskScoreByTimeByRepository(w http.ResponseWriter, r *http.Request) {
+	h.getRiskScoreByTime(w, r)
+}
+
+func (h *Handler) getRiskScoreByTime(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.dashboardUseCase.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.GetRiskScoreByTime(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
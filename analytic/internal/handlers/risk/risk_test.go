@@ -0,0 +1,195 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	controller "github.com/ZupIT/horusec-platform/analytic/internal/controllers/risk"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+)
+
+func TestOptions(t *testing.T) {
+	t.Run("should return no content when options", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		handler := NewRiskHandler(controllerMock)
+
+		handler.Options(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestGetRiskScoreRanking(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get risk score ranking", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRiskScoreRanking").Return([]*risk.RiskScore{}, nil)
+
+		handler := NewRiskHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 0, 10)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreRanking(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get risk score ranking", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRiskScoreRanking").Return([]*risk.RiskScore{}, errors.New("test"))
+
+		handler := NewRiskHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreRanking(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewRiskHandler(controllerMock)
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, "", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreRanking(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetRiskScoreByTimeByWorkspace(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get risk score by time", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRiskScoreByTime").Return([]*risk.ScoreByTime{}, nil)
+
+		handler := NewRiskHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreByTimeByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get risk score by time", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRiskScoreByTime").Return([]*risk.ScoreByTime{}, errors.New("test"))
+
+		handler := NewRiskHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreByTimeByWorkspace(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetRiskScoreByTimeByRepository(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should return 200 when success get risk score by time", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+		controllerMock.On("GetRiskScoreByTime").Return([]*risk.ScoreByTime{}, nil)
+
+		handler := NewRiskHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreByTimeByRepository(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid repository id", func(t *testing.T) {
+		controllerMock := &controller.Mock{}
+
+		handler := NewRiskHandler(controllerMock)
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime))
+
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		ctx.URLParams.Add("repositoryID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.GetRiskScoreByTimeByRepository(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,34 @@
+package archive
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+)
+
+type IRepoArchive interface {
+	ListWorkspaceRepositoriesID(workspaceID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type RepoArchive struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewRepoArchive(connection *database.Connection) IRepoArchive {
+	return &RepoArchive{
+		databaseRead: connection.Read,
+	}
+}
+
+func (r *RepoArchive) ListWorkspaceRepositoriesID(workspaceID uuid.UUID) (repositoriesID []uuid.UUID, err error) {
+	return repositoriesID, r.databaseRead.Raw(r.queryListWorkspaceRepositoriesID(), &repositoriesID,
+		workspaceID, workspaceID).GetErrorExceptNotFound()
+}
+
+func (r *RepoArchive) queryListWorkspaceRepositoriesID() string {
+	return `
+		SELECT DISTINCT repository_id FROM vulnerabilities_by_repository WHERE workspace_id = ?
+		UNION
+		SELECT repository_id FROM repository_criticality WHERE workspace_id = ?
+	`
+}
@@ -0,0 +1,17 @@
+package archive
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListWorkspaceRepositoriesID(_ uuid.UUID) ([]uuid.UUID, error) {
+	args := m.MethodCalled("ListWorkspaceRepositoriesID")
+	return args.Get(0).([]uuid.UUID), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,34 @@
+package archive
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+)
+
+func TestListWorkspaceRepositoriesID(t *testing.T) {
+	t.Run("should success list workspace repositories id", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewRepoArchive(&database.Connection{Read: databaseReadMock})
+
+		_, err := repository.ListWorkspaceRepositoriesID(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list workspace repositories id", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewRepoArchive(&database.Connection{Read: databaseReadMock})
+
+		_, err := repository.ListWorkspaceRepositoriesID(uuid.New())
+		assert.Error(t, err)
+	})
+}
@@ -17,6 +17,11 @@ type IRepoDashboard interface {
 	GetDashboardVulnByRepository(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByRepository, error)
 	GetDashboardVulnByLanguage(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByLanguage, error)
 	GetDashboardVulnByTime(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByTime, error)
+	GetDashboardTotalWorkspaces(filter *dashboard.Filter) (int, error)
+	GetDashboardVulnByWorkspace(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByWorkspace, error)
+	GetDashboardVulnBySecurityTool(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesBySecurityTool, error)
+	GetDashboardVulnByCWE(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByCWE, error)
+	GetDashboardVulnByOWASP(filter *dashboard.Filter) ([]*dashboard.VulnerabilitiesByCWE, error)
 }
 
 type RepoDashboard struct {
@@ -222,6 +227,114 @@ func (r *RepoDashboard) queryGetDashboardVulnByTime() string {
 	`
 }
 
+func (r *RepoDashboard) GetDashboardTotalWorkspaces(filter *dashboard.Filter) (count int, err error) {
+	condition, args := filter.GetConditionFilter()
+
+	query := fmt.Sprintf(r.queryGetDashboardTotalWorkspaces(),
+		dashboardEnums.TableVulnerabilitiesByWorkspace, condition)
+
+	return count, r.databaseRead.Raw(query, &count, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoDashboard) queryGetDashboardTotalWorkspaces() string {
+	return `
+		SELECT COUNT(*) 
+		FROM (
+				SELECT DISTINCT ON(workspace_id) workspace_id
+				FROM %[1]s
+				WHERE %[2]s
+		) AS result
+	`
+}
+
+func (r *RepoDashboard) GetDashboardVulnByWorkspace(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByWorkspace, err error) {
+	condition, args := filter.GetConditionFilter()
+	limit, offset := filter.GetPagination()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByWorkspace(),
+		r.queryDefaultFields(), dashboardEnums.TableVulnerabilitiesByWorkspace, condition)
+
+	return vulns, r.databaseRead.Raw(query, &vulns, append(args, limit, offset)...).GetErrorExceptNotFound()
+}
+
+func (r *RepoDashboard) queryGetDashboardVulnByWorkspace() string {
+	return `
+		SELECT workspace_id, MAX(workspace_name) AS workspace_name, %[1]s
+		FROM (
+				SELECT DISTINCT ON (repository_id) *
+				FROM %[2]s
+				WHERE %[3]s
+				ORDER BY repository_id, created_at DESC
+		) AS result
+		GROUP BY workspace_id
+		ORDER BY critical_vulnerability DESC, high_vulnerability DESC, medium_vulnerability DESC, 
+		low_vulnerability DESC
+		LIMIT ? OFFSET ?
+	`
+}
+
+func (r *RepoDashboard) GetDashboardVulnBySecurityTool(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesBySecurityTool, err error) {
+	query, args := r.getQueryAndArgsByDimension(filter, dashboardEnums.TableVulnerabilitiesBySecurityTool,
+		dashboardEnums.ColumnSecurityTool)
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoDashboard) GetDashboardVulnByCWE(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByCWE, err error) {
+	query, args := r.getQueryAndArgsByDimension(filter, dashboardEnums.TableVulnerabilitiesByCWE,
+		dashboardEnums.ColumnCWE)
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoDashboard) GetDashboardVulnByOWASP(
+	filter *dashboard.Filter) (vulns []*dashboard.VulnerabilitiesByCWE, err error) {
+	query, args := r.getQueryAndArgsByDimension(filter, dashboardEnums.TableVulnerabilitiesByCWE,
+		dashboardEnums.ColumnOWASPCategory)
+
+	return vulns, r.databaseRead.Raw(query, &vulns, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoDashboard) getQueryAndArgsByDimension(filter *dashboard.Filter, table,
+	column string) (string, []interface{}) {
+	condition, args := filter.GetConditionFilter()
+	limit, _ := filter.GetPagination()
+
+	query := fmt.Sprintf(r.queryGetDashboardVulnByDimension(), r.queryDefaultFields(), table, condition, column)
+
+	return query, append(args, limit)
+}
+
+//nolint:funlen // need to be bigger than 15
+func (r *RepoDashboard) queryGetDashboardVulnByDimension() string {
+	return `
+		SELECT %[4]s, %[1]s
+		FROM (
+				SELECT *
+				FROM %[2]s AS vuln_by_dimension
+				INNER JOIN
+				(
+					SELECT DISTINCT ON(repository_id, created_at, %[4]s) vulnerability_id AS sub_query_vulnerability_id
+					FROM %[2]s
+					WHERE created_at 
+					IN 
+					(
+						SELECT MAX(created_at) FROM %[2]s GROUP BY(repository_id, DATE(created_at), %[4]s)
+					)
+				) AS vuln_by_dimension_sub_query
+				ON vuln_by_dimension.vulnerability_id  = vuln_by_dimension_sub_query.sub_query_vulnerability_id 
+				WHERE %[3]s
+		) AS result
+		GROUP BY %[4]s
+		ORDER BY critical_vulnerability DESC, high_vulnerability DESC, medium_vulnerability DESC, 
+		low_vulnerability DESC
+		LIMIT ?
+	`
+}
+
 func (r *RepoDashboard) queryDefaultFields() string {
 	return `
 		SUM(critical_vulnerability) as critical_vulnerability, SUM(critical_false_positive) as critical_false_positive, 
@@ -46,3 +46,28 @@ func (m *Mock) GetDashboardVulnByTime(_ *dashboard.Filter) ([]*dashboard.Vulnera
 	args := m.MethodCalled("GetDashboardVulnByTime")
 	return args.Get(0).([]*dashboard.VulnerabilitiesByTime), utilsMock.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetDashboardTotalWorkspaces(_ *dashboard.Filter) (int, error) {
+	args := m.MethodCalled("GetDashboardTotalWorkspaces")
+	return args.Get(0).(int), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardVulnByWorkspace(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesByWorkspace, error) {
+	args := m.MethodCalled("GetDashboardVulnByWorkspace")
+	return args.Get(0).([]*dashboard.VulnerabilitiesByWorkspace), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardVulnBySecurityTool(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesBySecurityTool, error) {
+	args := m.MethodCalled("GetDashboardVulnBySecurityTool")
+	return args.Get(0).([]*dashboard.VulnerabilitiesBySecurityTool), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardVulnByCWE(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesByCWE, error) {
+	args := m.MethodCalled("GetDashboardVulnByCWE")
+	return args.Get(0).([]*dashboard.VulnerabilitiesByCWE), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetDashboardVulnByOWASP(_ *dashboard.Filter) ([]*dashboard.VulnerabilitiesByCWE, error) {
+	args := m.MethodCalled("GetDashboardVulnByOWASP")
+	return args.Get(0).([]*dashboard.VulnerabilitiesByCWE), utilsMock.ReturnNilOrError(args, 1)
+}
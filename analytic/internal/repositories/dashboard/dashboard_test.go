@@ -1,8 +1,12 @@
 package dashboard
 
 import (
+	"errors"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
@@ -137,3 +141,161 @@ func TestGetDashboardVulnByTime(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+// rawSpy keeps the query and the arguments of the last raw call and fills the scanned entity with the result, since
+// the database mock ignores them
+type rawSpy struct {
+	*database.Mock
+	query  string
+	args   []interface{}
+	result interface{}
+	err    error
+}
+
+func (s *rawSpy) Raw(query string, entity interface{}, args ...interface{}) response.IResponse {
+	s.query = query
+	s.args = args
+
+	if s.result != nil {
+		reflect.ValueOf(entity).Elem().Set(reflect.ValueOf(s.result))
+	}
+
+	return response.NewResponse(1, s.err, entity)
+}
+
+func newApplicationAdminFilter() *dashboard.Filter {
+	return &dashboard.Filter{
+		StartTime: time.Now().Add(-time.Hour),
+		EndTime:   time.Now(),
+		Page:      2,
+		Size:      10,
+	}
+}
+
+func TestGetDashboardTotalWorkspaces(t *testing.T) {
+	t.Run("should count the workspaces of all workspaces when filter has no workspace", func(t *testing.T) {
+		spy := &rawSpy{Mock: &database.Mock{}, result: 3}
+		filter := newApplicationAdminFilter()
+
+		total, err := NewRepoDashboard(&database.Connection{Read: spy, Write: &database.Mock{}}).
+			GetDashboardTotalWorkspaces(filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Contains(t, spy.query, "FROM vulnerabilities_by_workspace")
+		assert.Contains(t, spy.query, "WHERE workspace_id IS NOT NULL AND created_at >= ? AND created_at <= ?")
+		assert.Equal(t, []interface{}{filter.StartTime, filter.EndTime}, spy.args)
+	})
+
+	t.Run("should count only the workspace of the filter when it is set", func(t *testing.T) {
+		spy := &rawSpy{Mock: &database.Mock{}, result: 1}
+		filter := newApplicationAdminFilter()
+		filter.WorkspaceID = uuid.New()
+
+		total, err := NewRepoDashboard(&database.Connection{Read: spy, Write: &database.Mock{}}).
+			GetDashboardTotalWorkspaces(filter)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Contains(t, spy.query, "WHERE workspace_id = ? AND created_at >= ? AND created_at <= ?")
+		assert.NotContains(t, spy.query, "IS NOT NULL")
+		assert.Equal(t, []interface{}{filter.WorkspaceID, filter.StartTime, filter.EndTime}, spy.args)
+	})
+
+	t.Run("should return error when failed to count workspaces", func(t *testing.T) {
+		spy := &rawSpy{Mock: &database.Mock{}, err: errors.New("test")}
+
+		_, err := NewRepoDashboard(&database.Connection{Read: spy, Write: &database.Mock{}}).
+			GetDashboardTotalWorkspaces(newApplicationAdminFilter())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDashboardVulnByWorkspace(t *testing.T) {
+	t.Run("should rank the workspaces of all workspaces by page and size", func(t *testing.T) {
+		workspaceID := uuid.New()
+		spy := &rawSpy{Mock: &database.Mock{}, result: []*dashboard.VulnerabilitiesByWorkspace{{
+			WorkspaceName: "test",
+			Vulnerability: dashboard.Vulnerability{WorkspaceID: workspaceID, CriticalVulnerability: 5},
+		}}}
+		filter := newApplicationAdminFilter()
+
+		vulns, err := NewRepoDashboard(&database.Connection{Read: spy, Write: &database.Mock{}}).
+			GetDashboardVulnByWorkspace(filter)
+
+		assert.NoError(t, err)
+		assert.Len(t, vulns, 1)
+		assert.Equal(t, workspaceID, vulns[0].WorkspaceID)
+		assert.Equal(t, "test", vulns[0].WorkspaceName)
+		assert.Equal(t, 5, vulns[0].CriticalVulnerability)
+		assert.Contains(t, spy.query, "FROM vulnerabilities_by_workspace")
+		assert.Contains(t, spy.query, "WHERE workspace_id IS NOT NULL AND created_at >= ? AND created_at <= ?")
+		assert.Contains(t, spy.query, "ORDER BY critical_vulnerability DESC")
+		assert.Contains(t, spy.query, "LIMIT ? OFFSET ?")
+		assert.Equal(t, []interface{}{filter.StartTime, filter.EndTime, 10, 20}, spy.args)
+	})
+
+	t.Run("should return error when failed to get vulns by workspace", func(t *testing.T) {
+		spy := &rawSpy{Mock: &database.Mock{}, err: errors.New("test")}
+
+		_, err := NewRepoDashboard(&database.Connection{Read: spy, Write: &database.Mock{}}).
+			GetDashboardVulnByWorkspace(newApplicationAdminFilter())
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDashboardVulnBySecurityTool(t *testing.T) {
+	t.Run("should return get vulns by security tool without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, []*dashboard.VulnerabilitiesBySecurityTool{}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		_, err := repository.GetDashboardVulnBySecurityTool(&dashboard.Filter{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetDashboardVulnByCWE(t *testing.T) {
+	t.Run("should return get vulns by cwe without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, []*dashboard.VulnerabilitiesByCWE{}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		_, err := repository.GetDashboardVulnByCWE(&dashboard.Filter{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetDashboardVulnByOWASP(t *testing.T) {
+	t.Run("should return get vulns by owasp without errors", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(
+			response.NewResponse(0, nil, []*dashboard.VulnerabilitiesByCWE{}))
+
+		connection := &database.Connection{
+			Read:  databaseReadMock,
+			Write: &database.Mock{},
+		}
+
+		repository := NewRepoDashboard(connection)
+
+		_, err := repository.GetDashboardVulnByOWASP(&dashboard.Filter{})
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,94 @@
+package risk
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+type IRepoRisk interface {
+	GetRepositoryCriticality(repositoryID uuid.UUID) (riskEnums.Criticality, error)
+	GetVulnerabilitiesFirstSeen(repositoryID uuid.UUID) ([]*risk.VulnerabilityFirstSeen, error)
+	GetRiskScoreRanking(filter *dashboard.Filter) ([]*risk.RiskScore, error)
+	GetRiskScoreByTime(filter *dashboard.Filter) ([]*risk.ScoreByTime, error)
+}
+
+type RepoRisk struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewRepoRisk(connection *database.Connection) IRepoRisk {
+	return &RepoRisk{
+		databaseRead: connection.Read,
+	}
+}
+
+func (r *RepoRisk) GetRepositoryCriticality(repositoryID uuid.UUID) (riskEnums.Criticality, error) {
+	criticality := &risk.RepositoryCriticality{}
+
+	err := r.databaseRead.Find(criticality, r.filterByRepositoryID(repositoryID),
+		riskEnums.TableRepositoryCriticality).GetErrorExceptNotFound()
+
+	return criticality.GetCriticality(), err
+}
+
+func (r *RepoRisk) GetVulnerabilitiesFirstSeen(
+	repositoryID uuid.UUID) (firstSeen []*risk.VulnerabilityFirstSeen, err error) {
+	return firstSeen, r.databaseRead.Find(&firstSeen, r.filterByRepositoryID(repositoryID),
+		riskEnums.TableVulnerabilitiesFirstSeen).GetErrorExceptNotFound()
+}
+
+func (r *RepoRisk) filterByRepositoryID(repositoryID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{riskEnums.ColumnRepositoryID: repositoryID}
+}
+
+func (r *RepoRisk) GetRiskScoreRanking(filter *dashboard.Filter) (scores []*risk.RiskScore, err error) {
+	condition, args := filter.GetConditionFilter()
+	limit, offset := filter.GetPagination()
+
+	query := fmt.Sprintf(r.queryGetRiskScoreRanking(), riskEnums.TableRiskScoreByRepository, condition)
+
+	return scores, r.databaseRead.Raw(query, &scores, append(args, limit, offset)...).GetErrorExceptNotFound()
+}
+
+func (r *RepoRisk) queryGetRiskScoreRanking() string {
+	return `
+		SELECT *
+		FROM (
+				SELECT DISTINCT ON (repository_id) *
+				FROM %[1]s
+				WHERE %[2]s
+				ORDER BY repository_id, created_at DESC
+		) AS result
+		ORDER BY score DESC
+		LIMIT ? OFFSET ?
+	`
+}
+
+func (r *RepoRisk) GetRiskScoreByTime(filter *dashboard.Filter) (scores []*risk.ScoreByTime, err error) {
+	condition, args := filter.GetConditionFilter()
+
+	query := fmt.Sprintf(r.queryGetRiskScoreByTime(), riskEnums.TableRiskScoreByRepository, condition)
+
+	return scores, r.databaseRead.Raw(query, &scores, args...).GetErrorExceptNotFound()
+}
+
+func (r *RepoRisk) queryGetRiskScoreByTime() string {
+	return `
+		SELECT DATE(created_at) AS created_at, SUM(score) AS score
+		FROM (
+				SELECT DISTINCT ON (repository_id, DATE(created_at)) *
+				FROM %[1]s
+				WHERE %[2]s
+				ORDER BY repository_id, DATE(created_at), created_at DESC
+		) AS result
+		GROUP BY DATE(created_at)
+		ORDER BY DATE(created_at)
+	`
+}
@@ -0,0 +1,36 @@
+package risk
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetRepositoryCriticality(_ uuid.UUID) (riskEnums.Criticality, error) {
+	args := m.MethodCalled("GetRepositoryCriticality")
+	return args.Get(0).(riskEnums.Criticality), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetVulnerabilitiesFirstSeen(_ uuid.UUID) ([]*risk.VulnerabilityFirstSeen, error) {
+	args := m.MethodCalled("GetVulnerabilitiesFirstSeen")
+	return args.Get(0).([]*risk.VulnerabilityFirstSeen), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetRiskScoreRanking(_ *dashboard.Filter) ([]*risk.RiskScore, error) {
+	args := m.MethodCalled("GetRiskScoreRanking")
+	return args.Get(0).([]*risk.RiskScore), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetRiskScoreByTime(_ *dashboard.Filter) ([]*risk.ScoreByTime, error) {
+	args := m.MethodCalled("GetRiskScoreByTime")
+	return args.Get(0).([]*risk.ScoreByTime), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,74 @@
+package risk
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+func TestGetRepositoryCriticality(t *testing.T) {
+	t.Run("should return medium criticality when not found", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Find").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRepoRisk(&database.Connection{Read: databaseReadMock})
+
+		criticality, err := repository.GetRepositoryCriticality(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, riskEnums.CriticalityMedium, criticality)
+	})
+
+	t.Run("should return error when failed to find criticality", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Find").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewRepoRisk(&database.Connection{Read: databaseReadMock})
+
+		_, err := repository.GetRepositoryCriticality(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestGetVulnerabilitiesFirstSeen(t *testing.T) {
+	t.Run("should return vulnerabilities first seen without error", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Find").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRepoRisk(&database.Connection{Read: databaseReadMock})
+
+		_, err := repository.GetVulnerabilitiesFirstSeen(uuid.New())
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetRiskScoreRanking(t *testing.T) {
+	t.Run("should return risk score ranking without error", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRepoRisk(&database.Connection{Read: databaseReadMock})
+
+		_, err := repository.GetRiskScoreRanking(&dashboard.Filter{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestGetRiskScoreByTime(t *testing.T) {
+	t.Run("should return risk score by time without error", func(t *testing.T) {
+		databaseReadMock := &database.Mock{}
+		databaseReadMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewRepoRisk(&database.Connection{Read: databaseReadMock})
+
+		_, err := repository.GetRiskScoreByTime(&dashboard.Filter{})
+		assert.NoError(t, err)
+	})
+}
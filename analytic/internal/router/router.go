@@ -9,9 +9,17 @@ import (
 
 	"github.com/ZupIT/horusec-platform/analytic/docs"
 	"github.com/ZupIT/horusec-platform/analytic/internal/enums/routes"
+	archiveEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/archive"
 	dashboardEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	metadataEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/metadata"
+	notificationEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/notification"
+	riskEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/risk"
+	transferEvents "github.com/ZupIT/horusec-platform/analytic/internal/events/transfer"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/risk"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 type IRouter interface {
@@ -22,20 +30,38 @@ type Router struct {
 	httpRouter.IRouter
 	swagger.ISwagger
 	middlewares.IAuthzMiddleware
+	permissionMiddleware.IMiddleware
 	healthHandler    *health.Handler
 	dashboardHandler *dashboard.Handler
 	dashboardEvents  *dashboardEvents.Events
+	riskHandler      *risk.Handler
+	riskEvents       *riskEvents.Events
+	archiveEvents    *archiveEvents.Events
+	metadataEvents   *metadataEvents.Events
+	transferEvents   *transferEvents.Events
+	notifyEvents     *notificationEvents.Events
 }
 
+//nolint:funlen // receives all the handlers and middlewares of the service
 func NewHTTPRouter(router httpRouter.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	healthHandler *health.Handler, dashboardHandler *dashboard.Handler, eventsDashboard *dashboardEvents.Events) IRouter {
+	healthHandler *health.Handler, dashboardHandler *dashboard.Handler, eventsDashboard *dashboardEvents.Events,
+	riskHandler *risk.Handler, eventsRisk *riskEvents.Events, eventsArchive *archiveEvents.Events,
+	eventsMetadata *metadataEvents.Events, eventsTransfer *transferEvents.Events,
+	eventsNotification *notificationEvents.Events, middlewarePermission permissionMiddleware.IMiddleware) IRouter {
 	requestRouter := &Router{
 		IRouter:          router,
 		IAuthzMiddleware: authzMiddleware,
+		IMiddleware:      middlewarePermission,
 		ISwagger:         swagger.NewSwagger(router.GetMux(), router.GetPort()),
 		healthHandler:    healthHandler,
 		dashboardHandler: dashboardHandler,
 		dashboardEvents:  eventsDashboard,
+		riskHandler:      riskHandler,
+		riskEvents:       eventsRisk,
+		archiveEvents:    eventsArchive,
+		metadataEvents:   eventsMetadata,
+		transferEvents:   eventsTransfer,
+		notifyEvents:     eventsNotification,
 	}
 
 	return requestRouter.setRoutes()
@@ -45,6 +71,8 @@ func (r *Router) setRoutes() IRouter {
 	r.routerHealth()
 	r.routerSwagger()
 	r.routerDashboardWorkspace()
+	r.routerDashboardApplicationAdmin()
+	r.routerRiskScoreWorkspace()
 
 	return r
 }
@@ -63,9 +91,35 @@ func (r *Router) routerSwagger() {
 }
 
 func (r *Router) routerDashboardWorkspace() {
+	canRead := r.HasPermission(permissionEnums.AnalyticRead)
+
 	r.Route(routes.DashboardWorkspaceRouter, func(router chi.Router) {
 		router.Options("/", r.dashboardHandler.Options)
-		router.With(r.IsWorkspaceAdmin).Get("/", r.dashboardHandler.GetAllChartsByWorkspace)
-		router.With(r.IsRepositoryMember).Get("/{repositoryID}", r.dashboardHandler.GetAllChartsByRepository)
+		router.With(canRead).Get("/", r.dashboardHandler.GetAllChartsByWorkspace)
+		router.With(canRead).Get("/{repositoryID}", r.dashboardHandler.GetAllChartsByRepository)
+		router.With(canRead).Get("/export/{format}", r.dashboardHandler.ExportChartsByWorkspace)
+		router.With(canRead).Get("/events", r.dashboardHandler.StreamByWorkspace)
+		router.With(canRead).Get("/{repositoryID}/events", r.dashboardHandler.StreamByRepository)
+		router.With(canRead).Get("/{repositoryID}/export/{format}",
+			r.dashboardHandler.ExportChartsByRepository)
+	})
+}
+
+func (r *Router) routerDashboardApplicationAdmin() {
+	r.Route(routes.DashboardApplicationAdmin, func(router chi.Router) {
+		router.Options("/", r.dashboardHandler.Options)
+		router.With(r.IsApplicationAdmin).Get("/", r.dashboardHandler.GetAllChartsByApplicationAdmin)
+	})
+}
+
+func (r *Router) routerRiskScoreWorkspace() {
+	canRead := r.HasPermission(permissionEnums.AnalyticRead)
+
+	r.Route(routes.RiskScoreWorkspaceRouter, func(router chi.Router) {
+		router.Options("/", r.riskHandler.Options)
+		router.With(canRead).Get("/", r.riskHandler.GetRiskScoreRanking)
+		router.With(canRead).Get("/time-series", r.riskHandler.GetRiskScoreByTimeByWorkspace)
+		router.With(canRead).Get("/{repositoryID}/time-series",
+			r.riskHandler.GetRiskScoreByTimeByRepository)
 	})
 }
@@ -6,12 +6,20 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
+	eventArchive "github.com/ZupIT/horusec-platform/analytic/internal/events/archive"
 	eventDashboard "github.com/ZupIT/horusec-platform/analytic/internal/events/dashboard"
+	eventMetadata "github.com/ZupIT/horusec-platform/analytic/internal/events/metadata"
+	eventNotification "github.com/ZupIT/horusec-platform/analytic/internal/events/notification"
+	eventRisk "github.com/ZupIT/horusec-platform/analytic/internal/events/risk"
+	eventTransfer "github.com/ZupIT/horusec-platform/analytic/internal/events/transfer"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/dashboard"
 	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/analytic/internal/handlers/risk"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
@@ -21,7 +29,15 @@ func TestNewHTTPRouter(t *testing.T) {
 		dashboardHandlerMock := &dashboard.Handler{}
 		middlewareMock := &middlewares.AuthzMiddleware{}
 		eventMock := &eventDashboard.Events{}
-		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, dashboardHandlerMock, eventMock)
+		riskHandlerMock := &risk.Handler{}
+		riskEventMock := &eventRisk.Events{}
+		archiveEventMock := &eventArchive.Events{}
+		metadataEventMock := &eventMetadata.Events{}
+		transferEventMock := &eventTransfer.Events{}
+		notificationEventMock := &eventNotification.Events{}
+		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, dashboardHandlerMock, eventMock,
+			riskHandlerMock, riskEventMock, archiveEventMock, metadataEventMock,
+			transferEventMock, notificationEventMock, permissionMiddleware.NewPermissionMiddleware(&proto.Mock{}))
 		assert.NotEmpty(t, instance)
 	})
 }
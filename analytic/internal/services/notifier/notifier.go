@@ -0,0 +1,68 @@
+package notifier
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+)
+
+type INotifier interface {
+	Subscribe(workspaceID, repositoryID uuid.UUID) *notification.Subscription
+	Unsubscribe(subscription *notification.Subscription)
+	Notify(notification *notification.Notification)
+}
+
+// Notifier keeps the open dashboard subscriptions of this instance in memory, notifications are received from the
+// dashboard changed fanout exchange, so clients connected to any instance are notified
+type Notifier struct {
+	mutex         sync.RWMutex
+	subscriptions map[uuid.UUID]*notification.Subscription
+}
+
+func NewNotifier() INotifier {
+	return &Notifier{
+		subscriptions: map[uuid.UUID]*notification.Subscription{},
+	}
+}
+
+func (n *Notifier) Subscribe(workspaceID, repositoryID uuid.UUID) *notification.Subscription {
+	subscription := notification.NewSubscription(workspaceID, repositoryID)
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.subscriptions[subscription.SubscriptionID] = subscription
+	return subscription
+}
+
+func (n *Notifier) Unsubscribe(subscription *notification.Subscription) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if _, ok := n.subscriptions[subscription.SubscriptionID]; ok {
+		delete(n.subscriptions, subscription.SubscriptionID)
+		close(subscription.Notifications)
+	}
+}
+
+func (n *Notifier) Notify(newNotification *notification.Notification) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	for _, subscription := range n.subscriptions {
+		if subscription.IsSubscribedTo(newNotification) {
+			n.send(subscription, newNotification)
+		}
+	}
+}
+
+// send drops the notification when the subscriber is not consuming fast enough, avoiding a slow client to block
+// the analysis processing
+func (n *Notifier) send(subscription *notification.Subscription, newNotification *notification.Notification) {
+	select {
+	case subscription.Notifications <- newNotification:
+	default:
+	}
+}
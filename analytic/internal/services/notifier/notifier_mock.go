@@ -0,0 +1,25 @@
+package notifier
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Subscribe(_, _ uuid.UUID) *notification.Subscription {
+	args := m.MethodCalled("Subscribe")
+	return args.Get(0).(*notification.Subscription)
+}
+
+func (m *Mock) Unsubscribe(_ *notification.Subscription) {
+	_ = m.MethodCalled("Unsubscribe")
+}
+
+func (m *Mock) Notify(_ *notification.Notification) {
+	_ = m.MethodCalled("Notify")
+}
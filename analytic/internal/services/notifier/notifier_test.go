@@ -0,0 +1,60 @@
+package notifier
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/notification"
+	notificationEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/notification"
+)
+
+func TestNewNotifier(t *testing.T) {
+	t.Run("should success create a new notifier", func(t *testing.T) {
+		assert.NotNil(t, NewNotifier())
+	})
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Run("should success subscribe and unsubscribe closing the channel", func(t *testing.T) {
+		notifier := NewNotifier()
+
+		subscription := notifier.Subscribe(uuid.New(), uuid.Nil)
+		assert.NotNil(t, subscription)
+
+		notifier.Unsubscribe(subscription)
+		notifier.Unsubscribe(subscription)
+
+		_, ok := <-subscription.Notifications
+		assert.False(t, ok)
+	})
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("should deliver notification only to the subscriptions of the same workspace", func(t *testing.T) {
+		notifier := NewNotifier()
+		workspaceID := uuid.New()
+
+		subscription := notifier.Subscribe(workspaceID, uuid.Nil)
+		otherSubscription := notifier.Subscribe(uuid.New(), uuid.Nil)
+
+		notifier.Notify(&notification.Notification{WorkspaceID: workspaceID})
+
+		assert.Len(t, subscription.Notifications, 1)
+		assert.Len(t, otherSubscription.Notifications, 0)
+	})
+
+	t.Run("should drop notifications when subscription buffer is full", func(t *testing.T) {
+		notifier := NewNotifier()
+		workspaceID := uuid.New()
+
+		subscription := notifier.Subscribe(workspaceID, uuid.Nil)
+
+		for i := 0; i <= notificationEnums.SubscriptionBufferSize; i++ {
+			notifier.Notify(&notification.Notification{WorkspaceID: workspaceID})
+		}
+
+		assert.Len(t, subscription.Notifications, notificationEnums.SubscriptionBufferSize)
+	})
+}
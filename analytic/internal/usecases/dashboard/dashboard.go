@@ -2,13 +2,18 @@ package dashboard
 
 import (
 	"net/http"
+	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 
 	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/languages"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
 
 	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	"github.com/ZupIT/horusec-platform/analytic/internal/enums/owasp"
 )
 
 type IUseCases interface {
@@ -18,6 +23,11 @@ type IUseCases interface {
 		analysis *analysisEntities.Analysis) []*dashboard.VulnerabilitiesByRepository
 	ParseAnalysisToVulnerabilitiesByLanguage(analysis *analysisEntities.Analysis) []*dashboard.VulnerabilitiesByLanguage
 	ParseAnalysisToVulnerabilitiesByTime(analysis *analysisEntities.Analysis) *dashboard.VulnerabilitiesByTime
+	ParseAnalysisToVulnerabilitiesByWorkspace(analysis *analysisEntities.Analysis) *dashboard.VulnerabilitiesByWorkspace
+	ParseAnalysisToVulnerabilitiesBySecurityTool(
+		analysis *analysisEntities.Analysis) []*dashboard.VulnerabilitiesBySecurityTool
+	ParseAnalysisToVulnerabilitiesByCWE(analysis *analysisEntities.Analysis) []*dashboard.VulnerabilitiesByCWE
+	ApplicationAdminFilterFromRequest(request *http.Request) (*dashboard.Filter, error)
 }
 type UseCases struct{}
 
@@ -160,6 +170,117 @@ func (u *UseCases) ParseAnalysisToVulnerabilitiesByTime(
 	return vulnsByTime
 }
 
+func (u *UseCases) ParseAnalysisToVulnerabilitiesByWorkspace(
+	analysis *analysisEntities.Analysis) *dashboard.VulnerabilitiesByWorkspace {
+	vulnsByWorkspace := &dashboard.VulnerabilitiesByWorkspace{
+		WorkspaceName: analysis.WorkspaceName,
+		Vulnerability: u.newVulnerabilityFromAnalysis(analysis),
+	}
+
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnsByWorkspace.AddCountVulnerabilityBySeverity(analysis.AnalysisVulnerabilities[index].Vulnerability.Severity,
+			analysis.AnalysisVulnerabilities[index].Vulnerability.Type)
+	}
+
+	return vulnsByWorkspace
+}
+
+func (u *UseCases) ParseAnalysisToVulnerabilitiesBySecurityTool(
+	analysis *analysisEntities.Analysis) []*dashboard.VulnerabilitiesBySecurityTool {
+	mapVulnBySecurityTool := map[tools.Tool]*dashboard.VulnerabilitiesBySecurityTool{}
+
+	for index := range analysis.AnalysisVulnerabilities {
+		vuln := analysis.AnalysisVulnerabilities[index].Vulnerability
+		if _, ok := mapVulnBySecurityTool[vuln.SecurityTool]; !ok {
+			mapVulnBySecurityTool[vuln.SecurityTool] = &dashboard.VulnerabilitiesBySecurityTool{
+				SecurityTool:  vuln.SecurityTool,
+				Vulnerability: u.newVulnerabilityFromAnalysis(analysis),
+			}
+		}
+
+		mapVulnBySecurityTool[vuln.SecurityTool].AddCountVulnerabilityBySeverity(vuln.Severity, vuln.Type)
+	}
+
+	return u.mapVulnBySecurityToolToSlice(mapVulnBySecurityTool)
+}
+
+func (u *UseCases) mapVulnBySecurityToolToSlice(
+	mapVulnBySecurityTool map[tools.Tool]*dashboard.VulnerabilitiesBySecurityTool) (
+	sliceVulnsBySecurityTool []*dashboard.VulnerabilitiesBySecurityTool) {
+	for _, vulnsBySecurityTool := range mapVulnBySecurityTool {
+		sliceVulnsBySecurityTool = append(sliceVulnsBySecurityTool, vulnsBySecurityTool)
+	}
+
+	return sliceVulnsBySecurityTool
+}
+
+func (u *UseCases) ParseAnalysisToVulnerabilitiesByCWE(
+	analysis *analysisEntities.Analysis) []*dashboard.VulnerabilitiesByCWE {
+	mapVulnByCWE := map[string]*dashboard.VulnerabilitiesByCWE{}
+
+	for index := range analysis.AnalysisVulnerabilities {
+		vuln := analysis.AnalysisVulnerabilities[index].Vulnerability
+		cwe := u.getCWEFromDetails(vuln.Details)
+
+		if _, ok := mapVulnByCWE[cwe]; !ok {
+			mapVulnByCWE[cwe] = u.newVulnerabilitiesByCWE(analysis, cwe, vuln.SecurityTool)
+		}
+
+		mapVulnByCWE[cwe].AddCountVulnerabilityBySeverity(vuln.Severity, vuln.Type)
+	}
+
+	return u.mapVulnByCWEToSlice(mapVulnByCWE)
+}
+
+func (u *UseCases) newVulnerabilitiesByCWE(analysis *analysisEntities.Analysis, cwe string,
+	tool tools.Tool) *dashboard.VulnerabilitiesByCWE {
+	return &dashboard.VulnerabilitiesByCWE{
+		CWE:           cwe,
+		OWASPCategory: u.getOWASPCategory(cwe, tool).ToString(),
+		Vulnerability: u.newVulnerabilityFromAnalysis(analysis),
+	}
+}
+
+func (u *UseCases) mapVulnByCWEToSlice(mapVulnByCWE map[string]*dashboard.VulnerabilitiesByCWE) (
+	sliceVulnsByCWE []*dashboard.VulnerabilitiesByCWE) {
+	for _, vulnsByCWE := range mapVulnByCWE {
+		sliceVulnsByCWE = append(sliceVulnsByCWE, vulnsByCWE)
+	}
+
+	return sliceVulnsByCWE
+}
+
+// getCWEFromDetails returns the first cwe referenced in the vulnerability details, the tools usually
+// write it as "CWE-89", "CWE: 89" or inside the rule metadata as "cwe/89"
+func (u *UseCases) getCWEFromDetails(details string) string {
+	match := regexp.MustCompile(dashboardEnums.RegexCWE).FindStringSubmatch(details)
+	if len(match) != dashboardEnums.RegexCWEMatchLength || strings.TrimLeft(match[1], "0") == "" {
+		return dashboardEnums.UnclassifiedCWE
+	}
+
+	return dashboardEnums.PrefixCWE + strings.TrimLeft(match[1], "0")
+}
+
+// getOWASPCategory when the finding has no cwe but comes from a dependency audit tool it is classified as a
+// vulnerable and outdated component
+func (u *UseCases) getOWASPCategory(cwe string, tool tools.Tool) owasp.Category {
+	category := owasp.GetCategoryByCWE(cwe)
+	if category == owasp.Unclassified && u.isDependencyAuditTool(tool) {
+		return owasp.VulnerableComponents
+	}
+
+	return category
+}
+
+func (u *UseCases) isDependencyAuditTool(tool tools.Tool) bool {
+	switch tool {
+	case tools.NpmAudit, tools.YarnAudit, tools.Safety, tools.BundlerAudit, tools.MixAudit:
+		return true
+	}
+
+	return false
+}
+
 func (u *UseCases) newVulnerabilityFromAnalysis(analysis *analysisEntities.Analysis) dashboard.Vulnerability {
 	return dashboard.Vulnerability{
 		VulnerabilityID: uuid.New(),
@@ -180,5 +301,17 @@ func (u *UseCases) FilterFromRequest(request *http.Request) (*dashboard.Filter,
 		return nil, err
 	}
 
+	filter.SetLabels(request)
 	return filter, filter.Validate()
 }
+
+func (u *UseCases) ApplicationAdminFilterFromRequest(request *http.Request) (*dashboard.Filter, error) {
+	filter := &dashboard.Filter{}
+
+	if err := filter.SetDateRangeAndPagination(request); err != nil {
+		return nil, err
+	}
+
+	filter.SetLabels(request)
+	return filter, filter.ValidateApplicationAdmin()
+}
@@ -121,6 +121,81 @@ func TestParseAnalysisToVulnerabilitiesByTime(t *testing.T) {
 	})
 }
 
+func TestParseAnalysisToVulnerabilitiesByWorkspace(t *testing.T) {
+	t.Run("should success parse without errors", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		analysis := getAnalysisMock()
+		analysis.WorkspaceName = "test"
+
+		result := useCases.ParseAnalysisToVulnerabilitiesByWorkspace(analysis)
+		assert.Equal(t, "test", result.WorkspaceName)
+		assert.Equal(t, analysis.WorkspaceID, result.WorkspaceID)
+		assert.Equal(t, analysis.RepositoryID, result.RepositoryID)
+		assert.Equal(t, 2, result.CriticalVulnerability)
+		assert.Equal(t, 0, result.HighVulnerability)
+	})
+}
+
+func TestParseAnalysisToVulnerabilitiesBySecurityTool(t *testing.T) {
+	t.Run("should success parse without errors", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		result := useCases.ParseAnalysisToVulnerabilitiesBySecurityTool(getAnalysisMock())
+		assert.Len(t, result, 1)
+		assert.Equal(t, tools.HorusecEngine, result[0].SecurityTool)
+	})
+}
+
+func TestParseAnalysisToVulnerabilitiesByCWE(t *testing.T) {
+	t.Run("should success parse without errors grouping by cwe", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		analysis := getAnalysisMock()
+		analysis.AnalysisVulnerabilities[0].Vulnerability.Details = "SQL Injection. For more information checkout " +
+			"the CWE-089 (https://cwe.mitre.org/data/definitions/89.html) advisory."
+		analysis.AnalysisVulnerabilities[1].Vulnerability.Details = "Use of hard-coded password"
+
+		result := useCases.ParseAnalysisToVulnerabilitiesByCWE(analysis)
+		assert.Len(t, result, 2)
+
+		for _, vulns := range result {
+			if vulns.CWE == "CWE-89" {
+				assert.Equal(t, "A03:2021-Injection", vulns.OWASPCategory)
+				continue
+			}
+
+			assert.Equal(t, "UNCLASSIFIED", vulns.CWE)
+			assert.Equal(t, "UNCLASSIFIED", vulns.OWASPCategory)
+		}
+	})
+
+	t.Run("should classify dependency audit findings without cwe as vulnerable components", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		analysis := getAnalysisMock()
+		analysis.AnalysisVulnerabilities = analysis.AnalysisVulnerabilities[:1]
+		analysis.AnalysisVulnerabilities[0].Vulnerability.Details = "lodash prototype pollution"
+		analysis.AnalysisVulnerabilities[0].Vulnerability.SecurityTool = tools.NpmAudit
+
+		result := useCases.ParseAnalysisToVulnerabilitiesByCWE(analysis)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "A06:2021-Vulnerable and Outdated Components", result[0].OWASPCategory)
+	})
+}
+
+func TestGetCWEFromDetails(t *testing.T) {
+	t.Run("should extract cwe from the different formats used by the tools", func(t *testing.T) {
+		useCases := &UseCases{}
+
+		assert.Equal(t, "CWE-312", useCases.getCWEFromDetails("For more information checkout the CWE-312"))
+		assert.Equal(t, "CWE-79", useCases.getCWEFromDetails("cwe: 79 cross site scripting"))
+		assert.Equal(t, "CWE-22", useCases.getCWEFromDetails("rule metadata cwe/22"))
+		assert.Equal(t, "UNCLASSIFIED", useCases.getCWEFromDetails("CWE-000"))
+		assert.Equal(t, "UNCLASSIFIED", useCases.getCWEFromDetails("without classification"))
+	})
+}
+
 func TestFilterFromRequest(t *testing.T) {
 	layoutDateTime := "2006-01-02T15:04:05Z"
 	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
@@ -181,3 +256,47 @@ func TestFilterFromRequest(t *testing.T) {
 		assert.Nil(t, filter)
 	})
 }
+
+func TestApplicationAdminFilterFromRequest(t *testing.T) {
+	layoutDateTime := "2006-01-02T15:04:05Z"
+	startTime, _ := time.Parse(layoutDateTime, "2020-01-01T00:00:00Z")
+	endTime, _ := time.Parse(layoutDateTime, "2022-01-01T00:00:00Z")
+
+	t.Run("should success create a new filter without workspace from request data", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s&page=%v&size=%v",
+			startTime.Format(layoutDateTime), endTime.Format(layoutDateTime), 1, 18)
+
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		filter, err := useCases.ApplicationAdminFilterFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, startTime, filter.StartTime)
+		assert.Equal(t, endTime, filter.EndTime)
+		assert.Equal(t, 1, filter.Page)
+		assert.Equal(t, 18, filter.Size)
+		assert.Equal(t, uuid.Nil, filter.WorkspaceID)
+	})
+
+	t.Run("should return error when failed to set date range", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		url := fmt.Sprintf("/test?initialDate=%s&finalDate=%s", startTime.Format(layoutDateTime), "test")
+
+		r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+		filter, err := useCases.ApplicationAdminFilterFromRequest(r)
+		assert.Error(t, err)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("should return error when missing date range", func(t *testing.T) {
+		useCases := NewUseCaseDashboard()
+
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+
+		_, err := useCases.ApplicationAdminFilterFromRequest(r)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,239 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
+	"github.com/google/uuid"
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	exportEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/export"
+)
+
+type IUseCases interface {
+	ExportCharts(format exportEnums.Format, response *dashboard.Response, filter *dashboard.Filter,
+		chartName string) ([]byte, error)
+	ToCSV(chart *dashboard.Chart) ([]byte, error)
+	ToXLSX(charts []*dashboard.Chart) ([]byte, error)
+	ToPDF(filter *dashboard.Filter, charts []*dashboard.Chart) ([]byte, error)
+}
+
+type UseCases struct{}
+
+func NewUseCaseExport() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) ExportCharts(format exportEnums.Format, response *dashboard.Response,
+	filter *dashboard.Filter, chartName string) ([]byte, error) {
+	switch format {
+	case exportEnums.CSV:
+		return u.exportChartToCSV(response, chartName)
+	case exportEnums.XLSX:
+		return u.ToXLSX(response.ToCharts())
+	case exportEnums.PDF:
+		return u.ToPDF(filter, response.ToCharts())
+	}
+
+	return nil, exportEnums.ErrorInvalidExportFormat
+}
+
+func (u *UseCases) exportChartToCSV(response *dashboard.Response, chartName string) ([]byte, error) {
+	chart := response.GetChartByName(chartName)
+	if chart == nil {
+		return nil, exportEnums.ErrorInvalidChart
+	}
+
+	return u.ToCSV(chart)
+}
+
+func (u *UseCases) ToCSV(chart *dashboard.Chart) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+
+	if err := writer.Write(chart.Header); err != nil {
+		return nil, err
+	}
+
+	for _, row := range chart.Rows {
+		if err := writer.Write(u.escapeFormulas(row)); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+// escapeFormulas prefixes with a quote the values that spreadsheets would run as formulas, like author emails and
+// repository names sent by the cli starting with =, +, - or @
+func (u *UseCases) escapeFormulas(row []string) []string {
+	escaped := make([]string, len(row))
+
+	for index, value := range row {
+		escaped[index] = u.escapeFormula(value)
+	}
+
+	return escaped
+}
+
+func (u *UseCases) escapeFormula(value string) string {
+	if _, err := strconv.Atoi(value); err == nil {
+		return value
+	}
+
+	if value != "" && strings.ContainsAny(value[:1], exportEnums.FormulaPrefixes) {
+		return exportEnums.FormulaEscape + value
+	}
+
+	return value
+}
+
+func (u *UseCases) ToXLSX(charts []*dashboard.Chart) ([]byte, error) {
+	file := excelize.NewFile()
+
+	for _, chart := range charts {
+		if err := u.addChartToSheet(file, chart); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.writeXLSXFile(file)
+}
+
+func (u *UseCases) writeXLSXFile(file *excelize.File) ([]byte, error) {
+	file.DeleteSheet(exportEnums.DefaultXLSXSheet)
+	file.SetActiveSheet(0)
+
+	buffer, err := file.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (u *UseCases) addChartToSheet(file *excelize.File, chart *dashboard.Chart) error {
+	file.NewSheet(chart.Name)
+
+	if err := u.setSheetRow(file, chart.Name, 1, u.toSheetRow(chart.Header)); err != nil {
+		return err
+	}
+
+	for index, row := range chart.Rows {
+		if err := u.setSheetRow(file, chart.Name, index+2, u.toSheetRow(row)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *UseCases) setSheetRow(file *excelize.File, sheet string, row int, values []interface{}) error {
+	cell, err := excelize.CoordinatesToCellName(1, row)
+	if err != nil {
+		return err
+	}
+
+	return file.SetSheetRow(sheet, cell, &values)
+}
+
+func (u *UseCases) toSheetRow(row []string) (values []interface{}) {
+	for _, value := range row {
+		if number, err := strconv.Atoi(value); err == nil {
+			values = append(values, number)
+			continue
+		}
+
+		values = append(values, u.escapeFormula(value))
+	}
+
+	return values
+}
+
+func (u *UseCases) ToPDF(filter *dashboard.Filter, charts []*dashboard.Chart) ([]byte, error) {
+	pdf := u.newPDF()
+
+	// the core fonts are encoded in cp1252, so the utf-8 values like author names must be translated
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+	u.addPDFTitle(pdf, filter, translate)
+
+	for _, chart := range charts {
+		u.addPDFTable(pdf, chart, translate)
+	}
+
+	return u.writePDF(pdf)
+}
+
+func (u *UseCases) writePDF(pdf *gofpdf.Fpdf) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	if err := pdf.Output(buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (u *UseCases) newPDF() *gofpdf.Fpdf {
+	pdf := gofpdf.New(exportEnums.PDFOrientation, exportEnums.PDFUnit, exportEnums.PDFPageSize, "")
+	pdf.SetFillColor(exportEnums.PDFHeaderFillColor, exportEnums.PDFHeaderFillColor, exportEnums.PDFHeaderFillColor)
+	pdf.AddPage()
+
+	return pdf
+}
+
+func (u *UseCases) addPDFTitle(pdf *gofpdf.Fpdf, filter *dashboard.Filter, translate func(string) string) {
+	pdf.SetFont(exportEnums.PDFFontFamily, "B", exportEnums.PDFTitleFontSize)
+	pdf.CellFormat(0, exportEnums.PDFTitleLineHeight, exportEnums.ReportTitle, "", 1, "L", false, 0, "")
+
+	pdf.SetFont(exportEnums.PDFFontFamily, "", exportEnums.PDFSubTitleFontSize)
+	pdf.CellFormat(0, exportEnums.PDFTableLineHeight, translate(u.getReportDescription(filter)), "", 1, "L", false,
+		0, "")
+	pdf.Ln(exportEnums.PDFTableLineHeight)
+}
+
+func (u *UseCases) getReportDescription(filter *dashboard.Filter) string {
+	description := fmt.Sprintf("Period: %s - %s", filter.StartTime.Format("2006-01-02"),
+		filter.EndTime.Format("2006-01-02"))
+
+	if filter.WorkspaceID != uuid.Nil {
+		description += fmt.Sprintf(" | Workspace: %s", filter.WorkspaceID)
+	}
+
+	if filter.RepositoryID != uuid.Nil {
+		description += fmt.Sprintf(" | Repository: %s", filter.RepositoryID)
+	}
+
+	return description
+}
+
+func (u *UseCases) addPDFTable(pdf *gofpdf.Fpdf, chart *dashboard.Chart, translate func(string) string) {
+	columnWidth := float64(exportEnums.PDFPageWidth) / float64(len(chart.Header))
+
+	pdf.SetFont(exportEnums.PDFFontFamily, "B", exportEnums.PDFSubTitleFontSize)
+	pdf.CellFormat(0, exportEnums.PDFTitleLineHeight, translate(chart.Name), "", 1, "L", false, 0, "")
+
+	pdf.SetFont(exportEnums.PDFFontFamily, "B", exportEnums.PDFTableFontSize)
+	u.addPDFTableRow(pdf, chart.Header, columnWidth, true, translate)
+
+	pdf.SetFont(exportEnums.PDFFontFamily, "", exportEnums.PDFTableFontSize)
+	for _, row := range chart.Rows {
+		u.addPDFTableRow(pdf, row, columnWidth, false, translate)
+	}
+
+	pdf.Ln(exportEnums.PDFTableLineHeight)
+}
+
+func (u *UseCases) addPDFTableRow(pdf *gofpdf.Fpdf, row []string, columnWidth float64, isHeader bool,
+	translate func(string) string) {
+	for _, value := range row {
+		pdf.CellFormat(columnWidth, exportEnums.PDFTableLineHeight, translate(value), "1", 0, "C", isHeader, 0, "")
+	}
+
+	pdf.Ln(-1)
+}
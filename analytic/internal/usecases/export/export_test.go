@@ -0,0 +1,152 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
+	"github.com/google/uuid"
+	"github.com/jung-kurt/gofpdf"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/dashboard"
+	dashboardEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/dashboard"
+	exportEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/export"
+)
+
+func getResponseMock() *dashboard.Response {
+	vulnerability := &dashboard.Vulnerability{CriticalVulnerability: 2}
+
+	return &dashboard.Response{
+		TotalAuthors:            1,
+		TotalRepositories:       1,
+		VulnerabilityBySeverity: vulnerability.ToResponseBySeverities(),
+		VulnerabilitiesByAuthor: []dashboard.ByAuthor{
+			{Author: "test@horusec.io", BySeverities: vulnerability.ToResponseBySeverities()},
+		},
+	}
+}
+
+func getFilterMock() *dashboard.Filter {
+	return &dashboard.Filter{
+		WorkspaceID:  uuid.New(),
+		RepositoryID: uuid.New(),
+		StartTime:    time.Now(),
+		EndTime:      time.Now(),
+	}
+}
+
+func TestExportCharts(t *testing.T) {
+	t.Run("should export chart to csv", func(t *testing.T) {
+		useCases := NewUseCaseExport()
+
+		content, err := useCases.ExportCharts(exportEnums.CSV, getResponseMock(), getFilterMock(),
+			dashboardEnums.ChartVulnerabilitiesByAuthor)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "AUTHOR,CRITICAL,HIGH,MEDIUM,LOW,INFO,UNKNOWN,TOTAL\ntest@horusec.io,2,0,0,0,0,0,2\n",
+			string(content))
+	})
+
+	t.Run("should return error when invalid chart to csv", func(t *testing.T) {
+		useCases := NewUseCaseExport()
+
+		_, err := useCases.ExportCharts(exportEnums.CSV, getResponseMock(), getFilterMock(), "test")
+
+		assert.Equal(t, exportEnums.ErrorInvalidChart, err)
+	})
+
+	t.Run("should export charts to xlsx with one sheet per chart", func(t *testing.T) {
+		useCases := NewUseCaseExport()
+
+		content, err := useCases.ExportCharts(exportEnums.XLSX, getResponseMock(), getFilterMock(), "")
+		assert.NoError(t, err)
+
+		file, err := excelize.OpenReader(bytes.NewReader(content))
+		assert.NoError(t, err)
+		assert.Len(t, file.GetSheetList(), 9)
+
+		value, err := file.GetCellValue(dashboardEnums.ChartVulnerabilitiesByAuthor, "A2")
+		assert.NoError(t, err)
+		assert.Equal(t, "test@horusec.io", value)
+	})
+
+	t.Run("should export charts to pdf", func(t *testing.T) {
+		useCases := NewUseCaseExport()
+
+		content, err := useCases.ExportCharts(exportEnums.PDF, getResponseMock(), getFilterMock(), "")
+
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(content, []byte("%PDF")))
+	})
+
+	t.Run("should return error when invalid format", func(t *testing.T) {
+		useCases := NewUseCaseExport()
+
+		_, err := useCases.ExportCharts("test", getResponseMock(), getFilterMock(), "")
+
+		assert.Equal(t, exportEnums.ErrorInvalidExportFormat, err)
+	})
+}
+
+func getInjectionChartMock() *dashboard.Chart {
+	return &dashboard.Chart{
+		Name:   dashboardEnums.ChartVulnerabilitiesByAuthor,
+		Header: []string{"AUTHOR", "TOTAL"},
+		Rows: [][]string{
+			{"=HYPERLINK(\"http://evil\")", "1"},
+			{"+1+1", "2"},
+			{"-1+1", "-3"},
+			{"@SUM(A1)", "4"},
+			{"test@horusec.io", "5"},
+		},
+	}
+}
+
+func TestToCSV(t *testing.T) {
+	t.Run("should escape the values that would run as formulas", func(t *testing.T) {
+		content, err := NewUseCaseExport().ToCSV(getInjectionChartMock())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "AUTHOR,TOTAL\n\"'=HYPERLINK(\"\"http://evil\"\")\",1\n'+1+1,2\n'-1+1,-3\n'@SUM(A1),4\n"+
+			"test@horusec.io,5\n", string(content))
+	})
+}
+
+func TestToXLSX(t *testing.T) {
+	t.Run("should escape the values that would run as formulas", func(t *testing.T) {
+		content, err := NewUseCaseExport().ToXLSX([]*dashboard.Chart{getInjectionChartMock()})
+		assert.NoError(t, err)
+
+		file, err := excelize.OpenReader(bytes.NewReader(content))
+		assert.NoError(t, err)
+
+		for cell, expected := range map[string]string{"A2": "'=HYPERLINK(\"http://evil\")", "A3": "'+1+1",
+			"A4": "'-1+1", "A5": "'@SUM(A1)", "A6": "test@horusec.io", "B4": "-3"} {
+			value, err := file.GetCellValue(dashboardEnums.ChartVulnerabilitiesByAuthor, cell)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, value)
+		}
+
+		formula, err := file.GetCellFormula(dashboardEnums.ChartVulnerabilitiesByAuthor, "A2")
+		assert.NoError(t, err)
+		assert.Empty(t, formula)
+	})
+}
+
+func TestAddPDFTable(t *testing.T) {
+	t.Run("should translate the utf-8 values to the encoding of the pdf font", func(t *testing.T) {
+		pdf := gofpdf.New(exportEnums.PDFOrientation, exportEnums.PDFUnit, exportEnums.PDFPageSize, "")
+		pdf.SetCompression(false)
+		pdf.AddPage()
+
+		(&UseCases{}).addPDFTable(pdf, &dashboard.Chart{Name: "test", Header: []string{"AUTHOR"},
+			Rows: [][]string{{"José Conceição"}}}, pdf.UnicodeTranslatorFromDescriptor(""))
+
+		buffer := &bytes.Buffer{}
+		assert.NoError(t, pdf.Output(buffer))
+		assert.Contains(t, buffer.String(), "Jos\xe9 Concei\xe7\xe3o")
+		assert.NotContains(t, buffer.String(), "José")
+	})
+}
@@ -0,0 +1,104 @@
+package risk
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+type IUseCases interface {
+	ParseAnalysisToRiskScore(analysis *analysisEntities.Analysis, criticality riskEnums.Criticality,
+		firstSeen []*risk.VulnerabilityFirstSeen) *risk.RiskScore
+	ParseAnalysisToVulnerabilitiesFirstSeen(analysis *analysisEntities.Analysis,
+		firstSeen []*risk.VulnerabilityFirstSeen) []*risk.VulnerabilityFirstSeen
+}
+
+type UseCases struct {
+	model *risk.Model
+}
+
+func NewUseCaseRisk() IUseCases {
+	return &UseCases{
+		model: risk.NewModel(),
+	}
+}
+
+func (u *UseCases) ParseAnalysisToRiskScore(analysis *analysisEntities.Analysis, criticality riskEnums.Criticality,
+	firstSeen []*risk.VulnerabilityFirstSeen) *risk.RiskScore {
+	firstSeenByHash := u.mapFirstSeenByHash(firstSeen)
+	riskScore := u.newRiskScore(analysis, criticality)
+
+	for index := range analysis.AnalysisVulnerabilities {
+		vuln := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vuln.Type != vulnerability.Vulnerability {
+			continue
+		}
+
+		riskScore.AddVulnerabilityScore(u.model.GetVulnerabilityScore(vuln.Severity,
+			u.getVulnerabilityAge(analysis.CreatedAt, firstSeenByHash[vuln.VulnHash])))
+	}
+
+	return riskScore.SetScoreByModel(u.model)
+}
+
+func (u *UseCases) newRiskScore(analysis *analysisEntities.Analysis,
+	criticality riskEnums.Criticality) *risk.RiskScore {
+	return &risk.RiskScore{
+		RiskScoreID:    uuid.New(),
+		CreatedAt:      analysis.CreatedAt,
+		WorkspaceID:    analysis.WorkspaceID,
+		RepositoryID:   analysis.RepositoryID,
+		RepositoryName: analysis.RepositoryName,
+		Criticality:    criticality,
+	}
+}
+
+func (u *UseCases) getVulnerabilityAge(analysisCreatedAt, firstSeenAt time.Time) time.Duration {
+	if firstSeenAt.IsZero() {
+		return 0
+	}
+
+	return analysisCreatedAt.Sub(firstSeenAt)
+}
+
+func (u *UseCases) mapFirstSeenByHash(firstSeen []*risk.VulnerabilityFirstSeen) map[string]time.Time {
+	firstSeenByHash := map[string]time.Time{}
+
+	for _, vulnFirstSeen := range firstSeen {
+		firstSeenByHash[vulnFirstSeen.VulnHash] = vulnFirstSeen.CreatedAt
+	}
+
+	return firstSeenByHash
+}
+
+func (u *UseCases) ParseAnalysisToVulnerabilitiesFirstSeen(analysis *analysisEntities.Analysis,
+	firstSeen []*risk.VulnerabilityFirstSeen) (newFirstSeen []*risk.VulnerabilityFirstSeen) {
+	firstSeenByHash := u.mapFirstSeenByHash(firstSeen)
+
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnHash := analysis.AnalysisVulnerabilities[index].Vulnerability.VulnHash
+		if _, ok := firstSeenByHash[vulnHash]; ok {
+			continue
+		}
+
+		firstSeenByHash[vulnHash] = analysis.CreatedAt
+		newFirstSeen = append(newFirstSeen, u.newVulnerabilityFirstSeen(analysis, vulnHash))
+	}
+
+	return newFirstSeen
+}
+
+func (u *UseCases) newVulnerabilityFirstSeen(analysis *analysisEntities.Analysis,
+	vulnHash string) *risk.VulnerabilityFirstSeen {
+	return &risk.VulnerabilityFirstSeen{
+		RepositoryID: analysis.RepositoryID,
+		VulnHash:     vulnHash,
+		CreatedAt:    analysis.CreatedAt,
+	}
+}
@@ -0,0 +1,96 @@
+package risk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	"github.com/ZupIT/horusec-platform/analytic/internal/entities/risk"
+	riskEnums "github.com/ZupIT/horusec-platform/analytic/internal/enums/risk"
+)
+
+func newAnalysisVulnerability(severity severities.Severity, vulnType vulnerability.Type,
+	vulnHash string) analysisEntities.AnalysisVulnerabilities {
+	return analysisEntities.AnalysisVulnerabilities{
+		Vulnerability: vulnerabilityEntities.Vulnerability{
+			Severity: severity,
+			Type:     vulnType,
+			VulnHash: vulnHash,
+		},
+	}
+}
+
+func TestParseAnalysisToRiskScore(t *testing.T) {
+	analysis := &analysisEntities.Analysis{
+		RepositoryID:   uuid.New(),
+		RepositoryName: "test",
+		WorkspaceID:    uuid.New(),
+		CreatedAt:      time.Now(),
+		AnalysisVulnerabilities: []analysisEntities.AnalysisVulnerabilities{
+			newAnalysisVulnerability(severities.Critical, vulnerability.Vulnerability, "1"),
+			newAnalysisVulnerability(severities.Low, vulnerability.Vulnerability, "2"),
+			newAnalysisVulnerability(severities.Critical, vulnerability.FalsePositive, "3"),
+			newAnalysisVulnerability(severities.Critical, vulnerability.RiskAccepted, "4"),
+			newAnalysisVulnerability(severities.High, vulnerability.Corrected, "5"),
+		},
+	}
+
+	t.Run("should ignore false positive and risk accepted vulnerabilities", func(t *testing.T) {
+		useCases := NewUseCaseRisk()
+
+		result := useCases.ParseAnalysisToRiskScore(analysis, riskEnums.CriticalityMedium, nil)
+		assert.Equal(t, analysis.RepositoryID, result.RepositoryID)
+		assert.Equal(t, analysis.RepositoryName, result.RepositoryName)
+		assert.Equal(t, 2, result.ActiveVulnerabilities)
+		assert.Equal(t, float64(11), result.Score)
+	})
+
+	t.Run("should apply repository criticality to the score", func(t *testing.T) {
+		useCases := NewUseCaseRisk()
+
+		result := useCases.ParseAnalysisToRiskScore(analysis, riskEnums.CriticalityCritical, nil)
+		assert.Equal(t, riskEnums.CriticalityCritical, result.Criticality)
+		assert.Equal(t, float64(22), result.Score)
+	})
+
+	t.Run("should increase score of vulnerabilities open for a long time", func(t *testing.T) {
+		useCases := NewUseCaseRisk()
+
+		firstSeen := []*risk.VulnerabilityFirstSeen{
+			{VulnHash: "1", CreatedAt: analysis.CreatedAt.AddDate(0, 0, -riskEnums.DefaultMaxAgeInDays*2)},
+		}
+
+		result := useCases.ParseAnalysisToRiskScore(analysis, riskEnums.CriticalityMedium, firstSeen)
+		assert.Equal(t, float64(21), result.Score)
+	})
+}
+
+func TestParseAnalysisToVulnerabilitiesFirstSeen(t *testing.T) {
+	analysis := &analysisEntities.Analysis{
+		RepositoryID: uuid.New(),
+		CreatedAt:    time.Now(),
+		AnalysisVulnerabilities: []analysisEntities.AnalysisVulnerabilities{
+			newAnalysisVulnerability(severities.Critical, vulnerability.Vulnerability, "1"),
+			newAnalysisVulnerability(severities.Low, vulnerability.Vulnerability, "2"),
+			newAnalysisVulnerability(severities.Low, vulnerability.Vulnerability, "2"),
+		},
+	}
+
+	t.Run("should return only vulnerabilities not seen before", func(t *testing.T) {
+		useCases := NewUseCaseRisk()
+
+		result := useCases.ParseAnalysisToVulnerabilitiesFirstSeen(analysis,
+			[]*risk.VulnerabilityFirstSeen{{VulnHash: "1"}})
+		assert.Len(t, result, 1)
+		assert.Equal(t, "2", result[0].VulnHash)
+		assert.Equal(t, analysis.RepositoryID, result[0].RepositoryID)
+		assert.Equal(t, analysis.CreatedAt, result[0].CreatedAt)
+	})
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/ZupIT/horusec-platform/api/config/providers"
 	"github.com/ZupIT/horusec-platform/api/internal/enums"
+	"github.com/ZupIT/horusec-platform/clientip"
 )
 
 // @title Horusec-API
@@ -22,5 +23,5 @@ func main() {
 		panic(err)
 	}
 
-	router.ListenAndServe()
+	clientip.ListenAndServe(router)
 }
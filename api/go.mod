@@ -4,12 +4,14 @@ go 1.16
 
 require (
 	github.com/ZupIT/horusec-devkit v1.0.3
+	github.com/ZupIT/horusec-platform/clientip v0.0.0
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.2.0
 	github.com/go-ozzo/ozzo-validation/v4 v4.3.0
 	github.com/google/uuid v1.2.0
 	github.com/google/wire v0.5.0
+	github.com/lib/pq v1.10.1
 	github.com/prometheus/common v0.25.0 // indirect
 	github.com/stretchr/testify v1.7.0
 	github.com/swaggo/swag v1.7.0
@@ -22,3 +24,5 @@ require (
 	gorm.io/driver/postgres v1.1.0 // indirect
 	gorm.io/gorm v1.21.10 // indirect
 )
+
+replace github.com/ZupIT/horusec-platform/clientip => ../clientip
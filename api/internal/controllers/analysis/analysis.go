@@ -25,12 +25,14 @@ import (
 	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 
+	controllerEnums "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis/enums"
+	quotaEntities "github.com/ZupIT/horusec-platform/api/internal/entities/quota"
 	repoAnalysis "github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
 )
 
 type IController interface {
-	GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error)
+	GetAnalysis(analysisID, workspaceID, repositoryID uuid.UUID) (*analysis.Analysis, error)
 	SaveAnalysis(analysisEntity *analysis.Analysis) (uuid.UUID, error)
 }
 
@@ -51,7 +53,30 @@ func NewAnalysisController(broker brokerService.IBroker, appConfig appConfigurat
 	}
 }
 
-func (c *Controller) GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error) {
+// GetAnalysis only returns the analyses of the workspace of the token, and of its repository when it is a repository
+// token, the analyses of others are reported as not found to not disclose that they exist
+func (c *Controller) GetAnalysis(analysisID, workspaceID, repositoryID uuid.UUID) (*analysis.Analysis, error) {
+	analysisEntity, err := c.findAnalysis(analysisID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAnalysisInTokenScope(analysisEntity, workspaceID, repositoryID) {
+		return nil, enums.ErrorNotFoundRecords
+	}
+
+	return analysisEntity, nil
+}
+
+func isAnalysisInTokenScope(analysisEntity *analysis.Analysis, workspaceID, repositoryID uuid.UUID) bool {
+	if analysisEntity.WorkspaceID != workspaceID {
+		return false
+	}
+
+	return repositoryID == uuid.Nil || analysisEntity.RepositoryID == repositoryID
+}
+
+func (c *Controller) findAnalysis(analysisID uuid.UUID) (*analysis.Analysis, error) {
 	response := c.repoAnalysis.FindAnalysisByID(analysisID)
 	if response.GetError() != nil {
 		return nil, response.GetError()
@@ -64,7 +89,7 @@ func (c *Controller) GetAnalysis(analysisID uuid.UUID) (*analysis.Analysis, erro
 }
 
 func (c *Controller) SaveAnalysis(analysisEntity *analysis.Analysis) (uuid.UUID, error) {
-	analysisEntity, err := c.createRepositoryIfNotExists(analysisEntity)
+	analysisEntity, err := c.checkIsArchivedAndCreateRepository(analysisEntity)
 	if err != nil {
 		return uuid.Nil, err
 	}
@@ -78,22 +103,69 @@ func (c *Controller) SaveAnalysis(analysisEntity *analysis.Analysis) (uuid.UUID,
 	return analysisDecorated.ID, nil
 }
 
-func (c *Controller) createRepositoryIfNotExists(analysisEntity *analysis.Analysis) (*analysis.Analysis, error) {
+func (c *Controller) checkIsArchivedAndCreateRepository(
+	analysisEntity *analysis.Analysis) (*analysis.Analysis, error) {
+	if err := c.checkIsArchived(analysisEntity.WorkspaceID, analysisEntity.RepositoryID); err != nil {
+		return nil, err
+	}
+	usage, err := c.checkQuota(analysisEntity)
+	if err != nil {
+		return nil, err
+	}
+	return c.createRepositoryIfNotExists(analysisEntity, usage)
+}
+
+// checkQuota verifies the limits of the workspace before anything is saved, the vulnerabilities are counted as sent
+func (c *Controller) checkQuota(analysisEntity *analysis.Analysis) (*quotaEntities.Usage, error) {
+	usage, err := c.repoRepository.GetQuotaUsage(analysisEntity.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if usage.IsAnalysesPerDayLimitReached() {
+		return nil, controllerEnums.ErrorAnalysesPerDayLimitReached
+	}
+	if usage.IsVulnerabilitiesPerAnalysisExceeded(len(analysisEntity.AnalysisVulnerabilities)) {
+		return nil, controllerEnums.ErrorVulnerabilitiesPerAnalysisLimitReached
+	}
+	return usage, nil
+}
+
+func (c *Controller) createRepositoryIfNotExists(
+	analysisEntity *analysis.Analysis, usage *quotaEntities.Usage) (*analysis.Analysis, error) {
 	if analysisEntity.RepositoryID == uuid.Nil {
 		analysisEntity.SetRepositoryID(uuid.New())
 		repositoryID, err := c.repoRepository.FindRepository(analysisEntity.WorkspaceID, analysisEntity.RepositoryName)
 		if err != nil {
 			if err == enums.ErrorNotFoundRecords {
-				return analysisEntity, c.repoRepository.CreateRepository(analysisEntity.RepositoryID,
-					analysisEntity.WorkspaceID, analysisEntity.RepositoryName)
+				return analysisEntity, c.createRepository(analysisEntity, usage)
 			}
 			return nil, err
 		}
 		analysisEntity.SetRepositoryID(repositoryID)
+		return analysisEntity, c.checkIsArchived(analysisEntity.WorkspaceID, repositoryID)
 	}
 	return analysisEntity, nil
 }
 
+func (c *Controller) createRepository(analysisEntity *analysis.Analysis, usage *quotaEntities.Usage) error {
+	if usage.IsRepositoriesLimitReached() {
+		return controllerEnums.ErrorRepositoriesLimitReached
+	}
+	return c.repoRepository.CreateRepository(analysisEntity.RepositoryID, analysisEntity.WorkspaceID,
+		analysisEntity.RepositoryName)
+}
+
+func (c *Controller) checkIsArchived(workspaceID, repositoryID uuid.UUID) error {
+	isArchived, err := c.repoRepository.IsArchived(workspaceID, repositoryID)
+	if err != nil {
+		return err
+	}
+	if isArchived {
+		return controllerEnums.ErrorArchivedWorkspaceOrRepository
+	}
+	return nil
+}
+
 func (c *Controller) decorateAnalysisEntityAndSaveOnDatabase(
 	analysisEntity *analysis.Analysis) (*analysis.Analysis, error) {
 	analysisDecorated := c.decoratorAnalysisToSave(analysisEntity)
@@ -147,7 +219,7 @@ func (c *Controller) hasDuplicatedHash(
 }
 
 func (c *Controller) publishInBroker(analysisID uuid.UUID) error {
-	response, err := c.GetAnalysis(analysisID)
+	response, err := c.findAnalysis(analysisID)
 	if err != nil {
 		return err
 	}
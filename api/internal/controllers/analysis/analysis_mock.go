@@ -17,7 +17,7 @@ func (m *Mock) SaveAnalysis(_ *analysis.Analysis) (uuid.UUID, error) {
 	return args.Get(0).(uuid.UUID), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetAnalysis(_ uuid.UUID) (*analysis.Analysis, error) {
+func (m *Mock) GetAnalysis(_, _, _ uuid.UUID) (*analysis.Analysis, error) {
 	args := m.MethodCalled("GetAnalysis")
 	return args.Get(0).(*analysis.Analysis), mockUtils.ReturnNilOrError(args, 1)
 }
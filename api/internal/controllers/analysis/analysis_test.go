@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	controllerEnums "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis/enums"
+	quotaEntities "github.com/ZupIT/horusec-platform/api/internal/entities/quota"
 	repoAnalysis "github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
 
@@ -25,33 +27,65 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 )
 
+func newScopedAnalysisRepositoryMock(workspaceID, repositoryID uuid.UUID) *repoAnalysis.Mock {
+	repoAnalysisMock := &repoAnalysis.Mock{}
+	repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(0, nil, &analysis.Analysis{
+		ID:           uuid.New(),
+		WorkspaceID:  workspaceID,
+		RepositoryID: repositoryID,
+		Status:       analysisEnum.Success,
+		CreatedAt:    time.Now(),
+		FinishedAt:   time.Now(),
+	}))
+	return repoAnalysisMock
+}
+
 func TestController_GetAnalysis(t *testing.T) {
+	workspaceID := uuid.New()
+	repositoryID := uuid.New()
+
 	t.Run("Should return analysis existing from database", func(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		mockAppConfig := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
-		repoAnalysisMock := &repoAnalysis.Mock{}
-		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(0, nil, &analysis.Analysis{
-			ID:         uuid.New(),
-			Status:     analysisEnum.Success,
-			Errors:     "",
-			CreatedAt:  time.Now(),
-			FinishedAt: time.Now(),
-		}))
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoAnalysisMock := newScopedAnalysisRepositoryMock(workspaceID, repositoryID)
 		controller := NewAnalysisController(
 			brokerMock,
 			mockAppConfig,
 			repoRepositoryMock,
 			repoAnalysisMock,
 		)
-		res, err := controller.GetAnalysis(uuid.New())
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, repositoryID)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res)
+	})
+	t.Run("Should return analysis of any repository of the workspace to workspace tokens", func(t *testing.T) {
+		controller := NewAnalysisController(&broker.Mock{}, &appConfiguration.Mock{}, &repository.Mock{},
+			newScopedAnalysisRepositoryMock(workspaceID, repositoryID))
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, uuid.Nil)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, res)
 	})
+	t.Run("Should return not found records when analysis belongs to another workspace", func(t *testing.T) {
+		controller := NewAnalysisController(&broker.Mock{}, &appConfiguration.Mock{}, &repository.Mock{},
+			newScopedAnalysisRepositoryMock(uuid.New(), uuid.New()))
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, uuid.Nil)
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return not found records when analysis belongs to another repository", func(t *testing.T) {
+		controller := NewAnalysisController(&broker.Mock{}, &appConfiguration.Mock{}, &repository.Mock{},
+			newScopedAnalysisRepositoryMock(workspaceID, uuid.New()))
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, repositoryID)
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+		assert.Nil(t, res)
+	})
 	t.Run("Should return error when get analysis from database", func(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		mockAppConfig := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
 		controller := NewAnalysisController(
@@ -60,7 +94,7 @@ func TestController_GetAnalysis(t *testing.T) {
 			repoRepositoryMock,
 			repoAnalysisMock,
 		)
-		res, err := controller.GetAnalysis(uuid.New())
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, repositoryID)
 		assert.Error(t, err)
 		assert.Empty(t, res)
 	})
@@ -68,6 +102,7 @@ func TestController_GetAnalysis(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		mockAppConfig := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
 		controller := NewAnalysisController(
@@ -76,7 +111,7 @@ func TestController_GetAnalysis(t *testing.T) {
 			repoRepositoryMock,
 			repoAnalysisMock,
 		)
-		res, err := controller.GetAnalysis(uuid.New())
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, repositoryID)
 		assert.Error(t, err)
 		assert.Equal(t, err, enums.ErrorNotFoundRecords)
 		assert.Empty(t, res)
@@ -85,6 +120,7 @@ func TestController_GetAnalysis(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		mockAppConfig := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(0, nil, nil))
 		controller := NewAnalysisController(
@@ -93,7 +129,7 @@ func TestController_GetAnalysis(t *testing.T) {
 			repoRepositoryMock,
 			repoAnalysisMock,
 		)
-		res, err := controller.GetAnalysis(uuid.New())
+		res, err := controller.GetAnalysis(uuid.New(), workspaceID, repositoryID)
 		assert.Error(t, err)
 		assert.Equal(t, err, enums.ErrorNotFoundRecords)
 		assert.Empty(t, res)
@@ -106,6 +142,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(nil)
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
@@ -141,6 +179,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(nil)
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
@@ -202,6 +242,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(nil)
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(arguments *analysis.Analysis) {
@@ -290,6 +332,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(nil)
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoRepositoryMock.On("FindRepository").Return(uuid.New(), nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
@@ -325,6 +369,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoRepositoryMock.On("FindRepository").Return(uuid.Nil, errors.New("unexpected error"))
 		repoRepositoryMock.On("CreateRepository").Return(nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
@@ -352,6 +398,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoRepositoryMock.On("FindRepository").Return(uuid.Nil, errors.New("unexpected error"))
 		repoRepositoryMock.On("CreateRepository").Return(nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
@@ -379,6 +427,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		errCreateRepository := errors.New("unexpected error")
 		repoRepositoryMock.On("FindRepository").Return(uuid.Nil, enums.ErrorNotFoundRecords)
 		repoRepositoryMock.On("CreateRepository").Return(errCreateRepository)
@@ -409,6 +459,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(nil)
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(errors.New("unexpected error"))
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
@@ -444,6 +496,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(nil)
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
@@ -479,6 +533,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(errors.New("unexpected error"))
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
@@ -508,6 +564,8 @@ func TestController_SaveAnalysis(t *testing.T) {
 		brokerMock.On("Publish").Return(errors.New("unexpected error"))
 		appConfigMock := &appConfiguration.Mock{}
 		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, nil)
 		repoAnalysisMock := &repoAnalysis.Mock{}
 		repoAnalysisMock.On("CreateFullAnalysisResponse").Return(nil)
 		repoAnalysisMock.On("CreateFullAnalysisArguments").Return(func(any *analysis.Analysis) {})
@@ -538,4 +596,120 @@ func TestController_SaveAnalysis(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, res, uuid.Nil)
 	})
+	t.Run("Should return error when workspace or repository is archived", func(t *testing.T) {
+		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(true, nil)
+		controller := NewAnalysisController(
+			&broker.Mock{},
+			&appConfiguration.Mock{},
+			repoRepositoryMock,
+			&repoAnalysis.Mock{},
+		)
+		res, err := controller.SaveAnalysis(&analysis.Analysis{
+			ID:           uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+		})
+		assert.Equal(t, controllerEnums.ErrorArchivedWorkspaceOrRepository, err)
+		assert.Equal(t, res, uuid.Nil)
+	})
+	t.Run("Should return error when failed to check if is archived", func(t *testing.T) {
+		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, errors.New("unexpected error"))
+		controller := NewAnalysisController(
+			&broker.Mock{},
+			&appConfiguration.Mock{},
+			repoRepositoryMock,
+			&repoAnalysis.Mock{},
+		)
+		res, err := controller.SaveAnalysis(&analysis.Analysis{
+			ID:           uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+		})
+		assert.Error(t, err)
+		assert.Equal(t, res, uuid.Nil)
+	})
+	t.Run("Should return error when workspace reached the limit of analyses per day", func(t *testing.T) {
+		limit := 10
+		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{MaxAnalysesPerDay: &limit,
+			AnalysesToday: 10}, nil)
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		controller := NewAnalysisController(
+			&broker.Mock{},
+			&appConfiguration.Mock{},
+			repoRepositoryMock,
+			repoAnalysisMock,
+		)
+		res, err := controller.SaveAnalysis(&analysis.Analysis{
+			ID:           uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+		})
+		assert.Equal(t, controllerEnums.ErrorAnalysesPerDayLimitReached, err)
+		assert.Equal(t, res, uuid.Nil)
+		repoAnalysisMock.AssertNotCalled(t, "CreateFullAnalysisResponse")
+	})
+	t.Run("Should return error when analysis exceeds the limit of vulnerabilities", func(t *testing.T) {
+		limit := 1
+		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{MaxVulnerabilitiesPerAnalysis: &limit}, nil)
+		controller := NewAnalysisController(
+			&broker.Mock{},
+			&appConfiguration.Mock{},
+			repoRepositoryMock,
+			&repoAnalysis.Mock{},
+		)
+		res, err := controller.SaveAnalysis(&analysis.Analysis{
+			ID:                      uuid.New(),
+			WorkspaceID:             uuid.New(),
+			RepositoryID:            uuid.New(),
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{{}, {}},
+		})
+		assert.Equal(t, controllerEnums.ErrorVulnerabilitiesPerAnalysisLimitReached, err)
+		assert.Equal(t, res, uuid.Nil)
+	})
+	t.Run("Should return error when workspace reached the limit of repositories", func(t *testing.T) {
+		limit := 1
+		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{MaxRepositories: &limit,
+			Repositories: 1}, nil)
+		repoRepositoryMock.On("FindRepository").Return(uuid.Nil, enums.ErrorNotFoundRecords)
+		controller := NewAnalysisController(
+			&broker.Mock{},
+			&appConfiguration.Mock{},
+			repoRepositoryMock,
+			&repoAnalysis.Mock{},
+		)
+		res, err := controller.SaveAnalysis(&analysis.Analysis{
+			ID:             uuid.New(),
+			WorkspaceID:    uuid.New(),
+			RepositoryName: uuid.NewString(),
+		})
+		assert.Equal(t, controllerEnums.ErrorRepositoriesLimitReached, err)
+		assert.Equal(t, res, uuid.Nil)
+		repoRepositoryMock.AssertNotCalled(t, "CreateRepository")
+	})
+	t.Run("Should return error when failed to get quota usage", func(t *testing.T) {
+		repoRepositoryMock := &repository.Mock{}
+		repoRepositoryMock.On("IsArchived").Return(false, nil)
+		repoRepositoryMock.On("GetQuotaUsage").Return(&quotaEntities.Usage{}, errors.New("unexpected error"))
+		controller := NewAnalysisController(
+			&broker.Mock{},
+			&appConfiguration.Mock{},
+			repoRepositoryMock,
+			&repoAnalysis.Mock{},
+		)
+		res, err := controller.SaveAnalysis(&analysis.Analysis{
+			ID:           uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+		})
+		assert.Error(t, err)
+		assert.Equal(t, res, uuid.Nil)
+	})
 }
@@ -0,0 +1,13 @@
+package enums
+
+import "errors"
+
+var ErrorArchivedWorkspaceOrRepository = errors.New("{HORUSEC} analysis cannot be sent to an archived " +
+	"workspace or repository")
+
+var ErrorAnalysesPerDayLimitReached = errors.New("{HORUSEC} the workspace reached its limit of analyses per day")
+
+var ErrorVulnerabilitiesPerAnalysisLimitReached = errors.New("{HORUSEC} the analysis exceeds the limit of " +
+	"vulnerabilities per analysis of the workspace")
+
+var ErrorRepositoriesLimitReached = errors.New("{HORUSEC} the workspace reached its limit of repositories")
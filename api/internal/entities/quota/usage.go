@@ -0,0 +1,23 @@
+package quota
+
+// Usage contains the limits of the workspace managed in core with the consumption checked when saving an analysis,
+// a null limit means that there is no limit
+type Usage struct {
+	MaxRepositories               *int `gorm:"Column:max_repositories"`
+	MaxAnalysesPerDay             *int `gorm:"Column:max_analyses_per_day"`
+	MaxVulnerabilitiesPerAnalysis *int `gorm:"Column:max_vulnerabilities_per_analysis"`
+	Repositories                  int  `gorm:"Column:repositories"`
+	AnalysesToday                 int  `gorm:"Column:analyses_today"`
+}
+
+func (u *Usage) IsRepositoriesLimitReached() bool {
+	return u.MaxRepositories != nil && u.Repositories >= *u.MaxRepositories
+}
+
+func (u *Usage) IsAnalysesPerDayLimitReached() bool {
+	return u.MaxAnalysesPerDay != nil && u.AnalysesToday >= *u.MaxAnalysesPerDay
+}
+
+func (u *Usage) IsVulnerabilitiesPerAnalysisExceeded(vulnerabilities int) bool {
+	return u.MaxVulnerabilitiesPerAnalysis != nil && vulnerabilities > *u.MaxVulnerabilitiesPerAnalysis
+}
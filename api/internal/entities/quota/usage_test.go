@@ -0,0 +1,50 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRepositoriesLimitReached(t *testing.T) {
+	t.Run("Should return true when repositories reached the limit", func(t *testing.T) {
+		limit := 1
+
+		assert.True(t, (&Usage{MaxRepositories: &limit, Repositories: 1}).IsRepositoriesLimitReached())
+	})
+	t.Run("Should return false when repositories are under the limit", func(t *testing.T) {
+		limit := 2
+
+		assert.False(t, (&Usage{MaxRepositories: &limit, Repositories: 1}).IsRepositoriesLimitReached())
+	})
+	t.Run("Should return false when there is no limit", func(t *testing.T) {
+		assert.False(t, (&Usage{Repositories: 100}).IsRepositoriesLimitReached())
+	})
+}
+
+func TestIsAnalysesPerDayLimitReached(t *testing.T) {
+	t.Run("Should return true when analyses of today reached the limit", func(t *testing.T) {
+		limit := 10
+
+		assert.True(t, (&Usage{MaxAnalysesPerDay: &limit, AnalysesToday: 10}).IsAnalysesPerDayLimitReached())
+	})
+	t.Run("Should return false when there is no limit", func(t *testing.T) {
+		assert.False(t, (&Usage{AnalysesToday: 100}).IsAnalysesPerDayLimitReached())
+	})
+}
+
+func TestIsVulnerabilitiesPerAnalysisExceeded(t *testing.T) {
+	t.Run("Should return true when vulnerabilities are over the limit", func(t *testing.T) {
+		limit := 10
+
+		assert.True(t, (&Usage{MaxVulnerabilitiesPerAnalysis: &limit}).IsVulnerabilitiesPerAnalysisExceeded(11))
+	})
+	t.Run("Should return false when vulnerabilities are equal to the limit", func(t *testing.T) {
+		limit := 10
+
+		assert.False(t, (&Usage{MaxVulnerabilitiesPerAnalysis: &limit}).IsVulnerabilitiesPerAnalysisExceeded(10))
+	})
+	t.Run("Should return false when there is no limit", func(t *testing.T) {
+		assert.False(t, (&Usage{}).IsVulnerabilitiesPerAnalysisExceeded(1000))
+	})
+}
@@ -1,17 +1,57 @@
 package token
 
 import (
+	"net"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	tokensEnums "github.com/ZupIT/horusec-platform/api/internal/middelwares/token/enums"
 )
 
 type Token struct {
-	TokenID        uuid.UUID `gorm:"Column:token_id"`
-	RepositoryID   uuid.UUID `gorm:"Column:repository_id"`
-	RepositoryName string    `gorm:"Column:repository_name"`
-	WorkspaceID    uuid.UUID `gorm:"Column:workspace_id"`
-	WorkspaceName  string    `gorm:"Column:workspace_name"`
-	ExpiresAt      time.Time `gorm:"Column:expires_at"`
-	IsExpirable    bool      `gorm:"Column:is_expirable"`
+	TokenID        uuid.UUID      `gorm:"Column:token_id"`
+	RepositoryID   uuid.UUID      `gorm:"Column:repository_id"`
+	RepositoryName string         `gorm:"Column:repository_name"`
+	WorkspaceID    uuid.UUID      `gorm:"Column:workspace_id"`
+	WorkspaceName  string         `gorm:"Column:workspace_name"`
+	ExpiresAt      time.Time      `gorm:"Column:expires_at"`
+	IsExpirable    bool           `gorm:"Column:is_expirable"`
+	Scopes         pq.StringArray `gorm:"Column:scopes;type:text[]"`
+	AllowedCIDRs   pq.StringArray `gorm:"Column:allowed_cidrs;type:text[]"`
+	LastUsedAt     *time.Time     `gorm:"Column:last_used_at"`
+	LastUsedIP     string         `gorm:"Column:last_used_ip"`
+}
+
+// IsAllowedIP checks the ip against the allowed cidrs of the token, a token without cidrs can be used from anywhere
+func (t *Token) IsAllowedIP(ip string) bool {
+	if len(t.AllowedCIDRs) == 0 {
+		return true
+	}
+
+	parsedIP := net.ParseIP(ip)
+	for _, cidr := range t.AllowedCIDRs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(parsedIP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShouldUpdateLastUsed avoids a write on every request of the same pipeline
+func (t *Token) ShouldUpdateLastUsed(ip string) bool {
+	return t.LastUsedAt == nil || t.LastUsedIP != ip ||
+		time.Since(*t.LastUsedAt) > tokensEnums.LastUsedUpdateInterval
+}
+
+func HasScope(scopes []string, scope tokensEnums.Scope) bool {
+	for _, value := range scopes {
+		if value == scope.ToString() {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,54 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+
+	tokensEnums "github.com/ZupIT/horusec-platform/api/internal/middelwares/token/enums"
+)
+
+func TestIsAllowedIP(t *testing.T) {
+	t.Run("Should allow any ip when token does not have allowed cidrs", func(t *testing.T) {
+		assert.True(t, (&Token{}).IsAllowedIP("192.168.0.1"))
+	})
+	t.Run("Should allow ip inside one of the allowed cidrs", func(t *testing.T) {
+		token := &Token{AllowedCIDRs: pq.StringArray{"10.0.0.0/8", "192.168.0.0/24"}}
+
+		assert.True(t, token.IsAllowedIP("192.168.0.1"))
+	})
+	t.Run("Should not allow ip outside the allowed cidrs", func(t *testing.T) {
+		token := &Token{AllowedCIDRs: pq.StringArray{"10.0.0.0/8"}}
+
+		assert.False(t, token.IsAllowedIP("192.168.0.1"))
+		assert.False(t, token.IsAllowedIP("invalid"))
+	})
+}
+
+func TestShouldUpdateLastUsed(t *testing.T) {
+	t.Run("Should update when token was never used", func(t *testing.T) {
+		assert.True(t, (&Token{}).ShouldUpdateLastUsed("127.0.0.1"))
+	})
+	t.Run("Should update when token was used from another ip", func(t *testing.T) {
+		lastUsedAt := time.Now()
+		token := &Token{LastUsedAt: &lastUsedAt, LastUsedIP: "10.0.0.1"}
+
+		assert.True(t, token.ShouldUpdateLastUsed("127.0.0.1"))
+	})
+	t.Run("Should not update when token was used recently from the same ip", func(t *testing.T) {
+		lastUsedAt := time.Now()
+		token := &Token{LastUsedAt: &lastUsedAt, LastUsedIP: "127.0.0.1"}
+
+		assert.False(t, token.ShouldUpdateLastUsed("127.0.0.1"))
+	})
+}
+
+func TestHasScope(t *testing.T) {
+	t.Run("Should return true when scopes contains the scope", func(t *testing.T) {
+		assert.True(t, HasScope([]string{"analysis:upload"}, tokensEnums.ScopeUploadAnalysis))
+		assert.False(t, HasScope([]string{"analysis:read"}, tokensEnums.ScopeUploadAnalysis))
+		assert.False(t, HasScope(nil, tokensEnums.ScopeUploadAnalysis))
+	})
+}
@@ -15,23 +15,26 @@
 package analysis
 
 import (
+	"encoding/json"
 	netHTTP "net/http"
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 
 	analysisController "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis"
+	controllerEnums "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis/enums"
+	tokenEntities "github.com/ZupIT/horusec-platform/api/internal/entities/token"
 	handlersEnums "github.com/ZupIT/horusec-platform/api/internal/handlers/analysis/enums"
 	tokenMiddlewareEnum "github.com/ZupIT/horusec-platform/api/internal/middelwares/token/enums"
 	analysisUseCases "github.com/ZupIT/horusec-platform/api/internal/usecases/analysis"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	httpEntities "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities"
 
 	analysisEntities "github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 
-	_ "github.com/ZupIT/horusec-devkit/pkg/entities/cli"        // [swagger-import]
-	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
+	_ "github.com/ZupIT/horusec-devkit/pkg/entities/cli" // [swagger-import]
 )
 
 type Handler struct {
@@ -60,7 +63,9 @@ func (h *Handler) Options(w netHTTP.ResponseWriter, _ *netHTTP.Request) {
 // @Param SendNewAnalysis body cli.AnalysisData true "send new analysis info"
 // @Success 201 {object} entities.Response{content=string} "CREATED"
 // @Success 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Success 403 {object} entities.Response{content=string} "FORBIDDEN"
 // @Success 404 {object} entities.Response{content=string} "NOT FOUND"
+// @Success 429 {object} entities.Response{content=string} "TOO MANY REQUESTS"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /api/analysis [post]
 func (h *Handler) Post(w netHTTP.ResponseWriter, r *netHTTP.Request) {
@@ -117,12 +122,35 @@ func (h *Handler) isToCreateNewRepository(analysisEntity *analysisEntities.Analy
 func (h *Handler) saveAnalysis(w netHTTP.ResponseWriter, analysisEntity *analysisEntities.Analysis) {
 	analysisID, err := h.controller.SaveAnalysis(analysisEntity)
 	if err != nil {
-		httpUtil.StatusInternalServerError(w, err)
+		h.checkSaveAnalysisErrors(w, err)
 		return
 	}
 	httpUtil.StatusCreated(w, analysisID)
 }
 
+func (h *Handler) checkSaveAnalysisErrors(w netHTTP.ResponseWriter, err error) {
+	switch err {
+	case controllerEnums.ErrorArchivedWorkspaceOrRepository, controllerEnums.ErrorRepositoriesLimitReached,
+		controllerEnums.ErrorVulnerabilitiesPerAnalysisLimitReached:
+		httpUtil.StatusForbidden(w, err)
+	case controllerEnums.ErrorAnalysesPerDayLimitReached:
+		h.statusTooManyRequests(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
+
+// statusTooManyRequests is written here since the devkit http utils do not have a too many requests response
+func (h *Handler) statusTooManyRequests(w netHTTP.ResponseWriter, err error) {
+	response := &httpEntities.Response{}
+	response.SetResponseData(netHTTP.StatusTooManyRequests, netHTTP.StatusText(netHTTP.StatusTooManyRequests),
+		err.Error())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(netHTTP.StatusTooManyRequests)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
 // Get
 // @Tags Analysis
 // @Security ApiKeyAuth
@@ -142,14 +170,38 @@ func (h *Handler) Get(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
-	response, err := h.controller.GetAnalysis(analysisID)
+	workspaceID, repositoryID := h.getTokenWorkspaceAndRepositoryID(r)
+	response, err := h.controller.GetAnalysis(analysisID, workspaceID, repositoryID)
 	if err != nil {
-		if err == enums.ErrorNotFoundRecords {
-			httpUtil.StatusNotFound(w, err)
-		} else {
-			httpUtil.StatusInternalServerError(w, err)
-		}
+		h.checkGetAnalysisErrors(w, err)
+		return
+	}
+	httpUtil.StatusOK(w, h.removeFindingsWithoutScope(r, response))
+}
+
+func (h *Handler) checkGetAnalysisErrors(w netHTTP.ResponseWriter, err error) {
+	if err == enums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
 	} else {
-		httpUtil.StatusOK(w, response)
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
+
+// getTokenWorkspaceAndRepositoryID returns an empty repository id for the workspace tokens
+func (h *Handler) getTokenWorkspaceAndRepositoryID(r *netHTTP.Request) (workspaceID, repositoryID uuid.UUID) {
+	workspaceID, _ = r.Context().Value(tokenMiddlewareEnum.WorkspaceID).(uuid.UUID)
+	repositoryID, _ = r.Context().Value(tokenMiddlewareEnum.RepositoryID).(uuid.UUID)
+
+	return workspaceID, repositoryID
+}
+
+// removeFindingsWithoutScope keeps only the analysis summary when the token cannot read the findings
+func (h *Handler) removeFindingsWithoutScope(
+	r *netHTTP.Request, response *analysisEntities.Analysis) *analysisEntities.Analysis {
+	scopes, _ := r.Context().Value(tokenMiddlewareEnum.Scopes).([]string)
+	if !tokenEntities.HasScope(scopes, tokenMiddlewareEnum.ScopeReadFindings) {
+		response.AnalysisVulnerabilities = nil
 	}
+
+	return response
 }
@@ -18,6 +18,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	analysisController "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis"
+	controllerEnums "github.com/ZupIT/horusec-platform/api/internal/controllers/analysis/enums"
+	repoAnalysis "github.com/ZupIT/horusec-platform/api/internal/repositories/analysis"
+	"github.com/ZupIT/horusec-platform/api/internal/repositories/repository"
 
 	"github.com/ZupIT/horusec-devkit/pkg/entities/analysis"
 	"github.com/ZupIT/horusec-devkit/pkg/entities/cli"
@@ -27,7 +30,10 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/tools"
 	vulnerabilityEnum "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	appConfiguration "github.com/ZupIT/horusec-devkit/pkg/services/app"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 )
 
 func TestHandler_Options(t *testing.T) {
@@ -58,6 +64,44 @@ func TestHandler_Get(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+	t.Run("should return 200 without findings when token cannot read findings", func(t *testing.T) {
+		controllerMock := &analysisController.Mock{}
+		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{{VulnerabilityID: uuid.New()}}}, nil)
+		handler := NewAnalysisHandler(controllerMock)
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("analysisID", "85d08ec1-7786-4c2d-bf4e-5fee3a010315")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = r.WithContext(context.WithValue(r.Context(), tokensEnums.Scopes, []string{"analysis:read"}))
+
+		handler.Get(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "vulnerabilityID")
+	})
+
+	t.Run("should return 200 with findings when token can read findings", func(t *testing.T) {
+		controllerMock := &analysisController.Mock{}
+		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{
+			AnalysisVulnerabilities: []analysis.AnalysisVulnerabilities{{VulnerabilityID: uuid.New()}}}, nil)
+		handler := NewAnalysisHandler(controllerMock)
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("analysisID", "85d08ec1-7786-4c2d-bf4e-5fee3a010315")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = r.WithContext(context.WithValue(r.Context(), tokensEnums.Scopes, []string{"findings:read"}))
+
+		handler.Get(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "vulnerabilityID")
+	})
+
 	t.Run("should return 400 when not exists analysisID", func(t *testing.T) {
 		controllerMock := &analysisController.Mock{}
 		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{}, nil)
@@ -84,6 +128,25 @@ func TestHandler_Get(t *testing.T) {
 
 		assert.Equal(t, http.StatusNotFound, w.Code)
 	})
+	t.Run("should return 404 when analysis belongs to another workspace", func(t *testing.T) {
+		repoAnalysisMock := &repoAnalysis.Mock{}
+		repoAnalysisMock.On("FindAnalysisByID").Return(response.NewResponse(0, nil, &analysis.Analysis{
+			ID: uuid.New(), WorkspaceID: uuid.New(), RepositoryID: uuid.New()}))
+		handler := NewAnalysisHandler(analysisController.NewAnalysisController(&broker.Mock{},
+			&appConfiguration.Mock{}, &repository.Mock{}, repoAnalysisMock))
+		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("analysisID", "85d08ec1-7786-4c2d-bf4e-5fee3a010315")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+		r = r.WithContext(context.WithValue(r.Context(), tokensEnums.WorkspaceID, uuid.New()))
+		r = r.WithContext(context.WithValue(r.Context(), tokensEnums.RepositoryID, uuid.Nil))
+
+		handler.Get(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
 	t.Run("should return 500 when return error unexpected", func(t *testing.T) {
 		controllerMock := &analysisController.Mock{}
 		controllerMock.On("GetAnalysis").Return(&analysis.Analysis{}, errors.New("unexpected error"))
@@ -265,4 +328,76 @@ func TestHandler_Post(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
+	t.Run("should return 403 when save analysis to an archived workspace or repository", func(t *testing.T) {
+		controllerMock := &analysisController.Mock{}
+		controllerMock.On("SaveAnalysis").Return(uuid.Nil, controllerEnums.ErrorArchivedWorkspaceOrRepository)
+		handler := NewAnalysisHandler(controllerMock)
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewReader(analysisDataMock.ToBytes()))
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryName, uuid.New().String())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceName, uuid.New().String())
+		r = r.WithContext(ctx)
+		r.Header.Set("X-Horusec-Authorization", uuid.New().String())
+
+		handler.Post(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+	t.Run("should return 429 when workspace reached the limit of analyses per day", func(t *testing.T) {
+		controllerMock := &analysisController.Mock{}
+		controllerMock.On("SaveAnalysis").Return(uuid.Nil, controllerEnums.ErrorAnalysesPerDayLimitReached)
+		handler := NewAnalysisHandler(controllerMock)
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewReader(analysisDataMock.ToBytes()))
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryName, uuid.New().String())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceName, uuid.New().String())
+		r = r.WithContext(ctx)
+		r.Header.Set("X-Horusec-Authorization", uuid.New().String())
+
+		handler.Post(w, r)
+
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+	t.Run("should return 403 when analysis exceeds the limit of vulnerabilities per analysis", func(t *testing.T) {
+		controllerMock := &analysisController.Mock{}
+		controllerMock.On("SaveAnalysis").Return(uuid.Nil, controllerEnums.ErrorVulnerabilitiesPerAnalysisLimitReached)
+		handler := NewAnalysisHandler(controllerMock)
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewReader(analysisDataMock.ToBytes()))
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryName, uuid.New().String())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceName, uuid.New().String())
+		r = r.WithContext(ctx)
+		r.Header.Set("X-Horusec-Authorization", uuid.New().String())
+
+		handler.Post(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+	t.Run("should return 403 when workspace reached the limit of repositories", func(t *testing.T) {
+		controllerMock := &analysisController.Mock{}
+		controllerMock.On("SaveAnalysis").Return(uuid.Nil, controllerEnums.ErrorRepositoriesLimitReached)
+		handler := NewAnalysisHandler(controllerMock)
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest(http.MethodPost, "/test", bytes.NewReader(analysisDataMock.ToBytes()))
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.RepositoryName, uuid.New().String())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceID, uuid.New())
+		ctx = context.WithValue(ctx, tokensEnums.WorkspaceName, uuid.New().String())
+		r = r.WithContext(ctx)
+		r.Header.Set("X-Horusec-Authorization", uuid.New().String())
+
+		handler.Post(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
 }
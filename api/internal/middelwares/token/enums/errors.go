@@ -3,3 +3,5 @@ package enums
 import "errors"
 
 var ErrorTokenExpired = errors.New("this authorization token has expired, please renew it")
+var ErrorTokenIPNotAllowed = errors.New("this authorization token is not allowed to be used from this ip address")
+var ErrorTokenScopeNotAllowed = errors.New("this authorization token does not have the scope required by this action")
@@ -1,5 +1,7 @@
 package enums
 
+import "time"
+
 type CtxKey string
 
 const (
@@ -7,4 +9,20 @@ const (
 	WorkspaceName  CtxKey = "workspaceName"
 	WorkspaceID    CtxKey = "workspaceID"
 	RepositoryID   CtxKey = "repositoryID"
+	Scopes         CtxKey = "scopes"
 )
+
+const LastUsedUpdateInterval = time.Minute
+
+// Scope is the same value stored by the core service when the token is created
+type Scope string
+
+const (
+	ScopeUploadAnalysis Scope = "analysis:upload"
+	ScopeReadAnalysis   Scope = "analysis:read"
+	ScopeReadFindings   Scope = "findings:read"
+)
+
+func (s Scope) ToString() string {
+	return string(s)
+}
@@ -15,10 +15,13 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/clientip"
 )
 
 type ITokenAuthz interface {
 	IsAuthorized(next http.Handler) http.Handler
+	HasScope(scope tokensEnums.Scope) func(next http.Handler) http.Handler
 }
 
 type Authz struct {
@@ -59,7 +62,21 @@ func (a *Authz) getTokenHashFromAuthorizationHeader(r *http.Request) (string, er
 
 func (a *Authz) getContextAndValidateIsValidToken(
 	tokenValue string, r *http.Request) (context.Context, error) {
-	ctx := r.Context()
+	tokenFound, err := a.findToken(tokenValue)
+	if err != nil {
+		return nil, err
+	}
+
+	clientIP := clientip.Get(r)
+	if err := a.validateToken(tokenFound, clientIP); err != nil {
+		return nil, err
+	}
+
+	a.updateLastUsed(tokenFound, clientIP)
+	return a.bindTokenInCtx(r.Context(), tokenFound), nil
+}
+
+func (a *Authz) findToken(tokenValue string) (*token.Token, error) {
 	res := a.repoToken.FindTokenByValue(tokenValue)
 	if err := res.GetError(); err != nil {
 		return nil, err
@@ -67,15 +84,30 @@ func (a *Authz) getContextAndValidateIsValidToken(
 	if res.GetData() == nil {
 		return nil, enumsDatabase.ErrorNotFoundRecords
 	}
-	tokenFound := res.GetData().(*token.Token)
-	ctx = a.bindTokenInCtx(ctx, tokenFound)
-	return ctx, a.returnErrorIfTokenIsExpired(tokenFound)
+	return res.GetData().(*token.Token), nil
+}
+
+func (a *Authz) validateToken(tokenFound *token.Token, clientIP string) error {
+	if err := a.returnErrorIfTokenIsExpired(tokenFound); err != nil {
+		return err
+	}
+	if !tokenFound.IsAllowedIP(clientIP) {
+		return tokensEnums.ErrorTokenIPNotAllowed
+	}
+	return nil
+}
+
+func (a *Authz) updateLastUsed(tokenFound *token.Token, clientIP string) {
+	if tokenFound.ShouldUpdateLastUsed(clientIP) {
+		logger.LogError("{HORUSEC_API}", a.repoToken.UpdateLastUsed(tokenFound.TokenID, clientIP))
+	}
 }
 
 func (a *Authz) bindTokenInCtx(ctx context.Context, tokenFound *token.Token) context.Context {
 	newCtx := context.WithValue(ctx, tokensEnums.RepositoryID, tokenFound.RepositoryID)
 	newCtx = context.WithValue(newCtx, tokensEnums.RepositoryName, tokenFound.RepositoryName)
 	newCtx = context.WithValue(newCtx, tokensEnums.WorkspaceID, tokenFound.WorkspaceID)
+	newCtx = context.WithValue(newCtx, tokensEnums.Scopes, []string(tokenFound.Scopes))
 	return context.WithValue(newCtx, tokensEnums.WorkspaceName, tokenFound.WorkspaceName)
 }
 
@@ -99,7 +131,25 @@ func (a *Authz) verifyValidateTokenErrors(w http.ResponseWriter, err error) {
 		httpUtil.StatusUnauthorized(w, tokensEnums.ErrorTokenExpired)
 		return
 	}
+	if err == tokensEnums.ErrorTokenIPNotAllowed {
+		httpUtil.StatusForbidden(w, tokensEnums.ErrorTokenIPNotAllowed)
+		return
+	}
 	logger.LogError("{HORUSEC_API}", err)
 
 	httpUtil.StatusUnauthorized(w, enums.ErrorUnauthorized)
 }
+
+// HasScope must be used after IsAuthorized, it blocks the tokens that were not created with the scope
+func (a *Authz) HasScope(scope tokensEnums.Scope) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scopes, _ := r.Context().Value(tokensEnums.Scopes).([]string)
+			if !token.HasScope(scopes, scope) {
+				httpUtil.StatusForbidden(w, tokensEnums.ErrorTokenScopeNotAllowed)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
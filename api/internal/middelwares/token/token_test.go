@@ -1,6 +1,7 @@
 package token
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -9,13 +10,18 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
 
+	"github.com/go-chi/chi/middleware"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	entityToken "github.com/ZupIT/horusec-platform/api/internal/entities/token"
+	tokensEnums "github.com/ZupIT/horusec-platform/api/internal/middelwares/token/enums"
 	"github.com/ZupIT/horusec-platform/api/internal/repositories/token"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/clientip"
 )
 
 func testHandler(w http.ResponseWriter, _ *http.Request) {
@@ -41,6 +47,7 @@ func TestAuthz_IsAuthorized(t *testing.T) {
 		}
 
 		repoTokenMock.On("FindTokenByValue").Return(response.NewResponse(1, nil, data))
+		repoTokenMock.On("UpdateLastUsed").Return(nil)
 
 		middleware := NewTokenAuthz(repoTokenMock)
 
@@ -152,6 +159,7 @@ func TestAuthz_IsAuthorized(t *testing.T) {
 		}
 
 		repoTokenMock.On("FindTokenByValue").Return(response.NewResponse(1, nil, data))
+		repoTokenMock.On("UpdateLastUsed").Return(nil)
 
 		middleware := NewTokenAuthz(repoTokenMock)
 
@@ -167,4 +175,97 @@ func TestAuthz_IsAuthorized(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
+	t.Run("Should return forbidden when ip is not in the allowed cidrs", func(t *testing.T) {
+		repoTokenMock := &token.Mock{}
+		data := &entityToken.Token{
+			TokenID:      uuid.New(),
+			AllowedCIDRs: pq.StringArray{"10.0.0.0/8"},
+		}
+
+		repoTokenMock.On("FindTokenByValue").Return(response.NewResponse(1, nil, data))
+
+		handler := NewTokenAuthz(repoTokenMock).IsAuthorized(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", uuid.New().String())
+		req.RemoteAddr = "192.168.0.1:5000"
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		repoTokenMock.AssertNotCalled(t, "UpdateLastUsed")
+	})
+	t.Run("Should return success when ip is in the allowed cidrs and not update last used", func(t *testing.T) {
+		repoTokenMock := &token.Mock{}
+		lastUsedAt := time.Now()
+		data := &entityToken.Token{
+			TokenID:      uuid.New(),
+			AllowedCIDRs: pq.StringArray{"10.0.0.0/8"},
+			LastUsedAt:   &lastUsedAt,
+			LastUsedIP:   "10.0.0.1",
+		}
+
+		repoTokenMock.On("FindTokenByValue").Return(response.NewResponse(1, nil, data))
+
+		handler := NewTokenAuthz(repoTokenMock).IsAuthorized(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", uuid.New().String())
+		req.RemoteAddr = "10.0.0.1:5000"
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		repoTokenMock.AssertNotCalled(t, "UpdateLastUsed")
+	})
+	t.Run("Should return forbidden when a disallowed ip forwards an allowed ip", func(t *testing.T) {
+		repoTokenMock := &token.Mock{}
+		data := &entityToken.Token{
+			TokenID:      uuid.New(),
+			AllowedCIDRs: pq.StringArray{"10.0.0.0/8"},
+		}
+
+		repoTokenMock.On("FindTokenByValue").Return(response.NewResponse(1, nil, data))
+
+		handler := middleware.RealIP(NewTokenAuthz(repoTokenMock).IsAuthorized(http.HandlerFunc(testHandler)))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req.Header.Add("X-Horusec-Authorization", uuid.New().String())
+		req.Header.Add("X-Forwarded-For", "10.0.0.1")
+		req.RemoteAddr = "192.168.0.1:5000"
+		req = req.WithContext(clientip.WithConnectionAddress(req.Context(), req.RemoteAddr))
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		repoTokenMock.AssertNotCalled(t, "UpdateLastUsed")
+	})
+}
+
+func TestAuthz_HasScope(t *testing.T) {
+	t.Run("Should return success when token has the scope", func(t *testing.T) {
+		handler := NewTokenAuthz(&token.Mock{}).HasScope(tokensEnums.ScopeUploadAnalysis)(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req = req.WithContext(context.WithValue(req.Context(), tokensEnums.Scopes, []string{"analysis:upload"}))
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+	t.Run("Should return forbidden when token does not have the scope", func(t *testing.T) {
+		handler := NewTokenAuthz(&token.Mock{}).HasScope(tokensEnums.ScopeUploadAnalysis)(http.HandlerFunc(testHandler))
+
+		req, _ := http.NewRequest("GET", "http://test", nil)
+		req = req.WithContext(context.WithValue(req.Context(), tokensEnums.Scopes, []string{"analysis:read"}))
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
 }
@@ -8,11 +8,15 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/api/internal/entities/quota"
 )
 
 type IRepository interface {
 	CreateRepository(ID, workspaceID uuid.UUID, name string) error
 	FindRepository(workspaceID uuid.UUID, name string) (uuid.UUID, error)
+	IsArchived(workspaceID, repositoryID uuid.UUID) (bool, error)
+	GetQuotaUsage(workspaceID uuid.UUID) (*quotaEntities.Usage, error)
 }
 
 type Repository struct {
@@ -60,3 +64,47 @@ func (r *Repository) CreateRepository(repositoryID, workspaceID uuid.UUID, name
 	}
 	return r.databaseWrite.Create(entity, r.repositoryTableName).GetError()
 }
+
+func (r *Repository) IsArchived(workspaceID, repositoryID uuid.UUID) (bool, error) {
+	var count int64
+	res := r.databaseRead.Raw(r.queryIsArchived(), &count, repositoryID, workspaceID)
+	if res.GetErrorExceptNotFound() != nil {
+		return false, res.GetErrorExceptNotFound()
+	}
+	return count > 0, nil
+}
+
+func (r *Repository) queryIsArchived() string {
+	return `
+			SELECT COUNT(*)
+			FROM workspaces AS ws
+			LEFT JOIN repositories AS repo ON repo.workspace_id = ws.workspace_id AND repo.repository_id = ?
+			WHERE ws.workspace_id = ? AND (ws.archived_at IS NOT NULL OR repo.archived_at IS NOT NULL)
+	`
+}
+
+// GetQuotaUsage returns all limits as null when the workspace does not have a quota
+func (r *Repository) GetQuotaUsage(workspaceID uuid.UUID) (*quotaEntities.Usage, error) {
+	usage := &quotaEntities.Usage{}
+	res := r.databaseRead.Raw(r.queryGetQuotaUsage(), usage, workspaceID)
+	if res.GetErrorExceptNotFound() != nil {
+		return nil, res.GetErrorExceptNotFound()
+	}
+	return usage, nil
+}
+
+func (r *Repository) queryGetQuotaUsage() string {
+	return `
+			SELECT quota.max_repositories, quota.max_analyses_per_day, quota.max_vulnerabilities_per_analysis,
+				(
+					SELECT COUNT(*) FROM repositories AS repo WHERE repo.workspace_id = ws.workspace_id
+				) AS repositories,
+				(
+					SELECT COUNT(*) FROM analysis AS an
+					WHERE an.workspace_id = ws.workspace_id AND an.created_at >= CURRENT_DATE
+				) AS analyses_today
+			FROM workspaces AS ws
+			LEFT JOIN workspace_quotas AS quota ON quota.workspace_id = ws.workspace_id
+			WHERE ws.workspace_id = ?
+	`
+}
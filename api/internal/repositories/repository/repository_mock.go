@@ -5,6 +5,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	utilsMock "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/api/internal/entities/quota"
 )
 
 type Mock struct {
@@ -20,3 +22,13 @@ func (m *Mock) CreateRepository(_, _ uuid.UUID, _ string) error {
 	args := m.MethodCalled("CreateRepository")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) IsArchived(_, _ uuid.UUID) (bool, error) {
+	args := m.MethodCalled("IsArchived")
+	return args.Get(0).(bool), utilsMock.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetQuotaUsage(_ uuid.UUID) (*quotaEntities.Usage, error) {
+	args := m.MethodCalled("GetQuotaUsage")
+	return args.Get(0).(*quotaEntities.Usage), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -68,3 +68,49 @@ func TestRepository_FindRepository(t *testing.T) {
 		assert.Equal(t, enums.ErrorNotFoundRecords, err)
 	})
 }
+
+func TestRepository_IsArchived(t *testing.T) {
+	t.Run("Should return false when workspace and repository are not archived", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, nil, nil))
+		connectionMock := &database.Connection{
+			Read: mockRead,
+		}
+		isArchived, err := NewRepositoriesRepository(connectionMock).IsArchived(uuid.New(), uuid.New())
+		assert.False(t, isArchived)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return error when failed to check if is archived", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+		connectionMock := &database.Connection{
+			Read: mockRead,
+		}
+		isArchived, err := NewRepositoriesRepository(connectionMock).IsArchived(uuid.New(), uuid.New())
+		assert.False(t, isArchived)
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_GetQuotaUsage(t *testing.T) {
+	t.Run("Should return the quota usage of the workspace", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(1, nil, nil))
+		connectionMock := &database.Connection{
+			Read: mockRead,
+		}
+		usage, err := NewRepositoriesRepository(connectionMock).GetQuotaUsage(uuid.New())
+		assert.NotNil(t, usage)
+		assert.NoError(t, err)
+	})
+	t.Run("Should return unexpected error when get quota usage", func(t *testing.T) {
+		mockRead := &database.Mock{}
+		mockRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connectionMock := &database.Connection{
+			Read: mockRead,
+		}
+		usage, err := NewRepositoriesRepository(connectionMock).GetQuotaUsage(uuid.New())
+		assert.Nil(t, usage)
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,10 @@
 package token
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
 	"github.com/ZupIT/horusec-platform/api/internal/entities/token"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
@@ -9,15 +13,20 @@ import (
 
 type IToken interface {
 	FindTokenByValue(tokenValue string) response.IResponse
+	UpdateLastUsed(tokenID uuid.UUID, ip string) error
 }
 
 type Token struct {
-	databaseRead database.IDatabaseRead
+	databaseRead   database.IDatabaseRead
+	databaseWrite  database.IDatabaseWrite
+	tokenTableName string
 }
 
 func NewRepositoriesToken(connection *database.Connection) IToken {
 	return &Token{
-		databaseRead: connection.Read,
+		databaseRead:   connection.Read,
+		databaseWrite:  connection.Write,
+		tokenTableName: "tokens",
 	}
 }
 
@@ -25,7 +34,9 @@ func (a *Token) FindTokenByValue(tokenValue string) response.IResponse {
 	rawSQL := `
 		SELECT tokens.token_id as token_id, tokens.repository_id as repository_id,
 			repositories.name as repository_name, tokens.workspace_id as workspace_id,
-			workspaces.name as workspace_name, tokens.expires_at as expires_at, tokens.is_expirable as is_expirable
+			workspaces.name as workspace_name, tokens.expires_at as expires_at, tokens.is_expirable as is_expirable,
+			tokens.scopes as scopes, tokens.allowed_cidrs as allowed_cidrs, tokens.last_used_at as last_used_at,
+			tokens.last_used_ip as last_used_ip
 		FROM tokens
 		INNER JOIN workspaces ON tokens.workspace_id = workspaces.workspace_id  
 		LEFT JOIN repositories ON tokens.repository_id = repositories.repository_id
@@ -33,3 +44,8 @@ func (a *Token) FindTokenByValue(tokenValue string) response.IResponse {
 	`
 	return a.databaseRead.Raw(rawSQL, &token.Token{}, tokenValue)
 }
+
+func (a *Token) UpdateLastUsed(tokenID uuid.UUID, ip string) error {
+	return a.databaseWrite.Update(map[string]interface{}{"last_used_at": time.Now(), "last_used_ip": ip},
+		map[string]interface{}{"token_id": tokenID}, a.tokenTableName).GetError()
+}
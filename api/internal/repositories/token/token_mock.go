@@ -1,9 +1,11 @@
 package token
 
 import (
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 )
 
 type Mock struct {
@@ -14,3 +16,8 @@ func (m *Mock) FindTokenByValue(_ string) response.IResponse {
 	args := m.MethodCalled("FindTokenByValue")
 	return args.Get(0).(response.IResponse)
 }
+
+func (m *Mock) UpdateLastUsed(_ uuid.UUID, _ string) error {
+	args := m.MethodCalled("UpdateLastUsed")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -63,3 +63,15 @@ func TestToken_FindTokenByValue(t *testing.T) {
 		assert.Empty(t, res.GetData())
 	})
 }
+
+func TestToken_UpdateLastUsed(t *testing.T) {
+	t.Run("Should update the last used date and ip of the token", func(t *testing.T) {
+		dbMockWrite := &database.Mock{}
+		dbMockWrite.On("Update").Return(response.NewResponse(1, nil, nil))
+		connectionMock := &database.Connection{
+			Write: dbMockWrite,
+		}
+		err := NewRepositoriesToken(connectionMock).UpdateLastUsed(uuid.New(), "127.0.0.1")
+		assert.NoError(t, err)
+	})
+}
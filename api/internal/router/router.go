@@ -9,6 +9,7 @@ import (
 	"github.com/ZupIT/horusec-platform/api/internal/handlers/analysis"
 	"github.com/ZupIT/horusec-platform/api/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/api/internal/middelwares/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/api/internal/middelwares/token/enums"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/swagger"
 )
@@ -48,8 +49,8 @@ func (r *Router) routerAnalysis() {
 	r.Route(enums.AnalysisRouter, func(router chi.Router) {
 		router.Use(r.tokenAuthz.IsAuthorized)
 		router.Options("/", r.analysisHandler.Options)
-		router.Post("/", r.analysisHandler.Post)
-		router.Get("/{analysisID}", r.analysisHandler.Get)
+		router.With(r.tokenAuthz.HasScope(tokenEnums.ScopeUploadAnalysis)).Post("/", r.analysisHandler.Post)
+		router.With(r.tokenAuthz.HasScope(tokenEnums.ScopeReadAnalysis)).Get("/{analysisID}", r.analysisHandler.Get)
 	})
 }
 
@@ -1,6 +1,9 @@
 package main
 
-import "github.com/ZupIT/horusec-platform/auth/config/providers"
+import (
+	"github.com/ZupIT/horusec-platform/auth/config/providers"
+	"github.com/ZupIT/horusec-platform/clientip"
+)
 
 // @title Horusec-Auth
 // @description Service responsible for authentication and account operations.
@@ -19,5 +22,5 @@ func main() {
 		panic(err)
 	}
 
-	router.ListenAndServe()
+	clientip.ListenAndServe(router)
 }
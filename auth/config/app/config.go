@@ -13,7 +13,9 @@ import (
 
 	"github.com/ZupIT/horusec-platform/auth/config/app/enums"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
 )
 
 type IConfig interface {
@@ -28,6 +30,8 @@ type IConfig interface {
 	GetEnableDefaultUser() bool
 	GetDefaultUserData() (*accountEntities.Account, error)
 	GetApplicationAdminData() (*accountEntities.Account, error)
+	IsMFARequired() bool
+	GetPasswordPolicy() *passwordEntities.Policy
 }
 
 type Config struct {
@@ -39,6 +43,8 @@ type Config struct {
 	EnableDefaultUser      bool
 	DefaultUserData        string
 	HorusecManagerURL      string
+	RequireMFA             bool
+	PasswordPolicy         passwordEntities.Policy
 	databaseWrite          database.IDatabaseWrite
 }
 
@@ -52,12 +58,29 @@ func NewAuthAppConfig(connection *database.Connection) IConfig {
 		EnableDefaultUser:      env.GetEnvOrDefaultBool(enums.EnvEnableDefaultUser, false),
 		DefaultUserData:        env.GetEnvOrDefault(enums.EnvDefaultUserData, enums.DefaultUserData),
 		HorusecManagerURL:      env.GetEnvOrDefault(enums.EnvHorusecManager, "http://localhost:8043"),
+		RequireMFA:             env.GetEnvOrDefaultBool(enums.EnvRequireMFA, false),
+		PasswordPolicy:         newPasswordPolicy(),
 		databaseWrite:          connection.Write,
 	}
 
 	return config.createDefaultUsers()
 }
 
+// newPasswordPolicy defaults to the same rules that were required before the policy was configurable
+func newPasswordPolicy() passwordEntities.Policy {
+	return passwordEntities.Policy{
+		MinLength:               env.GetEnvOrDefaultInt(enums.EnvPasswordMinLength, passwordEnums.DefaultMinLength),
+		RequireUppercase:        env.GetEnvOrDefaultBool(enums.EnvPasswordRequireUppercase, true),
+		RequireLowercase:        env.GetEnvOrDefaultBool(enums.EnvPasswordRequireLowercase, true),
+		RequireNumber:           env.GetEnvOrDefaultBool(enums.EnvPasswordRequireNumber, true),
+		RequireSpecialCharacter: env.GetEnvOrDefaultBool(enums.EnvPasswordRequireSpecial, true),
+		HistorySize:             env.GetEnvOrDefaultInt(enums.EnvPasswordHistorySize, 0),
+		MaxAgeDays:              env.GetEnvOrDefaultInt(enums.EnvPasswordMaxAgeDays, 0),
+		CheckBreached:           env.GetEnvOrDefaultBool(enums.EnvPasswordCheckBreached, true),
+		BreachedHashesPath:      env.GetEnvOrDefault(enums.EnvPasswordBreachedHashes, ""),
+	}
+}
+
 func (c *Config) GetAuthenticationType() auth.AuthenticationType {
 	return c.AuthType
 }
@@ -67,6 +90,8 @@ func (c *Config) ToConfigResponse() map[string]interface{} {
 		"enableApplicationAdmin": c.EnableApplicationAdmin,
 		"authType":               c.AuthType,
 		"disableEmails":          c.DisableEmails,
+		"requireMfa":             c.RequireMFA,
+		"passwordPolicy":         c.PasswordPolicy,
 	}
 }
 
@@ -114,6 +139,14 @@ func (c *Config) GetApplicationAdminData() (*accountEntities.Account, error) {
 	return account, json.Unmarshal([]byte(c.ApplicationAdminData), &account)
 }
 
+func (c *Config) IsMFARequired() bool {
+	return c.RequireMFA
+}
+
+func (c *Config) GetPasswordPolicy() *passwordEntities.Policy {
+	return &c.PasswordPolicy
+}
+
 func (c *Config) createDefaultUsers() IConfig {
 	if c.GetEnableDefaultUser() {
 		c.createHorusecDefaultUser()
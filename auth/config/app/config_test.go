@@ -12,6 +12,7 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app/enums"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
 )
 
 func getMockedConnection() *database.Connection {
@@ -126,6 +127,8 @@ func TestToConfigResponse(t *testing.T) {
 			assert.Equal(t, false, result["enableApplicationAdmin"])
 			assert.Equal(t, auth.Horusec, result["authType"])
 			assert.Equal(t, false, result["disableEmails"])
+			assert.Equal(t, false, result["requireMfa"])
+			assert.NotNil(t, result["passwordPolicy"])
 		})
 	})
 }
@@ -157,6 +160,32 @@ func TestToGetAuthConfigResponse(t *testing.T) {
 	})
 }
 
+func TestGetPasswordPolicy(t *testing.T) {
+	t.Run("should get default password policy", func(t *testing.T) {
+		appConfig := NewAuthAppConfig(getMockedConnection())
+
+		policy := appConfig.GetPasswordPolicy()
+		assert.Equal(t, passwordEnums.DefaultMinLength, policy.MinLength)
+		assert.True(t, policy.RequireUppercase)
+		assert.True(t, policy.CheckBreached)
+		assert.Zero(t, policy.HistorySize)
+		assert.Zero(t, policy.MaxAgeDays)
+	})
+
+	t.Run("should get password policy from environment", func(t *testing.T) {
+		_ = os.Setenv(enums.EnvPasswordMinLength, "12")
+		_ = os.Setenv(enums.EnvPasswordHistorySize, "5")
+		defer func() {
+			_ = os.Unsetenv(enums.EnvPasswordMinLength)
+			_ = os.Unsetenv(enums.EnvPasswordHistorySize)
+		}()
+
+		policy := NewAuthAppConfig(getMockedConnection()).GetPasswordPolicy()
+		assert.Equal(t, 12, policy.MinLength)
+		assert.Equal(t, 5, policy.HistorySize)
+	})
+}
+
 func TestGetHorusecAuthURL(t *testing.T) {
 	t.Run("should success get auth url", func(t *testing.T) {
 		appConfig := NewAuthAppConfig(getMockedConnection())
@@ -189,6 +218,23 @@ func TestGetEnableDefaultUser(t *testing.T) {
 	})
 }
 
+func TestIsMFARequired(t *testing.T) {
+	t.Run("should return false when not required", func(t *testing.T) {
+		appConfig := NewAuthAppConfig(getMockedConnection())
+
+		assert.False(t, appConfig.IsMFARequired())
+	})
+
+	t.Run("should return true when required", func(t *testing.T) {
+		_ = os.Setenv(enums.EnvRequireMFA, "true")
+		defer func() { _ = os.Unsetenv(enums.EnvRequireMFA) }()
+
+		appConfig := NewAuthAppConfig(getMockedConnection())
+
+		assert.True(t, appConfig.IsMFARequired())
+	})
+}
+
 func TestGetDefaultUserData(t *testing.T) {
 	t.Run("should success get if default user is enabled", func(t *testing.T) {
 		appConfig := NewAuthAppConfig(getMockedConnection())
@@ -9,6 +9,16 @@ const (
 	EnvEnableDefaultUser        = "HORUSEC_ENABLE_DEFAULT_USER"
 	EnvDefaultUserData          = "HORUSEC_DEFAULT_USER_DATA"
 	EnvHorusecManager           = "HORUSEC_MANAGER_URL"
+	EnvRequireMFA               = "HORUSEC_AUTH_REQUIRE_MFA"
+	EnvPasswordMinLength        = "HORUSEC_PASSWORD_MIN_LENGTH"
+	EnvPasswordRequireUppercase = "HORUSEC_PASSWORD_REQUIRE_UPPERCASE"
+	EnvPasswordRequireLowercase = "HORUSEC_PASSWORD_REQUIRE_LOWERCASE"
+	EnvPasswordRequireNumber    = "HORUSEC_PASSWORD_REQUIRE_NUMBER"
+	EnvPasswordRequireSpecial   = "HORUSEC_PASSWORD_REQUIRE_SPECIAL_CHARACTER"
+	EnvPasswordHistorySize      = "HORUSEC_PASSWORD_HISTORY_SIZE"
+	EnvPasswordMaxAgeDays       = "HORUSEC_PASSWORD_MAX_AGE_DAYS"
+	EnvPasswordCheckBreached    = "HORUSEC_PASSWORD_CHECK_BREACHED"
+	EnvPasswordBreachedHashes   = "HORUSEC_PASSWORD_BREACHED_HASHES_PATH"
 	DuplicatedAccount           = "duplicate key value violates unique constraint"
 	DefaultUserData             = "{\"username\": \"dev\", \"email\":\"dev@example.com\", \"password\":\"Devpass0*\"}"
 	ApplicationAdminDefaultData = "{\"username\": \"horusec-admin\", \"email\":\"horusec-admin@example.com\"," +
@@ -8,7 +8,6 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	brokerConfig "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 	databaseConfig "github.com/ZupIT/horusec-devkit/pkg/services/database/config"
 
@@ -16,16 +15,38 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/cors"
 	"github.com/ZupIT/horusec-platform/auth/config/grpc"
 	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	adminController "github.com/ZupIT/horusec-platform/auth/internal/controllers/admin"
 	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
+	scimController "github.com/ZupIT/horusec-platform/auth/internal/controllers/scim"
 	accountHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/account"
+	adminHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/admin"
 	authHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/authentication"
 	healthHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/health"
+	scimHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/scim"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	adminRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/admin"
 	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	lockoutRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/lockout"
+	mfaRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/mfa"
+	passwordRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/password"
+	personalTokenRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/personaltoken"
+	scimRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/scim"
+	sessionRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/session"
 	"github.com/ZupIT/horusec-platform/auth/internal/router"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/horusec"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/keycloak"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/ldap"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/oidc"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/saml"
+	encryptionService "github.com/ZupIT/horusec-platform/auth/internal/services/encryption"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	lockoutService "github.com/ZupIT/horusec-platform/auth/internal/services/lockout"
+	mfaService "github.com/ZupIT/horusec-platform/auth/internal/services/mfa"
+	passwordService "github.com/ZupIT/horusec-platform/auth/internal/services/password"
+	personalTokenService "github.com/ZupIT/horusec-platform/auth/internal/services/personaltoken"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
 	authUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
 )
@@ -35,7 +56,6 @@ var devKitProviders = wire.NewSet(
 	brokerConfig.NewBrokerConfig,
 	broker.NewBroker,
 	database.NewDatabaseReadAndWrite,
-	cache.NewCache,
 	httpRouter.NewHTTPRouter,
 )
 
@@ -49,12 +69,16 @@ var configProviders = wire.NewSet(
 var controllerProviders = wire.NewSet(
 	authController.NewAuthenticationController,
 	accountController.NewAccountController,
+	scimController.NewSCIMController,
+	adminController.NewAdminController,
 )
 
 var handleProviders = wire.NewSet(
 	authHandler.NewAuthenticationHandler,
 	accountHandler.NewAccountHandler,
 	healthHandler.NewHealthHandler,
+	scimHandler.NewSCIMHandler,
+	adminHandler.NewAdminHandler,
 )
 
 var useCasesProviders = wire.NewSet(
@@ -65,12 +89,30 @@ var useCasesProviders = wire.NewSet(
 var repositoriesProviders = wire.NewSet(
 	accountRepository.NewAccountRepository,
 	authRepository.NewAuthenticationRepository,
+	mfaRepository.NewMFARepository,
+	cacheRepository.NewCacheRepository,
+	lockoutRepository.NewLockoutRepository,
+	sessionRepository.NewSessionRepository,
+	personalTokenRepository.NewPersonalTokenRepository,
+	scimRepository.NewSCIMRepository,
+	adminRepository.NewAdminRepository,
+	passwordRepository.NewPasswordRepository,
 )
 
 var serviceProviders = wire.NewSet(
 	horusec.NewHorusecAuthenticationService,
 	ldap.NewLDAPAuthenticationService,
 	keycloak.NewKeycloakAuthenticationService,
+	oidc.NewOIDCAuthenticationService,
+	saml.NewSAMLAuthenticationService,
+	auditService.NewAuditService,
+	encryptionService.NewEncryptionService,
+	invitationService.NewInvitationService,
+	mfaService.NewMFAService,
+	lockoutService.NewLockoutService,
+	sessionService.NewSessionService,
+	personalTokenService.NewPersonalTokenService,
+	passwordService.NewPasswordService,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
@@ -8,7 +8,6 @@ package providers
 import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	config2 "github.com/ZupIT/horusec-devkit/pkg/services/broker/config"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/config"
 	router2 "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
@@ -18,16 +17,38 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/cors"
 	"github.com/ZupIT/horusec-platform/auth/config/grpc"
 	account3 "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	admin3 "github.com/ZupIT/horusec-platform/auth/internal/controllers/admin"
 	authentication3 "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
+	scim3 "github.com/ZupIT/horusec-platform/auth/internal/controllers/scim"
 	account4 "github.com/ZupIT/horusec-platform/auth/internal/handlers/account"
+	admin4 "github.com/ZupIT/horusec-platform/auth/internal/handlers/admin"
 	authentication4 "github.com/ZupIT/horusec-platform/auth/internal/handlers/authentication"
 	"github.com/ZupIT/horusec-platform/auth/internal/handlers/health"
+	scim4 "github.com/ZupIT/horusec-platform/auth/internal/handlers/scim"
 	account2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	admin2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/admin"
 	authentication2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	"github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	lockout2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/lockout"
+	mfa2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/mfa"
+	password2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/password"
+	personaltoken2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/personaltoken"
+	scim2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/scim"
+	session2 "github.com/ZupIT/horusec-platform/auth/internal/repositories/session"
 	"github.com/ZupIT/horusec-platform/auth/internal/router"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/audit"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/horusec"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/keycloak"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/ldap"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/oidc"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/saml"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/encryption"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/lockout"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/mfa"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/password"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/personaltoken"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	"github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
 	"github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
 )
@@ -47,37 +68,58 @@ func Initialize(string2 string) (router.IRouter, error) {
 	accountIUseCases := account.NewAccountUseCases(appIConfig)
 	iRepository := account2.NewAccountRepository(connection, accountIUseCases)
 	authenticationIRepository := authentication2.NewAuthenticationRepository(connection, iUseCases)
-	iCache := cache.NewCache()
-	iService := horusec.NewHorusecAuthenticationService(iRepository, appIConfig, iUseCases, authenticationIRepository, iCache)
-	ldapIService := ldap.NewLDAPAuthenticationService(iRepository, iUseCases, appIConfig, authenticationIRepository, iCache)
-	keycloakIService := keycloak.NewKeycloakAuthenticationService(iRepository, appIConfig, iUseCases, authenticationIRepository)
-	iController := authentication3.NewAuthenticationController(appIConfig, iService, ldapIService, keycloakIService, iRepository)
-	handler := authentication4.NewAuthenticationHandler(appIConfig, iUseCases, iController)
-	iAuthGRPCServer := grpc.NewAuthGRPCServer(handler)
+	mfaIRepository := mfa2.NewMFARepository(connection, accountIUseCases)
+	sessionIRepository := session2.NewSessionRepository(connection)
+	sessionIService := session.NewSessionService(sessionIRepository, iRepository)
+	cacheIRepository := cache.NewCacheRepository(connection)
+	encryptionIService := encryption.NewEncryptionService()
+	mfaIService := mfa.NewMFAService(mfaIRepository, iRepository, appIConfig, cacheIRepository, encryptionIService)
+	passwordIRepository := password2.NewPasswordRepository(connection)
+	passwordIService := password.NewPasswordService(passwordIRepository, appIConfig, cacheIRepository)
+	iService := horusec.NewHorusecAuthenticationService(iRepository, appIConfig, iUseCases, authenticationIRepository, sessionIService, mfaIService, passwordIService)
 	configIConfig := config2.NewBrokerConfig()
 	iBroker, err := broker.NewBroker(configIConfig)
 	if err != nil {
 		return nil, err
 	}
-	accountIController := account3.NewAccountController(iRepository, keycloakIService, accountIUseCases, appIConfig, iBroker, iCache)
-	accountHandler := account4.NewAccountHandler(accountIUseCases, accountIController, appIConfig)
+	invitationIService := invitation.NewInvitationService(iBroker)
+	ldapIService := ldap.NewLDAPAuthenticationService(iRepository, iUseCases, appIConfig, authenticationIRepository, sessionIService, invitationIService)
+	keycloakIService := keycloak.NewKeycloakAuthenticationService(iRepository, appIConfig, iUseCases, authenticationIRepository)
+	oidcIService := oidc.NewOIDCAuthenticationService(iRepository, appIConfig, authenticationIRepository, cacheIRepository, sessionIService, invitationIService)
+	samlIService := saml.NewSAMLAuthenticationService(iRepository, appIConfig, authenticationIRepository, cacheIRepository, sessionIService, invitationIService)
+	lockoutIRepository := lockout2.NewLockoutRepository(connection)
+	lockoutIService := lockout.NewLockoutService(cacheIRepository, lockoutIRepository, iRepository, accountIUseCases, appIConfig, iBroker)
+	personaltokenIRepository := personaltoken2.NewPersonalTokenRepository(connection)
+	personaltokenIService := personaltoken.NewPersonalTokenService(personaltokenIRepository, iRepository, appIConfig)
+	iController := authentication3.NewAuthenticationController(appIConfig, iService, ldapIService, keycloakIService, oidcIService, samlIService, iRepository, lockoutIService, personaltokenIService, authenticationIRepository)
+	handler := authentication4.NewAuthenticationHandler(appIConfig, iUseCases, iController)
+	iAuthGRPCServer := grpc.NewAuthGRPCServer(handler)
+	accountIController := account3.NewAccountController(iRepository, keycloakIService, accountIUseCases, appIConfig, iBroker, sessionIService, mfaIService, lockoutIService, cacheIRepository, personaltokenIService, passwordIService)
+	auditIService := audit.NewAuditService(iBroker, iRepository)
+	accountHandler := account4.NewAccountHandler(accountIUseCases, accountIController, appIConfig, auditIService)
 	healthHandler := health.NewHealthHandler(connection, iBroker)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthGRPCServer, handler, accountHandler, healthHandler)
+	scimIRepository := scim2.NewSCIMRepository(connection)
+	scimIController := scim3.NewSCIMController(scimIRepository, iRepository, iController, sessionIService, invitationIService)
+	scimHandler := scim4.NewSCIMHandler(scimIController, accountIController, auditIService)
+	adminIRepository := admin2.NewAdminRepository(connection)
+	adminIController := admin3.NewAdminController(adminIRepository, iRepository, accountIController, iController, sessionIService, appIConfig)
+	adminHandler := admin4.NewAdminHandler(adminIController, accountIController, auditIService)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthGRPCServer, handler, accountHandler, healthHandler, scimHandler, adminHandler)
 	return routerIRouter, nil
 }
 
 // wire.go:
 
-var devKitProviders = wire.NewSet(config.NewDatabaseConfig, config2.NewBrokerConfig, broker.NewBroker, database.NewDatabaseReadAndWrite, cache.NewCache, router2.NewHTTPRouter)
+var devKitProviders = wire.NewSet(config.NewDatabaseConfig, config2.NewBrokerConfig, broker.NewBroker, database.NewDatabaseReadAndWrite, router2.NewHTTPRouter)
 
 var configProviders = wire.NewSet(grpc.NewAuthGRPCServer, cors.NewCorsConfig, app.NewAuthAppConfig, router.NewHTTPRouter)
 
-var controllerProviders = wire.NewSet(authentication3.NewAuthenticationController, account3.NewAccountController)
+var controllerProviders = wire.NewSet(authentication3.NewAuthenticationController, account3.NewAccountController, scim3.NewSCIMController, admin3.NewAdminController)
 
-var handleProviders = wire.NewSet(authentication4.NewAuthenticationHandler, account4.NewAccountHandler, health.NewHealthHandler)
+var handleProviders = wire.NewSet(authentication4.NewAuthenticationHandler, account4.NewAccountHandler, health.NewHealthHandler, scim4.NewSCIMHandler, admin4.NewAdminHandler)
 
 var useCasesProviders = wire.NewSet(authentication.NewAuthenticationUseCases, account.NewAccountUseCases)
 
-var repositoriesProviders = wire.NewSet(account2.NewAccountRepository, authentication2.NewAuthenticationRepository)
+var repositoriesProviders = wire.NewSet(account2.NewAccountRepository, authentication2.NewAuthenticationRepository, mfa2.NewMFARepository, cache.NewCacheRepository, lockout2.NewLockoutRepository, session2.NewSessionRepository, personaltoken2.NewPersonalTokenRepository, scim2.NewSCIMRepository, admin2.NewAdminRepository, password2.NewPasswordRepository)
 
-var serviceProviders = wire.NewSet(horusec.NewHorusecAuthenticationService, ldap.NewLDAPAuthenticationService, keycloak.NewKeycloakAuthenticationService)
+var serviceProviders = wire.NewSet(horusec.NewHorusecAuthenticationService, ldap.NewLDAPAuthenticationService, keycloak.NewKeycloakAuthenticationService, oidc.NewOIDCAuthenticationService, saml.NewSAMLAuthenticationService, audit.NewAuditService, encryption.NewEncryptionService, invitation.NewInvitationService, mfa.NewMFAService, lockout.NewLockoutService, session.NewSessionService, personaltoken.NewPersonalTokenService, password.NewPasswordService)
@@ -87,7 +87,7 @@ var doc = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Check for valid reset password code",
+                "description": "Check for valid reset password code, returning a token that only allows changing the password",
                 "consumes": [
                     "application/json"
                 ],
@@ -5,24 +5,31 @@ go 1.16
 require (
 	github.com/Nerzal/gocloak/v7 v7.11.0
 	github.com/ZupIT/horusec-devkit v1.0.3
+	github.com/ZupIT/horusec-platform/clientip v0.0.0
+	github.com/ZupIT/horusec-platform/permission v0.0.0
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
+	github.com/beevik/etree v1.1.0
+	github.com/crewjam/saml v0.4.14
 	github.com/go-asn1-ber/asn1-ber v1.5.3 // indirect
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.2.0
 	github.com/go-ldap/ldap/v3 v3.3.0
 	github.com/go-ozzo/ozzo-validation/v4 v4.3.0
 	github.com/go-resty/resty/v2 v2.6.0 // indirect
+	github.com/golang-jwt/jwt/v4 v4.5.2
 	github.com/google/uuid v1.2.0
 	github.com/google/wire v0.5.0
 	github.com/lib/pq v1.3.0
 	github.com/pkg/errors v0.9.1
 	github.com/prometheus/common v0.23.0 // indirect
-	github.com/stretchr/testify v1.7.0
+	github.com/russellhaering/goxmldsig v1.3.0 // indirect
+	github.com/stretchr/testify v1.8.1
 	github.com/swaggo/swag v1.7.0
-	golang.org/x/crypto v0.0.0-20210503195802-e9a32991a82e // indirect
-	golang.org/x/net v0.0.0-20210504132125-bbd867fde50d // indirect
-	golang.org/x/sys v0.0.0-20210503173754-0981d6026fa6 // indirect
-	google.golang.org/genproto v0.0.0-20210503173045-b96a97608f20 // indirect
 	google.golang.org/grpc v1.37.0
 	gorm.io/gorm v1.21.9 // indirect
 )
+
+replace (
+	github.com/ZupIT/horusec-platform/clientip => ../clientip
+	github.com/ZupIT/horusec-platform/permission => ../permission
+)
@@ -1,12 +1,15 @@
 package account
 
 import (
+	"fmt"
+
+	"github.com/Nerzal/gocloak/v7"
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
@@ -14,26 +17,58 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/keycloak"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	lockoutService "github.com/ZupIT/horusec-platform/auth/internal/services/lockout"
+	mfaService "github.com/ZupIT/horusec-platform/auth/internal/services/mfa"
+	passwordService "github.com/ZupIT/horusec-platform/auth/internal/services/password"
+	personalTokenService "github.com/ZupIT/horusec-platform/auth/internal/services/personaltoken"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
 )
 
 type IController interface {
 	CreateAccountKeycloak(token string) (*accountEntities.Response, error)
 	CreateAccountHorusec(data *accountEntities.Data) (*accountEntities.Response, error)
-	ValidateAccountEmail(accountID uuid.UUID) error
-	SendResetPasswordCode(email string) error
+	ValidateAccountEmail(token string) error
+	ResendValidationEmail(data *accountEntities.Email) error
+	SendAccountValidationEmail(account *accountEntities.Account) error
+	SendResetPasswordCode(data *accountEntities.Email) error
+	SendAccountResetPasswordCode(account *accountEntities.Account) error
 	CheckResetPasswordCode(data *accountEntities.ResetCodeData) (string, error)
 	ChangePassword(data *accountEntities.ChangePasswordData) error
+	ChangeExpiredPassword(data *passwordEntities.ExpiredPasswordData) (*authEntities.LoginResponse, error)
 	RefreshToken(refreshToken string) (*authEntities.LoginResponse, error)
 	Logout(refreshToken string)
 	CheckExistingEmailOrUsername(data *accountEntities.CheckEmailAndUsername) error
 	DeleteAccount(accountID uuid.UUID) error
 	GetAccountID(token string) (uuid.UUID, error)
 	UpdateAccount(data *accountEntities.UpdateAccount) (*accountEntities.Response, error)
+	EnrollMFA(accountID uuid.UUID) (*mfaEntities.EnrollmentResponse, error)
+	EnableMFA(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error)
+	DisableMFA(data *mfaEntities.CodeData) error
+	RegenerateMFARecoveryCodes(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error)
+	UnlockAccount(accountID, actorID uuid.UUID) error
+	UnlockAccountWithToken(token string) error
+	ListSessions(accountID uuid.UUID) ([]*sessionEntities.Session, error)
+	RevokeSession(accountID, sessionID uuid.UUID) error
+	RevokeAccountSessions(accountID, actorID uuid.UUID) error
+	CreatePersonalAccessToken(token string,
+		data *personalTokenEntities.Data) (*personalTokenEntities.CreateResponse, error)
+	ListPersonalAccessTokens(accountID uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error)
+	RevokePersonalAccessToken(accountID, tokenID uuid.UUID) error
 }
 
 type Controller struct {
@@ -42,19 +77,33 @@ type Controller struct {
 	accountUseCases   accountUseCases.IUseCases
 	appConfig         app.IConfig
 	broker            broker.IBroker
-	cache             cache.ICache
+	sessionService    sessionService.IService
+	mfaService        mfaService.IService
+	lockoutService    lockoutService.IService
+	cacheRepository   cacheRepository.IRepository
+	personalToken     personalTokenService.IService
+	passwordService   passwordService.IService
+	invitationService invitationService.IService
 }
 
 func NewAccountController(repositoryAccount accountRepository.IRepository, keycloakAuth keycloak.IService,
 	useCasesAccount accountUseCases.IUseCases, appConfig app.IConfig, brokerLib broker.IBroker,
-	cacheLib cache.ICache) IController {
+	serviceSession sessionService.IService, serviceMFA mfaService.IService, serviceLockout lockoutService.IService,
+	repositoryCache cacheRepository.IRepository, servicePersonalToken personalTokenService.IService,
+	servicePassword passwordService.IService) IController {
 	return &Controller{
 		accountRepository: repositoryAccount,
 		keycloakAuth:      keycloakAuth,
 		appConfig:         appConfig,
 		accountUseCases:   useCasesAccount,
 		broker:            brokerLib,
-		cache:             cacheLib,
+		sessionService:    serviceSession,
+		mfaService:        serviceMFA,
+		lockoutService:    serviceLockout,
+		cacheRepository:   repositoryCache,
+		personalToken:     servicePersonalToken,
+		passwordService:   servicePassword,
+		invitationService: invitationService.NewInvitationService(brokerLib),
 	}
 }
 
@@ -70,10 +119,36 @@ func (c *Controller) CreateAccountKeycloak(token string) (*accountEntities.Respo
 			c.accountUseCases.CheckCreateAccountErrors(err)
 	}
 
+	c.bindKeycloakInvitations(account, userInfo)
+
 	return account.ToResponse(), nil
 }
 
+// bindKeycloakInvitations only binds the pending invitations when keycloak asserts that the email is verified
+func (c *Controller) bindKeycloakInvitations(account *accountEntities.Account, userInfo *gocloak.UserInfo) {
+	if userInfo.EmailVerified != nil && *userInfo.EmailVerified {
+		c.invitationService.BindPendingInvitations(account)
+	}
+}
+
 func (c *Controller) CreateAccountHorusec(data *accountEntities.Data) (*accountEntities.Response, error) {
+	account, err := c.createAccountHorusec(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.passwordService.SaveHistory(account); err != nil {
+		return nil, err
+	}
+
+	return account.ToResponse(), c.sendValidateAccountEmail(account)
+}
+
+func (c *Controller) createAccountHorusec(data *accountEntities.Data) (*accountEntities.Account, error) {
+	if err := c.passwordService.Validate(uuid.Nil, data.Password); err != nil {
+		return nil, err
+	}
+
 	account := data.ToAccount()
 	if c.appConfig.IsEmailsDisabled() {
 		_ = account.SetIsConfirmedTrue()
@@ -83,41 +158,142 @@ func (c *Controller) CreateAccountHorusec(data *accountEntities.Data) (*accountE
 		return nil, c.accountUseCases.CheckCreateAccountErrors(err)
 	}
 
-	return account.ToResponse(), c.sendValidateAccountEmail(account)
+	c.bindConfirmedAccountInvitations(account)
+	return account, nil
+}
+
+// bindConfirmedAccountInvitations binds the pending invitations of accounts confirmed without the email validation,
+// the others are bound when the email is validated
+func (c *Controller) bindConfirmedAccountInvitations(account *accountEntities.Account) {
+	if account.IsConfirmed {
+		c.invitationService.BindPendingInvitations(account)
+	}
 }
 
+// sendValidateAccountEmail overwrites any pending verification of the account, so only the last link sent is valid
 func (c *Controller) sendValidateAccountEmail(account *accountEntities.Account) error {
 	if c.appConfig.IsEmailsDisabled() {
 		return nil
 	}
 
+	verification, token := accountEntities.NewEmailVerification(account)
+	if err := c.cacheRepository.Set(c.getEmailVerificationKey(account.AccountID), verification.ToString(),
+		accountEnums.VerificationTokenDuration); err != nil {
+		return err
+	}
+
 	return c.broker.Publish(queues.HorusecEmail.ToString(), "", "",
-		c.accountUseCases.NewAccountValidationEmail(account))
+		c.accountUseCases.NewAccountValidationEmail(account, token))
 }
 
-func (c *Controller) ValidateAccountEmail(accountID uuid.UUID) error {
-	account, err := c.accountRepository.GetAccount(accountID)
+func (c *Controller) getEmailVerificationKey(accountID uuid.UUID) string {
+	return fmt.Sprintf(accountEnums.CacheKeyEmailVerification, accountID)
+}
+
+func (c *Controller) ValidateAccountEmail(token string) error {
+	account, err := c.getAccountToValidate(token)
 	if err != nil {
 		return err
 	}
 
-	_, err = c.accountRepository.Update(account.SetIsConfirmedTrue())
-	return err
+	if _, err = c.accountRepository.Update(account.SetIsConfirmedTrue()); err != nil {
+		return err
+	}
+
+	c.invitationService.BindPendingInvitations(account)
+
+	return c.cacheRepository.Delete(c.getEmailVerificationKey(account.AccountID))
 }
 
-func (c *Controller) SendResetPasswordCode(email string) error {
-	account, err := c.accountRepository.GetAccountByEmail(email)
+func (c *Controller) getAccountToValidate(token string) (*accountEntities.Account, error) {
+	accountID, secret, err := accountEntities.ParseVerificationToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := c.getEmailVerification(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getVerificationAccount(verification, secret)
+}
+
+// getVerificationAccount checks the secret against the current account, so a link sent to a previous email is invalid
+func (c *Controller) getVerificationAccount(verification *accountEntities.EmailVerification,
+	secret string) (*accountEntities.Account, error) {
+	account, err := c.accountRepository.GetAccount(verification.AccountID)
+	if err != nil || !verification.Matches(secret, account) {
+		return nil, accountEnums.ErrorInvalidVerificationToken
+	}
+
+	return account, nil
+}
+
+func (c *Controller) getEmailVerification(accountID uuid.UUID) (*accountEntities.EmailVerification, error) {
+	value, err := c.cacheRepository.Get(c.getEmailVerificationKey(accountID))
+	if err != nil {
+		return nil, accountEnums.ErrorInvalidVerificationToken
+	}
+
+	verification, err := accountEntities.ParseEmailVerification(value)
+	if err != nil {
+		return nil, accountEnums.ErrorInvalidVerificationToken
+	}
+
+	return verification, nil
+}
+
+func (c *Controller) ResendValidationEmail(data *accountEntities.Email) error {
+	account, err := c.getAccountByEmail(data)
+	if err != nil {
+		return err
+	}
+
+	return c.SendAccountValidationEmail(account)
+}
+
+func (c *Controller) SendAccountValidationEmail(account *accountEntities.Account) error {
+	if account.IsConfirmed {
+		return accountEnums.ErrorAccountAlreadyConfirmed
+	}
+
+	return c.sendValidateAccountEmail(account)
+}
+
+func (c *Controller) SendResetPasswordCode(data *accountEntities.Email) error {
+	account, err := c.getAccountByEmail(data)
 	if err != nil {
 		return err
 	}
 
+	return c.SendAccountResetPasswordCode(account)
+}
+
+func (c *Controller) SendAccountResetPasswordCode(account *accountEntities.Account) error {
 	code := c.accountUseCases.GenerateResetPasswordCode()
 
-	c.cache.Set(account.Email, code, accountEnums.ResetPasswordCodeDuration)
+	if err := c.lockoutService.SetResetPasswordCode(account.Email, code); err != nil {
+		return err
+	}
 
 	return c.sendResetPasswordCodeEmail(account, code)
 }
 
+// getAccountByEmail counts the unknown emails as failures of the address, since they are used to find accounts
+func (c *Controller) getAccountByEmail(data *accountEntities.Email) (*accountEntities.Account, error) {
+	if err := c.lockoutService.CheckIP(data.IPAddress); err != nil {
+		return nil, err
+	}
+
+	account, err := c.accountRepository.GetAccountByEmail(data.Email)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		c.lockoutService.RegisterIPFailure(data.IPAddress)
+	}
+
+	return account, err
+}
+
 func (c *Controller) sendResetPasswordCodeEmail(account *accountEntities.Account, code string) error {
 	if c.appConfig.IsEmailsDisabled() {
 		return nil
@@ -128,70 +304,141 @@ func (c *Controller) sendResetPasswordCodeEmail(account *accountEntities.Account
 }
 
 func (c *Controller) CheckResetPasswordCode(data *accountEntities.ResetCodeData) (string, error) {
-	correctCode, err := c.cache.GetString(data.Email)
+	if err := c.lockoutService.CheckResetPasswordCode(data.Email, data.Code, data.IPAddress); err != nil {
+		return "", err
+	}
+
+	return c.createChangeTokenIfCorrectCode(data)
+}
+
+func (c *Controller) createChangeTokenIfCorrectCode(data *accountEntities.ResetCodeData) (string, error) {
+	account, err := c.accountRepository.GetAccountByEmail(data.Email)
 	if err != nil {
 		return "", err
 	}
 
-	if data.Code != correctCode {
-		return "", accountEnums.ErrorIncorrectRetrievePasswordCode
+	token, err := c.createResetPasswordChangeToken(account)
+	if err != nil {
+		return "", err
 	}
 
-	return c.createAccessTokenIfCorrectCode(data)
+	c.lockoutService.DeleteResetPasswordCode(data.Email)
+	return token, nil
 }
 
-func (c *Controller) createAccessTokenIfCorrectCode(data *accountEntities.ResetCodeData) (string, error) {
-	account, err := c.accountRepository.GetAccountByEmail(data.Email)
+// createResetPasswordChangeToken returns a token that only allows changing the password, the code doesn't replace
+// the second factor, so it is required after the change when the account uses mfa
+func (c *Controller) createResetPasswordChangeToken(account *accountEntities.Account) (string, error) {
+	isMFARequired, err := c.mfaService.IsChallengeRequired(account)
 	if err != nil {
 		return "", err
 	}
 
-	token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
-	c.cache.Delete(data.Email)
-	return token, nil
+	return c.passwordService.NewChangeToken(account, isMFARequired)
 }
 
 func (c *Controller) ChangePassword(data *accountEntities.ChangePasswordData) error {
-	account, err := c.accountRepository.GetAccount(data.AccountID)
-	if err != nil {
+	if _, err := c.setNewPassword(data.AccountID, data.Password); err != nil {
 		return err
 	}
 
-	if crypto.CheckPasswordHashBcrypt(data.Password, account.Password) {
+	return c.sessionService.RevokeOtherSessions(data.AccountID, data.RefreshToken)
+}
+
+// setNewPassword checks the password against the policy and keeps the new one in the password history
+func (c *Controller) setNewPassword(accountID uuid.UUID, password string) (*accountEntities.Account, error) {
+	account, err := c.accountRepository.GetAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.validateNewPassword(account, password); err != nil {
+		return nil, err
+	}
+
+	if _, err = c.accountRepository.Update(account.SetNewPassword(password)); err != nil {
+		return nil, err
+	}
+
+	return account, c.passwordService.SaveHistory(account)
+}
+
+func (c *Controller) validateNewPassword(account *accountEntities.Account, password string) error {
+	if crypto.CheckPasswordHashBcrypt(password, account.Password) {
 		return accountEnums.ErrorPasswordEqualPrevious
 	}
 
-	_, err = c.accountRepository.Update(account.SetNewPassword(data.Password))
-	return err
+	return c.passwordService.Validate(account.AccountID, password)
 }
 
-func (c *Controller) RefreshToken(refreshToken string) (*authEntities.LoginResponse, error) {
-	accountID, err := c.cache.GetString(refreshToken)
+// ChangeExpiredPassword uses the token returned by the login with an expired password or by the reset password code,
+// the tokens of the session are only created after the password is changed
+func (c *Controller) ChangeExpiredPassword(
+	data *passwordEntities.ExpiredPasswordData) (*authEntities.LoginResponse, error) {
+	challenge, err := c.passwordService.GetExpiredChallenge(data.PasswordChangeToken)
 	if err != nil {
 		return nil, err
 	}
 
-	account, err := c.accountRepository.GetAccount(parser.ParseStringToUUID(accountID))
+	account, err := c.setNewPassword(challenge.AccountID, data.Password)
 	if err != nil {
 		return nil, err
 	}
 
-	c.cache.Delete(refreshToken)
-	return c.createNewTokens(account), nil
+	if err := c.passwordService.RemoveExpiredChallenge(data.PasswordChangeToken); err != nil {
+		return nil, err
+	}
+
+	return c.getChangedPasswordLoginResponse(account, challenge, &data.Device)
 }
 
-func (c *Controller) createNewTokens(account *accountEntities.Account) *authEntities.LoginResponse {
+// getChangedPasswordLoginResponse returns a mfa challenge instead of the session when the second factor wasn't
+// checked before the change token was created
+func (c *Controller) getChangedPasswordLoginResponse(account *accountEntities.Account,
+	challenge *passwordEntities.ChangeChallenge, device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	if !challenge.IsMFARequired {
+		return c.createLoginSession(account, device)
+	}
+
+	if err := c.sessionService.RevokeAllSessions(account.AccountID); err != nil {
+		return nil, err
+	}
+
+	return c.mfaService.NewChallenge(account)
+}
+
+func (c *Controller) createLoginSession(account *accountEntities.Account,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	if err := c.sessionService.RevokeAllSessions(account.AccountID); err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := c.sessionService.CreateSession(account.AccountID, device)
+	if err != nil {
+		return nil, err
+	}
+
 	accessToken, expiresAt, _ := jwt.CreateToken(account.ToTokenData(), nil)
+	return account.ToLoginResponse(accessToken, refreshToken, expiresAt), nil
+}
 
-	refreshToken := jwt.CreateRefreshToken()
+func (c *Controller) RefreshToken(refreshToken string) (*authEntities.LoginResponse, error) {
+	session, newRefreshToken, err := c.sessionService.RefreshSession(refreshToken)
+	if err != nil {
+		return nil, err
+	}
 
-	c.cache.Set(refreshToken, account.AccountID, authEnums.TokenDuration)
+	account, err := c.accountRepository.GetAccount(session.AccountID)
+	if err != nil {
+		return nil, err
+	}
 
-	return account.ToLoginResponse(accessToken, refreshToken, expiresAt)
+	accessToken, expiresAt, _ := jwt.CreateToken(account.ToTokenData(), nil)
+	return account.ToLoginResponse(accessToken, newRefreshToken, expiresAt), nil
 }
 
 func (c *Controller) Logout(refreshToken string) {
-	c.cache.Delete(refreshToken)
+	_ = c.sessionService.RevokeSessionByToken(refreshToken)
 }
 
 func (c *Controller) CheckExistingEmailOrUsername(data *accountEntities.CheckEmailAndUsername) error {
@@ -218,7 +465,7 @@ func (c *Controller) GetAccountID(token string) (uuid.UUID, error) {
 		return jwt.GetAccountIDByJWTToken(token)
 	case auth.Keycloak:
 		return c.getAccountIDKeycloak(token)
-	case auth.Ldap:
+	case auth.Ldap, oidcEnums.AuthenticationTypeOIDC, samlEnums.AuthenticationTypeSAML:
 		return jwt.GetAccountIDByJWTToken(token)
 	}
 
@@ -254,6 +501,10 @@ func (c *Controller) UpdateAccount(data *accountEntities.UpdateAccount) (*accoun
 
 func (c *Controller) checkForEmailChange(data *accountEntities.UpdateAccount, account *accountEntities.Account) error {
 	if data.HasEmailChange(account.Email) {
+		if err := c.cacheRepository.Delete(c.getEmailVerificationKey(account.AccountID)); err != nil {
+			return err
+		}
+
 		account.UpdateFromUpdateAccountData(data)
 		return c.sendValidateAccountEmail(account)
 	}
@@ -261,3 +512,82 @@ func (c *Controller) checkForEmailChange(data *accountEntities.UpdateAccount, ac
 	account.UpdateFromUpdateAccountData(data)
 	return nil
 }
+
+func (c *Controller) EnrollMFA(accountID uuid.UUID) (*mfaEntities.EnrollmentResponse, error) {
+	if c.appConfig.GetAuthenticationType() != auth.Horusec {
+		return nil, mfaEnums.ErrorMFAOnlyHorusecAuth
+	}
+
+	return c.mfaService.Enroll(accountID)
+}
+
+func (c *Controller) EnableMFA(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	if c.appConfig.GetAuthenticationType() != auth.Horusec {
+		return nil, mfaEnums.ErrorMFAOnlyHorusecAuth
+	}
+
+	return c.mfaService.Enable(data)
+}
+
+func (c *Controller) DisableMFA(data *mfaEntities.CodeData) error {
+	if c.appConfig.GetAuthenticationType() != auth.Horusec {
+		return mfaEnums.ErrorMFAOnlyHorusecAuth
+	}
+
+	return c.mfaService.Disable(data)
+}
+
+func (c *Controller) RegenerateMFARecoveryCodes(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse,
+	error) {
+	if c.appConfig.GetAuthenticationType() != auth.Horusec {
+		return nil, mfaEnums.ErrorMFAOnlyHorusecAuth
+	}
+
+	return c.mfaService.RegenerateRecoveryCodes(data)
+}
+
+func (c *Controller) UnlockAccount(accountID, actorID uuid.UUID) error {
+	return c.lockoutService.UnlockByAdmin(accountID, actorID)
+}
+
+func (c *Controller) UnlockAccountWithToken(token string) error {
+	return c.lockoutService.UnlockWithToken(token)
+}
+
+func (c *Controller) ListSessions(accountID uuid.UUID) ([]*sessionEntities.Session, error) {
+	return c.sessionService.ListSessions(accountID)
+}
+
+func (c *Controller) RevokeSession(accountID, sessionID uuid.UUID) error {
+	return c.sessionService.RevokeSession(accountID, sessionID)
+}
+
+func (c *Controller) RevokeAccountSessions(accountID, actorID uuid.UUID) error {
+	return c.sessionService.RevokeAccountSessionsByAdmin(accountID, actorID)
+}
+
+// CreatePersonalAccessToken the token keeps the groups of the jwt that issued it, keycloak isn't supported since its
+// authorization only accepts keycloak tokens
+func (c *Controller) CreatePersonalAccessToken(token string,
+	data *personalTokenEntities.Data) (*personalTokenEntities.CreateResponse, error) {
+	if c.appConfig.GetAuthenticationType() == auth.Keycloak {
+		return nil, personalTokenEnums.ErrorNotSupported
+	}
+
+	claims, err := jwt.DecodeToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.personalToken.CreateToken(data.SetAccountData(parser.ParseStringToUUID(claims.Subject),
+		claims.Permissions))
+}
+
+func (c *Controller) ListPersonalAccessTokens(
+	accountID uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error) {
+	return c.personalToken.ListTokens(accountID)
+}
+
+func (c *Controller) RevokePersonalAccessToken(accountID, tokenID uuid.UUID) error {
+	return c.personalToken.RevokeToken(accountID, tokenID)
+}
@@ -8,6 +8,10 @@ import (
 
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
 )
 
 type Mock struct {
@@ -24,21 +28,41 @@ func (m *Mock) CreateAccountHorusec(_ *accountEntities.Data) (*accountEntities.R
 	return args.Get(0).(*accountEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) ValidateAccountEmail(_ uuid.UUID) error {
+func (m *Mock) ValidateAccountEmail(_ string) error {
 	args := m.MethodCalled("ValidateAccountEmail")
 	return mockUtils.ReturnNilOrError(args, 0)
 }
 
-func (m *Mock) SendResetPasswordCode(_ string) error {
+func (m *Mock) ResendValidationEmail(_ *accountEntities.Email) error {
+	args := m.MethodCalled("ResendValidationEmail")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) SendAccountValidationEmail(_ *accountEntities.Account) error {
+	args := m.MethodCalled("SendAccountValidationEmail")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) SendResetPasswordCode(_ *accountEntities.Email) error {
 	args := m.MethodCalled("SendResetPasswordCode")
 	return mockUtils.ReturnNilOrError(args, 0)
 }
 
+func (m *Mock) SendAccountResetPasswordCode(_ *accountEntities.Account) error {
+	args := m.MethodCalled("SendAccountResetPasswordCode")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
 func (m *Mock) ChangePassword(_ *accountEntities.ChangePasswordData) error {
 	args := m.MethodCalled("ChangePassword")
 	return mockUtils.ReturnNilOrError(args, 0)
 }
 
+func (m *Mock) ChangeExpiredPassword(_ *passwordEntities.ExpiredPasswordData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("ChangeExpiredPassword")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
 func (m *Mock) CheckExistingEmailOrUsername(_ *accountEntities.CheckEmailAndUsername) error {
 	args := m.MethodCalled("CheckExistingEmailOrUsername")
 	return mockUtils.ReturnNilOrError(args, 0)
@@ -72,3 +96,64 @@ func (m *Mock) UpdateAccount(_ *accountEntities.UpdateAccount) (*accountEntities
 	args := m.MethodCalled("UpdateAccount")
 	return args.Get(0).(*accountEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) EnrollMFA(_ uuid.UUID) (*mfaEntities.EnrollmentResponse, error) {
+	args := m.MethodCalled("EnrollMFA")
+	return args.Get(0).(*mfaEntities.EnrollmentResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) EnableMFA(_ *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	args := m.MethodCalled("EnableMFA")
+	return args.Get(0).(*mfaEntities.RecoveryCodesResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DisableMFA(_ *mfaEntities.CodeData) error {
+	args := m.MethodCalled("DisableMFA")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RegenerateMFARecoveryCodes(_ *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	args := m.MethodCalled("RegenerateMFARecoveryCodes")
+	return args.Get(0).(*mfaEntities.RecoveryCodesResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) UnlockAccount(_, _ uuid.UUID) error {
+	args := m.MethodCalled("UnlockAccount")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) UnlockAccountWithToken(_ string) error {
+	args := m.MethodCalled("UnlockAccountWithToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListSessions(_ uuid.UUID) ([]*sessionEntities.Session, error) {
+	args := m.MethodCalled("ListSessions")
+	return args.Get(0).([]*sessionEntities.Session), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeSession(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeSession")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeAccountSessions(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeAccountSessions")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) CreatePersonalAccessToken(_ string,
+	_ *personalTokenEntities.Data) (*personalTokenEntities.CreateResponse, error) {
+	args := m.MethodCalled("CreatePersonalAccessToken")
+	return args.Get(0).(*personalTokenEntities.CreateResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListPersonalAccessTokens(_ uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error) {
+	args := m.MethodCalled("ListPersonalAccessTokens")
+	return args.Get(0).([]*personalTokenEntities.PersonalAccessToken), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokePersonalAccessToken(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokePersonalAccessToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
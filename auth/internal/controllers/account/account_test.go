@@ -10,19 +10,37 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	tokenEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
 	authServices "github.com/ZupIT/horusec-platform/auth/internal/services/authentication"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	lockoutService "github.com/ZupIT/horusec-platform/auth/internal/services/lockout"
+	mfaService "github.com/ZupIT/horusec-platform/auth/internal/services/mfa"
+	passwordService "github.com/ZupIT/horusec-platform/auth/internal/services/password"
+	personalTokenService "github.com/ZupIT/horusec-platform/auth/internal/services/personaltoken"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
 )
 
@@ -33,10 +51,49 @@ func getAppConfig() app.IConfig {
 	return app.NewAuthAppConfig(&database.Connection{Read: databaseMock, Write: databaseMock})
 }
 
+func newLockoutMock() *lockoutService.Mock {
+	lockoutMock := &lockoutService.Mock{}
+	lockoutMock.On("CheckIP").Return(nil)
+	lockoutMock.On("RegisterIPFailure")
+	lockoutMock.On("SetResetPasswordCode").Return(nil)
+	lockoutMock.On("CheckResetPasswordCode").Return(nil)
+	lockoutMock.On("DeleteResetPasswordCode")
+
+	return lockoutMock
+}
+
+func newCacheRepositoryMock() *cacheRepository.Mock {
+	cacheRepositoryMock := &cacheRepository.Mock{}
+	cacheRepositoryMock.On("Set").Return(nil)
+	cacheRepositoryMock.On("Delete").Return(nil)
+
+	return cacheRepositoryMock
+}
+
+func newPasswordServiceMock() *passwordService.Mock {
+	passwordServiceMock := &passwordService.Mock{}
+	passwordServiceMock.On("Validate").Return(nil)
+	passwordServiceMock.On("SaveHistory").Return(nil)
+
+	return passwordServiceMock
+}
+
+func newSessionServiceMock() *sessionService.Mock {
+	sessionServiceMock := &sessionService.Mock{}
+	sessionServiceMock.On("RefreshSession").Return(&sessionEntities.Session{}, "new-refresh-token", nil)
+	sessionServiceMock.On("RevokeSessionByToken").Return(nil)
+	sessionServiceMock.On("RevokeOtherSessions").Return(nil)
+	sessionServiceMock.On("ListSessions").Return([]*sessionEntities.Session{}, nil)
+	sessionServiceMock.On("RevokeSession").Return(nil)
+	sessionServiceMock.On("RevokeAccountSessionsByAdmin").Return(nil)
+
+	return sessionServiceMock
+}
+
 func TestNewAccountController(t *testing.T) {
 	t.Run("should success create a new controller", func(t *testing.T) {
 		assert.NotNil(t, NewAccountController(nil, nil, nil,
-			nil, nil, nil))
+			nil, nil, nil, nil, nil, nil, nil, nil))
 	})
 }
 
@@ -60,13 +117,44 @@ func TestCreateAccountKeycloak(t *testing.T) {
 		accountRepositoryMock.On("CreateAccount").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.CreateAccountKeycloak("test")
 		assert.NotNil(t, result)
 		assert.NoError(t, err)
 	})
 
+	t.Run("should bind the pending invitations when keycloak asserts that the email is verified", func(t *testing.T) {
+		appConfig := getAppConfig()
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		test := ""
+		emailVerified := true
+		userInfo := &gocloak.UserInfo{
+			Sub:               &test,
+			Name:              &test,
+			PreferredUsername: &test,
+			Email:             &test,
+			EmailVerified:     &emailVerified,
+		}
+
+		serviceMock := &authServices.Mock{}
+		serviceMock.On("GetUserInfo").Return(userInfo, nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("CreateAccount").Return(&accountEntities.Account{}, nil)
+
+		controller := NewAccountController(accountRepositoryMock, serviceMock,
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
+
+		_, err := controller.CreateAccountKeycloak("test")
+		assert.NoError(t, err)
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
 	t.Run("should return already existing account", func(t *testing.T) {
 		appConfig := getAppConfig()
 		brokerMock := &broker.Mock{}
@@ -87,7 +175,8 @@ func TestCreateAccountKeycloak(t *testing.T) {
 			errors.New(accountEnums.DuplicatedConstraintPrimaryKey))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		_, err := controller.CreateAccountKeycloak("test")
 		assert.Error(t, err)
@@ -104,7 +193,8 @@ func TestCreateAccountKeycloak(t *testing.T) {
 		serviceMock.On("GetUserInfo").Return(userInfo, errors.New("test"))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		_, err := controller.CreateAccountKeycloak("test")
 		assert.Error(t, err)
@@ -122,14 +212,20 @@ func TestCreateAccountHorusec(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		brokerMock.On("Publish").Return(nil)
 
+		invitationServiceMock := &invitationService.Mock{}
+		invitationServiceMock.On("BindPendingInvitations")
+
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
+		controller.(*Controller).invitationService = invitationServiceMock
 
 		data := &accountEntities.Data{}
 
 		result, err := controller.CreateAccountHorusec(data)
 		assert.NotNil(t, result)
 		assert.NoError(t, err)
+		invitationServiceMock.AssertNotCalled(t, "BindPendingInvitations")
 	})
 
 	t.Run("should success create a new account with email disabled", func(t *testing.T) {
@@ -142,14 +238,20 @@ func TestCreateAccountHorusec(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		brokerMock.On("Publish").Return(nil)
 
+		invitationServiceMock := &invitationService.Mock{}
+		invitationServiceMock.On("BindPendingInvitations")
+
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
+		controller.(*Controller).invitationService = invitationServiceMock
 
 		data := &accountEntities.Data{}
 
 		result, err := controller.CreateAccountHorusec(data)
 		assert.NotNil(t, result)
 		assert.NoError(t, err)
+		invitationServiceMock.AssertCalled(t, "BindPendingInvitations")
 	})
 
 	t.Run("should return error when failed to create account", func(t *testing.T) {
@@ -162,45 +264,257 @@ func TestCreateAccountHorusec(t *testing.T) {
 		brokerMock := &broker.Mock{}
 		brokerMock.On("Publish").Return(nil)
 
+		invitationServiceMock := &invitationService.Mock{}
+		invitationServiceMock.On("BindPendingInvitations")
+
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
+		controller.(*Controller).invitationService = invitationServiceMock
 
 		data := &accountEntities.Data{}
 
 		result, err := controller.CreateAccountHorusec(data)
 		assert.Nil(t, result)
 		assert.Error(t, err)
+		invitationServiceMock.AssertNotCalled(t, "BindPendingInvitations")
+	})
+
+	t.Run("should return error when password doesn't satisfy the policy", func(t *testing.T) {
+		appConfig := getAppConfig()
+		accountRepositoryMock := &accountRepository.Mock{}
+
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("Validate").Return(passwordEnums.ErrorPasswordPolicy)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, passwordServiceMock)
+
+		_, err := controller.CreateAccountHorusec(&accountEntities.Data{})
+		assert.Equal(t, passwordEnums.ErrorPasswordPolicy, err)
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should return error when failed to save password history", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("CreateAccount").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("Validate").Return(nil)
+		passwordServiceMock.On("SaveHistory").Return(errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, passwordServiceMock)
+
+		_, err := controller.CreateAccountHorusec(&accountEntities.Data{})
+		assert.Error(t, err)
 	})
 }
 
+func newEmailVerificationMock(account *accountEntities.Account) (*cacheRepository.Mock, string) {
+	verification, token := accountEntities.NewEmailVerification(account)
+
+	cacheRepositoryMock := newCacheRepositoryMock()
+	cacheRepositoryMock.On("Get").Return(verification.ToString(), nil)
+
+	return cacheRepositoryMock, token
+}
+
 func TestValidateAccountEmail(t *testing.T) {
+	account := &accountEntities.Account{AccountID: uuid.New(), Email: "test@test.com"}
+
 	t.Run("should success validate account", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
+		cacheRepositoryMock, token := newEmailVerificationMock(account)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
+		accountRepositoryMock.On("Update").Return(account, nil)
+
 		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		assert.NoError(t, controller.ValidateAccountEmail(token))
+		cacheRepositoryMock.AssertCalled(t, "Delete")
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return error when token has invalid format", func(t *testing.T) {
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, controller.ValidateAccountEmail(uuid.NewString()))
+	})
+
+	t.Run("should return error when verification does not exist or expired", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		_, token := accountEntities.NewEmailVerification(account)
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, controller.ValidateAccountEmail(token))
+	})
+
+	t.Run("should return error when stored verification is invalid", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return("test", nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		_, token := accountEntities.NewEmailVerification(account)
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, controller.ValidateAccountEmail(token))
+	})
+
+	t.Run("should return error when token is not the last one sent", func(t *testing.T) {
+		cacheRepositoryMock, _ := newEmailVerificationMock(account)
 
 		accountRepositoryMock := &accountRepository.Mock{}
-		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
-		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
 
-		assert.NoError(t, controller.ValidateAccountEmail(uuid.New()))
+		_, token := accountEntities.NewEmailVerification(account)
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, controller.ValidateAccountEmail(token))
+	})
+
+	t.Run("should return error when account email changed after sending the token", func(t *testing.T) {
+		cacheRepositoryMock, token := newEmailVerificationMock(account)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(
+			&accountEntities.Account{AccountID: account.AccountID, Email: "new@test.com"}, nil)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, controller.ValidateAccountEmail(token))
 	})
 
 	t.Run("should return error when failed to get account", func(t *testing.T) {
+		cacheRepositoryMock, token := newEmailVerificationMock(account)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, controller.ValidateAccountEmail(token))
+	})
+
+	t.Run("should return error when failed to update account", func(t *testing.T) {
+		cacheRepositoryMock, token := newEmailVerificationMock(account)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
+		accountRepositoryMock.On("Update").Return(account, errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{}, nil, nil,
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		assert.Error(t, controller.ValidateAccountEmail(token))
+		cacheRepositoryMock.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestResendValidationEmail(t *testing.T) {
+	t.Run("should success send a new validation email", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
+		cacheRepositoryMock := newCacheRepositoryMock()
+
 		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
 
 		accountRepositoryMock := &accountRepository.Mock{}
-		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		assert.NoError(t, controller.ResendValidationEmail(&accountEntities.Email{Email: "test@test.com"}))
+		cacheRepositoryMock.AssertCalled(t, "Set")
+	})
+
+	t.Run("should return error when account is already confirmed", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{IsConfirmed: true}, nil)
 
-		assert.Error(t, controller.ValidateAccountEmail(uuid.New()))
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{}, nil, getAppConfig(),
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, accountEnums.ErrorAccountAlreadyConfirmed,
+			controller.ResendValidationEmail(&accountEntities.Email{Email: "test@test.com"}))
+	})
+
+	t.Run("should register address failure when account not found", func(t *testing.T) {
+		lockoutMock := newLockoutMock()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(
+			&accountEntities.Account{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{}, nil, getAppConfig(),
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords,
+			controller.ResendValidationEmail(&accountEntities.Email{Email: "test@test.com"}))
+		lockoutMock.AssertCalled(t, "RegisterIPFailure")
+	})
+
+	t.Run("should return error when failed to store verification", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(errors.New("test"))
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		assert.Error(t, controller.ResendValidationEmail(&accountEntities.Email{Email: "test@test.com"}))
+	})
+}
+
+func TestSendAccountValidationEmail(t *testing.T) {
+	t.Run("should success send a validation email to the account", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, nil)
+
+		assert.NoError(t, controller.SendAccountValidationEmail(&accountEntities.Account{}))
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return error when account is already confirmed", func(t *testing.T) {
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil, getAppConfig(),
+			&broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, accountEnums.ErrorAccountAlreadyConfirmed,
+			controller.SendAccountValidationEmail(&accountEntities.Account{IsConfirmed: true}))
 	})
 }
 
@@ -216,9 +530,10 @@ func TestSendResetPasswordCode(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
-		assert.NoError(t, controller.SendResetPasswordCode("test"))
+		assert.NoError(t, controller.SendResetPasswordCode(&accountEntities.Email{Email: "test@test.com"}))
 	})
 
 	t.Run("should not send email when it is disabled", func(t *testing.T) {
@@ -232,9 +547,10 @@ func TestSendResetPasswordCode(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
-		assert.NoError(t, controller.SendResetPasswordCode("test"))
+		assert.NoError(t, controller.SendResetPasswordCode(&accountEntities.Email{Email: "test@test.com"}))
 	})
 
 	t.Run("should return error when failed to get account", func(t *testing.T) {
@@ -247,112 +563,218 @@ func TestSendResetPasswordCode(t *testing.T) {
 			&accountEntities.Account{}, errors.New("test"))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cache.NewCache())
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
-		assert.Error(t, controller.SendResetPasswordCode("test"))
+		assert.Error(t, controller.SendResetPasswordCode(&accountEntities.Email{Email: "test@test.com"}))
+	})
+
+	t.Run("should register ip failure when email not found", func(t *testing.T) {
+		appConfig := getAppConfig()
+		lockoutMock := newLockoutMock()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(
+			&accountEntities.Account{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
+
+		assert.Error(t, controller.SendResetPasswordCode(&accountEntities.Email{Email: "test@test.com"}))
+		lockoutMock.AssertCalled(t, "RegisterIPFailure")
+	})
+
+	t.Run("should return error when ip is locked", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("CheckIP").Return(lockoutEnums.ErrorIPLocked)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, lockoutEnums.ErrorIPLocked,
+			controller.SendResetPasswordCode(&accountEntities.Email{Email: "test@test.com"}))
+	})
+
+	t.Run("should return error when failed to store code", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
+
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("CheckIP").Return(nil)
+		lockoutMock.On("SetResetPasswordCode").Return(errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
+
+		assert.Error(t, controller.SendResetPasswordCode(&accountEntities.Email{Email: "test@test.com"}))
 	})
 }
 
-func TestCheckResetPasswordCode(t *testing.T) {
-	t.Run("should success verify code without errors", func(t *testing.T) {
+func TestSendAccountResetPasswordCode(t *testing.T) {
+	t.Run("should success send reset password code to the account", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
+		lockoutMock := newLockoutMock()
+
 		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(),
+			&mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
 
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("123456", nil)
-		cacheMock.On("Delete")
+		assert.NoError(t, controller.SendAccountResetPasswordCode(&accountEntities.Account{Email: "test@test.com"}))
+		lockoutMock.AssertCalled(t, "SetResetPasswordCode")
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return error when failed to store reset password code", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("SetResetPasswordCode").Return(errors.New("test"))
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
+
+		assert.Error(t, controller.SendAccountResetPasswordCode(&accountEntities.Account{Email: "test@test.com"}))
+	})
+}
+
+func TestCheckResetPasswordCode(t *testing.T) {
+	data := &accountEntities.ResetCodeData{
+		Email: "test@test.com",
+		Code:  "123456",
+	}
+
+	t.Run("should success verify code and return a password change token", func(t *testing.T) {
+		appConfig := getAppConfig()
+		lockoutMock := newLockoutMock()
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
-		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("IsChallengeRequired").Return(false, nil)
 
-		data := &accountEntities.ResetCodeData{
-			Email: "test@test.com",
-			Code:  "123456",
-		}
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("NewChangeToken").Return("change-token", nil)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			mfaServiceMock, lockoutMock, newCacheRepositoryMock(), nil, passwordServiceMock)
 
 		result, err := controller.CheckResetPasswordCode(data)
-		assert.NotEmpty(t, result)
 		assert.NoError(t, err)
+		assert.Equal(t, "change-token", result)
+		lockoutMock.AssertCalled(t, "DeleteResetPasswordCode")
 	})
 
-	t.Run("should return error when failed to get account", func(t *testing.T) {
+	t.Run("should not return an access token when the account has mfa enabled", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
+		lockoutMock := newLockoutMock()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{IsMFAEnabled: true}, nil)
+
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("IsChallengeRequired").Return(true, nil)
 
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("123456", nil)
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("NewChangeToken").Return("change-token", nil)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			mfaServiceMock, lockoutMock, newCacheRepositoryMock(), nil, passwordServiceMock)
+
+		result, err := controller.CheckResetPasswordCode(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "change-token", result)
+		mfaServiceMock.AssertCalled(t, "IsChallengeRequired")
+
+		_, err = jwt.DecodeToken(result)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to check if mfa is required", func(t *testing.T) {
+		appConfig := getAppConfig()
+		lockoutMock := newLockoutMock()
 
 		accountRepositoryMock := &accountRepository.Mock{}
-		accountRepositoryMock.On("GetAccountByEmail").Return(
-			&accountEntities.Account{}, errors.New("test"))
-		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("IsChallengeRequired").Return(false, errors.New("test"))
 
-		data := &accountEntities.ResetCodeData{
-			Email: "test@test.com",
-			Code:  "123456",
-		}
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			mfaServiceMock, lockoutMock, newCacheRepositoryMock(), nil, &passwordService.Mock{})
 
 		result, err := controller.CheckResetPasswordCode(data)
 		assert.Empty(t, result)
 		assert.Error(t, err)
+		lockoutMock.AssertNotCalled(t, "DeleteResetPasswordCode")
 	})
 
-	t.Run("should return error when wrong code", func(t *testing.T) {
+	t.Run("should return error when failed to create the password change token", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
-
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("123456", nil)
+		lockoutMock := newLockoutMock()
 
 		accountRepositoryMock := &accountRepository.Mock{}
-		accountRepositoryMock.On("GetAccountByEmail").Return(
-			&accountEntities.Account{}, errors.New("test"))
-		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("IsChallengeRequired").Return(false, nil)
 
-		data := &accountEntities.ResetCodeData{
-			Email: "test@test.com",
-			Code:  "654321",
-		}
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("NewChangeToken").Return("", errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			mfaServiceMock, lockoutMock, newCacheRepositoryMock(), nil, passwordServiceMock)
 
 		result, err := controller.CheckResetPasswordCode(data)
 		assert.Empty(t, result)
 		assert.Error(t, err)
-		assert.Equal(t, accountEnums.ErrorIncorrectRetrievePasswordCode, err)
+		lockoutMock.AssertNotCalled(t, "DeleteResetPasswordCode")
 	})
 
-	t.Run("should return error when failed to get stored code", func(t *testing.T) {
+	t.Run("should return error when failed to get account", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
+
 		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(
+			&accountEntities.Account{}, errors.New("test"))
 
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("123456", errors.New("test"))
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		result, err := controller.CheckResetPasswordCode(data)
+		assert.Empty(t, result)
+		assert.Error(t, err)
+	})
 
-		data := &accountEntities.ResetCodeData{
-			Email: "test@test.com",
-			Code:  "654321",
-		}
+	t.Run("should return error when wrong code or attempts exceeded", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("CheckResetPasswordCode").Return(accountEnums.ErrorIncorrectRetrievePasswordCode)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, lockoutMock, newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.CheckResetPasswordCode(data)
 		assert.Empty(t, result)
-		assert.Error(t, err)
+		assert.Equal(t, accountEnums.ErrorIncorrectRetrievePasswordCode, err)
 	})
 }
 
@@ -361,27 +783,50 @@ func TestChangePassword(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
 		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
 
+		sessionServiceMock := newSessionServiceMock()
+
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, sessionServiceMock, &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
 
 		data := &accountEntities.ChangePasswordData{
 			Password: "test",
 		}
 
 		assert.NoError(t, controller.ChangePassword(data))
+		sessionServiceMock.AssertCalled(t, "RevokeOtherSessions")
+	})
+
+	t.Run("should return error when failed to revoke other sessions", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeOtherSessions").Return(errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, sessionServiceMock,
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
+
+		data := &accountEntities.ChangePasswordData{
+			Password: "test",
+		}
+
+		assert.Error(t, controller.ChangePassword(data))
 	})
 
 	t.Run("should return error when same password", func(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		password, _ := crypto.HashPasswordBcrypt("test")
 
@@ -389,7 +834,8 @@ func TestChangePassword(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{Password: password}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
 
 		data := &accountEntities.ChangePasswordData{
 			Password: "test",
@@ -404,13 +850,13 @@ func TestChangePassword(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, newPasswordServiceMock())
 
 		data := &accountEntities.ChangePasswordData{
 			Password: "test",
@@ -418,83 +864,249 @@ func TestChangePassword(t *testing.T) {
 
 		assert.Error(t, controller.ChangePassword(data))
 	})
+
+	t.Run("should return error when password doesn't satisfy the policy", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("Validate").Return(passwordEnums.ErrorPasswordPolicy)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, passwordServiceMock)
+
+		err := controller.ChangePassword(&accountEntities.ChangePasswordData{Password: "test"})
+		assert.Equal(t, passwordEnums.ErrorPasswordPolicy, err)
+		accountRepositoryMock.AssertNotCalled(t, "Update")
+	})
+}
+
+func TestChangeExpiredPassword(t *testing.T) {
+	data := &passwordEntities.ExpiredPasswordData{PasswordChangeToken: uuid.NewString(), Password: "test"}
+
+	t.Run("should success change expired password and create the session", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := newPasswordServiceMock()
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), false), nil)
+		passwordServiceMock.On("RemoveExpiredChallenge").Return(nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(nil)
+		sessionServiceMock.On("CreateSession").Return("refresh-token", nil)
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, sessionServiceMock,
+			nil, nil, nil, nil, passwordServiceMock)
+
+		result, err := controller.ChangeExpiredPassword(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "refresh-token", result.RefreshToken)
+		assert.NotEmpty(t, result.AccessToken)
+		passwordServiceMock.AssertCalled(t, "RemoveExpiredChallenge")
+	})
+
+	t.Run("should require the mfa instead of creating the session when the code didn't check it", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{IsMFAEnabled: true}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := newPasswordServiceMock()
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), true), nil)
+		passwordServiceMock.On("RemoveExpiredChallenge").Return(nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(nil)
+
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("NewChallenge").Return(&authEntities.LoginResponse{MFAToken: "mfa-token"}, nil)
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, sessionServiceMock,
+			mfaServiceMock, nil, nil, nil, passwordServiceMock)
+
+		result, err := controller.ChangeExpiredPassword(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "mfa-token", result.MFAToken)
+		assert.Empty(t, result.AccessToken)
+		assert.Empty(t, result.RefreshToken)
+		sessionServiceMock.AssertCalled(t, "RevokeAllSessions")
+		sessionServiceMock.AssertNotCalled(t, "CreateSession")
+	})
+
+	t.Run("should return error when failed to revoke the sessions before requiring the mfa", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{IsMFAEnabled: true}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := newPasswordServiceMock()
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), true), nil)
+		passwordServiceMock.On("RemoveExpiredChallenge").Return(nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(errors.New("test"))
+
+		mfaServiceMock := &mfaService.Mock{}
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, sessionServiceMock,
+			mfaServiceMock, nil, nil, nil, passwordServiceMock)
+
+		_, err := controller.ChangeExpiredPassword(data)
+		assert.Error(t, err)
+		mfaServiceMock.AssertNotCalled(t, "NewChallenge")
+	})
+
+	t.Run("should return error when invalid password change token", func(t *testing.T) {
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("GetExpiredChallenge").Return(&passwordEntities.ChangeChallenge{},
+			passwordEnums.ErrorInvalidChangeToken)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, passwordServiceMock)
+
+		_, err := controller.ChangeExpiredPassword(data)
+		assert.Equal(t, passwordEnums.ErrorInvalidChangeToken, err)
+	})
+
+	t.Run("should return error when password doesn't satisfy the policy", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), false), nil)
+		passwordServiceMock.On("Validate").Return(passwordEnums.ErrorPasswordPolicy)
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			passwordServiceMock)
+
+		_, err := controller.ChangeExpiredPassword(data)
+		assert.Equal(t, passwordEnums.ErrorPasswordPolicy, err)
+		passwordServiceMock.AssertNotCalled(t, "RemoveExpiredChallenge")
+	})
+
+	t.Run("should return error when the token was already used by a concurrent change", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := newPasswordServiceMock()
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), false), nil)
+		passwordServiceMock.On("RemoveExpiredChallenge").Return(passwordEnums.ErrorInvalidChangeToken)
+
+		sessionServiceMock := &sessionService.Mock{}
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, sessionServiceMock,
+			nil, nil, nil, nil, passwordServiceMock)
+
+		_, err := controller.ChangeExpiredPassword(data)
+		assert.Equal(t, passwordEnums.ErrorInvalidChangeToken, err)
+		sessionServiceMock.AssertNotCalled(t, "CreateSession")
+	})
+
+	t.Run("should return error when failed to create the session", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := newPasswordServiceMock()
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), false), nil)
+		passwordServiceMock.On("RemoveExpiredChallenge").Return(nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(nil)
+		sessionServiceMock.On("CreateSession").Return("", errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, sessionServiceMock,
+			nil, nil, nil, nil, passwordServiceMock)
+
+		_, err := controller.ChangeExpiredPassword(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to revoke the sessions", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
+
+		passwordServiceMock := newPasswordServiceMock()
+		passwordServiceMock.On("GetExpiredChallenge").Return(passwordEntities.NewChangeChallenge(uuid.New(), false), nil)
+		passwordServiceMock.On("RemoveExpiredChallenge").Return(nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(errors.New("test"))
+
+		controller := NewAccountController(accountRepositoryMock, nil, nil, nil, nil, sessionServiceMock,
+			nil, nil, nil, nil, passwordServiceMock)
+
+		_, err := controller.ChangeExpiredPassword(data)
+		assert.Error(t, err)
+	})
 }
 
 func TestRefreshToken(t *testing.T) {
 	t.Run("should success refresh token", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
-
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("test", nil)
-		cacheMock.On("Delete")
-		cacheMock.On("Set")
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.RefreshToken("test")
-		assert.NotNil(t, result)
 		assert.NoError(t, err)
+		assert.Equal(t, "new-refresh-token", result.RefreshToken)
+		assert.NotEmpty(t, result.AccessToken)
 	})
 
 	t.Run("should return error when failed to get account", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
-
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("test", nil)
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.RefreshToken("test")
 		assert.Nil(t, result)
 		assert.Error(t, err)
 	})
 
-	t.Run("should return error when failed to get refresh token", func(t *testing.T) {
+	t.Run("should return error when refresh token is invalid or reused", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
-		accountRepositoryMock := &accountRepository.Mock{}
 
-		cacheMock := &cache.Mock{}
-		cacheMock.On("GetString").Return("", errors.New("test"))
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RefreshSession").Return(&sessionEntities.Session{}, "",
+			sessionEnums.ErrorRefreshTokenReused)
 
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, sessionServiceMock,
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.RefreshToken("test")
 		assert.Nil(t, result)
-		assert.Error(t, err)
+		assert.Equal(t, sessionEnums.ErrorRefreshTokenReused, err)
 	})
 }
 
 func TestLogout(t *testing.T) {
-	t.Run("should success logout user", func(t *testing.T) {
+	t.Run("should revoke the session of the refresh token", func(t *testing.T) {
 		appConfig := getAppConfig()
-		serviceMock := &authServices.Mock{}
-		brokerMock := &broker.Mock{}
-		accountRepositoryMock := &accountRepository.Mock{}
+		sessionServiceMock := newSessionServiceMock()
 
-		cacheMock := &cache.Mock{}
-		cacheMock.On("Delete")
-
-		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, sessionServiceMock,
+			&mfaService.Mock{}, newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		assert.NotPanics(t, func() {
-			controller.Logout("")
+			controller.Logout("test")
 		})
+		sessionServiceMock.AssertCalled(t, "RevokeSessionByToken")
 	})
 }
 
@@ -503,14 +1115,14 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 		accountRepositoryMock.On("GetAccountByUsername").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.CheckEmailAndUsername{}
 
@@ -521,7 +1133,6 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
@@ -529,7 +1140,8 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 			&accountEntities.Account{Username: "test"}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.CheckEmailAndUsername{}
 
@@ -542,14 +1154,14 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccountByEmail").Return(
 			&accountEntities.Account{Email: "test"}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.CheckEmailAndUsername{}
 
@@ -564,13 +1176,13 @@ func TestDeleteAccount(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("Delete").Return(nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		assert.NoError(t, controller.DeleteAccount(uuid.New()))
 	})
@@ -581,11 +1193,11 @@ func TestGetAccountID(t *testing.T) {
 		appConfig := &app.Config{AuthType: auth.Horusec}
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 		accountRepositoryMock := &accountRepository.Mock{}
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		account := &accountEntities.Account{AccountID: uuid.New()}
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
@@ -599,11 +1211,11 @@ func TestGetAccountID(t *testing.T) {
 		appConfig := &app.Config{AuthType: auth.Ldap}
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 		accountRepositoryMock := &accountRepository.Mock{}
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		account := &accountEntities.Account{AccountID: uuid.New()}
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
@@ -616,14 +1228,14 @@ func TestGetAccountID(t *testing.T) {
 	t.Run("should success get account id keycloak", func(t *testing.T) {
 		appConfig := &app.Config{AuthType: auth.Keycloak}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 		accountRepositoryMock := &accountRepository.Mock{}
 
 		serviceMock := &authServices.Mock{}
 		serviceMock.On("GetAccountDataFromToken").Return(&proto.GetAccountDataResponse{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.GetAccountID("")
 		assert.NoError(t, err)
@@ -633,7 +1245,6 @@ func TestGetAccountID(t *testing.T) {
 	t.Run("should return error when failed to get account data keycloak", func(t *testing.T) {
 		appConfig := &app.Config{AuthType: auth.Keycloak}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 		accountRepositoryMock := &accountRepository.Mock{}
 
 		serviceMock := &authServices.Mock{}
@@ -641,7 +1252,8 @@ func TestGetAccountID(t *testing.T) {
 			&proto.GetAccountDataResponse{}, errors.New("test"))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.GetAccountID("")
 		assert.Error(t, err)
@@ -651,12 +1263,12 @@ func TestGetAccountID(t *testing.T) {
 	t.Run("should return error when invalid auth type", func(t *testing.T) {
 		appConfig := &app.Config{AuthType: "test"}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 		accountRepositoryMock := &accountRepository.Mock{}
 		serviceMock := &authServices.Mock{}
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		result, err := controller.GetAccountID("")
 		assert.Error(t, err)
@@ -670,14 +1282,14 @@ func TestUpdateAccount(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
 		brokerMock := &broker.Mock{}
-		cacheMock := &cache.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
 		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.UpdateAccount{}
 
@@ -689,7 +1301,6 @@ func TestUpdateAccount(t *testing.T) {
 	t.Run("should success update account with email change", func(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
-		cacheMock := &cache.Mock{}
 
 		brokerMock := &broker.Mock{}
 		brokerMock.On("Publish").Return(nil)
@@ -699,7 +1310,8 @@ func TestUpdateAccount(t *testing.T) {
 		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.UpdateAccount{Email: "test"}
 
@@ -708,10 +1320,28 @@ func TestUpdateAccount(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 
+	t.Run("should return error when failed to invalidate pending verification on email change", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Delete").Return(errors.New("test"))
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+
+		controller := NewAccountController(accountRepositoryMock, &authServices.Mock{},
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, &broker.Mock{}, newSessionServiceMock(),
+			&mfaService.Mock{}, newLockoutMock(), cacheRepositoryMock, nil, nil)
+
+		result, err := controller.UpdateAccount(&accountEntities.UpdateAccount{Email: "test"})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		cacheRepositoryMock.AssertNotCalled(t, "Set")
+	})
+
 	t.Run("should return error when failed to send email", func(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
-		cacheMock := &cache.Mock{}
 
 		brokerMock := &broker.Mock{}
 		brokerMock.On("Publish").Return(errors.New("test"))
@@ -721,7 +1351,8 @@ func TestUpdateAccount(t *testing.T) {
 		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.UpdateAccount{Email: "test"}
 
@@ -733,7 +1364,6 @@ func TestUpdateAccount(t *testing.T) {
 	t.Run("should return error when failed to update", func(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
-		cacheMock := &cache.Mock{}
 
 		brokerMock := &broker.Mock{}
 		brokerMock.On("Publish").Return(nil)
@@ -743,7 +1373,8 @@ func TestUpdateAccount(t *testing.T) {
 		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, errors.New("test"))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.UpdateAccount{Email: "test"}
 
@@ -755,14 +1386,14 @@ func TestUpdateAccount(t *testing.T) {
 	t.Run("should return error when failed to get account", func(t *testing.T) {
 		appConfig := getAppConfig()
 		serviceMock := &authServices.Mock{}
-		cacheMock := &cache.Mock{}
 		brokerMock := &broker.Mock{}
 
 		accountRepositoryMock := &accountRepository.Mock{}
 		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
 
 		controller := NewAccountController(accountRepositoryMock, serviceMock,
-			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, cacheMock)
+			accountUseCases.NewAccountUseCases(appConfig), appConfig, brokerMock, newSessionServiceMock(), &mfaService.Mock{},
+			newLockoutMock(), newCacheRepositoryMock(), nil, nil)
 
 		data := &accountEntities.UpdateAccount{Email: "test"}
 
@@ -771,3 +1402,212 @@ func TestUpdateAccount(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestEnrollMFA(t *testing.T) {
+	t.Run("should success enroll mfa when horusec auth", func(t *testing.T) {
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("Enroll").Return(&mfaEntities.EnrollmentResponse{}, nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Horusec}, &broker.Mock{}, newSessionServiceMock(), mfaServiceMock, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		result, err := controller.EnrollMFA(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when not horusec auth", func(t *testing.T) {
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Ldap}, &broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		_, err := controller.EnrollMFA(uuid.New())
+		assert.Equal(t, mfaEnums.ErrorMFAOnlyHorusecAuth, err)
+	})
+}
+
+func TestEnableMFA(t *testing.T) {
+	t.Run("should success enable mfa when horusec auth", func(t *testing.T) {
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("Enable").Return(&mfaEntities.RecoveryCodesResponse{}, nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Horusec}, &broker.Mock{}, newSessionServiceMock(), mfaServiceMock, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		result, err := controller.EnableMFA(&mfaEntities.CodeData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when not horusec auth", func(t *testing.T) {
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Keycloak}, &broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		_, err := controller.EnableMFA(&mfaEntities.CodeData{})
+		assert.Equal(t, mfaEnums.ErrorMFAOnlyHorusecAuth, err)
+	})
+}
+
+func TestDisableMFA(t *testing.T) {
+	t.Run("should success disable mfa when horusec auth", func(t *testing.T) {
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("Disable").Return(nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Horusec}, &broker.Mock{}, newSessionServiceMock(), mfaServiceMock, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.NoError(t, controller.DisableMFA(&mfaEntities.CodeData{}))
+	})
+
+	t.Run("should return error when not horusec auth", func(t *testing.T) {
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Ldap}, &broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, mfaEnums.ErrorMFAOnlyHorusecAuth, controller.DisableMFA(&mfaEntities.CodeData{}))
+	})
+}
+
+func TestRegenerateMFARecoveryCodes(t *testing.T) {
+	t.Run("should success regenerate recovery codes when horusec auth", func(t *testing.T) {
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("RegenerateRecoveryCodes").Return(&mfaEntities.RecoveryCodesResponse{}, nil)
+
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Horusec}, &broker.Mock{}, newSessionServiceMock(), mfaServiceMock, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		result, err := controller.RegenerateMFARecoveryCodes(&mfaEntities.CodeData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when not horusec auth", func(t *testing.T) {
+		controller := NewAccountController(&accountRepository.Mock{}, &authServices.Mock{}, nil,
+			&app.Config{AuthType: auth.Ldap}, &broker.Mock{}, newSessionServiceMock(), &mfaService.Mock{}, newLockoutMock(),
+			newCacheRepositoryMock(), nil, nil)
+
+		_, err := controller.RegenerateMFARecoveryCodes(&mfaEntities.CodeData{})
+		assert.Equal(t, mfaEnums.ErrorMFAOnlyHorusecAuth, err)
+	})
+}
+
+func TestUnlockAccount(t *testing.T) {
+	t.Run("should unlock account with lockout service", func(t *testing.T) {
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("UnlockByAdmin").Return(nil)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, nil, nil, lockoutMock,
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.NoError(t, controller.UnlockAccount(uuid.New(), uuid.New()))
+	})
+}
+
+func TestUnlockAccountWithToken(t *testing.T) {
+	t.Run("should unlock account with token with lockout service", func(t *testing.T) {
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("UnlockWithToken").Return(lockoutEnums.ErrorInvalidUnlockToken)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, nil, nil, lockoutMock,
+			newCacheRepositoryMock(), nil, nil)
+
+		assert.Equal(t, lockoutEnums.ErrorInvalidUnlockToken, controller.UnlockAccountWithToken("test"))
+	})
+}
+
+func TestListSessions(t *testing.T) {
+	t.Run("should list sessions with session service", func(t *testing.T) {
+		controller := NewAccountController(nil, nil, nil, nil, nil, newSessionServiceMock(), nil, nil, nil, nil, nil)
+
+		result, err := controller.ListSessions(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Run("should revoke session with session service", func(t *testing.T) {
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeSession").Return(sessionEnums.ErrorSessionNotFound)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, sessionServiceMock, nil, nil, nil, nil, nil)
+
+		assert.Equal(t, sessionEnums.ErrorSessionNotFound, controller.RevokeSession(uuid.New(), uuid.New()))
+	})
+}
+
+func TestRevokeAccountSessions(t *testing.T) {
+	t.Run("should revoke account sessions with session service", func(t *testing.T) {
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAccountSessionsByAdmin").Return(sessionEnums.ErrorRevokeNotAllowed)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, sessionServiceMock, nil, nil, nil, nil, nil)
+
+		assert.Equal(t, sessionEnums.ErrorRevokeNotAllowed, controller.RevokeAccountSessions(uuid.New(), uuid.New()))
+	})
+}
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	data := &personalTokenEntities.Data{Name: "test"}
+
+	t.Run("should create token with the account and groups of the jwt", func(t *testing.T) {
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("CreateToken").Return(&personalTokenEntities.CreateResponse{}, nil)
+
+		token, _, _ := jwt.CreateToken(&tokenEntities.TokenData{AccountID: uuid.New()}, []string{"group"})
+
+		controller := NewAccountController(nil, nil, nil, &app.Config{AuthType: auth.Horusec}, nil, nil, nil, nil,
+			nil, personalTokenMock, nil)
+
+		result, err := controller.CreatePersonalAccessToken(token, data)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, []string{"group"}, data.Permissions)
+	})
+
+	t.Run("should return error when invalid jwt", func(t *testing.T) {
+		controller := NewAccountController(nil, nil, nil, &app.Config{AuthType: auth.Horusec}, nil, nil, nil, nil,
+			nil, &personalTokenService.Mock{}, nil)
+
+		_, err := controller.CreatePersonalAccessToken("test", data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when keycloak authentication", func(t *testing.T) {
+		controller := NewAccountController(nil, nil, nil, &app.Config{AuthType: auth.Keycloak}, nil, nil, nil, nil,
+			nil, &personalTokenService.Mock{}, nil)
+
+		_, err := controller.CreatePersonalAccessToken("test", data)
+		assert.Equal(t, personalTokenEnums.ErrorNotSupported, err)
+	})
+}
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	t.Run("should list tokens with personal token service", func(t *testing.T) {
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("ListTokens").Return([]*personalTokenEntities.PersonalAccessToken{}, nil)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, nil, nil, nil, nil, personalTokenMock, nil)
+
+		result, err := controller.ListPersonalAccessTokens(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	t.Run("should revoke token with personal token service", func(t *testing.T) {
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("RevokeToken").Return(personalTokenEnums.ErrorTokenNotFound)
+
+		controller := NewAccountController(nil, nil, nil, nil, nil, nil, nil, nil, nil, personalTokenMock, nil)
+
+		assert.Equal(t, personalTokenEnums.ErrorTokenNotFound,
+			controller.RevokePersonalAccessToken(uuid.New(), uuid.New()))
+	})
+}
@@ -0,0 +1,190 @@
+package admin
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	adminEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/admin"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	adminRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/admin"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+type IController interface {
+	ListAccounts(actor *authEntities.Actor, filter *adminEntities.Filter) (*adminEntities.AccountsResponse, error)
+	GetMemberships(accountID uuid.UUID, actor *authEntities.Actor) (*adminEntities.Memberships, error)
+	DisableAccount(accountID uuid.UUID, actor *authEntities.Actor) error
+	EnableAccount(accountID uuid.UUID, actor *authEntities.Actor) error
+	SetApplicationAdmin(accountID uuid.UUID, actor *authEntities.Actor,
+		data *adminEntities.ApplicationAdminData) error
+	ForcePasswordReset(accountID uuid.UUID, actor *authEntities.Actor) error
+	ResendConfirmationEmail(accountID uuid.UUID, actor *authEntities.Actor) error
+}
+
+type Controller struct {
+	adminRepository   adminRepository.IRepository
+	accountRepository accountRepository.IRepository
+	accountController accountController.IController
+	authController    authController.IController
+	sessionService    sessionService.IService
+	appConfig         app.IConfig
+}
+
+func NewAdminController(repositoryAdmin adminRepository.IRepository, repositoryAccount accountRepository.IRepository,
+	controllerAccount accountController.IController, controllerAuth authController.IController,
+	serviceSession sessionService.IService, appConfig app.IConfig) IController {
+	return &Controller{
+		adminRepository:   repositoryAdmin,
+		accountRepository: repositoryAccount,
+		accountController: controllerAccount,
+		authController:    controllerAuth,
+		sessionService:    serviceSession,
+		appConfig:         appConfig,
+	}
+}
+
+func (c *Controller) ListAccounts(actor *authEntities.Actor,
+	filter *adminEntities.Filter) (*adminEntities.AccountsResponse, error) {
+	if err := c.checkIsApplicationAdmin(actor); err != nil {
+		return nil, err
+	}
+
+	accounts, total, err := c.adminRepository.ListAccounts(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return adminEntities.NewAccountsResponse(accounts, total), nil
+}
+
+func (c *Controller) GetMemberships(accountID uuid.UUID,
+	actor *authEntities.Actor) (*adminEntities.Memberships, error) {
+	account, err := c.getAccount(accountID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := &adminEntities.Memberships{}
+	if memberships.Workspaces, err = c.adminRepository.ListWorkspaceMemberships(account.AccountID); err != nil {
+		return nil, err
+	}
+
+	memberships.Repositories, err = c.adminRepository.ListRepositoryMemberships(account.AccountID)
+	return memberships, err
+}
+
+// DisableAccount also revokes the sessions of the account, so it's logged out right away
+func (c *Controller) DisableAccount(accountID uuid.UUID, actor *authEntities.Actor) error {
+	account, err := c.getAccountToChange(accountID, actor)
+	if err != nil {
+		return err
+	}
+
+	if err := c.accountRepository.UpdateIsDisabled(account.AccountID, true); err != nil {
+		return err
+	}
+
+	return c.sessionService.RevokeAllSessions(account.AccountID)
+}
+
+func (c *Controller) EnableAccount(accountID uuid.UUID, actor *authEntities.Actor) error {
+	account, err := c.getAccount(accountID, actor)
+	if err != nil {
+		return err
+	}
+
+	return c.accountRepository.UpdateIsDisabled(account.AccountID, false)
+}
+
+// SetApplicationAdmin only blocks the self demotion, so there is always at least one application admin left
+func (c *Controller) SetApplicationAdmin(accountID uuid.UUID, actor *authEntities.Actor,
+	data *adminEntities.ApplicationAdminData) error {
+	if !*data.IsApplicationAdmin && accountID == actor.AccountID {
+		return adminEnums.ErrorChangeOwnAccount
+	}
+
+	account, err := c.getAccount(accountID, actor)
+	if err != nil {
+		return err
+	}
+
+	return c.accountRepository.UpdateIsApplicationAdmin(account.AccountID, *data.IsApplicationAdmin)
+}
+
+// ForcePasswordReset replaces the password by a random one and ends the sessions, the account can only be accessed
+// again using the reset password code sent by email
+func (c *Controller) ForcePasswordReset(accountID uuid.UUID, actor *authEntities.Actor) error {
+	if c.appConfig.GetAuthenticationType() != auth.Horusec {
+		return adminEnums.ErrorPasswordResetNotSupported
+	}
+
+	account, err := c.getAccount(accountID, actor)
+	if err != nil {
+		return err
+	}
+
+	if err := c.resetPassword(account); err != nil {
+		return err
+	}
+
+	return c.accountController.SendAccountResetPasswordCode(account)
+}
+
+func (c *Controller) resetPassword(account *accountEntities.Account) error {
+	if _, err := c.accountRepository.Update(account.SetNewPassword(uuid.NewString())); err != nil {
+		return err
+	}
+
+	return c.sessionService.RevokeAllSessions(account.AccountID)
+}
+
+func (c *Controller) ResendConfirmationEmail(accountID uuid.UUID, actor *authEntities.Actor) error {
+	account, err := c.getAccount(accountID, actor)
+	if err != nil {
+		return err
+	}
+
+	return c.accountController.SendAccountValidationEmail(account)
+}
+
+func (c *Controller) getAccountToChange(accountID uuid.UUID,
+	actor *authEntities.Actor) (*accountEntities.Account, error) {
+	if accountID == actor.AccountID {
+		return nil, adminEnums.ErrorChangeOwnAccount
+	}
+
+	return c.getAccount(accountID, actor)
+}
+
+func (c *Controller) getAccount(accountID uuid.UUID,
+	actor *authEntities.Actor) (*accountEntities.Account, error) {
+	if err := c.checkIsApplicationAdmin(actor); err != nil {
+		return nil, err
+	}
+
+	account, err := c.accountRepository.GetAccount(accountID)
+	if err == databaseEnums.ErrorNotFoundRecords || (err == nil && account.AccountID == uuid.Nil) {
+		return nil, adminEnums.ErrorAccountNotFound
+	}
+
+	return account, err
+}
+
+// checkIsApplicationAdmin resolves the application admin with the active authentication type, so the admins of the
+// group based types are the members of the admin group instead of the accounts flagged in the database
+func (c *Controller) checkIsApplicationAdmin(actor *authEntities.Actor) error {
+	isApplicationAdmin, err := c.authController.IsAuthorized(actor.ToApplicationAdminAuthorizationData())
+	if err != nil || !isApplicationAdmin {
+		return adminEnums.ErrorNotAllowed
+	}
+
+	return nil
+}
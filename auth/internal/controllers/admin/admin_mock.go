@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListAccounts(_ *authEntities.Actor, _ *adminEntities.Filter) (*adminEntities.AccountsResponse, error) {
+	args := m.MethodCalled("ListAccounts")
+	return args.Get(0).(*adminEntities.AccountsResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetMemberships(_ uuid.UUID, _ *authEntities.Actor) (*adminEntities.Memberships, error) {
+	args := m.MethodCalled("GetMemberships")
+	return args.Get(0).(*adminEntities.Memberships), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DisableAccount(_ uuid.UUID, _ *authEntities.Actor) error {
+	args := m.MethodCalled("DisableAccount")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) EnableAccount(_ uuid.UUID, _ *authEntities.Actor) error {
+	args := m.MethodCalled("EnableAccount")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) SetApplicationAdmin(_ uuid.UUID, _ *authEntities.Actor, _ *adminEntities.ApplicationAdminData) error {
+	args := m.MethodCalled("SetApplicationAdmin")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ForcePasswordReset(_ uuid.UUID, _ *authEntities.Actor) error {
+	args := m.MethodCalled("ForcePasswordReset")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ResendConfirmationEmail(_ uuid.UUID, _ *authEntities.Actor) error {
+	args := m.MethodCalled("ResendConfirmationEmail")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,350 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	adminEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/admin"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	adminRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/admin"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+func newAdminAccount() *accountEntities.Account {
+	return &accountEntities.Account{AccountID: uuid.New(), Email: "test@test.com", IsApplicationAdmin: true}
+}
+
+func newAccountRepositoryMock(account *accountEntities.Account) *accountRepository.Mock {
+	accountRepositoryMock := &accountRepository.Mock{}
+	accountRepositoryMock.On("GetAccount").Return(account, nil)
+	accountRepositoryMock.On("Update").Return(account, nil)
+	accountRepositoryMock.On("UpdateIsDisabled").Return(nil)
+	accountRepositoryMock.On("UpdateIsApplicationAdmin").Return(nil)
+
+	return accountRepositoryMock
+}
+
+func newAdminRepositoryMock() *adminRepository.Mock {
+	adminRepositoryMock := &adminRepository.Mock{}
+	adminRepositoryMock.On("ListAccounts").Return([]*accountEntities.Account{newAdminAccount()}, 1, nil)
+	adminRepositoryMock.On("ListWorkspaceMemberships").Return([]*adminEntities.WorkspaceMembership{}, nil)
+	adminRepositoryMock.On("ListRepositoryMemberships").Return([]*adminEntities.RepositoryMembership{}, nil)
+
+	return adminRepositoryMock
+}
+
+func newSessionServiceMock() *sessionService.Mock {
+	sessionServiceMock := &sessionService.Mock{}
+	sessionServiceMock.On("RevokeAllSessions").Return(nil)
+
+	return sessionServiceMock
+}
+
+func newAccountControllerMock() *accountController.Mock {
+	accountControllerMock := &accountController.Mock{}
+	accountControllerMock.On("SendAccountValidationEmail").Return(nil)
+	accountControllerMock.On("SendAccountResetPasswordCode").Return(nil)
+
+	return accountControllerMock
+}
+
+func newAuthControllerMock(isApplicationAdmin bool, err error) *authController.Mock {
+	authControllerMock := &authController.Mock{}
+	authControllerMock.On("IsAuthorized").Return(isApplicationAdmin, err)
+
+	return authControllerMock
+}
+
+func newController(accountRepositoryMock *accountRepository.Mock, sessionServiceMock *sessionService.Mock,
+	accountControllerMock *accountController.Mock) IController {
+	return NewAdminController(newAdminRepositoryMock(), accountRepositoryMock, accountControllerMock,
+		newAuthControllerMock(true, nil), sessionServiceMock, &app.Config{AuthType: auth.Horusec})
+}
+
+func newNotAllowedController(authControllerMock *authController.Mock) IController {
+	return NewAdminController(newAdminRepositoryMock(), newAccountRepositoryMock(newAdminAccount()),
+		newAccountControllerMock(), authControllerMock, newSessionServiceMock(),
+		&app.Config{AuthType: auth.Horusec})
+}
+
+func newActor() *authEntities.Actor {
+	return authEntities.NewActor(uuid.New(), "test")
+}
+
+func newApplicationAdminData(isApplicationAdmin bool) *adminEntities.ApplicationAdminData {
+	return &adminEntities.ApplicationAdminData{IsApplicationAdmin: &isApplicationAdmin}
+}
+
+func TestNewAdminController(t *testing.T) {
+	t.Run("should create admin controller", func(t *testing.T) {
+		assert.NotNil(t, NewAdminController(nil, nil, nil, nil, nil, nil))
+	})
+}
+
+func TestListAccounts(t *testing.T) {
+	t.Run("should success list accounts", func(t *testing.T) {
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			newAccountControllerMock())
+
+		result, err := controller.ListAccounts(newActor(), &adminEntities.Filter{Page: 1, Size: 10})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.TotalItems)
+		assert.Len(t, result.Data, 1)
+	})
+
+	t.Run("should return error when actor is not an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, nil))
+
+		_, err := controller.ListAccounts(newActor(), &adminEntities.Filter{Page: 1, Size: 10})
+		assert.Equal(t, adminEnums.ErrorNotAllowed, err)
+	})
+
+	t.Run("should return error when failed to check if actor is an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, errors.New("test")))
+
+		_, err := controller.ListAccounts(newActor(), &adminEntities.Filter{Page: 1, Size: 10})
+		assert.Equal(t, adminEnums.ErrorNotAllowed, err)
+	})
+
+	t.Run("should return error when failed to list accounts", func(t *testing.T) {
+		adminRepositoryMock := &adminRepository.Mock{}
+		adminRepositoryMock.On("ListAccounts").Return([]*accountEntities.Account{}, 0, errors.New("test"))
+
+		controller := NewAdminController(adminRepositoryMock, newAccountRepositoryMock(newAdminAccount()),
+			newAccountControllerMock(), newAuthControllerMock(true, nil), newSessionServiceMock(),
+			&app.Config{})
+
+		_, err := controller.ListAccounts(newActor(), &adminEntities.Filter{Page: 1, Size: 10})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetMemberships(t *testing.T) {
+	t.Run("should success get memberships", func(t *testing.T) {
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			newAccountControllerMock())
+
+		result, err := controller.GetMemberships(uuid.New(), newActor())
+		assert.NoError(t, err)
+		assert.NotNil(t, result.Workspaces)
+		assert.NotNil(t, result.Repositories)
+	})
+
+	t.Run("should return error when account not found", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := newController(accountRepositoryMock, newSessionServiceMock(), newAccountControllerMock())
+
+		_, err := controller.GetMemberships(uuid.New(), newActor())
+		assert.Equal(t, adminEnums.ErrorAccountNotFound, err)
+	})
+
+	t.Run("should return error when account is empty", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+
+		controller := newController(accountRepositoryMock, newSessionServiceMock(), newAccountControllerMock())
+
+		_, err := controller.GetMemberships(uuid.New(), newActor())
+		assert.Equal(t, adminEnums.ErrorAccountNotFound, err)
+	})
+
+	t.Run("should return error when failed to list workspace memberships", func(t *testing.T) {
+		adminRepositoryMock := &adminRepository.Mock{}
+		adminRepositoryMock.On("ListWorkspaceMemberships").Return(
+			[]*adminEntities.WorkspaceMembership{}, errors.New("test"))
+
+		controller := NewAdminController(adminRepositoryMock, newAccountRepositoryMock(newAdminAccount()),
+			newAccountControllerMock(), newAuthControllerMock(true, nil), newSessionServiceMock(),
+			&app.Config{})
+
+		_, err := controller.GetMemberships(uuid.New(), newActor())
+		assert.Error(t, err)
+	})
+}
+
+func TestDisableAccount(t *testing.T) {
+	t.Run("should success disable account and revoke its sessions", func(t *testing.T) {
+		accountRepositoryMock := newAccountRepositoryMock(newAdminAccount())
+		sessionServiceMock := newSessionServiceMock()
+
+		controller := newController(accountRepositoryMock, sessionServiceMock, newAccountControllerMock())
+
+		assert.NoError(t, controller.DisableAccount(uuid.New(), newActor()))
+		accountRepositoryMock.AssertCalled(t, "UpdateIsDisabled")
+		sessionServiceMock.AssertCalled(t, "RevokeAllSessions")
+	})
+
+	t.Run("should return error when disabling own account", func(t *testing.T) {
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			newAccountControllerMock())
+
+		accountID := uuid.New()
+		assert.Equal(t, adminEnums.ErrorChangeOwnAccount,
+			controller.DisableAccount(accountID, authEntities.NewActor(accountID, "test")))
+	})
+
+	t.Run("should return error when failed to disable account", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(newAdminAccount(), nil)
+		accountRepositoryMock.On("UpdateIsDisabled").Return(errors.New("test"))
+
+		sessionServiceMock := newSessionServiceMock()
+		controller := newController(accountRepositoryMock, sessionServiceMock, newAccountControllerMock())
+
+		assert.Error(t, controller.DisableAccount(uuid.New(), newActor()))
+		sessionServiceMock.AssertNotCalled(t, "RevokeAllSessions")
+	})
+
+	t.Run("should return error when actor is not an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, nil))
+
+		assert.Equal(t, adminEnums.ErrorNotAllowed, controller.DisableAccount(uuid.New(), newActor()))
+	})
+}
+
+func TestEnableAccount(t *testing.T) {
+	t.Run("should success enable account", func(t *testing.T) {
+		accountRepositoryMock := newAccountRepositoryMock(newAdminAccount())
+
+		controller := newController(accountRepositoryMock, newSessionServiceMock(), newAccountControllerMock())
+
+		assert.NoError(t, controller.EnableAccount(uuid.New(), newActor()))
+		accountRepositoryMock.AssertCalled(t, "UpdateIsDisabled")
+	})
+
+	t.Run("should return error when actor is not an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, nil))
+
+		assert.Equal(t, adminEnums.ErrorNotAllowed, controller.EnableAccount(uuid.New(), newActor()))
+	})
+}
+
+func TestSetApplicationAdmin(t *testing.T) {
+	t.Run("should success promote account", func(t *testing.T) {
+		accountRepositoryMock := newAccountRepositoryMock(newAdminAccount())
+
+		controller := newController(accountRepositoryMock, newSessionServiceMock(), newAccountControllerMock())
+
+		assert.NoError(t, controller.SetApplicationAdmin(uuid.New(), newActor(), newApplicationAdminData(true)))
+		accountRepositoryMock.AssertCalled(t, "UpdateIsApplicationAdmin")
+	})
+
+	t.Run("should success demote another account", func(t *testing.T) {
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			newAccountControllerMock())
+
+		assert.NoError(t, controller.SetApplicationAdmin(uuid.New(), newActor(), newApplicationAdminData(false)))
+	})
+
+	t.Run("should return error when demoting own account", func(t *testing.T) {
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			newAccountControllerMock())
+
+		accountID := uuid.New()
+		assert.Equal(t, adminEnums.ErrorChangeOwnAccount,
+			controller.SetApplicationAdmin(accountID, authEntities.NewActor(accountID, "test"), newApplicationAdminData(false)))
+	})
+
+	t.Run("should return error when actor is not an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, nil))
+
+		assert.Equal(t, adminEnums.ErrorNotAllowed,
+			controller.SetApplicationAdmin(uuid.New(), newActor(), newApplicationAdminData(true)))
+	})
+}
+
+func TestForcePasswordReset(t *testing.T) {
+	t.Run("should success force password reset", func(t *testing.T) {
+		accountRepositoryMock := newAccountRepositoryMock(newAdminAccount())
+		sessionServiceMock := newSessionServiceMock()
+		accountControllerMock := newAccountControllerMock()
+
+		controller := newController(accountRepositoryMock, sessionServiceMock, accountControllerMock)
+
+		assert.NoError(t, controller.ForcePasswordReset(uuid.New(), newActor()))
+		accountRepositoryMock.AssertCalled(t, "Update")
+		sessionServiceMock.AssertCalled(t, "RevokeAllSessions")
+		accountControllerMock.AssertCalled(t, "SendAccountResetPasswordCode")
+	})
+
+	t.Run("should return error when not using horusec authentication", func(t *testing.T) {
+		controller := NewAdminController(newAdminRepositoryMock(), newAccountRepositoryMock(newAdminAccount()),
+			newAccountControllerMock(), newAuthControllerMock(true, nil), newSessionServiceMock(),
+			&app.Config{AuthType: auth.Keycloak})
+
+		assert.Equal(t, adminEnums.ErrorPasswordResetNotSupported,
+			controller.ForcePasswordReset(uuid.New(), newActor()))
+	})
+
+	t.Run("should return error when failed to update password", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(newAdminAccount(), nil)
+		accountRepositoryMock.On("Update").Return(&accountEntities.Account{}, errors.New("test"))
+
+		controller := newController(accountRepositoryMock, newSessionServiceMock(), newAccountControllerMock())
+
+		assert.Error(t, controller.ForcePasswordReset(uuid.New(), newActor()))
+	})
+
+	t.Run("should return error when failed to revoke sessions", func(t *testing.T) {
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(errors.New("test"))
+
+		accountControllerMock := newAccountControllerMock()
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), sessionServiceMock,
+			accountControllerMock)
+
+		assert.Error(t, controller.ForcePasswordReset(uuid.New(), newActor()))
+		accountControllerMock.AssertNotCalled(t, "SendAccountResetPasswordCode")
+	})
+
+	t.Run("should return error when actor is not an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, nil))
+
+		assert.Equal(t, adminEnums.ErrorNotAllowed, controller.ForcePasswordReset(uuid.New(), newActor()))
+	})
+}
+
+func TestResendConfirmationEmail(t *testing.T) {
+	t.Run("should success resend confirmation email", func(t *testing.T) {
+		accountControllerMock := newAccountControllerMock()
+
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			accountControllerMock)
+
+		assert.NoError(t, controller.ResendConfirmationEmail(uuid.New(), newActor()))
+		accountControllerMock.AssertCalled(t, "SendAccountValidationEmail")
+	})
+
+	t.Run("should return error when account is already confirmed", func(t *testing.T) {
+		accountControllerMock := &accountController.Mock{}
+		accountControllerMock.On("SendAccountValidationEmail").Return(accountEnums.ErrorAccountAlreadyConfirmed)
+
+		controller := newController(newAccountRepositoryMock(newAdminAccount()), newSessionServiceMock(),
+			accountControllerMock)
+
+		assert.Equal(t, accountEnums.ErrorAccountAlreadyConfirmed,
+			controller.ResendConfirmationEmail(uuid.New(), newActor()))
+	})
+
+	t.Run("should return error when actor is not an application admin", func(t *testing.T) {
+		controller := newNotAllowedController(newAuthControllerMock(false, nil))
+
+		assert.Equal(t, adminEnums.ErrorNotAllowed, controller.ResendConfirmationEmail(uuid.New(), newActor()))
+	})
+}
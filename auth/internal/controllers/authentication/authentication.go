@@ -1,16 +1,36 @@
 package authentication
 
 import (
+	"github.com/google/uuid"
+
 	authTypes "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
 	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
+	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
+	ldapEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/ldap"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	customRoleEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/customrole"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/horusec"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/keycloak"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/ldap"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/oidc"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/saml"
+	lockoutService "github.com/ZupIT/horusec-platform/auth/internal/services/lockout"
+	personalTokenService "github.com/ZupIT/horusec-platform/auth/internal/services/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 )
 
 type IController interface {
@@ -18,6 +38,21 @@ type IController interface {
 	IsAuthorized(data *authEntities.AuthorizationData) (bool, error)
 	GetAccountInfo(token string) (*proto.GetAccountDataResponse, error)
 	GetAccountInfoByEmail(email string) (*proto.GetAccountDataResponse, error)
+	GetOIDCAuthorizationURL() (*oidcEntities.AuthorizationURLResponse, error)
+	OIDCCallback(data *oidcEntities.CallbackData) (*authEntities.LoginResponse, error)
+	GetSAMLMetadata() ([]byte, error)
+	GetSAMLLoginURL() (*samlEntities.LoginURLResponse, error)
+	SAMLAssertionConsumer(data *samlEntities.AssertionData) (string, error)
+	SAMLExchangeLoginCode(data *samlEntities.LoginCodeData) (*authEntities.LoginResponse, error)
+	VerifyMFA(data *mfaEntities.ChallengeData) (*authEntities.LoginResponse, error)
+	EnrollMFA(data *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error)
+}
+
+// iService contains the methods implemented by all the authentication types
+type iService interface {
+	Login(credentials *authEntities.LoginCredentials) (*authEntities.LoginResponse, error)
+	IsAuthorized(data *authEntities.AuthorizationData) (bool, error)
+	GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error)
 }
 
 type Controller struct {
@@ -25,54 +60,177 @@ type Controller struct {
 	horusecAuth       horusec.IService
 	keycloakAuth      keycloak.IService
 	ldapAuth          ldap.IService
+	oidcAuth          oidc.IService
+	samlAuth          saml.IService
 	accountRepository accountRepository.IRepository
+	lockoutService    lockoutService.IService
+	personalToken     personalTokenService.IService
+	authRepository    authRepository.IRepository
 }
 
 func NewAuthenticationController(appConfig app.IConfig, authHorusec horusec.IService, ldapAuth ldap.IService,
-	keycloakAuth keycloak.IService, repositoryAccount accountRepository.IRepository) IController {
+	keycloakAuth keycloak.IService, oidcAuth oidc.IService, samlAuth saml.IService,
+	repositoryAccount accountRepository.IRepository, serviceLockout lockoutService.IService,
+	servicePersonalToken personalTokenService.IService, repositoryAuth authRepository.IRepository) IController {
 	return &Controller{
 		appConfig:         appConfig,
 		horusecAuth:       authHorusec,
 		ldapAuth:          ldapAuth,
 		keycloakAuth:      keycloakAuth,
+		oidcAuth:          oidcAuth,
+		samlAuth:          samlAuth,
 		accountRepository: repositoryAccount,
+		lockoutService:    serviceLockout,
+		personalToken:     servicePersonalToken,
+		authRepository:    repositoryAuth,
 	}
 }
 
 func (c *Controller) Login(credentials *authEntities.LoginCredentials) (*authEntities.LoginResponse, error) {
-	switch c.appConfig.GetAuthenticationType() {
-	case authTypes.Horusec:
-		return c.horusecAuth.Login(credentials)
-	case authTypes.Keycloak:
-		return c.keycloakAuth.Login(credentials)
-	case authTypes.Ldap:
-		return c.ldapAuth.Login(credentials)
+	service, err := c.getService()
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, authEnums.ErrorAuthTypeInvalid
+	if err := c.lockoutService.CheckLogin(credentials.Username, credentials.IPAddress); err != nil {
+		return nil, err
+	}
+
+	response, err := service.Login(credentials)
+	c.registerLoginAttempt(credentials, err)
+
+	return response, err
+}
+
+// registerLoginAttempt only counts the wrong credentials as failures, the other errors aren't a guess attempt
+func (c *Controller) registerLoginAttempt(credentials *authEntities.LoginCredentials, err error) {
+	if err == nil {
+		c.lockoutService.RegisterLoginSuccess(credentials.Username)
+		return
+	}
+
+	if c.isWrongCredentialsError(err) {
+		c.lockoutService.RegisterLoginFailure(credentials.Username, credentials.IPAddress)
+	}
 }
 
+func (c *Controller) isWrongCredentialsError(err error) bool {
+	return err == horusecAuthEnums.ErrorWrongEmailOrPassword || err == databaseEnums.ErrorNotFoundRecords ||
+		err == ldapEnums.ErrorLdapUnauthorized || err == ldapEnums.ErrorUserDoesNotExist
+}
+
+// IsAuthorized checks the permissions sent by the routes against the custom roles of the account first, when they
+// don't allow it the permission is checked as the built-in role that grants it
 func (c *Controller) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
-	switch c.appConfig.GetAuthenticationType() {
-	case authTypes.Horusec:
-		return c.horusecAuth.IsAuthorized(data)
-	case authTypes.Keycloak:
-		return c.keycloakAuth.IsAuthorized(data)
-	case authTypes.Ldap:
-		return c.ldapAuth.IsAuthorized(data)
+	service, err := c.getService()
+	if err != nil {
+		return false, err
+	}
+
+	permission := data.ReplacePermissionByPreset()
+	if personalTokenEntities.IsPersonalAccessToken(data.Token) {
+		return c.isAuthorizedWithPersonalToken(service, data, permission)
+	}
+
+	return c.isAuthorized(service, data, permission)
+}
+
+// isAuthorizedWithPersonalToken replaces the personal access token by a jwt of its owner after checking the token
+// scopes, then the account roles are checked the same way as for any other request
+func (c *Controller) isAuthorizedWithPersonalToken(service iService, data *authEntities.AuthorizationData,
+	permission permissionEnums.Permission) (bool, error) {
+	token, err := c.personalToken.NewAuthorizationToken(data.Token, permission)
+	if err != nil {
+		return false, c.checkPersonalTokenUnauthorizedErrors(err)
+	}
+
+	data.Token = token
+	return c.isAuthorized(service, data, permission)
+}
+
+func (c *Controller) isAuthorized(service iService, data *authEntities.AuthorizationData,
+	permission permissionEnums.Permission) (bool, error) {
+	if permission != "" && c.hasCustomRolePermission(service, data, permission) {
+		return true, nil
+	}
+
+	return service.IsAuthorized(data)
+}
+
+// hasCustomRolePermission any failure only skips the custom roles, the built-in role check is still made
+func (c *Controller) hasCustomRolePermission(service iService, data *authEntities.AuthorizationData,
+	permission permissionEnums.Permission) bool {
+	customRoles, err := c.getCustomRoles(service, data)
+	if err != nil {
+		logger.LogError(customRoleEnums.MessageFailedToGetCustomRolePermissions, err)
+		return false
 	}
 
-	return false, authEnums.ErrorAuthTypeInvalid
+	for index := range *customRoles {
+		if (*customRoles)[index].HasPermission(permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Controller) getCustomRoles(service iService,
+	data *authEntities.AuthorizationData) (*[]authEntities.CustomRole, error) {
+	accountData, err := service.GetAccountDataFromToken(data.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := uuid.Parse(accountData.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.authRepository.GetCustomRoles(accountID, data.WorkspaceID, data.RepositoryID)
+}
+
+// checkPersonalTokenUnauthorizedErrors an invalid token or scope is an unauthorized request, not a failure
+func (c *Controller) checkPersonalTokenUnauthorizedErrors(err error) error {
+	if err == personalTokenEnums.ErrorInvalidToken || err == personalTokenEnums.ErrorScopeNotAllowed {
+		return nil
+	}
+
+	return err
 }
 
 func (c *Controller) GetAccountInfo(token string) (*proto.GetAccountDataResponse, error) {
+	service, err := c.getService()
+	if err != nil {
+		return nil, err
+	}
+
+	if personalTokenEntities.IsPersonalAccessToken(token) {
+		return c.personalToken.GetAccountData(token)
+	}
+
+	return service.GetAccountDataFromToken(token)
+}
+
+func (c *Controller) getService() (iService, error) {
 	switch c.appConfig.GetAuthenticationType() {
 	case authTypes.Horusec:
-		return c.horusecAuth.GetAccountDataFromToken(token)
+		return c.horusecAuth, nil
 	case authTypes.Keycloak:
-		return c.keycloakAuth.GetAccountDataFromToken(token)
+		return c.keycloakAuth, nil
 	case authTypes.Ldap:
-		return c.ldapAuth.GetAccountDataFromToken(token)
+		return c.ldapAuth, nil
+	}
+
+	return c.getSingleSignOnService()
+}
+
+func (c *Controller) getSingleSignOnService() (iService, error) {
+	switch c.appConfig.GetAuthenticationType() {
+	case oidcEnums.AuthenticationTypeOIDC:
+		return c.oidcAuth, nil
+	case samlEnums.AuthenticationTypeSAML:
+		return c.samlAuth, nil
 	}
 
 	return nil, authEnums.ErrorAuthTypeInvalid
@@ -86,3 +244,67 @@ func (c *Controller) GetAccountInfoByEmail(email string) (*proto.GetAccountDataR
 
 	return account.ToGetAccountDataResponse(nil), nil
 }
+
+func (c *Controller) GetOIDCAuthorizationURL() (*oidcEntities.AuthorizationURLResponse, error) {
+	if c.appConfig.GetAuthenticationType() != oidcEnums.AuthenticationTypeOIDC {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.oidcAuth.GetAuthorizationURL()
+}
+
+func (c *Controller) OIDCCallback(data *oidcEntities.CallbackData) (*authEntities.LoginResponse, error) {
+	if c.appConfig.GetAuthenticationType() != oidcEnums.AuthenticationTypeOIDC {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.oidcAuth.Callback(data)
+}
+
+func (c *Controller) GetSAMLMetadata() ([]byte, error) {
+	if c.appConfig.GetAuthenticationType() != samlEnums.AuthenticationTypeSAML {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.samlAuth.GetMetadata()
+}
+
+func (c *Controller) GetSAMLLoginURL() (*samlEntities.LoginURLResponse, error) {
+	if c.appConfig.GetAuthenticationType() != samlEnums.AuthenticationTypeSAML {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.samlAuth.GetLoginURL()
+}
+
+func (c *Controller) SAMLAssertionConsumer(data *samlEntities.AssertionData) (string, error) {
+	if c.appConfig.GetAuthenticationType() != samlEnums.AuthenticationTypeSAML {
+		return "", authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.samlAuth.AssertionConsumer(data)
+}
+
+func (c *Controller) SAMLExchangeLoginCode(data *samlEntities.LoginCodeData) (*authEntities.LoginResponse, error) {
+	if c.appConfig.GetAuthenticationType() != samlEnums.AuthenticationTypeSAML {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.samlAuth.ExchangeLoginCode(data)
+}
+
+func (c *Controller) VerifyMFA(data *mfaEntities.ChallengeData) (*authEntities.LoginResponse, error) {
+	if c.appConfig.GetAuthenticationType() != authTypes.Horusec {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.horusecAuth.VerifyMFA(data)
+}
+
+func (c *Controller) EnrollMFA(data *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error) {
+	if c.appConfig.GetAuthenticationType() != authTypes.Horusec {
+		return nil, authEnums.ErrorAuthTypeInvalid
+	}
+
+	return c.horusecAuth.EnrollMFA(data)
+}
@@ -7,6 +7,9 @@ import (
 	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
 )
 
 type Mock struct {
@@ -32,3 +35,43 @@ func (m *Mock) GetAccountInfoByEmail(_ string) (*proto.GetAccountDataResponse, e
 	args := m.MethodCalled("GetAccountInfoByEmail")
 	return args.Get(0).(*proto.GetAccountDataResponse), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetOIDCAuthorizationURL() (*oidcEntities.AuthorizationURLResponse, error) {
+	args := m.MethodCalled("GetOIDCAuthorizationURL")
+	return args.Get(0).(*oidcEntities.AuthorizationURLResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) OIDCCallback(_ *oidcEntities.CallbackData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("OIDCCallback")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetSAMLMetadata() ([]byte, error) {
+	args := m.MethodCalled("GetSAMLMetadata")
+	return args.Get(0).([]byte), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetSAMLLoginURL() (*samlEntities.LoginURLResponse, error) {
+	args := m.MethodCalled("GetSAMLLoginURL")
+	return args.Get(0).(*samlEntities.LoginURLResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SAMLAssertionConsumer(_ *samlEntities.AssertionData) (string, error) {
+	args := m.MethodCalled("SAMLAssertionConsumer")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SAMLExchangeLoginCode(_ *samlEntities.LoginCodeData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("SAMLExchangeLoginCode")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) VerifyMFA(_ *mfaEntities.ChallengeData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("VerifyMFA")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) EnrollMFA(_ *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error) {
+	args := m.MethodCalled("EnrollMFA")
+	return args.Get(0).(*mfaEntities.EnrollmentResponse), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
@@ -12,15 +13,38 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
 	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
+	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	personalTokenRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/personaltoken"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication"
+	lockoutService "github.com/ZupIT/horusec-platform/auth/internal/services/lockout"
+	personalTokenService "github.com/ZupIT/horusec-platform/auth/internal/services/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 )
 
+func newLockoutMock() *lockoutService.Mock {
+	lockoutMock := &lockoutService.Mock{}
+	lockoutMock.On("CheckLogin").Return(nil)
+	lockoutMock.On("RegisterLoginSuccess")
+	lockoutMock.On("RegisterLoginFailure")
+
+	return lockoutMock
+}
+
 func TestNewAuthenticationController(t *testing.T) {
 	t.Run("should success create a new controller", func(t *testing.T) {
 		assert.NotNil(t, NewAuthenticationController(nil, nil,
-			nil, nil, nil))
+			nil, nil, nil, nil, nil, nil, nil, nil))
 	})
 }
 
@@ -34,7 +58,8 @@ func TestLogin(t *testing.T) {
 		authenticationMock.On("Login").Return(&authEntities.LoginResponse{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.Login(&authEntities.LoginCredentials{})
 		assert.NoError(t, err)
@@ -50,7 +75,8 @@ func TestLogin(t *testing.T) {
 		authenticationMock.On("Login").Return(&authEntities.LoginResponse{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.Login(&authEntities.LoginCredentials{})
 		assert.NoError(t, err)
@@ -66,7 +92,23 @@ func TestLogin(t *testing.T) {
 		authenticationMock.On("Login").Return(&authEntities.LoginResponse{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.Login(&authEntities.LoginCredentials{})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should success login with saml auth type", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("Login").Return(&authEntities.LoginResponse{}, nil)
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.Login(&authEntities.LoginCredentials{})
 		assert.NoError(t, err)
@@ -80,13 +122,64 @@ func TestLogin(t *testing.T) {
 		authenticationMock := &authentication.Mock{}
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.Login(&authEntities.LoginCredentials{})
 		assert.Error(t, err)
 		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
 		assert.Nil(t, response)
 	})
+
+	t.Run("should return error without trying credentials when locked", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: auth.Horusec}
+		authenticationMock := &authentication.Mock{}
+
+		lockoutMock := &lockoutService.Mock{}
+		lockoutMock.On("CheckLogin").Return(lockoutEnums.ErrorAccountLocked)
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, lockoutMock,
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.Login(&authEntities.LoginCredentials{})
+		assert.Equal(t, lockoutEnums.ErrorAccountLocked, err)
+		authenticationMock.AssertNotCalled(t, "Login")
+	})
+
+	t.Run("should register failure when wrong credentials", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: auth.Horusec}
+		lockoutMock := newLockoutMock()
+
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("Login").Return(&authEntities.LoginResponse{},
+			horusecAuthEnums.ErrorWrongEmailOrPassword)
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, lockoutMock,
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.Login(&authEntities.LoginCredentials{})
+		assert.Equal(t, horusecAuthEnums.ErrorWrongEmailOrPassword, err)
+		lockoutMock.AssertCalled(t, "RegisterLoginFailure")
+	})
+
+	t.Run("should not register failure when other errors", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: auth.Horusec}
+		lockoutMock := newLockoutMock()
+
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("Login").Return(&authEntities.LoginResponse{}, errors.New("test"))
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, lockoutMock,
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.Login(&authEntities.LoginCredentials{})
+		assert.Error(t, err)
+		lockoutMock.AssertNotCalled(t, "RegisterLoginFailure")
+		lockoutMock.AssertNotCalled(t, "RegisterLoginSuccess")
+	})
 }
 
 func TestIsAuthorized(t *testing.T) {
@@ -99,7 +192,8 @@ func TestIsAuthorized(t *testing.T) {
 		authenticationMock.On("IsAuthorized").Return(true, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.IsAuthorized(&authEntities.AuthorizationData{})
 		assert.NoError(t, err)
@@ -115,7 +209,8 @@ func TestIsAuthorized(t *testing.T) {
 		authenticationMock.On("IsAuthorized").Return(true, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.IsAuthorized(&authEntities.AuthorizationData{})
 		assert.NoError(t, err)
@@ -131,7 +226,8 @@ func TestIsAuthorized(t *testing.T) {
 		authenticationMock.On("IsAuthorized").Return(true, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.IsAuthorized(&authEntities.AuthorizationData{})
 		assert.NoError(t, err)
@@ -145,7 +241,8 @@ func TestIsAuthorized(t *testing.T) {
 		authenticationMock := &authentication.Mock{}
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.IsAuthorized(&authEntities.AuthorizationData{})
 		assert.Error(t, err)
@@ -164,7 +261,8 @@ func TestGetAccountInfo(t *testing.T) {
 		authenticationMock.On("GetAccountDataFromToken").Return(&proto.GetAccountDataResponse{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.GetAccountInfo("")
 		assert.NoError(t, err)
@@ -180,7 +278,8 @@ func TestGetAccountInfo(t *testing.T) {
 		authenticationMock.On("GetAccountDataFromToken").Return(&proto.GetAccountDataResponse{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.GetAccountInfo("")
 		assert.NoError(t, err)
@@ -196,7 +295,8 @@ func TestGetAccountInfo(t *testing.T) {
 		authenticationMock.On("GetAccountDataFromToken").Return(&proto.GetAccountDataResponse{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.GetAccountInfo("")
 		assert.NoError(t, err)
@@ -210,7 +310,8 @@ func TestGetAccountInfo(t *testing.T) {
 		authenticationMock := &authentication.Mock{}
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.GetAccountInfo("")
 		assert.Error(t, err)
@@ -229,7 +330,8 @@ func TestGetAccountInfoByEmail(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		response, err := controller.GetAccountInfoByEmail("test@test.com")
 		assert.NoError(t, err)
@@ -246,9 +348,425 @@ func TestGetAccountInfoByEmail(t *testing.T) {
 			&accountEntities.Account{}, errors.New("test"))
 
 		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
-			authenticationMock, accountRepositoryMock)
+			authenticationMock, authenticationMock, authenticationMock, accountRepositoryMock, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
 
 		_, err := controller.GetAccountInfoByEmail("test@test.com")
 		assert.Error(t, err)
 	})
 }
+
+func TestGetOIDCAuthorizationURL(t *testing.T) {
+	t.Run("should success get authorization url with oidc auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("GetAuthorizationURL").Return(&oidcEntities.AuthorizationURLResponse{}, nil)
+
+		appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.GetOIDCAuthorizationURL()
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not oidc", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.GetOIDCAuthorizationURL()
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestOIDCCallback(t *testing.T) {
+	t.Run("should success login with oidc callback", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("Callback").Return(&authEntities.LoginResponse{}, nil)
+
+		appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.OIDCCallback(&oidcEntities.CallbackData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not oidc", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: auth.Ldap}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.OIDCCallback(&oidcEntities.CallbackData{})
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestGetSAMLMetadata(t *testing.T) {
+	t.Run("should success get metadata with saml auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("GetMetadata").Return([]byte("metadata"), nil)
+
+		appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.GetSAMLMetadata()
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not saml", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.GetSAMLMetadata()
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestGetSAMLLoginURL(t *testing.T) {
+	t.Run("should success get login url with saml auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("GetLoginURL").Return(&samlEntities.LoginURLResponse{}, nil)
+
+		appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.GetSAMLLoginURL()
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not saml", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.GetSAMLLoginURL()
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestSAMLAssertionConsumer(t *testing.T) {
+	t.Run("should success consume assertion with saml auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("AssertionConsumer").Return("http://localhost:8043/auth/saml?code=test", nil)
+
+		appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.SAMLAssertionConsumer(&samlEntities.AssertionData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not saml", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.SAMLAssertionConsumer(&samlEntities.AssertionData{})
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestSAMLExchangeLoginCode(t *testing.T) {
+	t.Run("should success exchange login code with saml auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("ExchangeLoginCode").Return(&authEntities.LoginResponse{}, nil)
+
+		appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.SAMLExchangeLoginCode(&samlEntities.LoginCodeData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not saml", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.SAMLExchangeLoginCode(&samlEntities.LoginCodeData{})
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	t.Run("should success verify mfa with horusec auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("VerifyMFA").Return(&authEntities.LoginResponse{}, nil)
+
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.VerifyMFA(&mfaEntities.ChallengeData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not horusec", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: auth.Ldap}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.VerifyMFA(&mfaEntities.ChallengeData{})
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestEnrollMFA(t *testing.T) {
+	t.Run("should success enroll mfa with horusec auth type", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("EnrollMFA").Return(&mfaEntities.EnrollmentResponse{}, nil)
+
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.EnrollMFA(&mfaEntities.ChallengeData{})
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+	})
+
+	t.Run("should return error when auth type is not horusec", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		appConfig := &app.Config{AuthType: auth.Keycloak}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			&personalTokenService.Mock{}, &authRepository.Mock{})
+
+		_, err := controller.EnrollMFA(&mfaEntities.ChallengeData{})
+		assert.Equal(t, authEnums.ErrorAuthTypeInvalid, err)
+	})
+}
+
+func TestIsAuthorizedWithPersonalToken(t *testing.T) {
+	t.Run("should check the account roles with the owner jwt", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("IsAuthorized").Return(true, nil)
+
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("NewAuthorizationToken").Return("jwt", nil)
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), personalTokenMock, &authRepository.Mock{})
+
+		data := &authEntities.AuthorizationData{Token: "hpat_test"}
+
+		response, err := controller.IsAuthorized(data)
+		assert.NoError(t, err)
+		assert.True(t, response)
+		assert.Equal(t, "jwt", data.Token)
+	})
+
+	t.Run("should return unauthorized without error when scope doesn't allow", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("NewAuthorizationToken").Return("", personalTokenEnums.ErrorScopeNotAllowed)
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), personalTokenMock, &authRepository.Mock{})
+
+		response, err := controller.IsAuthorized(&authEntities.AuthorizationData{Token: "hpat_test"})
+		assert.NoError(t, err)
+		assert.False(t, response)
+		authenticationMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should deny members and tokens management to manage repositories scope", func(t *testing.T) {
+		data := &personalTokenEntities.Data{Name: "test", Scopes: []personalTokenEnums.Scope{
+			personalTokenEnums.ScopeManageRepositories}}
+		personalToken, token := personalTokenEntities.NewPersonalAccessToken(
+			data.SetAccountData(uuid.New(), []string{"group"}))
+
+		personalTokenRepositoryMock := &personalTokenRepository.Mock{}
+		personalTokenRepositoryMock.On("GetToken").Return(personalToken, nil)
+		personalTokenRepositoryMock.On("UpdateLastUsed").Return(nil)
+
+		authenticationMock := &authentication.Mock{}
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controller := NewAuthenticationController(appConfig, authenticationMock, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{}, newLockoutMock(),
+			personalTokenService.NewPersonalTokenService(personalTokenRepositoryMock, nil, appConfig),
+			&authRepository.Mock{})
+
+		for _, permission := range []permissionEnums.Permission{permissionEnums.WorkspaceMembersManage,
+			permissionEnums.WorkspaceTokensManage, permissionEnums.RepositoryMembersManage,
+			permissionEnums.RepositoryTokensManage} {
+			response, err := controller.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+				Type: auth.AuthorizationType(permission), WorkspaceID: uuid.New()})
+			assert.NoError(t, err)
+			assert.False(t, response)
+		}
+
+		authenticationMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should return error when failed to check personal token", func(t *testing.T) {
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("NewAuthorizationToken").Return("", errors.New("test"))
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, nil, nil, nil, nil, nil, nil,
+			nil, personalTokenMock, &authRepository.Mock{})
+
+		response, err := controller.IsAuthorized(&authEntities.AuthorizationData{Token: "hpat_test"})
+		assert.Error(t, err)
+		assert.False(t, response)
+	})
+}
+
+func TestIsAuthorizedWithCustomRole(t *testing.T) {
+	permissionData := func() *authEntities.AuthorizationData {
+		return &authEntities.AuthorizationData{WorkspaceID: uuid.New(), RepositoryID: uuid.New(),
+			Type: auth.AuthorizationType(permissionEnums.VulnerabilityTypeUpdate)}
+	}
+
+	t.Run("should return authorized when the custom role has the permission", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("GetAccountDataFromToken").Return(
+			&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetCustomRoles").Return(&[]authEntities.CustomRole{
+			{Permissions: []string{permissionEnums.VulnerabilityTypeUpdate.ToString()}}}, nil)
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), &personalTokenService.Mock{}, authRepositoryMock)
+
+		response, err := controller.IsAuthorized(permissionData())
+		assert.NoError(t, err)
+		assert.True(t, response)
+		authenticationMock.AssertNotCalled(t, "IsAuthorized")
+	})
+
+	t.Run("should check the built-in role when the custom role does not have the permission", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("IsAuthorized").Return(false, nil)
+		authenticationMock.On("GetAccountDataFromToken").Return(
+			&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetCustomRoles").Return(&[]authEntities.CustomRole{
+			{Permissions: []string{permissionEnums.VulnerabilityRead.ToString()}}}, nil)
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), &personalTokenService.Mock{}, authRepositoryMock)
+
+		data := permissionData()
+
+		response, err := controller.IsAuthorized(data)
+		assert.NoError(t, err)
+		assert.False(t, response)
+		assert.Equal(t, auth.RepositorySupervisor, data.Type)
+	})
+
+	t.Run("should check the built-in role when failed to get the account", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("IsAuthorized").Return(true, nil)
+		authenticationMock.On("GetAccountDataFromToken").Return(
+			&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), &personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.IsAuthorized(permissionData())
+		assert.NoError(t, err)
+		assert.True(t, response)
+	})
+
+	t.Run("should check the built-in role when the account id is invalid", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+		authenticationMock.On("IsAuthorized").Return(true, nil)
+		authenticationMock.On("GetAccountDataFromToken").Return(
+			&proto.GetAccountDataResponse{AccountID: "test"}, nil)
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), &personalTokenService.Mock{}, &authRepository.Mock{})
+
+		response, err := controller.IsAuthorized(permissionData())
+		assert.NoError(t, err)
+		assert.True(t, response)
+	})
+}
+
+func TestGetAccountInfoWithPersonalToken(t *testing.T) {
+	t.Run("should get account info with personal token service", func(t *testing.T) {
+		authenticationMock := &authentication.Mock{}
+
+		personalTokenMock := &personalTokenService.Mock{}
+		personalTokenMock.On("GetAccountData").Return(&proto.GetAccountDataResponse{}, nil)
+
+		controller := NewAuthenticationController(&app.Config{AuthType: auth.Horusec}, authenticationMock,
+			authenticationMock, authenticationMock, authenticationMock, authenticationMock, &accountRepository.Mock{},
+			newLockoutMock(), personalTokenMock, &authRepository.Mock{})
+
+		response, err := controller.GetAccountInfo("hpat_test")
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		authenticationMock.AssertNotCalled(t, "GetAccountDataFromToken")
+	})
+}
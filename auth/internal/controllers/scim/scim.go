@@ -0,0 +1,530 @@
+package scim
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	scimRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/scim"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+type IController interface {
+	CreateToken(data *scimEntities.TokenData, actor *authEntities.Actor) (*scimEntities.TokenCreateResponse, error)
+	ListTokens(actor *authEntities.Actor) ([]*scimEntities.Token, error)
+	RevokeToken(tokenID uuid.UUID, actor *authEntities.Actor) error
+	Authenticate(token string) error
+	ListUsers(query *scimEntities.ListQuery) (*scimEntities.ListResponse, error)
+	GetUser(accountID uuid.UUID) (*scimEntities.UserResource, error)
+	CreateUser(user *scimEntities.UserResource) (*scimEntities.UserResource, error)
+	ReplaceUser(accountID uuid.UUID, user *scimEntities.UserResource) (*scimEntities.UserResource, error)
+	PatchUser(accountID uuid.UUID, patch *scimEntities.PatchRequest) (*scimEntities.UserResource, error)
+	DeleteUser(accountID uuid.UUID) error
+	ListGroups(query *scimEntities.ListQuery) (*scimEntities.ListResponse, error)
+	GetGroup(groupID uuid.UUID) (*scimEntities.GroupResource, error)
+	CreateGroup(group *scimEntities.GroupResource) (*scimEntities.GroupResource, error)
+	ReplaceGroup(groupID uuid.UUID, group *scimEntities.GroupResource) (*scimEntities.GroupResource, error)
+	PatchGroup(groupID uuid.UUID, patch *scimEntities.PatchRequest) (*scimEntities.GroupResource, error)
+	DeleteGroup(groupID uuid.UUID) error
+}
+
+type Controller struct {
+	scimRepository    scimRepository.IRepository
+	accountRepository accountRepository.IRepository
+	authController    authController.IController
+	sessionService    sessionService.IService
+	invitationService invitationService.IService
+}
+
+func NewSCIMController(repositorySCIM scimRepository.IRepository, repositoryAccount accountRepository.IRepository,
+	controllerAuth authController.IController, serviceSession sessionService.IService,
+	serviceInvitation invitationService.IService) IController {
+	return &Controller{
+		scimRepository:    repositorySCIM,
+		accountRepository: repositoryAccount,
+		authController:    controllerAuth,
+		sessionService:    serviceSession,
+		invitationService: serviceInvitation,
+	}
+}
+
+func (c *Controller) CreateToken(data *scimEntities.TokenData,
+	actor *authEntities.Actor) (*scimEntities.TokenCreateResponse, error) {
+	if err := c.checkIsApplicationAdmin(actor); err != nil {
+		return nil, err
+	}
+
+	token, plainToken := scimEntities.NewToken(data)
+
+	return token.ToCreateResponse(plainToken), c.scimRepository.CreateToken(token)
+}
+
+// checkIsApplicationAdmin resolves the application admin with the active authentication type, the same way as the
+// authorization of the other services
+func (c *Controller) checkIsApplicationAdmin(actor *authEntities.Actor) error {
+	isApplicationAdmin, err := c.authController.IsAuthorized(actor.ToApplicationAdminAuthorizationData())
+	if err != nil || !isApplicationAdmin {
+		return scimEnums.ErrorNotAllowed
+	}
+
+	return nil
+}
+
+func (c *Controller) ListTokens(actor *authEntities.Actor) ([]*scimEntities.Token, error) {
+	if err := c.checkIsApplicationAdmin(actor); err != nil {
+		return nil, err
+	}
+
+	return c.scimRepository.ListTokens()
+}
+
+func (c *Controller) RevokeToken(tokenID uuid.UUID, actor *authEntities.Actor) error {
+	if err := c.checkIsApplicationAdmin(actor); err != nil {
+		return err
+	}
+
+	return c.scimRepository.RevokeToken(tokenID)
+}
+
+func (c *Controller) Authenticate(token string) error {
+	tokenID, secret, err := scimEntities.ParseToken(token)
+	if err != nil {
+		return err
+	}
+
+	scimToken, err := c.scimRepository.GetToken(tokenID)
+	if err != nil || !scimToken.IsActive() || !scimToken.MatchesSecret(secret) {
+		return scimEnums.ErrorInvalidToken
+	}
+
+	return nil
+}
+
+func (c *Controller) ListUsers(query *scimEntities.ListQuery) (*scimEntities.ListResponse, error) {
+	accounts, total, err := c.scimRepository.ListAccounts(query)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []*scimEntities.UserResource{}
+	for _, account := range accounts {
+		users = append(users, scimEntities.NewUserResource(account))
+	}
+
+	return scimEntities.NewListResponse(users, len(users), total, query), nil
+}
+
+func (c *Controller) GetUser(accountID uuid.UUID) (*scimEntities.UserResource, error) {
+	account, err := c.getAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scimEntities.NewUserResource(account), nil
+}
+
+func (c *Controller) getAccount(accountID uuid.UUID) (*accountEntities.Account, error) {
+	account, err := c.accountRepository.GetAccount(accountID)
+	if err == databaseEnums.ErrorNotFoundRecords || (err == nil && account.AccountID == uuid.Nil) {
+		return nil, scimEnums.ErrorUserNotFound
+	}
+
+	return account, err
+}
+
+func (c *Controller) CreateUser(user *scimEntities.UserResource) (*scimEntities.UserResource, error) {
+	if c.isUserInUse(user, uuid.Nil) {
+		return nil, scimEnums.ErrorUserAlreadyExists
+	}
+
+	account, err := c.accountRepository.CreateAccount(user.ToAccount())
+	if err != nil {
+		return nil, err
+	}
+
+	c.invitationService.BindPendingInvitations(account)
+	return scimEntities.NewUserResource(account), nil
+}
+
+// isUserInUse checks if the user name or the email belongs to an account other than the given one
+func (c *Controller) isUserInUse(user *scimEntities.UserResource, accountID uuid.UUID) bool {
+	account, err := c.accountRepository.GetAccountByEmail(user.GetEmail())
+	if isOtherAccount(account, err, accountID) {
+		return true
+	}
+
+	account, err = c.accountRepository.GetAccountByUsername(user.UserName)
+	return isOtherAccount(account, err, accountID)
+}
+
+func isOtherAccount(account *accountEntities.Account, err error, accountID uuid.UUID) bool {
+	return err == nil && account.AccountID != uuid.Nil && account.AccountID != accountID
+}
+
+func (c *Controller) ReplaceUser(accountID uuid.UUID,
+	user *scimEntities.UserResource) (*scimEntities.UserResource, error) {
+	account, err := c.getAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.updateUser(account, user)
+}
+
+func (c *Controller) PatchUser(accountID uuid.UUID,
+	patch *scimEntities.PatchRequest) (*scimEntities.UserResource, error) {
+	account, err := c.getAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	user := scimEntities.NewUserResource(account)
+	if err := patch.ApplyToUser(user); err != nil {
+		return nil, err
+	}
+
+	if err := user.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", scimEnums.ErrorInvalidValue, err)
+	}
+
+	return c.updateUser(account, user)
+}
+
+func (c *Controller) updateUser(account *accountEntities.Account,
+	user *scimEntities.UserResource) (*scimEntities.UserResource, error) {
+	if c.isUserInUse(user, account.AccountID) {
+		return nil, scimEnums.ErrorUserAlreadyExists
+	}
+
+	wasDisabled := account.IsDisabled
+	if _, err := c.accountRepository.Update(user.UpdateAccount(account)); err != nil {
+		return nil, err
+	}
+
+	if wasDisabled != account.IsDisabled {
+		return scimEntities.NewUserResource(account), c.updateIsDisabled(account)
+	}
+
+	return scimEntities.NewUserResource(account), nil
+}
+
+// updateIsDisabled a deactivated account can't login anymore and its current sessions are ended
+func (c *Controller) updateIsDisabled(account *accountEntities.Account) error {
+	if err := c.accountRepository.UpdateIsDisabled(account.AccountID, account.IsDisabled); err != nil {
+		return err
+	}
+
+	if account.IsDisabled {
+		return c.sessionService.RevokeAllSessions(account.AccountID)
+	}
+
+	return nil
+}
+
+// DeleteUser the sessions, roles and group memberships of the account are removed by the foreign keys
+func (c *Controller) DeleteUser(accountID uuid.UUID) error {
+	if _, err := c.getAccount(accountID); err != nil {
+		return err
+	}
+
+	return c.accountRepository.Delete(accountID)
+}
+
+func (c *Controller) ListGroups(query *scimEntities.ListQuery) (*scimEntities.ListResponse, error) {
+	groups, total, err := c.scimRepository.ListGroups(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := c.newGroupResources(groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return scimEntities.NewListResponse(resources, len(resources), total, query), nil
+}
+
+func (c *Controller) newGroupResources(groups []*scimEntities.Group) ([]*scimEntities.GroupResource, error) {
+	resources := []*scimEntities.GroupResource{}
+
+	for _, group := range groups {
+		resource, err := c.newGroupResource(group)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func (c *Controller) newGroupResource(group *scimEntities.Group) (*scimEntities.GroupResource, error) {
+	members, err := c.scimRepository.ListGroupMembers(group.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := c.scimRepository.ListGroupRoles(group.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scimEntities.NewGroupResource(group, members, roles), nil
+}
+
+func (c *Controller) GetGroup(groupID uuid.UUID) (*scimEntities.GroupResource, error) {
+	group, err := c.getGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newGroupResource(group)
+}
+
+func (c *Controller) getGroup(groupID uuid.UUID) (*scimEntities.Group, error) {
+	group, err := c.scimRepository.GetGroup(groupID)
+	if err == databaseEnums.ErrorNotFoundRecords || (err == nil && group.GroupID == uuid.Nil) {
+		return nil, scimEnums.ErrorGroupNotFound
+	}
+
+	return group, err
+}
+
+func (c *Controller) CreateGroup(resource *scimEntities.GroupResource) (*scimEntities.GroupResource, error) {
+	if c.isGroupInUse(resource.DisplayName, uuid.Nil) {
+		return nil, scimEnums.ErrorGroupAlreadyExists
+	}
+
+	group := resource.ToGroup(uuid.New(), time.Now())
+	if err := c.createGroup(group, resource); err != nil {
+		return nil, err
+	}
+
+	return c.newGroupResource(group)
+}
+
+func (c *Controller) createGroup(group *scimEntities.Group, resource *scimEntities.GroupResource) error {
+	if err := c.scimRepository.CreateGroup(group); err != nil {
+		return err
+	}
+
+	if err := c.scimRepository.ReplaceGroupRoles(group.GroupID, resource.ToGroupRoles(group.GroupID)); err != nil {
+		return err
+	}
+
+	return c.addMembers(group.GroupID, resource.MemberIDs())
+}
+
+func (c *Controller) isGroupInUse(displayName string, groupID uuid.UUID) bool {
+	group, err := c.scimRepository.GetGroupByDisplayName(displayName)
+
+	return err == nil && group.GroupID != uuid.Nil && group.GroupID != groupID
+}
+
+func (c *Controller) ReplaceGroup(groupID uuid.UUID,
+	resource *scimEntities.GroupResource) (*scimEntities.GroupResource, error) {
+	group, err := c.getGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.newGroupResource(group)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.updateGroup(group, current, resource)
+}
+
+func (c *Controller) PatchGroup(groupID uuid.UUID,
+	patch *scimEntities.PatchRequest) (*scimEntities.GroupResource, error) {
+	current, err := c.GetGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *current
+	if err := patch.ApplyToGroup(&updated); err != nil {
+		return nil, err
+	}
+
+	if err := updated.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %s", scimEnums.ErrorInvalidValue, err)
+	}
+
+	return c.updateGroup(&scimEntities.Group{GroupID: groupID, CreatedAt: current.Meta.Created}, current, &updated)
+}
+
+func (c *Controller) updateGroup(group *scimEntities.Group, current,
+	updated *scimEntities.GroupResource) (*scimEntities.GroupResource, error) {
+	if c.isGroupInUse(updated.DisplayName, group.GroupID) {
+		return nil, scimEnums.ErrorGroupAlreadyExists
+	}
+
+	group = updated.ToGroup(group.GroupID, group.CreatedAt)
+	if err := c.scimRepository.UpdateGroup(group); err != nil {
+		return nil, err
+	}
+
+	if err := c.syncGroup(group.GroupID, current, updated); err != nil {
+		return nil, err
+	}
+
+	return c.newGroupResource(group)
+}
+
+// syncGroup when the roles changed they are revoked from the current members and granted again with the new
+// mapping, otherwise only the added and removed members are changed
+func (c *Controller) syncGroup(groupID uuid.UUID, current, updated *scimEntities.GroupResource) error {
+	if !c.haveRolesChanged(groupID, current, updated) {
+		return c.syncMembers(groupID, current.MemberIDs(), updated.MemberIDs())
+	}
+
+	return c.remapGroup(groupID, current, updated)
+}
+
+// haveRolesChanged a group sent without the roles extension keeps its current roles
+func (c *Controller) haveRolesChanged(groupID uuid.UUID, current, updated *scimEntities.GroupResource) bool {
+	return updated.HasRoles() && !reflect.DeepEqual(current.ToGroupRoles(groupID), updated.ToGroupRoles(groupID))
+}
+
+func (c *Controller) remapGroup(groupID uuid.UUID, current, updated *scimEntities.GroupResource) error {
+	if err := c.revokeRoles(groupID, current.MemberIDs()); err != nil {
+		return err
+	}
+
+	if err := c.scimRepository.ReplaceGroupRoles(groupID, updated.ToGroupRoles(groupID)); err != nil {
+		return err
+	}
+
+	if err := c.syncMembers(groupID, current.MemberIDs(), updated.MemberIDs()); err != nil {
+		return err
+	}
+
+	return c.grantRoles(groupID, intersection(current.MemberIDs(), updated.MemberIDs()))
+}
+
+func (c *Controller) syncMembers(groupID uuid.UUID, current, updated []uuid.UUID) error {
+	if err := c.removeMembers(groupID, difference(current, updated)); err != nil {
+		return err
+	}
+
+	return c.addMembers(groupID, difference(updated, current))
+}
+
+func (c *Controller) addMembers(groupID uuid.UUID, accountIDs []uuid.UUID) error {
+	for _, accountID := range accountIDs {
+		if err := c.addMember(groupID, accountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) addMember(groupID, accountID uuid.UUID) error {
+	if _, err := c.getAccount(accountID); err != nil {
+		return c.checkMemberNotFound(err)
+	}
+
+	if err := c.scimRepository.AddGroupMember(scimEntities.NewGroupMember(groupID, accountID)); err != nil {
+		return err
+	}
+
+	return c.scimRepository.GrantGroupRoles(groupID, accountID)
+}
+
+func (c *Controller) checkMemberNotFound(err error) error {
+	if err == scimEnums.ErrorUserNotFound {
+		return scimEnums.ErrorMemberNotFound
+	}
+
+	return err
+}
+
+func (c *Controller) removeMembers(groupID uuid.UUID, accountIDs []uuid.UUID) error {
+	for _, accountID := range accountIDs {
+		if err := c.scimRepository.RevokeGroupRoles(groupID, accountID); err != nil {
+			return err
+		}
+
+		if err := c.scimRepository.RemoveGroupMember(groupID, accountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) grantRoles(groupID uuid.UUID, accountIDs []uuid.UUID) error {
+	for _, accountID := range accountIDs {
+		if err := c.scimRepository.GrantGroupRoles(groupID, accountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Controller) revokeRoles(groupID uuid.UUID, accountIDs []uuid.UUID) error {
+	for _, accountID := range accountIDs {
+		if err := c.scimRepository.RevokeGroupRoles(groupID, accountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteGroup the roles are revoked before the group is deleted, since the revoke depends on its role mapping
+func (c *Controller) DeleteGroup(groupID uuid.UUID) error {
+	group, err := c.GetGroup(groupID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.revokeRoles(groupID, group.MemberIDs()); err != nil {
+		return err
+	}
+
+	return c.scimRepository.DeleteGroup(groupID)
+}
+
+// difference returns the ids of the first list that aren't in the second one
+func difference(first, second []uuid.UUID) []uuid.UUID {
+	var result []uuid.UUID
+
+	for _, id := range first {
+		if !contains(second, id) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+func intersection(first, second []uuid.UUID) []uuid.UUID {
+	return difference(first, difference(first, second))
+}
+
+func contains(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, item := range ids {
+		if item == id {
+			return true
+		}
+	}
+
+	return false
+}
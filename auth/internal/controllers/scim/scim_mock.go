@@ -0,0 +1,96 @@
+package scim
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateToken(_ *scimEntities.TokenData,
+	_ *authEntities.Actor) (*scimEntities.TokenCreateResponse, error) {
+	args := m.MethodCalled("CreateToken")
+	return args.Get(0).(*scimEntities.TokenCreateResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTokens(_ *authEntities.Actor) ([]*scimEntities.Token, error) {
+	args := m.MethodCalled("ListTokens")
+	return args.Get(0).([]*scimEntities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeToken(_ uuid.UUID, _ *authEntities.Actor) error {
+	args := m.MethodCalled("RevokeToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Authenticate(_ string) error {
+	args := m.MethodCalled("Authenticate")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListUsers(_ *scimEntities.ListQuery) (*scimEntities.ListResponse, error) {
+	args := m.MethodCalled("ListUsers")
+	return args.Get(0).(*scimEntities.ListResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetUser(_ uuid.UUID) (*scimEntities.UserResource, error) {
+	args := m.MethodCalled("GetUser")
+	return args.Get(0).(*scimEntities.UserResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CreateUser(_ *scimEntities.UserResource) (*scimEntities.UserResource, error) {
+	args := m.MethodCalled("CreateUser")
+	return args.Get(0).(*scimEntities.UserResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ReplaceUser(_ uuid.UUID, _ *scimEntities.UserResource) (*scimEntities.UserResource, error) {
+	args := m.MethodCalled("ReplaceUser")
+	return args.Get(0).(*scimEntities.UserResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) PatchUser(_ uuid.UUID, _ *scimEntities.PatchRequest) (*scimEntities.UserResource, error) {
+	args := m.MethodCalled("PatchUser")
+	return args.Get(0).(*scimEntities.UserResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DeleteUser(_ uuid.UUID) error {
+	args := m.MethodCalled("DeleteUser")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListGroups(_ *scimEntities.ListQuery) (*scimEntities.ListResponse, error) {
+	args := m.MethodCalled("ListGroups")
+	return args.Get(0).(*scimEntities.ListResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetGroup(_ uuid.UUID) (*scimEntities.GroupResource, error) {
+	args := m.MethodCalled("GetGroup")
+	return args.Get(0).(*scimEntities.GroupResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CreateGroup(_ *scimEntities.GroupResource) (*scimEntities.GroupResource, error) {
+	args := m.MethodCalled("CreateGroup")
+	return args.Get(0).(*scimEntities.GroupResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ReplaceGroup(_ uuid.UUID, _ *scimEntities.GroupResource) (*scimEntities.GroupResource, error) {
+	args := m.MethodCalled("ReplaceGroup")
+	return args.Get(0).(*scimEntities.GroupResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) PatchGroup(_ uuid.UUID, _ *scimEntities.PatchRequest) (*scimEntities.GroupResource, error) {
+	args := m.MethodCalled("PatchGroup")
+	return args.Get(0).(*scimEntities.GroupResource), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DeleteGroup(_ uuid.UUID) error {
+	args := m.MethodCalled("DeleteGroup")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,608 @@
+package scim
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountRoles "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	scimRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/scim"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+func newAccountRepositoryMock(account *accountEntities.Account) *accountRepository.Mock {
+	accountRepositoryMock := &accountRepository.Mock{}
+	accountRepositoryMock.On("GetAccount").Return(account, nil)
+	accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
+	accountRepositoryMock.On("GetAccountByUsername").Return(&accountEntities.Account{}, nil)
+	accountRepositoryMock.On("CreateAccount").Return(account, nil)
+	accountRepositoryMock.On("Update").Return(account, nil)
+	accountRepositoryMock.On("UpdateIsDisabled").Return(nil)
+	accountRepositoryMock.On("Delete").Return(nil)
+
+	return accountRepositoryMock
+}
+
+func newSCIMRepositoryMock(group *scimEntities.Group, members []*scimEntities.GroupMember,
+	roles []*scimEntities.GroupRole) *scimRepository.Mock {
+	scimRepositoryMock := &scimRepository.Mock{}
+	scimRepositoryMock.On("GetGroup").Return(group, nil)
+	scimRepositoryMock.On("GetGroupByDisplayName").Return(&scimEntities.Group{}, nil)
+	scimRepositoryMock.On("CreateGroup").Return(nil)
+	scimRepositoryMock.On("UpdateGroup").Return(nil)
+	scimRepositoryMock.On("DeleteGroup").Return(nil)
+	scimRepositoryMock.On("ListGroupMembers").Return(members, nil)
+	scimRepositoryMock.On("ListGroupRoles").Return(roles, nil)
+	scimRepositoryMock.On("ReplaceGroupRoles").Return(nil)
+	scimRepositoryMock.On("AddGroupMember").Return(nil)
+	scimRepositoryMock.On("RemoveGroupMember").Return(nil)
+	scimRepositoryMock.On("GrantGroupRoles").Return(nil)
+	scimRepositoryMock.On("RevokeGroupRoles").Return(nil)
+
+	return scimRepositoryMock
+}
+
+func newAuthControllerMock(isApplicationAdmin bool, err error) *authController.Mock {
+	authControllerMock := &authController.Mock{}
+	authControllerMock.On("IsAuthorized").Return(isApplicationAdmin, err)
+
+	return authControllerMock
+}
+
+func newActor() *authEntities.Actor {
+	return authEntities.NewActor(uuid.New(), "test")
+}
+
+func newAccount() *accountEntities.Account {
+	return &accountEntities.Account{AccountID: uuid.New(), Email: "test@test.com", Username: "test"}
+}
+
+func newGroup() *scimEntities.Group {
+	return &scimEntities.Group{GroupID: uuid.New(), DisplayName: "test"}
+}
+
+func newPatch(operations ...*scimEntities.PatchOperation) *scimEntities.PatchRequest {
+	return &scimEntities.PatchRequest{Operations: operations}
+}
+
+func TestNewSCIMController(t *testing.T) {
+	t.Run("should success create a new controller", func(t *testing.T) {
+		assert.NotNil(t, NewSCIMController(nil, nil, nil, nil, nil))
+	})
+}
+
+func TestCreateToken(t *testing.T) {
+	t.Run("should success create token when actor is application admin", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("CreateToken").Return(nil)
+
+		controller := NewSCIMController(scimRepositoryMock, nil, newAuthControllerMock(true, nil), nil, nil)
+
+		result, err := controller.CreateToken(&scimEntities.TokenData{Name: "test"}, newActor())
+		assert.NoError(t, err)
+		assert.Contains(t, result.PlainToken, scimEnums.TokenPrefix)
+	})
+
+	t.Run("should return error when actor is not application admin", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+
+		controller := NewSCIMController(scimRepositoryMock, nil, newAuthControllerMock(false, nil), nil, nil)
+
+		_, err := controller.CreateToken(&scimEntities.TokenData{Name: "test"}, newActor())
+		assert.Equal(t, scimEnums.ErrorNotAllowed, err)
+		scimRepositoryMock.AssertNotCalled(t, "CreateToken")
+	})
+
+	t.Run("should return error when failed to check if actor is application admin", func(t *testing.T) {
+		controller := NewSCIMController(&scimRepository.Mock{}, nil, newAuthControllerMock(false, errors.New("test")),
+			nil, nil)
+
+		_, err := controller.CreateToken(&scimEntities.TokenData{Name: "test"}, newActor())
+		assert.Equal(t, scimEnums.ErrorNotAllowed, err)
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	t.Run("should success list tokens when actor is application admin", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("ListTokens").Return([]*scimEntities.Token{{}}, nil)
+
+		controller := NewSCIMController(scimRepositoryMock, nil, newAuthControllerMock(true, nil), nil, nil)
+
+		result, err := controller.ListTokens(newActor())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+
+	t.Run("should return error when actor is not application admin", func(t *testing.T) {
+		controller := NewSCIMController(&scimRepository.Mock{}, nil, newAuthControllerMock(false, nil), nil, nil)
+
+		_, err := controller.ListTokens(newActor())
+		assert.Equal(t, scimEnums.ErrorNotAllowed, err)
+	})
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("should success revoke token when actor is application admin", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("RevokeToken").Return(nil)
+
+		controller := NewSCIMController(scimRepositoryMock, nil, newAuthControllerMock(true, nil), nil, nil)
+
+		assert.NoError(t, controller.RevokeToken(uuid.New(), newActor()))
+	})
+
+	t.Run("should return error when actor is not application admin", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+
+		controller := NewSCIMController(scimRepositoryMock, nil, newAuthControllerMock(false, nil), nil, nil)
+
+		assert.Equal(t, scimEnums.ErrorNotAllowed, controller.RevokeToken(uuid.New(), newActor()))
+		scimRepositoryMock.AssertNotCalled(t, "RevokeToken")
+	})
+}
+
+func TestAuthenticate(t *testing.T) {
+	token, plainToken := scimEntities.NewToken(&scimEntities.TokenData{Name: "test"})
+
+	t.Run("should success authenticate with a valid token", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetToken").Return(token, nil)
+
+		assert.NoError(t, NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).Authenticate("Bearer "+plainToken))
+	})
+
+	t.Run("should return error when token is malformed", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+
+		err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).Authenticate("Bearer test")
+		assert.Equal(t, scimEnums.ErrorInvalidToken, err)
+		scimRepositoryMock.AssertNotCalled(t, "GetToken")
+	})
+
+	t.Run("should return error when secret doesn't match", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetToken").Return(token, nil)
+
+		err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).Authenticate(plainToken + "test")
+		assert.Equal(t, scimEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when token was not found", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetToken").Return(&scimEntities.Token{}, databaseEnums.ErrorNotFoundRecords)
+
+		err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).Authenticate(plainToken)
+		assert.Equal(t, scimEnums.ErrorInvalidToken, err)
+	})
+}
+
+func TestListUsers(t *testing.T) {
+	query, _ := scimEntities.NewListQuery(scimEnums.ResourceTypeUser, "", "", "")
+
+	t.Run("should success list users", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("ListAccounts").Return([]*accountEntities.Account{newAccount()}, 10, nil)
+
+		result, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).ListUsers(query)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, result.TotalResults)
+		assert.Equal(t, 1, result.ItemsPerPage)
+	})
+
+	t.Run("should return error when failed to list accounts", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("ListAccounts").Return([]*accountEntities.Account{}, 0, errors.New("test"))
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).ListUsers(query)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	t.Run("should success get user", func(t *testing.T) {
+		account := newAccount()
+
+		result, err := NewSCIMController(nil, newAccountRepositoryMock(account), nil, nil, nil).GetUser(account.AccountID)
+		assert.NoError(t, err)
+		assert.Equal(t, account.AccountID.String(), result.ID)
+	})
+
+	t.Run("should return user not found when account doesn't exist", func(t *testing.T) {
+		_, err := NewSCIMController(nil, newAccountRepositoryMock(&accountEntities.Account{}), nil, nil, nil).
+			GetUser(uuid.New())
+		assert.Equal(t, scimEnums.ErrorUserNotFound, err)
+	})
+}
+
+func TestCreateUser(t *testing.T) {
+	user := scimEntities.NewUserResource(newAccount())
+
+	t.Run("should success create user", func(t *testing.T) {
+		accountRepositoryMock := newAccountRepositoryMock(newAccount())
+
+		invitationServiceMock := &invitationService.Mock{}
+		invitationServiceMock.On("BindPendingInvitations")
+
+		_, err := NewSCIMController(nil, accountRepositoryMock, nil, nil, invitationServiceMock).CreateUser(user)
+		assert.NoError(t, err)
+		accountRepositoryMock.AssertCalled(t, "CreateAccount")
+		invitationServiceMock.AssertCalled(t, "BindPendingInvitations")
+	})
+
+	t.Run("should return error when email is already in use", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(newAccount(), nil)
+
+		_, err := NewSCIMController(nil, accountRepositoryMock, nil, nil, nil).CreateUser(user)
+		assert.Equal(t, scimEnums.ErrorUserAlreadyExists, err)
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should return error when failed to create account", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("GetAccountByUsername").Return(&accountEntities.Account{}, nil)
+		accountRepositoryMock.On("CreateAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		invitationServiceMock := &invitationService.Mock{}
+
+		_, err := NewSCIMController(nil, accountRepositoryMock, nil, nil, invitationServiceMock).CreateUser(user)
+		assert.Error(t, err)
+		invitationServiceMock.AssertNotCalled(t, "BindPendingInvitations")
+	})
+}
+
+func TestReplaceUser(t *testing.T) {
+	t.Run("should success replace user", func(t *testing.T) {
+		account := newAccount()
+		accountRepositoryMock := newAccountRepositoryMock(account)
+
+		_, err := NewSCIMController(nil, accountRepositoryMock, nil, nil, nil).ReplaceUser(account.AccountID,
+			scimEntities.NewUserResource(account))
+		assert.NoError(t, err)
+		accountRepositoryMock.AssertCalled(t, "Update")
+		accountRepositoryMock.AssertNotCalled(t, "UpdateIsDisabled")
+	})
+
+	t.Run("should return error when user doesn't exist", func(t *testing.T) {
+		_, err := NewSCIMController(nil, newAccountRepositoryMock(&accountEntities.Account{}), nil, nil, nil).
+			ReplaceUser(uuid.New(), &scimEntities.UserResource{})
+		assert.Equal(t, scimEnums.ErrorUserNotFound, err)
+	})
+}
+
+func TestPatchUser(t *testing.T) {
+	deactivate := &scimEntities.PatchOperation{Op: scimEnums.OperationReplace, Path: "active", Value: []byte("false")}
+
+	t.Run("should deactivate user and revoke its sessions", func(t *testing.T) {
+		account := newAccount()
+		accountRepositoryMock := newAccountRepositoryMock(account)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("RevokeAllSessions").Return(nil)
+
+		result, err := NewSCIMController(nil, accountRepositoryMock, nil, sessionServiceMock, nil).
+			PatchUser(account.AccountID, newPatch(deactivate))
+		assert.NoError(t, err)
+		assert.False(t, *result.Active)
+		accountRepositoryMock.AssertCalled(t, "UpdateIsDisabled")
+		sessionServiceMock.AssertCalled(t, "RevokeAllSessions")
+	})
+
+	t.Run("should reactivate user without revoking sessions", func(t *testing.T) {
+		account := newAccount()
+		account.IsDisabled = true
+
+		sessionServiceMock := &sessionService.Mock{}
+
+		result, err := NewSCIMController(nil, newAccountRepositoryMock(account), nil, sessionServiceMock, nil).
+			PatchUser(account.AccountID, newPatch(&scimEntities.PatchOperation{
+				Op: scimEnums.OperationReplace, Value: []byte(`{"active": true}`)}))
+		assert.NoError(t, err)
+		assert.True(t, *result.Active)
+		sessionServiceMock.AssertNotCalled(t, "RevokeAllSessions")
+	})
+
+	t.Run("should return error when patch is invalid", func(t *testing.T) {
+		account := newAccount()
+
+		_, err := NewSCIMController(nil, newAccountRepositoryMock(account), nil, nil, nil).
+			PatchUser(account.AccountID, newPatch(&scimEntities.PatchOperation{
+				Op: scimEnums.OperationReplace, Path: "active", Value: []byte(`"test"`)}))
+		assert.Equal(t, scimEnums.ErrorInvalidValue, err)
+	})
+
+	t.Run("should return invalid value when patched user is invalid", func(t *testing.T) {
+		account := newAccount()
+
+		_, err := NewSCIMController(nil, newAccountRepositoryMock(account), nil, nil, nil).
+			PatchUser(account.AccountID, newPatch(&scimEntities.PatchOperation{
+				Op: scimEnums.OperationReplace, Path: "userName", Value: []byte(`""`)}))
+		assert.True(t, errors.Is(err, scimEnums.ErrorInvalidValue))
+	})
+
+	t.Run("should return error when user name is in use by another account", func(t *testing.T) {
+		account := newAccount()
+		accountRepositoryMock := newAccountRepositoryMock(account)
+		accountRepositoryMock.ExpectedCalls = nil
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+		accountRepositoryMock.On("GetAccountByUsername").Return(newAccount(), nil)
+
+		_, err := NewSCIMController(nil, accountRepositoryMock, nil, nil, nil).PatchUser(account.AccountID,
+			newPatch(deactivate))
+		assert.Equal(t, scimEnums.ErrorUserAlreadyExists, err)
+		accountRepositoryMock.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("should return user not found when account doesn't exist", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := NewSCIMController(nil, accountRepositoryMock, nil, nil, nil).PatchUser(uuid.New(), newPatch(deactivate))
+		assert.Equal(t, scimEnums.ErrorUserNotFound, err)
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Run("should success delete user", func(t *testing.T) {
+		account := newAccount()
+		accountRepositoryMock := newAccountRepositoryMock(account)
+
+		assert.NoError(t, NewSCIMController(nil, accountRepositoryMock, nil, nil, nil).DeleteUser(account.AccountID))
+		accountRepositoryMock.AssertCalled(t, "Delete")
+	})
+
+	t.Run("should return error when user doesn't exist", func(t *testing.T) {
+		accountRepositoryMock := newAccountRepositoryMock(&accountEntities.Account{})
+
+		err := NewSCIMController(nil, accountRepositoryMock, nil, nil, nil).DeleteUser(uuid.New())
+		assert.Equal(t, scimEnums.ErrorUserNotFound, err)
+		accountRepositoryMock.AssertNotCalled(t, "Delete")
+	})
+}
+
+func TestListGroups(t *testing.T) {
+	query, _ := scimEntities.NewListQuery(scimEnums.ResourceTypeGroup, "", "", "")
+
+	t.Run("should success list groups with members and roles", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(nil, []*scimEntities.GroupMember{{AccountID: uuid.New()}},
+			[]*scimEntities.GroupRole{{WorkspaceID: uuid.New(), Role: accountRoles.Admin}})
+		scimRepositoryMock.On("ListGroups").Return([]*scimEntities.Group{newGroup()}, 1, nil)
+
+		result, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).ListGroups(query)
+		assert.NoError(t, err)
+
+		groups := result.Resources.([]*scimEntities.GroupResource)
+		assert.Len(t, groups[0].Members, 1)
+		assert.Len(t, groups[0].Extension.Roles, 1)
+	})
+
+	t.Run("should return error when failed to list members", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("ListGroups").Return([]*scimEntities.Group{newGroup()}, 1, nil)
+		scimRepositoryMock.On("ListGroupMembers").Return([]*scimEntities.GroupMember{}, errors.New("test"))
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).ListGroups(query)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to list groups", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("ListGroups").Return([]*scimEntities.Group{}, 0, errors.New("test"))
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).ListGroups(query)
+		assert.Error(t, err)
+	})
+}
+
+func TestGetGroup(t *testing.T) {
+	t.Run("should success get group", func(t *testing.T) {
+		group := newGroup()
+
+		result, err := NewSCIMController(newSCIMRepositoryMock(group, nil, nil), nil, nil, nil, nil).GetGroup(group.GroupID)
+		assert.NoError(t, err)
+		assert.Equal(t, group.GroupID.String(), result.ID)
+	})
+
+	t.Run("should return group not found when group doesn't exist", func(t *testing.T) {
+		_, err := NewSCIMController(newSCIMRepositoryMock(&scimEntities.Group{}, nil, nil), nil, nil, nil, nil).
+			GetGroup(uuid.New())
+		assert.Equal(t, scimEnums.ErrorGroupNotFound, err)
+	})
+
+	t.Run("should return error when failed to list roles", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetGroup").Return(newGroup(), nil)
+		scimRepositoryMock.On("ListGroupMembers").Return([]*scimEntities.GroupMember{}, nil)
+		scimRepositoryMock.On("ListGroupRoles").Return([]*scimEntities.GroupRole{}, errors.New("test"))
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).GetGroup(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateGroup(t *testing.T) {
+	resource := &scimEntities.GroupResource{
+		DisplayName: "test",
+		Members:     []*scimEntities.MemberResource{{Value: uuid.NewString()}},
+		Extension: &scimEntities.GroupExtension{Roles: []*scimEntities.RoleMapping{
+			{WorkspaceID: uuid.New(), Role: accountRoles.Member}}},
+	}
+
+	t.Run("should success create group, its roles and grant them to the members", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(nil, nil, nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, newAccountRepositoryMock(newAccount()), nil, nil, nil).
+			CreateGroup(resource)
+		assert.NoError(t, err)
+		scimRepositoryMock.AssertCalled(t, "CreateGroup")
+		scimRepositoryMock.AssertCalled(t, "ReplaceGroupRoles")
+		scimRepositoryMock.AssertCalled(t, "AddGroupMember")
+		scimRepositoryMock.AssertCalled(t, "GrantGroupRoles")
+	})
+
+	t.Run("should return error when display name is already in use", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetGroupByDisplayName").Return(newGroup(), nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).CreateGroup(resource)
+		assert.Equal(t, scimEnums.ErrorGroupAlreadyExists, err)
+		scimRepositoryMock.AssertNotCalled(t, "CreateGroup")
+	})
+
+	t.Run("should return error when member is not a provisioned user", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(nil, nil, nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, newAccountRepositoryMock(&accountEntities.Account{}), nil, nil, nil).
+			CreateGroup(resource)
+		assert.Equal(t, scimEnums.ErrorMemberNotFound, err)
+		scimRepositoryMock.AssertNotCalled(t, "AddGroupMember")
+	})
+
+	t.Run("should return error when failed to create group", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetGroupByDisplayName").Return(&scimEntities.Group{}, nil)
+		scimRepositoryMock.On("CreateGroup").Return(errors.New("test"))
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).CreateGroup(resource)
+		assert.Error(t, err)
+		scimRepositoryMock.AssertNotCalled(t, "ReplaceGroupRoles")
+	})
+}
+
+func TestReplaceGroup(t *testing.T) {
+	group := newGroup()
+	currentMember := &scimEntities.GroupMember{AccountID: uuid.New()}
+	currentRoles := []*scimEntities.GroupRole{
+		{GroupID: group.GroupID, WorkspaceID: uuid.New(), Role: accountRoles.Member}}
+
+	t.Run("should only sync the changed members when roles didn't change", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(group, []*scimEntities.GroupMember{currentMember}, nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, newAccountRepositoryMock(newAccount()), nil, nil, nil).
+			ReplaceGroup(group.GroupID, &scimEntities.GroupResource{DisplayName: "test",
+				Members: []*scimEntities.MemberResource{{Value: uuid.NewString()}}})
+		assert.NoError(t, err)
+		scimRepositoryMock.AssertNotCalled(t, "ReplaceGroupRoles")
+		scimRepositoryMock.AssertNumberOfCalls(t, "RevokeGroupRoles", 1)
+		scimRepositoryMock.AssertNumberOfCalls(t, "RemoveGroupMember", 1)
+		scimRepositoryMock.AssertNumberOfCalls(t, "AddGroupMember", 1)
+		scimRepositoryMock.AssertNumberOfCalls(t, "GrantGroupRoles", 1)
+	})
+
+	t.Run("should remap the roles of the kept members when roles changed", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(group, []*scimEntities.GroupMember{currentMember}, currentRoles)
+
+		_, err := NewSCIMController(scimRepositoryMock, newAccountRepositoryMock(newAccount()), nil, nil, nil).
+			ReplaceGroup(group.GroupID, &scimEntities.GroupResource{DisplayName: "test",
+				Members: []*scimEntities.MemberResource{{Value: currentMember.AccountID.String()}},
+				Extension: &scimEntities.GroupExtension{Roles: []*scimEntities.RoleMapping{
+					{WorkspaceID: uuid.New(), Role: accountRoles.Admin}}}})
+		assert.NoError(t, err)
+		scimRepositoryMock.AssertCalled(t, "ReplaceGroupRoles")
+		scimRepositoryMock.AssertNumberOfCalls(t, "RevokeGroupRoles", 1)
+		scimRepositoryMock.AssertNumberOfCalls(t, "GrantGroupRoles", 1)
+		scimRepositoryMock.AssertNotCalled(t, "AddGroupMember")
+	})
+
+	t.Run("should return error when display name is in use by another group", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(group, nil, nil)
+		scimRepositoryMock.ExpectedCalls = nil
+		scimRepositoryMock.On("GetGroup").Return(group, nil)
+		scimRepositoryMock.On("ListGroupMembers").Return([]*scimEntities.GroupMember{}, nil)
+		scimRepositoryMock.On("ListGroupRoles").Return([]*scimEntities.GroupRole{}, nil)
+		scimRepositoryMock.On("GetGroupByDisplayName").Return(newGroup(), nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).ReplaceGroup(group.GroupID,
+			&scimEntities.GroupResource{DisplayName: "test"})
+		assert.Equal(t, scimEnums.ErrorGroupAlreadyExists, err)
+		scimRepositoryMock.AssertNotCalled(t, "UpdateGroup")
+	})
+
+	t.Run("should return error when group doesn't exist", func(t *testing.T) {
+		_, err := NewSCIMController(newSCIMRepositoryMock(&scimEntities.Group{}, nil, nil), nil, nil, nil, nil).
+			ReplaceGroup(uuid.New(), &scimEntities.GroupResource{})
+		assert.Equal(t, scimEnums.ErrorGroupNotFound, err)
+	})
+}
+
+func TestPatchGroup(t *testing.T) {
+	group := newGroup()
+	member := &scimEntities.GroupMember{AccountID: uuid.New()}
+
+	t.Run("should remove member of the patch path", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(group, []*scimEntities.GroupMember{member}, nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).PatchGroup(group.GroupID,
+			newPatch(&scimEntities.PatchOperation{Op: scimEnums.OperationRemove,
+				Path: `members[value eq "` + member.AccountID.String() + `"]`}))
+		assert.NoError(t, err)
+		scimRepositoryMock.AssertCalled(t, "RevokeGroupRoles")
+		scimRepositoryMock.AssertCalled(t, "RemoveGroupMember")
+	})
+
+	t.Run("should return error when patch path is invalid", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(group, nil, nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).PatchGroup(group.GroupID,
+			newPatch(&scimEntities.PatchOperation{Op: scimEnums.OperationRemove, Path: "displayName"}))
+		assert.Equal(t, scimEnums.ErrorInvalidPath, err)
+		scimRepositoryMock.AssertNotCalled(t, "UpdateGroup")
+	})
+
+	t.Run("should return invalid value when patched group is invalid", func(t *testing.T) {
+		scimRepositoryMock := newSCIMRepositoryMock(group, nil, nil)
+
+		_, err := NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).PatchGroup(group.GroupID,
+			newPatch(&scimEntities.PatchOperation{Op: scimEnums.OperationAdd, Path: "members",
+				Value: []byte(`[{"value": "test"}]`)}))
+		assert.True(t, errors.Is(err, scimEnums.ErrorInvalidValue))
+	})
+
+	t.Run("should return error when group doesn't exist", func(t *testing.T) {
+		_, err := NewSCIMController(newSCIMRepositoryMock(&scimEntities.Group{}, nil, nil), nil, nil, nil, nil).
+			PatchGroup(uuid.New(), newPatch())
+		assert.Equal(t, scimEnums.ErrorGroupNotFound, err)
+	})
+}
+
+func TestDeleteGroup(t *testing.T) {
+	t.Run("should revoke the roles of the members and delete group", func(t *testing.T) {
+		group := newGroup()
+		scimRepositoryMock := newSCIMRepositoryMock(group, []*scimEntities.GroupMember{{AccountID: uuid.New()}}, nil)
+
+		assert.NoError(t, NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).DeleteGroup(group.GroupID))
+		scimRepositoryMock.AssertCalled(t, "RevokeGroupRoles")
+		scimRepositoryMock.AssertCalled(t, "DeleteGroup")
+	})
+
+	t.Run("should not delete group when failed to revoke roles", func(t *testing.T) {
+		scimRepositoryMock := &scimRepository.Mock{}
+		scimRepositoryMock.On("GetGroup").Return(newGroup(), nil)
+		scimRepositoryMock.On("ListGroupMembers").Return([]*scimEntities.GroupMember{{AccountID: uuid.New()}}, nil)
+		scimRepositoryMock.On("ListGroupRoles").Return([]*scimEntities.GroupRole{}, nil)
+		scimRepositoryMock.On("RevokeGroupRoles").Return(errors.New("test"))
+
+		assert.Error(t, NewSCIMController(scimRepositoryMock, nil, nil, nil, nil).DeleteGroup(uuid.New()))
+		scimRepositoryMock.AssertNotCalled(t, "DeleteGroup")
+	})
+
+	t.Run("should return error when group doesn't exist", func(t *testing.T) {
+		err := NewSCIMController(newSCIMRepositoryMock(&scimEntities.Group{}, nil, nil), nil, nil, nil, nil).
+			DeleteGroup(uuid.New())
+		assert.Equal(t, scimEnums.ErrorGroupNotFound, err)
+	})
+}
@@ -19,6 +19,11 @@ type Account struct {
 	Username           string    `json:"username"`
 	IsConfirmed        bool      `json:"isConfirmed"`
 	IsApplicationAdmin bool      `json:"isApplicationAdmin"`
+	MFASecret          string    `json:"-"`
+	IsMFAEnabled       bool      `json:"isMfaEnabled"`
+	IsDisabled         bool      `json:"isDisabled"`
+	ExternalID         string    `json:"-"`
+	PasswordChangedAt  time.Time `json:"-"`
 	CreatedAt          time.Time `json:"createdAt"`
 	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -30,6 +35,8 @@ func (a *Account) ToResponse() *Response {
 		Username:           a.Username,
 		IsConfirmed:        a.IsConfirmed,
 		IsApplicationAdmin: a.IsApplicationAdmin,
+		IsMFAEnabled:       a.IsMFAEnabled,
+		IsDisabled:         a.IsDisabled,
 		CreatedAt:          a.CreatedAt,
 		UpdatedAt:          a.UpdatedAt,
 	}
@@ -59,6 +66,27 @@ func (a *Account) ToLoginResponse(accessToken, refreshToken string, expiresAt ti
 	}
 }
 
+func (a *Account) ToMFALoginResponse(mfaToken string, isEnrollment bool) *authEntities.LoginResponse {
+	return &authEntities.LoginResponse{
+		AccountID:       a.AccountID,
+		Username:        a.Username,
+		Email:           a.Email,
+		MFAToken:        mfaToken,
+		IsMFARequired:   true,
+		IsMFAEnrollment: isEnrollment,
+	}
+}
+
+func (a *Account) ToPasswordExpiredLoginResponse(passwordChangeToken string) *authEntities.LoginResponse {
+	return &authEntities.LoginResponse{
+		AccountID:           a.AccountID,
+		Username:            a.Username,
+		Email:               a.Email,
+		PasswordChangeToken: passwordChangeToken,
+		IsPasswordExpired:   true,
+	}
+}
+
 func (a *Account) HashPassword() {
 	hash, _ := crypto.HashPasswordBcrypt(a.Password)
 	a.Password = hash
@@ -69,6 +97,7 @@ func (a *Account) SetNewAccountData() *Account {
 	a.AccountID = uuid.New()
 	a.CreatedAt = time.Now()
 	a.UpdatedAt = time.Now()
+	a.PasswordChangedAt = time.Now()
 
 	return a
 }
@@ -99,6 +128,7 @@ func (a *Account) Update() *Account {
 func (a *Account) SetNewPassword(password string) *Account {
 	a.Password = password
 	a.HashPassword()
+	a.PasswordChangedAt = time.Now()
 
 	return a.Update()
 }
@@ -96,6 +96,41 @@ func TestToLoginResponse(t *testing.T) {
 	})
 }
 
+func TestToMFALoginResponse(t *testing.T) {
+	t.Run("should success parse to mfa login response without tokens", func(t *testing.T) {
+		account := &Account{
+			AccountID: uuid.New(),
+			Email:     "test@test.com",
+			Username:  "test",
+		}
+
+		response := account.ToMFALoginResponse("test", true)
+		assert.Equal(t, "test", response.MFAToken)
+		assert.True(t, response.IsMFARequired)
+		assert.True(t, response.IsMFAEnrollment)
+		assert.Empty(t, response.AccessToken)
+		assert.Empty(t, response.RefreshToken)
+		assert.Equal(t, account.AccountID, response.AccountID)
+	})
+}
+
+func TestToPasswordExpiredLoginResponse(t *testing.T) {
+	t.Run("should success parse to password expired login response without tokens", func(t *testing.T) {
+		account := &Account{
+			AccountID: uuid.New(),
+			Email:     "test@test.com",
+			Username:  "test",
+		}
+
+		response := account.ToPasswordExpiredLoginResponse("test")
+		assert.Equal(t, "test", response.PasswordChangeToken)
+		assert.True(t, response.IsPasswordExpired)
+		assert.Empty(t, response.AccessToken)
+		assert.Empty(t, response.RefreshToken)
+		assert.Equal(t, account.AccountID, response.AccountID)
+	})
+}
+
 func TestHashPassword(t *testing.T) {
 	t.Run("should success parse to account response", func(t *testing.T) {
 		account := &Account{
@@ -172,6 +207,7 @@ func TestSetNewPassword(t *testing.T) {
 		_ = account.SetNewPassword("test")
 		assert.NotEqual(t, expectedTime, account.UpdatedAt)
 		assert.True(t, crypto.CheckPasswordHashBcrypt("test", account.Password))
+		assert.False(t, account.PasswordChangedAt.IsZero())
 	})
 }
 
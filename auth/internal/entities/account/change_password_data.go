@@ -5,18 +5,18 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
-
-	utils "github.com/ZupIT/horusec-devkit/pkg/utils/validation"
 )
 
+// ChangePasswordData keeps the session of the refresh token when it is sent, all the other sessions are revoked
 type ChangePasswordData struct {
-	Password  string    `json:"password"`
-	AccountID uuid.UUID `json:"accountID" swaggerignore:"true"`
+	Password     string    `json:"password"`
+	RefreshToken string    `json:"refreshToken"`
+	AccountID    uuid.UUID `json:"accountID" swaggerignore:"true"`
 }
 
 func (c *ChangePasswordData) Validate() error {
 	return validation.ValidateStruct(c,
-		validation.Field(&c.Password, utils.PasswordValidationRules()...),
+		validation.Field(&c.Password, validation.Required, validation.Length(1, 255)),
 	)
 }
 
@@ -16,10 +16,8 @@ func TestValidateChangePasswordData(t *testing.T) {
 		assert.NoError(t, data.Validate())
 	})
 
-	t.Run("should return error when invalid data", func(t *testing.T) {
-		data := &ChangePasswordData{
-			Password: "test",
-		}
+	t.Run("should return error when empty password", func(t *testing.T) {
+		data := &ChangePasswordData{}
 
 		assert.Error(t, data.Validate())
 	})
@@ -5,8 +5,6 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
-
-	utils "github.com/ZupIT/horusec-devkit/pkg/utils/validation"
 )
 
 type Data struct {
@@ -18,7 +16,7 @@ type Data struct {
 func (u *Data) Validate() error {
 	return validation.ValidateStruct(u,
 		validation.Field(&u.Email, validation.Required, validation.Length(1, 255), is.EmailFormat),
-		validation.Field(&u.Password, utils.PasswordValidationRules()...),
+		validation.Field(&u.Password, validation.Required, validation.Length(1, 255)),
 		validation.Field(&u.Username, validation.Length(1, 255), validation.Required),
 	)
 }
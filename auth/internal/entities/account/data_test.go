@@ -27,10 +27,9 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, data.Validate())
 	})
 
-	t.Run("should return error when invalid data password", func(t *testing.T) {
+	t.Run("should return error when empty password", func(t *testing.T) {
 		data := &Data{
 			Email:    "test@test.com",
-			Password: "test",
 			Username: "test",
 		}
 
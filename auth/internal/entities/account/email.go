@@ -5,10 +5,13 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
 )
 
 type Email struct {
-	Email string `json:"email"`
+	Email     string `json:"email"`
+	IPAddress string `json:"-"`
 }
 
 func (e *Email) Validate() error {
@@ -22,3 +25,9 @@ func (e *Email) ToBytes() []byte {
 
 	return bytes
 }
+
+func (e *Email) SetIPAddress(remoteAddress string) *Email {
+	e.IPAddress = lockoutEntities.NormalizeIPAddress(remoteAddress)
+
+	return e
+}
@@ -33,3 +33,9 @@ func TestToBytesEmail(t *testing.T) {
 		assert.NotEmpty(t, data.ToBytes())
 	})
 }
+
+func TestSetIPAddressEmail(t *testing.T) {
+	t.Run("should set ip address without port", func(t *testing.T) {
+		assert.Equal(t, "127.0.0.1", (&Email{}).SetIPAddress("127.0.0.1:8006").IPAddress)
+	})
+}
@@ -0,0 +1,75 @@
+package account
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+)
+
+// EmailVerification is the pending confirmation of an account email, only the hash of the token secret is stored
+// and it is bound to the email, so a verification sent before an email change can't confirm the new one
+type EmailVerification struct {
+	AccountID  uuid.UUID `json:"accountID"`
+	Email      string    `json:"email"`
+	SecretHash string    `json:"secretHash"`
+}
+
+// NewEmailVerification returns the verification to store and the token to send, the token carries the account id
+// to find the verification and a random secret to validate it
+func NewEmailVerification(account *Account) (verification *EmailVerification, token string) {
+	secret := make([]byte, accountEnums.VerificationTokenSize)
+	_, _ = rand.Read(secret)
+
+	verification = &EmailVerification{
+		AccountID:  account.AccountID,
+		Email:      account.Email,
+		SecretHash: hashVerificationSecret(hex.EncodeToString(secret)),
+	}
+
+	return verification, account.AccountID.String() + accountEnums.VerificationTokenSeparator +
+		hex.EncodeToString(secret)
+}
+
+func ParseVerificationToken(token string) (accountID uuid.UUID, secret string, err error) {
+	parts := strings.SplitN(token, accountEnums.VerificationTokenSeparator, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return uuid.Nil, "", accountEnums.ErrorInvalidVerificationToken
+	}
+
+	accountID, err = uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, "", accountEnums.ErrorInvalidVerificationToken
+	}
+
+	return accountID, parts[1], nil
+}
+
+func ParseEmailVerification(value string) (*EmailVerification, error) {
+	verification := &EmailVerification{}
+
+	return verification, json.Unmarshal([]byte(value), verification)
+}
+
+func (e *EmailVerification) Matches(secret string, account *Account) bool {
+	return e.AccountID == account.AccountID && e.Email == account.Email &&
+		subtle.ConstantTimeCompare([]byte(hashVerificationSecret(secret)), []byte(e.SecretHash)) == 1
+}
+
+func (e *EmailVerification) ToString() string {
+	bytes, _ := json.Marshal(e)
+
+	return string(bytes)
+}
+
+func hashVerificationSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
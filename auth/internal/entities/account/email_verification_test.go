@@ -0,0 +1,67 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+)
+
+func TestNewEmailVerification(t *testing.T) {
+	t.Run("should create verification without storing the token secret", func(t *testing.T) {
+		account := &Account{AccountID: uuid.New(), Email: "test@test.com"}
+
+		verification, token := NewEmailVerification(account)
+		assert.Equal(t, account.AccountID, verification.AccountID)
+		assert.Equal(t, account.Email, verification.Email)
+		assert.NotContains(t, token, verification.SecretHash)
+
+		accountID, secret, err := ParseVerificationToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, account.AccountID, accountID)
+		assert.True(t, verification.Matches(secret, account))
+	})
+}
+
+func TestParseVerificationToken(t *testing.T) {
+	t.Run("should return error when token has no secret", func(t *testing.T) {
+		_, _, err := ParseVerificationToken(uuid.NewString())
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, err)
+	})
+
+	t.Run("should return error when token has invalid account id", func(t *testing.T) {
+		_, _, err := ParseVerificationToken("test.test")
+		assert.Equal(t, accountEnums.ErrorInvalidVerificationToken, err)
+	})
+}
+
+func TestParseEmailVerification(t *testing.T) {
+	t.Run("should parse stored verification", func(t *testing.T) {
+		verification, _ := NewEmailVerification(&Account{AccountID: uuid.New(), Email: "test@test.com"})
+
+		result, err := ParseEmailVerification(verification.ToString())
+		assert.NoError(t, err)
+		assert.Equal(t, verification, result)
+	})
+
+	t.Run("should return error when invalid value", func(t *testing.T) {
+		_, err := ParseEmailVerification("test")
+		assert.Error(t, err)
+	})
+}
+
+func TestMatchesEmailVerification(t *testing.T) {
+	account := &Account{AccountID: uuid.New(), Email: "test@test.com"}
+	verification, token := NewEmailVerification(account)
+	_, secret, _ := ParseVerificationToken(token)
+
+	t.Run("should not match wrong secret", func(t *testing.T) {
+		assert.False(t, verification.Matches("test", account))
+	})
+
+	t.Run("should not match when account email changed", func(t *testing.T) {
+		assert.False(t, verification.Matches(secret, &Account{AccountID: account.AccountID, Email: "new@test.com"}))
+	})
+}
@@ -0,0 +1,25 @@
+package account
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Identity links an account to the subject of an external identity provider, the subject is stable while the
+// email can be changed or reassigned by the provider
+type Identity struct {
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	AccountID uuid.UUID `json:"accountID"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func NewIdentity(accountID uuid.UUID, issuer, subject string) *Identity {
+	return &Identity{
+		Issuer:    issuer,
+		Subject:   subject,
+		AccountID: accountID,
+		CreatedAt: time.Now(),
+	}
+}
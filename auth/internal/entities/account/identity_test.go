@@ -0,0 +1,20 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdentity(t *testing.T) {
+	t.Run("should success create identity", func(t *testing.T) {
+		accountID := uuid.New()
+
+		identity := NewIdentity(accountID, "http://issuer", "subject")
+		assert.Equal(t, accountID, identity.AccountID)
+		assert.Equal(t, "http://issuer", identity.Issuer)
+		assert.Equal(t, "subject", identity.Subject)
+		assert.NotEmpty(t, identity.CreatedAt)
+	})
+}
@@ -5,11 +5,14 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
 )
 
 type ResetCodeData struct {
-	Email string `json:"email"`
-	Code  string `json:"code"`
+	Email     string `json:"email"`
+	Code      string `json:"code"`
+	IPAddress string `json:"-"`
 }
 
 //nolint // valid magic number
@@ -25,3 +28,9 @@ func (r *ResetCodeData) ToBytes() []byte {
 
 	return bytes
 }
+
+func (r *ResetCodeData) SetIPAddress(remoteAddress string) *ResetCodeData {
+	r.IPAddress = lockoutEntities.NormalizeIPAddress(remoteAddress)
+
+	return r
+}
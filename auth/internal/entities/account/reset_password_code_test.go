@@ -45,3 +45,9 @@ func TestToBytesResetCodeData(t *testing.T) {
 		assert.NotEmpty(t, data.ToBytes())
 	})
 }
+
+func TestSetIPAddressResetCodeData(t *testing.T) {
+	t.Run("should set ip address without port", func(t *testing.T) {
+		assert.Equal(t, "127.0.0.1", (&ResetCodeData{}).SetIPAddress("127.0.0.1:8006").IPAddress)
+	})
+}
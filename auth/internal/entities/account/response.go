@@ -12,6 +12,8 @@ type Response struct {
 	Username           string    `json:"username"`
 	IsConfirmed        bool      `json:"isConfirmed"`
 	IsApplicationAdmin bool      `json:"isApplicationAdmin"`
+	IsMFAEnabled       bool      `json:"isMfaEnabled"`
+	IsDisabled         bool      `json:"isDisabled"`
 	CreatedAt          time.Time `json:"createdAt"`
 	UpdatedAt          time.Time `json:"updatedAt"`
 }
@@ -0,0 +1,26 @@
+package account
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// VerifiedEmail is published when the email of an account is verified, core uses it to bind the pending invitations
+// sent to the email
+type VerifiedEmail struct {
+	AccountID uuid.UUID `json:"accountID"`
+	Email     string    `json:"email"`
+}
+
+func NewVerifiedEmail(account *Account) *VerifiedEmail {
+	return &VerifiedEmail{
+		AccountID: account.AccountID,
+		Email:     account.Email,
+	}
+}
+
+func (v *VerifiedEmail) ToBytes() []byte {
+	bytes, _ := json.Marshal(v)
+	return bytes
+}
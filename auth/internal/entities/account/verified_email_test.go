@@ -0,0 +1,24 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVerifiedEmail(t *testing.T) {
+	t.Run("should success create a verified email from the account", func(t *testing.T) {
+		account := &Account{AccountID: uuid.New(), Email: "test@test.com"}
+
+		verifiedEmail := NewVerifiedEmail(account)
+		assert.Equal(t, account.AccountID, verifiedEmail.AccountID)
+		assert.Equal(t, account.Email, verifiedEmail.Email)
+	})
+}
+
+func TestToBytesVerifiedEmail(t *testing.T) {
+	t.Run("should success parse to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, NewVerifiedEmail(&Account{Email: "test@test.com"}).ToBytes())
+	})
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+type AccountsResponse struct {
+	TotalItems int                         `json:"totalItems"`
+	Data       []*accountEntities.Response `json:"data"`
+}
+
+func NewAccountsResponse(accounts []*accountEntities.Account, totalItems int) *AccountsResponse {
+	response := &AccountsResponse{TotalItems: totalItems, Data: []*accountEntities.Response{}}
+
+	for _, account := range accounts {
+		response.Data = append(response.Data, account.ToResponse())
+	}
+
+	return response
+}
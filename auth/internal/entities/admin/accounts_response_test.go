@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+func TestNewAccountsResponse(t *testing.T) {
+	t.Run("should create accounts response", func(t *testing.T) {
+		account := &accountEntities.Account{AccountID: uuid.New(), Password: "test"}
+
+		response := NewAccountsResponse([]*accountEntities.Account{account}, 11)
+		assert.Equal(t, 11, response.TotalItems)
+		assert.Len(t, response.Data, 1)
+		assert.Equal(t, account.AccountID, response.Data[0].AccountID)
+	})
+
+	t.Run("should create empty accounts response", func(t *testing.T) {
+		response := NewAccountsResponse(nil, 0)
+		assert.NotNil(t, response.Data)
+		assert.Empty(t, response.Data)
+	})
+}
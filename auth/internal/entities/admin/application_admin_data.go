@@ -0,0 +1,15 @@
+package admin
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type ApplicationAdminData struct {
+	IsApplicationAdmin *bool `json:"isApplicationAdmin"`
+}
+
+func (a *ApplicationAdminData) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.IsApplicationAdmin, validation.NotNil),
+	)
+}
@@ -0,0 +1,19 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateApplicationAdminData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		isApplicationAdmin := false
+
+		assert.NoError(t, (&ApplicationAdminData{IsApplicationAdmin: &isApplicationAdmin}).Validate())
+	})
+
+	t.Run("should return error when missing application admin flag", func(t *testing.T) {
+		assert.Error(t, (&ApplicationAdminData{}).Validate())
+	})
+}
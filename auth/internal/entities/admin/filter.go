@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/pagination"
+
+	adminEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/admin"
+)
+
+// Filter is the search and the pagination of the accounts list, the page is one based
+type Filter struct {
+	Search string
+	Page   int
+	Size   int
+}
+
+func NewFilter(search, page, size string) (*Filter, error) {
+	filter := &Filter{Search: strings.TrimSpace(search)}
+
+	pageNumber, err := parsePagination(page, adminEnums.DefaultPaginationPage)
+	if err != nil {
+		return nil, err
+	}
+
+	sizeNumber, err := parsePagination(size, adminEnums.DefaultPaginationSize)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Page, filter.Size = pageNumber, sizeNumber
+	return filter, filter.Validate()
+}
+
+func parsePagination(value string, defaultValue int) (int, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, adminEnums.ErrorInvalidPagination
+	}
+
+	return number, nil
+}
+
+func (f *Filter) Validate() error {
+	err := validation.ValidateStruct(f,
+		validation.Field(&f.Page, validation.Required, validation.Min(1)),
+		validation.Field(&f.Size, validation.Required, validation.Min(1), validation.Max(adminEnums.MaxPaginationSize)),
+	)
+	if err != nil {
+		return adminEnums.ErrorInvalidPagination
+	}
+
+	return nil
+}
+
+func (f *Filter) GetSkip() int64 {
+	return pagination.GetSkip(int64(f.Page), int64(f.Size))
+}
+
+func (f *Filter) HasSearch() bool {
+	return f.Search != ""
+}
+
+func (f *Filter) GetSearch() string {
+	return "%" + f.Search + "%"
+}
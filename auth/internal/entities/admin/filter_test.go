@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	adminEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/admin"
+)
+
+func TestNewFilter(t *testing.T) {
+	t.Run("should create filter with default pagination", func(t *testing.T) {
+		filter, err := NewFilter(" test ", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "test", filter.Search)
+		assert.Equal(t, adminEnums.DefaultPaginationPage, filter.Page)
+		assert.Equal(t, adminEnums.DefaultPaginationSize, filter.Size)
+	})
+
+	t.Run("should create filter with pagination", func(t *testing.T) {
+		filter, err := NewFilter("", "3", "20")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, filter.Page)
+		assert.Equal(t, 20, filter.Size)
+	})
+
+	t.Run("should return error when invalid page", func(t *testing.T) {
+		_, err := NewFilter("", "test", "")
+		assert.Equal(t, adminEnums.ErrorInvalidPagination, err)
+	})
+
+	t.Run("should return error when invalid size", func(t *testing.T) {
+		_, err := NewFilter("", "", "test")
+		assert.Equal(t, adminEnums.ErrorInvalidPagination, err)
+	})
+
+	t.Run("should return error when page is zero", func(t *testing.T) {
+		_, err := NewFilter("", "0", "")
+		assert.Equal(t, adminEnums.ErrorInvalidPagination, err)
+	})
+
+	t.Run("should return error when size is greater than the max", func(t *testing.T) {
+		_, err := NewFilter("", "", "101")
+		assert.Equal(t, adminEnums.ErrorInvalidPagination, err)
+	})
+}
+
+func TestGetSkip(t *testing.T) {
+	t.Run("should return the skip of the page", func(t *testing.T) {
+		assert.Equal(t, int64(0), (&Filter{Page: 1, Size: 10}).GetSkip())
+		assert.Equal(t, int64(20), (&Filter{Page: 3, Size: 10}).GetSkip())
+	})
+}
+
+func TestHasSearch(t *testing.T) {
+	t.Run("should return true when there is a search", func(t *testing.T) {
+		assert.True(t, (&Filter{Search: "test"}).HasSearch())
+	})
+
+	t.Run("should return false when there is no search", func(t *testing.T) {
+		assert.False(t, (&Filter{}).HasSearch())
+	})
+}
+
+func TestGetSearch(t *testing.T) {
+	t.Run("should return the search as a like pattern", func(t *testing.T) {
+		assert.Equal(t, "%test%", (&Filter{Search: "test"}).GetSearch())
+	})
+}
@@ -0,0 +1,21 @@
+package admin
+
+import "github.com/google/uuid"
+
+type WorkspaceMembership struct {
+	WorkspaceID uuid.UUID `json:"workspaceID"`
+	Name        string    `json:"name"`
+	Role        string    `json:"role"`
+}
+
+type RepositoryMembership struct {
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	Name         string    `json:"name"`
+	Role         string    `json:"role"`
+}
+
+type Memberships struct {
+	Workspaces   []*WorkspaceMembership  `json:"workspaces"`
+	Repositories []*RepositoryMembership `json:"repositories"`
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	"github.com/ZupIT/horusec-platform/clientip"
+)
+
+// Event has the same json of the core audit events, which stores them, the workspace and repository ids are left out
+// since the actions of auth are not scoped to them
+type Event struct {
+	EventID    uuid.UUID             `json:"eventID"`
+	Service    string                `json:"service"`
+	Action     auditEnums.Action     `json:"action"`
+	ActorID    uuid.UUID             `json:"actorID"`
+	ActorEmail string                `json:"actorEmail"`
+	IP         string                `json:"ip"`
+	TargetType auditEnums.TargetType `json:"targetType"`
+	TargetID   uuid.UUID             `json:"targetID"`
+	Before     json.RawMessage       `json:"before,omitempty"`
+	After      json.RawMessage       `json:"after,omitempty"`
+	OccurredAt time.Time             `json:"occurredAt"`
+}
+
+func NewEvent(action auditEnums.Action, targetType auditEnums.TargetType, targetID uuid.UUID) *Event {
+	return &Event{
+		EventID:    uuid.New(),
+		Service:    auditEnums.ServiceAuth,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// SetActor is not called for the scim provisioning, since it is done by the identity provider and not by an account
+func (e *Event) SetActor(accountID uuid.UUID) *Event {
+	e.ActorID = accountID
+
+	return e
+}
+
+func (e *Event) SetActorEmail(email string) *Event {
+	e.ActorEmail = email
+
+	return e
+}
+
+func (e *Event) HasActor() bool {
+	return e.ActorID != uuid.Nil
+}
+
+// SetChanges keeps the state of the target before and after the action, nil means that the state does not exist,
+// like the before of a creation
+func (e *Event) SetChanges(before, after interface{}) *Event {
+	e.Before = e.toRawMessage(before)
+	e.After = e.toRawMessage(after)
+
+	return e
+}
+
+func (e *Event) toRawMessage(value interface{}) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+
+	bytes, _ := json.Marshal(value)
+	return bytes
+}
+
+// SetIP uses the address of the connection, the forwarded headers are only trusted from the configured proxies
+func (e *Event) SetIP(r *http.Request) *Event {
+	e.IP = clientip.Get(r)
+	return e
+}
+
+func (e *Event) ToBytes() []byte {
+	bytes, _ := json.Marshal(e)
+
+	return bytes
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	"github.com/ZupIT/horusec-platform/clientip"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("should success create a new auth event", func(t *testing.T) {
+		targetID := uuid.New()
+
+		event := NewEvent(auditEnums.ActionAccountDisable, auditEnums.TargetAccount, targetID)
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, auditEnums.ServiceAuth, event.Service)
+		assert.Equal(t, auditEnums.ActionAccountDisable, event.Action)
+		assert.Equal(t, auditEnums.TargetAccount, event.TargetType)
+		assert.Equal(t, targetID, event.TargetID)
+		assert.False(t, event.OccurredAt.IsZero())
+	})
+}
+
+func TestSetActor(t *testing.T) {
+	t.Run("should success set actor id and email", func(t *testing.T) {
+		accountID := uuid.New()
+
+		event := (&Event{}).SetActor(accountID).SetActorEmail("test@test.com")
+		assert.Equal(t, accountID, event.ActorID)
+		assert.Equal(t, "test@test.com", event.ActorEmail)
+	})
+}
+
+func TestHasActor(t *testing.T) {
+	t.Run("should return true when actor was set", func(t *testing.T) {
+		assert.True(t, (&Event{}).SetActor(uuid.New()).HasActor())
+	})
+
+	t.Run("should return false when actor was not set", func(t *testing.T) {
+		assert.False(t, (&Event{}).HasActor())
+	})
+}
+
+func TestSetChanges(t *testing.T) {
+	t.Run("should success set before and after as json", func(t *testing.T) {
+		event := (&Event{}).SetChanges(map[string]bool{"admin": false}, map[string]bool{"admin": true})
+		assert.JSONEq(t, `{"admin": false}`, string(event.Before))
+		assert.JSONEq(t, `{"admin": true}`, string(event.After))
+	})
+
+	t.Run("should keep before empty when nil", func(t *testing.T) {
+		event := (&Event{}).SetChanges(nil, map[string]string{"userName": "test"})
+		assert.Nil(t, event.Before)
+		assert.NotNil(t, event.After)
+	})
+}
+
+func TestSetIP(t *testing.T) {
+	t.Run("should remove the port of the remote address", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r.RemoteAddr = "10.0.0.1:5432"
+
+		assert.Equal(t, "10.0.0.1", (&Event{}).SetIP(r).IP)
+	})
+
+	t.Run("should keep remote address when it has no port", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r.RemoteAddr = "10.0.0.1"
+
+		assert.Equal(t, "10.0.0.1", (&Event{}).SetIP(r).IP)
+	})
+
+	t.Run("should ignore the forwarded header of untrusted connections", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r = r.WithContext(clientip.WithConnectionAddress(r.Context(), "10.0.0.1:5432"))
+		r.RemoteAddr = "192.168.0.1:5432"
+		r.Header.Set(clientip.HeaderForwarded, "192.168.0.1")
+
+		assert.Equal(t, "10.0.0.1", (&Event{}).SetIP(r).IP)
+	})
+}
+
+func TestEventToBytes(t *testing.T) {
+	t.Run("should parse to the json of the core audit events", func(t *testing.T) {
+		event := NewEvent(auditEnums.ActionSCIMUserCreate, auditEnums.TargetAccount, uuid.New())
+
+		parsed := map[string]interface{}{}
+		assert.NoError(t, json.Unmarshal(event.ToBytes(), &parsed))
+		assert.Equal(t, event.EventID.String(), parsed["eventID"])
+		assert.Equal(t, "auth", parsed["service"])
+		assert.Equal(t, "scim.user.create", parsed["action"])
+	})
+}
@@ -0,0 +1,26 @@
+package authentication
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/google/uuid"
+)
+
+// Actor is the account that made a request, the token is kept to authorize it with the active authentication type
+type Actor struct {
+	AccountID uuid.UUID
+	Token     string
+}
+
+func NewActor(accountID uuid.UUID, token string) *Actor {
+	return &Actor{
+		AccountID: accountID,
+		Token:     token,
+	}
+}
+
+func (a *Actor) ToApplicationAdminAuthorizationData() *AuthorizationData {
+	return &AuthorizationData{
+		Token: a.Token,
+		Type:  auth.ApplicationAdmin,
+	}
+}
@@ -0,0 +1,27 @@
+package authentication
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewActor(t *testing.T) {
+	t.Run("should create a new actor", func(t *testing.T) {
+		accountID := uuid.New()
+
+		actor := NewActor(accountID, "test")
+		assert.Equal(t, accountID, actor.AccountID)
+		assert.Equal(t, "test", actor.Token)
+	})
+}
+
+func TestToApplicationAdminAuthorizationData(t *testing.T) {
+	t.Run("should parse to application admin authorization data with the actor token", func(t *testing.T) {
+		data := NewActor(uuid.New(), "test").ToApplicationAdminAuthorizationData()
+		assert.Equal(t, "test", data.Token)
+		assert.Equal(t, auth.ApplicationAdmin, data.Type)
+	})
+}
@@ -3,6 +3,9 @@ package authentication
 import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/google/uuid"
+
+	customRoleEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/customrole"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 )
 
 type AuthorizationData struct {
@@ -22,3 +25,15 @@ func (a *AuthorizationData) SetGroups(authzGroups *AuthzGroups) *AuthorizationDa
 
 	return a
 }
+
+// ReplacePermissionByPreset replaces the permission sent by the route by the built-in role that grants it, the
+// returned permission is empty when the authorization type was already a built-in role
+func (a *AuthorizationData) ReplacePermissionByPreset() permissionEnums.Permission {
+	permission := permissionEnums.Permission(a.Type)
+	if !permission.IsValid() {
+		return ""
+	}
+
+	a.Type = customRoleEnums.ToPreset(permission, a.RepositoryID != uuid.Nil)
+	return permission
+}
@@ -3,7 +3,12 @@ package authentication
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 )
 
 func TestNewHorusecAuthenticationService(t *testing.T) {
@@ -23,3 +28,42 @@ func TestNewHorusecAuthenticationService(t *testing.T) {
 		assert.Equal(t, []string(authzGroups.AuthzMember), data.AuthzMember)
 	})
 }
+
+func TestReplacePermissionByPreset(t *testing.T) {
+	t.Run("should keep the authorization type when it is a built-in role", func(t *testing.T) {
+		data := AuthorizationData{Type: auth.RepositoryAdmin}
+
+		assert.Empty(t, data.ReplacePermissionByPreset())
+		assert.Equal(t, auth.RepositoryAdmin, data.Type)
+	})
+
+	t.Run("should replace by the workspace preset when there is no repository", func(t *testing.T) {
+		data := AuthorizationData{Type: auth.AuthorizationType(permissionEnums.WorkspaceRead)}
+
+		assert.Equal(t, permissionEnums.WorkspaceRead, data.ReplacePermissionByPreset())
+		assert.Equal(t, auth.WorkspaceMember, data.Type)
+	})
+
+	t.Run("should replace by workspace admin when the permission has no workspace preset", func(t *testing.T) {
+		data := AuthorizationData{Type: auth.AuthorizationType(permissionEnums.WebhookManage)}
+
+		assert.Equal(t, permissionEnums.WebhookManage, data.ReplacePermissionByPreset())
+		assert.Equal(t, auth.WorkspaceAdmin, data.Type)
+	})
+
+	t.Run("should replace by the repository preset when there is a repository", func(t *testing.T) {
+		data := AuthorizationData{RepositoryID: uuid.New(),
+			Type: auth.AuthorizationType(permissionEnums.VulnerabilityTypeUpdate)}
+
+		assert.Equal(t, permissionEnums.VulnerabilityTypeUpdate, data.ReplacePermissionByPreset())
+		assert.Equal(t, auth.RepositorySupervisor, data.Type)
+	})
+
+	t.Run("should replace by repository admin when the permission has no repository preset", func(t *testing.T) {
+		data := AuthorizationData{RepositoryID: uuid.New(),
+			Type: auth.AuthorizationType(permissionEnums.RepositoryTokensManage)}
+
+		assert.Equal(t, permissionEnums.RepositoryTokensManage, data.ReplacePermissionByPreset())
+		assert.Equal(t, auth.RepositoryAdmin, data.Type)
+	})
+}
@@ -0,0 +1,21 @@
+package authentication
+
+import (
+	"github.com/lib/pq"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+type CustomRole struct {
+	Permissions pq.StringArray `json:"permissions" gorm:"type:text[]"`
+}
+
+func (c *CustomRole) HasPermission(permission permissionEnums.Permission) bool {
+	for _, value := range c.Permissions {
+		if value == permission.ToString() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,23 @@
+package authentication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func TestHasPermission(t *testing.T) {
+	t.Run("should return true when the custom role has the permission", func(t *testing.T) {
+		customRole := &CustomRole{Permissions: []string{permissionEnums.VulnerabilityTypeUpdate.ToString()}}
+
+		assert.True(t, customRole.HasPermission(permissionEnums.VulnerabilityTypeUpdate))
+	})
+
+	t.Run("should return false when the custom role does not have the permission", func(t *testing.T) {
+		customRole := &CustomRole{Permissions: []string{permissionEnums.VulnerabilityTypeUpdate.ToString()}}
+
+		assert.False(t, customRole.HasPermission(permissionEnums.VulnerabilitySeverityUpdate))
+	})
+}
@@ -7,11 +7,14 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
 )
 
 type LoginCredentials struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+	sessionEntities.Device
 }
 
 func (l *LoginCredentials) Validate() error {
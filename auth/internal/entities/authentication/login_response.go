@@ -1,19 +1,38 @@
 package authentication
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type LoginResponse struct {
-	AccountID          uuid.UUID `json:"accountID"`
-	AccessToken        string    `json:"accessToken"`
-	RefreshToken       string    `json:"refreshToken"`
-	Username           string    `json:"username"`
-	Email              string    `json:"email"`
-	ExpiresAt          time.Time `json:"expiresAt"`
-	ExpiresIn          int       `json:"expiresIn"`
-	RefreshExpiresIn   int       `json:"refreshExpiresIn"`
-	IsApplicationAdmin bool      `json:"isApplicationAdmin"`
+	AccountID           uuid.UUID `json:"accountID"`
+	AccessToken         string    `json:"accessToken"`
+	RefreshToken        string    `json:"refreshToken"`
+	Username            string    `json:"username"`
+	Email               string    `json:"email"`
+	ExpiresAt           time.Time `json:"expiresAt"`
+	ExpiresIn           int       `json:"expiresIn"`
+	RefreshExpiresIn    int       `json:"refreshExpiresIn"`
+	IsApplicationAdmin  bool      `json:"isApplicationAdmin"`
+	MFAToken            string    `json:"mfaToken,omitempty"`
+	IsMFARequired       bool      `json:"isMfaRequired,omitempty"`
+	IsMFAEnrollment     bool      `json:"isMfaEnrollment,omitempty"`
+	RecoveryCodes       []string  `json:"recoveryCodes,omitempty"`
+	PasswordChangeToken string    `json:"passwordChangeToken,omitempty"`
+	IsPasswordExpired   bool      `json:"isPasswordExpired,omitempty"`
+}
+
+func ParseLoginResponse(value string) (*LoginResponse, error) {
+	loginResponse := &LoginResponse{}
+
+	return loginResponse, json.Unmarshal([]byte(value), loginResponse)
+}
+
+func (l *LoginResponse) ToString() string {
+	bytes, _ := json.Marshal(l)
+
+	return string(bytes)
 }
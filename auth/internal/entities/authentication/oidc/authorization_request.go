@@ -0,0 +1,54 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type AuthorizationRequest struct {
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+}
+
+type AuthorizationURLResponse struct {
+	AuthorizationURL string `json:"authorizationURL"`
+	State            string `json:"state"`
+}
+
+func NewAuthorizationRequest() *AuthorizationRequest {
+	return &AuthorizationRequest{
+		State:        uuid.NewString(),
+		Nonce:        uuid.NewString(),
+		CodeVerifier: strings.ReplaceAll(uuid.NewString()+uuid.NewString(), "-", ""),
+	}
+}
+
+func ParseAuthorizationRequest(value string) (*AuthorizationRequest, error) {
+	request := &AuthorizationRequest{}
+
+	return request, json.Unmarshal([]byte(value), request)
+}
+
+func (a *AuthorizationRequest) ToString() string {
+	bytes, _ := json.Marshal(a)
+
+	return string(bytes)
+}
+
+func (a *AuthorizationRequest) GetCodeChallenge() string {
+	hash := sha256.Sum256([]byte(a.CodeVerifier))
+
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func (a *AuthorizationRequest) ToAuthorizationURLResponse(authorizationURL string) *AuthorizationURLResponse {
+	return &AuthorizationURLResponse{
+		AuthorizationURL: authorizationURL,
+		State:            a.State,
+	}
+}
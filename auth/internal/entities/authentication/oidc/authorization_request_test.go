@@ -0,0 +1,54 @@
+package oidc
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthorizationRequest(t *testing.T) {
+	t.Run("should create a request with unique state, nonce and verifier", func(t *testing.T) {
+		request := NewAuthorizationRequest()
+
+		assert.NotEmpty(t, request.State)
+		assert.NotEmpty(t, request.Nonce)
+		assert.Len(t, request.CodeVerifier, 64)
+		assert.NotEqual(t, request.State, NewAuthorizationRequest().State)
+	})
+}
+
+func TestParseAuthorizationRequest(t *testing.T) {
+	t.Run("should success parse the request saved as string", func(t *testing.T) {
+		request := NewAuthorizationRequest()
+
+		result, err := ParseAuthorizationRequest(request.ToString())
+		assert.NoError(t, err)
+		assert.Equal(t, request, result)
+	})
+
+	t.Run("should return error when invalid value", func(t *testing.T) {
+		_, err := ParseAuthorizationRequest("test")
+		assert.Error(t, err)
+	})
+}
+
+func TestGetCodeChallenge(t *testing.T) {
+	t.Run("should return the base64 url encoded sha256 of the verifier", func(t *testing.T) {
+		request := &AuthorizationRequest{CodeVerifier: "test"}
+		hash := sha256.Sum256([]byte("test"))
+
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(hash[:]), request.GetCodeChallenge())
+	})
+}
+
+func TestToAuthorizationURLResponse(t *testing.T) {
+	t.Run("should success parse to authorization url response", func(t *testing.T) {
+		request := NewAuthorizationRequest()
+
+		response := request.ToAuthorizationURLResponse("http://test")
+		assert.Equal(t, "http://test", response.AuthorizationURL)
+		assert.Equal(t, request.State, response.State)
+	})
+}
@@ -0,0 +1,20 @@
+package oidc
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+)
+
+type CallbackData struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+	sessionEntities.Device
+}
+
+func (c *CallbackData) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Code, validation.Required),
+		validation.Field(&c.State, validation.Required),
+	)
+}
@@ -0,0 +1,18 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCallbackData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		assert.NoError(t, (&CallbackData{Code: "test", State: "test"}).Validate())
+	})
+
+	t.Run("should return error when missing code or state", func(t *testing.T) {
+		assert.Error(t, (&CallbackData{State: "test"}).Validate())
+		assert.Error(t, (&CallbackData{Code: "test"}).Validate())
+	})
+}
@@ -0,0 +1,21 @@
+package oidc
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+)
+
+type ClaimsMapping struct {
+	Username string
+	Email    string
+	Groups   string
+}
+
+func NewClaimsMapping() *ClaimsMapping {
+	return &ClaimsMapping{
+		Username: env.GetEnvOrDefault(oidcEnums.EnvOIDCUsernameClaim, oidcEnums.DefaultUsernameClaim),
+		Email:    env.GetEnvOrDefault(oidcEnums.EnvOIDCEmailClaim, oidcEnums.DefaultEmailClaim),
+		Groups:   env.GetEnvOrDefault(oidcEnums.EnvOIDCGroupsClaim, oidcEnums.DefaultGroupsClaim),
+	}
+}
@@ -0,0 +1,47 @@
+package oidc
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+)
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	KeyID    string `json:"kid"`
+	KeyType  string `json:"kty"`
+	Modulus  string `json:"n"`
+	Exponent string `json:"e"`
+}
+
+func (j *JWKS) GetKey(keyID string) *JWK {
+	for index := range j.Keys {
+		if j.Keys[index].KeyID == keyID && j.Keys[index].KeyType == oidcEnums.KeyTypeRSA {
+			return &j.Keys[index]
+		}
+	}
+
+	return nil
+}
+
+func (j *JWK) ToRSAPublicKey() (*rsa.PublicKey, error) {
+	modulus, err := base64.RawURLEncoding.DecodeString(j.Modulus)
+	if err != nil {
+		return nil, err
+	}
+
+	exponent, err := base64.RawURLEncoding.DecodeString(j.Exponent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(modulus),
+		E: int(new(big.Int).SetBytes(exponent).Int64()),
+	}, nil
+}
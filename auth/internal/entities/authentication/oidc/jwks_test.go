@@ -0,0 +1,38 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetKey(t *testing.T) {
+	jwks := &JWKS{Keys: []JWK{{KeyID: "rsa", KeyType: "RSA"}, {KeyID: "ec", KeyType: "EC"}}}
+
+	t.Run("should return rsa key by id", func(t *testing.T) {
+		assert.Equal(t, "rsa", jwks.GetKey("rsa").KeyID)
+	})
+
+	t.Run("should return nil when key not found or not rsa", func(t *testing.T) {
+		assert.Nil(t, jwks.GetKey("test"))
+		assert.Nil(t, jwks.GetKey("ec"))
+	})
+}
+
+func TestToRSAPublicKey(t *testing.T) {
+	t.Run("should success parse to rsa public key", func(t *testing.T) {
+		key, err := (&JWK{Modulus: "AQAB", Exponent: "AQAB"}).ToRSAPublicKey()
+		assert.NoError(t, err)
+		assert.Equal(t, 65537, key.E)
+	})
+
+	t.Run("should return error when invalid modulus", func(t *testing.T) {
+		_, err := (&JWK{Modulus: "!", Exponent: "AQAB"}).ToRSAPublicKey()
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid exponent", func(t *testing.T) {
+		_, err := (&JWK{Modulus: "AQAB", Exponent: "!"}).ToRSAPublicKey()
+		assert.Error(t, err)
+	})
+}
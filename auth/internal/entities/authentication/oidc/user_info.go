@@ -0,0 +1,100 @@
+package oidc
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+)
+
+type UserInfo struct {
+	Issuer        string
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified bool
+	Groups        []string
+}
+
+func NewUserInfoFromClaims(claims map[string]interface{}, claimsMapping *ClaimsMapping) *UserInfo {
+	userInfo := &UserInfo{
+		Issuer:        getStringClaim(claims, oidcEnums.ClaimIssuer),
+		Subject:       getStringClaim(claims, oidcEnums.ClaimSubject),
+		Username:      getStringClaim(claims, claimsMapping.Username),
+		Email:         getStringClaim(claims, claimsMapping.Email),
+		EmailVerified: getBoolClaim(claims, oidcEnums.ClaimEmailVerified),
+		Groups:        getStringSliceClaim(claims, claimsMapping.Groups),
+	}
+
+	if userInfo.Username == "" {
+		userInfo.Username = userInfo.Email
+	}
+
+	return userInfo
+}
+
+// Validate requires the email to be verified by the issuer, otherwise anyone able to set an unverified email in the
+// identity provider could log in as the horusec account with that email
+func (u *UserInfo) Validate() error {
+	if u.Subject == "" || u.Email == "" {
+		return oidcEnums.ErrorOIDCMissingSubjectOrEmail
+	}
+
+	if !u.EmailVerified {
+		return oidcEnums.ErrorOIDCEmailNotVerified
+	}
+
+	return nil
+}
+
+func (u *UserInfo) ToIdentity(accountID uuid.UUID) *accountEntities.Identity {
+	return accountEntities.NewIdentity(accountID, u.Issuer, u.Subject)
+}
+
+func (u *UserInfo) ToAccount() *accountEntities.Account {
+	account := &accountEntities.Account{
+		Username: u.Username,
+		Email:    u.Email,
+		Password: uuid.NewString(),
+	}
+
+	return account.SetNewAccountData().SetIsConfirmedTrue()
+}
+
+func getStringClaim(claims map[string]interface{}, name string) string {
+	value, ok := claims[name].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// getBoolClaim accepts the boolean claim also sent as a string, as some identity providers do
+func getBoolClaim(claims map[string]interface{}, name string) bool {
+	switch claim := claims[name].(type) {
+	case bool:
+		return claim
+	case string:
+		return claim == "true"
+	}
+
+	return false
+}
+
+// getStringSliceClaim accepts the groups claim sent as a json array or as a single string, as both are used by
+// the identity providers
+func getStringSliceClaim(claims map[string]interface{}, name string) (values []string) {
+	switch claim := claims[name].(type) {
+	case string:
+		return []string{claim}
+	case []interface{}:
+		for _, value := range claim {
+			values = append(values, fmt.Sprint(value))
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,77 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+)
+
+func TestNewUserInfoFromClaims(t *testing.T) {
+	claimsMapping := &ClaimsMapping{Username: "preferred_username", Email: "email", Groups: "groups"}
+
+	t.Run("should map claims to user info", func(t *testing.T) {
+		userInfo := NewUserInfoFromClaims(map[string]interface{}{"iss": "http://issuer", "sub": "id",
+			"preferred_username": "test", "email": "test@test.com", "email_verified": true,
+			"groups": []interface{}{"admin", "member"}}, claimsMapping)
+
+		assert.Equal(t, "http://issuer", userInfo.Issuer)
+		assert.Equal(t, "id", userInfo.Subject)
+		assert.True(t, userInfo.EmailVerified)
+		assert.Equal(t, "test", userInfo.Username)
+		assert.Equal(t, "test@test.com", userInfo.Email)
+		assert.Equal(t, []string{"admin", "member"}, userInfo.Groups)
+	})
+
+	t.Run("should use email as username and accept single group", func(t *testing.T) {
+		userInfo := NewUserInfoFromClaims(map[string]interface{}{"email": "test@test.com", "groups": "admin"},
+			claimsMapping)
+
+		assert.Equal(t, "test@test.com", userInfo.Username)
+		assert.Equal(t, []string{"admin"}, userInfo.Groups)
+		assert.False(t, userInfo.EmailVerified)
+	})
+
+	t.Run("should accept email verified sent as string", func(t *testing.T) {
+		assert.True(t, NewUserInfoFromClaims(map[string]interface{}{"email_verified": "true"},
+			claimsMapping).EmailVerified)
+		assert.False(t, NewUserInfoFromClaims(map[string]interface{}{"email_verified": "false"},
+			claimsMapping).EmailVerified)
+	})
+}
+
+func TestValidateUserInfo(t *testing.T) {
+	t.Run("should return error when missing subject or email", func(t *testing.T) {
+		assert.Error(t, (&UserInfo{Email: "test@test.com", EmailVerified: true}).Validate())
+		assert.Error(t, (&UserInfo{Subject: "id", EmailVerified: true}).Validate())
+		assert.NoError(t, (&UserInfo{Subject: "id", Email: "test@test.com", EmailVerified: true}).Validate())
+	})
+
+	t.Run("should return error when email not verified", func(t *testing.T) {
+		assert.Equal(t, oidcEnums.ErrorOIDCEmailNotVerified,
+			(&UserInfo{Subject: "id", Email: "test@test.com"}).Validate())
+	})
+}
+
+func TestToIdentity(t *testing.T) {
+	t.Run("should parse to identity of the account", func(t *testing.T) {
+		accountID := uuid.New()
+
+		identity := (&UserInfo{Issuer: "http://issuer", Subject: "id"}).ToIdentity(accountID)
+		assert.Equal(t, accountID, identity.AccountID)
+		assert.Equal(t, "http://issuer", identity.Issuer)
+		assert.Equal(t, "id", identity.Subject)
+	})
+}
+
+func TestToAccount(t *testing.T) {
+	t.Run("should parse to a confirmed account", func(t *testing.T) {
+		account := (&UserInfo{Username: "test", Email: "test@test.com"}).ToAccount()
+
+		assert.Equal(t, "test", account.Username)
+		assert.True(t, account.IsConfirmed)
+		assert.NotEmpty(t, account.Password)
+	})
+}
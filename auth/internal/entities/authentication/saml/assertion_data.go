@@ -0,0 +1,20 @@
+package saml
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+)
+
+type AssertionData struct {
+	SAMLResponse string
+	RelayState   string
+	sessionEntities.Device
+}
+
+func (a *AssertionData) Validate() error {
+	return validation.ValidateStruct(a,
+		validation.Field(&a.SAMLResponse, validation.Required),
+		validation.Field(&a.RelayState, validation.Required),
+	)
+}
@@ -0,0 +1,21 @@
+package saml
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+)
+
+type AttributesMapping struct {
+	Username string
+	Email    string
+	Groups   string
+}
+
+func NewAttributesMapping() *AttributesMapping {
+	return &AttributesMapping{
+		Username: env.GetEnvOrDefault(samlEnums.EnvSAMLUsernameAttribute, samlEnums.DefaultUsernameAttribute),
+		Email:    env.GetEnvOrDefault(samlEnums.EnvSAMLEmailAttribute, samlEnums.DefaultEmailAttribute),
+		Groups:   env.GetEnvOrDefault(samlEnums.EnvSAMLGroupsAttribute, samlEnums.DefaultGroupsAttribute),
+	}
+}
@@ -0,0 +1,48 @@
+package saml
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+type AuthenticationRequest struct {
+	RelayState string `json:"relayState"`
+	RequestID  string `json:"requestID"`
+}
+
+type LoginURLResponse struct {
+	LoginURL   string `json:"loginURL"`
+	RelayState string `json:"relayState"`
+}
+
+func NewAuthenticationRequest() *AuthenticationRequest {
+	return &AuthenticationRequest{
+		RelayState: uuid.NewString(),
+	}
+}
+
+func ParseAuthenticationRequest(value string) (*AuthenticationRequest, error) {
+	request := &AuthenticationRequest{}
+
+	return request, json.Unmarshal([]byte(value), request)
+}
+
+func (a *AuthenticationRequest) ToString() string {
+	bytes, _ := json.Marshal(a)
+
+	return string(bytes)
+}
+
+func (a *AuthenticationRequest) SetRequestID(requestID string) *AuthenticationRequest {
+	a.RequestID = requestID
+
+	return a
+}
+
+func (a *AuthenticationRequest) ToLoginURLResponse(loginURL string) *LoginURLResponse {
+	return &LoginURLResponse{
+		LoginURL:   loginURL,
+		RelayState: a.RelayState,
+	}
+}
@@ -0,0 +1,39 @@
+package saml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuthenticationRequest(t *testing.T) {
+	t.Run("should create a request with random relay state", func(t *testing.T) {
+		assert.NotEqual(t, NewAuthenticationRequest().RelayState, NewAuthenticationRequest().RelayState)
+	})
+}
+
+func TestParseAuthenticationRequest(t *testing.T) {
+	t.Run("should success parse the request saved as string", func(t *testing.T) {
+		request := NewAuthenticationRequest().SetRequestID("id")
+
+		result, err := ParseAuthenticationRequest(request.ToString())
+		assert.NoError(t, err)
+		assert.Equal(t, request, result)
+	})
+
+	t.Run("should return error when invalid value", func(t *testing.T) {
+		_, err := ParseAuthenticationRequest("test")
+		assert.Error(t, err)
+	})
+}
+
+func TestToLoginURLResponse(t *testing.T) {
+	t.Run("should parse to login url response", func(t *testing.T) {
+		request := NewAuthenticationRequest().SetRequestID("id")
+
+		response := request.ToLoginURLResponse("http://idp/sso")
+		assert.Equal(t, "id", request.RequestID)
+		assert.Equal(t, "http://idp/sso", response.LoginURL)
+		assert.Equal(t, request.RelayState, response.RelayState)
+	})
+}
@@ -0,0 +1,15 @@
+package saml
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type LoginCodeData struct {
+	Code string `json:"code"`
+}
+
+func (l *LoginCodeData) Validate() error {
+	return validation.ValidateStruct(l,
+		validation.Field(&l.Code, validation.Required),
+	)
+}
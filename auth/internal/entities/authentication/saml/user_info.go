@@ -0,0 +1,88 @@
+package saml
+
+import (
+	"github.com/crewjam/saml"
+	"github.com/google/uuid"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+)
+
+type UserInfo struct {
+	NameID   string
+	Username string
+	Email    string
+	Groups   []string
+}
+
+func NewUserInfoFromAssertion(assertion *saml.Assertion, attributesMapping *AttributesMapping) *UserInfo {
+	userInfo := &UserInfo{
+		NameID:   getNameID(assertion),
+		Username: getFirstAttributeValue(assertion, attributesMapping.Username),
+		Email:    getFirstAttributeValue(assertion, attributesMapping.Email),
+		Groups:   getAttributeValues(assertion, attributesMapping.Groups),
+	}
+
+	if userInfo.Username == "" {
+		userInfo.Username = userInfo.Email
+	}
+
+	return userInfo
+}
+
+func (u *UserInfo) Validate() error {
+	if u.NameID == "" || u.Email == "" {
+		return samlEnums.ErrorSAMLMissingNameIDOrEmail
+	}
+
+	return nil
+}
+
+func (u *UserInfo) ToAccount() *accountEntities.Account {
+	account := &accountEntities.Account{
+		Username: u.Username,
+		Email:    u.Email,
+		Password: uuid.NewString(),
+	}
+
+	return account.SetNewAccountData().SetIsConfirmedTrue()
+}
+
+func getNameID(assertion *saml.Assertion) string {
+	if assertion.Subject == nil || assertion.Subject.NameID == nil {
+		return ""
+	}
+
+	return assertion.Subject.NameID.Value
+}
+
+func getFirstAttributeValue(assertion *saml.Assertion, name string) string {
+	values := getAttributeValues(assertion, name)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// getAttributeValues matches the attribute by name or friendly name, since identity providers usually send the
+// attributes named by uri and only the friendly name is readable
+func getAttributeValues(assertion *saml.Assertion, name string) (values []string) {
+	for _, statement := range assertion.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if attribute.Name == name || attribute.FriendlyName == name {
+				values = append(values, toStringValues(attribute.Values)...)
+			}
+		}
+	}
+
+	return values
+}
+
+func toStringValues(attributeValues []saml.AttributeValue) (values []string) {
+	for _, attributeValue := range attributeValues {
+		values = append(values, attributeValue.Value)
+	}
+
+	return values
+}
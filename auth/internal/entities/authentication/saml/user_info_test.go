@@ -0,0 +1,59 @@
+package saml
+
+import (
+	"testing"
+
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserInfoFromAssertion(t *testing.T) {
+	attributesMapping := &AttributesMapping{Username: "username", Email: "email", Groups: "groups"}
+
+	t.Run("should map assertion attributes to user info", func(t *testing.T) {
+		assertion := &saml.Assertion{
+			Subject: &saml.Subject{NameID: &saml.NameID{Value: "id"}},
+			AttributeStatements: []saml.AttributeStatement{{Attributes: []saml.Attribute{
+				{Name: "username", Values: []saml.AttributeValue{{Value: "test"}}},
+				{Name: "urn:oid:0.9.2342.19200300.100.1.3", FriendlyName: "email",
+					Values: []saml.AttributeValue{{Value: "test@test.com"}}},
+				{Name: "groups", Values: []saml.AttributeValue{{Value: "admin"}, {Value: "member"}}},
+			}}},
+		}
+
+		userInfo := NewUserInfoFromAssertion(assertion, attributesMapping)
+		assert.Equal(t, "id", userInfo.NameID)
+		assert.Equal(t, "test", userInfo.Username)
+		assert.Equal(t, "test@test.com", userInfo.Email)
+		assert.Equal(t, []string{"admin", "member"}, userInfo.Groups)
+	})
+
+	t.Run("should use email as username when username attribute is missing", func(t *testing.T) {
+		assertion := &saml.Assertion{AttributeStatements: []saml.AttributeStatement{{Attributes: []saml.Attribute{
+			{Name: "email", Values: []saml.AttributeValue{{Value: "test@test.com"}}},
+		}}}}
+
+		userInfo := NewUserInfoFromAssertion(assertion, attributesMapping)
+		assert.Empty(t, userInfo.NameID)
+		assert.Equal(t, "test@test.com", userInfo.Username)
+		assert.Empty(t, userInfo.Groups)
+	})
+}
+
+func TestValidateUserInfo(t *testing.T) {
+	t.Run("should return error when missing name id or email", func(t *testing.T) {
+		assert.Error(t, (&UserInfo{Email: "test@test.com"}).Validate())
+		assert.Error(t, (&UserInfo{NameID: "id"}).Validate())
+		assert.NoError(t, (&UserInfo{NameID: "id", Email: "test@test.com"}).Validate())
+	})
+}
+
+func TestToAccount(t *testing.T) {
+	t.Run("should parse to a confirmed account", func(t *testing.T) {
+		account := (&UserInfo{Username: "test", Email: "test@test.com"}).ToAccount()
+
+		assert.Equal(t, "test", account.Username)
+		assert.True(t, account.IsConfirmed)
+		assert.NotEmpty(t, account.Password)
+	})
+}
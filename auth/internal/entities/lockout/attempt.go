@@ -0,0 +1,40 @@
+package lockout
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+)
+
+// NormalizeIdentifier makes the email or username used to login case insensitive, so the attempts can't be
+// spread across different spellings of the same account
+func NormalizeIdentifier(identifier string) string {
+	return strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// NormalizeIPAddress removes the port from the remote address, the router already replaces it with the forwarded
+// address when running behind a proxy
+func NormalizeIPAddress(remoteAddress string) string {
+	host, _, err := net.SplitHostPort(remoteAddress)
+	if err != nil {
+		return remoteAddress
+	}
+
+	return host
+}
+
+// GetRetryDelay doubles the time to wait before the next attempt for each failure after the free ones
+func GetRetryDelay(failures int) time.Duration {
+	if failures <= lockoutEnums.FreeFailedAttempts {
+		return 0
+	}
+
+	delay := lockoutEnums.BaseDelay << uint(failures-lockoutEnums.FreeFailedAttempts-1)
+	if delay > lockoutEnums.MaxDelay || delay <= 0 {
+		return lockoutEnums.MaxDelay
+	}
+
+	return delay
+}
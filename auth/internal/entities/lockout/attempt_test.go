@@ -0,0 +1,43 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+)
+
+func TestNormalizeIdentifier(t *testing.T) {
+	t.Run("should remove case and spaces", func(t *testing.T) {
+		assert.Equal(t, "test@test.com", NormalizeIdentifier(" Test@Test.com "))
+	})
+}
+
+func TestNormalizeIPAddress(t *testing.T) {
+	t.Run("should remove port from remote address", func(t *testing.T) {
+		assert.Equal(t, "127.0.0.1", NormalizeIPAddress("127.0.0.1:8006"))
+		assert.Equal(t, "::1", NormalizeIPAddress("[::1]:8006"))
+	})
+
+	t.Run("should keep address without port", func(t *testing.T) {
+		assert.Equal(t, "127.0.0.1", NormalizeIPAddress("127.0.0.1"))
+	})
+}
+
+func TestGetRetryDelay(t *testing.T) {
+	t.Run("should not delay free attempts", func(t *testing.T) {
+		assert.Equal(t, time.Duration(0), GetRetryDelay(lockoutEnums.FreeFailedAttempts))
+	})
+
+	t.Run("should double delay for each failure", func(t *testing.T) {
+		assert.Equal(t, lockoutEnums.BaseDelay, GetRetryDelay(lockoutEnums.FreeFailedAttempts+1))
+		assert.Equal(t, lockoutEnums.BaseDelay*2, GetRetryDelay(lockoutEnums.FreeFailedAttempts+2))
+		assert.Equal(t, lockoutEnums.BaseDelay*4, GetRetryDelay(lockoutEnums.FreeFailedAttempts+3))
+	})
+
+	t.Run("should limit delay to the max delay", func(t *testing.T) {
+		assert.Equal(t, lockoutEnums.MaxDelay, GetRetryDelay(100))
+	})
+}
@@ -0,0 +1,56 @@
+package lockout
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+)
+
+// Event is the audit record of an account or address being locked or unlocked
+type Event struct {
+	EventID     uuid.UUID           `json:"eventID" gorm:"primary_key"`
+	AccountID   *uuid.UUID          `json:"accountID"`
+	Identifier  string              `json:"identifier"`
+	IPAddress   string              `json:"ipAddress"`
+	Target      lockoutEnums.Target `json:"target"`
+	Action      lockoutEnums.Action `json:"action"`
+	Method      lockoutEnums.Method `json:"method"`
+	ActorID     *uuid.UUID          `json:"actorID"`
+	LockedUntil *time.Time          `json:"lockedUntil"`
+	CreatedAt   time.Time           `json:"createdAt"`
+}
+
+func NewLockedEvent(target lockoutEnums.Target, identifier, ipAddress string) *Event {
+	lockedUntil := time.Now().Add(lockoutEnums.LockoutDuration)
+
+	return &Event{
+		EventID:     uuid.New(),
+		Identifier:  identifier,
+		IPAddress:   ipAddress,
+		Target:      target,
+		Action:      lockoutEnums.ActionLocked,
+		Method:      lockoutEnums.MethodFailedAttempts,
+		LockedUntil: &lockedUntil,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func NewUnlockedEvent(accountID uuid.UUID, method lockoutEnums.Method, actorID *uuid.UUID) *Event {
+	return &Event{
+		EventID:   uuid.New(),
+		AccountID: &accountID,
+		Target:    lockoutEnums.TargetAccount,
+		Action:    lockoutEnums.ActionUnlocked,
+		Method:    method,
+		ActorID:   actorID,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (e *Event) SetAccountID(accountID uuid.UUID) *Event {
+	e.AccountID = &accountID
+
+	return e
+}
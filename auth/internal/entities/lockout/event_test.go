@@ -0,0 +1,47 @@
+package lockout
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+)
+
+func TestNewLockedEvent(t *testing.T) {
+	t.Run("should create locked event with lock expiration", func(t *testing.T) {
+		event := NewLockedEvent(lockoutEnums.TargetIP, "", "127.0.0.1")
+
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, lockoutEnums.ActionLocked, event.Action)
+		assert.Equal(t, lockoutEnums.MethodFailedAttempts, event.Method)
+		assert.Equal(t, "127.0.0.1", event.IPAddress)
+		assert.NotNil(t, event.LockedUntil)
+		assert.Nil(t, event.AccountID)
+	})
+}
+
+func TestNewUnlockedEvent(t *testing.T) {
+	t.Run("should create unlocked event with actor", func(t *testing.T) {
+		accountID := uuid.New()
+		actorID := uuid.New()
+
+		event := NewUnlockedEvent(accountID, lockoutEnums.MethodAdmin, &actorID)
+
+		assert.Equal(t, accountID, *event.AccountID)
+		assert.Equal(t, actorID, *event.ActorID)
+		assert.Equal(t, lockoutEnums.ActionUnlocked, event.Action)
+		assert.Equal(t, lockoutEnums.TargetAccount, event.Target)
+		assert.Nil(t, event.LockedUntil)
+	})
+}
+
+func TestSetAccountID(t *testing.T) {
+	t.Run("should set event account id", func(t *testing.T) {
+		accountID := uuid.New()
+
+		assert.Equal(t, accountID, *NewLockedEvent(lockoutEnums.TargetAccount, "test", "").
+			SetAccountID(accountID).AccountID)
+	})
+}
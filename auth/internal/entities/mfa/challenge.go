@@ -0,0 +1,34 @@
+package mfa
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// Challenge is the pending second step of a login, identified by the mfa token returned instead of the access token
+type Challenge struct {
+	MFAToken     string    `json:"mfaToken"`
+	AccountID    uuid.UUID `json:"accountID"`
+	IsEnrollment bool      `json:"isEnrollment"`
+}
+
+func NewChallenge(accountID uuid.UUID, isEnrollment bool) *Challenge {
+	return &Challenge{
+		MFAToken:     uuid.NewString(),
+		AccountID:    accountID,
+		IsEnrollment: isEnrollment,
+	}
+}
+
+func ParseChallenge(value string) (*Challenge, error) {
+	challenge := &Challenge{}
+
+	return challenge, json.Unmarshal([]byte(value), challenge)
+}
+
+func (c *Challenge) ToString() string {
+	bytes, _ := json.Marshal(c)
+
+	return string(bytes)
+}
@@ -0,0 +1,21 @@
+package mfa
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+)
+
+type ChallengeData struct {
+	MFAToken string `json:"mfaToken"`
+	Code     string `json:"code"`
+	sessionEntities.Device
+}
+
+func (c *ChallengeData) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.MFAToken, validation.Required, is.UUID),
+		validation.Field(&c.Code, validation.Length(0, 255)),
+	)
+}
@@ -0,0 +1,22 @@
+package mfa
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateChallengeData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &ChallengeData{MFAToken: uuid.NewString(), Code: "123456"}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid mfa token", func(t *testing.T) {
+		data := &ChallengeData{MFAToken: "test", Code: "123456"}
+
+		assert.Error(t, data.Validate())
+	})
+}
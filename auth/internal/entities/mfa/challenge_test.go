@@ -0,0 +1,34 @@
+package mfa
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChallenge(t *testing.T) {
+	t.Run("should create a new challenge", func(t *testing.T) {
+		accountID := uuid.New()
+
+		challenge := NewChallenge(accountID, true)
+		assert.Equal(t, accountID, challenge.AccountID)
+		assert.True(t, challenge.IsEnrollment)
+		assert.NotEmpty(t, challenge.MFAToken)
+	})
+}
+
+func TestParseChallenge(t *testing.T) {
+	t.Run("should success parse the challenge saved as string", func(t *testing.T) {
+		challenge := NewChallenge(uuid.New(), false)
+
+		result, err := ParseChallenge(challenge.ToString())
+		assert.NoError(t, err)
+		assert.Equal(t, challenge, result)
+	})
+
+	t.Run("should return error when invalid value", func(t *testing.T) {
+		_, err := ParseChallenge("test")
+		assert.Error(t, err)
+	})
+}
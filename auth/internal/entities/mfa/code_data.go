@@ -0,0 +1,23 @@
+package mfa
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+type CodeData struct {
+	AccountID uuid.UUID `json:"accountID" swaggerignore:"true"`
+	Code      string    `json:"code"`
+}
+
+func (c *CodeData) Validate() error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Code, validation.Required, validation.Length(1, 255)),
+	)
+}
+
+func (c *CodeData) SetAccountID(accountID uuid.UUID) *CodeData {
+	c.AccountID = accountID
+
+	return c
+}
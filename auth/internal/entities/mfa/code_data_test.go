@@ -0,0 +1,30 @@
+package mfa
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCodeData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &CodeData{Code: "123456"}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when empty code", func(t *testing.T) {
+		data := &CodeData{}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetAccountID(t *testing.T) {
+	t.Run("should set account id", func(t *testing.T) {
+		accountID := uuid.New()
+
+		assert.Equal(t, accountID, (&CodeData{}).SetAccountID(accountID).AccountID)
+	})
+}
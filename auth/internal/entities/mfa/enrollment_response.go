@@ -0,0 +1,6 @@
+package mfa
+
+type EnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioningURI"`
+}
@@ -0,0 +1,74 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+)
+
+// RecoveryCode is a single use code to login when the authenticator is not available, only its hash is stored
+type RecoveryCode struct {
+	RecoveryCodeID uuid.UUID  `json:"recoveryCodeID" gorm:"primary_key"`
+	AccountID      uuid.UUID  `json:"accountID"`
+	CodeHash       string     `json:"-"`
+	UsedAt         *time.Time `json:"usedAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+func NewRecoveryCodes(accountID uuid.UUID) (recoveryCodes []*RecoveryCode, codes []string) {
+	for index := 0; index < mfaEnums.RecoveryCodesCount; index++ {
+		code := generateRecoveryCode()
+		hash, _ := crypto.HashPasswordBcrypt(code)
+
+		codes = append(codes, formatRecoveryCode(code))
+		recoveryCodes = append(recoveryCodes, &RecoveryCode{
+			RecoveryCodeID: uuid.New(),
+			AccountID:      accountID,
+			CodeHash:       hash,
+			CreatedAt:      time.Now(),
+		})
+	}
+
+	return recoveryCodes, codes
+}
+
+func (r *RecoveryCode) Matches(code string) bool {
+	return crypto.CheckPasswordHashBcrypt(NormalizeRecoveryCode(code), r.CodeHash)
+}
+
+func (r *RecoveryCode) SetUsed() *RecoveryCode {
+	now := time.Now()
+	r.UsedAt = &now
+
+	return r
+}
+
+// NormalizeRecoveryCode removes the separator and the case, so the code can be typed as it is shown or not
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), mfaEnums.RecoveryCodeSeparator, ""))
+}
+
+func generateRecoveryCode() string {
+	code := make([]byte, mfaEnums.RecoveryCodeSize)
+	charsetSize := big.NewInt(int64(len(mfaEnums.RecoveryCodeCharset)))
+
+	for index := range code {
+		position, _ := rand.Int(rand.Reader, charsetSize)
+		code[index] = mfaEnums.RecoveryCodeCharset[position.Int64()]
+	}
+
+	return string(code)
+}
+
+func formatRecoveryCode(code string) string {
+	half := len(code) / 2
+
+	return code[:half] + mfaEnums.RecoveryCodeSeparator + code[half:]
+}
@@ -0,0 +1,52 @@
+package mfa
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+)
+
+func TestNewRecoveryCodes(t *testing.T) {
+	t.Run("should create recovery codes with hashes", func(t *testing.T) {
+		accountID := uuid.New()
+
+		recoveryCodes, codes := NewRecoveryCodes(accountID)
+		assert.Len(t, recoveryCodes, mfaEnums.RecoveryCodesCount)
+		assert.Len(t, codes, mfaEnums.RecoveryCodesCount)
+
+		for index, code := range codes {
+			assert.Len(t, code, mfaEnums.RecoveryCodeSize+1)
+			assert.Equal(t, accountID, recoveryCodes[index].AccountID)
+			assert.NotEqual(t, code, recoveryCodes[index].CodeHash)
+			assert.Nil(t, recoveryCodes[index].UsedAt)
+			assert.True(t, recoveryCodes[index].Matches(code))
+		}
+	})
+}
+
+func TestMatches(t *testing.T) {
+	t.Run("should match code without separator and with other case", func(t *testing.T) {
+		recoveryCodes, codes := NewRecoveryCodes(uuid.New())
+
+		code := strings.ToUpper(strings.ReplaceAll(codes[0], mfaEnums.RecoveryCodeSeparator, ""))
+		assert.True(t, recoveryCodes[0].Matches(code))
+	})
+
+	t.Run("should not match other code", func(t *testing.T) {
+		recoveryCodes, codes := NewRecoveryCodes(uuid.New())
+
+		assert.False(t, recoveryCodes[0].Matches(codes[1]))
+	})
+}
+
+func TestSetUsed(t *testing.T) {
+	t.Run("should set used at", func(t *testing.T) {
+		recoveryCode := &RecoveryCode{}
+
+		assert.NotNil(t, recoveryCode.SetUsed().UsedAt)
+	})
+}
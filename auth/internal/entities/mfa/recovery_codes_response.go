@@ -0,0 +1,5 @@
+package mfa
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
@@ -0,0 +1,90 @@
+package mfa
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // sha1 is the rfc 6238 default, supported by all authenticator apps
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+)
+
+// TOTP implements the rfc 6238 time based one time password, using the defaults expected by the authenticator
+// apps: sha1, six digits and a thirty seconds period
+type TOTP struct {
+	Secret string
+}
+
+func NewTOTP() *TOTP {
+	secret := make([]byte, mfaEnums.SecretSize)
+	_, _ = rand.Read(secret)
+
+	return &TOTP{Secret: base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(secret)}
+}
+
+func (t *TOTP) GetStep(now time.Time) int64 {
+	return now.Unix() / mfaEnums.PeriodSeconds
+}
+
+func (t *TOTP) GenerateCode(step int64) string {
+	secret, _ := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(t.Secret)
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	hash := hmac.New(sha1.New, secret)
+	_, _ = hash.Write(counter)
+	sum := hash.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf(mfaEnums.CodeFormat, value%mfaEnums.CodeModulo)
+}
+
+// Validate checks the code against the current step and the adjacent ones, tolerating clock drift between the
+// server and the authenticator, returning the matched step to allow rejecting a code already used
+func (t *TOTP) Validate(code string, now time.Time) (int64, bool) {
+	current := t.GetStep(now)
+
+	for step := current - mfaEnums.ValidationWindow; step <= current+mfaEnums.ValidationWindow; step++ {
+		if subtle.ConstantTimeCompare([]byte(t.GenerateCode(step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func (t *TOTP) GetProvisioningURI(accountName string) string {
+	uri := url.URL{
+		Scheme: mfaEnums.ProvisioningURIScheme,
+		Host:   mfaEnums.ProvisioningURIHost,
+		Path:   "/" + mfaEnums.Issuer + ":" + accountName,
+	}
+
+	uri.RawQuery = url.Values{
+		"secret":    {t.Secret},
+		"issuer":    {mfaEnums.Issuer},
+		"algorithm": {mfaEnums.Algorithm},
+		"digits":    {strconv.Itoa(mfaEnums.CodeDigits)},
+		"period":    {strconv.Itoa(mfaEnums.PeriodSeconds)},
+	}.Encode()
+
+	return uri.String()
+}
+
+func IsTOTPCode(code string) bool {
+	if len(code) != mfaEnums.CodeDigits {
+		return false
+	}
+
+	_, err := strconv.ParseUint(code, 10, 32)
+	return err == nil
+}
@@ -0,0 +1,98 @@
+package mfa
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+)
+
+func getRFCTestTOTP() *TOTP {
+	return &TOTP{Secret: base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(
+		[]byte("12345678901234567890"))}
+}
+
+func TestNewTOTP(t *testing.T) {
+	t.Run("should create a new totp with a random secret", func(t *testing.T) {
+		totp := NewTOTP()
+
+		assert.NotEmpty(t, totp.Secret)
+		assert.NotEqual(t, totp.Secret, NewTOTP().Secret)
+		assert.NotContains(t, totp.Secret, "=")
+	})
+}
+
+func TestGenerateCode(t *testing.T) {
+	t.Run("should generate the rfc 6238 expected codes", func(t *testing.T) {
+		totp := getRFCTestTOTP()
+
+		assert.Equal(t, "287082", totp.GenerateCode(totp.GetStep(time.Unix(59, 0))))
+		assert.Equal(t, "081804", totp.GenerateCode(totp.GetStep(time.Unix(1111111109, 0))))
+		assert.Equal(t, "050471", totp.GenerateCode(totp.GetStep(time.Unix(1111111111, 0))))
+		assert.Equal(t, "005924", totp.GenerateCode(totp.GetStep(time.Unix(1234567890, 0))))
+		assert.Equal(t, "279037", totp.GenerateCode(totp.GetStep(time.Unix(2000000000, 0))))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should validate code of the current step", func(t *testing.T) {
+		totp := getRFCTestTOTP()
+
+		step, ok := totp.Validate("287082", time.Unix(59, 0))
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), step)
+	})
+
+	t.Run("should validate code of the adjacent steps", func(t *testing.T) {
+		totp := getRFCTestTOTP()
+
+		_, ok := totp.Validate("287082", time.Unix(89, 0))
+		assert.True(t, ok)
+
+		_, ok = totp.Validate("287082", time.Unix(29, 0))
+		assert.True(t, ok)
+	})
+
+	t.Run("should not validate code out of the window", func(t *testing.T) {
+		totp := getRFCTestTOTP()
+
+		_, ok := totp.Validate("287082", time.Unix(120, 0))
+		assert.False(t, ok)
+	})
+
+	t.Run("should not validate invalid code", func(t *testing.T) {
+		totp := getRFCTestTOTP()
+
+		_, ok := totp.Validate("000000", time.Unix(59, 0))
+		assert.False(t, ok)
+	})
+}
+
+func TestGetProvisioningURI(t *testing.T) {
+	t.Run("should return the otpauth uri", func(t *testing.T) {
+		totp := getRFCTestTOTP()
+
+		uri := totp.GetProvisioningURI("test@test.com")
+		assert.True(t, strings.HasPrefix(uri, "otpauth://totp/Horusec:test@test.com?"))
+		assert.Contains(t, uri, "secret="+totp.Secret)
+		assert.Contains(t, uri, "issuer="+mfaEnums.Issuer)
+		assert.Contains(t, uri, "digits=6")
+		assert.Contains(t, uri, "period=30")
+	})
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	t.Run("should return true when six digits code", func(t *testing.T) {
+		assert.True(t, IsTOTPCode("012345"))
+	})
+
+	t.Run("should return false when not a totp code", func(t *testing.T) {
+		assert.False(t, IsTOTPCode("12345"))
+		assert.False(t, IsTOTPCode("abcde-fghij"))
+		assert.False(t, IsTOTPCode("+12345"))
+	})
+}
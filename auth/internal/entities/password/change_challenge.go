@@ -0,0 +1,33 @@
+package password
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// ChangeChallenge is the account allowed to change the password with a change token, the second factor is required
+// after the change when the token was created before it was checked, as by the reset password code
+type ChangeChallenge struct {
+	AccountID     uuid.UUID `json:"accountID"`
+	IsMFARequired bool      `json:"isMFARequired"`
+}
+
+func NewChangeChallenge(accountID uuid.UUID, isMFARequired bool) *ChangeChallenge {
+	return &ChangeChallenge{
+		AccountID:     accountID,
+		IsMFARequired: isMFARequired,
+	}
+}
+
+func ParseChangeChallenge(value string) (*ChangeChallenge, error) {
+	challenge := &ChangeChallenge{}
+
+	return challenge, json.Unmarshal([]byte(value), challenge)
+}
+
+func (c *ChangeChallenge) ToString() string {
+	bytes, _ := json.Marshal(c)
+
+	return string(bytes)
+}
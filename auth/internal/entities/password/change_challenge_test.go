@@ -0,0 +1,33 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChangeChallenge(t *testing.T) {
+	t.Run("should create a new change challenge", func(t *testing.T) {
+		accountID := uuid.New()
+
+		challenge := NewChangeChallenge(accountID, true)
+		assert.Equal(t, accountID, challenge.AccountID)
+		assert.True(t, challenge.IsMFARequired)
+	})
+}
+
+func TestParseChangeChallenge(t *testing.T) {
+	t.Run("should success parse the change challenge saved as string", func(t *testing.T) {
+		challenge := NewChangeChallenge(uuid.New(), false)
+
+		result, err := ParseChangeChallenge(challenge.ToString())
+		assert.NoError(t, err)
+		assert.Equal(t, challenge, result)
+	})
+
+	t.Run("should return error when invalid value", func(t *testing.T) {
+		_, err := ParseChangeChallenge("test")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,24 @@
+package password
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+// ExpiredPasswordData changes the password with the token returned by the login with an expired password or by
+// the reset password code, logging in when valid
+type ExpiredPasswordData struct {
+	PasswordChangeToken string `json:"passwordChangeToken"`
+	Password            string `json:"password"`
+	sessionEntities.Device
+}
+
+func (e *ExpiredPasswordData) Validate() error {
+	return validation.ValidateStruct(e,
+		validation.Field(&e.PasswordChangeToken, validation.Required, is.UUID),
+		validation.Field(&e.Password, validation.Required, validation.Length(1, passwordEnums.MaxLength)),
+	)
+}
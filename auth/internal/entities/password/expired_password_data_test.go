@@ -0,0 +1,28 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpiredPasswordDataValidate(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &ExpiredPasswordData{PasswordChangeToken: uuid.NewString(), Password: "test"}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid password change token", func(t *testing.T) {
+		data := &ExpiredPasswordData{PasswordChangeToken: "test", Password: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when empty password", func(t *testing.T) {
+		data := &ExpiredPasswordData{PasswordChangeToken: uuid.NewString()}
+
+		assert.Error(t, data.Validate())
+	})
+}
@@ -0,0 +1,32 @@
+package password
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+// History keeps the hashes of the previous passwords of the account, used to reject their reuse
+type History struct {
+	PasswordHistoryID uuid.UUID `json:"passwordHistoryID" gorm:"primary_key"`
+	AccountID         uuid.UUID `json:"accountID"`
+	Password          string    `json:"-"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+func NewHistory(account *accountEntities.Account) *History {
+	return &History{
+		PasswordHistoryID: uuid.New(),
+		AccountID:         account.AccountID,
+		Password:          account.Password,
+		CreatedAt:         time.Now(),
+	}
+}
+
+func (h *History) Matches(password string) bool {
+	return crypto.CheckPasswordHashBcrypt(password, h.Password)
+}
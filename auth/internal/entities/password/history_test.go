@@ -0,0 +1,37 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+func TestNewHistory(t *testing.T) {
+	t.Run("should success create a new history with the account password hash", func(t *testing.T) {
+		account := &accountEntities.Account{AccountID: uuid.New(), Password: "hash"}
+
+		history := NewHistory(account)
+		assert.NotEqual(t, uuid.Nil, history.PasswordHistoryID)
+		assert.Equal(t, account.AccountID, history.AccountID)
+		assert.Equal(t, "hash", history.Password)
+		assert.False(t, history.CreatedAt.IsZero())
+	})
+}
+
+func TestMatches(t *testing.T) {
+	hash, _ := crypto.HashPasswordBcrypt("test")
+	history := &History{Password: hash}
+
+	t.Run("should return true when same password", func(t *testing.T) {
+		assert.True(t, history.Matches("test"))
+	})
+
+	t.Run("should return false when different password", func(t *testing.T) {
+		assert.False(t, history.Matches("other"))
+	})
+}
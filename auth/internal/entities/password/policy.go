@@ -0,0 +1,83 @@
+package password
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	validationEnums "github.com/ZupIT/horusec-devkit/pkg/utils/validation/enums"
+
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+var (
+	uppercaseRegex        = regexp.MustCompile(validationEnums.RegexUppercaseCharacter)
+	lowercaseRegex        = regexp.MustCompile(validationEnums.RegexLowercaseCharacter)
+	numberRegex           = regexp.MustCompile(validationEnums.RegexNumericCharacter)
+	specialCharacterRegex = regexp.MustCompile(validationEnums.RegexEspecialCharacter)
+)
+
+// Policy are the rules of the horusec auth passwords, the history and the max age are disabled when zero
+type Policy struct {
+	MinLength               int    `json:"minLength"`
+	RequireUppercase        bool   `json:"requireUppercase"`
+	RequireLowercase        bool   `json:"requireLowercase"`
+	RequireNumber           bool   `json:"requireNumber"`
+	RequireSpecialCharacter bool   `json:"requireSpecialCharacter"`
+	HistorySize             int    `json:"historySize"`
+	MaxAgeDays              int    `json:"maxAgeDays"`
+	CheckBreached           bool   `json:"checkBreached"`
+	BreachedHashesPath      string `json:"-"`
+}
+
+type characterRule struct {
+	isRequired bool
+	regex      *regexp.Regexp
+	violation  passwordEnums.Violation
+	message    string
+}
+
+// Check returns the violations of the length and character rules, the history and the breached passwords are
+// checked by the password service since they need the database or the hashes list
+func (p *Policy) Check(password string) []*Violation {
+	var violations []*Violation
+
+	if len([]rune(password)) < p.MinLength {
+		violations = append(violations, NewViolation(passwordEnums.ViolationMinLength,
+			fmt.Sprintf(passwordEnums.MessageMinLength, p.MinLength)))
+	}
+
+	for _, rule := range p.getCharacterRules() {
+		if rule.isRequired && !rule.regex.MatchString(password) {
+			violations = append(violations, NewViolation(rule.violation, rule.message))
+		}
+	}
+
+	return violations
+}
+
+func (p *Policy) getCharacterRules() []*characterRule {
+	return []*characterRule{
+		{p.RequireUppercase, uppercaseRegex, passwordEnums.ViolationUppercase,
+			validationEnums.MessageMustContainUppercaseCharacter},
+		{p.RequireLowercase, lowercaseRegex, passwordEnums.ViolationLowercase,
+			validationEnums.MessageMustContainLowercaseCharacter},
+		{p.RequireNumber, numberRegex, passwordEnums.ViolationNumber,
+			validationEnums.MessageMustContainNumericCharacter},
+		{p.RequireSpecialCharacter, specialCharacterRegex, passwordEnums.ViolationSpecialCharacter,
+			validationEnums.MessageMustContainEspecialCharacter},
+	}
+}
+
+func (p *Policy) HasHistory() bool {
+	return p.HistorySize > 0
+}
+
+// IsExpired ignores the accounts without the date of the last change, like the ones of other auth types
+func (p *Policy) IsExpired(passwordChangedAt time.Time) bool {
+	if p.MaxAgeDays <= 0 || passwordChangedAt.IsZero() {
+		return false
+	}
+
+	return time.Since(passwordChangedAt) > time.Duration(p.MaxAgeDays)*time.Hour*24
+}
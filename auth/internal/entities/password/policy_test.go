@@ -0,0 +1,78 @@
+package password
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+func getViolationCodes(violations []*Violation) []passwordEnums.Violation {
+	var codes []passwordEnums.Violation
+	for _, violation := range violations {
+		codes = append(codes, violation.Code)
+	}
+
+	return codes
+}
+
+func TestCheck(t *testing.T) {
+	policy := &Policy{
+		MinLength:               8,
+		RequireUppercase:        true,
+		RequireLowercase:        true,
+		RequireNumber:           true,
+		RequireSpecialCharacter: true,
+	}
+
+	t.Run("should return no violations when password satisfies the policy", func(t *testing.T) {
+		assert.Empty(t, policy.Check("Str0ng@Horusec"))
+	})
+
+	t.Run("should return a violation for each failed rule", func(t *testing.T) {
+		assert.Equal(t, []passwordEnums.Violation{passwordEnums.ViolationMinLength, passwordEnums.ViolationLowercase,
+			passwordEnums.ViolationNumber, passwordEnums.ViolationSpecialCharacter},
+			getViolationCodes(policy.Check("TEST")))
+	})
+
+	t.Run("should return min length message with the configured length", func(t *testing.T) {
+		violations := policy.Check("Te@1")
+
+		assert.Len(t, violations, 1)
+		assert.Equal(t, "must have at least 8 characters", violations[0].Message)
+	})
+
+	t.Run("should not check the character rules when disabled", func(t *testing.T) {
+		assert.Empty(t, (&Policy{MinLength: 4}).Check("test"))
+	})
+}
+
+func TestHasHistory(t *testing.T) {
+	t.Run("should return true when history size is configured", func(t *testing.T) {
+		assert.True(t, (&Policy{HistorySize: 5}).HasHistory())
+	})
+
+	t.Run("should return false when history size is zero", func(t *testing.T) {
+		assert.False(t, (&Policy{}).HasHistory())
+	})
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Run("should return true when password is older than the max age", func(t *testing.T) {
+		assert.True(t, (&Policy{MaxAgeDays: 30}).IsExpired(time.Now().AddDate(0, 0, -31)))
+	})
+
+	t.Run("should return false when password is newer than the max age", func(t *testing.T) {
+		assert.False(t, (&Policy{MaxAgeDays: 30}).IsExpired(time.Now().AddDate(0, 0, -29)))
+	})
+
+	t.Run("should return false when max age is disabled", func(t *testing.T) {
+		assert.False(t, (&Policy{}).IsExpired(time.Now().AddDate(-1, 0, 0)))
+	})
+
+	t.Run("should return false when password changed date is empty", func(t *testing.T) {
+		assert.False(t, (&Policy{MaxAgeDays: 30}).IsExpired(time.Time{}))
+	})
+}
@@ -0,0 +1,49 @@
+package password
+
+import (
+	"strings"
+
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+type Violation struct {
+	Code    passwordEnums.Violation `json:"code"`
+	Message string                  `json:"message"`
+}
+
+func NewViolation(code passwordEnums.Violation, message string) *Violation {
+	return &Violation{
+		Code:    code,
+		Message: message,
+	}
+}
+
+// PolicyError is returned as the content of the bad request, so the manager can show each failed rule
+type PolicyError struct {
+	Message    string       `json:"message"`
+	Violations []*Violation `json:"violations"`
+}
+
+func NewPolicyError(violations []*Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return &PolicyError{
+		Message:    passwordEnums.ErrorPasswordPolicy.Error(),
+		Violations: violations,
+	}
+}
+
+func (p *PolicyError) Error() string {
+	messages := make([]string, 0, len(p.Violations))
+	for _, violation := range p.Violations {
+		messages = append(messages, violation.Message)
+	}
+
+	return p.Message + ": " + strings.Join(messages, ", ")
+}
+
+func (p *PolicyError) Is(target error) bool {
+	return target == passwordEnums.ErrorPasswordPolicy
+}
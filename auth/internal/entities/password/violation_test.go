@@ -0,0 +1,54 @@
+package password
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+func TestNewViolation(t *testing.T) {
+	t.Run("should success create a new violation", func(t *testing.T) {
+		violation := NewViolation(passwordEnums.ViolationBreached, "test")
+
+		assert.Equal(t, passwordEnums.ViolationBreached, violation.Code)
+		assert.Equal(t, "test", violation.Message)
+	})
+}
+
+func TestNewPolicyError(t *testing.T) {
+	t.Run("should return nil when there are no violations", func(t *testing.T) {
+		assert.NoError(t, NewPolicyError(nil))
+	})
+
+	t.Run("should return policy error with the violations", func(t *testing.T) {
+		err := NewPolicyError([]*Violation{NewViolation(passwordEnums.ViolationNumber, "test")})
+
+		var policyError *PolicyError
+		assert.True(t, errors.As(err, &policyError))
+		assert.Len(t, policyError.Violations, 1)
+		assert.Equal(t, passwordEnums.ErrorPasswordPolicy.Error(), policyError.Message)
+	})
+}
+
+func TestPolicyErrorError(t *testing.T) {
+	t.Run("should return the messages of the violations", func(t *testing.T) {
+		err := NewPolicyError([]*Violation{
+			NewViolation(passwordEnums.ViolationNumber, "test1"),
+			NewViolation(passwordEnums.ViolationUppercase, "test2"),
+		})
+
+		assert.Equal(t, passwordEnums.ErrorPasswordPolicy.Error()+": test1, test2", err.Error())
+	})
+}
+
+func TestPolicyErrorIs(t *testing.T) {
+	t.Run("should match the password policy error", func(t *testing.T) {
+		err := NewPolicyError([]*Violation{NewViolation(passwordEnums.ViolationNumber, "test")})
+
+		assert.ErrorIs(t, err, passwordEnums.ErrorPasswordPolicy)
+		assert.NotErrorIs(t, err, passwordEnums.ErrorInvalidChangeToken)
+	})
+}
@@ -0,0 +1,14 @@
+package personaltoken
+
+// CreateResponse is the only moment the plain token is returned, it can't be recovered later
+type CreateResponse struct {
+	Token string `json:"token"`
+	*PersonalAccessToken
+}
+
+func (p *PersonalAccessToken) ToCreateResponse(token string) *CreateResponse {
+	return &CreateResponse{
+		Token:               token,
+		PersonalAccessToken: p,
+	}
+}
@@ -0,0 +1,45 @@
+package personaltoken
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+)
+
+type Data struct {
+	Name        string                     `json:"name"`
+	Scopes      []personalTokenEnums.Scope `json:"scopes"`
+	ExpiresAt   *time.Time                 `json:"expiresAt"`
+	AccountID   uuid.UUID                  `json:"accountID" swaggerignore:"true"`
+	Permissions []string                   `json:"permissions" swaggerignore:"true"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&d.Scopes, validation.Required,
+			validation.Each(validation.In(personalTokenEnums.ScopeValues()...))),
+		validation.Field(&d.ExpiresAt, validation.Min(time.Now())),
+	)
+}
+
+// SetAccountData keeps the groups of the login that is issuing the token, used by the group based authorizations
+func (d *Data) SetAccountData(accountID uuid.UUID, permissions []string) *Data {
+	d.AccountID = accountID
+	d.Permissions = permissions
+
+	return d
+}
+
+func (d *Data) ScopesToStringArray() pq.StringArray {
+	scopes := pq.StringArray{}
+	for _, scope := range d.Scopes {
+		scopes = append(scopes, scope.ToString())
+	}
+
+	return scopes
+}
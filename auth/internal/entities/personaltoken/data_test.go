@@ -0,0 +1,51 @@
+package personaltoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+)
+
+func TestValidateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		data := &Data{Name: "test", Scopes: []personalTokenEnums.Scope{personalTokenEnums.ScopeReadDashboards},
+			ExpiresAt: &expiresAt}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid scope", func(t *testing.T) {
+		data := &Data{Name: "test", Scopes: []personalTokenEnums.Scope{"test"}}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when empty scopes", func(t *testing.T) {
+		data := &Data{Name: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when expiration in the past", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Hour)
+		data := &Data{Name: "test", Scopes: []personalTokenEnums.Scope{personalTokenEnums.ScopeReadDashboards},
+			ExpiresAt: &expiresAt}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetAccountData(t *testing.T) {
+	t.Run("should set account id and permissions", func(t *testing.T) {
+		accountID := uuid.New()
+
+		data := (&Data{}).SetAccountData(accountID, []string{"test"})
+		assert.Equal(t, accountID, data.AccountID)
+		assert.Equal(t, []string{"test"}, data.Permissions)
+	})
+}
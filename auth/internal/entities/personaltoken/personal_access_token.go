@@ -0,0 +1,112 @@
+package personaltoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+// PersonalAccessToken is a long lived credential of an account used by automations, the token carries the token id
+// and a secret, only the hash of the secret is stored. The permissions are the groups of the login that issued it
+type PersonalAccessToken struct {
+	TokenID     uuid.UUID      `json:"tokenID" gorm:"primary_key"`
+	AccountID   uuid.UUID      `json:"accountID"`
+	Name        string         `json:"name"`
+	Scopes      pq.StringArray `json:"scopes" gorm:"type:text[]"`
+	Permissions pq.StringArray `json:"-" gorm:"type:text[]"`
+	SecretHash  string         `json:"-"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	ExpiresAt   *time.Time     `json:"expiresAt"`
+	LastUsedAt  *time.Time     `json:"lastUsedAt"`
+	RevokedAt   *time.Time     `json:"revokedAt"`
+}
+
+// NewPersonalAccessToken returns the token to store and the plain token, which is only shown once
+func NewPersonalAccessToken(data *Data) (personalToken *PersonalAccessToken, token string) {
+	secret := newSecret()
+
+	personalToken = &PersonalAccessToken{
+		TokenID:     uuid.New(),
+		AccountID:   data.AccountID,
+		Name:        data.Name,
+		Scopes:      data.ScopesToStringArray(),
+		Permissions: data.Permissions,
+		SecretHash:  hashSecret(secret),
+		CreatedAt:   time.Now(),
+		ExpiresAt:   data.ExpiresAt,
+	}
+
+	return personalToken, personalTokenEnums.TokenPrefix + personalToken.TokenID.String() +
+		personalTokenEnums.TokenSeparator + secret
+}
+
+func newSecret() string {
+	secret := make([]byte, personalTokenEnums.TokenSecretSize)
+	_, _ = rand.Read(secret)
+
+	return hex.EncodeToString(secret)
+}
+
+// IsPersonalAccessToken tells apart the personal access tokens from the jwt sent in the same header
+func IsPersonalAccessToken(token string) bool {
+	return strings.HasPrefix(removeBearer(token), personalTokenEnums.TokenPrefix)
+}
+
+func ParseToken(token string) (tokenID uuid.UUID, secret string, err error) {
+	token = strings.TrimPrefix(removeBearer(token), personalTokenEnums.TokenPrefix)
+
+	parts := strings.SplitN(token, personalTokenEnums.TokenSeparator, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return uuid.Nil, "", personalTokenEnums.ErrorInvalidToken
+	}
+
+	tokenID, err = uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, "", personalTokenEnums.ErrorInvalidToken
+	}
+
+	return tokenID, parts[1], nil
+}
+
+func removeBearer(token string) string {
+	return strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+}
+
+func (p *PersonalAccessToken) IsActive() bool {
+	return p.RevokedAt == nil && (p.ExpiresAt == nil || p.ExpiresAt.After(time.Now()))
+}
+
+func (p *PersonalAccessToken) MatchesSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(p.SecretHash)) == 1
+}
+
+// AllowsPermission checks if any of the token scopes allows the permission required by the route
+func (p *PersonalAccessToken) AllowsPermission(permission permissionEnums.Permission) bool {
+	for _, scope := range p.Scopes {
+		if scopeAllowsPermission(personalTokenEnums.Scope(scope), permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ShouldUpdateLastUsed avoids a write on every authorized request of the same automation
+func (p *PersonalAccessToken) ShouldUpdateLastUsed() bool {
+	return p.LastUsedAt == nil || time.Since(*p.LastUsedAt) > personalTokenEnums.LastUsedUpdateInterval
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,143 @@
+package personaltoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func TestNewPersonalAccessToken(t *testing.T) {
+	t.Run("should create active token without storing the token secret", func(t *testing.T) {
+		data := &Data{Name: "test", Scopes: []personalTokenEnums.Scope{personalTokenEnums.ScopeTriage}}
+		data.SetAccountData(uuid.New(), []string{"group"})
+
+		personalToken, token := NewPersonalAccessToken(data)
+		assert.Equal(t, data.AccountID, personalToken.AccountID)
+		assert.Equal(t, "test", personalToken.Name)
+		assert.Equal(t, []string{"vulnerabilities:triage"}, []string(personalToken.Scopes))
+		assert.Equal(t, []string{"group"}, []string(personalToken.Permissions))
+		assert.True(t, personalToken.IsActive())
+		assert.True(t, IsPersonalAccessToken(token))
+		assert.NotContains(t, token, personalToken.SecretHash)
+
+		tokenID, secret, err := ParseToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, personalToken.TokenID, tokenID)
+		assert.True(t, personalToken.MatchesSecret(secret))
+	})
+}
+
+func TestIsPersonalAccessToken(t *testing.T) {
+	t.Run("should accept token with bearer prefix", func(t *testing.T) {
+		assert.True(t, IsPersonalAccessToken("Bearer hpat_test"))
+	})
+
+	t.Run("should return false for jwt", func(t *testing.T) {
+		assert.False(t, IsPersonalAccessToken("Bearer eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"))
+	})
+}
+
+func TestParseToken(t *testing.T) {
+	t.Run("should return error when token has no secret", func(t *testing.T) {
+		_, _, err := ParseToken("hpat_" + uuid.NewString())
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when token has invalid token id", func(t *testing.T) {
+		_, _, err := ParseToken("hpat_test.test")
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+}
+
+func TestIsActive(t *testing.T) {
+	t.Run("should return false when token is expired", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+
+		assert.False(t, (&PersonalAccessToken{ExpiresAt: &expiresAt}).IsActive())
+	})
+
+	t.Run("should return false when token is revoked", func(t *testing.T) {
+		revokedAt := time.Now()
+
+		assert.False(t, (&PersonalAccessToken{RevokedAt: &revokedAt}).IsActive())
+	})
+
+	t.Run("should return true when token has no expiration", func(t *testing.T) {
+		assert.True(t, (&PersonalAccessToken{}).IsActive())
+	})
+}
+
+func TestAllowsPermission(t *testing.T) {
+	t.Run("should allow only reading with read dashboards scope", func(t *testing.T) {
+		personalToken := &PersonalAccessToken{Scopes: []string{"dashboards:read"}}
+
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.AnalyticRead))
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.RepositoryRead))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.VulnerabilityRead))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.RepositoryUpdate))
+	})
+
+	t.Run("should allow the vulnerabilities updates with triage scope", func(t *testing.T) {
+		personalToken := &PersonalAccessToken{Scopes: []string{"vulnerabilities:triage"}}
+
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.VulnerabilityTypeUpdate))
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.VulnerabilitySeverityUpdate))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.RepositoryUpdate))
+	})
+
+	t.Run("should allow repositories and webhooks with manage repositories scope", func(t *testing.T) {
+		personalToken := &PersonalAccessToken{Scopes: []string{"repositories:manage"}}
+
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.RepositoryCreate))
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.RepositoryUpdate))
+		assert.True(t, personalToken.AllowsPermission(permissionEnums.WebhookManage))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.VulnerabilityTypeUpdate))
+	})
+
+	t.Run("should deny members and tokens management with manage repositories scope", func(t *testing.T) {
+		personalToken := &PersonalAccessToken{Scopes: []string{"repositories:manage"}}
+
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.WorkspaceMembersManage))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.WorkspaceRolesManage))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.WorkspaceTokensManage))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.RepositoryMembersManage))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.RepositoryTokensManage))
+	})
+
+	t.Run("should never allow the built-in role checks", func(t *testing.T) {
+		personalToken := &PersonalAccessToken{Scopes: []string{"dashboards:read", "vulnerabilities:triage",
+			"repositories:manage"}}
+
+		assert.False(t, personalToken.AllowsPermission(""))
+		assert.False(t, personalToken.AllowsPermission(permissionEnums.Permission(auth.ApplicationAdmin)))
+	})
+}
+
+func TestShouldUpdateLastUsed(t *testing.T) {
+	t.Run("should return true when never used", func(t *testing.T) {
+		assert.True(t, (&PersonalAccessToken{}).ShouldUpdateLastUsed())
+	})
+
+	t.Run("should return false when recently used", func(t *testing.T) {
+		lastUsedAt := time.Now()
+
+		assert.False(t, (&PersonalAccessToken{LastUsedAt: &lastUsedAt}).ShouldUpdateLastUsed())
+	})
+}
+
+func TestToCreateResponse(t *testing.T) {
+	t.Run("should return the plain token with token data", func(t *testing.T) {
+		personalToken := &PersonalAccessToken{TokenID: uuid.New()}
+
+		response := personalToken.ToCreateResponse("test")
+		assert.Equal(t, "test", response.Token)
+		assert.Equal(t, personalToken.TokenID, response.TokenID)
+	})
+}
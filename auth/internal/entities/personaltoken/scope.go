@@ -0,0 +1,29 @@
+package personaltoken
+
+import (
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+// permissionsByScope are the permissions sent by the routes that each scope can pass, the account roles are still
+// checked after the scope. The built-in role checks, as the application admin, are never allowed with a token
+var permissionsByScope = map[personalTokenEnums.Scope][]permissionEnums.Permission{
+	personalTokenEnums.ScopeReadDashboards: {permissionEnums.WorkspaceRead, permissionEnums.RepositoryRead,
+		permissionEnums.AnalyticRead},
+	personalTokenEnums.ScopeTriage: {permissionEnums.WorkspaceRead, permissionEnums.RepositoryRead,
+		permissionEnums.VulnerabilityRead, permissionEnums.VulnerabilityTypeUpdate,
+		permissionEnums.VulnerabilitySeverityUpdate},
+	personalTokenEnums.ScopeManageRepositories: {permissionEnums.WorkspaceRead, permissionEnums.RepositoryCreate,
+		permissionEnums.RepositoryRead, permissionEnums.RepositoryUpdate, permissionEnums.RepositoryDelete,
+		permissionEnums.RepositoryTransfer, permissionEnums.WebhookRead, permissionEnums.WebhookManage},
+}
+
+func scopeAllowsPermission(scope personalTokenEnums.Scope, permission permissionEnums.Permission) bool {
+	for _, allowed := range permissionsByScope[scope] {
+		if allowed == permission {
+			return true
+		}
+	}
+
+	return false
+}
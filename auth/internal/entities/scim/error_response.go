@@ -0,0 +1,24 @@
+package scim
+
+import (
+	"strconv"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// ErrorResponse is the error body expected by the scim clients, the status is sent as a string as in the rfc
+type ErrorResponse struct {
+	Schemas  []string            `json:"schemas"`
+	Status   string              `json:"status"`
+	ScimType scimEnums.ErrorType `json:"scimType,omitempty"`
+	Detail   string              `json:"detail"`
+}
+
+func NewErrorResponse(status int, errorType scimEnums.ErrorType, err error) *ErrorResponse {
+	return &ErrorResponse{
+		Schemas:  []string{scimEnums.SchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: errorType,
+		Detail:   err.Error(),
+	}
+}
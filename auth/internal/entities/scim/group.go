@@ -0,0 +1,47 @@
+package scim
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
+// Group is a group provisioned by the identity provider, its members receive the roles mapped to the group
+type Group struct {
+	GroupID     uuid.UUID `json:"groupID" gorm:"primary_key"`
+	DisplayName string    `json:"displayName"`
+	ExternalID  string    `json:"externalID"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type GroupMember struct {
+	GroupID   uuid.UUID `json:"groupID"`
+	AccountID uuid.UUID `json:"accountID"`
+	Username  string    `json:"username" gorm:"->"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GroupRole maps the group onto a workspace role, or onto a repository role when the repository id is set
+type GroupRole struct {
+	GroupID      uuid.UUID         `json:"groupID"`
+	WorkspaceID  uuid.UUID         `json:"workspaceID"`
+	RepositoryID *uuid.UUID        `json:"repositoryID"`
+	Role         accountEnums.Role `json:"role"`
+}
+
+func NewGroupMember(groupID, accountID uuid.UUID) *GroupMember {
+	return &GroupMember{
+		GroupID:   groupID,
+		AccountID: accountID,
+		CreatedAt: time.Now(),
+	}
+}
+
+func (g *Group) Update() *Group {
+	g.UpdatedAt = time.Now()
+
+	return g
+}
@@ -0,0 +1,156 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// memberPathRegex matches the path used to remove a single member, like members[value eq "id"]
+var memberPathRegex = regexp.MustCompile(`(?i)^\s*members\[\s*value\s+eq\s+"([^"]+)"\s*]\s*$`)
+
+type groupAttributeSetter func(group *GroupResource, value json.RawMessage) error
+
+// groupAttributeSetters are the group attributes a patch can change, by their lower case path, the members are
+// changed according to the operation
+var groupAttributeSetters = map[string]groupAttributeSetter{
+	"displayname": setGroupDisplayName,
+	"externalid":  setGroupExternalID,
+	strings.ToLower(scimEnums.SchemaGroupRoles):            setGroupExtension,
+	strings.ToLower(scimEnums.SchemaGroupRoles + ":roles"): setGroupRoles,
+}
+
+func (p *PatchRequest) ApplyToGroup(group *GroupResource) error {
+	for _, operation := range p.Operations {
+		if err := operation.applyToGroup(group); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *PatchOperation) applyToGroup(group *GroupResource) error {
+	if matches := memberPathRegex.FindStringSubmatch(o.Path); matches != nil {
+		return o.removeMember(group, matches[1])
+	}
+
+	return o.forEachAttribute(func(path string, value json.RawMessage) error {
+		return o.applyGroupAttribute(group, path, value)
+	})
+}
+
+func (o *PatchOperation) applyGroupAttribute(group *GroupResource, path string, value json.RawMessage) error {
+	if strings.EqualFold(path, scimEnums.FilterPathMembers) {
+		return o.applyMembers(group, value)
+	}
+
+	setter, ok := groupAttributeSetters[strings.ToLower(path)]
+	if !ok {
+		return nil
+	}
+
+	if o.Op == scimEnums.OperationRemove {
+		return scimEnums.ErrorInvalidPath
+	}
+
+	return setter(group, value)
+}
+
+func (o *PatchOperation) removeMember(group *GroupResource, accountID string) error {
+	if o.Op != scimEnums.OperationRemove {
+		return scimEnums.ErrorInvalidPath
+	}
+
+	group.Members = removeMembers(group.Members, []*MemberResource{{Value: accountID}})
+	return nil
+}
+
+func (o *PatchOperation) applyMembers(group *GroupResource, value json.RawMessage) error {
+	var members []*MemberResource
+
+	if len(value) != 0 && json.Unmarshal(value, &members) != nil {
+		return scimEnums.ErrorInvalidValue
+	}
+
+	o.changeMembers(group, members)
+	return nil
+}
+
+// changeMembers a remove without value removes all the members
+func (o *PatchOperation) changeMembers(group *GroupResource, members []*MemberResource) {
+	switch o.Op {
+	case scimEnums.OperationAdd:
+		group.Members = append(removeMembers(group.Members, members), members...)
+	case scimEnums.OperationRemove:
+		group.Members = o.getRemainingMembers(group.Members, members)
+	case scimEnums.OperationReplace:
+		group.Members = members
+	}
+}
+
+func (o *PatchOperation) getRemainingMembers(current, removed []*MemberResource) []*MemberResource {
+	if len(removed) == 0 {
+		return []*MemberResource{}
+	}
+
+	return removeMembers(current, removed)
+}
+
+func removeMembers(current, removed []*MemberResource) []*MemberResource {
+	remaining := []*MemberResource{}
+
+	for _, member := range current {
+		if !containsMember(removed, member.Value) {
+			remaining = append(remaining, member)
+		}
+	}
+
+	return remaining
+}
+
+func containsMember(members []*MemberResource, accountID string) bool {
+	for _, member := range members {
+		if strings.EqualFold(member.Value, accountID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setGroupDisplayName(group *GroupResource, value json.RawMessage) (err error) {
+	group.DisplayName, err = parseString(value)
+
+	return err
+}
+
+func setGroupExternalID(group *GroupResource, value json.RawMessage) (err error) {
+	group.ExternalID, err = parseString(value)
+
+	return err
+}
+
+func setGroupRoles(group *GroupResource, value json.RawMessage) error {
+	var roles []*RoleMapping
+
+	if err := json.Unmarshal(value, &roles); err != nil {
+		return scimEnums.ErrorInvalidValue
+	}
+
+	group.Extension = &GroupExtension{Roles: roles}
+	return nil
+}
+
+func setGroupExtension(group *GroupResource, value json.RawMessage) error {
+	extension := &GroupExtension{}
+
+	if err := json.Unmarshal(value, extension); err != nil {
+		return scimEnums.ErrorInvalidValue
+	}
+
+	group.Extension = extension
+	return nil
+}
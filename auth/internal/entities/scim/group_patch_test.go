@@ -0,0 +1,89 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+func TestApplyToGroup(t *testing.T) {
+	memberID := uuid.NewString()
+
+	newGroup := func() *GroupResource {
+		return &GroupResource{DisplayName: "test", Members: []*MemberResource{{Value: memberID}}}
+	}
+
+	t.Run("should add members without duplicating them", func(t *testing.T) {
+		group := newGroup()
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationAdd, Path: "members",
+			Value: []byte(`[{"value": "` + memberID + `"}, {"value": "` + uuid.NewString() + `"}]`)}}}
+
+		assert.NoError(t, patch.ApplyToGroup(group))
+		assert.Len(t, group.Members, 2)
+	})
+
+	t.Run("should remove the member of the path", func(t *testing.T) {
+		group := newGroup()
+		patch := &PatchRequest{Operations: []*PatchOperation{
+			{Op: scimEnums.OperationRemove, Path: `members[value eq "` + memberID + `"]`}}}
+
+		assert.NoError(t, patch.ApplyToGroup(group))
+		assert.Empty(t, group.Members)
+	})
+
+	t.Run("should remove all members when remove without value", func(t *testing.T) {
+		group := newGroup()
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationRemove, Path: "members"}}}
+
+		assert.NoError(t, patch.ApplyToGroup(group))
+		assert.Empty(t, group.Members)
+	})
+
+	t.Run("should replace members and attributes of an operation without path", func(t *testing.T) {
+		group := newGroup()
+		newMemberID := uuid.NewString()
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationReplace,
+			Value: []byte(`{"displayName": "other", "members": [{"value": "` + newMemberID + `"}]}`)}}}
+
+		assert.NoError(t, patch.ApplyToGroup(group))
+		assert.Equal(t, "other", group.DisplayName)
+		assert.Equal(t, newMemberID, group.Members[0].Value)
+	})
+
+	t.Run("should replace the roles of the extension", func(t *testing.T) {
+		group := newGroup()
+		workspaceID := uuid.New()
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationReplace,
+			Path:  scimEnums.SchemaGroupRoles + ":roles",
+			Value: []byte(`[{"workspaceID": "` + workspaceID.String() + `", "role": "admin"}]`)}}}
+
+		assert.NoError(t, patch.ApplyToGroup(group))
+		assert.Equal(t, workspaceID, group.Extension.Roles[0].WorkspaceID)
+		assert.Equal(t, accountEnums.Admin, group.Extension.Roles[0].Role)
+	})
+
+	t.Run("should return error when member path isn't a remove", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{
+			{Op: scimEnums.OperationAdd, Path: `members[value eq "` + memberID + `"]`}}}
+
+		assert.Equal(t, scimEnums.ErrorInvalidPath, patch.ApplyToGroup(newGroup()))
+	})
+
+	t.Run("should return error when removing the display name", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationRemove, Path: "displayName"}}}
+
+		assert.Equal(t, scimEnums.ErrorInvalidPath, patch.ApplyToGroup(newGroup()))
+	})
+
+	t.Run("should return error when invalid members", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{
+			{Op: scimEnums.OperationAdd, Path: "members", Value: []byte(`"test"`)}}}
+
+		assert.Equal(t, scimEnums.ErrorInvalidValue, patch.ApplyToGroup(newGroup()))
+	})
+}
@@ -0,0 +1,146 @@
+package scim
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// GroupResource is the scim representation of a group, the workspace and repository roles of the group are sent in
+// the horusec extension schema
+type GroupResource struct {
+	Schemas     []string          `json:"schemas"`
+	ID          string            `json:"id,omitempty"`
+	ExternalID  string            `json:"externalId,omitempty"`
+	DisplayName string            `json:"displayName"`
+	Members     []*MemberResource `json:"members"`
+	Extension   *GroupExtension   `json:"urn:horusec:params:scim:schemas:extension:2.0:Group,omitempty"`
+	Meta        *Meta             `json:"meta,omitempty"`
+}
+
+type MemberResource struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type GroupExtension struct {
+	Roles []*RoleMapping `json:"roles"`
+}
+
+type RoleMapping struct {
+	WorkspaceID  uuid.UUID         `json:"workspaceID"`
+	RepositoryID *uuid.UUID        `json:"repositoryID,omitempty"`
+	Role         accountEnums.Role `json:"role"`
+}
+
+func NewGroupResource(group *Group, members []*GroupMember, roles []*GroupRole) *GroupResource {
+	return &GroupResource{
+		Schemas:     []string{scimEnums.SchemaGroup, scimEnums.SchemaGroupRoles},
+		ID:          group.GroupID.String(),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Members:     newMemberResources(members),
+		Extension:   newGroupExtension(roles),
+		Meta:        newMeta(scimEnums.ResourceTypeGroup, group.CreatedAt, group.UpdatedAt),
+	}
+}
+
+func newMemberResources(members []*GroupMember) []*MemberResource {
+	resources := []*MemberResource{}
+
+	for _, member := range members {
+		resources = append(resources, &MemberResource{Value: member.AccountID.String(), Display: member.Username})
+	}
+
+	return resources
+}
+
+func newGroupExtension(roles []*GroupRole) *GroupExtension {
+	extension := &GroupExtension{Roles: []*RoleMapping{}}
+
+	for _, role := range roles {
+		extension.Roles = append(extension.Roles, &RoleMapping{
+			WorkspaceID: role.WorkspaceID, RepositoryID: role.RepositoryID, Role: role.Role})
+	}
+
+	return extension
+}
+
+func (g *GroupResource) Validate() error {
+	return validation.ValidateStruct(g,
+		validation.Field(&g.DisplayName, validation.Required, validation.Length(1, 255)),
+		validation.Field(&g.ExternalID, validation.Length(0, 255)),
+		validation.Field(&g.Members),
+		validation.Field(&g.Extension),
+	)
+}
+
+func (m *MemberResource) Validate() error {
+	return validation.ValidateStruct(m,
+		validation.Field(&m.Value, validation.Required, is.UUID),
+	)
+}
+
+func (e *GroupExtension) Validate() error {
+	return validation.ValidateStruct(e,
+		validation.Field(&e.Roles),
+	)
+}
+
+func (r *RoleMapping) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.WorkspaceID, validation.Required),
+		validation.Field(&r.Role, validation.Required,
+			validation.In(accountEnums.Admin, accountEnums.Supervisor, accountEnums.Member)),
+		validation.Field(&r.Role, validation.When(r.RepositoryID == nil,
+			validation.NotIn(accountEnums.Supervisor).Error(scimEnums.ErrorInvalidRoleMapping.Error()))),
+	)
+}
+
+// MemberIDs returns the account ids of the members, the resource must be valid
+func (g *GroupResource) MemberIDs() []uuid.UUID {
+	var accountIDs []uuid.UUID
+
+	for _, member := range g.Members {
+		accountIDs = append(accountIDs, uuid.MustParse(member.Value))
+	}
+
+	return accountIDs
+}
+
+// HasRoles tells if the roles were sent, a group without the extension keeps its current roles
+func (g *GroupResource) HasRoles() bool {
+	return g.Extension != nil
+}
+
+func (g *GroupResource) ToGroup(groupID uuid.UUID, createdAt time.Time) *Group {
+	group := &Group{
+		GroupID:     groupID,
+		DisplayName: g.DisplayName,
+		ExternalID:  g.ExternalID,
+		CreatedAt:   createdAt,
+	}
+
+	return group.Update()
+}
+
+func (g *GroupResource) ToGroupRoles(groupID uuid.UUID) []*GroupRole {
+	var roles []*GroupRole
+
+	if !g.HasRoles() {
+		return roles
+	}
+
+	for _, role := range g.Extension.Roles {
+		roles = append(roles, &GroupRole{
+			GroupID: groupID, WorkspaceID: role.WorkspaceID, RepositoryID: role.RepositoryID, Role: role.Role})
+	}
+
+	return roles
+}
@@ -0,0 +1,101 @@
+package scim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
+func TestNewGroupResource(t *testing.T) {
+	t.Run("should create group resource with members and roles", func(t *testing.T) {
+		group := &Group{GroupID: uuid.New(), DisplayName: "test"}
+		members := []*GroupMember{{AccountID: uuid.New(), Username: "test"}}
+		roles := []*GroupRole{{WorkspaceID: uuid.New(), Role: accountEnums.Admin}}
+
+		resource := NewGroupResource(group, members, roles)
+		assert.Equal(t, group.GroupID.String(), resource.ID)
+		assert.Equal(t, members[0].AccountID.String(), resource.Members[0].Value)
+		assert.Equal(t, "test", resource.Members[0].Display)
+		assert.Equal(t, roles[0].WorkspaceID, resource.Extension.Roles[0].WorkspaceID)
+	})
+}
+
+func TestValidateGroupResource(t *testing.T) {
+	repositoryID := uuid.New()
+
+	t.Run("should return no error when valid group", func(t *testing.T) {
+		group := &GroupResource{DisplayName: "test", Members: []*MemberResource{{Value: uuid.NewString()}},
+			Extension: &GroupExtension{Roles: []*RoleMapping{
+				{WorkspaceID: uuid.New(), Role: accountEnums.Admin},
+				{WorkspaceID: uuid.New(), RepositoryID: &repositoryID, Role: accountEnums.Supervisor},
+			}}}
+
+		assert.NoError(t, group.Validate())
+	})
+
+	t.Run("should return error when member isn't an uuid", func(t *testing.T) {
+		group := &GroupResource{DisplayName: "test", Members: []*MemberResource{{Value: "test"}}}
+
+		assert.Error(t, group.Validate())
+	})
+
+	t.Run("should return error when supervisor role without repository", func(t *testing.T) {
+		group := &GroupResource{DisplayName: "test", Extension: &GroupExtension{Roles: []*RoleMapping{
+			{WorkspaceID: uuid.New(), Role: accountEnums.Supervisor}}}}
+
+		assert.Error(t, group.Validate())
+	})
+
+	t.Run("should return error when invalid role", func(t *testing.T) {
+		group := &GroupResource{DisplayName: "test", Extension: &GroupExtension{Roles: []*RoleMapping{
+			{WorkspaceID: uuid.New(), Role: "test"}}}}
+
+		assert.Error(t, group.Validate())
+	})
+
+	t.Run("should return error when empty display name", func(t *testing.T) {
+		assert.Error(t, (&GroupResource{}).Validate())
+	})
+}
+
+func TestMemberIDs(t *testing.T) {
+	t.Run("should return the account ids of the members", func(t *testing.T) {
+		accountID := uuid.New()
+		group := &GroupResource{Members: []*MemberResource{{Value: accountID.String()}}}
+
+		assert.Equal(t, []uuid.UUID{accountID}, group.MemberIDs())
+	})
+}
+
+func TestToGroup(t *testing.T) {
+	t.Run("should create group keeping the creation date", func(t *testing.T) {
+		groupID := uuid.New()
+		createdAt := time.Now().Add(-time.Hour)
+
+		group := (&GroupResource{DisplayName: "test", ExternalID: "test"}).ToGroup(groupID, createdAt)
+		assert.Equal(t, groupID, group.GroupID)
+		assert.Equal(t, createdAt, group.CreatedAt)
+		assert.True(t, group.UpdatedAt.After(createdAt))
+	})
+}
+
+func TestToGroupRoles(t *testing.T) {
+	t.Run("should return the roles of the extension", func(t *testing.T) {
+		groupID := uuid.New()
+		group := &GroupResource{Extension: &GroupExtension{Roles: []*RoleMapping{
+			{WorkspaceID: uuid.New(), Role: accountEnums.Member}}}}
+
+		roles := group.ToGroupRoles(groupID)
+		assert.Len(t, roles, 1)
+		assert.Equal(t, groupID, roles[0].GroupID)
+	})
+
+	t.Run("should return no roles when without extension", func(t *testing.T) {
+		assert.False(t, (&GroupResource{}).HasRoles())
+		assert.Empty(t, (&GroupResource{}).ToGroupRoles(uuid.New()))
+	})
+}
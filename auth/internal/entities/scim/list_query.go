@@ -0,0 +1,91 @@
+package scim
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// filterRegex matches the simple equality filters sent by the identity providers, like userName eq "john"
+var filterRegex = regexp.MustCompile(`^\s*([\w.]+)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// filterColumns are the filterable attributes of each resource, in lower case, and their table columns
+var filterColumns = map[scimEnums.ResourceType]map[string]string{
+	scimEnums.ResourceTypeUser: {
+		"username":     "username",
+		"emails":       "email",
+		"emails.value": "email",
+		"externalid":   "external_id",
+	},
+	scimEnums.ResourceTypeGroup: {
+		"displayname": "display_name",
+		"externalid":  "external_id",
+	},
+}
+
+// ListQuery is the filter and the pagination of a list request, the start index is one based as in the rfc
+type ListQuery struct {
+	FilterColumn string
+	FilterValue  string
+	StartIndex   int
+	Count        int
+}
+
+func NewListQuery(resourceType scimEnums.ResourceType, filter, startIndex, count string) (*ListQuery, error) {
+	query := &ListQuery{
+		StartIndex: parsePositiveInt(startIndex, scimEnums.DefaultStartIndex),
+		Count:      parsePositiveInt(count, scimEnums.DefaultCount),
+	}
+
+	if query.Count > scimEnums.MaxCount {
+		query.Count = scimEnums.MaxCount
+	}
+
+	return query, query.setFilter(resourceType, filter)
+}
+
+func parsePositiveInt(value string, defaultValue int) int {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 0 {
+		return defaultValue
+	}
+
+	return number
+}
+
+func (l *ListQuery) setFilter(resourceType scimEnums.ResourceType, filter string) (err error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil
+	}
+
+	l.FilterColumn, l.FilterValue, err = parseFilter(resourceType, filter)
+	return err
+}
+
+func parseFilter(resourceType scimEnums.ResourceType, filter string) (column, value string, err error) {
+	matches := filterRegex.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", "", scimEnums.ErrorInvalidFilter
+	}
+
+	column, ok := filterColumns[resourceType][strings.ToLower(matches[1])]
+	if !ok {
+		return "", "", scimEnums.ErrorInvalidFilter
+	}
+
+	return column, strings.ReplaceAll(matches[2], `\"`, `"`), nil
+}
+
+func (l *ListQuery) HasFilter() bool {
+	return l.FilterColumn != ""
+}
+
+func (l *ListQuery) GetOffset() int {
+	if l.StartIndex < 1 {
+		return 0
+	}
+
+	return l.StartIndex - 1
+}
@@ -0,0 +1,51 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+func TestNewListQuery(t *testing.T) {
+	t.Run("should create query with the default pagination", func(t *testing.T) {
+		query, err := NewListQuery(scimEnums.ResourceTypeUser, "", "", "test")
+		assert.NoError(t, err)
+		assert.False(t, query.HasFilter())
+		assert.Equal(t, scimEnums.DefaultCount, query.Count)
+		assert.Equal(t, 0, query.GetOffset())
+	})
+
+	t.Run("should create query with the filter and pagination", func(t *testing.T) {
+		query, err := NewListQuery(scimEnums.ResourceTypeUser, `userName eq "test"`, "11", "10")
+		assert.NoError(t, err)
+		assert.Equal(t, "username", query.FilterColumn)
+		assert.Equal(t, "test", query.FilterValue)
+		assert.Equal(t, 10, query.GetOffset())
+		assert.Equal(t, 10, query.Count)
+	})
+
+	t.Run("should parse email filter with escaped quotes", func(t *testing.T) {
+		query, err := NewListQuery(scimEnums.ResourceTypeUser, `emails.value EQ "te\"st"`, "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "email", query.FilterColumn)
+		assert.Equal(t, `te"st`, query.FilterValue)
+	})
+
+	t.Run("should limit the count", func(t *testing.T) {
+		query, err := NewListQuery(scimEnums.ResourceTypeGroup, "", "", "100000")
+		assert.NoError(t, err)
+		assert.Equal(t, scimEnums.MaxCount, query.Count)
+	})
+
+	t.Run("should return error when unsupported operator", func(t *testing.T) {
+		_, err := NewListQuery(scimEnums.ResourceTypeUser, `userName co "test"`, "", "")
+		assert.Equal(t, scimEnums.ErrorInvalidFilter, err)
+	})
+
+	t.Run("should return error when attribute isn't filterable", func(t *testing.T) {
+		_, err := NewListQuery(scimEnums.ResourceTypeGroup, `userName eq "test"`, "", "")
+		assert.Equal(t, scimEnums.ErrorInvalidFilter, err)
+	})
+}
@@ -0,0 +1,23 @@
+package scim
+
+import (
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+type ListResponse struct {
+	Schemas      []string    `json:"schemas"`
+	TotalResults int         `json:"totalResults"`
+	StartIndex   int         `json:"startIndex"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Resources    interface{} `json:"Resources"`
+}
+
+func NewListResponse(resources interface{}, length, total int, query *ListQuery) *ListResponse {
+	return &ListResponse{
+		Schemas:      []string{scimEnums.SchemaListResponse},
+		TotalResults: total,
+		StartIndex:   query.GetOffset() + 1,
+		ItemsPerPage: length,
+		Resources:    resources,
+	}
+}
@@ -0,0 +1,105 @@
+package scim
+
+import (
+	"encoding/json"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// PatchRequest is the scim patch operation message, rfc7644 section 3.5.2. The attributes horusec doesn't store,
+// like the names of the user, are ignored so the identity providers can keep sending the whole profile
+type PatchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*PatchOperation `json:"Operations"`
+}
+
+type PatchOperation struct {
+	Op    scimEnums.Operation `json:"op"`
+	Path  string              `json:"path"`
+	Value json.RawMessage     `json:"value"`
+}
+
+func (p *PatchRequest) Validate() error {
+	return validation.ValidateStruct(p,
+		validation.Field(&p.Operations, validation.Required),
+	)
+}
+
+func (o *PatchOperation) Validate() error {
+	return validation.ValidateStruct(o,
+		validation.Field(&o.Op, validation.Required, validation.In(scimEnums.OperationValues()...)),
+	)
+}
+
+// forEachAttribute applies the operation value to its path, an operation without path has an object of attributes
+// as value, which are applied one by one
+func (o *PatchOperation) forEachAttribute(apply func(path string, value json.RawMessage) error) error {
+	if o.Path != "" {
+		return apply(o.Path, o.Value)
+	}
+
+	return o.forEachValueAttribute(apply)
+}
+
+func (o *PatchOperation) forEachValueAttribute(apply func(path string, value json.RawMessage) error) error {
+	attributes, err := o.getAttributes()
+	if err != nil {
+		return err
+	}
+
+	for path, value := range attributes {
+		if err := apply(path, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getAttributes returns the attributes of an operation without path, where the value is an object of attributes
+func (o *PatchOperation) getAttributes() (map[string]json.RawMessage, error) {
+	attributes := map[string]json.RawMessage{}
+
+	if err := json.Unmarshal(o.Value, &attributes); err != nil {
+		return nil, scimEnums.ErrorInvalidValue
+	}
+
+	return attributes, nil
+}
+
+func parseString(value json.RawMessage) (string, error) {
+	var text string
+
+	if err := json.Unmarshal(value, &text); err != nil {
+		return "", scimEnums.ErrorInvalidValue
+	}
+
+	return text, nil
+}
+
+// parseBool accepts booleans sent as strings, like the "False" sent by some identity providers
+func parseBool(value json.RawMessage) (bool, error) {
+	var boolean bool
+	if err := json.Unmarshal(value, &boolean); err == nil {
+		return boolean, nil
+	}
+
+	return parseBoolString(value)
+}
+
+func parseBoolString(value json.RawMessage) (bool, error) {
+	text, err := parseString(value)
+	if err != nil {
+		return false, err
+	}
+
+	boolean, err := strconv.ParseBool(text)
+	if err != nil {
+		return false, scimEnums.ErrorInvalidValue
+	}
+
+	return boolean, nil
+}
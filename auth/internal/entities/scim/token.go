@@ -0,0 +1,78 @@
+package scim
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// Token is the credential used by the identity provider to call the scim api, it carries the token id and a secret,
+// only the hash of the secret is stored
+type Token struct {
+	TokenID    uuid.UUID  `json:"tokenID" gorm:"primary_key"`
+	Name       string     `json:"name"`
+	SecretHash string     `json:"-"`
+	CreatedBy  uuid.UUID  `json:"createdBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+}
+
+// NewToken returns the token to store and the plain token, which is only shown once
+func NewToken(data *TokenData) (scimToken *Token, token string) {
+	secret := newSecret()
+
+	scimToken = &Token{
+		TokenID:    uuid.New(),
+		Name:       data.Name,
+		SecretHash: hashSecret(secret),
+		CreatedBy:  data.CreatedBy,
+		CreatedAt:  time.Now(),
+	}
+
+	return scimToken, scimEnums.TokenPrefix + scimToken.TokenID.String() + scimEnums.TokenSeparator + secret
+}
+
+func newSecret() string {
+	secret := make([]byte, scimEnums.TokenSecretSize)
+	_, _ = rand.Read(secret)
+
+	return hex.EncodeToString(secret)
+}
+
+// ParseToken reads the token of the authorization header, sent as a bearer token by the identity providers
+func ParseToken(token string) (tokenID uuid.UUID, secret string, err error) {
+	token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+
+	parts := strings.SplitN(strings.TrimPrefix(token, scimEnums.TokenPrefix), scimEnums.TokenSeparator, 2)
+	if !strings.HasPrefix(token, scimEnums.TokenPrefix) || len(parts) != 2 || parts[1] == "" {
+		return uuid.Nil, "", scimEnums.ErrorInvalidToken
+	}
+
+	tokenID, err = uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, "", scimEnums.ErrorInvalidToken
+	}
+
+	return tokenID, parts[1], nil
+}
+
+func (t *Token) IsActive() bool {
+	return t.RevokedAt == nil
+}
+
+func (t *Token) MatchesSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(t.SecretHash)) == 1
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
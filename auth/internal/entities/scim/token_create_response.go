@@ -0,0 +1,14 @@
+package scim
+
+// TokenCreateResponse is the only moment the plain token is returned, it can't be recovered later
+type TokenCreateResponse struct {
+	PlainToken string `json:"token"`
+	*Token
+}
+
+func (t *Token) ToCreateResponse(token string) *TokenCreateResponse {
+	return &TokenCreateResponse{
+		PlainToken: token,
+		Token:      t,
+	}
+}
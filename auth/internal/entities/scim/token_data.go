@@ -0,0 +1,23 @@
+package scim
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+type TokenData struct {
+	Name      string    `json:"name"`
+	CreatedBy uuid.UUID `json:"createdBy" swaggerignore:"true"`
+}
+
+func (t *TokenData) Validate() error {
+	return validation.ValidateStruct(t,
+		validation.Field(&t.Name, validation.Required, validation.Length(1, 255)),
+	)
+}
+
+func (t *TokenData) SetCreatedBy(accountID uuid.UUID) *TokenData {
+	t.CreatedBy = accountID
+
+	return t
+}
@@ -0,0 +1,26 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTokenData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		assert.NoError(t, (&TokenData{Name: "test"}).Validate())
+	})
+
+	t.Run("should return error when empty name", func(t *testing.T) {
+		assert.Error(t, (&TokenData{}).Validate())
+	})
+}
+
+func TestSetCreatedBy(t *testing.T) {
+	t.Run("should set the account that created the token", func(t *testing.T) {
+		accountID := uuid.New()
+
+		assert.Equal(t, accountID, (&TokenData{}).SetCreatedBy(accountID).CreatedBy)
+	})
+}
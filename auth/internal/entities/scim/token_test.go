@@ -0,0 +1,76 @@
+package scim
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+func TestNewToken(t *testing.T) {
+	t.Run("should create token storing only the hash of the secret", func(t *testing.T) {
+		data := &TokenData{Name: "test", CreatedBy: uuid.New()}
+
+		token, plainToken := NewToken(data)
+		assert.Equal(t, data.CreatedBy, token.CreatedBy)
+		assert.True(t, strings.HasPrefix(plainToken, scimEnums.TokenPrefix+token.TokenID.String()))
+		assert.NotContains(t, plainToken, token.SecretHash)
+		assert.True(t, token.IsActive())
+	})
+}
+
+func TestParseToken(t *testing.T) {
+	t.Run("should parse bearer token", func(t *testing.T) {
+		token, plainToken := NewToken(&TokenData{Name: "test"})
+
+		tokenID, secret, err := ParseToken("Bearer " + plainToken)
+		assert.NoError(t, err)
+		assert.Equal(t, token.TokenID, tokenID)
+		assert.True(t, token.MatchesSecret(secret))
+	})
+
+	t.Run("should return error when token without prefix", func(t *testing.T) {
+		_, _, err := ParseToken(uuid.NewString() + scimEnums.TokenSeparator + "test")
+		assert.Equal(t, scimEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when token without secret", func(t *testing.T) {
+		_, _, err := ParseToken(scimEnums.TokenPrefix + uuid.NewString())
+		assert.Equal(t, scimEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when invalid token id", func(t *testing.T) {
+		_, _, err := ParseToken(scimEnums.TokenPrefix + "test" + scimEnums.TokenSeparator + "test")
+		assert.Equal(t, scimEnums.ErrorInvalidToken, err)
+	})
+}
+
+func TestMatchesSecret(t *testing.T) {
+	t.Run("should return false when secret doesn't match", func(t *testing.T) {
+		token, _ := NewToken(&TokenData{Name: "test"})
+
+		assert.False(t, token.MatchesSecret("test"))
+	})
+}
+
+func TestIsActiveToken(t *testing.T) {
+	t.Run("should return false when token was revoked", func(t *testing.T) {
+		revokedAt := time.Now()
+
+		assert.False(t, (&Token{RevokedAt: &revokedAt}).IsActive())
+	})
+}
+
+func TestToCreateResponse(t *testing.T) {
+	t.Run("should return the token with the plain token", func(t *testing.T) {
+		token, plainToken := NewToken(&TokenData{Name: "test"})
+
+		response := token.ToCreateResponse(plainToken)
+		assert.Equal(t, plainToken, response.PlainToken)
+		assert.Equal(t, token.TokenID, response.TokenID)
+	})
+}
@@ -0,0 +1,87 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+type userAttributeSetter func(user *UserResource, value json.RawMessage) error
+
+// userAttributeSetters are the user attributes a patch can change, by their lower case path
+var userAttributeSetters = map[string]userAttributeSetter{
+	"active":                       setUserActive,
+	"username":                     setUserName,
+	"externalid":                   setUserExternalID,
+	"emails":                       setUserEmails,
+	`emails[type eq "work"].value`: setUserWorkEmail,
+}
+
+// ApplyToUser changes the user with the patch operations, removing attributes isn't supported since the stored
+// ones are required by the account
+func (p *PatchRequest) ApplyToUser(user *UserResource) error {
+	for _, operation := range p.Operations {
+		if err := operation.applyToUser(user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *PatchOperation) applyToUser(user *UserResource) error {
+	if o.Op == scimEnums.OperationRemove {
+		return scimEnums.ErrorInvalidPath
+	}
+
+	return o.forEachAttribute(func(path string, value json.RawMessage) error {
+		return applyUserAttribute(user, path, value)
+	})
+}
+
+func applyUserAttribute(user *UserResource, path string, value json.RawMessage) error {
+	setter, ok := userAttributeSetters[strings.ToLower(path)]
+	if !ok {
+		return nil
+	}
+
+	return setter(user, value)
+}
+
+func setUserActive(user *UserResource, value json.RawMessage) error {
+	isActive, err := parseBool(value)
+	user.Active = &isActive
+
+	return err
+}
+
+func setUserName(user *UserResource, value json.RawMessage) (err error) {
+	user.UserName, err = parseString(value)
+
+	return err
+}
+
+func setUserExternalID(user *UserResource, value json.RawMessage) (err error) {
+	user.ExternalID, err = parseString(value)
+
+	return err
+}
+
+func setUserEmails(user *UserResource, value json.RawMessage) error {
+	var emails []*Email
+
+	if err := json.Unmarshal(value, &emails); err != nil {
+		return scimEnums.ErrorInvalidValue
+	}
+
+	user.Emails = emails
+	return nil
+}
+
+func setUserWorkEmail(user *UserResource, value json.RawMessage) error {
+	email, err := parseString(value)
+	user.Emails = []*Email{newWorkEmail(email)}
+
+	return err
+}
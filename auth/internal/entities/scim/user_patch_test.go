@@ -0,0 +1,79 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+func TestValidatePatchRequest(t *testing.T) {
+	t.Run("should return no error when valid patch", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationAdd}}}
+
+		assert.NoError(t, patch.Validate())
+	})
+
+	t.Run("should return error when invalid operation", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: "test"}}}
+
+		assert.Error(t, patch.Validate())
+	})
+
+	t.Run("should return error when without operations", func(t *testing.T) {
+		assert.Error(t, (&PatchRequest{}).Validate())
+	})
+}
+
+func TestApplyToUser(t *testing.T) {
+	t.Run("should apply the operation of the path", func(t *testing.T) {
+		user := &UserResource{}
+		patch := &PatchRequest{Operations: []*PatchOperation{
+			{Op: scimEnums.OperationReplace, Path: "active", Value: []byte(`"False"`)},
+			{Op: scimEnums.OperationReplace, Path: `emails[type eq "work"].value`, Value: []byte(`"test@test.com"`)},
+		}}
+
+		assert.NoError(t, patch.ApplyToUser(user))
+		assert.False(t, user.IsActive())
+		assert.Equal(t, "test@test.com", user.GetEmail())
+	})
+
+	t.Run("should apply the attributes of an operation without path ignoring the unknown ones", func(t *testing.T) {
+		user := &UserResource{}
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationAdd,
+			Value: []byte(`{"userName": "test", "externalId": "test", "name": {"givenName": "test"}}`)}}}
+
+		assert.NoError(t, patch.ApplyToUser(user))
+		assert.Equal(t, "test", user.UserName)
+		assert.Equal(t, "test", user.ExternalID)
+	})
+
+	t.Run("should replace the emails", func(t *testing.T) {
+		user := &UserResource{Emails: []*Email{{Value: "other@test.com"}}}
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationReplace, Path: "emails",
+			Value: []byte(`[{"value": "test@test.com", "primary": true}]`)}}}
+
+		assert.NoError(t, patch.ApplyToUser(user))
+		assert.Equal(t, "test@test.com", user.GetEmail())
+	})
+
+	t.Run("should return error when remove operation", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationRemove, Path: "externalId"}}}
+
+		assert.Equal(t, scimEnums.ErrorInvalidPath, patch.ApplyToUser(&UserResource{}))
+	})
+
+	t.Run("should return error when invalid value", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{
+			{Op: scimEnums.OperationReplace, Path: "active", Value: []byte(`"test"`)}}}
+
+		assert.Equal(t, scimEnums.ErrorInvalidValue, patch.ApplyToUser(&UserResource{}))
+	})
+
+	t.Run("should return error when value of an operation without path isn't an object", func(t *testing.T) {
+		patch := &PatchRequest{Operations: []*PatchOperation{{Op: scimEnums.OperationReplace, Value: []byte(`true`)}}}
+
+		assert.Equal(t, scimEnums.ErrorInvalidValue, patch.ApplyToUser(&UserResource{}))
+	})
+}
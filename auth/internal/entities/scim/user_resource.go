@@ -0,0 +1,114 @@
+package scim
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+// UserResource is the scim representation of an account, only the attributes horusec stores are kept
+type UserResource struct {
+	Schemas    []string `json:"schemas"`
+	ID         string   `json:"id,omitempty"`
+	ExternalID string   `json:"externalId,omitempty"`
+	UserName   string   `json:"userName"`
+	Emails     []*Email `json:"emails,omitempty"`
+	Active     *bool    `json:"active,omitempty"`
+	Meta       *Meta    `json:"meta,omitempty"`
+}
+
+type Email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type Meta struct {
+	ResourceType scimEnums.ResourceType `json:"resourceType"`
+	Created      time.Time              `json:"created"`
+	LastModified time.Time              `json:"lastModified"`
+}
+
+func NewUserResource(account *accountEntities.Account) *UserResource {
+	isActive := !account.IsDisabled
+
+	return &UserResource{
+		Schemas:    []string{scimEnums.SchemaUser},
+		ID:         account.AccountID.String(),
+		ExternalID: account.ExternalID,
+		UserName:   account.Username,
+		Emails:     []*Email{newWorkEmail(account.Email)},
+		Active:     &isActive,
+		Meta:       newMeta(scimEnums.ResourceTypeUser, account.CreatedAt, account.UpdatedAt),
+	}
+}
+
+func newWorkEmail(email string) *Email {
+	return &Email{Value: email, Type: scimEnums.EmailTypeWork, Primary: true}
+}
+
+func newMeta(resourceType scimEnums.ResourceType, createdAt, updatedAt time.Time) *Meta {
+	return &Meta{ResourceType: resourceType, Created: createdAt, LastModified: updatedAt}
+}
+
+func (u *UserResource) Validate() error {
+	return validation.ValidateStruct(u,
+		validation.Field(&u.UserName, validation.Required, validation.Length(1, 255)),
+		validation.Field(&u.ExternalID, validation.Length(0, 255)),
+		validation.Field(&u.Emails, validation.Required),
+	)
+}
+
+func (e *Email) Validate() error {
+	return validation.ValidateStruct(e,
+		validation.Field(&e.Value, validation.Required, validation.Length(1, 255), is.EmailFormat),
+	)
+}
+
+// GetEmail returns the primary email, or the first one when none is marked as primary
+func (u *UserResource) GetEmail() string {
+	if len(u.Emails) == 0 {
+		return ""
+	}
+
+	for _, email := range u.Emails {
+		if email.Primary {
+			return email.Value
+		}
+	}
+
+	return u.Emails[0].Value
+}
+
+// IsActive a user created without the active attribute is active
+func (u *UserResource) IsActive() bool {
+	return u.Active == nil || *u.Active
+}
+
+// ToAccount the password is random since provisioned users authenticate through the identity provider or reset it
+func (u *UserResource) ToAccount() *accountEntities.Account {
+	account := &accountEntities.Account{
+		Email:       u.GetEmail(),
+		Username:    u.UserName,
+		Password:    uuid.NewString(),
+		IsConfirmed: true,
+		IsDisabled:  !u.IsActive(),
+		ExternalID:  u.ExternalID,
+	}
+
+	return account.SetNewAccountData()
+}
+
+func (u *UserResource) UpdateAccount(account *accountEntities.Account) *accountEntities.Account {
+	account.Email = u.GetEmail()
+	account.Username = u.UserName
+	account.ExternalID = u.ExternalID
+	account.IsDisabled = !u.IsActive()
+
+	return account.Update()
+}
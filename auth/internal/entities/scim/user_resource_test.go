@@ -0,0 +1,98 @@
+package scim
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+func TestNewUserResource(t *testing.T) {
+	t.Run("should create user resource from account", func(t *testing.T) {
+		account := &accountEntities.Account{AccountID: uuid.New(), Email: "test@test.com", Username: "test",
+			ExternalID: "test", IsDisabled: true}
+
+		user := NewUserResource(account)
+		assert.Equal(t, account.AccountID.String(), user.ID)
+		assert.Equal(t, account.Email, user.GetEmail())
+		assert.Equal(t, account.ExternalID, user.ExternalID)
+		assert.False(t, user.IsActive())
+	})
+}
+
+func TestValidateUserResource(t *testing.T) {
+	t.Run("should return no error when valid user", func(t *testing.T) {
+		user := &UserResource{UserName: "test", Emails: []*Email{{Value: "test@test.com"}}}
+
+		assert.NoError(t, user.Validate())
+	})
+
+	t.Run("should return error when without emails", func(t *testing.T) {
+		assert.Error(t, (&UserResource{UserName: "test"}).Validate())
+	})
+
+	t.Run("should return error when invalid email", func(t *testing.T) {
+		user := &UserResource{UserName: "test", Emails: []*Email{{Value: "test"}}}
+
+		assert.Error(t, user.Validate())
+	})
+
+	t.Run("should return error when empty user name", func(t *testing.T) {
+		user := &UserResource{Emails: []*Email{{Value: "test@test.com"}}}
+
+		assert.Error(t, user.Validate())
+	})
+}
+
+func TestGetEmail(t *testing.T) {
+	t.Run("should return the primary email", func(t *testing.T) {
+		user := &UserResource{Emails: []*Email{{Value: "other@test.com"}, {Value: "test@test.com", Primary: true}}}
+
+		assert.Equal(t, "test@test.com", user.GetEmail())
+	})
+
+	t.Run("should return the first email when none is primary", func(t *testing.T) {
+		user := &UserResource{Emails: []*Email{{Value: "test@test.com"}, {Value: "other@test.com"}}}
+
+		assert.Equal(t, "test@test.com", user.GetEmail())
+	})
+
+	t.Run("should return empty when without emails", func(t *testing.T) {
+		assert.Empty(t, (&UserResource{}).GetEmail())
+	})
+}
+
+func TestIsActiveUser(t *testing.T) {
+	t.Run("should return true when active wasn't sent", func(t *testing.T) {
+		assert.True(t, (&UserResource{}).IsActive())
+	})
+}
+
+func TestToAccount(t *testing.T) {
+	t.Run("should create a confirmed account with a random password", func(t *testing.T) {
+		isActive := false
+		user := &UserResource{UserName: "test", Emails: []*Email{{Value: "test@test.com"}}, Active: &isActive}
+
+		account := user.ToAccount()
+		assert.NotEqual(t, uuid.Nil, account.AccountID)
+		assert.NotEmpty(t, account.Password)
+		assert.True(t, account.IsConfirmed)
+		assert.True(t, account.IsDisabled)
+		assert.Equal(t, "test@test.com", account.Email)
+	})
+}
+
+func TestUpdateAccount(t *testing.T) {
+	t.Run("should update the account attributes", func(t *testing.T) {
+		user := &UserResource{UserName: "test", Emails: []*Email{{Value: "test@test.com"}}, ExternalID: "test"}
+		account := &accountEntities.Account{Username: "other", Email: "other@test.com", IsDisabled: true}
+
+		account = user.UpdateAccount(account)
+		assert.Equal(t, "test", account.Username)
+		assert.Equal(t, "test@test.com", account.Email)
+		assert.Equal(t, "test", account.ExternalID)
+		assert.False(t, account.IsDisabled)
+	})
+}
@@ -0,0 +1,21 @@
+package session
+
+import (
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+)
+
+// Device identifies where a login came from, it is embedded in the login data and never read from the request body
+type Device struct {
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+func (d *Device) SetDevice(userAgent, remoteAddress string) {
+	if len(userAgent) > sessionEnums.MaxUserAgentLength {
+		userAgent = userAgent[:sessionEnums.MaxUserAgentLength]
+	}
+
+	d.UserAgent = userAgent
+	d.IPAddress = lockoutEntities.NormalizeIPAddress(remoteAddress)
+}
@@ -0,0 +1,27 @@
+package session
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+)
+
+func TestSetDevice(t *testing.T) {
+	t.Run("should set user agent and ip address without port", func(t *testing.T) {
+		device := &Device{}
+		device.SetDevice("test", "127.0.0.1:8006")
+
+		assert.Equal(t, "test", device.UserAgent)
+		assert.Equal(t, "127.0.0.1", device.IPAddress)
+	})
+
+	t.Run("should truncate long user agents", func(t *testing.T) {
+		device := &Device{}
+		device.SetDevice(strings.Repeat("a", 300), "127.0.0.1")
+
+		assert.Len(t, device.UserAgent, sessionEnums.MaxUserAgentLength)
+	})
+}
@@ -0,0 +1,89 @@
+package session
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+)
+
+// Session is a login of an account, the refresh token carries the session id and a secret that changes on each use,
+// only the hash of the current secret is stored
+type Session struct {
+	SessionID        uuid.UUID  `json:"sessionID" gorm:"primary_key"`
+	AccountID        uuid.UUID  `json:"accountID"`
+	RefreshTokenHash string     `json:"-"`
+	UserAgent        string     `json:"userAgent"`
+	IPAddress        string     `json:"ipAddress"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	LastUsedAt       time.Time  `json:"lastUsedAt"`
+	ExpiresAt        time.Time  `json:"expiresAt"`
+	RevokedAt        *time.Time `json:"revokedAt"`
+}
+
+// NewSession returns the session to store and its first refresh token
+func NewSession(accountID uuid.UUID, device *Device) (session *Session, refreshToken string) {
+	session = &Session{
+		SessionID: uuid.New(),
+		AccountID: accountID,
+		UserAgent: device.UserAgent,
+		IPAddress: device.IPAddress,
+		CreatedAt: time.Now(),
+	}
+
+	return session, session.Rotate()
+}
+
+// Rotate replaces the refresh token secret and extends the session, the previous token is no longer valid
+func (s *Session) Rotate() (refreshToken string) {
+	secret := make([]byte, sessionEnums.TokenSecretSize)
+	_, _ = rand.Read(secret)
+
+	s.RefreshTokenHash = hashSecret(hex.EncodeToString(secret))
+	s.LastUsedAt = time.Now()
+	s.ExpiresAt = s.LastUsedAt.Add(sessionEnums.Duration)
+
+	return s.SessionID.String() + sessionEnums.TokenSeparator + hex.EncodeToString(secret)
+}
+
+func ParseRefreshToken(refreshToken string) (sessionID uuid.UUID, secret string, err error) {
+	parts := strings.SplitN(refreshToken, sessionEnums.TokenSeparator, 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return uuid.Nil, "", sessionEnums.ErrorInvalidRefreshToken
+	}
+
+	sessionID, err = uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.Nil, "", sessionEnums.ErrorInvalidRefreshToken
+	}
+
+	return sessionID, parts[1], nil
+}
+
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(time.Now())
+}
+
+func (s *Session) MatchesSecret(secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(s.RefreshTokenHash)) == 1
+}
+
+func (s *Session) ToRotateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"refresh_token_hash": s.RefreshTokenHash,
+		"last_used_at":       s.LastUsedAt,
+		"expires_at":         s.ExpiresAt,
+	}
+}
+
+func hashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
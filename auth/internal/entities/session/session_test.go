@@ -0,0 +1,80 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+)
+
+func TestNewSession(t *testing.T) {
+	t.Run("should create active session without storing the token secret", func(t *testing.T) {
+		accountID := uuid.New()
+
+		session, refreshToken := NewSession(accountID, &Device{UserAgent: "test", IPAddress: "127.0.0.1"})
+		assert.Equal(t, accountID, session.AccountID)
+		assert.Equal(t, "test", session.UserAgent)
+		assert.Equal(t, "127.0.0.1", session.IPAddress)
+		assert.True(t, session.IsActive())
+		assert.NotContains(t, refreshToken, session.RefreshTokenHash)
+
+		sessionID, secret, err := ParseRefreshToken(refreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, session.SessionID, sessionID)
+		assert.True(t, session.MatchesSecret(secret))
+	})
+}
+
+func TestRotate(t *testing.T) {
+	t.Run("should invalidate previous refresh token", func(t *testing.T) {
+		session, refreshToken := NewSession(uuid.New(), &Device{})
+		_, previousSecret, _ := ParseRefreshToken(refreshToken)
+
+		_, secret, _ := ParseRefreshToken(session.Rotate())
+		assert.False(t, session.MatchesSecret(previousSecret))
+		assert.True(t, session.MatchesSecret(secret))
+	})
+}
+
+func TestParseRefreshToken(t *testing.T) {
+	t.Run("should return error when token has no secret", func(t *testing.T) {
+		_, _, err := ParseRefreshToken(uuid.NewString())
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken, err)
+	})
+
+	t.Run("should return error when token has invalid session id", func(t *testing.T) {
+		_, _, err := ParseRefreshToken("test.test")
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken, err)
+	})
+}
+
+func TestIsActive(t *testing.T) {
+	t.Run("should return false when session is revoked", func(t *testing.T) {
+		revokedAt := time.Now()
+		session, _ := NewSession(uuid.New(), &Device{})
+		session.RevokedAt = &revokedAt
+
+		assert.False(t, session.IsActive())
+	})
+
+	t.Run("should return false when session is expired", func(t *testing.T) {
+		session, _ := NewSession(uuid.New(), &Device{})
+		session.ExpiresAt = time.Now().Add(-time.Minute)
+
+		assert.False(t, session.IsActive())
+	})
+}
+
+func TestToRotateMap(t *testing.T) {
+	t.Run("should return the rotated fields", func(t *testing.T) {
+		session, _ := NewSession(uuid.New(), &Device{})
+
+		result := session.ToRotateMap()
+		assert.Equal(t, session.RefreshTokenHash, result["refresh_token_hash"])
+		assert.Equal(t, session.LastUsedAt, result["last_used_at"])
+		assert.Equal(t, session.ExpiresAt, result["expires_at"])
+	})
+}
@@ -7,3 +7,7 @@ var ErrorUsernameAlreadyInUse = errors.New("{ACCOUNT} username already in use")
 var ErrorIncorrectRetrievePasswordCode = errors.New("{ACCOUNT} wrong or invalid retrieve password")
 var ErrorPasswordEqualPrevious = errors.New("{ACCOUNT} the new password cannot be the same as the previous one")
 var ErrorInvalidOrExpiredToken = errors.New("{ACCOUNT} invalid or expired refresh token")
+var ErrorInvalidAccountID = errors.New("{ACCOUNT} invalid account id")
+var ErrorInvalidVerificationToken = errors.New("{ACCOUNT} invalid, used or expired email verification link")
+var ErrorAccountAlreadyConfirmed = errors.New("{ACCOUNT} account email already confirmed")
+var ErrorAccountDisabled = errors.New("{ACCOUNT} account is disabled")
@@ -1,5 +1,6 @@
 package account
 
 const (
-	MessageFailedToRefreshToken = "something went wrong while refreshing token: "
+	MessageFailedToRefreshToken    = "something went wrong while refreshing token: "
+	MessageFailedToBindInvitations = "something went wrong while publishing the verified email of the account: "
 )
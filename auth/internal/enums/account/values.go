@@ -4,10 +4,17 @@ import "time"
 
 const (
 	DatabaseTableAccount           = "accounts"
+	DatabaseTableAccountIdentity   = "account_identities"
 	DuplicatedConstraintEmail      = "duplicate key value violates unique constraint \"accounts_email_key\""
 	DuplicatedConstraintUsername   = "duplicate key value violates unique constraint \"uk_accounts_username\""
 	DuplicatedConstraintPrimaryKey = "duplicate key value violates unique constraint \"accounts_pkey\""
 	ID                             = "accountID"
 	ResetPasswordCharset           = "1234567890abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	ResetPasswordCodeDuration      = time.Minute * 10
+	VerificationToken              = "token"
+	VerificationTokenSize          = 32
+	VerificationTokenSeparator     = "."
+	VerificationTokenDuration      = time.Hour * 24
+	CacheKeyEmailVerification      = "email-verification-%s"
+	ExchangeEmailVerified          = "horusec-account-email-verified"
 )
@@ -0,0 +1,10 @@
+package admin
+
+import "errors"
+
+var ErrorNotAllowed = errors.New("{ADMIN} only application admins can administer accounts")
+var ErrorAccountNotFound = errors.New("{ADMIN} account not found")
+var ErrorInvalidPagination = errors.New("{ADMIN} invalid pagination, page and size should be positive numbers")
+var ErrorChangeOwnAccount = errors.New("{ADMIN} application admins can't disable or demote their own account")
+var ErrorPasswordResetNotSupported = errors.New(
+	"{ADMIN} forcing a password reset is only supported when using horusec authentication")
@@ -0,0 +1,10 @@
+package admin
+
+const (
+	SearchQuery           = "search"
+	PageQuery             = "page"
+	SizeQuery             = "size"
+	DefaultPaginationPage = 1
+	DefaultPaginationSize = 10
+	MaxPaginationSize     = 100
+)
@@ -0,0 +1,6 @@
+package audit
+
+const (
+	MessageFailedToPublishEvent = "{AUTH_AUDIT} failed to publish audit event"
+	MessageFailedToGetActor     = "{AUTH_AUDIT} failed to get the account that performed the audited action"
+)
@@ -0,0 +1,35 @@
+package audit
+
+const (
+	ExchangeAudit = "horusec-audit"
+	ServiceAuth   = "auth"
+)
+
+type Action string
+
+const (
+	ActionAccountDisable           Action = "account.disable"
+	ActionAccountEnable            Action = "account.enable"
+	ActionAccountApplicationAdmin  Action = "account.application_admin.update"
+	ActionAccountPasswordReset     Action = "account.password.reset"
+	ActionAccountPasswordChange    Action = "account.password.change"
+	ActionAccountSessionRevoke     Action = "account.session.revoke"
+	ActionAccountSessionsRevokeAll Action = "account.session.revoke_all"
+	ActionSCIMTokenCreate          Action = "scim.token.create"
+	ActionSCIMTokenRevoke          Action = "scim.token.revoke"
+	ActionSCIMUserCreate           Action = "scim.user.create"
+	ActionSCIMUserUpdate           Action = "scim.user.update"
+	ActionSCIMUserDelete           Action = "scim.user.delete"
+	ActionSCIMGroupCreate          Action = "scim.group.create"
+	ActionSCIMGroupUpdate          Action = "scim.group.update"
+	ActionSCIMGroupDelete          Action = "scim.group.delete"
+)
+
+type TargetType string
+
+const (
+	TargetAccount   TargetType = "account"
+	TargetSession   TargetType = "session"
+	TargetSCIMToken TargetType = "scim_token"
+	TargetSCIMGroup TargetType = "scim_group"
+)
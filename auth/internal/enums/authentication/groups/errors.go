@@ -0,0 +1,6 @@
+package groups
+
+import "errors"
+
+var ErrorApplicationAdminGroupNotSet = errors.New("{AUTH GROUPS} horusec application admin group env not set")
+var ErrorInvalidAuthorizationType = errors.New("{AUTH GROUPS} invalid authorization type")
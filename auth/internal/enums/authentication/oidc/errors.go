@@ -0,0 +1,16 @@
+package oidc
+
+import "errors"
+
+var ErrorOIDCPasswordLoginNotSupported = errors.New(
+	"{OIDC AUTH} login with credentials is not supported, use the authorization code flow")
+var ErrorOIDCInvalidState = errors.New("{OIDC AUTH} invalid or expired authorization state")
+var ErrorOIDCMissingIDToken = errors.New("{OIDC AUTH} token response missing id token")
+var ErrorOIDCInvalidIDToken = errors.New("{OIDC AUTH} invalid id token")
+var ErrorOIDCInvalidIssuer = errors.New("{OIDC AUTH} id token issued by an unexpected issuer")
+var ErrorOIDCInvalidAudience = errors.New("{OIDC AUTH} id token issued to an unexpected audience")
+var ErrorOIDCInvalidNonce = errors.New("{OIDC AUTH} id token nonce does not match the authorization request")
+var ErrorOIDCUnexpectedSigningMethod = errors.New("{OIDC AUTH} id token signed with an unexpected method")
+var ErrorOIDCSigningKeyNotFound = errors.New("{OIDC AUTH} id token signing key not found in issuer jwks")
+var ErrorOIDCMissingSubjectOrEmail = errors.New("{OIDC AUTH} id token missing subject or email claims")
+var ErrorOIDCEmailNotVerified = errors.New("{OIDC AUTH} id token email is not verified by the issuer")
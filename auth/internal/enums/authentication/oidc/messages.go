@@ -0,0 +1,8 @@
+package oidc
+
+const (
+	MessageFailedToGetDiscoveryDocument = "{OIDC AUTH} failed to get issuer discovery document"
+	MessageFailedToGetJWKS              = "{OIDC AUTH} failed to get issuer jwks"
+	MessageFailedToExchangeCode         = "{OIDC AUTH} failed to exchange authorization code"
+	MessageUnexpectedStatusCode         = "{OIDC AUTH} unexpected status code %d from %s"
+)
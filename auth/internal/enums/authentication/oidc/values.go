@@ -0,0 +1,39 @@
+package oidc
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+)
+
+const AuthenticationTypeOIDC auth.AuthenticationType = "oidc"
+
+const (
+	EnvOIDCIssuer                = "HORUSEC_OIDC_ISSUER"
+	EnvOIDCClientID              = "HORUSEC_OIDC_CLIENT_ID"
+	EnvOIDCClientSecret          = "HORUSEC_OIDC_CLIENT_SECRET" //nolint:gosec // false positive
+	EnvOIDCRedirectURL           = "HORUSEC_OIDC_REDIRECT_URL"
+	EnvOIDCScopes                = "HORUSEC_OIDC_SCOPES"
+	EnvOIDCUsernameClaim         = "HORUSEC_OIDC_USERNAME_CLAIM"
+	EnvOIDCEmailClaim            = "HORUSEC_OIDC_EMAIL_CLAIM"
+	EnvOIDCGroupsClaim           = "HORUSEC_OIDC_GROUPS_CLAIM"
+	EnvOIDCAdminGroup            = "HORUSEC_OIDC_ADMIN_GROUP"
+	DefaultScopes                = "openid profile email"
+	DefaultUsernameClaim         = "preferred_username"
+	DefaultEmailClaim            = "email"
+	DefaultGroupsClaim           = "groups"
+	DiscoveryPath                = "/.well-known/openid-configuration"
+	CacheKeyAuthorizationState   = "oidc-state-%s"
+	CodeChallengeMethod          = "S256"
+	ResponseTypeCode             = "code"
+	GrantTypeAuthorizationCode   = "authorization_code"
+	ClaimIssuer                  = "iss"
+	ClaimAudience                = "aud"
+	ClaimNonce                   = "nonce"
+	ClaimSubject                 = "sub"
+	ClaimEmailVerified           = "email_verified"
+	HeaderKeyID                  = "kid"
+	KeyTypeRSA                   = "RSA"
+	AuthorizationRequestDuration = time.Minute * 10
+	HTTPClientTimeout            = time.Second * 10
+)
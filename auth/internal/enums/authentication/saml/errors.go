@@ -0,0 +1,13 @@
+package saml
+
+import "errors"
+
+var ErrorSAMLPasswordLoginNotSupported = errors.New(
+	"{SAML AUTH} login with credentials is not supported, use the identity provider single sign-on")
+var ErrorSAMLInvalidRelayState = errors.New("{SAML AUTH} invalid or expired relay state")
+var ErrorSAMLInvalidLoginCode = errors.New("{SAML AUTH} invalid or expired login code")
+var ErrorSAMLInvalidAssertion = errors.New("{SAML AUTH} invalid saml assertion")
+var ErrorSAMLInvalidCertificate = errors.New("{SAML AUTH} invalid service provider certificate")
+var ErrorSAMLInvalidPrivateKey = errors.New("{SAML AUTH} invalid service provider private key")
+var ErrorSAMLMissingIDPMetadata = errors.New("{SAML AUTH} identity provider metadata env not set")
+var ErrorSAMLMissingNameIDOrEmail = errors.New("{SAML AUTH} assertion missing name id or email attribute")
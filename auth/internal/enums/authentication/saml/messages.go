@@ -0,0 +1,7 @@
+package saml
+
+const (
+	MessageFailedToGetIDPMetadata   = "{SAML AUTH} failed to get identity provider metadata"
+	MessageFailedToParseIDPMetadata = "{SAML AUTH} failed to parse identity provider metadata"
+	MessageUnexpectedStatusCode     = "{SAML AUTH} unexpected status code %d from %s"
+)
@@ -0,0 +1,39 @@
+package saml
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+)
+
+const AuthenticationTypeSAML auth.AuthenticationType = "saml"
+
+const (
+	EnvSAMLEntityID             = "HORUSEC_SAML_ENTITY_ID"
+	EnvSAMLCertificate          = "HORUSEC_SAML_CERTIFICATE"
+	EnvSAMLPrivateKey           = "HORUSEC_SAML_PRIVATE_KEY" //nolint:gosec // false positive
+	EnvSAMLIDPMetadataURL       = "HORUSEC_SAML_IDP_METADATA_URL"
+	EnvSAMLIDPMetadata          = "HORUSEC_SAML_IDP_METADATA"
+	EnvSAMLUsernameAttribute    = "HORUSEC_SAML_USERNAME_ATTRIBUTE"
+	EnvSAMLEmailAttribute       = "HORUSEC_SAML_EMAIL_ATTRIBUTE"
+	EnvSAMLGroupsAttribute      = "HORUSEC_SAML_GROUPS_ATTRIBUTE"
+	EnvSAMLAdminGroup           = "HORUSEC_SAML_ADMIN_GROUP"
+	DefaultUsernameAttribute    = "username"
+	DefaultEmailAttribute       = "email"
+	DefaultGroupsAttribute      = "groups"
+	MetadataPath                = "/auth/authenticate/saml/metadata"
+	AssertionConsumerPath       = "/auth/authenticate/saml/acs"
+	ManagerLoginPath            = "/auth/saml?code=%s"
+	CacheKeyAuthenticationState = "saml-state-%s"
+	CacheKeyLoginCode           = "saml-code-%s"
+	PEMTypeCertificate          = "CERTIFICATE"
+	PEMTypeRSAPrivateKey        = "RSA PRIVATE KEY"
+	FormKeySAMLResponse         = "SAMLResponse"
+	FormKeyRelayState           = "RelayState"
+	ElementAssertion            = "Assertion"
+	ElementEncryptedAssertion   = "EncryptedAssertion"
+	ContentTypeMetadata         = "application/samlmetadata+xml"
+	AuthenticationRequestTime   = time.Minute * 10
+	LoginCodeDuration           = time.Minute
+	HTTPClientTimeout           = time.Second * 10
+)
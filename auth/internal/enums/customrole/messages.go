@@ -0,0 +1,6 @@
+package customrole
+
+const (
+	MessageFailedToGetCustomRolePermissions = "{CUSTOM_ROLE} failed to get the custom role permissions, " +
+		"checking only the built-in role"
+)
@@ -0,0 +1,44 @@
+package customrole
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+// workspacePresets are the built-in roles that grant the permissions in the workspace scope, any permission that
+// is not listed requires the workspace admin role
+var workspacePresets = map[permissionEnums.Permission]auth.AuthorizationType{
+	permissionEnums.WorkspaceRead:  auth.WorkspaceMember,
+	permissionEnums.RepositoryRead: auth.WorkspaceMember,
+}
+
+// repositoryPresets are the built-in roles that grant the permissions in the repository scope, any permission that
+// is not listed requires the repository admin role
+var repositoryPresets = map[permissionEnums.Permission]auth.AuthorizationType{
+	permissionEnums.RepositoryRead:              auth.RepositoryMember,
+	permissionEnums.AnalyticRead:                auth.RepositoryMember,
+	permissionEnums.VulnerabilityRead:           auth.RepositoryMember,
+	permissionEnums.VulnerabilityTypeUpdate:     auth.RepositorySupervisor,
+	permissionEnums.VulnerabilitySeverityUpdate: auth.RepositorySupervisor,
+	permissionEnums.RepositoryTransfer:          auth.WorkspaceAdmin,
+}
+
+// ToPreset returns the built-in role check that grants the permission, keeping the accounts without a custom role
+// authorized the same way as before the permissions existed
+func ToPreset(permission permissionEnums.Permission, isRepositoryScope bool) auth.AuthorizationType {
+	if isRepositoryScope {
+		return getPreset(permission, repositoryPresets, auth.RepositoryAdmin)
+	}
+
+	return getPreset(permission, workspacePresets, auth.WorkspaceAdmin)
+}
+
+func getPreset(permission permissionEnums.Permission, presets map[permissionEnums.Permission]auth.AuthorizationType,
+	defaultPreset auth.AuthorizationType) auth.AuthorizationType {
+	if preset, ok := presets[permission]; ok {
+		return preset
+	}
+
+	return defaultPreset
+}
@@ -0,0 +1,6 @@
+package encryption
+
+import "errors"
+
+var ErrorEncryptionKeyNotSet = errors.New("{ENCRYPTION} encryption key env not set")
+var ErrorInvalidEncryptedValue = errors.New("{ENCRYPTION} invalid encrypted value")
@@ -0,0 +1,5 @@
+package encryption
+
+const (
+	EnvEncryptionKey = "HORUSEC_AUTH_ENCRYPTION_KEY"
+)
@@ -0,0 +1,12 @@
+package lockout
+
+import "errors"
+
+var ErrorAccountLocked = errors.New(
+	"{LOCKOUT} account temporarily locked after too many failed attempts, try again later or check your email")
+var ErrorIPLocked = errors.New("{LOCKOUT} too many failed attempts from this address, try again later")
+var ErrorRetryDelay = errors.New("{LOCKOUT} too many failed attempts, wait a few seconds before trying again")
+var ErrorResetCodeAttemptsExceeded = errors.New(
+	"{LOCKOUT} too many invalid attempts for this reset code, request a new one")
+var ErrorInvalidUnlockToken = errors.New("{LOCKOUT} invalid or expired unlock token")
+var ErrorUnlockNotAllowed = errors.New("{LOCKOUT} only application admins can unlock other accounts")
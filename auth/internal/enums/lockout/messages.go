@@ -0,0 +1,8 @@
+package lockout
+
+const (
+	MessageFailedToRegisterFailure = "{LOCKOUT} failed to register failed attempt"
+	MessageFailedToClearFailures   = "{LOCKOUT} failed to clear failed attempts"
+	MessageFailedToSaveEvent       = "{LOCKOUT} failed to save lockout audit event"
+	MessageFailedToSendUnlockEmail = "{LOCKOUT} failed to send account unlock email"
+)
@@ -0,0 +1,53 @@
+package lockout
+
+import (
+	"time"
+
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+)
+
+const (
+	DatabaseTableLockoutEvents = "account_lockout_events"
+	MaxAccountFailedAttempts   = 5
+	MaxIPFailedAttempts        = 20
+	MaxResetCodeAttempts       = 5
+	FreeFailedAttempts         = 2
+	BaseDelay                  = time.Second
+	MaxDelay                   = time.Second * 30
+	FailedAttemptsWindow       = time.Minute * 15
+	LockoutDuration            = time.Minute * 15
+	CacheKeyAccountFailures    = "lockout-account-failures-%s"
+	CacheKeyAccountDelay       = "lockout-account-delay-%s"
+	CacheKeyAccountLocked      = "lockout-account-locked-%s"
+	CacheKeyIPFailures         = "lockout-ip-failures-%s"
+	CacheKeyIPLocked           = "lockout-ip-locked-%s"
+	CacheKeyUnlockToken        = "lockout-unlock-token-%s"
+	CacheKeyResetCode          = "reset-password-code-%s"
+	CacheKeyResetCodeAttempts  = "reset-password-code-attempts-%s"
+	Token                      = "token"
+)
+
+// EmailTemplateAccountUnlock isn't one of the devkit templates, it is registered by the messages service
+const EmailTemplateAccountUnlock emailEnums.Template = "account-unlock"
+
+type Target string
+
+const (
+	TargetAccount Target = "account"
+	TargetIP      Target = "ip"
+)
+
+type Action string
+
+const (
+	ActionLocked   Action = "locked"
+	ActionUnlocked Action = "unlocked"
+)
+
+type Method string
+
+const (
+	MethodFailedAttempts Method = "failed_attempts"
+	MethodAdmin          Method = "admin"
+	MethodEmail          Method = "email"
+)
@@ -0,0 +1,14 @@
+package mfa
+
+import "errors"
+
+var ErrorMFAInvalidCode = errors.New("{MFA} invalid authentication code")
+var ErrorMFAInvalidChallenge = errors.New("{MFA} invalid or expired mfa token")
+var ErrorMFATooManyAttempts = errors.New("{MFA} too many invalid attempts, login again to get a new mfa token")
+var ErrorMFAAlreadyEnabled = errors.New("{MFA} mfa already enabled for this account")
+var ErrorMFANotEnrolled = errors.New("{MFA} mfa enrollment not started for this account")
+var ErrorMFANotEnabled = errors.New("{MFA} mfa not enabled for this account")
+var ErrorMFAEnrollmentNotRequired = errors.New("{MFA} mfa token is not for an enrollment")
+var ErrorMFARequiredByPolicy = errors.New(
+	"{MFA} mfa is required by the workspace or application policy and can not be disabled")
+var ErrorMFAOnlyHorusecAuth = errors.New("{MFA} mfa is only available for horusec authentication type")
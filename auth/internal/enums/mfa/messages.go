@@ -0,0 +1,7 @@
+package mfa
+
+const (
+	MessageFailedToRollbackEnable        = "{MFA} failed to rollback mfa enable transaction"
+	MessageFailedToRollbackDisable       = "{MFA} failed to rollback mfa disable transaction"
+	MessageFailedToRollbackRecoveryCodes = "{MFA} failed to rollback mfa recovery codes transaction"
+)
@@ -0,0 +1,27 @@
+package mfa
+
+import "time"
+
+const (
+	DatabaseTableRecoveryCodes = "account_mfa_recovery_codes"
+	Issuer                     = "Horusec"
+	ProvisioningURIScheme      = "otpauth"
+	ProvisioningURIHost        = "totp"
+	Algorithm                  = "SHA1"
+	SecretSize                 = 20
+	CodeDigits                 = 6
+	CodeModulo                 = 1000000
+	CodeFormat                 = "%06d"
+	PeriodSeconds              = 30
+	ValidationWindow           = 1
+	RecoveryCodesCount         = 10
+	RecoveryCodeSize           = 10
+	RecoveryCodeCharset        = "abcdefghijkmnpqrstuvwxyz23456789"
+	RecoveryCodeSeparator      = "-"
+	MaxChallengeAttempts       = 5
+	CacheKeyChallenge          = "mfa-challenge-%s"
+	CacheKeyChallengeAttempts  = "mfa-challenge-attempts-%s"
+	CacheKeyUsedCode           = "mfa-used-code-%s-%d"
+	ChallengeDuration          = time.Minute * 5
+	UsedCodeDuration           = time.Second * PeriodSeconds * (ValidationWindow*2 + 1)
+)
@@ -0,0 +1,6 @@
+package password
+
+import "errors"
+
+var ErrorPasswordPolicy = errors.New("{PASSWORD} password doesn't satisfy the password policy")
+var ErrorInvalidChangeToken = errors.New("{PASSWORD} invalid or expired password change token")
@@ -0,0 +1,9 @@
+package password
+
+const (
+	MessageMinLength            = "must have at least %d characters"
+	MessageReused               = "must not be one of the last %d passwords"
+	MessageBreached             = "was found in a list of breached passwords"
+	MessageFailedToPruneHistory = "{PASSWORD} failed to remove old passwords from history"
+	MessageFailedToReadBreached = "{PASSWORD} failed to read breached passwords list"
+)
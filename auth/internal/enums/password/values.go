@@ -0,0 +1,28 @@
+package password
+
+import "time"
+
+const (
+	DatabaseTableHistory      = "account_password_history"
+	DefaultMinLength          = 8
+	MaxLength                 = 255
+	HashPrefixLength          = 5
+	HashFileExtension         = ".txt"
+	HashCountSeparator        = ":"
+	BundledBreachedHashesPath = "breached"
+	CacheKeyChangeToken       = "password-change-token-%s"
+	ChangeTokenDuration       = time.Minute * 10
+)
+
+// Violation identifies each failed rule of the policy, so the manager can show its own messages
+type Violation string
+
+const (
+	ViolationMinLength        Violation = "min_length"
+	ViolationUppercase        Violation = "uppercase"
+	ViolationLowercase        Violation = "lowercase"
+	ViolationNumber           Violation = "number"
+	ViolationSpecialCharacter Violation = "special_character"
+	ViolationReused           Violation = "reused"
+	ViolationBreached         Violation = "breached"
+)
@@ -0,0 +1,11 @@
+package personaltoken
+
+import "errors"
+
+var ErrorInvalidToken = errors.New("{PERSONAL_ACCESS_TOKEN} invalid, revoked or expired personal access token")
+var ErrorScopeNotAllowed = errors.New("{PERSONAL_ACCESS_TOKEN} the token scopes don't allow this request")
+var ErrorInvalidTokenID = errors.New("{PERSONAL_ACCESS_TOKEN} invalid token id")
+var ErrorTokenNotFound = errors.New("{PERSONAL_ACCESS_TOKEN} personal access token not found")
+var ErrorTokensLimitReached = errors.New("{PERSONAL_ACCESS_TOKEN} maximum number of active tokens reached")
+var ErrorNotSupported = errors.New(
+	"{PERSONAL_ACCESS_TOKEN} personal access tokens aren't supported with keycloak authentication")
@@ -0,0 +1,5 @@
+package personaltoken
+
+const (
+	MessageFailedToUpdateLastUsed = "{PERSONAL_ACCESS_TOKEN} failed to update token last used time"
+)
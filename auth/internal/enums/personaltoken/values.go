@@ -0,0 +1,33 @@
+package personaltoken
+
+import "time"
+
+const (
+	DatabaseTablePersonalAccessTokens = "personal_access_tokens"
+	ID                                = "tokenID"
+	TokenPrefix                       = "hpat_"
+	TokenSeparator                    = "."
+	TokenSecretSize                   = 32
+	MaxTokensPerAccount               = 50
+	LastUsedUpdateInterval            = time.Minute
+)
+
+type Scope string
+
+const (
+	ScopeReadDashboards     Scope = "dashboards:read"
+	ScopeTriage             Scope = "vulnerabilities:triage"
+	ScopeManageRepositories Scope = "repositories:manage"
+)
+
+func (s Scope) ToString() string {
+	return string(s)
+}
+
+func ScopeValues() []interface{} {
+	return []interface{}{
+		ScopeReadDashboards,
+		ScopeTriage,
+		ScopeManageRepositories,
+	}
+}
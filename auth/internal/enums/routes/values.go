@@ -4,4 +4,6 @@ const (
 	AuthenticationHandler = "/auth/authenticate"
 	AccountHandler        = "/auth/account"
 	HealthHandler         = "/auth/health"
+	SCIMHandler           = "/auth/scim"
+	AdminHandler          = "/auth/admin"
 )
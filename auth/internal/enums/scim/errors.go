@@ -0,0 +1,18 @@
+package scim
+
+import "errors"
+
+var ErrorInvalidToken = errors.New("{SCIM} invalid or revoked scim token")
+var ErrorNotAllowed = errors.New("{SCIM} only application admins can manage scim tokens")
+var ErrorInvalidTokenID = errors.New("{SCIM} invalid token id")
+var ErrorTokenNotFound = errors.New("{SCIM} scim token not found")
+var ErrorInvalidResourceID = errors.New("{SCIM} invalid resource id")
+var ErrorUserNotFound = errors.New("{SCIM} user not found")
+var ErrorGroupNotFound = errors.New("{SCIM} group not found")
+var ErrorUserAlreadyExists = errors.New("{SCIM} user name or email already in use")
+var ErrorGroupAlreadyExists = errors.New("{SCIM} group display name already in use")
+var ErrorInvalidFilter = errors.New("{SCIM} unsupported filter, only the eq operator on a single attribute is accepted")
+var ErrorInvalidPath = errors.New("{SCIM} unsupported patch operation path")
+var ErrorInvalidValue = errors.New("{SCIM} invalid value for the patch operation")
+var ErrorInvalidRoleMapping = errors.New("{SCIM} supervisor role can only be mapped to repositories")
+var ErrorMemberNotFound = errors.New("{SCIM} group member is not a provisioned user")
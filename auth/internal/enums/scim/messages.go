@@ -0,0 +1,5 @@
+package scim
+
+const (
+	MessageInternalServerError = "{SCIM} failed to handle scim request"
+)
@@ -0,0 +1,84 @@
+package scim
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	DatabaseTableTokens       = "scim_tokens"
+	DatabaseTableGroups       = "scim_groups"
+	DatabaseTableGroupMembers = "scim_group_members"
+	DatabaseTableGroupRoles   = "scim_group_roles"
+	ID                        = "id"
+	TokenID                   = "tokenID"
+	TokenPrefix               = "hscim_"
+	TokenSeparator            = "."
+	TokenSecretSize           = 32
+	ContentType               = "application/scim+json"
+	QueryFilter               = "filter"
+	QueryStartIndex           = "startIndex"
+	QueryCount                = "count"
+	DefaultStartIndex         = 1
+	DefaultCount              = 100
+	MaxCount                  = 1000
+	FilterPathMembers         = "members"
+	EmailTypeWork             = "work"
+)
+
+const (
+	SchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SchemaGroupRoles   = "urn:horusec:params:scim:schemas:extension:2.0:Group"
+	SchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SchemaPatchOp      = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+type ResourceType string
+
+const (
+	ResourceTypeUser  ResourceType = "User"
+	ResourceTypeGroup ResourceType = "Group"
+)
+
+type Operation string
+
+const (
+	OperationAdd     Operation = "add"
+	OperationRemove  Operation = "remove"
+	OperationReplace Operation = "replace"
+)
+
+func (o Operation) ToString() string {
+	return string(o)
+}
+
+// UnmarshalJSON accepts the operation in any case, some identity providers send "Replace" instead of "replace"
+func (o *Operation) UnmarshalJSON(data []byte) error {
+	var operation string
+	if err := json.Unmarshal(data, &operation); err != nil {
+		return err
+	}
+
+	*o = Operation(strings.ToLower(operation))
+	return nil
+}
+
+func OperationValues() []interface{} {
+	return []interface{}{
+		OperationAdd,
+		OperationRemove,
+		OperationReplace,
+	}
+}
+
+// ErrorType are the scimType values of the scim error responses, rfc7644 section 3.12
+type ErrorType string
+
+const (
+	ErrorTypeInvalidFilter ErrorType = "invalidFilter"
+	ErrorTypeInvalidValue  ErrorType = "invalidValue"
+	ErrorTypeInvalidPath   ErrorType = "invalidPath"
+	ErrorTypeUniqueness    ErrorType = "uniqueness"
+)
@@ -0,0 +1,9 @@
+package session
+
+import "errors"
+
+var ErrorInvalidRefreshToken = errors.New("{SESSION} invalid, revoked or expired refresh token")
+var ErrorRefreshTokenReused = errors.New("{SESSION} refresh token already used, session revoked")
+var ErrorInvalidSessionID = errors.New("{SESSION} invalid session id")
+var ErrorSessionNotFound = errors.New("{SESSION} session not found")
+var ErrorRevokeNotAllowed = errors.New("{SESSION} only application admins can revoke sessions of other accounts")
@@ -0,0 +1,6 @@
+package session
+
+const (
+	MessageRefreshTokenReused     = "{SESSION} refresh token reuse detected, revoking session"
+	MessageFailedToRevokeSessions = "{SESSION} failed to revoke sessions"
+)
@@ -0,0 +1,12 @@
+package session
+
+import "time"
+
+const (
+	DatabaseTableSessions = "sessions"
+	ID                    = "sessionID"
+	TokenSeparator        = "."
+	TokenSecretSize       = 32
+	MaxUserAgentLength    = 255
+	Duration              = time.Hour * 2
+)
@@ -1,38 +1,61 @@
 package account
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
-	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+	httpEntities "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	auditEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/audit"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
 	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
+	"github.com/ZupIT/horusec-platform/clientip"
 )
 
 type Handler struct {
-	useCases   accountUseCases.IUseCases
-	controller accountController.IController
-	appConfig  app.IConfig
+	useCases     accountUseCases.IUseCases
+	controller   accountController.IController
+	appConfig    app.IConfig
+	auditService auditService.IService
 }
 
 func NewAccountHandler(useCases accountUseCases.IUseCases, controller accountController.IController,
-	appConfig app.IConfig) *Handler {
+	appConfig app.IConfig, serviceAudit auditService.IService) *Handler {
 	return &Handler{
-		useCases:   useCases,
-		controller: controller,
-		appConfig:  appConfig,
+		useCases:     useCases,
+		controller:   controller,
+		appConfig:    appConfig,
+		auditService: serviceAudit,
 	}
 }
 
+func (h *Handler) publishAuditEvent(r *http.Request, action auditEnums.Action, targetType auditEnums.TargetType,
+	targetID, actorID uuid.UUID) {
+	h.auditService.Publish(r, auditEntities.NewEvent(action, targetType, targetID).SetActor(actorID))
+}
+
 func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
 	httpUtil.StatusNoContent(w)
 }
@@ -80,7 +103,7 @@ func (h *Handler) checkCreateAccountKeycloakErrors(w http.ResponseWriter, err er
 // @Produce  json
 // @Param AccountData body accountEntities.Data true "create account with horusec data"
 // @Success 200 {object} entities.Response
-// @Failure 400 {object} entities.Response
+// @Failure 400 {object} entities.Response{content=passwordEntities.PolicyError}
 // @Failure 500 {object} entities.Response
 // @Router /auth/account/create-account-horusec [post]
 // @Security ApiKeyAuth
@@ -101,6 +124,10 @@ func (h *Handler) CreateAccountHorusec(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) checkCreateAccountHorusecErrors(w http.ResponseWriter, err error) {
+	if h.checkPasswordPolicyError(w, err) {
+		return
+	}
+
 	if err == accountEnums.ErrorEmailAlreadyInUse || err == accountEnums.ErrorUsernameAlreadyInUse {
 		httpUtil.StatusBadRequest(w, err)
 		return
@@ -110,29 +137,70 @@ func (h *Handler) checkCreateAccountHorusecErrors(w http.ResponseWriter, err err
 }
 
 // @Tags Account
-// @Description Validate account email
+// @Description Validate account email, the token is single use and expires
 // @ID validate-account-email
 // @Accept  json
 // @Produce  json
-// @Param accountID path string true "ID of the account"
-// @Success 304 {object} entities.Response
+// @Param token path string true "email verification token"
+// @Success 303 {object} entities.Response
 // @Failure 400 {object} entities.Response
 // @Failure 500 {object} entities.Response
-// @Router /auth/account/validate/{accountID} [get]
-// @Security ApiKeyAuth
+// @Router /auth/account/validate/{token} [get]
 func (h *Handler) ValidateAccountEmail(w http.ResponseWriter, r *http.Request) {
-	accountID, err := uuid.Parse(chi.URLParam(r, accountEnums.ID))
+	if err := h.controller.ValidateAccountEmail(chi.URLParam(r, accountEnums.VerificationToken)); err != nil {
+		h.checkValidateAccountEmailErrors(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.appConfig.GetHorusecManagerURL(), http.StatusSeeOther)
+}
+
+func (h *Handler) checkValidateAccountEmailErrors(w http.ResponseWriter, err error) {
+	if err == accountEnums.ErrorInvalidVerificationToken {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Account
+// @Description Send a new validation email, invalidating the previous ones
+// @ID resend-validation-email
+// @Accept  json
+// @Produce  json
+// @Param Email body accountEntities.Email true "email of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/resend-validation [post]
+func (h *Handler) ResendValidationEmail(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.EmailFromIOReadCloser(r.Body)
 	if err != nil {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
 
-	if err := h.controller.ValidateAccountEmail(accountID); err != nil {
-		httpUtil.StatusInternalServerError(w, err)
+	if err := h.controller.ResendValidationEmail(data.SetIPAddress(clientip.Get(r))); err != nil {
+		h.checkResendValidationEmailErrors(w, err)
 		return
 	}
 
-	http.Redirect(w, r, h.appConfig.GetHorusecManagerURL(), http.StatusSeeOther)
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) checkResendValidationEmailErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case accountEnums.ErrorAccountAlreadyConfirmed:
+		httpUtil.StatusBadRequest(w, err)
+	case lockoutEnums.ErrorIPLocked:
+		httpUtil.StatusForbidden(w, err)
+	case databaseEnums.ErrorNotFoundRecords:
+		httpUtil.StatusNoContent(w)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
 }
 
 // @Tags Account
@@ -153,16 +221,25 @@ func (h *Handler) SendResetPasswordCode(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	if err := h.controller.SendResetPasswordCode(data.Email); err != nil {
-		httpUtil.StatusInternalServerError(w, err)
+	if err := h.controller.SendResetPasswordCode(data.SetIPAddress(clientip.Get(r))); err != nil {
+		h.checkSendResetPasswordCodeErrors(w, err)
 		return
 	}
 
 	httpUtil.StatusNoContent(w)
 }
 
+func (h *Handler) checkSendResetPasswordCodeErrors(w http.ResponseWriter, err error) {
+	if err == lockoutEnums.ErrorIPLocked {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
 // @Tags Account
-// @Description Check for valid reset password code
+// @Description Check for valid reset password code, returning a token that only allows changing the password
 // @ID check-reset-password-code
 // @Accept  json
 // @Produce  json
@@ -180,7 +257,7 @@ func (h *Handler) CheckResetPasswordCode(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	token, err := h.controller.CheckResetPasswordCode(data)
+	token, err := h.controller.CheckResetPasswordCode(data.SetIPAddress(clientip.Get(r)))
 	if err != nil {
 		h.checkResetPasswordCodeError(w, err)
 		return
@@ -190,7 +267,8 @@ func (h *Handler) CheckResetPasswordCode(w http.ResponseWriter, r *http.Request)
 }
 
 func (h *Handler) checkResetPasswordCodeError(w http.ResponseWriter, err error) {
-	if err == accountEnums.ErrorIncorrectRetrievePasswordCode {
+	if err == accountEnums.ErrorIncorrectRetrievePasswordCode || err == lockoutEnums.ErrorIPLocked ||
+		err == lockoutEnums.ErrorResetCodeAttemptsExceeded {
 		httpUtil.StatusForbidden(w, err)
 		return
 	}
@@ -205,7 +283,7 @@ func (h *Handler) checkResetPasswordCodeError(w http.ResponseWriter, err error)
 // @Produce  json
 // @Param ChangePasswordData body accountEntities.ChangePasswordData true "change password data"
 // @Success 204 {object} entities.Response
-// @Failure 400 {object} entities.Response
+// @Failure 400 {object} entities.Response{content=passwordEntities.PolicyError}
 // @Failure 500 {object} entities.Response
 // @Router /auth/account/change-password [post]
 // @Security ApiKeyAuth
@@ -221,6 +299,8 @@ func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.publishAuditEvent(r, auditEnums.ActionAccountPasswordChange, auditEnums.TargetAccount, data.AccountID,
+		data.AccountID)
 	httpUtil.StatusNoContent(w)
 }
 
@@ -239,6 +319,10 @@ func (h *Handler) getChangePasswordData(r *http.Request) (*accountEntities.Chang
 }
 
 func (h *Handler) checkChangePasswordDataErrors(w http.ResponseWriter, err error) {
+	if h.checkPasswordPolicyError(w, err) {
+		return
+	}
+
 	if err == accountEnums.ErrorPasswordEqualPrevious {
 		httpUtil.StatusBadRequest(w, err)
 		return
@@ -247,6 +331,71 @@ func (h *Handler) checkChangePasswordDataErrors(w http.ResponseWriter, err error
 	httpUtil.StatusInternalServerError(w, err)
 }
 
+// checkPasswordPolicyError returns each failed rule as the content of the bad request, so they can be shown apart
+func (h *Handler) checkPasswordPolicyError(w http.ResponseWriter, err error) bool {
+	var policyError *passwordEntities.PolicyError
+	if !errors.As(err, &policyError) {
+		return false
+	}
+
+	response := &httpEntities.Response{}
+	response.SetResponseData(http.StatusBadRequest, http.StatusText(http.StatusBadRequest), policyError)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(response)
+
+	return true
+}
+
+// @Tags Account
+// @Description Change the password with the token returned by the login with an expired password or by the reset
+// @Description password code, returning the login tokens or the mfa challenge when the second factor is required
+// @ID change-expired-password
+// @Accept  json
+// @Produce  json
+// @Param ExpiredPasswordData body passwordEntities.ExpiredPasswordData true "password change token and new password"
+// @Success 200 {object} entities.Response{content=authentication.LoginResponse}
+// @Failure 400 {object} entities.Response{content=passwordEntities.PolicyError}
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/change-expired-password [post]
+func (h *Handler) ChangeExpiredPassword(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getExpiredPasswordData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.ChangeExpiredPassword(data)
+	if err != nil {
+		h.checkChangeExpiredPasswordErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+func (h *Handler) getExpiredPasswordData(r *http.Request) (*passwordEntities.ExpiredPasswordData, error) {
+	data := &passwordEntities.ExpiredPasswordData{}
+
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	data.SetDevice(r.UserAgent(), clientip.Get(r))
+	return data, data.Validate()
+}
+
+func (h *Handler) checkChangeExpiredPasswordErrors(w http.ResponseWriter, err error) {
+	if err == passwordEnums.ErrorInvalidChangeToken {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	h.checkChangePasswordDataErrors(w, err)
+}
+
 // @Tags Account
 // @Description Refresh access token
 // @ID refresh-token
@@ -396,3 +545,497 @@ func (h *Handler) getUpdateAccountData(r *http.Request) (*accountEntities.Update
 
 	return data.SetAccountIDAndIsConfirmed(accountID, h.appConfig.IsEmailsDisabled()), nil
 }
+
+// @Tags Account
+// @Description Start the mfa enrollment, returning the secret to register in the authenticator app
+// @ID enroll-mfa
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response{content=mfa.EnrollmentResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/mfa/enroll [post]
+// @Security ApiKeyAuth
+func (h *Handler) EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	response, err := h.controller.EnrollMFA(accountID)
+	if err != nil {
+		h.checkMFAErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+// @Tags Account
+// @Description Enable mfa confirming the enrollment with a code of the authenticator app, returning the recovery codes
+// @ID enable-mfa
+// @Accept  json
+// @Produce  json
+// @Param CodeData body mfa.CodeData true "authenticator code"
+// @Success 200 {object} entities.Response{content=mfa.RecoveryCodesResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/mfa/enable [post]
+// @Security ApiKeyAuth
+func (h *Handler) EnableMFA(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getMFACodeData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.EnableMFA(data)
+	if err != nil {
+		h.checkMFAErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+// @Tags Account
+// @Description Disable mfa using a code of the authenticator app or a recovery code
+// @ID disable-mfa
+// @Accept  json
+// @Produce  json
+// @Param CodeData body mfa.CodeData true "authenticator or recovery code"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/mfa/disable [post]
+// @Security ApiKeyAuth
+func (h *Handler) DisableMFA(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getMFACodeData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.DisableMFA(data); err != nil {
+		h.checkMFAErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Account
+// @Description Replace the mfa recovery codes, invalidating the previous ones
+// @ID regenerate-mfa-recovery-codes
+// @Accept  json
+// @Produce  json
+// @Param CodeData body mfa.CodeData true "authenticator code"
+// @Success 200 {object} entities.Response{content=mfa.RecoveryCodesResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/mfa/recovery-codes [post]
+// @Security ApiKeyAuth
+func (h *Handler) RegenerateMFARecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getMFACodeData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.RegenerateMFARecoveryCodes(data)
+	if err != nil {
+		h.checkMFAErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+func (h *Handler) getMFACodeData(r *http.Request) (*mfaEntities.CodeData, error) {
+	accountID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		return nil, err
+	}
+
+	data := &mfaEntities.CodeData{}
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	return data.SetAccountID(accountID), data.Validate()
+}
+
+func (h *Handler) checkMFAErrors(w http.ResponseWriter, err error) {
+	if err == mfaEnums.ErrorMFAInvalidCode {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	if h.isMFABadRequestError(err) {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+func (h *Handler) isMFABadRequestError(err error) bool {
+	switch err {
+	case mfaEnums.ErrorMFAOnlyHorusecAuth, mfaEnums.ErrorMFAAlreadyEnabled, mfaEnums.ErrorMFANotEnrolled,
+		mfaEnums.ErrorMFANotEnabled, mfaEnums.ErrorMFARequiredByPolicy:
+		return true
+	}
+
+	return false
+}
+
+// @Tags Account
+// @Description Unlock an account locked after too many failed attempts, only allowed to application admins
+// @ID unlock-account
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/unlock-account/{accountID} [post]
+// @Security ApiKeyAuth
+func (h *Handler) UnlockAccount(w http.ResponseWriter, r *http.Request) {
+	actorID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := h.unlockAccount(r, actorID); err != nil {
+		h.checkUnlockAccountErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) unlockAccount(r *http.Request, actorID uuid.UUID) error {
+	accountID, err := uuid.Parse(chi.URLParam(r, accountEnums.ID))
+	if err != nil {
+		return accountEnums.ErrorInvalidAccountID
+	}
+
+	return h.controller.UnlockAccount(accountID, actorID)
+}
+
+func (h *Handler) checkUnlockAccountErrors(w http.ResponseWriter, err error) {
+	if err == accountEnums.ErrorInvalidAccountID {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err == lockoutEnums.ErrorUnlockNotAllowed {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Account
+// @Description Unlock an account with the token sent by email when it was locked
+// @ID unlock-account-with-token
+// @Accept  json
+// @Produce  json
+// @Param token path string true "unlock token"
+// @Success 303 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/unlock/{token} [get]
+func (h *Handler) UnlockAccountWithToken(w http.ResponseWriter, r *http.Request) {
+	if err := h.controller.UnlockAccountWithToken(chi.URLParam(r, lockoutEnums.Token)); err != nil {
+		h.checkUnlockAccountWithTokenErrors(w, err)
+		return
+	}
+
+	http.Redirect(w, r, h.appConfig.GetHorusecManagerURL(), http.StatusSeeOther)
+}
+
+func (h *Handler) checkUnlockAccountWithTokenErrors(w http.ResponseWriter, err error) {
+	if err == lockoutEnums.ErrorInvalidUnlockToken {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Account
+// @Description List the active sessions of the logged account
+// @ID list-sessions
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/sessions [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	sessions, err := h.controller.ListSessions(accountID)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, sessions)
+}
+
+// @Tags Account
+// @Description Revoke one of the sessions of the logged account
+// @ID revoke-session
+// @Accept  json
+// @Produce  json
+// @Param sessionID path string true "ID of the session"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/sessions/{sessionID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := h.revokeSession(r, accountID); err != nil {
+		h.checkRevokeSessionErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) revokeSession(r *http.Request, accountID uuid.UUID) error {
+	sessionID, err := uuid.Parse(chi.URLParam(r, sessionEnums.ID))
+	if err != nil {
+		return sessionEnums.ErrorInvalidSessionID
+	}
+
+	if err := h.controller.RevokeSession(accountID, sessionID); err != nil {
+		return err
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionAccountSessionRevoke, auditEnums.TargetSession, sessionID, accountID)
+	return nil
+}
+
+func (h *Handler) checkRevokeSessionErrors(w http.ResponseWriter, err error) {
+	if err == sessionEnums.ErrorInvalidSessionID {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err == sessionEnums.ErrorSessionNotFound {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Account
+// @Description Revoke every session of an account, only allowed to application admins
+// @ID revoke-account-sessions
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/revoke-sessions/{accountID} [post]
+// @Security ApiKeyAuth
+func (h *Handler) RevokeAccountSessions(w http.ResponseWriter, r *http.Request) {
+	actorID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := h.revokeAccountSessions(r, actorID); err != nil {
+		h.checkRevokeAccountSessionsErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) revokeAccountSessions(r *http.Request, actorID uuid.UUID) error {
+	accountID, err := uuid.Parse(chi.URLParam(r, accountEnums.ID))
+	if err != nil {
+		return accountEnums.ErrorInvalidAccountID
+	}
+
+	if err := h.controller.RevokeAccountSessions(accountID, actorID); err != nil {
+		return err
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionAccountSessionsRevokeAll, auditEnums.TargetAccount, accountID, actorID)
+	return nil
+}
+
+func (h *Handler) checkRevokeAccountSessionsErrors(w http.ResponseWriter, err error) {
+	if err == accountEnums.ErrorInvalidAccountID {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err == sessionEnums.ErrorRevokeNotAllowed {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Account
+// @Description Create a personal access token for automations, the token is only returned once
+// @ID create-personal-access-token
+// @Accept  json
+// @Produce  json
+// @Param Data body personalTokenEntities.Data true "personal access token data"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/personal-access-tokens [post]
+// @Security ApiKeyAuth
+func (h *Handler) CreatePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getPersonalAccessTokenData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.CreatePersonalAccessToken(r.Header.Get(enums.HorusecJWTHeader), data)
+	if err != nil {
+		h.checkCreatePersonalAccessTokenErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusCreated(w, response)
+}
+
+func (h *Handler) getPersonalAccessTokenData(r *http.Request) (*personalTokenEntities.Data, error) {
+	if _, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader)); err != nil {
+		return nil, err
+	}
+
+	data := &personalTokenEntities.Data{}
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (h *Handler) checkCreatePersonalAccessTokenErrors(w http.ResponseWriter, err error) {
+	if err == personalTokenEnums.ErrorNotSupported || err == personalTokenEnums.ErrorTokensLimitReached {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Account
+// @Description List the personal access tokens of the logged account
+// @ID list-personal-access-tokens
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/personal-access-tokens [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListPersonalAccessTokens(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	tokens, err := h.controller.ListPersonalAccessTokens(accountID)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, tokens)
+}
+
+// @Tags Account
+// @Description Revoke one of the personal access tokens of the logged account
+// @ID revoke-personal-access-token
+// @Accept  json
+// @Produce  json
+// @Param tokenID path string true "ID of the token"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/account/personal-access-tokens/{tokenID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) RevokePersonalAccessToken(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.controller.GetAccountID(r.Header.Get(enums.HorusecJWTHeader))
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := h.revokePersonalAccessToken(r, accountID); err != nil {
+		h.checkRevokePersonalAccessTokenErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) revokePersonalAccessToken(r *http.Request, accountID uuid.UUID) error {
+	tokenID, err := uuid.Parse(chi.URLParam(r, personalTokenEnums.ID))
+	if err != nil {
+		return personalTokenEnums.ErrorInvalidTokenID
+	}
+
+	return h.controller.RevokePersonalAccessToken(accountID, tokenID)
+}
+
+func (h *Handler) checkRevokePersonalAccessTokenErrors(w http.ResponseWriter, err error) {
+	if err == personalTokenEnums.ErrorInvalidTokenID {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err == personalTokenEnums.ErrorTokenNotFound {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
@@ -3,6 +3,7 @@ package account
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -13,16 +14,34 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	"github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
 	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
 )
 
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
 func getAppConfig() app.IConfig {
 	databaseMock := &database.Mock{}
 	databaseMock.On("Create").Return(&response.Response{})
@@ -30,9 +49,15 @@ func getAppConfig() app.IConfig {
 	return app.NewAuthAppConfig(&database.Connection{Read: databaseMock, Write: databaseMock})
 }
 
+func newPolicyError() error {
+	return passwordEntities.NewPolicyError([]*passwordEntities.Violation{
+		passwordEntities.NewViolation(passwordEnums.ViolationMinLength, "test"),
+	})
+}
+
 func TestNewAccountHandler(t *testing.T) {
 	t.Run("should success create a new handler", func(t *testing.T) {
-		assert.NotNil(t, NewAccountHandler(nil, nil, nil))
+		assert.NotNil(t, NewAccountHandler(nil, nil, nil, nil))
 	})
 }
 
@@ -45,7 +70,8 @@ func TestCreateAccountKeycloak(t *testing.T) {
 
 		data := &accountEntities.AccessToken{AccessToken: "test"}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -64,7 +90,8 @@ func TestCreateAccountKeycloak(t *testing.T) {
 
 		data := &accountEntities.AccessToken{AccessToken: "test"}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -83,7 +110,8 @@ func TestCreateAccountKeycloak(t *testing.T) {
 
 		data := &accountEntities.AccessToken{AccessToken: "test"}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -97,7 +125,8 @@ func TestCreateAccountKeycloak(t *testing.T) {
 		appConfig := getAppConfig()
 		controllerMock := &accountController.Mock{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -121,7 +150,8 @@ func TestCreateAccountHorusec(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -131,6 +161,30 @@ func TestCreateAccountHorusec(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("should return 400 with the violations when password doesn't satisfy the policy", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("CreateAccountHorusec").Return(&accountEntities.Response{}, newPolicyError())
+
+		data := &accountEntities.Data{
+			Email:    "test@test.com",
+			Password: "test",
+			Username: "test",
+		}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.CreateAccountHorusec(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(passwordEnums.ViolationMinLength))
+	})
+
 	t.Run("should return 400 when username or email already in use", func(t *testing.T) {
 		appConfig := getAppConfig()
 
@@ -144,7 +198,8 @@ func TestCreateAccountHorusec(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -167,7 +222,8 @@ func TestCreateAccountHorusec(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -183,7 +239,8 @@ func TestCreateAccountHorusec(t *testing.T) {
 
 		data := &accountEntities.Data{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -201,13 +258,14 @@ func TestValidateAccountEmail(t *testing.T) {
 		controllerMock := &accountController.Mock{}
 		controllerMock.On("ValidateAccountEmail").Return(nil)
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
 
 		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("accountID", uuid.NewString())
+		ctx.URLParams.Add("token", "test")
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		handler.ValidateAccountEmail(w, r)
@@ -221,13 +279,14 @@ func TestValidateAccountEmail(t *testing.T) {
 		controllerMock := &accountController.Mock{}
 		controllerMock.On("ValidateAccountEmail").Return(errors.New("test"))
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
 
 		ctx := chi.NewRouteContext()
-		ctx.URLParams.Add("accountID", uuid.NewString())
+		ctx.URLParams.Add("token", "test")
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		handler.ValidateAccountEmail(w, r)
@@ -235,11 +294,14 @@ func TestValidateAccountEmail(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 
-	t.Run("should return 400 when invalid account id", func(t *testing.T) {
+	t.Run("should return 400 when invalid or expired token", func(t *testing.T) {
 		appConfig := getAppConfig()
+
 		controllerMock := &accountController.Mock{}
+		controllerMock.On("ValidateAccountEmail").Return(accountEnums.ErrorInvalidVerificationToken)
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -250,6 +312,117 @@ func TestValidateAccountEmail(t *testing.T) {
 	})
 }
 
+func TestResendValidationEmail(t *testing.T) {
+	t.Run("should return 204 when success resend email", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ResendValidationEmail").Return(nil)
+
+		data := &accountEntities.Email{Email: "test@test.com"}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.ResendValidationEmail(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 204 when account not found", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ResendValidationEmail").Return(databaseEnums.ErrorNotFoundRecords)
+
+		data := &accountEntities.Email{Email: "test@test.com"}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.ResendValidationEmail(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when account already confirmed", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ResendValidationEmail").Return(accountEnums.ErrorAccountAlreadyConfirmed)
+
+		data := &accountEntities.Email{Email: "test@test.com"}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.ResendValidationEmail(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when ip is locked", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ResendValidationEmail").Return(lockoutEnums.ErrorIPLocked)
+
+		data := &accountEntities.Email{Email: "test@test.com"}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.ResendValidationEmail(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ResendValidationEmail").Return(errors.New("test"))
+
+		data := &accountEntities.Email{Email: "test@test.com"}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.ResendValidationEmail(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid request body", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), &accountController.Mock{},
+			appConfig, newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("test")))
+		w := httptest.NewRecorder()
+
+		handler.ResendValidationEmail(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 func TestSendResetPasswordCode(t *testing.T) {
 	t.Run("should return 204 when success sent code", func(t *testing.T) {
 		appConfig := getAppConfig()
@@ -261,7 +434,8 @@ func TestSendResetPasswordCode(t *testing.T) {
 			Email: "test@test.com",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -271,6 +445,27 @@ func TestSendResetPasswordCode(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 
+	t.Run("should return 403 when ip is locked", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("SendResetPasswordCode").Return(lockoutEnums.ErrorIPLocked)
+
+		data := &accountEntities.Email{
+			Email: "test@test.com",
+		}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.SendResetPasswordCode(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		appConfig := getAppConfig()
 
@@ -281,7 +476,8 @@ func TestSendResetPasswordCode(t *testing.T) {
 			Email: "test@test.com",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -297,7 +493,8 @@ func TestSendResetPasswordCode(t *testing.T) {
 
 		data := &accountEntities.Email{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -320,7 +517,8 @@ func TestCheckResetPasswordCode(t *testing.T) {
 			Code:  "123456",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -330,6 +528,28 @@ func TestCheckResetPasswordCode(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("should return 403 when reset code attempts exceeded", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("CheckResetPasswordCode").Return("", lockoutEnums.ErrorResetCodeAttemptsExceeded)
+
+		data := &accountEntities.ResetCodeData{
+			Email: "test@test.com",
+			Code:  "123456",
+		}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.CheckResetPasswordCode(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("should return 403 when wrong code", func(t *testing.T) {
 		appConfig := getAppConfig()
 
@@ -342,7 +562,8 @@ func TestCheckResetPasswordCode(t *testing.T) {
 			Code:  "123456",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -363,7 +584,8 @@ func TestCheckResetPasswordCode(t *testing.T) {
 			Code:  "123456",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -379,7 +601,8 @@ func TestCheckResetPasswordCode(t *testing.T) {
 
 		data := &accountEntities.ResetCodeData{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -402,7 +625,8 @@ func TestChangePassword(t *testing.T) {
 			Password: "Test@123",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -423,7 +647,30 @@ func TestChangePassword(t *testing.T) {
 			Password: "Test@123",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.ChangePassword(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 with the violations when password doesn't satisfy the policy", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ChangePassword").Return(newPolicyError())
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		data := &accountEntities.ChangePasswordData{
+			Password: "test",
+		}
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -431,6 +678,7 @@ func TestChangePassword(t *testing.T) {
 		handler.ChangePassword(w, r)
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(passwordEnums.ViolationMinLength))
 	})
 
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
@@ -444,7 +692,8 @@ func TestChangePassword(t *testing.T) {
 			Password: "Test@123",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -462,7 +711,8 @@ func TestChangePassword(t *testing.T) {
 
 		data := &accountEntities.ChangePasswordData{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -480,7 +730,8 @@ func TestChangePassword(t *testing.T) {
 
 		data := &accountEntities.ChangePasswordData{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -491,6 +742,83 @@ func TestChangePassword(t *testing.T) {
 	})
 }
 
+func TestChangeExpiredPassword(t *testing.T) {
+	data := &passwordEntities.ExpiredPasswordData{PasswordChangeToken: uuid.NewString(), Password: "Test@123"}
+
+	t.Run("should return 200 when success change expired password", func(t *testing.T) {
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ChangeExpiredPassword").Return(&authentication.LoginResponse{}, nil)
+
+		handler := NewAccountHandler(nil, controllerMock, nil, newAuditServiceMock())
+
+		body, _ := json.Marshal(data)
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+
+		handler.ChangeExpiredPassword(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid request body", func(t *testing.T) {
+		handler := NewAccountHandler(nil, &accountController.Mock{}, nil, newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+
+		handler.ChangeExpiredPassword(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when password doesn't satisfy the policy", func(t *testing.T) {
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ChangeExpiredPassword").Return(&authentication.LoginResponse{}, newPolicyError())
+
+		handler := NewAccountHandler(nil, controllerMock, nil, newAuditServiceMock())
+
+		body, _ := json.Marshal(data)
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+
+		handler.ChangeExpiredPassword(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), string(passwordEnums.ViolationMinLength))
+	})
+
+	t.Run("should return 403 when invalid password change token", func(t *testing.T) {
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ChangeExpiredPassword").Return(&authentication.LoginResponse{},
+			passwordEnums.ErrorInvalidChangeToken)
+
+		handler := NewAccountHandler(nil, controllerMock, nil, newAuditServiceMock())
+
+		body, _ := json.Marshal(data)
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+
+		handler.ChangeExpiredPassword(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("ChangeExpiredPassword").Return(&authentication.LoginResponse{}, errors.New("test"))
+
+		handler := NewAccountHandler(nil, controllerMock, nil, newAuditServiceMock())
+
+		body, _ := json.Marshal(data)
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+
+		handler.ChangeExpiredPassword(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
 func TestRefreshToken(t *testing.T) {
 	t.Run("should return 200 when success set refresh token", func(t *testing.T) {
 		appConfig := getAppConfig()
@@ -502,7 +830,8 @@ func TestRefreshToken(t *testing.T) {
 			RefreshToken: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -522,7 +851,8 @@ func TestRefreshToken(t *testing.T) {
 			RefreshToken: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -540,7 +870,8 @@ func TestRefreshToken(t *testing.T) {
 
 		data := &accountEntities.RefreshToken{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -562,7 +893,8 @@ func TestLogout(t *testing.T) {
 			RefreshToken: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -580,7 +912,8 @@ func TestLogout(t *testing.T) {
 
 		data := &accountEntities.RefreshToken{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -603,7 +936,8 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -624,7 +958,8 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -645,7 +980,8 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -661,7 +997,8 @@ func TestCheckExistingEmailOrUsername(t *testing.T) {
 
 		data := &accountEntities.CheckEmailAndUsername{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -680,7 +1017,8 @@ func TestDeleteAccount(t *testing.T) {
 		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
 		controllerMock.On("DeleteAccount").Return(nil)
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -697,7 +1035,8 @@ func TestDeleteAccount(t *testing.T) {
 		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
 		controllerMock.On("DeleteAccount").Return(errors.New("test"))
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -713,7 +1052,8 @@ func TestDeleteAccount(t *testing.T) {
 		controllerMock := &accountController.Mock{}
 		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -737,7 +1077,8 @@ func TestUpdateAccount(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -759,7 +1100,8 @@ func TestUpdateAccount(t *testing.T) {
 			Username: "test",
 		}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -777,7 +1119,8 @@ func TestUpdateAccount(t *testing.T) {
 
 		data := &accountEntities.UpdateAccount{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -795,7 +1138,8 @@ func TestUpdateAccount(t *testing.T) {
 
 		data := &accountEntities.UpdateAccount{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -811,7 +1155,8 @@ func TestOptions(t *testing.T) {
 		appConfig := getAppConfig()
 		controllerMock := &accountController.Mock{}
 
-		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig)
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodOptions, "test", nil)
 		w := httptest.NewRecorder()
@@ -821,3 +1166,824 @@ func TestOptions(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
+
+func TestEnrollMFA(t *testing.T) {
+	t.Run("should return 200 when success enroll mfa", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("EnrollMFA").Return(&mfaEntities.EnrollmentResponse{}, nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.EnrollMFA(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when mfa already enabled", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("EnrollMFA").Return(&mfaEntities.EnrollmentResponse{}, mfaEnums.ErrorMFAAlreadyEnabled)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.EnrollMFA(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.EnrollMFA(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestEnableMFA(t *testing.T) {
+	t.Run("should return 200 when success enable mfa", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("EnableMFA").Return(&mfaEntities.RecoveryCodesResponse{}, nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.EnableMFA(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 403 when invalid code", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("EnableMFA").Return(&mfaEntities.RecoveryCodesResponse{}, mfaEnums.ErrorMFAInvalidCode)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.EnableMFA(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 400 when invalid request body", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+
+		handler.EnableMFA(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDisableMFA(t *testing.T) {
+	t.Run("should return 204 when success disable mfa", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("DisableMFA").Return(nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.DisableMFA(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when required by policy", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("DisableMFA").Return(mfaEnums.ErrorMFARequiredByPolicy)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.DisableMFA(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.DisableMFA(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRegenerateMFARecoveryCodes(t *testing.T) {
+	t.Run("should return 200 when success regenerate recovery codes", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RegenerateMFARecoveryCodes").Return(&mfaEntities.RecoveryCodesResponse{}, nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.RegenerateMFARecoveryCodes(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RegenerateMFARecoveryCodes").Return(
+			&mfaEntities.RecoveryCodesResponse{}, errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(`{"code": "123456"}`)))
+		w := httptest.NewRecorder()
+
+		handler.RegenerateMFARecoveryCodes(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestUnlockAccount(t *testing.T) {
+	newRequest := func(accountID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("accountID", accountID)
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 204 when success unlock account", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("UnlockAccount").Return(nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccount(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 403 when not application admin", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("UnlockAccount").Return(lockoutEnums.ErrorUnlockNotAllowed)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccount(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("UnlockAccount").Return(errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccount(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccount(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccount(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestUnlockAccountWithToken(t *testing.T) {
+	t.Run("should return 303 when success unlock account", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("UnlockAccountWithToken").Return(nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccountWithToken(w, r)
+
+		assert.Equal(t, http.StatusSeeOther, w.Code)
+	})
+
+	t.Run("should return 400 when invalid token", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("UnlockAccountWithToken").Return(lockoutEnums.ErrorInvalidUnlockToken)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccountWithToken(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("UnlockAccountWithToken").Return(errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.UnlockAccountWithToken(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestListSessions(t *testing.T) {
+	t.Run("should return 200 when success list sessions", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("ListSessions").Return([]*sessionEntities.Session{{SessionID: uuid.New()}}, nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListSessions(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "refreshTokenHash")
+	})
+
+	t.Run("should return 500 when failed to list sessions", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("ListSessions").Return([]*sessionEntities.Session{}, errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListSessions(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListSessions(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	newRequest := func(sessionID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("sessionID", sessionID)
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 204 when success revoke session", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokeSession").Return(nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeSession(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when session not found", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokeSession").Return(sessionEnums.ErrorSessionNotFound)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeSession(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokeSession").Return(errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeSession(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid session id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeSession(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeSession(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestRevokeAccountSessions(t *testing.T) {
+	newRequest := func(accountID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("accountID", accountID)
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 204 when success revoke account sessions", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokeAccountSessions").Return(nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeAccountSessions(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 403 when not application admin", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokeAccountSessions").Return(sessionEnums.ErrorRevokeNotAllowed)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeAccountSessions(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokeAccountSessions").Return(errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeAccountSessions(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeAccountSessions(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeAccountSessions(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	newRequest := func(body string) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte(body)))
+		return r
+	}
+
+	validBody := `{"name": "test", "scopes": ["dashboards:read"]}`
+
+	t.Run("should return 201 when success create token", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("CreatePersonalAccessToken").Return(&personalTokenEntities.CreateResponse{}, nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreatePersonalAccessToken(w, newRequest(validBody))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 400 when tokens limit reached", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("CreatePersonalAccessToken").Return(&personalTokenEntities.CreateResponse{},
+			personalTokenEnums.ErrorTokensLimitReached)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreatePersonalAccessToken(w, newRequest(validBody))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("CreatePersonalAccessToken").Return(&personalTokenEntities.CreateResponse{},
+			errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreatePersonalAccessToken(w, newRequest(validBody))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid scope", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreatePersonalAccessToken(w, newRequest(`{"name": "test", "scopes": ["test"]}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreatePersonalAccessToken(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreatePersonalAccessToken(w, newRequest(validBody))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	t.Run("should return 200 when success list tokens", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("ListPersonalAccessTokens").Return(
+			[]*personalTokenEntities.PersonalAccessToken{{TokenID: uuid.New(), SecretHash: "test"}}, nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListPersonalAccessTokens(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NotContains(t, w.Body.String(), "secretHash")
+	})
+
+	t.Run("should return 500 when failed to list tokens", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("ListPersonalAccessTokens").Return(
+			[]*personalTokenEntities.PersonalAccessToken{}, errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListPersonalAccessTokens(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListPersonalAccessTokens(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	newRequest := func(tokenID string) *http.Request {
+		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("tokenID", tokenID)
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 204 when success revoke token", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokePersonalAccessToken").Return(nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokePersonalAccessToken(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when token not found", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokePersonalAccessToken").Return(personalTokenEnums.ErrorTokenNotFound)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokePersonalAccessToken(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+		controllerMock.On("RevokePersonalAccessToken").Return(errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokePersonalAccessToken(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid token id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokePersonalAccessToken(w, newRequest("test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account id", func(t *testing.T) {
+		appConfig := getAppConfig()
+
+		controllerMock := &accountController.Mock{}
+		controllerMock.On("GetAccountID").Return(uuid.New(), errors.New("test"))
+
+		handler := NewAccountHandler(accountUseCases.NewAccountUseCases(appConfig), controllerMock, appConfig,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokePersonalAccessToken(w, newRequest(uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
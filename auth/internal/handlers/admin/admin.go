@@ -0,0 +1,279 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	adminController "github.com/ZupIT/horusec-platform/auth/internal/controllers/admin"
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+	auditEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/audit"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	adminEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/admin"
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
+)
+
+type Handler struct {
+	controller        adminController.IController
+	accountController accountController.IController
+	auditService      auditService.IService
+}
+
+func NewAdminHandler(controller adminController.IController, controllerAccount accountController.IController,
+	serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:        controller,
+		accountController: controllerAccount,
+		auditService:      serviceAudit,
+	}
+}
+
+// @Tags Admin
+// @Description List the accounts searching by username or email, only allowed to application admins
+// @ID admin-list-accounts
+// @Accept  json
+// @Produce  json
+// @Param search query string false "part of the username or email"
+// @Param page query string false "page of the list, starting at 1"
+// @Param size query string false "size of the page, up to 100"
+// @Success 200 {object} entities.Response{content=adminEntities.AccountsResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListAccounts(w http.ResponseWriter, r *http.Request) {
+	actor, err := h.getActor(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	response, err := h.listAccounts(r, actor)
+	if err != nil {
+		h.checkAdminErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+func (h *Handler) getActor(r *http.Request) (*authEntities.Actor, error) {
+	token := r.Header.Get(enums.HorusecJWTHeader)
+
+	accountID, err := h.accountController.GetAccountID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return authEntities.NewActor(accountID, token), nil
+}
+
+func (h *Handler) listAccounts(r *http.Request, actor *authEntities.Actor) (*adminEntities.AccountsResponse, error) {
+	filter, err := adminEntities.NewFilter(r.URL.Query().Get(adminEnums.SearchQuery),
+		r.URL.Query().Get(adminEnums.PageQuery), r.URL.Query().Get(adminEnums.SizeQuery))
+	if err != nil {
+		return nil, err
+	}
+
+	return h.controller.ListAccounts(actor, filter)
+}
+
+// @Tags Admin
+// @Description Get the workspaces and repositories of an account, only allowed to application admins
+// @ID admin-get-account-memberships
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 200 {object} entities.Response{content=adminEntities.Memberships}
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts/{accountID}/memberships [get]
+// @Security ApiKeyAuth
+func (h *Handler) GetMemberships(w http.ResponseWriter, r *http.Request) {
+	var memberships *adminEntities.Memberships
+
+	h.handleAccountAction(w, r, func(accountID uuid.UUID, actor *authEntities.Actor) (err error) {
+		memberships, err = h.controller.GetMemberships(accountID, actor)
+		return err
+	}, func() { httpUtil.StatusOK(w, memberships) })
+}
+
+// @Tags Admin
+// @Description Disable an account and end its sessions, only allowed to application admins
+// @ID admin-disable-account
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts/{accountID}/disable [post]
+// @Security ApiKeyAuth
+func (h *Handler) DisableAccount(w http.ResponseWriter, r *http.Request) {
+	h.handleAuditedAccountAction(w, r, auditEnums.ActionAccountDisable, h.controller.DisableAccount, nil)
+}
+
+// @Tags Admin
+// @Description Enable a disabled account, only allowed to application admins
+// @ID admin-enable-account
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts/{accountID}/enable [post]
+// @Security ApiKeyAuth
+func (h *Handler) EnableAccount(w http.ResponseWriter, r *http.Request) {
+	h.handleAuditedAccountAction(w, r, auditEnums.ActionAccountEnable, h.controller.EnableAccount, nil)
+}
+
+// @Tags Admin
+// @Description Promote or demote an account as application admin, only allowed to application admins
+// @ID admin-set-application-admin
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Param ApplicationAdminData body adminEntities.ApplicationAdminData true "application admin flag"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts/{accountID}/application-admin [patch]
+// @Security ApiKeyAuth
+func (h *Handler) SetApplicationAdmin(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getApplicationAdminData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.handleAuditedAccountAction(w, r, auditEnums.ActionAccountApplicationAdmin,
+		func(accountID uuid.UUID, actor *authEntities.Actor) error {
+			return h.controller.SetApplicationAdmin(accountID, actor, data)
+		}, data)
+}
+
+func (h *Handler) getApplicationAdminData(r *http.Request) (*adminEntities.ApplicationAdminData, error) {
+	data := &adminEntities.ApplicationAdminData{}
+
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+// @Tags Admin
+// @Description Replace the password of an account and send it a reset password code, only allowed to application admins
+// @ID admin-force-password-reset
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts/{accountID}/force-password-reset [post]
+// @Security ApiKeyAuth
+func (h *Handler) ForcePasswordReset(w http.ResponseWriter, r *http.Request) {
+	h.handleAuditedAccountAction(w, r, auditEnums.ActionAccountPasswordReset, h.controller.ForcePasswordReset, nil)
+}
+
+// @Tags Admin
+// @Description Send a new confirmation email to an account, only allowed to application admins
+// @ID admin-resend-confirmation-email
+// @Accept  json
+// @Produce  json
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/admin/accounts/{accountID}/resend-confirmation [post]
+// @Security ApiKeyAuth
+func (h *Handler) ResendConfirmationEmail(w http.ResponseWriter, r *http.Request) {
+	h.handleAccountAction(w, r, h.controller.ResendConfirmationEmail, func() { httpUtil.StatusNoContent(w) })
+}
+
+// handleAccountAction runs an action of the application admin over the account of the url and writes its response
+func (h *Handler) handleAccountAction(w http.ResponseWriter, r *http.Request,
+	action func(accountID uuid.UUID, actor *authEntities.Actor) error, success func()) {
+	actor, err := h.getActor(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := h.runAccountAction(r, actor, action); err != nil {
+		h.checkAdminErrors(w, err)
+		return
+	}
+
+	success()
+}
+
+// handleAuditedAccountAction publishes the audit event of the account action after it succeeds, with the after
+// state when the action changes one
+func (h *Handler) handleAuditedAccountAction(w http.ResponseWriter, r *http.Request, auditAction auditEnums.Action,
+	action func(accountID uuid.UUID, actor *authEntities.Actor) error, after interface{}) {
+	h.handleAccountAction(w, r, func(accountID uuid.UUID, actor *authEntities.Actor) error {
+		if err := action(accountID, actor); err != nil {
+			return err
+		}
+
+		h.auditService.Publish(r, auditEntities.NewEvent(auditAction, auditEnums.TargetAccount, accountID).
+			SetActor(actor.AccountID).SetChanges(nil, after))
+		return nil
+	}, func() { httpUtil.StatusNoContent(w) })
+}
+
+func (h *Handler) runAccountAction(r *http.Request, actor *authEntities.Actor,
+	action func(accountID uuid.UUID, actor *authEntities.Actor) error) error {
+	accountID, err := uuid.Parse(chi.URLParam(r, accountEnums.ID))
+	if err != nil {
+		return accountEnums.ErrorInvalidAccountID
+	}
+
+	return action(accountID, actor)
+}
+
+func (h *Handler) checkAdminErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case accountEnums.ErrorInvalidAccountID, adminEnums.ErrorInvalidPagination, adminEnums.ErrorChangeOwnAccount,
+		adminEnums.ErrorPasswordResetNotSupported, accountEnums.ErrorAccountAlreadyConfirmed:
+		httpUtil.StatusBadRequest(w, err)
+	case adminEnums.ErrorNotAllowed:
+		httpUtil.StatusForbidden(w, err)
+	case adminEnums.ErrorAccountNotFound:
+		httpUtil.StatusNotFound(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
@@ -0,0 +1,288 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	adminController "github.com/ZupIT/horusec-platform/auth/internal/controllers/admin"
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	adminEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/admin"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
+)
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func newRequest(method, target, body, accountID string) *http.Request {
+	r, _ := http.NewRequest(method, target, bytes.NewReader([]byte(body)))
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add(accountEnums.ID, accountID)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAccountControllerMock() *accountController.Mock {
+	controllerMock := &accountController.Mock{}
+	controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+	return controllerMock
+}
+
+func newUnauthorizedAccountControllerMock() *accountController.Mock {
+	controllerMock := &accountController.Mock{}
+	controllerMock.On("GetAccountID").Return(uuid.Nil, errors.New("test"))
+
+	return controllerMock
+}
+
+func TestNewAdminHandler(t *testing.T) {
+	t.Run("should create admin handler", func(t *testing.T) {
+		assert.NotNil(t, NewAdminHandler(nil, nil, nil))
+	})
+}
+
+func TestListAccounts(t *testing.T) {
+	t.Run("should return 200 when success list accounts", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("ListAccounts").Return(&adminEntities.AccountsResponse{}, nil)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListAccounts(w, newRequest(http.MethodGet, "test?search=test&page=2&size=20", "", ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid pagination", func(t *testing.T) {
+		handler := NewAdminHandler(&adminController.Mock{}, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListAccounts(w, newRequest(http.MethodGet, "test?page=test", "", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when invalid token", func(t *testing.T) {
+		handler := NewAdminHandler(&adminController.Mock{}, newUnauthorizedAccountControllerMock(),
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListAccounts(w, newRequest(http.MethodGet, "test", "", ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 403 when not application admin", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("ListAccounts").Return(&adminEntities.AccountsResponse{}, adminEnums.ErrorNotAllowed)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListAccounts(w, newRequest(http.MethodGet, "test", "", ""))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestGetMemberships(t *testing.T) {
+	t.Run("should return 200 when success get memberships", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("GetMemberships").Return(&adminEntities.Memberships{}, nil)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetMemberships(w, newRequest(http.MethodGet, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when account not found", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("GetMemberships").Return(&adminEntities.Memberships{}, adminEnums.ErrorAccountNotFound)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetMemberships(w, newRequest(http.MethodGet, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDisableAccount(t *testing.T) {
+	t.Run("should return 204 when success disable account", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("DisableAccount").Return(nil)
+
+		auditServiceMock := newAuditServiceMock()
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), auditServiceMock)
+		w := httptest.NewRecorder()
+
+		handler.DisableAccount(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return 400 when invalid account id", func(t *testing.T) {
+		handler := NewAdminHandler(&adminController.Mock{}, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DisableAccount(w, newRequest(http.MethodPost, "test", "", "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when disabling own account", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("DisableAccount").Return(adminEnums.ErrorChangeOwnAccount)
+
+		auditServiceMock := newAuditServiceMock()
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), auditServiceMock)
+		w := httptest.NewRecorder()
+
+		handler.DisableAccount(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should return 401 when invalid token", func(t *testing.T) {
+		handler := NewAdminHandler(&adminController.Mock{}, newUnauthorizedAccountControllerMock(),
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DisableAccount(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("DisableAccount").Return(errors.New("test"))
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DisableAccount(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestEnableAccount(t *testing.T) {
+	t.Run("should return 204 when success enable account", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("EnableAccount").Return(nil)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.EnableAccount(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestSetApplicationAdmin(t *testing.T) {
+	t.Run("should return 204 when success set application admin", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("SetApplicationAdmin").Return(nil)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.SetApplicationAdmin(w, newRequest(http.MethodPatch, "test", `{"isApplicationAdmin": true}`,
+			uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when missing application admin flag", func(t *testing.T) {
+		handler := NewAdminHandler(&adminController.Mock{}, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.SetApplicationAdmin(w, newRequest(http.MethodPatch, "test", `{}`, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewAdminHandler(&adminController.Mock{}, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.SetApplicationAdmin(w, newRequest(http.MethodPatch, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestForcePasswordReset(t *testing.T) {
+	t.Run("should return 204 when success force password reset", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("ForcePasswordReset").Return(nil)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ForcePasswordReset(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when not using horusec authentication", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("ForcePasswordReset").Return(adminEnums.ErrorPasswordResetNotSupported)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ForcePasswordReset(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestResendConfirmationEmail(t *testing.T) {
+	t.Run("should return 204 when success resend confirmation email", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("ResendConfirmationEmail").Return(nil)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ResendConfirmationEmail(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when account is already confirmed", func(t *testing.T) {
+		controllerMock := &adminController.Mock{}
+		controllerMock.On("ResendConfirmationEmail").Return(accountEnums.ErrorAccountAlreadyConfirmed)
+
+		handler := NewAdminHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ResendConfirmationEmail(w, newRequest(http.MethodPost, "test", "", uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -2,6 +2,7 @@ package authentication
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	authTypes "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
@@ -9,13 +10,24 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
 	"github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
 	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
 	ldapEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/ldap"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
 	authUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
+	"github.com/ZupIT/horusec-platform/clientip"
 )
 
 type Handler struct {
@@ -71,7 +83,7 @@ func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
 
 	response, err := h.controller.Login(credentials)
 	if err != nil {
-		h.checkLoginErrors(w, err)
+		h.checkLockoutAndLoginErrors(w, err)
 		return
 	}
 
@@ -84,17 +96,30 @@ func (h *Handler) getLoginCredentials(r *http.Request) (*authentication.LoginCre
 		return credentials, err
 	}
 
+	credentials.SetDevice(r.UserAgent(), clientip.Get(r))
 	return credentials, nil
 }
 
+func (h *Handler) checkLockoutAndLoginErrors(w http.ResponseWriter, err error) {
+	if err == lockoutEnums.ErrorAccountLocked || err == lockoutEnums.ErrorIPLocked ||
+		err == lockoutEnums.ErrorRetryDelay || err == accountEnums.ErrorAccountDisabled {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	h.checkLoginErrors(w, err)
+}
+
 func (h *Handler) checkLoginErrors(w http.ResponseWriter, err error) {
 	switch h.appConfig.GetAuthenticationType() {
 	case authTypes.Horusec:
 		h.checkLoginErrorsHorusec(w, err)
 	case authTypes.Ldap:
 		h.checkLoginErrorsLdap(w, err)
-	case authTypes.Keycloak:
-		httpUtil.StatusInternalServerError(w, err)
+	case oidcEnums.AuthenticationTypeOIDC:
+		h.checkLoginErrorsOIDC(w, err)
+	case samlEnums.AuthenticationTypeSAML:
+		h.checkLoginErrorsSAML(w, err)
 	default:
 		httpUtil.StatusInternalServerError(w, err)
 	}
@@ -123,6 +148,314 @@ func (h *Handler) checkLoginErrorsLdap(w http.ResponseWriter, err error) {
 	httpUtil.StatusInternalServerError(w, err)
 }
 
+func (h *Handler) checkLoginErrorsOIDC(w http.ResponseWriter, err error) {
+	if err == oidcEnums.ErrorOIDCPasswordLoginNotSupported || err == authEnums.ErrorAuthTypeInvalid {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if h.isOIDCForbiddenError(err) {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+func (h *Handler) isOIDCForbiddenError(err error) bool {
+	return err == oidcEnums.ErrorOIDCInvalidState || err == accountEnums.ErrorAccountDisabled ||
+		errors.Is(err, oidcEnums.ErrorOIDCMissingSubjectOrEmail)
+}
+
+// @Tags Authenticate
+// @Description Get the openid connect authorization url, starting the authorization code flow with pkce
+// @ID oidc-authorize
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response{content=oidc.AuthorizationURLResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/oidc/authorize [get]
+func (h *Handler) OIDCAuthorize(w http.ResponseWriter, _ *http.Request) {
+	response, err := h.controller.GetOIDCAuthorizationURL()
+	if err != nil {
+		h.checkLoginErrorsOIDC(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+// @Tags Authenticate
+// @Description Login in into a horusec account exchanging the openid connect authorization code
+// @ID oidc-callback
+// @Accept  json
+// @Produce  json
+// @Param CallbackData body oidc.CallbackData true "authorization code and state returned by the issuer"
+// @Success 200 {object} entities.Response{content=authentication.LoginResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/oidc/callback [post]
+func (h *Handler) OIDCCallback(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getOIDCCallbackData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.OIDCCallback(data)
+	if err != nil {
+		h.checkLoginErrorsOIDC(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+func (h *Handler) getOIDCCallbackData(r *http.Request) (*oidcEntities.CallbackData, error) {
+	data := &oidcEntities.CallbackData{}
+
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	data.SetDevice(r.UserAgent(), clientip.Get(r))
+	return data, data.Validate()
+}
+
+func (h *Handler) checkLoginErrorsSAML(w http.ResponseWriter, err error) {
+	if err == samlEnums.ErrorSAMLPasswordLoginNotSupported || err == authEnums.ErrorAuthTypeInvalid {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if h.isSAMLForbiddenError(err) {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+func (h *Handler) isSAMLForbiddenError(err error) bool {
+	switch err {
+	case samlEnums.ErrorSAMLInvalidRelayState, samlEnums.ErrorSAMLInvalidAssertion,
+		samlEnums.ErrorSAMLInvalidLoginCode, samlEnums.ErrorSAMLMissingNameIDOrEmail, accountEnums.ErrorAccountDisabled:
+		return true
+	}
+
+	return false
+}
+
+// @Tags Authenticate
+// @Description Get the saml service provider metadata, used to register horusec in the identity provider
+// @ID saml-metadata
+// @Produce  xml
+// @Success 200 {string} string "service provider metadata"
+// @Failure 400 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/saml/metadata [get]
+func (h *Handler) SAMLMetadata(w http.ResponseWriter, _ *http.Request) {
+	metadata, err := h.controller.GetSAMLMetadata()
+	if err != nil {
+		h.checkLoginErrorsSAML(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", samlEnums.ContentTypeMetadata)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(metadata)
+}
+
+// @Tags Authenticate
+// @Description Get the identity provider single sign-on url, starting the saml authentication
+// @ID saml-login
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response{content=saml.LoginURLResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/saml/login [get]
+func (h *Handler) SAMLLogin(w http.ResponseWriter, _ *http.Request) {
+	response, err := h.controller.GetSAMLLoginURL()
+	if err != nil {
+		h.checkLoginErrorsSAML(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+// @Tags Authenticate
+// @Description Assertion consumer service, validates the signed saml response posted by the identity provider
+// @Description and redirects to the manager with a single use login code
+// @ID saml-acs
+// @Accept  x-www-form-urlencoded
+// @Param SAMLResponse formData string true "base64 encoded saml response"
+// @Param RelayState formData string true "relay state returned by the login url"
+// @Success 303
+// @Failure 400 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/saml/acs [post]
+func (h *Handler) SAMLAssertionConsumer(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getSAMLAssertionData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	redirectURL, err := h.controller.SAMLAssertionConsumer(data)
+	if err != nil {
+		h.checkLoginErrorsSAML(w, err)
+		return
+	}
+
+	http.Redirect(w, r, redirectURL, http.StatusSeeOther)
+}
+
+func (h *Handler) getSAMLAssertionData(r *http.Request) (*samlEntities.AssertionData, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+
+	data := &samlEntities.AssertionData{
+		SAMLResponse: r.PostForm.Get(samlEnums.FormKeySAMLResponse),
+		RelayState:   r.PostForm.Get(samlEnums.FormKeyRelayState),
+	}
+
+	data.SetDevice(r.UserAgent(), clientip.Get(r))
+	return data, data.Validate()
+}
+
+// @Tags Authenticate
+// @Description Login in into a horusec account exchanging the single use code sent by the assertion consumer
+// @ID saml-token
+// @Accept  json
+// @Produce  json
+// @Param LoginCodeData body saml.LoginCodeData true "single use login code"
+// @Success 200 {object} entities.Response{content=authentication.LoginResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/saml/token [post]
+func (h *Handler) SAMLToken(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getSAMLLoginCodeData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.SAMLExchangeLoginCode(data)
+	if err != nil {
+		h.checkLoginErrorsSAML(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+func (h *Handler) getSAMLLoginCodeData(r *http.Request) (*samlEntities.LoginCodeData, error) {
+	data := &samlEntities.LoginCodeData{}
+
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+// @Tags Authenticate
+// @Description Verify the mfa code of a login challenge, using the authenticator or a recovery code
+// @ID mfa-verify
+// @Accept  json
+// @Produce  json
+// @Param ChallengeData body mfa.ChallengeData true "mfa token returned by the login and the authentication code"
+// @Success 200 {object} entities.Response{content=authentication.LoginResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/mfa/verify [post]
+func (h *Handler) MFAVerify(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getMFAChallengeData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.VerifyMFA(data)
+	if err != nil {
+		h.checkMFAErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+// @Tags Authenticate
+// @Description Start the mfa enrollment of a login challenge, required when the account has no mfa enabled yet
+// @ID mfa-enroll
+// @Accept  json
+// @Produce  json
+// @Param ChallengeData body mfa.ChallengeData true "mfa token returned by the login"
+// @Success 200 {object} entities.Response{content=mfa.EnrollmentResponse}
+// @Failure 400 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/authenticate/mfa/enroll [post]
+func (h *Handler) MFAEnroll(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getMFAChallengeData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	response, err := h.controller.EnrollMFA(data)
+	if err != nil {
+		h.checkMFAErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, response)
+}
+
+func (h *Handler) getMFAChallengeData(r *http.Request) (*mfaEntities.ChallengeData, error) {
+	data := &mfaEntities.ChallengeData{}
+
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	data.SetDevice(r.UserAgent(), clientip.Get(r))
+	return data, data.Validate()
+}
+
+func (h *Handler) checkMFAErrors(w http.ResponseWriter, err error) {
+	if err == authEnums.ErrorAuthTypeInvalid || err == mfaEnums.ErrorMFAEnrollmentNotRequired ||
+		err == mfaEnums.ErrorMFAAlreadyEnabled {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if h.isMFAForbiddenError(err) {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+func (h *Handler) isMFAForbiddenError(err error) bool {
+	switch err {
+	case mfaEnums.ErrorMFAInvalidCode, mfaEnums.ErrorMFAInvalidChallenge,
+		mfaEnums.ErrorMFATooManyAttempts, mfaEnums.ErrorMFANotEnrolled:
+		return true
+	}
+
+	return false
+}
+
 func (h *Handler) IsAuthorized(_ context.Context, data *proto.IsAuthorizedData) (*proto.IsAuthorizedResponse, error) {
 	isAuthorized, err := h.controller.IsAuthorized(h.useCases.NewAuthorizationDataFromGrpcData(data))
 
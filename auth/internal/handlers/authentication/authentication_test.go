@@ -3,11 +3,16 @@ package authentication
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/go-chi/chi/middleware"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
@@ -17,11 +22,32 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	authController "github.com/ZupIT/horusec-platform/auth/internal/controllers/authentication"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
 	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
 	ldapEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/ldap"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
 	authUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
+	"github.com/ZupIT/horusec-platform/clientip"
 )
 
+// loginControllerMock keeps the credentials received by the login, since the controller mock ignores the arguments
+type loginControllerMock struct {
+	*authController.Mock
+	credentials *authEntities.LoginCredentials
+}
+
+func (m *loginControllerMock) Login(credentials *authEntities.LoginCredentials) (*authEntities.LoginResponse, error) {
+	m.credentials = credentials
+	return m.Mock.Login(credentials)
+}
+
 func TestNewAuthenticationHandler(t *testing.T) {
 	t.Run("should success create a new handler", func(t *testing.T) {
 		assert.NotNil(t, NewAuthenticationHandler(nil, nil, nil))
@@ -66,6 +92,58 @@ func TestLogin(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("should use the connection ip ignoring the forwarded header of untrusted connections", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controllerMock := &loginControllerMock{Mock: &authController.Mock{}}
+		controllerMock.On("Login").Return(&authEntities.LoginResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(credentials.ToBytes()))
+		r = r.WithContext(clientip.WithConnectionAddress(r.Context(), "192.168.0.1:5000"))
+		r.RemoteAddr = "192.168.0.1:5000"
+		r.Header.Set(clientip.HeaderForwarded, "10.0.0.1")
+		w := httptest.NewRecorder()
+
+		middleware.RealIP(http.HandlerFunc(handler.Login)).ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "192.168.0.1", controllerMock.credentials.IPAddress)
+	})
+
+	t.Run("should return 403 when account is locked", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controllerMock := &authController.Mock{}
+		controllerMock.On("Login").Return(&authEntities.LoginResponse{}, lockoutEnums.ErrorAccountLocked)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(credentials.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.Login(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 403 when account is disabled", func(t *testing.T) {
+		appConfig := &app.Config{AuthType: auth.Horusec}
+
+		controllerMock := &authController.Mock{}
+		controllerMock.On("Login").Return(&authEntities.LoginResponse{}, accountEnums.ErrorAccountDisabled)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(credentials.ToBytes()))
+		w := httptest.NewRecorder()
+
+		handler.Login(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("should return 500 when something went wrong auth type horusec", func(t *testing.T) {
 		appConfig := &app.Config{AuthType: auth.Horusec}
 
@@ -305,3 +383,394 @@ func TestOptions(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
+
+func TestOIDCAuthorize(t *testing.T) {
+	appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+
+	t.Run("should return 200 when success get authorization url", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetOIDCAuthorizationURL").Return(&oidcEntities.AuthorizationURLResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.OIDCAuthorize(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when auth type is not oidc", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetOIDCAuthorizationURL").Return(
+			&oidcEntities.AuthorizationURLResponse{}, authEnums.ErrorAuthTypeInvalid)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.OIDCAuthorize(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get authorization url", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetOIDCAuthorizationURL").Return(
+			&oidcEntities.AuthorizationURLResponse{}, errors.New("test"))
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.OIDCAuthorize(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestOIDCCallback(t *testing.T) {
+	appConfig := &app.Config{AuthType: oidcEnums.AuthenticationTypeOIDC}
+	data, _ := json.Marshal(&oidcEntities.CallbackData{Code: "test", State: "test"})
+
+	t.Run("should return 200 when success login with callback", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("OIDCCallback").Return(&authEntities.LoginResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.OIDCCallback(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid callback data", func(t *testing.T) {
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), &authController.Mock{})
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+
+		handler.OIDCCallback(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when invalid state", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("OIDCCallback").Return(&authEntities.LoginResponse{}, oidcEnums.ErrorOIDCInvalidState)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.OIDCCallback(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 403 when account is disabled", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("OIDCCallback").Return(&authEntities.LoginResponse{}, accountEnums.ErrorAccountDisabled)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.OIDCCallback(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("OIDCCallback").Return(&authEntities.LoginResponse{}, errors.New("test"))
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.OIDCCallback(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestSAMLMetadata(t *testing.T) {
+	appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+	t.Run("should return 200 with xml metadata", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetSAMLMetadata").Return([]byte("<EntityDescriptor/>"), nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.SAMLMetadata(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, samlEnums.ContentTypeMetadata, w.Header().Get("Content-Type"))
+		assert.Equal(t, "<EntityDescriptor/>", w.Body.String())
+	})
+
+	t.Run("should return 400 when auth type is not saml", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetSAMLMetadata").Return([]byte{}, authEnums.ErrorAuthTypeInvalid)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.SAMLMetadata(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSAMLLogin(t *testing.T) {
+	appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+
+	t.Run("should return 200 when success get login url", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetSAMLLoginURL").Return(&samlEntities.LoginURLResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.SAMLLogin(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get login url", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("GetSAMLLoginURL").Return(&samlEntities.LoginURLResponse{}, errors.New("test"))
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.SAMLLogin(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestSAMLAssertionConsumer(t *testing.T) {
+	appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+	form := url.Values{samlEnums.FormKeySAMLResponse: {"test"}, samlEnums.FormKeyRelayState: {"test"}}
+
+	newRequest := func(body string) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return r
+	}
+
+	t.Run("should redirect to manager when success consume assertion", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("SAMLAssertionConsumer").Return("http://localhost:8043/auth/saml?code=test", nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+		w := httptest.NewRecorder()
+
+		handler.SAMLAssertionConsumer(w, newRequest(form.Encode()))
+
+		assert.Equal(t, http.StatusSeeOther, w.Code)
+		assert.Equal(t, "http://localhost:8043/auth/saml?code=test", w.Header().Get("Location"))
+	})
+
+	t.Run("should return 400 when missing saml response", func(t *testing.T) {
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), &authController.Mock{})
+		w := httptest.NewRecorder()
+
+		handler.SAMLAssertionConsumer(w, newRequest(""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when invalid assertion", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("SAMLAssertionConsumer").Return("", samlEnums.ErrorSAMLInvalidAssertion)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+		w := httptest.NewRecorder()
+
+		handler.SAMLAssertionConsumer(w, newRequest(form.Encode()))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 403 when account is disabled", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("SAMLAssertionConsumer").Return("", accountEnums.ErrorAccountDisabled)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+		w := httptest.NewRecorder()
+
+		handler.SAMLAssertionConsumer(w, newRequest(form.Encode()))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestSAMLToken(t *testing.T) {
+	appConfig := &app.Config{AuthType: samlEnums.AuthenticationTypeSAML}
+	data, _ := json.Marshal(&samlEntities.LoginCodeData{Code: "test"})
+
+	t.Run("should return 200 when success exchange login code", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("SAMLExchangeLoginCode").Return(&authEntities.LoginResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.SAMLToken(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid login code data", func(t *testing.T) {
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), &authController.Mock{})
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+
+		handler.SAMLToken(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when invalid login code", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("SAMLExchangeLoginCode").Return(
+			&authEntities.LoginResponse{}, samlEnums.ErrorSAMLInvalidLoginCode)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.SAMLToken(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestMFAVerify(t *testing.T) {
+	appConfig := &app.Config{AuthType: auth.Horusec}
+	data, _ := json.Marshal(&mfaEntities.ChallengeData{MFAToken: uuid.NewString(), Code: "123456"})
+
+	t.Run("should return 200 when success verify mfa", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("VerifyMFA").Return(&authEntities.LoginResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.MFAVerify(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid challenge data", func(t *testing.T) {
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), &authController.Mock{})
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("{}")))
+		w := httptest.NewRecorder()
+
+		handler.MFAVerify(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when too many attempts", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("VerifyMFA").Return(&authEntities.LoginResponse{}, mfaEnums.ErrorMFATooManyAttempts)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.MFAVerify(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("VerifyMFA").Return(&authEntities.LoginResponse{}, errors.New("test"))
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.MFAVerify(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestMFAEnroll(t *testing.T) {
+	appConfig := &app.Config{AuthType: auth.Horusec}
+	data, _ := json.Marshal(&mfaEntities.ChallengeData{MFAToken: uuid.NewString()})
+
+	t.Run("should return 200 when success enroll mfa", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("EnrollMFA").Return(&mfaEntities.EnrollmentResponse{}, nil)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.MFAEnroll(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when challenge is not an enrollment", func(t *testing.T) {
+		controllerMock := &authController.Mock{}
+		controllerMock.On("EnrollMFA").Return(
+			&mfaEntities.EnrollmentResponse{}, mfaEnums.ErrorMFAEnrollmentNotRequired)
+
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), controllerMock)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data))
+		w := httptest.NewRecorder()
+
+		handler.MFAEnroll(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewAuthenticationHandler(appConfig, authUseCases.NewAuthenticationUseCases(), &authController.Mock{})
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("{")))
+		w := httptest.NewRecorder()
+
+		handler.MFAEnroll(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,685 @@
+package scim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	scimController "github.com/ZupIT/horusec-platform/auth/internal/controllers/scim"
+	auditEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/audit"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
+)
+
+type scimError struct {
+	status    int
+	errorType scimEnums.ErrorType
+}
+
+// scimErrors are the status and scim type of the known errors, rfc7644 section 3.12
+var scimErrors = map[error]*scimError{
+	scimEnums.ErrorInvalidToken:       {status: http.StatusUnauthorized},
+	scimEnums.ErrorInvalidResourceID:  {status: http.StatusNotFound},
+	scimEnums.ErrorUserNotFound:       {status: http.StatusNotFound},
+	scimEnums.ErrorGroupNotFound:      {status: http.StatusNotFound},
+	scimEnums.ErrorUserAlreadyExists:  {status: http.StatusConflict, errorType: scimEnums.ErrorTypeUniqueness},
+	scimEnums.ErrorGroupAlreadyExists: {status: http.StatusConflict, errorType: scimEnums.ErrorTypeUniqueness},
+	scimEnums.ErrorInvalidFilter:      {status: http.StatusBadRequest, errorType: scimEnums.ErrorTypeInvalidFilter},
+	scimEnums.ErrorInvalidPath:        {status: http.StatusBadRequest, errorType: scimEnums.ErrorTypeInvalidPath},
+	scimEnums.ErrorInvalidValue:       {status: http.StatusBadRequest, errorType: scimEnums.ErrorTypeInvalidValue},
+	scimEnums.ErrorMemberNotFound:     {status: http.StatusBadRequest, errorType: scimEnums.ErrorTypeInvalidValue},
+}
+
+type Handler struct {
+	controller        scimController.IController
+	accountController accountController.IController
+	auditService      auditService.IService
+}
+
+func NewSCIMHandler(controller scimController.IController, controllerAccount accountController.IController,
+	serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:        controller,
+		accountController: controllerAccount,
+		auditService:      serviceAudit,
+	}
+}
+
+// @Tags SCIM
+// @Description Create a token for the identity provider to call the scim api, only allowed to application admins
+// @ID create-scim-token
+// @Accept  json
+// @Produce  json
+// @Param TokenData body scimEntities.TokenData true "scim token data"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/scim/tokens [post]
+// @Security ApiKeyAuth
+func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
+	actor, err := h.getActor(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	data, err := h.getTokenData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.createToken(w, r, data.SetCreatedBy(actor.AccountID), actor)
+}
+
+func (h *Handler) getActor(r *http.Request) (*authEntities.Actor, error) {
+	token := r.Header.Get(enums.HorusecJWTHeader)
+
+	accountID, err := h.accountController.GetAccountID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return authEntities.NewActor(accountID, token), nil
+}
+
+func (h *Handler) getTokenData(r *http.Request) (*scimEntities.TokenData, error) {
+	data := &scimEntities.TokenData{}
+
+	if err := parser.ParseBodyToEntity(r.Body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (h *Handler) createToken(w http.ResponseWriter, r *http.Request, data *scimEntities.TokenData,
+	actor *authEntities.Actor) {
+	response, err := h.controller.CreateToken(data, actor)
+	if err != nil {
+		h.checkTokenErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionSCIMTokenCreate, auditEnums.TargetSCIMToken,
+		response.TokenID).SetActor(actor.AccountID).SetChanges(nil, response.Token))
+	httpUtil.StatusCreated(w, response)
+}
+
+func (h *Handler) checkTokenErrors(w http.ResponseWriter, err error) {
+	if err == scimEnums.ErrorNotAllowed {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	h.checkRevokeTokenErrors(w, err)
+}
+
+func (h *Handler) checkRevokeTokenErrors(w http.ResponseWriter, err error) {
+	if err == scimEnums.ErrorInvalidTokenID {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err == scimEnums.ErrorTokenNotFound {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags SCIM
+// @Description List the active scim tokens, only allowed to application admins
+// @ID list-scim-tokens
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/scim/tokens [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListTokens(w http.ResponseWriter, r *http.Request) {
+	actor, err := h.getActor(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	tokens, err := h.controller.ListTokens(actor)
+	if err != nil {
+		h.checkTokenErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, tokens)
+}
+
+// @Tags SCIM
+// @Description Revoke a scim token, only allowed to application admins
+// @ID revoke-scim-token
+// @Accept  json
+// @Produce  json
+// @Param tokenID path string true "ID of the token"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /auth/scim/tokens/{tokenID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) RevokeToken(w http.ResponseWriter, r *http.Request) {
+	actor, err := h.getActor(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	if err := h.revokeToken(r, actor); err != nil {
+		h.checkTokenErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) revokeToken(r *http.Request, actor *authEntities.Actor) error {
+	tokenID, err := uuid.Parse(chi.URLParam(r, scimEnums.TokenID))
+	if err != nil {
+		return scimEnums.ErrorInvalidTokenID
+	}
+
+	if err := h.controller.RevokeToken(tokenID, actor); err != nil {
+		return err
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionSCIMTokenRevoke, auditEnums.TargetSCIMToken,
+		tokenID).SetActor(actor.AccountID))
+	return nil
+}
+
+// IsAuthorized authenticates the identity provider with the scim token sent as a bearer token
+func (h *Handler) IsAuthorized(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := h.controller.Authenticate(r.Header.Get("Authorization")); err != nil {
+			h.scimError(w, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// @Tags SCIM
+// @Description List the provisioned users, filtering by userName, emails or externalId with the eq operator
+// @ID scim-list-users
+// @Accept  json
+// @Produce  json
+// @Param filter query string false "filter, like userName eq \"john\""
+// @Param startIndex query int false "one based index of the first result"
+// @Param count query int false "amount of results"
+// @Success 200 {object} scimEntities.ListResponse
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Users [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	query, err := h.getListQuery(r, scimEnums.ResourceTypeUser)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	response, err := h.controller.ListUsers(query)
+	h.scimResponse(w, http.StatusOK, response, err)
+}
+
+func (h *Handler) getListQuery(r *http.Request, resourceType scimEnums.ResourceType) (*scimEntities.ListQuery, error) {
+	values := r.URL.Query()
+
+	return scimEntities.NewListQuery(resourceType, values.Get(scimEnums.QueryFilter),
+		values.Get(scimEnums.QueryStartIndex), values.Get(scimEnums.QueryCount))
+}
+
+// @Tags SCIM
+// @Description Get a provisioned user
+// @ID scim-get-user
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the user"
+// @Success 200 {object} scimEntities.UserResource
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Users/{id} [get]
+// @Security ApiKeyAuth
+func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.getResourceID(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	user, err := h.controller.GetUser(accountID)
+	h.scimResponse(w, http.StatusOK, user, err)
+}
+
+func (h *Handler) getResourceID(r *http.Request) (uuid.UUID, error) {
+	resourceID, err := uuid.Parse(chi.URLParam(r, scimEnums.ID))
+	if err != nil {
+		return uuid.Nil, scimEnums.ErrorInvalidResourceID
+	}
+
+	return resourceID, nil
+}
+
+// @Tags SCIM
+// @Description Provision a user, creating a confirmed account
+// @ID scim-create-user
+// @Accept  json
+// @Produce  json
+// @Param UserResource body scimEntities.UserResource true "scim user"
+// @Success 201 {object} scimEntities.UserResource
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 409 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Users [post]
+// @Security ApiKeyAuth
+func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.getUserResource(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	user, err = h.controller.CreateUser(user)
+	h.publishUserEvent(r, auditEnums.ActionSCIMUserCreate, user, err)
+	h.scimResponse(w, http.StatusCreated, user, err)
+}
+
+func (h *Handler) getUserResource(r *http.Request) (*scimEntities.UserResource, error) {
+	user := &scimEntities.UserResource{}
+
+	if err := parser.ParseBodyToEntity(r.Body, user); err != nil {
+		return nil, h.invalidValueError(err)
+	}
+
+	return user, h.invalidValueError(user.Validate())
+}
+
+func (h *Handler) invalidValueError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", scimEnums.ErrorInvalidValue, err)
+}
+
+// @Tags SCIM
+// @Description Replace a provisioned user, an inactive user is disabled and has its sessions revoked
+// @ID scim-replace-user
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the user"
+// @Param UserResource body scimEntities.UserResource true "scim user"
+// @Success 200 {object} scimEntities.UserResource
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 409 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Users/{id} [put]
+// @Security ApiKeyAuth
+func (h *Handler) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	accountID, user, err := h.getReplaceUserRequest(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	user, err = h.controller.ReplaceUser(accountID, user)
+	h.publishUserEvent(r, auditEnums.ActionSCIMUserUpdate, user, err)
+	h.scimResponse(w, http.StatusOK, user, err)
+}
+
+func (h *Handler) getReplaceUserRequest(r *http.Request) (uuid.UUID, *scimEntities.UserResource, error) {
+	accountID, err := h.getResourceID(r)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	user, err := h.getUserResource(r)
+	return accountID, user, err
+}
+
+// @Tags SCIM
+// @Description Patch a provisioned user, an inactive user is disabled and has its sessions revoked
+// @ID scim-patch-user
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the user"
+// @Param PatchRequest body scimEntities.PatchRequest true "scim patch operations"
+// @Success 200 {object} scimEntities.UserResource
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 409 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Users/{id} [patch]
+// @Security ApiKeyAuth
+func (h *Handler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	accountID, patch, err := h.getPatchRequest(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	user, err := h.controller.PatchUser(accountID, patch)
+	h.publishUserEvent(r, auditEnums.ActionSCIMUserUpdate, user, err)
+	h.scimResponse(w, http.StatusOK, user, err)
+}
+
+func (h *Handler) getPatchRequest(r *http.Request) (uuid.UUID, *scimEntities.PatchRequest, error) {
+	resourceID, err := h.getResourceID(r)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	patch := &scimEntities.PatchRequest{}
+	if err := parser.ParseBodyToEntity(r.Body, patch); err != nil {
+		return uuid.Nil, nil, h.invalidValueError(err)
+	}
+
+	return resourceID, patch, h.invalidValueError(patch.Validate())
+}
+
+// @Tags SCIM
+// @Description Deprovision a user, deleting its account
+// @ID scim-delete-user
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the user"
+// @Success 204
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Users/{id} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	accountID, err := h.getResourceID(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	err = h.controller.DeleteUser(accountID)
+	h.publishProvisioningEvent(r, auditEnums.ActionSCIMUserDelete, auditEnums.TargetAccount, accountID, nil, err)
+	h.scimNoContent(w, err)
+}
+
+// @Tags SCIM
+// @Description List the provisioned groups, filtering by displayName or externalId with the eq operator
+// @ID scim-list-groups
+// @Accept  json
+// @Produce  json
+// @Param filter query string false "filter, like displayName eq \"developers\""
+// @Param startIndex query int false "one based index of the first result"
+// @Param count query int false "amount of results"
+// @Success 200 {object} scimEntities.ListResponse
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Groups [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	query, err := h.getListQuery(r, scimEnums.ResourceTypeGroup)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	response, err := h.controller.ListGroups(query)
+	h.scimResponse(w, http.StatusOK, response, err)
+}
+
+// @Tags SCIM
+// @Description Get a provisioned group with its members and roles
+// @ID scim-get-group
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the group"
+// @Success 200 {object} scimEntities.GroupResource
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Groups/{id} [get]
+// @Security ApiKeyAuth
+func (h *Handler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, err := h.getResourceID(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	group, err := h.controller.GetGroup(groupID)
+	h.scimResponse(w, http.StatusOK, group, err)
+}
+
+// @Tags SCIM
+// @Description Provision a group, its members receive the workspace and repository roles mapped to it
+// @ID scim-create-group
+// @Accept  json
+// @Produce  json
+// @Param GroupResource body scimEntities.GroupResource true "scim group"
+// @Success 201 {object} scimEntities.GroupResource
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 409 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Groups [post]
+// @Security ApiKeyAuth
+func (h *Handler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	group, err := h.getGroupResource(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	group, err = h.controller.CreateGroup(group)
+	h.publishGroupEvent(r, auditEnums.ActionSCIMGroupCreate, group, err)
+	h.scimResponse(w, http.StatusCreated, group, err)
+}
+
+func (h *Handler) getGroupResource(r *http.Request) (*scimEntities.GroupResource, error) {
+	group := &scimEntities.GroupResource{}
+
+	if err := parser.ParseBodyToEntity(r.Body, group); err != nil {
+		return nil, h.invalidValueError(err)
+	}
+
+	return group, h.invalidValueError(group.Validate())
+}
+
+// @Tags SCIM
+// @Description Replace a provisioned group, syncing the roles of its members
+// @ID scim-replace-group
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the group"
+// @Param GroupResource body scimEntities.GroupResource true "scim group"
+// @Success 200 {object} scimEntities.GroupResource
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 409 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Groups/{id} [put]
+// @Security ApiKeyAuth
+func (h *Handler) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, group, err := h.getReplaceGroupRequest(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	group, err = h.controller.ReplaceGroup(groupID, group)
+	h.publishGroupEvent(r, auditEnums.ActionSCIMGroupUpdate, group, err)
+	h.scimResponse(w, http.StatusOK, group, err)
+}
+
+func (h *Handler) getReplaceGroupRequest(r *http.Request) (uuid.UUID, *scimEntities.GroupResource, error) {
+	groupID, err := h.getResourceID(r)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	group, err := h.getGroupResource(r)
+	return groupID, group, err
+}
+
+// @Tags SCIM
+// @Description Patch a provisioned group, syncing the roles of its members
+// @ID scim-patch-group
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the group"
+// @Param PatchRequest body scimEntities.PatchRequest true "scim patch operations"
+// @Success 200 {object} scimEntities.GroupResource
+// @Failure 400 {object} scimEntities.ErrorResponse
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 409 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Groups/{id} [patch]
+// @Security ApiKeyAuth
+func (h *Handler) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, patch, err := h.getPatchRequest(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	group, err := h.controller.PatchGroup(groupID, patch)
+	h.publishGroupEvent(r, auditEnums.ActionSCIMGroupUpdate, group, err)
+	h.scimResponse(w, http.StatusOK, group, err)
+}
+
+// @Tags SCIM
+// @Description Deprovision a group, revoking the roles it granted to its members
+// @ID scim-delete-group
+// @Accept  json
+// @Produce  json
+// @Param id path string true "ID of the group"
+// @Success 204
+// @Failure 401 {object} scimEntities.ErrorResponse
+// @Failure 404 {object} scimEntities.ErrorResponse
+// @Failure 500 {object} scimEntities.ErrorResponse
+// @Router /auth/scim/v2/Groups/{id} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	groupID, err := h.getResourceID(r)
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	err = h.controller.DeleteGroup(groupID)
+	h.publishProvisioningEvent(r, auditEnums.ActionSCIMGroupDelete, auditEnums.TargetSCIMGroup, groupID, nil, err)
+	h.scimNoContent(w, err)
+}
+
+func (h *Handler) publishUserEvent(r *http.Request, action auditEnums.Action, user *scimEntities.UserResource,
+	err error) {
+	if err != nil {
+		return
+	}
+
+	h.publishProvisioningEvent(r, action, auditEnums.TargetAccount, parser.ParseStringToUUID(user.ID), user, nil)
+}
+
+func (h *Handler) publishGroupEvent(r *http.Request, action auditEnums.Action, group *scimEntities.GroupResource,
+	err error) {
+	if err != nil {
+		return
+	}
+
+	h.publishProvisioningEvent(r, action, auditEnums.TargetSCIMGroup, parser.ParseStringToUUID(group.ID), group, nil)
+}
+
+// publishProvisioningEvent does nothing when the provisioning failed, the event is kept without actor since the
+// provisioning is done by the identity provider and not by an account
+func (h *Handler) publishProvisioningEvent(r *http.Request, action auditEnums.Action,
+	targetType auditEnums.TargetType, targetID uuid.UUID, after interface{}, err error) {
+	if err != nil {
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(action, targetType, targetID).SetChanges(nil, after))
+}
+
+// scimResponse writes the resource without the horusec response wrapper, as expected by the scim clients
+func (h *Handler) scimResponse(w http.ResponseWriter, status int, content interface{}, err error) {
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	h.writeResponse(w, status, content)
+}
+
+func (h *Handler) scimNoContent(w http.ResponseWriter, err error) {
+	if err != nil {
+		h.scimError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) scimError(w http.ResponseWriter, err error) {
+	response := h.getSCIMError(err)
+	if response.status == http.StatusInternalServerError {
+		logger.LogError(scimEnums.MessageInternalServerError, err)
+	}
+
+	h.writeResponse(w, response.status, scimEntities.NewErrorResponse(response.status, response.errorType, err))
+}
+
+func (h *Handler) getSCIMError(err error) *scimError {
+	if response, ok := scimErrors[err]; ok {
+		return response
+	}
+
+	if errors.Is(err, scimEnums.ErrorInvalidValue) {
+		return scimErrors[scimEnums.ErrorInvalidValue]
+	}
+
+	return &scimError{status: http.StatusInternalServerError}
+}
+
+func (h *Handler) writeResponse(w http.ResponseWriter, status int, content interface{}) {
+	w.Header().Set("Content-Type", scimEnums.ContentType)
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(content)
+}
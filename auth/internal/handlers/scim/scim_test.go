@@ -0,0 +1,713 @@
+package scim
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	accountController "github.com/ZupIT/horusec-platform/auth/internal/controllers/account"
+	scimController "github.com/ZupIT/horusec-platform/auth/internal/controllers/scim"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+	auditService "github.com/ZupIT/horusec-platform/auth/internal/services/audit"
+)
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func newRequest(method, body, paramKey, paramValue string) *http.Request {
+	r, _ := http.NewRequest(method, "test", bytes.NewReader([]byte(body)))
+
+	ctx := chi.NewRouteContext()
+	ctx.URLParams.Add(paramKey, paramValue)
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAccountControllerMock() *accountController.Mock {
+	controllerMock := &accountController.Mock{}
+	controllerMock.On("GetAccountID").Return(uuid.New(), nil)
+
+	return controllerMock
+}
+
+func getErrorResponse(w *httptest.ResponseRecorder) *scimEntities.ErrorResponse {
+	response := &scimEntities.ErrorResponse{}
+	_ = json.NewDecoder(w.Body).Decode(response)
+
+	return response
+}
+
+func TestNewSCIMHandler(t *testing.T) {
+	t.Run("should success create a new handler", func(t *testing.T) {
+		assert.NotNil(t, NewSCIMHandler(nil, nil, nil))
+	})
+}
+
+func TestCreateToken(t *testing.T) {
+	t.Run("should return 201 when success create token", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateToken").Return(&scimEntities.TokenCreateResponse{Token: &scimEntities.Token{}}, nil)
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateToken(w, newRequest(http.MethodPost, `{"name": "test"}`, "", ""))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 403 when actor isn't application admin", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateToken").Return(&scimEntities.TokenCreateResponse{}, scimEnums.ErrorNotAllowed)
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateToken(w, newRequest(http.MethodPost, `{"name": "test"}`, "", ""))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateToken").Return(&scimEntities.TokenCreateResponse{}, errors.New("test"))
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateToken(w, newRequest(http.MethodPost, `{"name": "test"}`, "", ""))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateToken(w, newRequest(http.MethodPost, `{"name": ""}`, "", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "CreateToken")
+	})
+
+	t.Run("should return 401 when invalid authorization", func(t *testing.T) {
+		accountControllerMock := &accountController.Mock{}
+		accountControllerMock.On("GetAccountID").Return(uuid.Nil, errors.New("test"))
+
+		handler := NewSCIMHandler(&scimController.Mock{}, accountControllerMock, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateToken(w, newRequest(http.MethodPost, `{"name": "test"}`, "", ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	t.Run("should return 200 when success list tokens", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ListTokens").Return([]*scimEntities.Token{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListTokens(w, newRequest(http.MethodGet, "", "", ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 403 when actor isn't application admin", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ListTokens").Return([]*scimEntities.Token{}, scimEnums.ErrorNotAllowed)
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListTokens(w, newRequest(http.MethodGet, "", "", ""))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("should return 204 when success revoke token", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("RevokeToken").Return(nil)
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeToken(w, newRequest(http.MethodDelete, "", scimEnums.TokenID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when token not found", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("RevokeToken").Return(scimEnums.ErrorTokenNotFound)
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeToken(w, newRequest(http.MethodDelete, "", scimEnums.TokenID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid token id", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, newAccountControllerMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeToken(w, newRequest(http.MethodDelete, "", scimEnums.TokenID, "test"))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "RevokeToken")
+	})
+}
+
+func TestIsAuthorized(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("should call next handler when valid token", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("Authenticate").Return(nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.IsAuthorized(next).ServeHTTP(w, newRequest(http.MethodGet, "", "", ""))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 401 with scim error when invalid token", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("Authenticate").Return(scimEnums.ErrorInvalidToken)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.IsAuthorized(next).ServeHTTP(w, newRequest(http.MethodGet, "", "", ""))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		assert.Equal(t, scimEnums.ContentType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "401", getErrorResponse(w).Status)
+	})
+}
+
+func TestListUsers(t *testing.T) {
+	t.Run("should return 200 when success list users", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ListUsers").Return(&scimEntities.ListResponse{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListUsers(w, httptest.NewRequest(http.MethodGet, `/test?filter=userName+eq+"test"`, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, scimEnums.ContentType, w.Header().Get("Content-Type"))
+	})
+
+	t.Run("should return 400 with invalid filter type when unsupported filter", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListUsers(w, httptest.NewRequest(http.MethodGet, `/test?filter=userName+sw+"test"`, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.ErrorTypeInvalidFilter, getErrorResponse(w).ScimType)
+		controllerMock.AssertNotCalled(t, "ListUsers")
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ListUsers").Return(&scimEntities.ListResponse{}, errors.New("test"))
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListUsers(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGetUser(t *testing.T) {
+	t.Run("should return 200 when success get user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("GetUser").Return(&scimEntities.UserResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetUser(w, newRequest(http.MethodGet, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when user not found", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("GetUser").Return(&scimEntities.UserResource{}, scimEnums.ErrorUserNotFound)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetUser(w, newRequest(http.MethodGet, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetUser(w, newRequest(http.MethodGet, "", scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		controllerMock.AssertNotCalled(t, "GetUser")
+	})
+}
+
+func TestCreateUser(t *testing.T) {
+	validBody := `{"userName": "test", "emails": [{"value": "test@test.com", "primary": true}]}`
+
+	t.Run("should return 201 when success create user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateUser").Return(&scimEntities.UserResource{}, nil)
+
+		auditServiceMock := newAuditServiceMock()
+		handler := NewSCIMHandler(controllerMock, nil, auditServiceMock)
+		w := httptest.NewRecorder()
+
+		handler.CreateUser(w, newRequest(http.MethodPost, validBody, "", ""))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return 409 with uniqueness type when user already exists", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateUser").Return(&scimEntities.UserResource{}, scimEnums.ErrorUserAlreadyExists)
+
+		auditServiceMock := newAuditServiceMock()
+		handler := NewSCIMHandler(controllerMock, nil, auditServiceMock)
+		w := httptest.NewRecorder()
+
+		handler.CreateUser(w, newRequest(http.MethodPost, validBody, "", ""))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
+		assert.Equal(t, scimEnums.ErrorTypeUniqueness, getErrorResponse(w).ScimType)
+	})
+
+	t.Run("should return 400 with invalid value type when invalid user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateUser(w, newRequest(http.MethodPost, `{"userName": "test"}`, "", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.ErrorTypeInvalidValue, getErrorResponse(w).ScimType)
+		controllerMock.AssertNotCalled(t, "CreateUser")
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateUser(w, newRequest(http.MethodPost, "test", "", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestReplaceUser(t *testing.T) {
+	validBody := `{"userName": "test", "emails": [{"value": "test@test.com"}], "active": false}`
+
+	t.Run("should return 200 when success replace user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ReplaceUser").Return(&scimEntities.UserResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ReplaceUser(w, newRequest(http.MethodPut, validBody, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ReplaceUser(w, newRequest(http.MethodPut, validBody, scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		controllerMock.AssertNotCalled(t, "ReplaceUser")
+	})
+
+	t.Run("should return 400 when invalid user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ReplaceUser(w, newRequest(http.MethodPut, `{}`, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPatchUser(t *testing.T) {
+	validBody := `{"Operations": [{"op": "Replace", "path": "active", "value": false}]}`
+
+	t.Run("should return 200 when success patch user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("PatchUser").Return(&scimEntities.UserResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchUser(w, newRequest(http.MethodPatch, validBody, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 with invalid path type when unsupported path", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("PatchUser").Return(&scimEntities.UserResource{}, scimEnums.ErrorInvalidPath)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchUser(w, newRequest(http.MethodPatch, validBody, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.ErrorTypeInvalidPath, getErrorResponse(w).ScimType)
+	})
+
+	t.Run("should return 400 with invalid value type when wrapped invalid value", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("PatchUser").Return(&scimEntities.UserResource{},
+			fmt.Errorf("%w: %s", scimEnums.ErrorInvalidValue, "test"))
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchUser(w, newRequest(http.MethodPatch, validBody, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, scimEnums.ErrorTypeInvalidValue, getErrorResponse(w).ScimType)
+	})
+
+	t.Run("should return 400 when without operations", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchUser(w, newRequest(http.MethodPatch, `{"Operations": []}`, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "PatchUser")
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchUser(w, newRequest(http.MethodPatch, validBody, scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	t.Run("should return 204 when success delete user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("DeleteUser").Return(nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DeleteUser(w, newRequest(http.MethodDelete, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when user not found", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("DeleteUser").Return(scimEnums.ErrorUserNotFound)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DeleteUser(w, newRequest(http.MethodDelete, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DeleteUser(w, newRequest(http.MethodDelete, "", scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestListGroups(t *testing.T) {
+	t.Run("should return 200 when success list groups", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ListGroups").Return(&scimEntities.ListResponse{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListGroups(w, httptest.NewRequest(http.MethodGet, `/test?filter=displayName+eq+"test"`, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when filtering by an user attribute", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListGroups(w, httptest.NewRequest(http.MethodGet, `/test?filter=userName+eq+"test"`, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "ListGroups")
+	})
+}
+
+func TestGetGroup(t *testing.T) {
+	t.Run("should return 200 when success get group", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("GetGroup").Return(&scimEntities.GroupResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetGroup(w, newRequest(http.MethodGet, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when group not found", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("GetGroup").Return(&scimEntities.GroupResource{}, scimEnums.ErrorGroupNotFound)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetGroup(w, newRequest(http.MethodGet, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GetGroup(w, newRequest(http.MethodGet, "", scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCreateGroup(t *testing.T) {
+	validBody := `{"displayName": "test", "members": [{"value": "` + uuid.NewString() + `"}],
+		"urn:horusec:params:scim:schemas:extension:2.0:Group": {"roles": [
+			{"workspaceID": "` + uuid.NewString() + `", "role": "member"}]}}`
+
+	t.Run("should return 201 when success create group", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateGroup").Return(&scimEntities.GroupResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateGroup(w, newRequest(http.MethodPost, validBody, "", ""))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 400 when member isn't a provisioned user", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateGroup").Return(&scimEntities.GroupResource{}, scimEnums.ErrorMemberNotFound)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateGroup(w, newRequest(http.MethodPost, validBody, "", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when supervisor role mapped to a workspace", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateGroup(w, newRequest(http.MethodPost, `{"displayName": "test",
+			"urn:horusec:params:scim:schemas:extension:2.0:Group": {"roles": [
+				{"workspaceID": "`+uuid.NewString()+`", "role": "supervisor"}]}}`, "", ""))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "CreateGroup")
+	})
+
+	t.Run("should return 409 when group already exists", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("CreateGroup").Return(&scimEntities.GroupResource{}, scimEnums.ErrorGroupAlreadyExists)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.CreateGroup(w, newRequest(http.MethodPost, validBody, "", ""))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestReplaceGroup(t *testing.T) {
+	t.Run("should return 200 when success replace group", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("ReplaceGroup").Return(&scimEntities.GroupResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ReplaceGroup(w, newRequest(http.MethodPut, `{"displayName": "test"}`, scimEnums.ID,
+			uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid group", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ReplaceGroup(w, newRequest(http.MethodPut, `{}`, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		controllerMock.AssertNotCalled(t, "ReplaceGroup")
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ReplaceGroup(w, newRequest(http.MethodPut, `{"displayName": "test"}`, scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestPatchGroup(t *testing.T) {
+	validBody := `{"Operations": [{"op": "remove", "path": "members[value eq \"` + uuid.NewString() + `\"]"}]}`
+
+	t.Run("should return 200 when success patch group", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("PatchGroup").Return(&scimEntities.GroupResource{}, nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchGroup(w, newRequest(http.MethodPatch, validBody, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("PatchGroup").Return(&scimEntities.GroupResource{}, errors.New("test"))
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchGroup(w, newRequest(http.MethodPatch, validBody, scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, "500", getErrorResponse(w).Status)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.PatchGroup(w, newRequest(http.MethodPatch, "test", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteGroup(t *testing.T) {
+	t.Run("should return 204 when success delete group", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("DeleteGroup").Return(nil)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DeleteGroup(w, newRequest(http.MethodDelete, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 404 when group not found", func(t *testing.T) {
+		controllerMock := &scimController.Mock{}
+		controllerMock.On("DeleteGroup").Return(scimEnums.ErrorGroupNotFound)
+
+		handler := NewSCIMHandler(controllerMock, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DeleteGroup(w, newRequest(http.MethodDelete, "", scimEnums.ID, uuid.NewString()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 404 when invalid id", func(t *testing.T) {
+		handler := NewSCIMHandler(&scimController.Mock{}, nil, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.DeleteGroup(w, newRequest(http.MethodDelete, "", scimEnums.ID, "test"))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
@@ -1,6 +1,8 @@
 package account
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
@@ -14,9 +16,13 @@ type IRepository interface {
 	GetAccount(accountID uuid.UUID) (*accountEntities.Account, error)
 	GetAccountByEmail(email string) (*accountEntities.Account, error)
 	GetAccountByUsername(username string) (*accountEntities.Account, error)
+	GetAccountByIdentity(issuer, subject string) (*accountEntities.Account, error)
+	CreateIdentity(identity *accountEntities.Identity) error
 	CreateAccount(account *accountEntities.Account) (*accountEntities.Account, error)
 	Update(account *accountEntities.Account) (*accountEntities.Account, error)
 	Delete(accountID uuid.UUID) error
+	UpdateIsDisabled(accountID uuid.UUID, isDisabled bool) error
+	UpdateIsApplicationAdmin(accountID uuid.UUID, isApplicationAdmin bool) error
 }
 
 type Repository struct {
@@ -54,6 +60,25 @@ func (r *Repository) GetAccountByUsername(username string) (*accountEntities.Acc
 		accountEnums.DatabaseTableAccount).GetError()
 }
 
+func (r *Repository) GetAccountByIdentity(issuer, subject string) (*accountEntities.Account, error) {
+	account := &accountEntities.Account{}
+
+	return account, r.databaseRead.Raw(r.queryGetAccountByIdentity(), account, issuer, subject).GetError()
+}
+
+func (r *Repository) queryGetAccountByIdentity() string {
+	return `
+		SELECT acc.*
+		FROM accounts AS acc
+		INNER JOIN account_identities AS ide ON ide.account_id = acc.account_id
+		WHERE ide.issuer = ? AND ide.subject = ?
+	`
+}
+
+func (r *Repository) CreateIdentity(identity *accountEntities.Identity) error {
+	return r.databaseWrite.Create(identity, accountEnums.DatabaseTableAccountIdentity).GetError()
+}
+
 func (r *Repository) CreateAccount(account *accountEntities.Account) (*accountEntities.Account, error) {
 	return account, r.databaseWrite.Create(account, accountEnums.DatabaseTableAccount).GetError()
 }
@@ -66,3 +91,15 @@ func (r *Repository) Update(account *accountEntities.Account) (*accountEntities.
 func (r *Repository) Delete(accountID uuid.UUID) error {
 	return r.databaseWrite.Delete(r.useCases.FilterAccountByID(accountID), accountEnums.DatabaseTableAccount).GetError()
 }
+
+// UpdateIsDisabled uses a map since the update of the whole account ignores the false value
+func (r *Repository) UpdateIsDisabled(accountID uuid.UUID, isDisabled bool) error {
+	return r.databaseWrite.Update(map[string]interface{}{"is_disabled": isDisabled, "updated_at": time.Now()},
+		r.useCases.FilterAccountByID(accountID), accountEnums.DatabaseTableAccount).GetError()
+}
+
+// UpdateIsApplicationAdmin uses a map since the update of the whole account ignores the false value
+func (r *Repository) UpdateIsApplicationAdmin(accountID uuid.UUID, isApplicationAdmin bool) error {
+	return r.databaseWrite.Update(map[string]interface{}{"is_application_admin": isApplicationAdmin,
+		"updated_at": time.Now()}, r.useCases.FilterAccountByID(accountID), accountEnums.DatabaseTableAccount).GetError()
+}
@@ -28,6 +28,16 @@ func (m *Mock) GetAccountByUsername(_ string) (*accountEntities.Account, error)
 	return args.Get(0).(*accountEntities.Account), mockUtils.ReturnNilOrError(args, 1)
 }
 
+func (m *Mock) GetAccountByIdentity(_, _ string) (*accountEntities.Account, error) {
+	args := m.MethodCalled("GetAccountByIdentity")
+	return args.Get(0).(*accountEntities.Account), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CreateIdentity(_ *accountEntities.Identity) error {
+	args := m.MethodCalled("CreateIdentity")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
 func (m *Mock) CreateAccount(_ *accountEntities.Account) (*accountEntities.Account, error) {
 	args := m.MethodCalled("CreateAccount")
 	return args.Get(0).(*accountEntities.Account), mockUtils.ReturnNilOrError(args, 1)
@@ -42,3 +52,13 @@ func (m *Mock) Delete(_ uuid.UUID) error {
 	args := m.MethodCalled("Delete")
 	return mockUtils.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) UpdateIsDisabled(_ uuid.UUID, _ bool) error {
+	args := m.MethodCalled("UpdateIsDisabled")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) UpdateIsApplicationAdmin(_ uuid.UUID, _ bool) error {
+	args := m.MethodCalled("UpdateIsApplicationAdmin")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -1,6 +1,7 @@
 package account
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
@@ -48,6 +49,43 @@ func TestGetAccountByEmail(t *testing.T) {
 	})
 }
 
+func TestGetAccountByIdentity(t *testing.T) {
+	t.Run("should success get a account by identity", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		repository := NewAccountRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			accountUseCases.NewAccountUseCases(&app.Config{}))
+
+		account, err := repository.GetAccountByIdentity("http://issuer", "subject")
+		assert.NoError(t, err)
+		assert.NotNil(t, account)
+	})
+
+	t.Run("should return error when identity not linked", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewAccountRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			accountUseCases.NewAccountUseCases(&app.Config{}))
+
+		_, err := repository.GetAccountByIdentity("http://issuer", "subject")
+		assert.Error(t, err)
+	})
+}
+
+func TestCreateIdentity(t *testing.T) {
+	t.Run("should success create identity", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		repository := NewAccountRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			accountUseCases.NewAccountUseCases(&app.Config{}))
+
+		assert.NoError(t, repository.CreateIdentity(accountEntities.NewIdentity(uuid.New(), "issuer", "subject")))
+	})
+}
+
 func TestGetAccountByUsername(t *testing.T) {
 	t.Run("should success get a account by username", func(t *testing.T) {
 		databaseMock := &database.Mock{}
@@ -74,6 +112,18 @@ func TestCreateAccount(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, account)
 	})
+
+	t.Run("should return error when failed to create account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewAccountRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			accountUseCases.NewAccountUseCases(&app.Config{}))
+
+		_, err := repository.CreateAccount(&accountEntities.Account{})
+		assert.Error(t, err)
+		databaseMock.AssertNotCalled(t, "Raw")
+	})
 }
 
 func TestUpdate(t *testing.T) {
@@ -101,3 +151,27 @@ func TestDelete(t *testing.T) {
 		assert.NoError(t, repository.Delete(uuid.New()))
 	})
 }
+
+func TestUpdateIsDisabled(t *testing.T) {
+	t.Run("should success update account disabled flag", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		repository := NewAccountRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			accountUseCases.NewAccountUseCases(&app.Config{}))
+
+		assert.NoError(t, repository.UpdateIsDisabled(uuid.New(), true))
+	})
+}
+
+func TestUpdateIsApplicationAdmin(t *testing.T) {
+	t.Run("should success update account application admin flag", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		repository := NewAccountRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			accountUseCases.NewAccountUseCases(&app.Config{}))
+
+		assert.NoError(t, repository.UpdateIsApplicationAdmin(uuid.New(), false))
+	})
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+)
+
+type IRepository interface {
+	ListAccounts(filter *adminEntities.Filter) ([]*accountEntities.Account, int, error)
+	ListWorkspaceMemberships(accountID uuid.UUID) ([]*adminEntities.WorkspaceMembership, error)
+	ListRepositoryMemberships(accountID uuid.UUID) ([]*adminEntities.RepositoryMembership, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+}
+
+func NewAdminRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+	}
+}
+
+func (r *Repository) ListAccounts(filter *adminEntities.Filter) ([]*accountEntities.Account, int, error) {
+	var accounts []*accountEntities.Account
+	var total int
+
+	where, params := r.getSearchCondition(filter)
+
+	if err := r.databaseRead.Raw("SELECT COUNT(*) FROM accounts "+where, &total,
+		params...).GetErrorExceptNotFound(); err != nil {
+		return nil, 0, err
+	}
+
+	return accounts, total, r.databaseRead.Raw("SELECT * FROM accounts "+where+
+		" ORDER BY created_at, account_id LIMIT ? OFFSET ?", &accounts,
+		append(params, filter.Size, filter.GetSkip())...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getSearchCondition(filter *adminEntities.Filter) (string, []interface{}) {
+	if !filter.HasSearch() {
+		return "", []interface{}{}
+	}
+
+	return "WHERE username ILIKE ? OR email ILIKE ?", []interface{}{filter.GetSearch(), filter.GetSearch()}
+}
+
+func (r *Repository) ListWorkspaceMemberships(accountID uuid.UUID) ([]*adminEntities.WorkspaceMembership, error) {
+	var memberships []*adminEntities.WorkspaceMembership
+
+	return memberships, r.databaseRead.Raw(r.queryListWorkspaceMemberships(), &memberships,
+		accountID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListWorkspaceMemberships() string {
+	return `
+		SELECT ws.workspace_id, ws.name, aw.role
+		FROM workspaces AS ws
+		INNER JOIN account_workspace AS aw ON aw.workspace_id = ws.workspace_id
+		WHERE aw.account_id = ?
+		ORDER BY ws.name
+	`
+}
+
+func (r *Repository) ListRepositoryMemberships(accountID uuid.UUID) ([]*adminEntities.RepositoryMembership, error) {
+	var memberships []*adminEntities.RepositoryMembership
+
+	return memberships, r.databaseRead.Raw(r.queryListRepositoryMemberships(), &memberships,
+		accountID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListRepositoryMemberships() string {
+	return `
+		SELECT repo.repository_id, repo.workspace_id, repo.name, ar.role
+		FROM repositories AS repo
+		INNER JOIN account_repository AS ar ON ar.repository_id = repo.repository_id
+		WHERE ar.account_id = ?
+		ORDER BY repo.name
+	`
+}
@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListAccounts(_ *adminEntities.Filter) ([]*accountEntities.Account, int, error) {
+	args := m.MethodCalled("ListAccounts")
+	return args.Get(0).([]*accountEntities.Account), args.Get(1).(int), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) ListWorkspaceMemberships(_ uuid.UUID) ([]*adminEntities.WorkspaceMembership, error) {
+	args := m.MethodCalled("ListWorkspaceMemberships")
+	return args.Get(0).([]*adminEntities.WorkspaceMembership), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListRepositoryMemberships(_ uuid.UUID) ([]*adminEntities.RepositoryMembership, error) {
+	args := m.MethodCalled("ListRepositoryMemberships")
+	return args.Get(0).([]*adminEntities.RepositoryMembership), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	adminEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/admin"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewAdminRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewAdminRepository(t *testing.T) {
+	t.Run("should create admin repository", func(t *testing.T) {
+		assert.NotNil(t, NewAdminRepository(&database.Connection{}))
+	})
+}
+
+func TestListAccounts(t *testing.T) {
+	t.Run("should success list accounts with search", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, _, err := getRepository(databaseMock).ListAccounts(&adminEntities.Filter{Search: "test", Page: 1, Size: 10})
+		assert.NoError(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 2)
+	})
+
+	t.Run("should success list accounts without search", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, _, err := getRepository(databaseMock).ListAccounts(&adminEntities.Filter{Page: 1, Size: 10})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to count accounts", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, _, err := getRepository(databaseMock).ListAccounts(&adminEntities.Filter{Page: 1, Size: 10})
+		assert.Error(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+}
+
+func TestListWorkspaceMemberships(t *testing.T) {
+	t.Run("should success list workspace memberships", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).ListWorkspaceMemberships(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list workspace memberships", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := getRepository(databaseMock).ListWorkspaceMemberships(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestListRepositoryMemberships(t *testing.T) {
+	t.Run("should success list repository memberships", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).ListRepositoryMemberships(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list repository memberships", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := getRepository(databaseMock).ListRepositoryMemberships(uuid.New())
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,8 @@
 package authentication
 
 import (
+	"database/sql"
+
 	"github.com/google/uuid"
 
 	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
@@ -16,6 +18,7 @@ type IRepository interface {
 	GetRepositoryGroups(repositoryID uuid.UUID) (*authEntities.AuthzGroups, error)
 	GetWorkspaceRole(accountID, workspaceID uuid.UUID) (accountEnums.Role, error)
 	GetRepositoryRole(accountID, repositoryID uuid.UUID) (accountEnums.Role, error)
+	GetCustomRoles(accountID, workspaceID, repositoryID uuid.UUID) (*[]authEntities.CustomRole, error)
 }
 
 type Repository struct {
@@ -53,9 +56,51 @@ func (r *Repository) GetWorkspaceRole(accountID, workspaceID uuid.UUID) (account
 		authEnums.TableAccountWorkspace).GetError()
 }
 
+// GetRepositoryRole returns the highest role of the account in the repository, considering the role granted
+// directly to the account and the roles granted to its teams
 func (r *Repository) GetRepositoryRole(accountID, repositoryID uuid.UUID) (accountEnums.Role, error) {
 	role := &authEntities.Role{}
 
-	return role.Role, r.databaseRead.Find(role, r.useCases.FilterAccountRepositoryByID(accountID, repositoryID),
-		authEnums.TableAccountRepository).GetError()
+	err := r.databaseRead.Raw(r.queryGetRepositoryRole(), role,
+		sql.Named("accountID", accountID), sql.Named("repositoryID", repositoryID)).GetError()
+
+	return role.Role, err
+}
+
+func (r *Repository) queryGetRepositoryRole() string {
+	return `
+			SELECT roles.role
+			FROM (
+				SELECT ar.role FROM account_repository AS ar
+				WHERE ar.account_id = @accountID AND ar.repository_id = @repositoryID
+				UNION ALL
+				SELECT tr.role FROM team_repository AS tr
+				INNER JOIN team_account AS ta ON ta.team_id = tr.team_id
+				WHERE ta.account_id = @accountID AND tr.repository_id = @repositoryID
+			) AS roles
+			ORDER BY CASE roles.role WHEN 'admin' THEN 1 WHEN 'supervisor' THEN 2 ELSE 3 END
+			LIMIT 1
+	`
+}
+
+// GetCustomRoles returns the custom role of the account in the workspace and in the repository, a custom role of the
+// workspace also applies to its repositories
+func (r *Repository) GetCustomRoles(accountID, workspaceID,
+	repositoryID uuid.UUID) (*[]authEntities.CustomRole, error) {
+	customRoles := &[]authEntities.CustomRole{}
+
+	return customRoles, r.databaseRead.Raw(r.queryGetCustomRoles(), customRoles, sql.Named("accountID", accountID),
+		sql.Named("workspaceID", workspaceID), sql.Named("repositoryID", repositoryID)).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryGetCustomRoles() string {
+	return `
+			SELECT cr.permissions FROM custom_roles AS cr
+			INNER JOIN account_workspace AS aw ON aw.custom_role_id = cr.custom_role_id
+			WHERE aw.account_id = @accountID AND aw.workspace_id = @workspaceID
+			UNION ALL
+			SELECT cr.permissions FROM custom_roles AS cr
+			INNER JOIN account_repository AS ar ON ar.custom_role_id = cr.custom_role_id
+			WHERE ar.account_id = @accountID AND ar.repository_id = @repositoryID
+	`
 }
@@ -33,3 +33,8 @@ func (m *Mock) GetRepositoryRole(_, _ uuid.UUID) (accountEnums.Role, error) {
 	args := m.MethodCalled("GetRepositoryRole")
 	return args.Get(0).(accountEnums.Role), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetCustomRoles(_, _, _ uuid.UUID) (*[]authEntities.CustomRole, error) {
+	args := m.MethodCalled("GetCustomRoles")
+	return args.Get(0).(*[]authEntities.CustomRole), mockUtils.ReturnNilOrError(args, 1)
+}
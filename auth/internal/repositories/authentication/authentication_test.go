@@ -63,7 +63,7 @@ func TestGetWorkspaceRole(t *testing.T) {
 func TestGetRepositoryRole(t *testing.T) {
 	t.Run("should success get repository role", func(t *testing.T) {
 		databaseMock := &database.Mock{}
-		databaseMock.On("Find").Return(&response.Response{})
+		databaseMock.On("Raw").Return(&response.Response{})
 
 		repository := NewAuthenticationRepository(&database.Connection{
 			Read: databaseMock, Write: databaseMock}, authUseCases.NewAuthenticationUseCases())
@@ -73,3 +73,17 @@ func TestGetRepositoryRole(t *testing.T) {
 		assert.NotNil(t, account)
 	})
 }
+
+func TestGetCustomRoles(t *testing.T) {
+	t.Run("should success get custom roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		repository := NewAuthenticationRepository(&database.Connection{
+			Read: databaseMock, Write: databaseMock}, authUseCases.NewAuthenticationUseCases())
+
+		customRoles, err := repository.GetCustomRoles(uuid.New(), uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, customRoles)
+	})
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	cacheEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/cache"
+)
+
+// IRepository is a cache stored in the database, unlike the in memory cache lib it is shared by all auth replicas
+type IRepository interface {
+	Get(key string) (string, error)
+	Set(key, value string, duration time.Duration) error
+	Delete(key string) error
+	Pop(key string) (string, error)
+	Increment(key string, duration time.Duration) (int, error)
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewCacheRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) Get(key string) (string, error) {
+	value := ""
+
+	return value, r.databaseRead.Raw(r.queryGetValue(), &value, key, time.Now()).GetError()
+}
+
+func (r *Repository) queryGetValue() string {
+	return `
+		SELECT value
+		FROM cache
+		WHERE key = ? AND expires_at > ?
+	`
+}
+
+// Set and Increment use raw upserts returning the written value, the write connection doesn't support raw queries
+func (r *Repository) Set(key, value string, duration time.Duration) error {
+	written := ""
+
+	return r.databaseRead.Raw(r.queryUpsertValue(), &written, key, value, time.Now().Add(duration),
+		time.Now()).GetError()
+}
+
+func (r *Repository) queryUpsertValue() string {
+	return `
+		INSERT INTO cache (key, value, expires_at, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at,
+			created_at = EXCLUDED.created_at
+		RETURNING value
+	`
+}
+
+func (r *Repository) Delete(key string) error {
+	return r.databaseWrite.Delete(map[string]interface{}{"key": key}, cacheEnums.DatabaseTableCache).GetError()
+}
+
+// Pop atomically gets and deletes a value, ensuring that a one time value is used only once among all replicas
+func (r *Repository) Pop(key string) (string, error) {
+	value := ""
+
+	return value, r.databaseRead.Raw(r.queryPopValue(), &value, key, time.Now()).GetError()
+}
+
+func (r *Repository) queryPopValue() string {
+	return `
+		DELETE FROM cache
+		WHERE key = ? AND expires_at > ?
+		RETURNING value
+	`
+}
+
+// Increment atomically adds one to a counter, restarting it when expired, the expiration is only set when the
+// counter starts, so it counts the occurrences inside a fixed window
+func (r *Repository) Increment(key string, duration time.Duration) (int, error) {
+	count := 0
+
+	return count, r.databaseRead.Raw(r.queryIncrementValue(), &count, key, time.Now().Add(duration),
+		time.Now()).GetError()
+}
+
+func (r *Repository) queryIncrementValue() string {
+	return `
+		INSERT INTO cache AS c (key, value, expires_at, created_at)
+		VALUES (?, '1', ?, ?)
+		ON CONFLICT (key) DO UPDATE SET
+			value = CASE WHEN c.expires_at > EXCLUDED.created_at THEN (c.value::INTEGER + 1)::TEXT ELSE '1' END,
+			expires_at = CASE WHEN c.expires_at > EXCLUDED.created_at THEN c.expires_at ELSE EXCLUDED.expires_at END,
+			created_at = CASE WHEN c.expires_at > EXCLUDED.created_at THEN c.created_at ELSE EXCLUDED.created_at END
+		RETURNING value::INTEGER
+	`
+}
@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Get(_ string) (string, error) {
+	args := m.MethodCalled("Get")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Set(_, _ string, _ time.Duration) error {
+	args := m.MethodCalled("Set")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Delete(_ string) error {
+	args := m.MethodCalled("Delete")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Pop(_ string) (string, error) {
+	args := m.MethodCalled("Pop")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Increment(_ string, _ time.Duration) (int, error) {
+	args := m.MethodCalled("Increment")
+	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
+}
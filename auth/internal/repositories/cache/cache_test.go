@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewCacheRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewCacheRepository(t *testing.T) {
+	t.Run("should create cache repository", func(t *testing.T) {
+		assert.NotNil(t, NewCacheRepository(&database.Connection{}))
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should success get value", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).Get("test")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found when missing or expired", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).Get("test")
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestSet(t *testing.T) {
+	t.Run("should success set value", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).Set("test", "test", time.Minute))
+	})
+
+	t.Run("should return error when failed to set value", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).Set("test", "test", time.Minute))
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should success delete value", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).Delete("test"))
+	})
+}
+
+func TestPop(t *testing.T) {
+	t.Run("should success pop value", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).Pop("test")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return not found when missing, expired or already used", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).Pop("test")
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestIncrement(t *testing.T) {
+	t.Run("should success increment counter", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).Increment("test", time.Minute)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to increment counter", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := getRepository(databaseMock).Increment("test", time.Minute)
+		assert.Error(t, err)
+	})
+}
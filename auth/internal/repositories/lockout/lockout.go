@@ -0,0 +1,28 @@
+package lockout
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+)
+
+type IRepository interface {
+	CreateEvent(event *lockoutEntities.Event) error
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewLockoutRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) CreateEvent(event *lockoutEntities.Event) error {
+	return r.databaseWrite.Create(event, lockoutEnums.DatabaseTableLockoutEvents).GetError()
+}
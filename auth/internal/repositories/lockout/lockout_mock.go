@@ -0,0 +1,18 @@
+package lockout
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateEvent(_ *lockoutEntities.Event) error {
+	args := m.MethodCalled("CreateEvent")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,40 @@
+package lockout
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+)
+
+func TestNewLockoutRepository(t *testing.T) {
+	t.Run("should create lockout repository", func(t *testing.T) {
+		assert.NotNil(t, NewLockoutRepository(&database.Connection{}))
+	})
+}
+
+func TestCreateEvent(t *testing.T) {
+	event := lockoutEntities.NewLockedEvent(lockoutEnums.TargetIP, "", "127.0.0.1")
+
+	t.Run("should success create lockout event", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		repository := NewLockoutRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+		assert.NoError(t, repository.CreateEvent(event))
+	})
+
+	t.Run("should return error when failed to create lockout event", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewLockoutRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+		assert.Error(t, repository.CreateEvent(event))
+	})
+}
@@ -0,0 +1,141 @@
+package mfa
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
+)
+
+type IRepository interface {
+	SetSecret(accountID uuid.UUID, secret string) error
+	Enable(accountID uuid.UUID, recoveryCodes []*mfaEntities.RecoveryCode) error
+	Disable(accountID uuid.UUID) error
+	ReplaceRecoveryCodes(accountID uuid.UUID, recoveryCodes []*mfaEntities.RecoveryCode) error
+	ListUnusedRecoveryCodes(accountID uuid.UUID) ([]*mfaEntities.RecoveryCode, error)
+	SetRecoveryCodeUsed(recoveryCode *mfaEntities.RecoveryCode) error
+	IsRequiredByWorkspace(accountID uuid.UUID) (bool, error)
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+	useCases      accountUseCases.IUseCases
+}
+
+func NewMFARepository(connection *database.Connection, useCases accountUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+		useCases:      useCases,
+	}
+}
+
+func (r *Repository) SetSecret(accountID uuid.UUID, secret string) error {
+	return r.databaseWrite.Update(map[string]interface{}{"mfa_secret": secret, "updated_at": time.Now()},
+		r.useCases.FilterAccountByID(accountID), accountEnums.DatabaseTableAccount).GetError()
+}
+
+func (r *Repository) Enable(accountID uuid.UUID, recoveryCodes []*mfaEntities.RecoveryCode) error {
+	transaction := r.databaseWrite.StartTransaction()
+
+	if err := r.setEnabled(transaction, accountID, true); err != nil {
+		logger.LogError(mfaEnums.MessageFailedToRollbackEnable, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := r.replaceRecoveryCodes(transaction, accountID, recoveryCodes); err != nil {
+		logger.LogError(mfaEnums.MessageFailedToRollbackEnable, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (r *Repository) Disable(accountID uuid.UUID) error {
+	transaction := r.databaseWrite.StartTransaction()
+
+	if err := r.setEnabled(transaction, accountID, false); err != nil {
+		logger.LogError(mfaEnums.MessageFailedToRollbackDisable, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := r.replaceRecoveryCodes(transaction, accountID, nil); err != nil {
+		logger.LogError(mfaEnums.MessageFailedToRollbackDisable, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (r *Repository) ReplaceRecoveryCodes(accountID uuid.UUID, recoveryCodes []*mfaEntities.RecoveryCode) error {
+	transaction := r.databaseWrite.StartTransaction()
+
+	if err := r.replaceRecoveryCodes(transaction, accountID, recoveryCodes); err != nil {
+		logger.LogError(mfaEnums.MessageFailedToRollbackRecoveryCodes, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+// setEnabled updates with a map, a struct would skip the false value and the secret cleanup when disabling
+func (r *Repository) setEnabled(transaction database.IDatabaseWrite, accountID uuid.UUID, isEnabled bool) error {
+	values := map[string]interface{}{"is_mfa_enabled": isEnabled, "updated_at": time.Now()}
+	if !isEnabled {
+		values["mfa_secret"] = ""
+	}
+
+	return transaction.Update(values, r.useCases.FilterAccountByID(accountID),
+		accountEnums.DatabaseTableAccount).GetError()
+}
+
+func (r *Repository) replaceRecoveryCodes(transaction database.IDatabaseWrite, accountID uuid.UUID,
+	recoveryCodes []*mfaEntities.RecoveryCode) error {
+	err := transaction.Delete(r.useCases.FilterAccountByID(accountID), mfaEnums.DatabaseTableRecoveryCodes).GetError()
+	if err != nil || len(recoveryCodes) == 0 {
+		return err
+	}
+
+	return transaction.Create(&recoveryCodes, mfaEnums.DatabaseTableRecoveryCodes).GetError()
+}
+
+func (r *Repository) ListUnusedRecoveryCodes(accountID uuid.UUID) ([]*mfaEntities.RecoveryCode, error) {
+	var recoveryCodes []*mfaEntities.RecoveryCode
+
+	filter := r.useCases.FilterAccountByID(accountID)
+	filter["used_at"] = nil
+
+	return recoveryCodes, r.databaseRead.Find(&recoveryCodes, filter, mfaEnums.DatabaseTableRecoveryCodes).
+		GetErrorExceptNotFound()
+}
+
+func (r *Repository) SetRecoveryCodeUsed(recoveryCode *mfaEntities.RecoveryCode) error {
+	return r.databaseWrite.Update(recoveryCode.SetUsed(),
+		map[string]interface{}{"recovery_code_id": recoveryCode.RecoveryCodeID},
+		mfaEnums.DatabaseTableRecoveryCodes).GetError()
+}
+
+func (r *Repository) IsRequiredByWorkspace(accountID uuid.UUID) (bool, error) {
+	count := 0
+
+	err := r.databaseRead.Raw(r.queryCountWorkspacesRequiringMFA(), &count, accountID).GetErrorExceptNotFound()
+
+	return count > 0, err
+}
+
+func (r *Repository) queryCountWorkspacesRequiringMFA() string {
+	return `
+		SELECT COUNT(ws.workspace_id)
+		FROM workspaces AS ws
+		INNER JOIN account_workspace AS aw ON aw.workspace_id = ws.workspace_id
+		WHERE aw.account_id = ? AND ws.require_mfa = true
+	`
+}
@@ -0,0 +1,49 @@
+package mfa
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) SetSecret(_ uuid.UUID, _ string) error {
+	args := m.MethodCalled("SetSecret")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Enable(_ uuid.UUID, _ []*mfaEntities.RecoveryCode) error {
+	args := m.MethodCalled("Enable")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Disable(_ uuid.UUID) error {
+	args := m.MethodCalled("Disable")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ReplaceRecoveryCodes(_ uuid.UUID, _ []*mfaEntities.RecoveryCode) error {
+	args := m.MethodCalled("ReplaceRecoveryCodes")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListUnusedRecoveryCodes(_ uuid.UUID) ([]*mfaEntities.RecoveryCode, error) {
+	args := m.MethodCalled("ListUnusedRecoveryCodes")
+	return args.Get(0).([]*mfaEntities.RecoveryCode), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SetRecoveryCodeUsed(_ *mfaEntities.RecoveryCode) error {
+	args := m.MethodCalled("SetRecoveryCodeUsed")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) IsRequiredByWorkspace(_ uuid.UUID) (bool, error) {
+	args := m.MethodCalled("IsRequiredByWorkspace")
+	return args.Get(0).(bool), mockUtils.ReturnNilOrError(args, 1)
+}
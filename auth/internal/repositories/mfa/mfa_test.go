@@ -0,0 +1,160 @@
+package mfa
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewMFARepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+		accountUseCases.NewAccountUseCases(&app.Config{}))
+}
+
+func TestNewMFARepository(t *testing.T) {
+	t.Run("should create mfa repository", func(t *testing.T) {
+		assert.NotNil(t, NewMFARepository(&database.Connection{}, nil))
+	})
+}
+
+func TestSetSecret(t *testing.T) {
+	t.Run("should success set mfa secret", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).SetSecret(uuid.New(), "test"))
+	})
+}
+
+func TestEnable(t *testing.T) {
+	t.Run("should success enable mfa and save recovery codes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		recoveryCodes := []*mfaEntities.RecoveryCode{{RecoveryCodeID: uuid.New()}}
+
+		assert.NoError(t, getRepository(databaseMock).Enable(uuid.New(), recoveryCodes))
+	})
+
+	t.Run("should return error and rollback when failed to update account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		assert.Error(t, getRepository(databaseMock).Enable(uuid.New(), nil))
+	})
+
+	t.Run("should return error and rollback when failed to save recovery codes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		assert.Error(t, getRepository(databaseMock).Enable(uuid.New(), nil))
+	})
+}
+
+func TestDisable(t *testing.T) {
+	t.Run("should success disable mfa", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).Disable(uuid.New()))
+	})
+
+	t.Run("should return error and rollback when failed to update account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		assert.Error(t, getRepository(databaseMock).Disable(uuid.New()))
+	})
+
+	t.Run("should return error and rollback when failed to delete recovery codes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		assert.Error(t, getRepository(databaseMock).Disable(uuid.New()))
+	})
+}
+
+func TestReplaceRecoveryCodes(t *testing.T) {
+	t.Run("should success replace recovery codes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		recoveryCodes := []*mfaEntities.RecoveryCode{{RecoveryCodeID: uuid.New()}}
+
+		assert.NoError(t, getRepository(databaseMock).ReplaceRecoveryCodes(uuid.New(), recoveryCodes))
+	})
+
+	t.Run("should return error and rollback when failed to create recovery codes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		recoveryCodes := []*mfaEntities.RecoveryCode{{RecoveryCodeID: uuid.New()}}
+
+		assert.Error(t, getRepository(databaseMock).ReplaceRecoveryCodes(uuid.New(), recoveryCodes))
+	})
+}
+
+func TestListUnusedRecoveryCodes(t *testing.T) {
+	t.Run("should success list unused recovery codes", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).ListUnusedRecoveryCodes(uuid.New())
+		assert.NoError(t, err)
+	})
+}
+
+func TestSetRecoveryCodeUsed(t *testing.T) {
+	t.Run("should success set recovery code as used", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		recoveryCode := &mfaEntities.RecoveryCode{RecoveryCodeID: uuid.New()}
+
+		assert.NoError(t, getRepository(databaseMock).SetRecoveryCodeUsed(recoveryCode))
+		assert.NotNil(t, recoveryCode.UsedAt)
+	})
+}
+
+func TestIsRequiredByWorkspace(t *testing.T) {
+	t.Run("should success check if mfa is required by workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		isRequired, err := getRepository(databaseMock).IsRequiredByWorkspace(uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, isRequired)
+	})
+}
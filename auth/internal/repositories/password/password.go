@@ -0,0 +1,70 @@
+package password
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+type IRepository interface {
+	CreateHistory(history *passwordEntities.History) error
+	ListHistory(accountID uuid.UUID, size int) ([]*passwordEntities.History, error)
+	DeleteOldHistory(accountID uuid.UUID, size int) error
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewPasswordRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) CreateHistory(history *passwordEntities.History) error {
+	return r.databaseWrite.Create(history, passwordEnums.DatabaseTableHistory).GetError()
+}
+
+func (r *Repository) ListHistory(accountID uuid.UUID, size int) ([]*passwordEntities.History, error) {
+	var history []*passwordEntities.History
+
+	return history, r.databaseRead.Raw(r.queryListHistory(), &history, accountID, size).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListHistory() string {
+	return `
+		SELECT *
+		FROM account_password_history
+		WHERE account_id = ?
+		ORDER BY created_at DESC
+		LIMIT ?
+	`
+}
+
+// DeleteOldHistory keeps only the last passwords of the account that are checked by the policy
+func (r *Repository) DeleteOldHistory(accountID uuid.UUID, size int) error {
+	var deleted []uuid.UUID
+
+	return r.databaseRead.Raw(r.queryDeleteOldHistory(), &deleted, accountID, accountID,
+		size).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryDeleteOldHistory() string {
+	return `
+		DELETE FROM account_password_history
+		WHERE account_id = ? AND password_history_id NOT IN (
+			SELECT password_history_id
+			FROM account_password_history
+			WHERE account_id = ?
+			ORDER BY created_at DESC
+			LIMIT ?
+		)
+		RETURNING password_history_id
+	`
+}
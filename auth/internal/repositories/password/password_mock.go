@@ -0,0 +1,29 @@
+package password
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateHistory(_ *passwordEntities.History) error {
+	args := m.MethodCalled("CreateHistory")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListHistory(_ uuid.UUID, _ int) ([]*passwordEntities.History, error) {
+	args := m.MethodCalled("ListHistory")
+	return args.Get(0).([]*passwordEntities.History), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DeleteOldHistory(_ uuid.UUID, _ int) error {
+	args := m.MethodCalled("DeleteOldHistory")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,74 @@
+package password
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewPasswordRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewPasswordRepository(t *testing.T) {
+	t.Run("should create password repository", func(t *testing.T) {
+		assert.NotNil(t, NewPasswordRepository(&database.Connection{}))
+	})
+}
+
+func TestCreateHistory(t *testing.T) {
+	t.Run("should success create history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).CreateHistory(&passwordEntities.History{}))
+	})
+
+	t.Run("should return error when failed to create history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).CreateHistory(&passwordEntities.History{}))
+	})
+}
+
+func TestListHistory(t *testing.T) {
+	t.Run("should success list history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).ListHistory(uuid.New(), 5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := getRepository(databaseMock).ListHistory(uuid.New(), 5)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeleteOldHistory(t *testing.T) {
+	t.Run("should success delete old history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).DeleteOldHistory(uuid.New(), 5))
+	})
+
+	t.Run("should return error when failed to delete old history", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).DeleteOldHistory(uuid.New(), 5))
+	})
+}
@@ -0,0 +1,79 @@
+package personaltoken
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+)
+
+type IRepository interface {
+	CreateToken(token *personalTokenEntities.PersonalAccessToken) error
+	GetToken(tokenID uuid.UUID) (*personalTokenEntities.PersonalAccessToken, error)
+	ListTokens(accountID uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error)
+	RevokeToken(accountID, tokenID uuid.UUID) error
+	UpdateLastUsed(tokenID uuid.UUID) error
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewPersonalTokenRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) CreateToken(token *personalTokenEntities.PersonalAccessToken) error {
+	return r.databaseWrite.Create(token, personalTokenEnums.DatabaseTablePersonalAccessTokens).GetError()
+}
+
+func (r *Repository) GetToken(tokenID uuid.UUID) (*personalTokenEntities.PersonalAccessToken, error) {
+	token := &personalTokenEntities.PersonalAccessToken{}
+
+	return token, r.databaseRead.Find(token, map[string]interface{}{"token_id": tokenID},
+		personalTokenEnums.DatabaseTablePersonalAccessTokens).GetError()
+}
+
+// ListTokens returns the tokens that weren't revoked, the expired ones are kept to be revoked by the owner
+func (r *Repository) ListTokens(accountID uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error) {
+	var tokens []*personalTokenEntities.PersonalAccessToken
+
+	return tokens, r.databaseRead.Raw(r.queryListTokens(), &tokens, accountID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListTokens() string {
+	return `
+		SELECT *
+		FROM personal_access_tokens
+		WHERE account_id = ? AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+}
+
+func (r *Repository) RevokeToken(accountID, tokenID uuid.UUID) error {
+	result := r.databaseWrite.Update(map[string]interface{}{"revoked_at": time.Now()}, map[string]interface{}{
+		"token_id": tokenID, "account_id": accountID, "revoked_at": nil,
+	}, personalTokenEnums.DatabaseTablePersonalAccessTokens)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return personalTokenEnums.ErrorTokenNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateLastUsed(tokenID uuid.UUID) error {
+	return r.databaseWrite.Update(map[string]interface{}{"last_used_at": time.Now()},
+		map[string]interface{}{"token_id": tokenID}, personalTokenEnums.DatabaseTablePersonalAccessTokens).GetError()
+}
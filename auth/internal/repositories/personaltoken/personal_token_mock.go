@@ -0,0 +1,39 @@
+package personaltoken
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateToken(_ *personalTokenEntities.PersonalAccessToken) error {
+	args := m.MethodCalled("CreateToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetToken(_ uuid.UUID) (*personalTokenEntities.PersonalAccessToken, error) {
+	args := m.MethodCalled("GetToken")
+	return args.Get(0).(*personalTokenEntities.PersonalAccessToken), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTokens(_ uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error) {
+	args := m.MethodCalled("ListTokens")
+	return args.Get(0).([]*personalTokenEntities.PersonalAccessToken), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeToken(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) UpdateLastUsed(_ uuid.UUID) error {
+	args := m.MethodCalled("UpdateLastUsed")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,129 @@
+package personaltoken
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewPersonalTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewPersonalTokenRepository(t *testing.T) {
+	t.Run("should create personal token repository", func(t *testing.T) {
+		assert.NotNil(t, NewPersonalTokenRepository(&database.Connection{}))
+	})
+}
+
+func TestCreateToken(t *testing.T) {
+	token, _ := personalTokenEntities.NewPersonalAccessToken(&personalTokenEntities.Data{})
+
+	t.Run("should success create token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).CreateToken(token))
+	})
+
+	t.Run("should return error when failed to create token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).CreateToken(token))
+	})
+}
+
+func TestGetToken(t *testing.T) {
+	t.Run("should success get token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		result, err := getRepository(databaseMock).GetToken(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when token not found", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).GetToken(uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	t.Run("should success list tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).ListTokens(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not return error when there are no tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).ListTokens(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := getRepository(databaseMock).ListTokens(uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("should success revoke token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, getRepository(databaseMock).RevokeToken(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return not found when token is revoked or from another account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, personalTokenEnums.ErrorTokenNotFound,
+			getRepository(databaseMock).RevokeToken(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return error when failed to update token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).RevokeToken(uuid.New(), uuid.New()))
+	})
+}
+
+func TestUpdateLastUsed(t *testing.T) {
+	t.Run("should success update last used", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, getRepository(databaseMock).UpdateLastUsed(uuid.New()))
+	})
+
+	t.Run("should return error when failed to update last used", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).UpdateLastUsed(uuid.New()))
+	})
+}
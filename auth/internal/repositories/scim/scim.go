@@ -0,0 +1,295 @@
+package scim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+	accountTableEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+type IRepository interface {
+	CreateToken(token *scimEntities.Token) error
+	GetToken(tokenID uuid.UUID) (*scimEntities.Token, error)
+	ListTokens() ([]*scimEntities.Token, error)
+	RevokeToken(tokenID uuid.UUID) error
+	ListAccounts(query *scimEntities.ListQuery) ([]*accountEntities.Account, int, error)
+	CreateGroup(group *scimEntities.Group) error
+	GetGroup(groupID uuid.UUID) (*scimEntities.Group, error)
+	GetGroupByDisplayName(displayName string) (*scimEntities.Group, error)
+	ListGroups(query *scimEntities.ListQuery) ([]*scimEntities.Group, int, error)
+	UpdateGroup(group *scimEntities.Group) error
+	DeleteGroup(groupID uuid.UUID) error
+	ListGroupMembers(groupID uuid.UUID) ([]*scimEntities.GroupMember, error)
+	AddGroupMember(member *scimEntities.GroupMember) error
+	RemoveGroupMember(groupID, accountID uuid.UUID) error
+	ListGroupRoles(groupID uuid.UUID) ([]*scimEntities.GroupRole, error)
+	ReplaceGroupRoles(groupID uuid.UUID, roles []*scimEntities.GroupRole) error
+	GrantGroupRoles(groupID, accountID uuid.UUID) error
+	RevokeGroupRoles(groupID, accountID uuid.UUID) error
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewSCIMRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) CreateToken(token *scimEntities.Token) error {
+	return r.databaseWrite.Create(token, scimEnums.DatabaseTableTokens).GetError()
+}
+
+func (r *Repository) GetToken(tokenID uuid.UUID) (*scimEntities.Token, error) {
+	token := &scimEntities.Token{}
+
+	return token, r.databaseRead.Find(token, map[string]interface{}{"token_id": tokenID},
+		scimEnums.DatabaseTableTokens).GetError()
+}
+
+func (r *Repository) ListTokens() ([]*scimEntities.Token, error) {
+	var tokens []*scimEntities.Token
+
+	return tokens, r.databaseRead.Raw(r.queryListTokens(), &tokens).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListTokens() string {
+	return `
+		SELECT *
+		FROM scim_tokens
+		WHERE revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+}
+
+func (r *Repository) RevokeToken(tokenID uuid.UUID) error {
+	result := r.databaseWrite.Update(map[string]interface{}{"revoked_at": time.Now()},
+		map[string]interface{}{"token_id": tokenID, "revoked_at": nil}, scimEnums.DatabaseTableTokens)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return scimEnums.ErrorTokenNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) ListAccounts(query *scimEntities.ListQuery) ([]*accountEntities.Account, int, error) {
+	var accounts []*accountEntities.Account
+
+	total, err := r.list(accountTableEnums.DatabaseTableAccount, "account_id", &accounts, query)
+	return accounts, total, err
+}
+
+// list returns a page of the table and the total of rows matching the filter, the filter column comes from the
+// list query whitelist so it's safe to be formatted into the query
+func (r *Repository) list(table, idColumn string, entities interface{},
+	query *scimEntities.ListQuery) (total int, err error) {
+	where, values := r.getListFilter(query)
+
+	err = r.databaseRead.Raw(fmt.Sprintf("SELECT COUNT(*) FROM %s %s", table, where), &total,
+		values...).GetErrorExceptNotFound()
+	if err != nil || query.Count == 0 {
+		return total, err
+	}
+
+	return total, r.databaseRead.Raw(fmt.Sprintf("SELECT * FROM %s %s ORDER BY created_at, %s LIMIT ? OFFSET ?",
+		table, where, idColumn), entities, append(values, query.Count, query.GetOffset())...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) getListFilter(query *scimEntities.ListQuery) (where string, values []interface{}) {
+	if !query.HasFilter() {
+		return "", []interface{}{}
+	}
+
+	return fmt.Sprintf("WHERE LOWER(%s) = LOWER(?)", query.FilterColumn), []interface{}{query.FilterValue}
+}
+
+func (r *Repository) CreateGroup(group *scimEntities.Group) error {
+	return r.databaseWrite.Create(group, scimEnums.DatabaseTableGroups).GetError()
+}
+
+func (r *Repository) GetGroup(groupID uuid.UUID) (*scimEntities.Group, error) {
+	group := &scimEntities.Group{}
+
+	return group, r.databaseRead.Find(group, map[string]interface{}{"group_id": groupID},
+		scimEnums.DatabaseTableGroups).GetError()
+}
+
+func (r *Repository) GetGroupByDisplayName(displayName string) (*scimEntities.Group, error) {
+	group := &scimEntities.Group{}
+
+	return group, r.databaseRead.Find(group, map[string]interface{}{"display_name": displayName},
+		scimEnums.DatabaseTableGroups).GetError()
+}
+
+func (r *Repository) ListGroups(query *scimEntities.ListQuery) ([]*scimEntities.Group, int, error) {
+	var groups []*scimEntities.Group
+
+	total, err := r.list(scimEnums.DatabaseTableGroups, "group_id", &groups, query)
+	return groups, total, err
+}
+
+func (r *Repository) UpdateGroup(group *scimEntities.Group) error {
+	return r.databaseWrite.Update(map[string]interface{}{
+		"display_name": group.DisplayName, "external_id": group.ExternalID, "updated_at": group.UpdatedAt,
+	}, map[string]interface{}{"group_id": group.GroupID}, scimEnums.DatabaseTableGroups).GetError()
+}
+
+// DeleteGroup the members and the roles of the group are removed by the foreign keys
+func (r *Repository) DeleteGroup(groupID uuid.UUID) error {
+	return r.databaseWrite.Delete(map[string]interface{}{"group_id": groupID},
+		scimEnums.DatabaseTableGroups).GetError()
+}
+
+func (r *Repository) ListGroupMembers(groupID uuid.UUID) ([]*scimEntities.GroupMember, error) {
+	var members []*scimEntities.GroupMember
+
+	return members, r.databaseRead.Raw(r.queryListGroupMembers(), &members, groupID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListGroupMembers() string {
+	return `
+		SELECT gm.group_id, gm.account_id, gm.created_at, ac.username
+		FROM scim_group_members AS gm
+		INNER JOIN accounts AS ac ON ac.account_id = gm.account_id
+		WHERE gm.group_id = ?
+		ORDER BY ac.username
+	`
+}
+
+func (r *Repository) AddGroupMember(member *scimEntities.GroupMember) error {
+	return r.databaseWrite.Create(member, scimEnums.DatabaseTableGroupMembers).GetError()
+}
+
+func (r *Repository) RemoveGroupMember(groupID, accountID uuid.UUID) error {
+	return r.databaseWrite.Delete(map[string]interface{}{"group_id": groupID, "account_id": accountID},
+		scimEnums.DatabaseTableGroupMembers).GetError()
+}
+
+func (r *Repository) ListGroupRoles(groupID uuid.UUID) ([]*scimEntities.GroupRole, error) {
+	var roles []*scimEntities.GroupRole
+
+	return roles, r.databaseRead.Find(&roles, map[string]interface{}{"group_id": groupID},
+		scimEnums.DatabaseTableGroupRoles).GetErrorExceptNotFound()
+}
+
+func (r *Repository) ReplaceGroupRoles(groupID uuid.UUID, roles []*scimEntities.GroupRole) error {
+	if err := r.databaseWrite.Delete(map[string]interface{}{"group_id": groupID},
+		scimEnums.DatabaseTableGroupRoles).GetError(); err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if err := r.databaseWrite.Create(role, scimEnums.DatabaseTableGroupRoles).GetError(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GrantGroupRoles gives the account the workspace and repository roles mapped to the group, a repository role
+// also makes the account a member of its workspace. The roles the account already has are kept
+func (r *Repository) GrantGroupRoles(groupID, accountID uuid.UUID) error {
+	var granted []uuid.UUID
+
+	if err := r.databaseRead.Raw(r.queryGrantWorkspaceRoles(), &granted, accountID, accountEnums.Member,
+		time.Now(), time.Now(), groupID).GetErrorExceptNotFound(); err != nil {
+		return err
+	}
+
+	return r.databaseRead.Raw(r.queryGrantRepositoryRoles(), &granted, accountID, time.Now(), time.Now(),
+		groupID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryGrantWorkspaceRoles() string {
+	return `
+		INSERT INTO account_workspace (workspace_id, account_id, role, created_at, updated_at)
+		SELECT DISTINCT ON (gr.workspace_id) gr.workspace_id, ?,
+			CASE WHEN gr.repository_id IS NULL THEN gr.role ELSE ? END, ?, ?
+		FROM scim_group_roles AS gr
+		WHERE gr.group_id = ?
+		ORDER BY gr.workspace_id, gr.repository_id NULLS FIRST
+		ON CONFLICT (workspace_id, account_id) DO NOTHING
+		RETURNING workspace_id
+	`
+}
+
+func (r *Repository) queryGrantRepositoryRoles() string {
+	return `
+		INSERT INTO account_repository (repository_id, workspace_id, account_id, role, created_at, updated_at)
+		SELECT gr.repository_id, gr.workspace_id, ?, gr.role, ?, ?
+		FROM scim_group_roles AS gr
+		WHERE gr.group_id = ? AND gr.repository_id IS NOT NULL
+		ON CONFLICT (repository_id, account_id) DO NOTHING
+		RETURNING repository_id
+	`
+}
+
+// RevokeGroupRoles removes the workspace and repository roles mapped to the group, unless another group of the
+// account maps the same workspace or repository. As in core, leaving a workspace also removes its repository roles
+func (r *Repository) RevokeGroupRoles(groupID, accountID uuid.UUID) error {
+	var revoked []uuid.UUID
+
+	if err := r.databaseRead.Raw(r.queryRevokeRepositoryRoles(), &revoked, groupID,
+		accountID).GetErrorExceptNotFound(); err != nil {
+		return err
+	}
+
+	return r.databaseRead.Raw(r.queryRevokeWorkspaceRoles(), &revoked, groupID,
+		accountID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryRevokeRepositoryRoles() string {
+	return `
+		DELETE FROM account_repository AS ar
+		USING scim_group_roles AS gr
+		WHERE gr.group_id = ? AND gr.repository_id = ar.repository_id AND ar.account_id = ?
+		AND NOT EXISTS (
+			SELECT 1
+			FROM scim_group_members AS gm
+			INNER JOIN scim_group_roles AS other ON other.group_id = gm.group_id
+			WHERE gm.account_id = ar.account_id AND gm.group_id <> gr.group_id
+			AND other.repository_id = ar.repository_id
+		)
+		RETURNING ar.repository_id
+	`
+}
+
+//nolint:funlen // need to be bigger than 15
+func (r *Repository) queryRevokeWorkspaceRoles() string {
+	return `
+		WITH removed AS (
+			DELETE FROM account_workspace AS aw
+			USING scim_group_roles AS gr
+			WHERE gr.group_id = ? AND gr.workspace_id = aw.workspace_id AND aw.account_id = ?
+			AND NOT EXISTS (
+				SELECT 1
+				FROM scim_group_members AS gm
+				INNER JOIN scim_group_roles AS other ON other.group_id = gm.group_id
+				WHERE gm.account_id = aw.account_id AND gm.group_id <> gr.group_id
+				AND other.workspace_id = aw.workspace_id
+			)
+			RETURNING aw.workspace_id, aw.account_id
+		)
+		DELETE FROM account_repository AS ar
+		USING removed
+		WHERE ar.workspace_id = removed.workspace_id AND ar.account_id = removed.account_id
+		RETURNING ar.repository_id
+	`
+}
@@ -0,0 +1,105 @@
+package scim
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateToken(_ *scimEntities.Token) error {
+	args := m.MethodCalled("CreateToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetToken(_ uuid.UUID) (*scimEntities.Token, error) {
+	args := m.MethodCalled("GetToken")
+	return args.Get(0).(*scimEntities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTokens() ([]*scimEntities.Token, error) {
+	args := m.MethodCalled("ListTokens")
+	return args.Get(0).([]*scimEntities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeToken(_ uuid.UUID) error {
+	args := m.MethodCalled("RevokeToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListAccounts(_ *scimEntities.ListQuery) ([]*accountEntities.Account, int, error) {
+	args := m.MethodCalled("ListAccounts")
+	return args.Get(0).([]*accountEntities.Account), args.Get(1).(int), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) CreateGroup(_ *scimEntities.Group) error {
+	args := m.MethodCalled("CreateGroup")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetGroup(_ uuid.UUID) (*scimEntities.Group, error) {
+	args := m.MethodCalled("GetGroup")
+	return args.Get(0).(*scimEntities.Group), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetGroupByDisplayName(_ string) (*scimEntities.Group, error) {
+	args := m.MethodCalled("GetGroupByDisplayName")
+	return args.Get(0).(*scimEntities.Group), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListGroups(_ *scimEntities.ListQuery) ([]*scimEntities.Group, int, error) {
+	args := m.MethodCalled("ListGroups")
+	return args.Get(0).([]*scimEntities.Group), args.Get(1).(int), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) UpdateGroup(_ *scimEntities.Group) error {
+	args := m.MethodCalled("UpdateGroup")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) DeleteGroup(_ uuid.UUID) error {
+	args := m.MethodCalled("DeleteGroup")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListGroupMembers(_ uuid.UUID) ([]*scimEntities.GroupMember, error) {
+	args := m.MethodCalled("ListGroupMembers")
+	return args.Get(0).([]*scimEntities.GroupMember), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) AddGroupMember(_ *scimEntities.GroupMember) error {
+	args := m.MethodCalled("AddGroupMember")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RemoveGroupMember(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RemoveGroupMember")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListGroupRoles(_ uuid.UUID) ([]*scimEntities.GroupRole, error) {
+	args := m.MethodCalled("ListGroupRoles")
+	return args.Get(0).([]*scimEntities.GroupRole), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ReplaceGroupRoles(_ uuid.UUID, _ []*scimEntities.GroupRole) error {
+	args := m.MethodCalled("ReplaceGroupRoles")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GrantGroupRoles(_, _ uuid.UUID) error {
+	args := m.MethodCalled("GrantGroupRoles")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeGroupRoles(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeGroupRoles")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
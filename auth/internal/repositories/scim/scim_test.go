@@ -0,0 +1,313 @@
+package scim
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	scimEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/scim"
+	scimEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/scim"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewSCIMRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func getListQuery(filter string) *scimEntities.ListQuery {
+	query, _ := scimEntities.NewListQuery(scimEnums.ResourceTypeUser, filter, "", "")
+	return query
+}
+
+func TestNewSCIMRepository(t *testing.T) {
+	t.Run("should create scim repository", func(t *testing.T) {
+		assert.NotNil(t, NewSCIMRepository(&database.Connection{}))
+	})
+}
+
+func TestCreateToken(t *testing.T) {
+	token, _ := scimEntities.NewToken(&scimEntities.TokenData{})
+
+	t.Run("should success create token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).CreateToken(token))
+	})
+
+	t.Run("should return error when failed to create token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).CreateToken(token))
+	})
+}
+
+func TestGetToken(t *testing.T) {
+	t.Run("should success get token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		result, err := getRepository(databaseMock).GetToken(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when token not found", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).GetToken(uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	t.Run("should not return error when there are no tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).ListTokens()
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := getRepository(databaseMock).ListTokens()
+		assert.Error(t, err)
+	})
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("should success revoke token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, getRepository(databaseMock).RevokeToken(uuid.New()))
+	})
+
+	t.Run("should return not found when no token was revoked", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, scimEnums.ErrorTokenNotFound, getRepository(databaseMock).RevokeToken(uuid.New()))
+	})
+
+	t.Run("should return error when failed to revoke token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).RevokeToken(uuid.New()))
+	})
+}
+
+func TestListAccounts(t *testing.T) {
+	t.Run("should success list accounts with filter", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, _, err := getRepository(databaseMock).ListAccounts(getListQuery(`userName eq "test"`))
+		assert.NoError(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 2)
+	})
+
+	t.Run("should only count accounts when count is zero", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		query, _ := scimEntities.NewListQuery(scimEnums.ResourceTypeUser, "", "", "0")
+
+		_, _, err := getRepository(databaseMock).ListAccounts(query)
+		assert.NoError(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+
+	t.Run("should return error when failed to count accounts", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, _, err := getRepository(databaseMock).ListAccounts(getListQuery(""))
+		assert.Error(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+}
+
+func TestCreateGroup(t *testing.T) {
+	t.Run("should success create group", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).CreateGroup(&scimEntities.Group{}))
+	})
+}
+
+func TestGetGroup(t *testing.T) {
+	t.Run("should success get group", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		result, err := getRepository(databaseMock).GetGroup(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when group not found", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).GetGroup(uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestGetGroupByDisplayName(t *testing.T) {
+	t.Run("should success get group by display name", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		result, err := getRepository(databaseMock).GetGroupByDisplayName("test")
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListGroups(t *testing.T) {
+	t.Run("should success list groups", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, _, err := getRepository(databaseMock).ListGroups(getListQuery(""))
+		assert.NoError(t, err)
+		databaseMock.AssertNumberOfCalls(t, "Raw", 2)
+	})
+}
+
+func TestUpdateGroup(t *testing.T) {
+	t.Run("should success update group", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).UpdateGroup(&scimEntities.Group{}))
+	})
+}
+
+func TestDeleteGroup(t *testing.T) {
+	t.Run("should success delete group", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).DeleteGroup(uuid.New()))
+	})
+}
+
+func TestListGroupMembers(t *testing.T) {
+	t.Run("should not return error when group has no members", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).ListGroupMembers(uuid.New())
+		assert.NoError(t, err)
+	})
+}
+
+func TestAddGroupMember(t *testing.T) {
+	t.Run("should success add group member", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).AddGroupMember(
+			scimEntities.NewGroupMember(uuid.New(), uuid.New())))
+	})
+}
+
+func TestRemoveGroupMember(t *testing.T) {
+	t.Run("should success remove group member", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).RemoveGroupMember(uuid.New(), uuid.New()))
+	})
+}
+
+func TestListGroupRoles(t *testing.T) {
+	t.Run("should not return error when group has no roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).ListGroupRoles(uuid.New())
+		assert.NoError(t, err)
+	})
+}
+
+func TestReplaceGroupRoles(t *testing.T) {
+	roles := []*scimEntities.GroupRole{{}, {}}
+
+	t.Run("should success replace group roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).ReplaceGroupRoles(uuid.New(), roles))
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("should return error when failed to delete previous roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).ReplaceGroupRoles(uuid.New(), roles))
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to create role", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).ReplaceGroupRoles(uuid.New(), roles))
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+	})
+}
+
+func TestGrantGroupRoles(t *testing.T) {
+	t.Run("should success grant workspace and repository roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		assert.NoError(t, getRepository(databaseMock).GrantGroupRoles(uuid.New(), uuid.New()))
+		databaseMock.AssertNumberOfCalls(t, "Raw", 2)
+	})
+
+	t.Run("should return error when failed to grant workspace roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).GrantGroupRoles(uuid.New(), uuid.New()))
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+}
+
+func TestRevokeGroupRoles(t *testing.T) {
+	t.Run("should success revoke repository and workspace roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		assert.NoError(t, getRepository(databaseMock).RevokeGroupRoles(uuid.New(), uuid.New()))
+		databaseMock.AssertNumberOfCalls(t, "Raw", 2)
+	})
+
+	t.Run("should return error when failed to revoke repository roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).RevokeGroupRoles(uuid.New(), uuid.New()))
+		databaseMock.AssertNumberOfCalls(t, "Raw", 1)
+	})
+}
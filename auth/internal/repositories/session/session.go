@@ -0,0 +1,108 @@
+package session
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+)
+
+type IRepository interface {
+	CreateSession(session *sessionEntities.Session) error
+	GetSession(sessionID uuid.UUID) (*sessionEntities.Session, error)
+	RotateSession(session *sessionEntities.Session, previousHash string) error
+	ListActiveSessions(accountID uuid.UUID) ([]*sessionEntities.Session, error)
+	RevokeSession(accountID, sessionID uuid.UUID) error
+	RevokeAccountSessions(accountID, exceptSessionID uuid.UUID) error
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+}
+
+func NewSessionRepository(connection *database.Connection) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+	}
+}
+
+func (r *Repository) CreateSession(session *sessionEntities.Session) error {
+	return r.databaseWrite.Create(session, sessionEnums.DatabaseTableSessions).GetError()
+}
+
+func (r *Repository) GetSession(sessionID uuid.UUID) (*sessionEntities.Session, error) {
+	session := &sessionEntities.Session{}
+
+	return session, r.databaseRead.Find(session, map[string]interface{}{"session_id": sessionID},
+		sessionEnums.DatabaseTableSessions).GetError()
+}
+
+// RotateSession only updates the session if the refresh token was not rotated by a concurrent request
+func (r *Repository) RotateSession(session *sessionEntities.Session, previousHash string) error {
+	result := r.databaseWrite.Update(session.ToRotateMap(), map[string]interface{}{
+		"session_id": session.SessionID, "refresh_token_hash": previousHash, "revoked_at": nil,
+	}, sessionEnums.DatabaseTableSessions)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return sessionEnums.ErrorInvalidRefreshToken
+	}
+
+	return nil
+}
+
+func (r *Repository) ListActiveSessions(accountID uuid.UUID) ([]*sessionEntities.Session, error) {
+	var sessions []*sessionEntities.Session
+
+	return sessions, r.databaseRead.Raw(r.queryListActiveSessions(), &sessions, accountID,
+		time.Now()).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListActiveSessions() string {
+	return `
+		SELECT *
+		FROM sessions
+		WHERE account_id = ? AND revoked_at IS NULL AND expires_at > ?
+		ORDER BY last_used_at DESC
+	`
+}
+
+func (r *Repository) RevokeSession(accountID, sessionID uuid.UUID) error {
+	result := r.databaseWrite.Update(map[string]interface{}{"revoked_at": time.Now()}, map[string]interface{}{
+		"session_id": sessionID, "account_id": accountID, "revoked_at": nil,
+	}, sessionEnums.DatabaseTableSessions)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return sessionEnums.ErrorSessionNotFound
+	}
+
+	return nil
+}
+
+// RevokeAccountSessions revokes every active session of the account, except the given one when it is not nil
+func (r *Repository) RevokeAccountSessions(accountID, exceptSessionID uuid.UUID) error {
+	var revoked []uuid.UUID
+
+	return r.databaseRead.Raw(r.queryRevokeAccountSessions(), &revoked, time.Now(), accountID,
+		exceptSessionID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryRevokeAccountSessions() string {
+	return `
+		UPDATE sessions
+		SET revoked_at = ?
+		WHERE account_id = ? AND session_id <> ? AND revoked_at IS NULL
+		RETURNING session_id
+	`
+}
@@ -0,0 +1,44 @@
+package session
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateSession(_ *sessionEntities.Session) error {
+	args := m.MethodCalled("CreateSession")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) GetSession(_ uuid.UUID) (*sessionEntities.Session, error) {
+	args := m.MethodCalled("GetSession")
+	return args.Get(0).(*sessionEntities.Session), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RotateSession(_ *sessionEntities.Session, _ string) error {
+	args := m.MethodCalled("RotateSession")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListActiveSessions(_ uuid.UUID) ([]*sessionEntities.Session, error) {
+	args := m.MethodCalled("ListActiveSessions")
+	return args.Get(0).([]*sessionEntities.Session), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeSession(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeSession")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeAccountSessions(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeAccountSessions")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,147 @@
+package session
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+)
+
+func getRepository(databaseMock *database.Mock) IRepository {
+	return NewSessionRepository(&database.Connection{Read: databaseMock, Write: databaseMock})
+}
+
+func TestNewSessionRepository(t *testing.T) {
+	t.Run("should create session repository", func(t *testing.T) {
+		assert.NotNil(t, NewSessionRepository(&database.Connection{}))
+	})
+}
+
+func TestCreateSession(t *testing.T) {
+	session, _ := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+
+	t.Run("should success create session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, getRepository(databaseMock).CreateSession(session))
+	})
+
+	t.Run("should return error when failed to create session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).CreateSession(session))
+	})
+}
+
+func TestGetSession(t *testing.T) {
+	t.Run("should success get session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		result, err := getRepository(databaseMock).GetSession(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return error when session not found", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).GetSession(uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestRotateSession(t *testing.T) {
+	session, _ := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+
+	t.Run("should success rotate session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, getRepository(databaseMock).RotateSession(session, "test"))
+	})
+
+	t.Run("should return invalid token when rotated by another request", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken,
+			getRepository(databaseMock).RotateSession(session, "test"))
+	})
+
+	t.Run("should return error when failed to update session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).RotateSession(session, "test"))
+	})
+}
+
+func TestListActiveSessions(t *testing.T) {
+	t.Run("should success list sessions", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := getRepository(databaseMock).ListActiveSessions(uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not return error when there are no sessions", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		_, err := getRepository(databaseMock).ListActiveSessions(uuid.New())
+		assert.NoError(t, err)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Run("should success revoke session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+
+		assert.NoError(t, getRepository(databaseMock).RevokeSession(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return not found when session is not active or from another account", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+
+		assert.Equal(t, sessionEnums.ErrorSessionNotFound,
+			getRepository(databaseMock).RevokeSession(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return error when failed to update session", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).RevokeSession(uuid.New(), uuid.New()))
+	})
+}
+
+func TestRevokeAccountSessions(t *testing.T) {
+	t.Run("should success revoke sessions", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		assert.NoError(t, getRepository(databaseMock).RevokeAccountSessions(uuid.New(), uuid.Nil))
+	})
+
+	t.Run("should return error when failed to revoke sessions", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, getRepository(databaseMock).RevokeAccountSessions(uuid.New(), uuid.Nil))
+	})
+}
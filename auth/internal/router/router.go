@@ -10,8 +10,10 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/docs"
 	"github.com/ZupIT/horusec-platform/auth/internal/enums/routes"
 	accountHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/account"
+	adminHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/admin"
 	authHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/authentication"
 	"github.com/ZupIT/horusec-platform/auth/internal/handlers/health"
+	scimHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/scim"
 )
 
 type IRouter interface {
@@ -25,10 +27,13 @@ type Router struct {
 	authHandler    *authHandler.Handler
 	accountHandler *accountHandler.Handler
 	healthHandler  *health.Handler
+	scimHandler    *scimHandler.Handler
+	adminHandler   *adminHandler.Handler
 }
 
 func NewHTTPRouter(routerConnection router.IRouter, authGRPCServer grpc.IAuthGRPCServer,
-	handlerAuth *authHandler.Handler, handlerAccount *accountHandler.Handler, handlerHealth *health.Handler) IRouter {
+	handlerAuth *authHandler.Handler, handlerAccount *accountHandler.Handler, handlerHealth *health.Handler,
+	handlerSCIM *scimHandler.Handler, handlerAdmin *adminHandler.Handler) IRouter {
 	httpRouter := &Router{
 		IRouter:         routerConnection,
 		ISwagger:        swagger.NewSwagger(routerConnection.GetMux(), routerConnection.GetPort()),
@@ -36,6 +41,8 @@ func NewHTTPRouter(routerConnection router.IRouter, authGRPCServer grpc.IAuthGRP
 		authHandler:     handlerAuth,
 		accountHandler:  handlerAccount,
 		healthHandler:   handlerHealth,
+		scimHandler:     handlerSCIM,
+		adminHandler:    handlerAdmin,
 	}
 
 	httpRouter.startGRPCServer()
@@ -57,6 +64,8 @@ func (r *Router) swaggerRoutes() {
 	r.authenticationRoutes()
 	r.accountRoutes()
 	r.healthRoutes()
+	r.scimRoutes()
+	r.adminRoutes()
 
 	docs.SwaggerInfo.Host = r.GetSwaggerHost()
 }
@@ -65,6 +74,14 @@ func (r *Router) authenticationRoutes() {
 	r.Route(routes.AuthenticationHandler, func(router chi.Router) {
 		router.Post("/login", r.authHandler.Login)
 		router.Get("/config", r.authHandler.GetConfig)
+		router.Get("/oidc/authorize", r.authHandler.OIDCAuthorize)
+		router.Post("/oidc/callback", r.authHandler.OIDCCallback)
+		router.Get("/saml/metadata", r.authHandler.SAMLMetadata)
+		router.Get("/saml/login", r.authHandler.SAMLLogin)
+		router.Post("/saml/acs", r.authHandler.SAMLAssertionConsumer)
+		router.Post("/saml/token", r.authHandler.SAMLToken)
+		router.Post("/mfa/verify", r.authHandler.MFAVerify)
+		router.Post("/mfa/enroll", r.authHandler.MFAEnroll)
 	})
 }
 
@@ -72,7 +89,8 @@ func (r *Router) accountRoutes() {
 	r.Route(routes.AccountHandler, func(router chi.Router) {
 		router.Post("/create-account-keycloak", r.accountHandler.CreateAccountKeycloak)
 		router.Post("/create-account-horusec", r.accountHandler.CreateAccountHorusec)
-		router.Get("/validate/{accountID}", r.accountHandler.ValidateAccountEmail)
+		router.Get("/validate/{token}", r.accountHandler.ValidateAccountEmail)
+		router.Post("/resend-validation", r.accountHandler.ResendValidationEmail)
 		router.Post("/send-reset-code", r.accountHandler.SendResetPasswordCode)
 		router.Post("/check-reset-code", r.accountHandler.CheckResetPasswordCode)
 		router.Post("/change-password", r.accountHandler.ChangePassword)
@@ -81,11 +99,77 @@ func (r *Router) accountRoutes() {
 		router.Delete("/delete", r.accountHandler.DeleteAccount)
 		router.Post("/verify-already-used", r.accountHandler.CheckExistingEmailOrUsername)
 		router.Patch("/update", r.accountHandler.UpdateAccount)
+		r.accountSecurityRoutes(router)
 	})
 }
 
+func (r *Router) accountSecurityRoutes(router chi.Router) {
+	router.Post("/mfa/enroll", r.accountHandler.EnrollMFA)
+	router.Post("/mfa/enable", r.accountHandler.EnableMFA)
+	router.Post("/mfa/disable", r.accountHandler.DisableMFA)
+	router.Post("/mfa/recovery-codes", r.accountHandler.RegenerateMFARecoveryCodes)
+	router.Post("/unlock-account/{accountID}", r.accountHandler.UnlockAccount)
+	router.Get("/unlock/{token}", r.accountHandler.UnlockAccountWithToken)
+	r.accountCredentialsRoutes(router)
+}
+
+func (r *Router) accountCredentialsRoutes(router chi.Router) {
+	router.Post("/change-expired-password", r.accountHandler.ChangeExpiredPassword)
+	router.Get("/sessions", r.accountHandler.ListSessions)
+	router.Delete("/sessions/{sessionID}", r.accountHandler.RevokeSession)
+	router.Post("/revoke-sessions/{accountID}", r.accountHandler.RevokeAccountSessions)
+	router.Post("/personal-access-tokens", r.accountHandler.CreatePersonalAccessToken)
+	router.Get("/personal-access-tokens", r.accountHandler.ListPersonalAccessTokens)
+	router.Delete("/personal-access-tokens/{tokenID}", r.accountHandler.RevokePersonalAccessToken)
+}
+
 func (r *Router) healthRoutes() {
 	r.Route(routes.HealthHandler, func(router chi.Router) {
 		router.Get("/", r.healthHandler.Get)
 	})
 }
+
+func (r *Router) scimRoutes() {
+	r.Route(routes.SCIMHandler, func(router chi.Router) {
+		router.Post("/tokens", r.scimHandler.CreateToken)
+		router.Get("/tokens", r.scimHandler.ListTokens)
+		router.Delete("/tokens/{tokenID}", r.scimHandler.RevokeToken)
+		router.Route("/v2", r.scimProvisioningRoutes)
+	})
+}
+
+func (r *Router) scimProvisioningRoutes(router chi.Router) {
+	router.Use(r.scimHandler.IsAuthorized)
+	r.scimUserRoutes(router)
+	r.scimGroupRoutes(router)
+}
+
+func (r *Router) scimUserRoutes(router chi.Router) {
+	router.Get("/Users", r.scimHandler.ListUsers)
+	router.Post("/Users", r.scimHandler.CreateUser)
+	router.Get("/Users/{id}", r.scimHandler.GetUser)
+	router.Put("/Users/{id}", r.scimHandler.ReplaceUser)
+	router.Patch("/Users/{id}", r.scimHandler.PatchUser)
+	router.Delete("/Users/{id}", r.scimHandler.DeleteUser)
+}
+
+func (r *Router) scimGroupRoutes(router chi.Router) {
+	router.Get("/Groups", r.scimHandler.ListGroups)
+	router.Post("/Groups", r.scimHandler.CreateGroup)
+	router.Get("/Groups/{id}", r.scimHandler.GetGroup)
+	router.Put("/Groups/{id}", r.scimHandler.ReplaceGroup)
+	router.Patch("/Groups/{id}", r.scimHandler.PatchGroup)
+	router.Delete("/Groups/{id}", r.scimHandler.DeleteGroup)
+}
+
+func (r *Router) adminRoutes() {
+	r.Route(routes.AdminHandler, func(router chi.Router) {
+		router.Get("/accounts", r.adminHandler.ListAccounts)
+		router.Get("/accounts/{accountID}/memberships", r.adminHandler.GetMemberships)
+		router.Post("/accounts/{accountID}/disable", r.adminHandler.DisableAccount)
+		router.Post("/accounts/{accountID}/enable", r.adminHandler.EnableAccount)
+		router.Patch("/accounts/{accountID}/application-admin", r.adminHandler.SetApplicationAdmin)
+		router.Post("/accounts/{accountID}/force-password-reset", r.adminHandler.ForcePasswordReset)
+		router.Post("/accounts/{accountID}/resend-confirmation", r.adminHandler.ResendConfirmationEmail)
+	})
+}
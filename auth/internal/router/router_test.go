@@ -11,8 +11,10 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/cors"
 	"github.com/ZupIT/horusec-platform/auth/config/grpc"
 	accountHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/account"
+	adminHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/admin"
 	authHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/authentication"
 	healthHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/health"
+	scimHandler "github.com/ZupIT/horusec-platform/auth/internal/handlers/scim"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
@@ -22,7 +24,8 @@ func TestNewHTTPRouter(t *testing.T) {
 
 		assert.NotPanics(t, func() {
 			assert.NotNil(t, NewHTTPRouter(routerService, authGRPCServer,
-				&authHandler.Handler{}, &accountHandler.Handler{}, &healthHandler.Handler{}))
+				&authHandler.Handler{}, &accountHandler.Handler{}, &healthHandler.Handler{},
+				&scimHandler.Handler{}, &adminHandler.Handler{}))
 		})
 	})
 }
@@ -0,0 +1,53 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	auditEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+)
+
+type IService interface {
+	Publish(r *http.Request, event *auditEntities.Event)
+}
+
+// Service publishes the administrative actions of auth to the audit exchange, where core stores them with its own
+type Service struct {
+	broker            broker.IBroker
+	accountRepository accountRepository.IRepository
+}
+
+func NewAuditService(brokerLib broker.IBroker, repositoryAccount accountRepository.IRepository) IService {
+	return &Service{
+		broker:            brokerLib,
+		accountRepository: repositoryAccount,
+	}
+}
+
+// Publish sets the email of the actor and the ip of the request, failures are only logged since the action was
+// already done and must not be answered as failed
+func (s *Service) Publish(r *http.Request, event *auditEntities.Event) {
+	s.setActorEmail(event.SetIP(r))
+
+	logger.LogError(auditEnums.MessageFailedToPublishEvent,
+		s.broker.Publish("", auditEnums.ExchangeAudit, exchange.Fanout, event.ToBytes()))
+}
+
+func (s *Service) setActorEmail(event *auditEntities.Event) {
+	if !event.HasActor() {
+		return
+	}
+
+	account, err := s.accountRepository.GetAccount(event.ActorID)
+	if err != nil {
+		logger.LogError(auditEnums.MessageFailedToGetActor, err)
+		return
+	}
+
+	event.SetActorEmail(account.Email)
+}
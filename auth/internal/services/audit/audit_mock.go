@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/stretchr/testify/mock"
+
+	auditEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/audit"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Publish(_ *http.Request, _ *auditEntities.Event) {
+	_ = m.MethodCalled("Publish")
+}
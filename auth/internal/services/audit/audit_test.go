@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	auditEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/audit"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+)
+
+func TestNewAuditService(t *testing.T) {
+	t.Run("should success create a new audit service", func(t *testing.T) {
+		assert.NotNil(t, NewAuditService(&broker.Mock{}, &accountRepository.Mock{}))
+	})
+}
+
+func TestPublish(t *testing.T) {
+	account := &accountEntities.Account{AccountID: uuid.New(), Email: "test@test.com"}
+
+	t.Run("should publish event with the actor email and ip of the request", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &accountRepository.Mock{}
+		repositoryMock.On("GetAccount").Return(account, nil)
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		r.RemoteAddr = "127.0.0.1:8000"
+
+		event := auditEntities.NewEvent(auditEnums.ActionAccountDisable, auditEnums.TargetAccount, uuid.New()).
+			SetActor(account.AccountID)
+		NewAuditService(brokerMock, repositoryMock).Publish(r, event)
+
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Equal(t, account.Email, event.ActorEmail)
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+
+	t.Run("should publish event without email when failed to get the actor", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		repositoryMock := &accountRepository.Mock{}
+		repositoryMock.On("GetAccount").Return(account, errors.New("test"))
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+
+		event := auditEntities.NewEvent(auditEnums.ActionAccountDisable, auditEnums.TargetAccount, uuid.New()).
+			SetActor(account.AccountID)
+		assert.NotPanics(t, func() {
+			NewAuditService(brokerMock, repositoryMock).Publish(r, event)
+		})
+
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Empty(t, event.ActorEmail)
+	})
+
+	t.Run("should publish event without getting the actor when it has none", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &accountRepository.Mock{}
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+
+		event := auditEntities.NewEvent(auditEnums.ActionSCIMUserCreate, auditEnums.TargetAccount, uuid.New())
+		NewAuditService(brokerMock, repositoryMock).Publish(r, event)
+
+		brokerMock.AssertCalled(t, "Publish")
+		repositoryMock.AssertNotCalled(t, "GetAccount")
+	})
+}
@@ -8,6 +8,9 @@ import (
 	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
 )
 
 type Mock struct {
@@ -33,3 +36,43 @@ func (m *Mock) GetUserInfo(_ string) (*gocloak.UserInfo, error) {
 	args := m.MethodCalled("GetUserInfo")
 	return args.Get(0).(*gocloak.UserInfo), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) GetAuthorizationURL() (*oidcEntities.AuthorizationURLResponse, error) {
+	args := m.MethodCalled("GetAuthorizationURL")
+	return args.Get(0).(*oidcEntities.AuthorizationURLResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Callback(_ *oidcEntities.CallbackData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("Callback")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetMetadata() ([]byte, error) {
+	args := m.MethodCalled("GetMetadata")
+	return args.Get(0).([]byte), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetLoginURL() (*samlEntities.LoginURLResponse, error) {
+	args := m.MethodCalled("GetLoginURL")
+	return args.Get(0).(*samlEntities.LoginURLResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) AssertionConsumer(_ *samlEntities.AssertionData) (string, error) {
+	args := m.MethodCalled("AssertionConsumer")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ExchangeLoginCode(_ *samlEntities.LoginCodeData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("ExchangeLoginCode")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) VerifyMFA(_ *mfaEntities.ChallengeData) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("VerifyMFA")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) EnrollMFA(_ *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error) {
+	args := m.MethodCalled("EnrollMFA")
+	return args.Get(0).(*mfaEntities.EnrollmentResponse), mockUtils.ReturnNilOrError(args, 1)
+}
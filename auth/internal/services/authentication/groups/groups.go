@@ -0,0 +1,160 @@
+package groups
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	groupsEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/groups"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+)
+
+type IAuthorizer interface {
+	IsAuthorized(data *authEntities.AuthorizationData) (bool, error)
+	IsApplicationAdmin(userGroups []string) bool
+}
+
+// Authorizer checks the permissions by the identity provider groups saved in the access token, comparing them with
+// the groups set in the workspaces and repositories. The application admin group is read from the given env, since
+// each authentication type has its own
+type Authorizer struct {
+	adminGroupEnv  string
+	appConfig      app.IConfig
+	authRepository authRepository.IRepository
+}
+
+func NewGroupsAuthorizer(adminGroupEnv string, appConfig app.IConfig,
+	repositoryAuth authRepository.IRepository) IAuthorizer {
+	return &Authorizer{
+		adminGroupEnv:  adminGroupEnv,
+		appConfig:      appConfig,
+		authRepository: repositoryAuth,
+	}
+}
+
+func (a *Authorizer) IsApplicationAdmin(userGroups []string) bool {
+	applicationAdminGroup, _ := a.getApplicationAdminAuthzGroupName()
+	return a.checkIsAuthorized(applicationAdminGroup, userGroups)
+}
+
+func (a *Authorizer) getApplicationAdminAuthzGroupName() ([]string, error) {
+	applicationAdminGroup := env.GetEnvOrDefault(a.adminGroupEnv, "")
+
+	if applicationAdminGroup == "" && a.appConfig.IsApplicationAdmEnabled() {
+		return []string{}, groupsEnums.ErrorApplicationAdminGroupNotSet
+	}
+
+	return []string{applicationAdminGroup}, nil
+}
+
+func (a *Authorizer) checkIsAuthorized(tokenGroups, horusecGroups []string) bool {
+	for _, tokenGroup := range tokenGroups {
+		if a.contains(horusecGroups, tokenGroup) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains ignores the empty groups, so an admin group env not set never matches
+func (a *Authorizer) contains(horusecGroups []string, tokenGroup string) bool {
+	for _, horusecGroup := range horusecGroups {
+		if tokenGroup != "" && strings.TrimSpace(horusecGroup) == tokenGroup {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *Authorizer) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
+	token, err := jwt.DecodeToken(data.Token)
+	if err != nil {
+		return false, err
+	}
+
+	horusecGroups, err := a.getHorusecAuthzGroups(data)
+	if err != nil {
+		return false, err
+	}
+
+	return a.checkIsAuthorized(token.Permissions, horusecGroups), nil
+}
+
+func (a *Authorizer) getHorusecAuthzGroups(data *authEntities.AuthorizationData) ([]string, error) {
+	switch data.Type {
+	case auth.ApplicationAdmin:
+		return a.getGroupsByAuthorizationType(data)
+	case auth.WorkspaceAdmin, auth.WorkspaceMember:
+		return a.getWorkspaceAuthzGroups(data)
+	case auth.RepositoryAdmin, auth.RepositorySupervisor, auth.RepositoryMember:
+		return a.getRepositoryAuthzGroups(data)
+	}
+
+	return nil, groupsEnums.ErrorInvalidAuthorizationType
+}
+
+func (a *Authorizer) getWorkspaceAuthzGroups(data *authEntities.AuthorizationData) ([]string, error) {
+	workspaceGroups, err := a.authRepository.GetWorkspaceGroups(data.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.getGroupsByAuthorizationType(data.SetGroups(workspaceGroups))
+}
+
+func (a *Authorizer) getRepositoryAuthzGroups(data *authEntities.AuthorizationData) ([]string, error) {
+	workspaceGroups, err := a.authRepository.GetWorkspaceGroups(data.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	repositoryGroups, err := a.authRepository.GetRepositoryGroups(data.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := a.getGroupsByAuthorizationType(data.SetGroups(repositoryGroups))
+	return append(groups, workspaceGroups.AuthzAdmin...), err
+}
+
+func (a *Authorizer) getGroupsByAuthorizationType(data *authEntities.AuthorizationData) ([]string, error) {
+	appAdminAuthz, err := a.getApplicationAdminAuthzGroupName()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.getGroupsByType(appAdminAuthz, data), nil
+}
+
+func (a *Authorizer) getGroupsByType(appAdminAuthz []string, data *authEntities.AuthorizationData) (groups []string) {
+	switch data.Type {
+	case auth.ApplicationAdmin:
+		groups = appAdminAuthz
+	case auth.RepositoryAdmin, auth.WorkspaceAdmin:
+		groups = a.appendAdmin(appAdminAuthz, data)
+	case auth.RepositorySupervisor:
+		groups = a.appendSupervisor(appAdminAuthz, data)
+	case auth.RepositoryMember, auth.WorkspaceMember:
+		groups = a.appendMember(appAdminAuthz, data)
+	}
+
+	return groups
+}
+
+func (a *Authorizer) appendAdmin(appAdminAuthz []string, data *authEntities.AuthorizationData) []string {
+	return append(appAdminAuthz, data.AuthzAdmin...)
+}
+
+func (a *Authorizer) appendSupervisor(appAdminAuthz []string, data *authEntities.AuthorizationData) []string {
+	return append(appAdminAuthz, append(data.AuthzAdmin, data.AuthzSupervisor...)...)
+}
+
+func (a *Authorizer) appendMember(appAdminAuthz []string, data *authEntities.AuthorizationData) []string {
+	return append(appAdminAuthz, append(data.AuthzAdmin, append(data.AuthzSupervisor, data.AuthzMember...)...)...)
+}
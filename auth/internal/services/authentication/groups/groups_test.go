@@ -0,0 +1,148 @@
+package groups
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	authorization "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	tokenEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	groupsEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/groups"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+)
+
+const testAdminGroupEnv = "HORUSEC_TEST_ADMIN_GROUP"
+
+func newTestToken(userGroups ...string) string {
+	token, _, _ := jwt.CreateToken(&tokenEntities.TokenData{AccountID: uuid.New()}, userGroups)
+	return token
+}
+
+func TestNewGroupsAuthorizer(t *testing.T) {
+	t.Run("should success create a new authorizer", func(t *testing.T) {
+		assert.NotNil(t, NewGroupsAuthorizer(testAdminGroupEnv, nil, nil))
+	})
+}
+
+func TestIsApplicationAdmin(t *testing.T) {
+	t.Run("should return true when user is in the admin group of the env", func(t *testing.T) {
+		_ = os.Setenv(testAdminGroupEnv, "admin")
+		defer os.Unsetenv(testAdminGroupEnv)
+
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, &authRepository.Mock{})
+
+		assert.True(t, authorizer.IsApplicationAdmin([]string{"member", "admin"}))
+		assert.False(t, authorizer.IsApplicationAdmin([]string{"member"}))
+	})
+
+	t.Run("should return false for empty group when admin group env not set", func(t *testing.T) {
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, &authRepository.Mock{})
+
+		assert.False(t, authorizer.IsApplicationAdmin([]string{""}))
+	})
+}
+
+func TestIsAuthorized(t *testing.T) {
+	t.Run("should authorize application admin by the group of the env", func(t *testing.T) {
+		_ = os.Setenv(testAdminGroupEnv, "admin")
+		defer os.Unsetenv(testAdminGroupEnv)
+
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, &authRepository.Mock{})
+
+		result, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("admin"),
+			Type: authorization.ApplicationAdmin})
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should return error when application admin enabled without group", func(t *testing.T) {
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{EnableApplicationAdmin: true},
+			&authRepository.Mock{})
+
+		_, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("admin"),
+			Type: authorization.ApplicationAdmin})
+		assert.Equal(t, groupsEnums.ErrorApplicationAdminGroupNotSet, err)
+	})
+
+	t.Run("should authorize workspace member by the workspace groups", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{
+			AuthzMember: []string{"member"}}, nil)
+
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, authRepositoryMock)
+
+		result, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("member"),
+			Type: authorization.WorkspaceMember})
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		result, err = authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("member"),
+			Type: authorization.WorkspaceAdmin})
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("should authorize workspace admin in any repository of the workspace", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{
+			AuthzAdmin: []string{"workspace-admin"}}, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(&authEntities.AuthzGroups{
+			AuthzSupervisor: []string{"supervisor"}}, nil)
+
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, authRepositoryMock)
+
+		result, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("workspace-admin"),
+			Type: authorization.RepositoryAdmin})
+		assert.NoError(t, err)
+		assert.True(t, result)
+
+		result, err = authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("supervisor"),
+			Type: authorization.RepositoryMember})
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should return error when failed to get workspace groups", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{}, errors.New("test"))
+
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, authRepositoryMock)
+
+		_, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("member"),
+			Type: authorization.RepositoryMember})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to get repository groups", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{}, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(&authEntities.AuthzGroups{}, errors.New("test"))
+
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, authRepositoryMock)
+
+		_, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("member"),
+			Type: authorization.RepositoryMember})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, &authRepository.Mock{})
+
+		_, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: "test"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid authorization type", func(t *testing.T) {
+		authorizer := NewGroupsAuthorizer(testAdminGroupEnv, &app.Config{}, &authRepository.Mock{})
+
+		_, err := authorizer.IsAuthorized(&authEntities.AuthorizationData{Token: newTestToken("admin"), Type: "test"})
+		assert.Equal(t, groupsEnums.ErrorInvalidAuthorizationType, err)
+	})
+}
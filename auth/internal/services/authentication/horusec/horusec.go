@@ -7,7 +7,6 @@ import (
 
 	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
@@ -15,10 +14,14 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
-	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
 	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
 	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	mfaService "github.com/ZupIT/horusec-platform/auth/internal/services/mfa"
+	passwordService "github.com/ZupIT/horusec-platform/auth/internal/services/password"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	authUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
 )
 
@@ -26,24 +29,32 @@ type IService interface {
 	Login(credentials *authEntities.LoginCredentials) (*authEntities.LoginResponse, error)
 	IsAuthorized(data *authEntities.AuthorizationData) (bool, error)
 	GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error)
+	VerifyMFA(data *mfaEntities.ChallengeData) (*authEntities.LoginResponse, error)
+	EnrollMFA(data *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error)
 }
 
 type Service struct {
 	accountRepository accountRepository.IRepository
 	authUseCases      authUseCases.IUseCases
-	cache             cache.ICache
+	sessionService    sessionService.IService
 	authRepository    authRepository.IRepository
 	appConfig         app.IConfig
+	mfaService        mfaService.IService
+	passwordService   passwordService.IService
 }
 
 func NewHorusecAuthenticationService(repositoryAccount accountRepository.IRepository, appConfig app.IConfig,
-	useCasesAuth authUseCases.IUseCases, repositoryAuth authRepository.IRepository, cacheLib cache.ICache) IService {
+	useCasesAuth authUseCases.IUseCases, repositoryAuth authRepository.IRepository,
+	serviceSession sessionService.IService, serviceMFA mfaService.IService,
+	servicePassword passwordService.IService) IService {
 	return &Service{
-		cache:             cacheLib,
+		sessionService:    serviceSession,
 		authUseCases:      useCasesAuth,
 		accountRepository: repositoryAccount,
 		authRepository:    repositoryAuth,
 		appConfig:         appConfig,
+		mfaService:        serviceMFA,
+		passwordService:   servicePassword,
 	}
 }
 
@@ -57,21 +68,58 @@ func (s *Service) Login(credentials *authEntities.LoginCredentials) (*authEntiti
 		return nil, err
 	}
 
-	return s.setTokensAndResponse(account)
+	return s.getLoginResponse(account, &credentials.Device)
 }
 
-func (s *Service) setTokensAndResponse(account *accountEntities.Account) (*authEntities.LoginResponse, error) {
-	refreshToken := jwt.CreateRefreshToken()
-	accessToken, expireAt, _ := jwt.CreateToken(account.ToTokenData(), nil)
+// getLoginResponse returns a mfa challenge instead of the tokens when a second factor is required
+func (s *Service) getLoginResponse(account *accountEntities.Account,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	isRequired, err := s.mfaService.IsChallengeRequired(account)
+	if err != nil {
+		return nil, err
+	}
 
-	s.setRefreshTokenCache(account.AccountID.String(), refreshToken)
+	if isRequired {
+		return s.mfaService.NewChallenge(account)
+	}
 
-	return account.ToLoginResponse(accessToken, refreshToken, expireAt), nil
+	return s.setTokensAndResponse(account, device)
+}
+
+func (s *Service) VerifyMFA(data *mfaEntities.ChallengeData) (*authEntities.LoginResponse, error) {
+	account, recoveryCodes, err := s.mfaService.VerifyChallenge(data)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.setTokensAndResponse(account, &data.Device)
+	if err != nil {
+		return nil, err
+	}
+
+	response.RecoveryCodes = recoveryCodes
+	return response, nil
 }
 
-func (s *Service) setRefreshTokenCache(accountID, refreshToken string) {
-	s.cache.Delete(refreshToken)
-	s.cache.Set(refreshToken, accountID, authEnums.TokenDuration)
+func (s *Service) EnrollMFA(data *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error) {
+	return s.mfaService.EnrollChallenge(data)
+}
+
+// setTokensAndResponse is only called after every factor is checked, when the password is expired the tokens are
+// replaced by a token that only allows changing it
+func (s *Service) setTokensAndResponse(account *accountEntities.Account,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	if s.passwordService.IsExpired(account) {
+		return s.passwordService.NewExpiredChallenge(account)
+	}
+
+	refreshToken, err := s.sessionService.CreateSession(account.AccountID, device)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, expireAt, _ := jwt.CreateToken(account.ToTokenData(), nil)
+	return account.ToLoginResponse(accessToken, refreshToken, expireAt), nil
 }
 
 func (s *Service) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
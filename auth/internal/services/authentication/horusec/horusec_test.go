@@ -9,7 +9,6 @@ import (
 
 	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
@@ -17,16 +16,41 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
 	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
 	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	mfaService "github.com/ZupIT/horusec-platform/auth/internal/services/mfa"
+	passwordService "github.com/ZupIT/horusec-platform/auth/internal/services/password"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	"github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
 )
 
+func newSessionServiceMock() *sessionService.Mock {
+	sessionServiceMock := &sessionService.Mock{}
+	sessionServiceMock.On("CreateSession").Return("test", nil)
+
+	return sessionServiceMock
+}
+
+func newMFAServiceMock() *mfaService.Mock {
+	mfaServiceMock := &mfaService.Mock{}
+	mfaServiceMock.On("IsChallengeRequired").Return(false, nil)
+
+	return mfaServiceMock
+}
+
+func newPasswordServiceMock() *passwordService.Mock {
+	passwordServiceMock := &passwordService.Mock{}
+	passwordServiceMock.On("IsExpired").Return(false)
+
+	return passwordServiceMock
+}
+
 func TestNewHorusecAuthenticationService(t *testing.T) {
 	t.Run("should success create a new service", func(t *testing.T) {
 		assert.NotNil(t, NewHorusecAuthenticationService(
-			nil, nil, nil, nil, nil))
+			nil, nil, nil, nil, nil, nil, nil))
 	})
 }
 
@@ -48,7 +72,8 @@ func TestLogin(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(),
+			newPasswordServiceMock())
 
 		credentials := &authEntities.LoginCredentials{
 			Username: "test@test.com",
@@ -65,6 +90,25 @@ func TestLogin(t *testing.T) {
 		assert.Equal(t, "test@test.com", result.Email)
 	})
 
+	t.Run("should return error when failed to create session", func(t *testing.T) {
+		passwordHash, _ := crypto.HashPasswordBcrypt("test")
+		account := &accountEntities.Account{Password: passwordHash, IsConfirmed: true, Email: "test@test.com"}
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("CreateSession").Return("", errors.New("test"))
+
+		service := NewHorusecAuthenticationService(accountRepositoryMock, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, sessionServiceMock, newMFAServiceMock(),
+			newPasswordServiceMock())
+
+		result, err := service.Login(&authEntities.LoginCredentials{Username: "test@test.com", Password: "test"})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
 	t.Run("should return error when account not confirmed", func(t *testing.T) {
 		appConfig := &app.Config{}
 		authRepositoryMock := &authRepository.Mock{}
@@ -82,7 +126,8 @@ func TestLogin(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(),
+			newPasswordServiceMock())
 
 		credentials := &authEntities.LoginCredentials{
 			Username: "test@test.com",
@@ -112,7 +157,8 @@ func TestLogin(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(),
+			newPasswordServiceMock())
 
 		credentials := &authEntities.LoginCredentials{
 			Username: "test",
@@ -142,7 +188,8 @@ func TestLogin(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(),
+			newPasswordServiceMock())
 
 		credentials := &authEntities.LoginCredentials{
 			Username: "test@test.com",
@@ -172,7 +219,8 @@ func TestLogin(t *testing.T) {
 		accountRepositoryMock.On("GetAccountByEmail").Return(account, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(),
+			newPasswordServiceMock())
 
 		credentials := &authEntities.LoginCredentials{
 			Username: "test@test.com",
@@ -186,6 +234,130 @@ func TestLogin(t *testing.T) {
 	})
 }
 
+func TestLoginWithMFA(t *testing.T) {
+	passwordHash, _ := crypto.HashPasswordBcrypt("test")
+	account := &accountEntities.Account{
+		Password:     passwordHash,
+		IsConfirmed:  true,
+		Email:        "test@test.com",
+		Username:     "test",
+		IsMFAEnabled: true,
+	}
+
+	credentials := &authEntities.LoginCredentials{
+		Username: "test@test.com",
+		Password: "test",
+	}
+
+	t.Run("should return mfa challenge instead of tokens when required", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("IsChallengeRequired").Return(true, nil)
+		mfaServiceMock.On("NewChallenge").Return(account.ToMFALoginResponse("test", false), nil)
+
+		service := NewHorusecAuthenticationService(accountRepositoryMock, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, newSessionServiceMock(), mfaServiceMock,
+			newPasswordServiceMock())
+
+		result, err := service.Login(credentials)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", result.MFAToken)
+		assert.True(t, result.IsMFARequired)
+		assert.Empty(t, result.AccessToken)
+	})
+
+	t.Run("should return error when failed to check if mfa is required", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("IsChallengeRequired").Return(false, errors.New("test"))
+
+		service := NewHorusecAuthenticationService(accountRepositoryMock, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, newSessionServiceMock(), mfaServiceMock,
+			newPasswordServiceMock())
+
+		result, err := service.Login(credentials)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestLoginWithExpiredPassword(t *testing.T) {
+	t.Run("should return password change token instead of tokens when password expired", func(t *testing.T) {
+		passwordHash, _ := crypto.HashPasswordBcrypt("test")
+		account := &accountEntities.Account{Password: passwordHash, IsConfirmed: true, Email: "test@test.com"}
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+
+		passwordServiceMock := &passwordService.Mock{}
+		passwordServiceMock.On("IsExpired").Return(true)
+		passwordServiceMock.On("NewExpiredChallenge").Return(account.ToPasswordExpiredLoginResponse("token"), nil)
+
+		sessionServiceMock := newSessionServiceMock()
+
+		service := NewHorusecAuthenticationService(accountRepositoryMock, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, sessionServiceMock, newMFAServiceMock(),
+			passwordServiceMock)
+
+		result, err := service.Login(&authEntities.LoginCredentials{Username: "test@test.com", Password: "test"})
+		assert.NoError(t, err)
+		assert.True(t, result.IsPasswordExpired)
+		assert.Equal(t, "token", result.PasswordChangeToken)
+		assert.Empty(t, result.AccessToken)
+		sessionServiceMock.AssertNotCalled(t, "CreateSession")
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	t.Run("should return tokens and recovery codes after verify mfa", func(t *testing.T) {
+		account := &accountEntities.Account{AccountID: uuid.New(), Email: "test@test.com", Username: "test"}
+
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("VerifyChallenge").Return(account, []string{"test"}, nil)
+
+		service := NewHorusecAuthenticationService(&accountRepository.Mock{}, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, newSessionServiceMock(), mfaServiceMock,
+			newPasswordServiceMock())
+
+		result, err := service.VerifyMFA(&mfaEntities.ChallengeData{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.AccessToken)
+		assert.NotEmpty(t, result.RefreshToken)
+		assert.Equal(t, []string{"test"}, result.RecoveryCodes)
+	})
+
+	t.Run("should return error when failed to verify mfa", func(t *testing.T) {
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("VerifyChallenge").Return(&accountEntities.Account{}, []string{}, errors.New("test"))
+
+		service := NewHorusecAuthenticationService(&accountRepository.Mock{}, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, newSessionServiceMock(), mfaServiceMock,
+			newPasswordServiceMock())
+
+		result, err := service.VerifyMFA(&mfaEntities.ChallengeData{})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestEnrollMFA(t *testing.T) {
+	t.Run("should return enrollment data", func(t *testing.T) {
+		mfaServiceMock := &mfaService.Mock{}
+		mfaServiceMock.On("EnrollChallenge").Return(&mfaEntities.EnrollmentResponse{Secret: "test"}, nil)
+
+		service := NewHorusecAuthenticationService(&accountRepository.Mock{}, &app.Config{},
+			authentication.NewAuthenticationUseCases(), &authRepository.Mock{}, newSessionServiceMock(), mfaServiceMock, nil)
+
+		result, err := service.EnrollMFA(&mfaEntities.ChallengeData{})
+		assert.NoError(t, err)
+		assert.Equal(t, "test", result.Secret)
+	})
+}
+
 func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 	t.Run("should return true and no error when application admin", func(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
@@ -203,7 +375,7 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		appConfig := &app.Config{EnableApplicationAdmin: true}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -235,7 +407,7 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		appConfig := &app.Config{EnableApplicationAdmin: true}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -267,7 +439,7 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		appConfig := &app.Config{EnableApplicationAdmin: false}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -290,7 +462,7 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		appConfig := &app.Config{EnableApplicationAdmin: true}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		data := &authEntities.AuthorizationData{
 			Token:        "test",
@@ -321,7 +493,7 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Admin, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -352,7 +524,7 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Admin, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -374,7 +546,7 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		data := &authEntities.AuthorizationData{
 			Token:        "test",
@@ -405,7 +577,7 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Member, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -436,7 +608,7 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Member, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -458,7 +630,7 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		data := &authEntities.AuthorizationData{
 			Token:        "test",
@@ -490,7 +662,7 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Member, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -522,7 +694,7 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Member, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -544,7 +716,7 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		data := &authEntities.AuthorizationData{
 			Token:        "test",
@@ -576,7 +748,7 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Supervisor, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -608,7 +780,7 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Supervisor, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -630,7 +802,7 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		data := &authEntities.AuthorizationData{
 			Token:        "test",
@@ -662,7 +834,7 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Admin, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -694,7 +866,7 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 		authRepositoryMock.On("GetWorkspaceRole").Return(accountEnums.Member, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), nil)
 
@@ -716,7 +888,7 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		data := &authEntities.AuthorizationData{
 			Token:        "test",
@@ -748,7 +920,7 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(account, nil)
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
 
@@ -775,7 +947,7 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(account, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
 
@@ -800,7 +972,7 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(account, errors.New("test"))
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
 
@@ -815,7 +987,7 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock := &accountRepository.Mock{}
 
 		service := NewHorusecAuthenticationService(accountRepositoryMock, appConfig,
-			authentication.NewAuthenticationUseCases(), authRepositoryMock, cache.NewCache())
+			authentication.NewAuthenticationUseCases(), authRepositoryMock, newSessionServiceMock(), newMFAServiceMock(), nil)
 
 		result, err := service.GetAccountDataFromToken("")
 		assert.Error(t, err)
@@ -842,7 +1014,7 @@ func TestCheckRepositoryRequestForWorkspaceAdmin(t *testing.T) {
 		service := Service{
 			accountRepository: accountRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			authRepository:    authRepositoryMock,
 			appConfig:         appConfig,
 		}
@@ -875,7 +1047,7 @@ func TestCheckRepositoryRequestForWorkspaceAdmin(t *testing.T) {
 		service := Service{
 			accountRepository: accountRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			authRepository:    authRepositoryMock,
 			appConfig:         appConfig,
 		}
@@ -908,7 +1080,7 @@ func TestCheckRepositoryRequestForWorkspaceAdmin(t *testing.T) {
 		service := Service{
 			accountRepository: accountRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			authRepository:    authRepositoryMock,
 			appConfig:         appConfig,
 		}
@@ -939,7 +1111,7 @@ func TestCheckRepositoryRequestForWorkspaceAdmin(t *testing.T) {
 		service := Service{
 			accountRepository: accountRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			authRepository:    authRepositoryMock,
 			appConfig:         appConfig,
 		}
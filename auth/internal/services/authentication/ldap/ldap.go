@@ -1,33 +1,32 @@
 package ldap
 
 import (
-	"strings"
-
-	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
-	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
-	authEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 	ldapEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/ldap"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
 	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/groups"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/ldap/client"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	authUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
 )
 
 type Service struct {
 	ldap              client.ILdapClient
 	accountRepository accountRepository.IRepository
-	authRepository    authRepository.IRepository
 	authUseCases      authUseCases.IUseCases
-	appConfig         app.IConfig
-	cache             cache.ICache
+	groups            groups.IAuthorizer
+	sessionService    sessionService.IService
+	invitationService invitationService.IService
 }
 
 type IService interface {
@@ -37,14 +36,15 @@ type IService interface {
 }
 
 func NewLDAPAuthenticationService(repositoryAccount accountRepository.IRepository, useCasesAuth authUseCases.IUseCases,
-	appConfig app.IConfig, repositoryAuth authRepository.IRepository, cacheLib cache.ICache) IService {
+	appConfig app.IConfig, repositoryAuth authRepository.IRepository, serviceSession sessionService.IService,
+	serviceInvitation invitationService.IService) IService {
 	return &Service{
-		cache:             cacheLib,
+		sessionService:    serviceSession,
+		invitationService: serviceInvitation,
 		ldap:              client.NewLdapClient(),
 		accountRepository: repositoryAccount,
 		authUseCases:      useCasesAuth,
-		appConfig:         appConfig,
-		authRepository:    repositoryAuth,
+		groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, repositoryAuth),
 	}
 }
 
@@ -60,7 +60,7 @@ func (s *Service) Login(credentials *authEntities.LoginCredentials) (*authEntiti
 	}
 
 	defer s.ldap.Close()
-	return s.setTokenAndResponse(account, userData["dn"])
+	return s.setTokenAndResponse(account, userData["dn"], &credentials.Device)
 }
 
 func (s *Service) verifyAuthenticateErrors(err error, isAuthenticated bool) error {
@@ -74,172 +74,58 @@ func (s *Service) verifyAuthenticateErrors(err error, isAuthenticated bool) erro
 func (s *Service) getAccountOrCreateIfNotExist(userData map[string]string) (*accountEntities.Account, error) {
 	account, err := s.accountRepository.GetAccountByUsername(userData["sAMAccountName"])
 	if account == nil || err != nil {
-		return s.accountRepository.CreateAccount(s.authUseCases.SetLdapAccountData(userData))
+		return s.createAccount(userData)
 	}
 
-	return account, nil
-}
-
-func (s *Service) setTokenAndResponse(account *accountEntities.Account,
-	userDN string) (*authEntities.LoginResponse, error) {
-	userGroups, err := s.ldap.GetUserGroups(userDN)
-	if err != nil {
-		return nil, err
+	if account.IsDisabled {
+		return nil, accountEnums.ErrorAccountDisabled
 	}
 
-	return s.newLoginResponse(account, userGroups)
-}
-
-func (s *Service) newLoginResponse(account *accountEntities.Account,
-	userGroups []string) (*authEntities.LoginResponse, error) {
-	refreshToken := jwt.CreateRefreshToken()
-	s.setRefreshTokenCache(account.AccountID.String(), refreshToken)
-
-	accessToken, expiresAt, _ := jwt.CreateToken(account.ToTokenData(), userGroups)
-	return &authEntities.LoginResponse{
-		AccessToken:        accessToken,
-		RefreshToken:       refreshToken,
-		ExpiresAt:          expiresAt,
-		Username:           account.Username,
-		Email:              account.Email,
-		IsApplicationAdmin: s.isApplicationAdmin(userGroups),
-	}, nil
-}
-
-func (s *Service) setRefreshTokenCache(accountID, refreshToken string) {
-	s.cache.Delete(refreshToken)
-	s.cache.Set(refreshToken, accountID, authEnums.TokenDuration)
-}
-
-func (s *Service) isApplicationAdmin(userGroups []string) bool {
-	applicationAdminGroup, _ := s.getApplicationAdminAuthzGroupName()
-	return s.checkIsAuthorized(applicationAdminGroup, userGroups)
-}
-
-func (s *Service) getApplicationAdminAuthzGroupName() ([]string, error) {
-	applicationAdminGroup := env.GetEnvOrDefault(ldapEnums.EnvLdapAdminGroup, "")
-
-	if applicationAdminGroup == "" && s.appConfig.IsApplicationAdmEnabled() {
-		return []string{}, ldapEnums.ErrorLdapApplicationAdminGroupNotSet
-	}
-
-	return []string{applicationAdminGroup}, nil
-}
-
-func (s *Service) checkIsAuthorized(tokenGroups, horusecGroups []string) bool {
-	for _, tokenGroup := range tokenGroups {
-		if s.contains(horusecGroups, tokenGroup) {
-			return true
-		}
-	}
-
-	return false
-}
-
-func (s *Service) contains(horusecGroups []string, tokenGroup string) bool {
-	for _, horusecGroup := range horusecGroups {
-		if strings.TrimSpace(horusecGroup) == tokenGroup {
-			return true
-		}
-	}
-
-	return false
-}
-
-func (s *Service) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
-	tokenGroups, err := s.getUserGroupsFromJWT(data.Token)
-	if err != nil {
-		return false, err
-	}
-
-	horusecGroups, err := s.getHorusecAuthzGroups(data)
-	if err != nil {
-		return false, err
-	}
-
-	return s.checkIsAuthorized(tokenGroups, horusecGroups), nil
+	return account, nil
 }
 
-func (s *Service) getUserGroupsFromJWT(tokenStr string) ([]string, error) {
-	token, err := jwt.DecodeToken(tokenStr)
+// createAccount binds the pending invitations of the new account, the email is asserted by the ldap directory
+func (s *Service) createAccount(userData map[string]string) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.CreateAccount(s.authUseCases.SetLdapAccountData(userData))
 	if err != nil {
 		return nil, err
 	}
 
-	return token.Permissions, nil
-}
+	s.invitationService.BindPendingInvitations(account)
 
-func (s *Service) getHorusecAuthzGroups(data *authEntities.AuthorizationData) ([]string, error) {
-	switch data.Type {
-	case auth.ApplicationAdmin:
-		return s.getGroupsByAuthorizationType(data)
-	case auth.WorkspaceAdmin, auth.WorkspaceMember:
-		return s.getWorkspaceAuthzGroups(data)
-	case auth.RepositoryAdmin, auth.RepositorySupervisor, auth.RepositoryMember:
-		return s.getRepositoryAuthzGroups(data)
-	}
-
-	return nil, ldapEnums.ErrorInvalidAuthorizationType
-}
-
-func (s *Service) getWorkspaceAuthzGroups(data *authEntities.AuthorizationData) ([]string, error) {
-	workspaceGroups, err := s.authRepository.GetWorkspaceGroups(data.WorkspaceID)
-	if err != nil {
-		return nil, err
-	}
-
-	return s.getGroupsByAuthorizationType(data.SetGroups(workspaceGroups))
+	return account, nil
 }
 
-func (s *Service) getRepositoryAuthzGroups(data *authEntities.AuthorizationData) ([]string, error) {
-	workspaceGroups, err := s.authRepository.GetWorkspaceGroups(data.WorkspaceID)
-	if err != nil {
-		return nil, err
-	}
-
-	repositoryGroups, err := s.authRepository.GetRepositoryGroups(data.RepositoryID)
+func (s *Service) setTokenAndResponse(account *accountEntities.Account, userDN string,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	userGroups, err := s.ldap.GetUserGroups(userDN)
 	if err != nil {
 		return nil, err
 	}
 
-	groups, err := s.getGroupsByAuthorizationType(data.SetGroups(repositoryGroups))
-	return append(groups, workspaceGroups.AuthzAdmin...), err
+	return s.newLoginResponse(account, userGroups, device)
 }
 
-func (s *Service) getGroupsByAuthorizationType(data *authEntities.AuthorizationData) (groups []string, err error) {
-	appAdminAuthz, err := s.getApplicationAdminAuthzGroupName()
+func (s *Service) newLoginResponse(account *accountEntities.Account, userGroups []string,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	refreshToken, err := s.sessionService.CreateSession(account.AccountID, device)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.getGroupsByType(appAdminAuthz, data), err
-}
-
-func (s *Service) getGroupsByType(appAdminAuthz []string, data *authEntities.AuthorizationData) (groups []string) {
-	switch data.Type {
-	case auth.ApplicationAdmin:
-		groups = appAdminAuthz
-	case auth.RepositoryAdmin, auth.WorkspaceAdmin:
-		groups = s.appendAdmin(appAdminAuthz, data)
-	case auth.RepositorySupervisor:
-		groups = s.appendSupervisor(appAdminAuthz, data)
-	case auth.RepositoryMember, auth.WorkspaceMember:
-		groups = s.appendMember(appAdminAuthz, data)
-	}
-
-	return groups
-}
-
-func (s *Service) appendAdmin(appAdminAuthz []string, data *authEntities.AuthorizationData) []string {
-	return append(appAdminAuthz, data.AuthzAdmin...)
-}
-
-func (s *Service) appendSupervisor(appAdminAuthz []string, data *authEntities.AuthorizationData) []string {
-	return append(appAdminAuthz, append(data.AuthzAdmin, data.AuthzSupervisor...)...)
+	accessToken, expiresAt, _ := jwt.CreateToken(account.ToTokenData(), userGroups)
+	return &authEntities.LoginResponse{
+		AccessToken:        accessToken,
+		RefreshToken:       refreshToken,
+		ExpiresAt:          expiresAt,
+		Username:           account.Username,
+		Email:              account.Email,
+		IsApplicationAdmin: s.groups.IsApplicationAdmin(userGroups),
+	}, nil
 }
 
-func (s *Service) appendMember(appAdminAuthz []string, data *authEntities.AuthorizationData) []string {
-	return append(appAdminAuthz, append(data.AuthzAdmin, append(data.AuthzSupervisor, data.AuthzMember...)...)...)
+func (s *Service) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
+	return s.groups.IsAuthorized(data)
 }
 
 func (s *Service) GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error) {
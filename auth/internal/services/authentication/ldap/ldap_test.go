@@ -9,23 +9,34 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	authorization "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
-	"github.com/ZupIT/horusec-devkit/pkg/services/cache"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
 
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	groupsEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/groups"
 	ldapEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/ldap"
 	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
 	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/groups"
 	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/ldap/client"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
 	"github.com/ZupIT/horusec-platform/auth/internal/usecases/authentication"
 )
 
+func newSessionServiceMock() *sessionService.Mock {
+	sessionServiceMock := &sessionService.Mock{}
+	sessionServiceMock.On("CreateSession").Return("test", nil)
+
+	return sessionServiceMock
+}
+
 func TestNewLDAPAuthenticationService(t *testing.T) {
 	t.Run("should success create a new service", func(t *testing.T) {
 		assert.NotNil(t, NewLDAPAuthenticationService(nil, nil, nil,
-			nil, nil))
+			nil, nil, nil))
 	})
 }
 
@@ -48,13 +59,16 @@ func TestLogin(t *testing.T) {
 		ldapMock.On("GetUserGroups").Return([]string{}, nil)
 		ldapMock.On("Close")
 
+		invitationServiceMock := &invitationService.Mock{}
+		invitationServiceMock.On("BindPendingInvitations")
+
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
+			invitationService: invitationServiceMock,
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
@@ -65,6 +79,7 @@ func TestLogin(t *testing.T) {
 		assert.False(t, result.IsApplicationAdmin)
 		assert.Equal(t, "test", result.Username)
 		assert.Equal(t, "test@test.com", result.Email)
+		invitationServiceMock.AssertCalled(t, "BindPendingInvitations")
 	})
 
 	t.Run("should login with existing account without errors", func(t *testing.T) {
@@ -85,12 +100,11 @@ func TestLogin(t *testing.T) {
 		ldapMock.On("Close")
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
@@ -103,6 +117,27 @@ func TestLogin(t *testing.T) {
 		assert.Equal(t, "test@test.com", result.Email)
 	})
 
+	t.Run("should return error when existing account is disabled", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByUsername").Return(&accountEntities.Account{IsDisabled: true}, nil)
+
+		ldapMock := &client.Mock{}
+		ldapMock.On("Authenticate").Return(true, map[string]string{}, nil)
+		ldapMock.On("Close")
+
+		service := Service{
+			sessionService:    newSessionServiceMock(),
+			ldap:              ldapMock,
+			accountRepository: accountRepositoryMock,
+			authUseCases:      authentication.NewAuthenticationUseCases(),
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, &app.Config{}, &authRepository.Mock{}),
+		}
+
+		_, err := service.Login(&authEntities.LoginCredentials{})
+		assert.Equal(t, accountEnums.ErrorAccountDisabled, err)
+		ldapMock.AssertNotCalled(t, "GetUserGroups")
+	})
+
 	t.Run("should return error when failed to get user groups", func(t *testing.T) {
 		appConfig := &app.Config{}
 		authRepositoryMock := &authRepository.Mock{}
@@ -121,12 +156,11 @@ func TestLogin(t *testing.T) {
 		ldapMock.On("Close")
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
@@ -152,18 +186,21 @@ func TestLogin(t *testing.T) {
 		ldapMock.On("GetUserGroups").Return([]string{}, errors.New("test"))
 		ldapMock.On("Close")
 
+		invitationServiceMock := &invitationService.Mock{}
+
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
+			invitationService: invitationServiceMock,
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
 		assert.Error(t, err)
 		assert.Nil(t, result)
+		invitationServiceMock.AssertNotCalled(t, "BindPendingInvitations")
 	})
 
 	t.Run("should return error user does no exist when failed to authenticate", func(t *testing.T) {
@@ -176,12 +213,11 @@ func TestLogin(t *testing.T) {
 			true, map[string]string{}, ldapEnums.ErrorUserDoesNotExist)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
@@ -200,12 +236,11 @@ func TestLogin(t *testing.T) {
 			true, map[string]string{}, ldapEnums.ErrorLdapUnauthorized)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
@@ -233,12 +268,11 @@ func TestLogin(t *testing.T) {
 		ldapMock.On("Close")
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		result, err := service.Login(&authEntities.LoginCredentials{})
@@ -267,12 +301,11 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -307,12 +340,11 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -347,12 +379,11 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -367,7 +398,7 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		result, err := service.IsAuthorized(data)
 		assert.False(t, result)
 		assert.Error(t, err)
-		assert.Equal(t, err, ldapEnums.ErrorLdapApplicationAdminGroupNotSet)
+		assert.Equal(t, err, groupsEnums.ErrorApplicationAdminGroupNotSet)
 	})
 
 	t.Run("should should return error when failed to get account id from token", func(t *testing.T) {
@@ -380,12 +411,11 @@ func TestIsAuthorizedApplicationAdmin(t *testing.T) {
 		}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		data := &authEntities.AuthorizationData{
@@ -413,17 +443,16 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzMember: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzMember: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -451,17 +480,16 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzMember: []string{"test2"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzMember: []string{"test2"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -489,17 +517,16 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{}
+		authzGroups := &authEntities.AuthzGroups{}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -523,12 +550,11 @@ func TestIsAuthorizedWorkspaceMember(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		data := &authEntities.AuthorizationData{
@@ -556,17 +582,16 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -594,17 +619,16 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -632,17 +656,16 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{}
+		authzGroups := &authEntities.AuthzGroups{}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -666,12 +689,11 @@ func TestIsAuthorizedWorkspaceAdmin(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		data := &authEntities.AuthorizationData{
@@ -699,18 +721,17 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -738,18 +759,17 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -777,18 +797,17 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -816,17 +835,16 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{}
+		authzGroups := &authEntities.AuthzGroups{}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -850,12 +868,11 @@ func TestIsAuthorizedRepositoryAdmin(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		data := &authEntities.AuthorizationData{
@@ -883,18 +900,17 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -922,18 +938,17 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -961,18 +976,17 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1000,17 +1014,16 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{}
+		authzGroups := &authEntities.AuthzGroups{}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1034,12 +1047,11 @@ func TestIsAuthorizedRepositorySupervisor(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		data := &authEntities.AuthorizationData{
@@ -1067,18 +1079,17 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1106,18 +1117,17 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test2"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, nil)
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1145,18 +1155,17 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
+		authzGroups := &authEntities.AuthzGroups{AuthzAdmin: []string{"test"}}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, nil)
-		authRepositoryMock.On("GetRepositoryGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1184,17 +1193,16 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 			IsApplicationAdmin: true,
 		}
 
-		groups := &authEntities.AuthzGroups{}
+		authzGroups := &authEntities.AuthzGroups{}
 		authRepositoryMock := &authRepository.Mock{}
-		authRepositoryMock.On("GetWorkspaceGroups").Return(groups, errors.New("test"))
+		authRepositoryMock.On("GetWorkspaceGroups").Return(authzGroups, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1218,12 +1226,11 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		data := &authEntities.AuthorizationData{
@@ -1239,21 +1246,6 @@ func TestIsAuthorizedRepositoryMember(t *testing.T) {
 	})
 }
 
-func TestGetHorusecAuthzGroups(t *testing.T) {
-	t.Run("should error when invalid authorization type", func(t *testing.T) {
-		service := &Service{}
-
-		data := &authEntities.AuthorizationData{
-			Type: "test",
-		}
-
-		groups, err := service.getHorusecAuthzGroups(data)
-
-		assert.Nil(t, groups)
-		assert.Error(t, err)
-	})
-}
-
 func TestGetAccountDataFromToken(t *testing.T) {
 	t.Run("should return account data without errors", func(t *testing.T) {
 		authRepositoryMock := &authRepository.Mock{}
@@ -1272,12 +1264,11 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(account, nil)
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1306,12 +1297,11 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(account, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1338,12 +1328,11 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		accountRepositoryMock.On("GetAccount").Return(account, errors.New("test"))
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		token, _, _ := jwt.CreateToken(account.ToTokenData(), []string{"test"})
@@ -1360,12 +1349,11 @@ func TestGetAccountDataFromToken(t *testing.T) {
 		ldapMock := &client.Mock{}
 
 		service := Service{
-			cache:             cache.NewCache(),
+			sessionService:    newSessionServiceMock(),
 			ldap:              ldapMock,
 			accountRepository: accountRepositoryMock,
-			authRepository:    authRepositoryMock,
 			authUseCases:      authentication.NewAuthenticationUseCases(),
-			appConfig:         appConfig,
+			groups:            groups.NewGroupsAuthorizer(ldapEnums.EnvLdapAdminGroup, appConfig, authRepositoryMock),
 		}
 
 		result, err := service.GetAccountDataFromToken("")
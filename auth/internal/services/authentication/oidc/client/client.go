@@ -0,0 +1,257 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+)
+
+type IClient interface {
+	GetAuthorizationURL(request *oidcEntities.AuthorizationRequest) (string, error)
+	ExchangeCode(code, codeVerifier string) (*oidcEntities.Token, error)
+	ValidateIDToken(idToken, nonce string) (map[string]interface{}, error)
+}
+
+// Client talks with a standard openid connect issuer, the discovery document is loaded on the first use and the
+// jwks is reloaded when an id token is signed by an unknown key, supporting the issuer keys rotation
+type Client struct {
+	mutex        sync.Mutex
+	httpClient   *http.Client
+	issuer       string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       string
+	discovery    *oidcEntities.Discovery
+	jwks         *oidcEntities.JWKS
+}
+
+func NewOIDCClient() IClient {
+	return &Client{
+		httpClient:   &http.Client{Timeout: oidcEnums.HTTPClientTimeout},
+		issuer:       strings.TrimSuffix(env.GetEnvOrDefault(oidcEnums.EnvOIDCIssuer, ""), "/"),
+		clientID:     env.GetEnvOrDefault(oidcEnums.EnvOIDCClientID, ""),
+		clientSecret: env.GetEnvOrDefault(oidcEnums.EnvOIDCClientSecret, ""),
+		redirectURL:  env.GetEnvOrDefault(oidcEnums.EnvOIDCRedirectURL, ""),
+		scopes:       env.GetEnvOrDefault(oidcEnums.EnvOIDCScopes, oidcEnums.DefaultScopes),
+	}
+}
+
+func (c *Client) GetAuthorizationURL(request *oidcEntities.AuthorizationRequest) (string, error) {
+	discovery, err := c.getDiscovery()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s?%s", discovery.AuthorizationEndpoint, c.newAuthorizationQuery(request).Encode()), nil
+}
+
+func (c *Client) newAuthorizationQuery(request *oidcEntities.AuthorizationRequest) url.Values {
+	return url.Values{
+		"response_type":         {oidcEnums.ResponseTypeCode},
+		"client_id":             {c.clientID},
+		"redirect_uri":          {c.redirectURL},
+		"scope":                 {c.scopes},
+		"state":                 {request.State},
+		"nonce":                 {request.Nonce},
+		"code_challenge":        {request.GetCodeChallenge()},
+		"code_challenge_method": {oidcEnums.CodeChallengeMethod},
+	}
+}
+
+func (c *Client) ExchangeCode(code, codeVerifier string) (*oidcEntities.Token, error) {
+	discovery, err := c.getDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oidcEntities.Token{}
+	if err := c.postForm(discovery.TokenEndpoint, c.newTokenForm(code, codeVerifier), token); err != nil {
+		return nil, errors.Wrap(err, oidcEnums.MessageFailedToExchangeCode)
+	}
+
+	if token.IDToken == "" {
+		return nil, oidcEnums.ErrorOIDCMissingIDToken
+	}
+
+	return token, nil
+}
+
+func (c *Client) newTokenForm(code, codeVerifier string) url.Values {
+	return url.Values{
+		"grant_type":    {oidcEnums.GrantTypeAuthorizationCode},
+		"code":          {code},
+		"redirect_uri":  {c.redirectURL},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+		"code_verifier": {codeVerifier},
+	}
+}
+
+func (c *Client) ValidateIDToken(idToken, nonce string) (map[string]interface{}, error) {
+	claims := jwt.MapClaims{}
+
+	if _, err := jwt.ParseWithClaims(idToken, claims, c.getSigningKey); err != nil {
+		return nil, errors.Wrap(err, oidcEnums.ErrorOIDCInvalidIDToken.Error())
+	}
+
+	return claims, c.validateClaims(claims, nonce)
+}
+
+func (c *Client) validateClaims(claims jwt.MapClaims, nonce string) error {
+	if issuer, _ := claims[oidcEnums.ClaimIssuer].(string); strings.TrimSuffix(issuer, "/") != c.issuer {
+		return oidcEnums.ErrorOIDCInvalidIssuer
+	}
+
+	if !c.containsAudience(claims[oidcEnums.ClaimAudience]) {
+		return oidcEnums.ErrorOIDCInvalidAudience
+	}
+
+	if claimNonce, _ := claims[oidcEnums.ClaimNonce].(string); claimNonce != nonce {
+		return oidcEnums.ErrorOIDCInvalidNonce
+	}
+
+	return nil
+}
+
+func (c *Client) containsAudience(audience interface{}) bool {
+	switch value := audience.(type) {
+	case string:
+		return value == c.clientID
+	case []interface{}:
+		for _, item := range value {
+			if item == c.clientID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (c *Client) getSigningKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+		return nil, oidcEnums.ErrorOIDCUnexpectedSigningMethod
+	}
+
+	keyID, _ := token.Header[oidcEnums.HeaderKeyID].(string)
+
+	key, err := c.getJWK(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.ToRSAPublicKey()
+}
+
+func (c *Client) getJWK(keyID string) (*oidcEntities.JWK, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if key := c.getCachedJWK(keyID); key != nil {
+		return key, nil
+	}
+
+	return c.reloadJWK(keyID)
+}
+
+// reloadJWK must be called holding the client mutex
+func (c *Client) reloadJWK(keyID string) (*oidcEntities.JWK, error) {
+	if err := c.loadJWKS(); err != nil {
+		return nil, err
+	}
+
+	if key := c.getCachedJWK(keyID); key != nil {
+		return key, nil
+	}
+
+	return nil, oidcEnums.ErrorOIDCSigningKeyNotFound
+}
+
+func (c *Client) getCachedJWK(keyID string) *oidcEntities.JWK {
+	if c.jwks == nil {
+		return nil
+	}
+
+	return c.jwks.GetKey(keyID)
+}
+
+func (c *Client) loadJWKS() error {
+	discovery, err := c.loadDiscovery()
+	if err != nil {
+		return err
+	}
+
+	jwks := &oidcEntities.JWKS{}
+	if err := c.getJSON(discovery.JWKSURI, jwks); err != nil {
+		return errors.Wrap(err, oidcEnums.MessageFailedToGetJWKS)
+	}
+
+	c.jwks = jwks
+	return nil
+}
+
+func (c *Client) getDiscovery() (*oidcEntities.Discovery, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.loadDiscovery()
+}
+
+// loadDiscovery must be called holding the client mutex
+func (c *Client) loadDiscovery() (*oidcEntities.Discovery, error) {
+	if c.discovery != nil {
+		return c.discovery, nil
+	}
+
+	discovery := &oidcEntities.Discovery{}
+	if err := c.getJSON(c.issuer+oidcEnums.DiscoveryPath, discovery); err != nil {
+		return nil, errors.Wrap(err, oidcEnums.MessageFailedToGetDiscoveryDocument)
+	}
+
+	if strings.TrimSuffix(discovery.Issuer, "/") != c.issuer {
+		return nil, oidcEnums.ErrorOIDCInvalidIssuer
+	}
+
+	c.discovery = discovery
+	return discovery, nil
+}
+
+func (c *Client) getJSON(endpoint string, entity interface{}) error {
+	response, err := c.httpClient.Get(endpoint)
+	if err != nil {
+		return err
+	}
+
+	return c.parseResponse(response, endpoint, entity)
+}
+
+func (c *Client) postForm(endpoint string, form url.Values, entity interface{}) error {
+	response, err := c.httpClient.PostForm(endpoint, form)
+	if err != nil {
+		return err
+	}
+
+	return c.parseResponse(response, endpoint, entity)
+}
+
+func (c *Client) parseResponse(response *http.Response, endpoint string, entity interface{}) error {
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf(oidcEnums.MessageUnexpectedStatusCode, response.StatusCode, endpoint)
+	}
+
+	return json.NewDecoder(response.Body).Decode(entity)
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetAuthorizationURL(_ *oidcEntities.AuthorizationRequest) (string, error) {
+	args := m.MethodCalled("GetAuthorizationURL")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ExchangeCode(_, _ string) (*oidcEntities.Token, error) {
+	args := m.MethodCalled("ExchangeCode")
+	return args.Get(0).(*oidcEntities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ValidateIDToken(_, _ string) (map[string]interface{}, error) {
+	args := m.MethodCalled("ValidateIDToken")
+	return args.Get(0).(map[string]interface{}), mockUtils.ReturnNilOrError(args, 1)
+}
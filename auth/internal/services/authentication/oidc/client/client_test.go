@@ -0,0 +1,221 @@
+package client
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+)
+
+const testKeyID = "test-key"
+
+type testIssuer struct {
+	server     *httptest.Server
+	privateKey *rsa.PrivateKey
+	idToken    string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	issuer := &testIssuer{privateKey: privateKey}
+	issuer.server = httptest.NewServer(http.HandlerFunc(issuer.handle))
+
+	return issuer
+}
+
+func (i *testIssuer) handle(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case oidcEnums.DiscoveryPath:
+		_ = json.NewEncoder(w).Encode(&oidcEntities.Discovery{Issuer: i.server.URL,
+			AuthorizationEndpoint: i.server.URL + "/authorize", TokenEndpoint: i.server.URL + "/token",
+			JWKSURI: i.server.URL + "/jwks"})
+	case "/jwks":
+		_ = json.NewEncoder(w).Encode(&oidcEntities.JWKS{Keys: []oidcEntities.JWK{{KeyID: testKeyID,
+			KeyType: oidcEnums.KeyTypeRSA, Modulus: base64.RawURLEncoding.EncodeToString(i.privateKey.N.Bytes()),
+			Exponent: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.privateKey.E)).Bytes())}}})
+	case "/token":
+		i.handleToken(w, r)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (i *testIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("code_verifier") == "" || r.FormValue("code") != "code" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(&oidcEntities.Token{AccessToken: "access", IDToken: i.idToken})
+}
+
+func (i *testIssuer) signToken(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header[oidcEnums.HeaderKeyID] = testKeyID
+
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+
+	return signed
+}
+
+func (i *testIssuer) newClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":   i.server.URL,
+		"aud":   []interface{}{"horusec"},
+		"sub":   "subject",
+		"nonce": "nonce",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+	}
+}
+
+func newTestClient(issuer *testIssuer) *Client {
+	_ = os.Setenv(oidcEnums.EnvOIDCIssuer, issuer.server.URL)
+	_ = os.Setenv(oidcEnums.EnvOIDCClientID, "horusec")
+	_ = os.Setenv(oidcEnums.EnvOIDCRedirectURL, "http://localhost:8043/auth")
+
+	return NewOIDCClient().(*Client)
+}
+
+func TestGetAuthorizationURL(t *testing.T) {
+	t.Run("should return authorization url with pkce challenge", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		defer issuer.server.Close()
+
+		request := oidcEntities.NewAuthorizationRequest()
+
+		authorizationURL, err := newTestClient(issuer).GetAuthorizationURL(request)
+		assert.NoError(t, err)
+
+		parsedURL, _ := url.Parse(authorizationURL)
+		assert.Equal(t, "/authorize", parsedURL.Path)
+		assert.Equal(t, request.State, parsedURL.Query().Get("state"))
+		assert.Equal(t, request.GetCodeChallenge(), parsedURL.Query().Get("code_challenge"))
+		assert.Equal(t, oidcEnums.CodeChallengeMethod, parsedURL.Query().Get("code_challenge_method"))
+	})
+
+	t.Run("should return error when failed to get discovery document", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		client := newTestClient(issuer)
+		issuer.server.Close()
+
+		_, err := client.GetAuthorizationURL(oidcEntities.NewAuthorizationRequest())
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when discovery document has other issuer", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		defer issuer.server.Close()
+
+		client := newTestClient(issuer)
+		client.issuer = "http://other-issuer"
+
+		_, err := client.GetAuthorizationURL(oidcEntities.NewAuthorizationRequest())
+		assert.Error(t, err)
+	})
+}
+
+func TestExchangeCode(t *testing.T) {
+	t.Run("should success exchange authorization code", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		defer issuer.server.Close()
+		issuer.idToken = "id-token"
+
+		token, err := newTestClient(issuer).ExchangeCode("code", "verifier")
+		assert.NoError(t, err)
+		assert.Equal(t, "id-token", token.IDToken)
+	})
+
+	t.Run("should return error when token response has no id token", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		defer issuer.server.Close()
+
+		_, err := newTestClient(issuer).ExchangeCode("code", "verifier")
+		assert.Equal(t, oidcEnums.ErrorOIDCMissingIDToken, err)
+	})
+
+	t.Run("should return error when issuer rejects the code", func(t *testing.T) {
+		issuer := newTestIssuer(t)
+		defer issuer.server.Close()
+
+		_, err := newTestClient(issuer).ExchangeCode("invalid", "verifier")
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateIDToken(t *testing.T) {
+	issuer := newTestIssuer(t)
+	defer issuer.server.Close()
+
+	t.Run("should success validate id token", func(t *testing.T) {
+		idToken := issuer.signToken(t, jwt.SigningMethodRS256, issuer.privateKey, issuer.newClaims())
+
+		claims, err := newTestClient(issuer).ValidateIDToken(idToken, "nonce")
+		assert.NoError(t, err)
+		assert.Equal(t, "subject", claims["sub"])
+	})
+
+	t.Run("should return error when nonce does not match", func(t *testing.T) {
+		idToken := issuer.signToken(t, jwt.SigningMethodRS256, issuer.privateKey, issuer.newClaims())
+
+		_, err := newTestClient(issuer).ValidateIDToken(idToken, "other")
+		assert.Equal(t, oidcEnums.ErrorOIDCInvalidNonce, err)
+	})
+
+	t.Run("should return error when audience does not match", func(t *testing.T) {
+		claims := issuer.newClaims()
+		claims["aud"] = "other"
+		idToken := issuer.signToken(t, jwt.SigningMethodRS256, issuer.privateKey, claims)
+
+		_, err := newTestClient(issuer).ValidateIDToken(idToken, "nonce")
+		assert.Equal(t, oidcEnums.ErrorOIDCInvalidAudience, err)
+	})
+
+	t.Run("should return error when issuer does not match", func(t *testing.T) {
+		claims := issuer.newClaims()
+		claims["iss"] = "other"
+		idToken := issuer.signToken(t, jwt.SigningMethodRS256, issuer.privateKey, claims)
+
+		_, err := newTestClient(issuer).ValidateIDToken(idToken, "nonce")
+		assert.Equal(t, oidcEnums.ErrorOIDCInvalidIssuer, err)
+	})
+
+	t.Run("should return error when id token is expired", func(t *testing.T) {
+		claims := issuer.newClaims()
+		claims["exp"] = time.Now().Add(-time.Minute).Unix()
+		idToken := issuer.signToken(t, jwt.SigningMethodRS256, issuer.privateKey, claims)
+
+		_, err := newTestClient(issuer).ValidateIDToken(idToken, "nonce")
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when signed with other key", func(t *testing.T) {
+		otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+		idToken := issuer.signToken(t, jwt.SigningMethodRS256, otherKey, issuer.newClaims())
+
+		_, err := newTestClient(issuer).ValidateIDToken(idToken, "nonce")
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when signed with unexpected method", func(t *testing.T) {
+		idToken := issuer.signToken(t, jwt.SigningMethodHS256, []byte("secret"), issuer.newClaims())
+
+		_, err := newTestClient(issuer).ValidateIDToken(idToken, "nonce")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,219 @@
+package oidc
+
+import (
+	"fmt"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/groups"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/oidc/client"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+type IService interface {
+	Login(credentials *authEntities.LoginCredentials) (*authEntities.LoginResponse, error)
+	IsAuthorized(data *authEntities.AuthorizationData) (bool, error)
+	GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error)
+	GetAuthorizationURL() (*oidcEntities.AuthorizationURLResponse, error)
+	Callback(data *oidcEntities.CallbackData) (*authEntities.LoginResponse, error)
+}
+
+type Service struct {
+	oidc              client.IClient
+	accountRepository accountRepository.IRepository
+	groups            groups.IAuthorizer
+	cacheRepository   cacheRepository.IRepository
+	sessionService    sessionService.IService
+	invitationService invitationService.IService
+	claimsMapping     *oidcEntities.ClaimsMapping
+}
+
+func NewOIDCAuthenticationService(repositoryAccount accountRepository.IRepository, appConfig app.IConfig,
+	repositoryAuth authRepository.IRepository, repositoryCache cacheRepository.IRepository,
+	serviceSession sessionService.IService, serviceInvitation invitationService.IService) IService {
+	return &Service{
+		sessionService:    serviceSession,
+		invitationService: serviceInvitation,
+		oidc:              client.NewOIDCClient(),
+		accountRepository: repositoryAccount,
+		groups:            groups.NewGroupsAuthorizer(oidcEnums.EnvOIDCAdminGroup, appConfig, repositoryAuth),
+		cacheRepository:   repositoryCache,
+		claimsMapping:     oidcEntities.NewClaimsMapping(),
+	}
+}
+
+func (s *Service) Login(_ *authEntities.LoginCredentials) (*authEntities.LoginResponse, error) {
+	return nil, oidcEnums.ErrorOIDCPasswordLoginNotSupported
+}
+
+func (s *Service) GetAuthorizationURL() (*oidcEntities.AuthorizationURLResponse, error) {
+	request := oidcEntities.NewAuthorizationRequest()
+
+	authorizationURL, err := s.oidc.GetAuthorizationURL(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cacheRepository.Set(s.getStateCacheKey(request.State), request.ToString(),
+		oidcEnums.AuthorizationRequestDuration); err != nil {
+		return nil, err
+	}
+
+	return request.ToAuthorizationURLResponse(authorizationURL), nil
+}
+
+func (s *Service) getStateCacheKey(state string) string {
+	return fmt.Sprintf(oidcEnums.CacheKeyAuthorizationState, state)
+}
+
+func (s *Service) Callback(data *oidcEntities.CallbackData) (*authEntities.LoginResponse, error) {
+	userInfo, err := s.getUserInfo(data)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.getAccountOrCreateIfNotExist(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.newLoginResponse(account, userInfo.Groups, &data.Device)
+}
+
+// popAuthorizationRequest removes the authorization request from the database cache, so each state can be used only
+// once, even when the callback is received by another replica
+func (s *Service) popAuthorizationRequest(state string) (*oidcEntities.AuthorizationRequest, error) {
+	value, err := s.cacheRepository.Pop(s.getStateCacheKey(state))
+	if err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return nil, oidcEnums.ErrorOIDCInvalidState
+		}
+
+		return nil, err
+	}
+
+	return oidcEntities.ParseAuthorizationRequest(value)
+}
+
+func (s *Service) getUserInfo(data *oidcEntities.CallbackData) (*oidcEntities.UserInfo, error) {
+	request, err := s.popAuthorizationRequest(data.State)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.oidc.ExchangeCode(data.Code, request.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validateIDToken(token, request)
+}
+
+func (s *Service) validateIDToken(token *oidcEntities.Token,
+	request *oidcEntities.AuthorizationRequest) (*oidcEntities.UserInfo, error) {
+	claims, err := s.oidc.ValidateIDToken(token.IDToken, request.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo := oidcEntities.NewUserInfoFromClaims(claims, s.claimsMapping)
+	return userInfo, userInfo.Validate()
+}
+
+// getAccountOrCreateIfNotExist finds the account linked to the issuer subject, since the email can be reassigned by
+// the identity provider it is only used to link the account on the first login
+func (s *Service) getAccountOrCreateIfNotExist(userInfo *oidcEntities.UserInfo) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.GetAccountByIdentity(userInfo.Issuer, userInfo.Subject)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		account, err = s.linkAccountOrCreateIfNotExist(userInfo)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsDisabled {
+		return nil, accountEnums.ErrorAccountDisabled
+	}
+
+	return account, nil
+}
+
+// linkAccountOrCreateIfNotExist links the account with the same verified email, an account already linked to other
+// subject of the issuer is rejected by the identities unique constraint
+func (s *Service) linkAccountOrCreateIfNotExist(userInfo *oidcEntities.UserInfo) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.GetAccountByEmail(userInfo.Email)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		account, err = s.createAccount(userInfo)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return account, s.accountRepository.CreateIdentity(userInfo.ToIdentity(account.AccountID))
+}
+
+// createAccount binds the pending invitations of the new account, the provider already asserted that the email
+// is verified
+func (s *Service) createAccount(userInfo *oidcEntities.UserInfo) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.CreateAccount(userInfo.ToAccount())
+	if err != nil {
+		return nil, err
+	}
+
+	s.invitationService.BindPendingInvitations(account)
+
+	return account, nil
+}
+
+func (s *Service) newLoginResponse(account *accountEntities.Account, userGroups []string,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	refreshToken, err := s.sessionService.CreateSession(account.AccountID, device)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, _ := jwt.CreateToken(account.ToTokenData(), userGroups)
+	return &authEntities.LoginResponse{
+		AccountID:          account.AccountID,
+		AccessToken:        accessToken,
+		RefreshToken:       refreshToken,
+		ExpiresAt:          expiresAt,
+		Username:           account.Username,
+		Email:              account.Email,
+		IsApplicationAdmin: s.groups.IsApplicationAdmin(userGroups),
+	}, nil
+}
+
+func (s *Service) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
+	return s.groups.IsAuthorized(data)
+}
+
+func (s *Service) GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error) {
+	claims, err := jwt.DecodeToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.accountRepository.GetAccount(parser.ParseStringToUUID(claims.Subject))
+	if err != nil {
+		return nil, err
+	}
+
+	return account.ToGetAccountDataResponse(claims.Permissions), nil
+}
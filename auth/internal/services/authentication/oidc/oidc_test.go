@@ -0,0 +1,445 @@
+package oidc
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	authorization "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	tokenEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	oidcEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/oidc"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	groupsEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/groups"
+	oidcEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/oidc"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/groups"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/oidc/client"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+func newSessionServiceMock() *sessionService.Mock {
+	sessionServiceMock := &sessionService.Mock{}
+	sessionServiceMock.On("CreateSession").Return("test", nil)
+
+	return sessionServiceMock
+}
+
+func newTestService(oidcMock *client.Mock, accountRepositoryMock *accountRepository.Mock,
+	authRepositoryMock *authRepository.Mock, cacheRepositoryMock *cacheRepository.Mock) *Service {
+	return &Service{
+		oidc:              oidcMock,
+		accountRepository: accountRepositoryMock,
+		cacheRepository:   cacheRepositoryMock,
+		sessionService:    newSessionServiceMock(),
+		invitationService: newInvitationServiceMock(),
+		claimsMapping:     oidcEntities.NewClaimsMapping(),
+		groups:            groups.NewGroupsAuthorizer(oidcEnums.EnvOIDCAdminGroup, &app.Config{}, authRepositoryMock),
+	}
+}
+
+func newInvitationServiceMock() *invitationService.Mock {
+	invitationServiceMock := &invitationService.Mock{}
+	invitationServiceMock.On("BindPendingInvitations").Return()
+
+	return invitationServiceMock
+}
+
+func newCacheRepositoryMockWithState() *cacheRepository.Mock {
+	cacheRepositoryMock := &cacheRepository.Mock{}
+	cacheRepositoryMock.On("Pop").Return(oidcEntities.NewAuthorizationRequest().ToString(), nil)
+
+	return cacheRepositoryMock
+}
+
+func newTestClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":                "http://issuer",
+		"sub":                uuid.NewString(),
+		"preferred_username": "test",
+		"email":              "test@test.com",
+		"email_verified":     true,
+		"groups":             []interface{}{"admin"},
+	}
+}
+
+func TestNewOIDCAuthenticationService(t *testing.T) {
+	t.Run("should success create a new service", func(t *testing.T) {
+		assert.NotNil(t, NewOIDCAuthenticationService(nil, nil, nil, nil, nil, nil))
+	})
+}
+
+func TestLogin(t *testing.T) {
+	t.Run("should return error when login with credentials", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			&cacheRepository.Mock{})
+
+		_, err := service.Login(&authEntities.LoginCredentials{})
+		assert.Equal(t, oidcEnums.ErrorOIDCPasswordLoginNotSupported, err)
+	})
+}
+
+func TestGetAuthorizationURL(t *testing.T) {
+	t.Run("should return authorization url and cache the request state", func(t *testing.T) {
+		oidcMock := &client.Mock{}
+		oidcMock.On("GetAuthorizationURL").Return("http://issuer/authorize", nil)
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(nil)
+
+		service := newTestService(oidcMock, &accountRepository.Mock{}, &authRepository.Mock{}, cacheRepositoryMock)
+
+		result, err := service.GetAuthorizationURL()
+		assert.NoError(t, err)
+		assert.Equal(t, "http://issuer/authorize", result.AuthorizationURL)
+		assert.NotEmpty(t, result.State)
+		cacheRepositoryMock.AssertCalled(t, "Set")
+	})
+
+	t.Run("should return error when failed to cache the request state", func(t *testing.T) {
+		oidcMock := &client.Mock{}
+		oidcMock.On("GetAuthorizationURL").Return("http://issuer/authorize", nil)
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(errors.New("test"))
+
+		service := newTestService(oidcMock, &accountRepository.Mock{}, &authRepository.Mock{}, cacheRepositoryMock)
+
+		_, err := service.GetAuthorizationURL()
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to get authorization url", func(t *testing.T) {
+		oidcMock := &client.Mock{}
+		oidcMock.On("GetAuthorizationURL").Return("", errors.New("test"))
+
+		service := newTestService(oidcMock, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		_, err := service.GetAuthorizationURL()
+		assert.Error(t, err)
+	})
+}
+
+func TestCallback(t *testing.T) {
+	account := &accountEntities.Account{AccountID: uuid.New(), Username: "test", Email: "test@test.com"}
+	data := &oidcEntities.CallbackData{Code: "code", State: "state"}
+
+	newOIDCMock := func(claims map[string]interface{}) *client.Mock {
+		oidcMock := &client.Mock{}
+		oidcMock.On("ExchangeCode").Return(&oidcEntities.Token{IDToken: "test"}, nil)
+		oidcMock.On("ValidateIDToken").Return(claims, nil)
+		return oidcMock
+	}
+
+	t.Run("should login with the account linked to the issuer subject", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(account, nil)
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		result, err := service.Callback(data)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result.AccessToken)
+		assert.Equal(t, "test@test.com", result.Email)
+		accountRepositoryMock.AssertNotCalled(t, "GetAccountByEmail")
+		accountRepositoryMock.AssertNotCalled(t, "CreateIdentity")
+
+		claims, _ := jwt.DecodeToken(result.AccessToken)
+		assert.Equal(t, []string{"admin"}, claims.Permissions)
+	})
+
+	t.Run("should link existing account with same email on first login", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+		accountRepositoryMock.On("CreateIdentity").Return(nil)
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.NoError(t, err)
+		accountRepositoryMock.AssertCalled(t, "CreateIdentity")
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should create and link account when there is none with the email", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("CreateAccount").Return(account, nil)
+		accountRepositoryMock.On("CreateIdentity").Return(nil)
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		result, err := service.Callback(data)
+		assert.NoError(t, err)
+		assert.Equal(t, account.AccountID, result.AccountID)
+		accountRepositoryMock.AssertCalled(t, "CreateAccount")
+		accountRepositoryMock.AssertCalled(t, "CreateIdentity")
+		service.invitationService.(*invitationService.Mock).AssertCalled(t, "BindPendingInvitations")
+	})
+
+	t.Run("should return error and not create account when failed to get account by email", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should return error and not create account when failed to get account by identity", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+		accountRepositoryMock.AssertNotCalled(t, "GetAccountByEmail")
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should return error when failed to link account", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+		accountRepositoryMock.On("CreateIdentity").Return(errors.New("test"))
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to create account", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("CreateAccount").Return(account, errors.New("test"))
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+		accountRepositoryMock.AssertNotCalled(t, "CreateIdentity")
+	})
+
+	t.Run("should return error when existing account is disabled", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(&accountEntities.Account{IsDisabled: true}, nil)
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Equal(t, accountEnums.ErrorAccountDisabled, err)
+	})
+
+	t.Run("should return error when failed to create session", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByIdentity").Return(account, nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("CreateSession").Return("", errors.New("test"))
+
+		service := newTestService(newOIDCMock(newTestClaims()), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+		service.sessionService = sessionServiceMock
+
+		result, err := service.Callback(data)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when state is missing, expired or already used", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.Callback(data)
+		assert.Equal(t, oidcEnums.ErrorOIDCInvalidState, err)
+	})
+
+	t.Run("should return error when failed to get state", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", errors.New("test"))
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+		assert.NotEqual(t, oidcEnums.ErrorOIDCInvalidState, err)
+	})
+
+	t.Run("should return error when failed to exchange code", func(t *testing.T) {
+		oidcMock := &client.Mock{}
+		oidcMock.On("ExchangeCode").Return(&oidcEntities.Token{}, errors.New("test"))
+
+		service := newTestService(oidcMock, &accountRepository.Mock{}, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to validate id token", func(t *testing.T) {
+		oidcMock := &client.Mock{}
+		oidcMock.On("ExchangeCode").Return(&oidcEntities.Token{IDToken: "test"}, nil)
+		oidcMock.On("ValidateIDToken").Return(map[string]interface{}{}, errors.New("test"))
+
+		service := newTestService(oidcMock, &accountRepository.Mock{}, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when id token missing email", func(t *testing.T) {
+		service := newTestService(newOIDCMock(map[string]interface{}{"sub": "test"}), &accountRepository.Mock{},
+			&authRepository.Mock{}, newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Equal(t, oidcEnums.ErrorOIDCMissingSubjectOrEmail, err)
+	})
+
+	t.Run("should return error and not get account when email not verified", func(t *testing.T) {
+		claims := newTestClaims()
+		claims["email_verified"] = false
+
+		accountRepositoryMock := &accountRepository.Mock{}
+
+		service := newTestService(newOIDCMock(claims), accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithState())
+
+		_, err := service.Callback(data)
+		assert.Equal(t, oidcEnums.ErrorOIDCEmailNotVerified, err)
+		accountRepositoryMock.AssertNotCalled(t, "GetAccountByIdentity")
+	})
+}
+
+func TestIsAuthorized(t *testing.T) {
+	token, _, _ := jwt.CreateToken(&tokenEntities.TokenData{AccountID: uuid.New()}, []string{"admin"})
+
+	t.Run("should return true when token group is workspace admin", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{
+			AuthzAdmin: []string{"admin"}}, nil)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, authRepositoryMock, &cacheRepository.Mock{})
+
+		result, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.WorkspaceAdmin})
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should return false when token group is not a repository member group", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{}, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(&authEntities.AuthzGroups{
+			AuthzMember: []string{"member"}}, nil)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, authRepositoryMock, &cacheRepository.Mock{})
+
+		result, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.RepositoryMember})
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("should return true when token group is application admin group", func(t *testing.T) {
+		_ = os.Setenv(oidcEnums.EnvOIDCAdminGroup, "admin")
+		defer os.Unsetenv(oidcEnums.EnvOIDCAdminGroup)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			&cacheRepository.Mock{})
+
+		result, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.ApplicationAdmin})
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should return error when application admin enabled without group", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			&cacheRepository.Mock{})
+		service.groups = groups.NewGroupsAuthorizer(oidcEnums.EnvOIDCAdminGroup,
+			&app.Config{EnableApplicationAdmin: true}, &authRepository.Mock{})
+
+		_, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.ApplicationAdmin})
+		assert.Equal(t, groupsEnums.ErrorApplicationAdminGroupNotSet, err)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			&cacheRepository.Mock{})
+
+		_, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: "test"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid authorization type", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			&cacheRepository.Mock{})
+
+		_, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token, Type: "test"})
+		assert.Equal(t, groupsEnums.ErrorInvalidAuthorizationType, err)
+	})
+}
+
+func TestGetAccountDataFromToken(t *testing.T) {
+	t.Run("should success get account data from token", func(t *testing.T) {
+		account := &accountEntities.Account{AccountID: uuid.New()}
+		token, _, _ := jwt.CreateToken(&tokenEntities.TokenData{AccountID: account.AccountID}, []string{"admin"})
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
+
+		service := newTestService(&client.Mock{}, accountRepositoryMock, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		result, err := service.GetAccountDataFromToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"admin"}, result.Permissions)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			&cacheRepository.Mock{})
+
+		_, err := service.GetAccountDataFromToken("test")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,285 @@
+package client
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+	"github.com/pkg/errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+)
+
+type IClient interface {
+	GetMetadata() ([]byte, error)
+	MakeAuthenticationRequest(relayState string) (loginURL, requestID string, err error)
+	ParseResponse(samlResponse string, possibleRequestIDs []string) (*saml.Assertion, error)
+}
+
+// Client is a saml 2.0 service provider, it is built on the first use since the identity provider metadata could
+// be fetched from a remote url that is not available during the startup
+type Client struct {
+	mutex           sync.Mutex
+	httpClient      *http.Client
+	authURL         string
+	serviceProvider *saml.ServiceProvider
+}
+
+func NewSAMLClient(authURL string) IClient {
+	return &Client{
+		httpClient: &http.Client{Timeout: samlEnums.HTTPClientTimeout},
+		authURL:    authURL,
+	}
+}
+
+func (c *Client) GetMetadata() ([]byte, error) {
+	serviceProvider, err := c.getServiceProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	return xml.MarshalIndent(serviceProvider.Metadata(), "", "  ")
+}
+
+func (c *Client) MakeAuthenticationRequest(relayState string) (loginURL, requestID string, err error) {
+	serviceProvider, err := c.getServiceProvider()
+	if err != nil {
+		return "", "", err
+	}
+
+	request, err := serviceProvider.MakeAuthenticationRequest(
+		serviceProvider.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding,
+		saml.HTTPPostBinding)
+	if err != nil {
+		return "", "", err
+	}
+
+	return c.getRedirectURL(serviceProvider, request, relayState)
+}
+
+func (c *Client) getRedirectURL(serviceProvider *saml.ServiceProvider, request *saml.AuthnRequest,
+	relayState string) (loginURL, requestID string, err error) {
+	redirectURL, err := request.Redirect(relayState, serviceProvider)
+	if err != nil {
+		return "", "", err
+	}
+
+	return redirectURL.String(), request.ID, nil
+}
+
+// ParseResponse validates the response signature, issuer, destination, conditions and audience, returning the
+// signed assertion only when all of them are valid and it is the only assertion of the response
+func (c *Client) ParseResponse(samlResponse string, possibleRequestIDs []string) (*saml.Assertion, error) {
+	serviceProvider, err := c.getServiceProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	decodedResponse, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, samlEnums.ErrorSAMLInvalidAssertion
+	}
+
+	if err := c.checkSingleAssertion(decodedResponse); err != nil {
+		return nil, err
+	}
+
+	return c.parseXMLResponse(serviceProvider, decodedResponse, possibleRequestIDs)
+}
+
+// checkSingleAssertion rejects the responses with more than one assertion, since the library skips the invalid ones
+// an extra assertion could be used to smuggle an unsigned identity
+func (c *Client) checkSingleAssertion(decodedResponse []byte) error {
+	document := etree.NewDocument()
+	if err := document.ReadFromBytes(decodedResponse); err != nil || document.Root() == nil {
+		return samlEnums.ErrorSAMLInvalidAssertion
+	}
+
+	assertions := document.Root().SelectElements(samlEnums.ElementAssertion)
+	encryptedAssertions := document.Root().SelectElements(samlEnums.ElementEncryptedAssertion)
+	if len(assertions)+len(encryptedAssertions) != 1 {
+		return samlEnums.ErrorSAMLInvalidAssertion
+	}
+
+	return nil
+}
+
+func (c *Client) parseXMLResponse(serviceProvider *saml.ServiceProvider, decodedResponse []byte,
+	possibleRequestIDs []string) (*saml.Assertion, error) {
+	assertion, err := serviceProvider.ParseXMLResponse(decodedResponse, possibleRequestIDs)
+	if err != nil {
+		logger.LogError(samlEnums.ErrorSAMLInvalidAssertion.Error(), c.getPrivateError(err))
+		return nil, samlEnums.ErrorSAMLInvalidAssertion
+	}
+
+	return assertion, nil
+}
+
+// getPrivateError returns the validation failure reason, that is hidden by the library in the private error field
+func (c *Client) getPrivateError(err error) error {
+	var invalidResponseErr *saml.InvalidResponseError
+	if errors.As(err, &invalidResponseErr) && invalidResponseErr.PrivateErr != nil {
+		return invalidResponseErr.PrivateErr
+	}
+
+	return err
+}
+
+func (c *Client) getServiceProvider() (*saml.ServiceProvider, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.serviceProvider != nil {
+		return c.serviceProvider, nil
+	}
+
+	serviceProvider, err := c.newServiceProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	c.serviceProvider = serviceProvider
+	return serviceProvider, nil
+}
+
+func (c *Client) newServiceProvider() (*saml.ServiceProvider, error) {
+	certificate, privateKey, err := c.getKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	idpMetadata, err := c.getIDPMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.setURLs(&saml.ServiceProvider{Key: privateKey, Certificate: certificate, IDPMetadata: idpMetadata})
+}
+
+func (c *Client) setURLs(serviceProvider *saml.ServiceProvider) (*saml.ServiceProvider, error) {
+	metadataURL, err := url.Parse(c.authURL + samlEnums.MetadataPath)
+	if err != nil {
+		return nil, err
+	}
+
+	acsURL, err := url.Parse(c.authURL + samlEnums.AssertionConsumerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceProvider.MetadataURL, serviceProvider.AcsURL = *metadataURL, *acsURL
+	serviceProvider.EntityID = env.GetEnvOrDefault(samlEnums.EnvSAMLEntityID, metadataURL.String())
+	return serviceProvider, nil
+}
+
+func (c *Client) getKeyPair() (*x509.Certificate, *rsa.PrivateKey, error) {
+	certificate, err := c.parseCertificate(env.GetEnvOrDefault(samlEnums.EnvSAMLCertificate, ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privateKey, err := c.parsePrivateKey(env.GetEnvOrDefault(samlEnums.EnvSAMLPrivateKey, ""))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return certificate, privateKey, nil
+}
+
+func (c *Client) parseCertificate(value string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != samlEnums.PEMTypeCertificate {
+		return nil, samlEnums.ErrorSAMLInvalidCertificate
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, samlEnums.ErrorSAMLInvalidCertificate.Error())
+	}
+
+	return certificate, nil
+}
+
+func (c *Client) parsePrivateKey(value string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return nil, samlEnums.ErrorSAMLInvalidPrivateKey
+	}
+
+	if block.Type == samlEnums.PEMTypeRSAPrivateKey {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	return c.parsePKCS8PrivateKey(block.Bytes)
+}
+
+func (c *Client) parsePKCS8PrivateKey(value []byte) (*rsa.PrivateKey, error) {
+	key, err := x509.ParsePKCS8PrivateKey(value)
+	if err != nil {
+		return nil, errors.Wrap(err, samlEnums.ErrorSAMLInvalidPrivateKey.Error())
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, samlEnums.ErrorSAMLInvalidPrivateKey
+	}
+
+	return privateKey, nil
+}
+
+func (c *Client) getIDPMetadata() (*saml.EntityDescriptor, error) {
+	if metadataURL := env.GetEnvOrDefault(samlEnums.EnvSAMLIDPMetadataURL, ""); metadataURL != "" {
+		return c.fetchIDPMetadata(metadataURL)
+	}
+
+	if metadata := env.GetEnvOrDefault(samlEnums.EnvSAMLIDPMetadata, ""); metadata != "" {
+		return c.parseIDPMetadata([]byte(metadata))
+	}
+
+	return nil, samlEnums.ErrorSAMLMissingIDPMetadata
+}
+
+func (c *Client) fetchIDPMetadata(metadataURL string) (*saml.EntityDescriptor, error) {
+	response, err := c.httpClient.Get(metadataURL)
+	if err != nil {
+		return nil, errors.Wrap(err, samlEnums.MessageFailedToGetIDPMetadata)
+	}
+
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(samlEnums.MessageUnexpectedStatusCode, response.StatusCode, metadataURL)
+	}
+
+	return c.readIDPMetadata(response.Body)
+}
+
+func (c *Client) readIDPMetadata(body io.Reader) (*saml.EntityDescriptor, error) {
+	metadata, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrap(err, samlEnums.MessageFailedToGetIDPMetadata)
+	}
+
+	return c.parseIDPMetadata(metadata)
+}
+
+func (c *Client) parseIDPMetadata(metadata []byte) (*saml.EntityDescriptor, error) {
+	entityDescriptor := &saml.EntityDescriptor{}
+	if err := xml.Unmarshal(metadata, entityDescriptor); err != nil {
+		return nil, errors.Wrap(err, samlEnums.MessageFailedToParseIDPMetadata)
+	}
+
+	return entityDescriptor, nil
+}
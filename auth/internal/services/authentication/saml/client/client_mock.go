@@ -0,0 +1,27 @@
+package client
+
+import (
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetMetadata() ([]byte, error) {
+	args := m.MethodCalled("GetMetadata")
+	return args.Get(0).([]byte), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) MakeAuthenticationRequest(_ string) (loginURL, requestID string, err error) {
+	args := m.MethodCalled("MakeAuthenticationRequest")
+	return args.Get(0).(string), args.Get(1).(string), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) ParseResponse(_ string, _ []string) (*saml.Assertion, error) {
+	args := m.MethodCalled("ParseResponse")
+	return args.Get(0).(*saml.Assertion), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,274 @@
+package client
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml"
+	"github.com/stretchr/testify/assert"
+
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+)
+
+const testAuthURL = "http://localhost:8006"
+
+type testServiceProviders struct {
+	metadata *saml.EntityDescriptor
+}
+
+func (s *testServiceProviders) GetServiceProvider(_ *http.Request, _ string) (*saml.EntityDescriptor, error) {
+	return s.metadata, nil
+}
+
+func newTestKeyPair(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: "horusec"},
+		NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour)}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	assert.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	assert.NoError(t, err)
+
+	return privateKey, certificate
+}
+
+func newTestIdentityProvider(t *testing.T) *saml.IdentityProvider {
+	privateKey, certificate := newTestKeyPair(t)
+	metadataURL, _ := url.Parse("http://idp/metadata")
+	ssoURL, _ := url.Parse("http://idp/sso")
+
+	return &saml.IdentityProvider{Key: privateKey, Certificate: certificate, MetadataURL: *metadataURL,
+		SSOURL: *ssoURL, ServiceProviderProvider: &testServiceProviders{}}
+}
+
+func setTestEnvs(t *testing.T, idp *saml.IdentityProvider) {
+	privateKey, certificate := newTestKeyPair(t)
+
+	_ = os.Setenv(samlEnums.EnvSAMLCertificate, string(pem.EncodeToMemory(&pem.Block{
+		Type: samlEnums.PEMTypeCertificate, Bytes: certificate.Raw})))
+	_ = os.Setenv(samlEnums.EnvSAMLPrivateKey, string(pem.EncodeToMemory(&pem.Block{
+		Type: samlEnums.PEMTypeRSAPrivateKey, Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})))
+
+	idpMetadata, _ := xml.Marshal(idp.Metadata())
+	_ = os.Setenv(samlEnums.EnvSAMLIDPMetadata, string(idpMetadata))
+}
+
+func unsetTestEnvs() {
+	_ = os.Unsetenv(samlEnums.EnvSAMLCertificate)
+	_ = os.Unsetenv(samlEnums.EnvSAMLPrivateKey)
+	_ = os.Unsetenv(samlEnums.EnvSAMLIDPMetadata)
+	_ = os.Unsetenv(samlEnums.EnvSAMLIDPMetadataURL)
+}
+
+// newTestResponse makes the identity provider answer the authentication request, returning the encoded response
+func newTestResponse(t *testing.T, idp *saml.IdentityProvider, client *Client, loginURL string) string {
+	return encodeTestResponse(newTestIdpRequest(t, idp, client, loginURL).ResponseEl)
+}
+
+func newTestIdpRequest(t *testing.T, idp *saml.IdentityProvider, client *Client,
+	loginURL string) *saml.IdpAuthnRequest {
+	serviceProvider, _ := client.getServiceProvider()
+	idp.ServiceProviderProvider = &testServiceProviders{metadata: serviceProvider.Metadata()}
+
+	request, err := saml.NewIdpAuthnRequest(idp, httptest.NewRequest(http.MethodGet, loginURL, nil))
+	assert.NoError(t, err)
+	assert.NoError(t, request.Validate())
+
+	assert.NoError(t, saml.DefaultAssertionMaker{}.MakeAssertion(request, &saml.Session{ID: "session",
+		NameID: "test", CustomAttributes: []saml.Attribute{{Name: "email",
+			Values: []saml.AttributeValue{{Value: "test@test.com"}}}}}))
+	assert.NoError(t, request.MakeAssertionEl())
+	assert.NoError(t, request.MakeResponse())
+
+	return request
+}
+
+func encodeTestResponse(responseEl *etree.Element) string {
+	document := etree.NewDocument()
+	document.SetRoot(responseEl)
+	response := &bytes.Buffer{}
+	_, _ = document.WriteTo(response)
+
+	return base64.StdEncoding.EncodeToString(response.Bytes())
+}
+
+// addUnsignedAssertion adds a plain copy of the signed assertion without its signature and with other subject
+func addUnsignedAssertion(request *saml.IdpAuthnRequest, beforeSigned bool) {
+	unsignedAssertion := *request.Assertion
+	unsignedSubject := *unsignedAssertion.Subject
+	unsignedNameID := *unsignedSubject.NameID
+
+	unsignedNameID.Value = "attacker"
+	unsignedSubject.NameID = &unsignedNameID
+	unsignedAssertion.Subject = &unsignedSubject
+	unsignedAssertion.Signature = nil
+
+	if beforeSigned {
+		request.ResponseEl.InsertChildAt(request.ResponseEl.SelectElement(samlEnums.ElementEncryptedAssertion).Index(),
+			unsignedAssertion.Element())
+		return
+	}
+
+	request.ResponseEl.AddChild(unsignedAssertion.Element())
+}
+
+func TestGetMetadata(t *testing.T) {
+	t.Run("should return service provider metadata with the assertion consumer url", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		defer unsetTestEnvs()
+
+		metadata, err := NewSAMLClient(testAuthURL).GetMetadata()
+		assert.NoError(t, err)
+		assert.Contains(t, string(metadata), testAuthURL+samlEnums.AssertionConsumerPath)
+	})
+
+	t.Run("should return error when identity provider metadata is not set", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		_ = os.Unsetenv(samlEnums.EnvSAMLIDPMetadata)
+		defer unsetTestEnvs()
+
+		_, err := NewSAMLClient(testAuthURL).GetMetadata()
+		assert.Equal(t, samlEnums.ErrorSAMLMissingIDPMetadata, err)
+	})
+
+	t.Run("should return error when invalid certificate", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		_ = os.Setenv(samlEnums.EnvSAMLCertificate, "test")
+		defer unsetTestEnvs()
+
+		_, err := NewSAMLClient(testAuthURL).GetMetadata()
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidCertificate, err)
+	})
+
+	t.Run("should return error when invalid private key", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		_ = os.Setenv(samlEnums.EnvSAMLPrivateKey, "test")
+		defer unsetTestEnvs()
+
+		_, err := NewSAMLClient(testAuthURL).GetMetadata()
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidPrivateKey, err)
+	})
+
+	t.Run("should fetch identity provider metadata from url", func(t *testing.T) {
+		idp := newTestIdentityProvider(t)
+		server := httptest.NewServer(http.HandlerFunc(idp.ServeMetadata))
+		defer server.Close()
+
+		setTestEnvs(t, idp)
+		_ = os.Unsetenv(samlEnums.EnvSAMLIDPMetadata)
+		_ = os.Setenv(samlEnums.EnvSAMLIDPMetadataURL, server.URL)
+		defer unsetTestEnvs()
+
+		_, err := NewSAMLClient(testAuthURL).GetMetadata()
+		assert.NoError(t, err)
+	})
+}
+
+func TestMakeAuthenticationRequest(t *testing.T) {
+	t.Run("should return identity provider redirect url with relay state", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		defer unsetTestEnvs()
+
+		loginURL, requestID, err := NewSAMLClient(testAuthURL).MakeAuthenticationRequest("state")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, requestID)
+
+		parsedURL, _ := url.Parse(loginURL)
+		assert.Equal(t, "/sso", parsedURL.Path)
+		assert.Equal(t, "state", parsedURL.Query().Get(samlEnums.FormKeyRelayState))
+		assert.NotEmpty(t, parsedURL.Query().Get("SAMLRequest"))
+	})
+}
+
+func TestParseResponse(t *testing.T) {
+	t.Run("should return assertion when response is signed by the identity provider", func(t *testing.T) {
+		idp := newTestIdentityProvider(t)
+		setTestEnvs(t, idp)
+		defer unsetTestEnvs()
+
+		client := NewSAMLClient(testAuthURL).(*Client)
+		loginURL, requestID, _ := client.MakeAuthenticationRequest("state")
+
+		assertion, err := client.ParseResponse(newTestResponse(t, idp, client, loginURL), []string{requestID})
+		assert.NoError(t, err)
+		assert.Equal(t, "test", assertion.Subject.NameID.Value)
+	})
+
+	t.Run("should return error when response is not for the request", func(t *testing.T) {
+		idp := newTestIdentityProvider(t)
+		setTestEnvs(t, idp)
+		defer unsetTestEnvs()
+
+		client := NewSAMLClient(testAuthURL).(*Client)
+		loginURL, _, _ := client.MakeAuthenticationRequest("state")
+
+		_, err := client.ParseResponse(newTestResponse(t, idp, client, loginURL), []string{"other"})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidAssertion, err)
+	})
+
+	t.Run("should return error when response is signed by other identity provider", func(t *testing.T) {
+		idp := newTestIdentityProvider(t)
+		setTestEnvs(t, idp)
+		defer unsetTestEnvs()
+
+		client := NewSAMLClient(testAuthURL).(*Client)
+		loginURL, requestID, _ := client.MakeAuthenticationRequest("state")
+		idp.Key, idp.Certificate = newTestKeyPair(t)
+
+		_, err := client.ParseResponse(newTestResponse(t, idp, client, loginURL), []string{requestID})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidAssertion, err)
+	})
+
+	t.Run("should return error when response has an extra unsigned assertion", func(t *testing.T) {
+		for _, beforeSigned := range []bool{false, true} {
+			idp := newTestIdentityProvider(t)
+			setTestEnvs(t, idp)
+
+			client := NewSAMLClient(testAuthURL).(*Client)
+			loginURL, requestID, _ := client.MakeAuthenticationRequest("state")
+
+			request := newTestIdpRequest(t, idp, client, loginURL)
+			addUnsignedAssertion(request, beforeSigned)
+
+			assertion, err := client.ParseResponse(encodeTestResponse(request.ResponseEl), []string{requestID})
+			assert.Equal(t, samlEnums.ErrorSAMLInvalidAssertion, err)
+			assert.Nil(t, assertion)
+			unsetTestEnvs()
+		}
+	})
+
+	t.Run("should return error when response is not xml", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		defer unsetTestEnvs()
+
+		_, err := NewSAMLClient(testAuthURL).ParseResponse(base64.StdEncoding.EncodeToString([]byte("test")),
+			[]string{})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidAssertion, err)
+	})
+
+	t.Run("should return error when response is not base64 encoded", func(t *testing.T) {
+		setTestEnvs(t, newTestIdentityProvider(t))
+		defer unsetTestEnvs()
+
+		_, err := NewSAMLClient(testAuthURL).ParseResponse("!", []string{})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidAssertion, err)
+	})
+}
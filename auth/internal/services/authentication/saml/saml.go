@@ -0,0 +1,237 @@
+package saml
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/groups"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/saml/client"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+type IService interface {
+	Login(credentials *authEntities.LoginCredentials) (*authEntities.LoginResponse, error)
+	IsAuthorized(data *authEntities.AuthorizationData) (bool, error)
+	GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error)
+	GetMetadata() ([]byte, error)
+	GetLoginURL() (*samlEntities.LoginURLResponse, error)
+	AssertionConsumer(data *samlEntities.AssertionData) (string, error)
+	ExchangeLoginCode(data *samlEntities.LoginCodeData) (*authEntities.LoginResponse, error)
+}
+
+type Service struct {
+	saml              client.IClient
+	accountRepository accountRepository.IRepository
+	groups            groups.IAuthorizer
+	appConfig         app.IConfig
+	cacheRepository   cacheRepository.IRepository
+	sessionService    sessionService.IService
+	attributesMapping *samlEntities.AttributesMapping
+	invitationService invitationService.IService
+}
+
+func NewSAMLAuthenticationService(repositoryAccount accountRepository.IRepository, appConfig app.IConfig,
+	repositoryAuth authRepository.IRepository, repositoryCache cacheRepository.IRepository,
+	serviceSession sessionService.IService, serviceInvitation invitationService.IService) IService {
+	return &Service{
+		saml:              client.NewSAMLClient(appConfig.GetHorusecAuthURL()),
+		accountRepository: repositoryAccount,
+		groups:            groups.NewGroupsAuthorizer(samlEnums.EnvSAMLAdminGroup, appConfig, repositoryAuth),
+		appConfig:         appConfig,
+		cacheRepository:   repositoryCache,
+		sessionService:    serviceSession,
+		attributesMapping: samlEntities.NewAttributesMapping(),
+		invitationService: serviceInvitation,
+	}
+}
+
+func (s *Service) Login(_ *authEntities.LoginCredentials) (*authEntities.LoginResponse, error) {
+	return nil, samlEnums.ErrorSAMLPasswordLoginNotSupported
+}
+
+func (s *Service) GetMetadata() ([]byte, error) {
+	return s.saml.GetMetadata()
+}
+
+func (s *Service) GetLoginURL() (*samlEntities.LoginURLResponse, error) {
+	request := samlEntities.NewAuthenticationRequest()
+
+	loginURL, requestID, err := s.saml.MakeAuthenticationRequest(request.RelayState)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cacheRepository.Set(s.getRelayStateCacheKey(request.RelayState),
+		request.SetRequestID(requestID).ToString(), samlEnums.AuthenticationRequestTime); err != nil {
+		return nil, err
+	}
+
+	return request.ToLoginURLResponse(loginURL), nil
+}
+
+func (s *Service) getRelayStateCacheKey(relayState string) string {
+	return fmt.Sprintf(samlEnums.CacheKeyAuthenticationState, relayState)
+}
+
+func (s *Service) getLoginCodeCacheKey(code string) string {
+	return fmt.Sprintf(samlEnums.CacheKeyLoginCode, code)
+}
+
+// AssertionConsumer validates the identity provider response and returns the manager url with a single use code,
+// since the response is posted by the browser the login data can not be returned directly to the manager
+func (s *Service) AssertionConsumer(data *samlEntities.AssertionData) (string, error) {
+	userInfo, err := s.getUserInfo(data)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := s.getAccountOrCreateIfNotExist(userInfo)
+	if err != nil {
+		return "", err
+	}
+
+	return s.newManagerLoginURL(account, userInfo.Groups, &data.Device)
+}
+
+func (s *Service) newManagerLoginURL(account *accountEntities.Account, userGroups []string,
+	device *sessionEntities.Device) (string, error) {
+	loginResponse, err := s.newLoginResponse(account, userGroups, device)
+	if err != nil {
+		return "", err
+	}
+
+	code := uuid.NewString()
+	if err := s.cacheRepository.Set(s.getLoginCodeCacheKey(code), loginResponse.ToString(),
+		samlEnums.LoginCodeDuration); err != nil {
+		return "", err
+	}
+
+	return s.appConfig.GetHorusecManagerURL() + fmt.Sprintf(samlEnums.ManagerLoginPath, code), nil
+}
+
+// ExchangeLoginCode removes the login response from the database cache, so each code can be used only once, even
+// when the code is exchanged in another replica
+func (s *Service) ExchangeLoginCode(data *samlEntities.LoginCodeData) (*authEntities.LoginResponse, error) {
+	value, err := s.cacheRepository.Pop(s.getLoginCodeCacheKey(data.Code))
+	if err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return nil, samlEnums.ErrorSAMLInvalidLoginCode
+		}
+
+		return nil, err
+	}
+
+	return authEntities.ParseLoginResponse(value)
+}
+
+// popAuthenticationRequest removes the authentication request from the database cache, so each relay state can be
+// used only once, even when the response is received by another replica
+func (s *Service) popAuthenticationRequest(relayState string) (*samlEntities.AuthenticationRequest, error) {
+	value, err := s.cacheRepository.Pop(s.getRelayStateCacheKey(relayState))
+	if err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return nil, samlEnums.ErrorSAMLInvalidRelayState
+		}
+
+		return nil, err
+	}
+
+	return samlEntities.ParseAuthenticationRequest(value)
+}
+
+func (s *Service) getUserInfo(data *samlEntities.AssertionData) (*samlEntities.UserInfo, error) {
+	request, err := s.popAuthenticationRequest(data.RelayState)
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, err := s.saml.ParseResponse(data.SAMLResponse, []string{request.RequestID})
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo := samlEntities.NewUserInfoFromAssertion(assertion, s.attributesMapping)
+	return userInfo, userInfo.Validate()
+}
+
+func (s *Service) getAccountOrCreateIfNotExist(userInfo *samlEntities.UserInfo) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.GetAccountByEmail(userInfo.Email)
+	if err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return s.createAccount(userInfo)
+		}
+
+		return nil, err
+	}
+
+	if account.IsDisabled {
+		return nil, accountEnums.ErrorAccountDisabled
+	}
+
+	return account, nil
+}
+
+// createAccount binds the pending invitations of the new account, the email is asserted by the identity provider
+func (s *Service) createAccount(userInfo *samlEntities.UserInfo) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.CreateAccount(userInfo.ToAccount())
+	if err != nil {
+		return nil, err
+	}
+
+	s.invitationService.BindPendingInvitations(account)
+	return account, nil
+}
+
+func (s *Service) newLoginResponse(account *accountEntities.Account, userGroups []string,
+	device *sessionEntities.Device) (*authEntities.LoginResponse, error) {
+	refreshToken, err := s.sessionService.CreateSession(account.AccountID, device)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, _ := jwt.CreateToken(account.ToTokenData(), userGroups)
+	return &authEntities.LoginResponse{
+		AccountID:          account.AccountID,
+		AccessToken:        accessToken,
+		RefreshToken:       refreshToken,
+		ExpiresAt:          expiresAt,
+		Username:           account.Username,
+		Email:              account.Email,
+		IsApplicationAdmin: s.groups.IsApplicationAdmin(userGroups),
+	}, nil
+}
+
+func (s *Service) IsAuthorized(data *authEntities.AuthorizationData) (bool, error) {
+	return s.groups.IsAuthorized(data)
+}
+
+func (s *Service) GetAccountDataFromToken(token string) (*proto.GetAccountDataResponse, error) {
+	claims, err := jwt.DecodeToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.accountRepository.GetAccount(parser.ParseStringToUUID(claims.Subject))
+	if err != nil {
+		return nil, err
+	}
+
+	return account.ToGetAccountDataResponse(claims.Permissions), nil
+}
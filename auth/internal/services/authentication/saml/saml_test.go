@@ -0,0 +1,442 @@
+package saml
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/crewjam/saml"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	authorization "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	tokenEntities "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/entities"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	samlEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication/saml"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	groupsEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/groups"
+	samlEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/saml"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	authRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/authentication"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/groups"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/authentication/saml/client"
+	invitationService "github.com/ZupIT/horusec-platform/auth/internal/services/invitation"
+	sessionService "github.com/ZupIT/horusec-platform/auth/internal/services/session"
+)
+
+func newSessionServiceMock() *sessionService.Mock {
+	sessionServiceMock := &sessionService.Mock{}
+	sessionServiceMock.On("CreateSession").Return("test", nil)
+
+	return sessionServiceMock
+}
+
+func newTestService(samlMock *client.Mock, accountRepositoryMock *accountRepository.Mock,
+	authRepositoryMock *authRepository.Mock, cacheRepositoryMock *cacheRepository.Mock) *Service {
+	return &Service{
+		saml:              samlMock,
+		accountRepository: accountRepositoryMock,
+		groups:            groups.NewGroupsAuthorizer(samlEnums.EnvSAMLAdminGroup, &app.Config{}, authRepositoryMock),
+		appConfig:         &app.Config{HorusecManagerURL: "http://localhost:8043"},
+		cacheRepository:   cacheRepositoryMock,
+		sessionService:    newSessionServiceMock(),
+		attributesMapping: samlEntities.NewAttributesMapping(),
+		invitationService: &invitationService.Mock{},
+	}
+}
+
+func newCacheRepositoryMockWithRelayState() *cacheRepository.Mock {
+	cacheRepositoryMock := &cacheRepository.Mock{}
+	cacheRepositoryMock.On("Set").Return(nil)
+	cacheRepositoryMock.On("Pop").Return(samlEntities.NewAuthenticationRequest().SetRequestID("request-id").ToString(),
+		nil)
+
+	return cacheRepositoryMock
+}
+
+func newTestAssertion() *saml.Assertion {
+	return &saml.Assertion{
+		Subject: &saml.Subject{NameID: &saml.NameID{Value: uuid.NewString()}},
+		AttributeStatements: []saml.AttributeStatement{{Attributes: []saml.Attribute{
+			{Name: "username", Values: []saml.AttributeValue{{Value: "test"}}},
+			{Name: "email", Values: []saml.AttributeValue{{Value: "test@test.com"}}},
+			{Name: "groups", Values: []saml.AttributeValue{{Value: "admin"}}},
+		}}},
+	}
+}
+
+func newTestSAMLMock() *client.Mock {
+	samlMock := &client.Mock{}
+	samlMock.On("MakeAuthenticationRequest").Return("http://idp/sso", "request-id", nil)
+
+	return samlMock
+}
+
+func getLoginCode(redirectURL string) string {
+	parsedURL, _ := url.Parse(redirectURL)
+	return parsedURL.Query().Get("code")
+}
+
+func TestNewSAMLAuthenticationService(t *testing.T) {
+	t.Run("should success create a new service", func(t *testing.T) {
+		assert.NotNil(t, NewSAMLAuthenticationService(nil, &app.Config{}, nil, nil, nil, nil))
+	})
+}
+
+func TestLogin(t *testing.T) {
+	t.Run("should return error when login with credentials", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		_, err := service.Login(&authEntities.LoginCredentials{})
+		assert.Equal(t, samlEnums.ErrorSAMLPasswordLoginNotSupported, err)
+	})
+}
+
+func TestGetMetadata(t *testing.T) {
+	t.Run("should return service provider metadata", func(t *testing.T) {
+		samlMock := &client.Mock{}
+		samlMock.On("GetMetadata").Return([]byte("metadata"), nil)
+
+		service := newTestService(samlMock, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		result, err := service.GetMetadata()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("metadata"), result)
+	})
+}
+
+func TestGetLoginURL(t *testing.T) {
+	t.Run("should return login url and cache the request id", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(nil)
+
+		service := newTestService(newTestSAMLMock(), &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		result, err := service.GetLoginURL()
+		assert.NoError(t, err)
+		assert.Equal(t, "http://idp/sso", result.LoginURL)
+		assert.NotEmpty(t, result.RelayState)
+		cacheRepositoryMock.AssertCalled(t, "Set")
+	})
+
+	t.Run("should return error when failed to cache the request", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(errors.New("test"))
+
+		service := newTestService(newTestSAMLMock(), &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.GetLoginURL()
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when failed to make authentication request", func(t *testing.T) {
+		samlMock := &client.Mock{}
+		samlMock.On("MakeAuthenticationRequest").Return("", "", errors.New("test"))
+
+		service := newTestService(samlMock, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		_, err := service.GetLoginURL()
+		assert.Error(t, err)
+	})
+}
+
+func TestAssertionConsumer(t *testing.T) {
+	account := &accountEntities.Account{AccountID: uuid.New(), Username: "test", Email: "test@test.com"}
+
+	t.Run("should login creating account and redirect with login code", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(newTestAssertion(), nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("CreateAccount").Return(account, nil)
+
+		invitationServiceMock := &invitationService.Mock{}
+		invitationServiceMock.On("BindPendingInvitations")
+
+		service := newTestService(samlMock, accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+		service.invitationService = invitationServiceMock
+
+		result, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.NoError(t, err)
+		assert.Contains(t, result, "http://localhost:8043/auth/saml?code=")
+		assert.NotEmpty(t, getLoginCode(result))
+		accountRepositoryMock.AssertCalled(t, "CreateAccount")
+		invitationServiceMock.AssertCalled(t, "BindPendingInvitations")
+	})
+
+	t.Run("should return error when existing account is disabled", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(newTestAssertion(), nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{IsDisabled: true}, nil)
+
+		service := newTestService(samlMock, accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.Equal(t, accountEnums.ErrorAccountDisabled, err)
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should return error when failed to create session", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(newTestAssertion(), nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+
+		sessionServiceMock := &sessionService.Mock{}
+		sessionServiceMock.On("CreateSession").Return("", errors.New("test"))
+
+		service := newTestService(samlMock, accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+		service.sessionService = sessionServiceMock
+
+		result, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.Error(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should not create account when failed to get account by email", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(newTestAssertion(), nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := newTestService(samlMock, accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test", RelayState: "state"})
+		assert.Equal(t, errors.New("test"), err)
+		accountRepositoryMock.AssertNotCalled(t, "CreateAccount")
+	})
+
+	t.Run("should return error when relay state is not in cache", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test", RelayState: "state"})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidRelayState, err)
+	})
+
+	t.Run("should return error when failed to get relay state from cache", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", errors.New("test"))
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test", RelayState: "state"})
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when failed to cache the login code", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(newTestAssertion(), nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(account, nil)
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return(samlEntities.NewAuthenticationRequest().ToString(), nil)
+		cacheRepositoryMock.On("Set").Return(errors.New("test"))
+
+		service := newTestService(samlMock, accountRepositoryMock, &authRepository.Mock{}, cacheRepositoryMock)
+
+		result, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.Equal(t, errors.New("test"), err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("should return error when invalid assertion", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(&saml.Assertion{}, samlEnums.ErrorSAMLInvalidAssertion)
+
+		service := newTestService(samlMock, &accountRepository.Mock{}, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidAssertion, err)
+	})
+
+	t.Run("should return error when assertion missing email", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(&saml.Assertion{}, nil)
+
+		service := newTestService(samlMock, &accountRepository.Mock{}, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.Equal(t, samlEnums.ErrorSAMLMissingNameIDOrEmail, err)
+	})
+
+	t.Run("should return error when failed to create account", func(t *testing.T) {
+		samlMock := newTestSAMLMock()
+		samlMock.On("ParseResponse").Return(newTestAssertion(), nil)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccountByEmail").Return(&accountEntities.Account{},
+			databaseEnums.ErrorNotFoundRecords)
+		accountRepositoryMock.On("CreateAccount").Return(account, errors.New("test"))
+
+		invitationServiceMock := &invitationService.Mock{}
+
+		service := newTestService(samlMock, accountRepositoryMock, &authRepository.Mock{},
+			newCacheRepositoryMockWithRelayState())
+		service.invitationService = invitationServiceMock
+
+		_, err := service.AssertionConsumer(&samlEntities.AssertionData{SAMLResponse: "test",
+			RelayState: "state"})
+		assert.Error(t, err)
+		invitationServiceMock.AssertNotCalled(t, "BindPendingInvitations")
+	})
+}
+
+func TestExchangeLoginCode(t *testing.T) {
+	t.Run("should return the login response saved with the code", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return((&authEntities.LoginResponse{Email: "test@test.com"}).ToString(), nil)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		result, err := service.ExchangeLoginCode(&samlEntities.LoginCodeData{Code: "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, "test@test.com", result.Email)
+	})
+
+	t.Run("should return error when code is not in cache", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.ExchangeLoginCode(&samlEntities.LoginCodeData{Code: "test"})
+		assert.Equal(t, samlEnums.ErrorSAMLInvalidLoginCode, err)
+	})
+
+	t.Run("should return error when failed to get code from cache", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", errors.New("test"))
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{},
+			cacheRepositoryMock)
+
+		_, err := service.ExchangeLoginCode(&samlEntities.LoginCodeData{Code: "test"})
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestIsAuthorized(t *testing.T) {
+	token, _, _ := jwt.CreateToken(&tokenEntities.TokenData{AccountID: uuid.New()}, []string{"admin"})
+
+	t.Run("should return true when token group is workspace admin", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{
+			AuthzAdmin: []string{"admin"}}, nil)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, authRepositoryMock, &cacheRepository.Mock{})
+
+		result, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.WorkspaceAdmin})
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should return false when token group is not a repository member group", func(t *testing.T) {
+		authRepositoryMock := &authRepository.Mock{}
+		authRepositoryMock.On("GetWorkspaceGroups").Return(&authEntities.AuthzGroups{}, nil)
+		authRepositoryMock.On("GetRepositoryGroups").Return(&authEntities.AuthzGroups{
+			AuthzMember: []string{"member"}}, nil)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, authRepositoryMock, &cacheRepository.Mock{})
+
+		result, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.RepositoryMember})
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("should return true when token group is application admin group", func(t *testing.T) {
+		_ = os.Setenv(samlEnums.EnvSAMLAdminGroup, "admin")
+		defer os.Unsetenv(samlEnums.EnvSAMLAdminGroup)
+
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		result, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.ApplicationAdmin})
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("should return error when application admin enabled without group", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+		service.groups = groups.NewGroupsAuthorizer(samlEnums.EnvSAMLAdminGroup,
+			&app.Config{EnableApplicationAdmin: true}, &authRepository.Mock{})
+
+		_, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token,
+			Type: authorization.ApplicationAdmin})
+		assert.Equal(t, groupsEnums.ErrorApplicationAdminGroupNotSet, err)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		_, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: "test"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when invalid authorization type", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		_, err := service.IsAuthorized(&authEntities.AuthorizationData{Token: token, Type: "test"})
+		assert.Equal(t, groupsEnums.ErrorInvalidAuthorizationType, err)
+	})
+}
+
+func TestGetAccountDataFromToken(t *testing.T) {
+	t.Run("should success get account data from token", func(t *testing.T) {
+		account := &accountEntities.Account{AccountID: uuid.New()}
+		token, _, _ := jwt.CreateToken(&tokenEntities.TokenData{AccountID: account.AccountID}, []string{"admin"})
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
+
+		service := newTestService(&client.Mock{}, accountRepositoryMock, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		result, err := service.GetAccountDataFromToken(token)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"admin"}, result.Permissions)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		service := newTestService(&client.Mock{}, &accountRepository.Mock{}, &authRepository.Mock{}, &cacheRepository.Mock{})
+
+		_, err := service.GetAccountDataFromToken("test")
+		assert.Error(t, err)
+	})
+}
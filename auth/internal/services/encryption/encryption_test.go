@@ -0,0 +1,65 @@
+package encryption
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	encryptionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/encryption"
+)
+
+func TestNewEncryptionService(t *testing.T) {
+	t.Run("should success create a new service with the env key", func(t *testing.T) {
+		_ = os.Setenv(encryptionEnums.EnvEncryptionKey, "test")
+		defer os.Unsetenv(encryptionEnums.EnvEncryptionKey)
+
+		assert.Equal(t, "test", NewEncryptionService().(*Service).key)
+	})
+}
+
+func TestEncrypt(t *testing.T) {
+	t.Run("should encrypt with a random nonce and decrypt to the same value", func(t *testing.T) {
+		service := &Service{key: "test"}
+
+		encrypted, err := service.Encrypt("secret")
+		assert.NoError(t, err)
+		assert.NotContains(t, encrypted, "secret")
+
+		otherEncrypted, _ := service.Encrypt("secret")
+		assert.NotEqual(t, encrypted, otherEncrypted)
+
+		decrypted, err := service.Decrypt(encrypted)
+		assert.NoError(t, err)
+		assert.Equal(t, "secret", decrypted)
+	})
+
+	t.Run("should return error when key is not set", func(t *testing.T) {
+		_, err := (&Service{}).Encrypt("secret")
+		assert.Equal(t, encryptionEnums.ErrorEncryptionKeyNotSet, err)
+	})
+}
+
+func TestDecrypt(t *testing.T) {
+	t.Run("should return error when encrypted with other key", func(t *testing.T) {
+		encrypted, _ := (&Service{key: "test"}).Encrypt("secret")
+
+		_, err := (&Service{key: "other"}).Decrypt(encrypted)
+		assert.Equal(t, encryptionEnums.ErrorInvalidEncryptedValue, err)
+	})
+
+	t.Run("should return error when value is not base64", func(t *testing.T) {
+		_, err := (&Service{key: "test"}).Decrypt("!")
+		assert.Equal(t, encryptionEnums.ErrorInvalidEncryptedValue, err)
+	})
+
+	t.Run("should return error when value is shorter than the nonce", func(t *testing.T) {
+		_, err := (&Service{key: "test"}).Decrypt("dGVzdA==")
+		assert.Equal(t, encryptionEnums.ErrorInvalidEncryptedValue, err)
+	})
+
+	t.Run("should return error when key is not set", func(t *testing.T) {
+		_, err := (&Service{}).Decrypt("dGVzdA==")
+		assert.Equal(t, encryptionEnums.ErrorEncryptionKeyNotSet, err)
+	})
+}
@@ -0,0 +1,35 @@
+package invitation
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+)
+
+type IService interface {
+	BindPendingInvitations(account *accountEntities.Account)
+}
+
+// Service asks core to bind the pending invitations sent to the account email, it must only be called after the
+// email is verified, since the invitations are matched by the email
+type Service struct {
+	broker broker.IBroker
+}
+
+func NewInvitationService(brokerLib broker.IBroker) IService {
+	return &Service{
+		broker: brokerLib,
+	}
+}
+
+// BindPendingInvitations only logs a failed publish, since the invitations are kept pending and can still be
+// accepted by their token
+func (s *Service) BindPendingInvitations(account *accountEntities.Account) {
+	if err := s.broker.Publish("", accountEnums.ExchangeEmailVerified, exchange.Fanout,
+		accountEntities.NewVerifiedEmail(account).ToBytes()); err != nil {
+		logger.LogError(accountEnums.MessageFailedToBindInvitations, err)
+	}
+}
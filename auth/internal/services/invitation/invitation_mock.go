@@ -0,0 +1,15 @@
+package invitation
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) BindPendingInvitations(_ *accountEntities.Account) {
+	_ = m.MethodCalled("BindPendingInvitations")
+}
@@ -0,0 +1,37 @@
+package invitation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+)
+
+func TestNewInvitationService(t *testing.T) {
+	t.Run("should success create a new service", func(t *testing.T) {
+		assert.NotNil(t, NewInvitationService(nil))
+	})
+}
+
+func TestBindPendingInvitations(t *testing.T) {
+	t.Run("should publish the verified email", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		NewInvitationService(brokerMock).BindPendingInvitations(&accountEntities.Account{Email: "test@test.com"})
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should not panic when failed to publish the verified email", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		assert.NotPanics(t, func() {
+			NewInvitationService(brokerMock).BindPendingInvitations(&accountEntities.Account{})
+		})
+	})
+}
@@ -0,0 +1,325 @@
+package lockout
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	lockoutEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/lockout"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	lockoutRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/lockout"
+	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
+)
+
+type IService interface {
+	CheckLogin(identifier, ipAddress string) error
+	RegisterLoginFailure(identifier, ipAddress string)
+	RegisterLoginSuccess(identifier string)
+	CheckIP(ipAddress string) error
+	RegisterIPFailure(ipAddress string)
+	SetResetPasswordCode(email, code string) error
+	CheckResetPasswordCode(email, code, ipAddress string) error
+	DeleteResetPasswordCode(email string)
+	UnlockByAdmin(accountID, actorID uuid.UUID) error
+	UnlockWithToken(token string) error
+}
+
+// Service keeps the failed attempts in the database cache, so the limits are shared by all the auth replicas
+type Service struct {
+	cacheRepository   cacheRepository.IRepository
+	lockoutRepository lockoutRepository.IRepository
+	accountRepository accountRepository.IRepository
+	accountUseCases   accountUseCases.IUseCases
+	appConfig         app.IConfig
+	broker            broker.IBroker
+}
+
+func NewLockoutService(repositoryCache cacheRepository.IRepository, repositoryLockout lockoutRepository.IRepository,
+	repositoryAccount accountRepository.IRepository, useCasesAccount accountUseCases.IUseCases,
+	appConfig app.IConfig, brokerLib broker.IBroker) IService {
+	return &Service{
+		cacheRepository:   repositoryCache,
+		lockoutRepository: repositoryLockout,
+		accountRepository: repositoryAccount,
+		accountUseCases:   useCasesAccount,
+		appConfig:         appConfig,
+		broker:            brokerLib,
+	}
+}
+
+// CheckLogin rejects the login while the address or the account is locked or still inside the progressive delay
+func (s *Service) CheckLogin(identifier, ipAddress string) error {
+	if err := s.CheckIP(ipAddress); err != nil {
+		return err
+	}
+
+	identifier = lockoutEntities.NormalizeIdentifier(identifier)
+	if s.exists(fmt.Sprintf(lockoutEnums.CacheKeyAccountLocked, identifier)) {
+		return lockoutEnums.ErrorAccountLocked
+	}
+
+	if s.exists(fmt.Sprintf(lockoutEnums.CacheKeyAccountDelay, identifier)) {
+		return lockoutEnums.ErrorRetryDelay
+	}
+
+	return nil
+}
+
+func (s *Service) CheckIP(ipAddress string) error {
+	if ipAddress != "" && s.exists(fmt.Sprintf(lockoutEnums.CacheKeyIPLocked, ipAddress)) {
+		return lockoutEnums.ErrorIPLocked
+	}
+
+	return nil
+}
+
+func (s *Service) exists(key string) bool {
+	_, err := s.cacheRepository.Get(key)
+
+	return err == nil
+}
+
+// RegisterLoginFailure counts the failure for the address and the account, delaying the next attempts and
+// locking the account when the limit is reached, the unknown identifiers are also counted
+func (s *Service) RegisterLoginFailure(identifier, ipAddress string) {
+	s.RegisterIPFailure(ipAddress)
+
+	normalized := lockoutEntities.NormalizeIdentifier(identifier)
+
+	failures, err := s.incrementFailures(fmt.Sprintf(lockoutEnums.CacheKeyAccountFailures, normalized))
+	if err != nil {
+		return
+	}
+
+	if failures >= lockoutEnums.MaxAccountFailedAttempts {
+		s.lockAccount(identifier, ipAddress)
+		return
+	}
+
+	s.setRetryDelay(normalized, lockoutEntities.GetRetryDelay(failures))
+}
+
+func (s *Service) incrementFailures(key string) (int, error) {
+	failures, err := s.cacheRepository.Increment(key, lockoutEnums.FailedAttemptsWindow)
+	if err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToRegisterFailure, err)
+	}
+
+	return failures, err
+}
+
+func (s *Service) setRetryDelay(identifier string, delay time.Duration) {
+	if delay <= 0 {
+		return
+	}
+
+	if err := s.cacheRepository.Set(fmt.Sprintf(lockoutEnums.CacheKeyAccountDelay, identifier), "", delay); err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToRegisterFailure, err)
+	}
+}
+
+func (s *Service) setLock(key, value string) bool {
+	if err := s.cacheRepository.Set(key, value, lockoutEnums.LockoutDuration); err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToRegisterFailure, err)
+		return false
+	}
+
+	return true
+}
+
+func (s *Service) lockAccount(identifier, ipAddress string) {
+	normalized := lockoutEntities.NormalizeIdentifier(identifier)
+	if !s.setLock(fmt.Sprintf(lockoutEnums.CacheKeyAccountLocked, normalized), ipAddress) {
+		return
+	}
+
+	s.clearFailures(normalized)
+	event := lockoutEntities.NewLockedEvent(lockoutEnums.TargetAccount, normalized, ipAddress)
+
+	if account, err := s.getAccountByIdentifier(identifier); err == nil {
+		event.SetAccountID(account.AccountID)
+		s.sendUnlockEmail(account)
+	}
+
+	s.saveEvent(event)
+}
+
+func (s *Service) getAccountByIdentifier(identifier string) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.GetAccountByEmail(identifier)
+	if err != nil {
+		return s.accountRepository.GetAccountByUsername(identifier)
+	}
+
+	return account, nil
+}
+
+func (s *Service) sendUnlockEmail(account *accountEntities.Account) {
+	if s.appConfig.IsEmailsDisabled() {
+		return
+	}
+
+	token := uuid.NewString()
+	if err := s.cacheRepository.Set(fmt.Sprintf(lockoutEnums.CacheKeyUnlockToken, token),
+		account.AccountID.String(), lockoutEnums.LockoutDuration); err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToSendUnlockEmail, err)
+		return
+	}
+
+	if err := s.broker.Publish(queues.HorusecEmail.ToString(), "", "",
+		s.accountUseCases.NewAccountUnlockEmail(account, token)); err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToSendUnlockEmail, err)
+	}
+}
+
+func (s *Service) saveEvent(event *lockoutEntities.Event) {
+	if err := s.lockoutRepository.CreateEvent(event); err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToSaveEvent, err)
+	}
+}
+
+// RegisterIPFailure counts a failure of any flow for the address, locking it when the limit is reached
+func (s *Service) RegisterIPFailure(ipAddress string) {
+	if ipAddress == "" {
+		return
+	}
+
+	failures, err := s.incrementFailures(fmt.Sprintf(lockoutEnums.CacheKeyIPFailures, ipAddress))
+	if err != nil || failures < lockoutEnums.MaxIPFailedAttempts {
+		return
+	}
+
+	if !s.setLock(fmt.Sprintf(lockoutEnums.CacheKeyIPLocked, ipAddress), "") {
+		return
+	}
+
+	s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyIPFailures, ipAddress))
+	s.saveEvent(lockoutEntities.NewLockedEvent(lockoutEnums.TargetIP, "", ipAddress))
+}
+
+func (s *Service) RegisterLoginSuccess(identifier string) {
+	s.clearFailures(lockoutEntities.NormalizeIdentifier(identifier))
+}
+
+func (s *Service) clearFailures(identifier string) {
+	s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyAccountFailures, identifier))
+	s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyAccountDelay, identifier))
+}
+
+func (s *Service) deleteOrLog(key string) {
+	if err := s.cacheRepository.Delete(key); err != nil {
+		logger.LogError(lockoutEnums.MessageFailedToClearFailures, err)
+	}
+}
+
+// SetResetPasswordCode replaces the previous code of the email, restarting its attempts
+func (s *Service) SetResetPasswordCode(email, code string) error {
+	email = lockoutEntities.NormalizeIdentifier(email)
+	if err := s.cacheRepository.Delete(fmt.Sprintf(lockoutEnums.CacheKeyResetCodeAttempts, email)); err != nil {
+		return err
+	}
+
+	return s.cacheRepository.Set(fmt.Sprintf(lockoutEnums.CacheKeyResetCode, email), code,
+		accountEnums.ResetPasswordCodeDuration)
+}
+
+// CheckResetPasswordCode counts the attempt before comparing, so concurrent guesses are also limited, the code is
+// discarded when its attempts are exceeded and a new one must be requested
+func (s *Service) CheckResetPasswordCode(email, code, ipAddress string) error {
+	if err := s.CheckIP(ipAddress); err != nil {
+		return err
+	}
+
+	email = lockoutEntities.NormalizeIdentifier(email)
+	if err := s.addResetCodeAttempt(email); err != nil {
+		return err
+	}
+
+	if !s.isCorrectResetCode(email, code) {
+		s.RegisterIPFailure(ipAddress)
+		return accountEnums.ErrorIncorrectRetrievePasswordCode
+	}
+
+	return nil
+}
+
+func (s *Service) isCorrectResetCode(email, code string) bool {
+	correctCode, err := s.cacheRepository.Get(fmt.Sprintf(lockoutEnums.CacheKeyResetCode, email))
+
+	return err == nil && subtle.ConstantTimeCompare([]byte(code), []byte(correctCode)) == 1
+}
+
+func (s *Service) addResetCodeAttempt(email string) error {
+	attempts, err := s.cacheRepository.Increment(fmt.Sprintf(lockoutEnums.CacheKeyResetCodeAttempts, email),
+		accountEnums.ResetPasswordCodeDuration)
+	if err != nil {
+		return err
+	}
+
+	if attempts > lockoutEnums.MaxResetCodeAttempts {
+		s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyResetCode, email))
+		return lockoutEnums.ErrorResetCodeAttemptsExceeded
+	}
+
+	return nil
+}
+
+func (s *Service) DeleteResetPasswordCode(email string) {
+	email = lockoutEntities.NormalizeIdentifier(email)
+
+	s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyResetCode, email))
+	s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyResetCodeAttempts, email))
+}
+
+func (s *Service) UnlockByAdmin(accountID, actorID uuid.UUID) error {
+	actor, err := s.accountRepository.GetAccount(actorID)
+	if err != nil {
+		return err
+	}
+
+	if !actor.IsApplicationAdmin {
+		return lockoutEnums.ErrorUnlockNotAllowed
+	}
+
+	return s.unlock(accountID, lockoutEnums.MethodAdmin, &actorID)
+}
+
+// UnlockWithToken unlocks the account of the token sent by email, the token can only be used once
+func (s *Service) UnlockWithToken(token string) error {
+	key := fmt.Sprintf(lockoutEnums.CacheKeyUnlockToken, token)
+
+	accountID, err := s.cacheRepository.Get(key)
+	if err != nil {
+		return lockoutEnums.ErrorInvalidUnlockToken
+	}
+
+	s.deleteOrLog(key)
+	return s.unlock(parser.ParseStringToUUID(accountID), lockoutEnums.MethodEmail, nil)
+}
+
+func (s *Service) unlock(accountID uuid.UUID, method lockoutEnums.Method, actorID *uuid.UUID) error {
+	account, err := s.accountRepository.GetAccount(accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, identifier := range []string{account.Email, account.Username} {
+		identifier = lockoutEntities.NormalizeIdentifier(identifier)
+
+		s.deleteOrLog(fmt.Sprintf(lockoutEnums.CacheKeyAccountLocked, identifier))
+		s.clearFailures(identifier)
+	}
+
+	return s.lockoutRepository.CreateEvent(lockoutEntities.NewUnlockedEvent(accountID, method, actorID))
+}
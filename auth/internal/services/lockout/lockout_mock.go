@@ -0,0 +1,58 @@
+package lockout
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CheckLogin(_, _ string) error {
+	args := m.MethodCalled("CheckLogin")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RegisterLoginFailure(_, _ string) {
+	_ = m.MethodCalled("RegisterLoginFailure")
+}
+
+func (m *Mock) RegisterLoginSuccess(_ string) {
+	_ = m.MethodCalled("RegisterLoginSuccess")
+}
+
+func (m *Mock) CheckIP(_ string) error {
+	args := m.MethodCalled("CheckIP")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RegisterIPFailure(_ string) {
+	_ = m.MethodCalled("RegisterIPFailure")
+}
+
+func (m *Mock) SetResetPasswordCode(_, _ string) error {
+	args := m.MethodCalled("SetResetPasswordCode")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) CheckResetPasswordCode(_, _, _ string) error {
+	args := m.MethodCalled("CheckResetPasswordCode")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) DeleteResetPasswordCode(_ string) {
+	_ = m.MethodCalled("DeleteResetPasswordCode")
+}
+
+func (m *Mock) UnlockByAdmin(_, _ uuid.UUID) error {
+	args := m.MethodCalled("UnlockByAdmin")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) UnlockWithToken(_ string) error {
+	args := m.MethodCalled("UnlockWithToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,275 @@
+package lockout
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	lockoutRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/lockout"
+	accountUseCases "github.com/ZupIT/horusec-platform/auth/internal/usecases/account"
+)
+
+type testMocks struct {
+	cache   *cacheRepository.Mock
+	lockout *lockoutRepository.Mock
+	account *accountRepository.Mock
+	broker  *broker.Mock
+}
+
+func newTestMocks() *testMocks {
+	mocks := &testMocks{
+		cache:   &cacheRepository.Mock{},
+		lockout: &lockoutRepository.Mock{},
+		account: &accountRepository.Mock{},
+		broker:  &broker.Mock{},
+	}
+
+	mocks.cache.On("Set").Return(nil)
+	mocks.cache.On("Delete").Return(nil)
+	mocks.lockout.On("CreateEvent").Return(nil)
+	mocks.broker.On("Publish").Return(nil)
+
+	return mocks
+}
+
+func (m *testMocks) newService() IService {
+	appConfig := &app.Config{}
+
+	return NewLockoutService(m.cache, m.lockout, m.account, accountUseCases.NewAccountUseCases(appConfig),
+		appConfig, m.broker)
+}
+
+func TestNewLockoutService(t *testing.T) {
+	t.Run("should success create a new lockout service", func(t *testing.T) {
+		assert.NotNil(t, NewLockoutService(nil, nil, nil, nil, nil, nil))
+	})
+}
+
+func TestCheckLogin(t *testing.T) {
+	t.Run("should success when nothing is locked", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", enums.ErrorNotFoundRecords)
+
+		assert.NoError(t, mocks.newService().CheckLogin("test@test.com", "127.0.0.1"))
+	})
+
+	t.Run("should return error when ip is locked", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", nil)
+
+		assert.Equal(t, lockoutEnums.ErrorIPLocked, mocks.newService().CheckLogin("test@test.com", "127.0.0.1"))
+	})
+
+	t.Run("should return error when account is locked", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", nil)
+
+		assert.Equal(t, lockoutEnums.ErrorAccountLocked, mocks.newService().CheckLogin("test@test.com", ""))
+	})
+
+	t.Run("should return error when inside retry delay", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", enums.ErrorNotFoundRecords).Once()
+		mocks.cache.On("Get").Return("", nil)
+
+		assert.Equal(t, lockoutEnums.ErrorRetryDelay, mocks.newService().CheckLogin("test@test.com", ""))
+	})
+}
+
+func TestRegisterLoginFailure(t *testing.T) {
+	t.Run("should set retry delay after the free attempts", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(lockoutEnums.FreeFailedAttempts+1, nil)
+
+		mocks.newService().RegisterLoginFailure("test@test.com", "127.0.0.1")
+		mocks.cache.AssertCalled(t, "Set")
+		mocks.lockout.AssertNotCalled(t, "CreateEvent")
+	})
+
+	t.Run("should lock account, send unlock email and save event when reaching the limit", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(lockoutEnums.MaxAccountFailedAttempts, nil)
+		mocks.account.On("GetAccountByEmail").Return(&accountEntities.Account{AccountID: uuid.New()}, nil)
+
+		mocks.newService().RegisterLoginFailure("test@test.com", "")
+		mocks.broker.AssertCalled(t, "Publish")
+		mocks.lockout.AssertCalled(t, "CreateEvent")
+	})
+
+	t.Run("should lock unknown account without sending email", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(lockoutEnums.MaxAccountFailedAttempts, nil)
+		mocks.account.On("GetAccountByEmail").Return(&accountEntities.Account{}, enums.ErrorNotFoundRecords)
+		mocks.account.On("GetAccountByUsername").Return(&accountEntities.Account{}, enums.ErrorNotFoundRecords)
+
+		mocks.newService().RegisterLoginFailure("test", "")
+		mocks.broker.AssertNotCalled(t, "Publish")
+		mocks.lockout.AssertCalled(t, "CreateEvent")
+	})
+
+	t.Run("should do nothing else when failed to increment failures", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(0, errors.New("test"))
+
+		mocks.newService().RegisterLoginFailure("test@test.com", "127.0.0.1")
+		mocks.cache.AssertNotCalled(t, "Set")
+	})
+}
+
+func TestRegisterIPFailure(t *testing.T) {
+	t.Run("should lock ip and save event when reaching the limit", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(lockoutEnums.MaxIPFailedAttempts, nil)
+
+		mocks.newService().RegisterIPFailure("127.0.0.1")
+		mocks.cache.AssertCalled(t, "Set")
+		mocks.lockout.AssertCalled(t, "CreateEvent")
+	})
+
+	t.Run("should ignore empty ip address", func(t *testing.T) {
+		mocks := newTestMocks()
+
+		mocks.newService().RegisterIPFailure("")
+		mocks.cache.AssertNotCalled(t, "Increment")
+	})
+}
+
+func TestRegisterLoginSuccess(t *testing.T) {
+	t.Run("should clear failures and retry delay", func(t *testing.T) {
+		mocks := newTestMocks()
+
+		mocks.newService().RegisterLoginSuccess("test@test.com")
+		mocks.cache.AssertNumberOfCalls(t, "Delete", 2)
+	})
+}
+
+func TestSetResetPasswordCode(t *testing.T) {
+	t.Run("should success set reset password code", func(t *testing.T) {
+		mocks := newTestMocks()
+
+		assert.NoError(t, mocks.newService().SetResetPasswordCode("test@test.com", "123456"))
+	})
+
+	t.Run("should return error when failed to restart attempts", func(t *testing.T) {
+		mocks := &testMocks{cache: &cacheRepository.Mock{}}
+		mocks.cache.On("Delete").Return(errors.New("test"))
+
+		assert.Error(t, mocks.newService().SetResetPasswordCode("test@test.com", "123456"))
+	})
+}
+
+func TestCheckResetPasswordCode(t *testing.T) {
+	t.Run("should success check correct code", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", enums.ErrorNotFoundRecords).Once()
+		mocks.cache.On("Get").Return("123456", nil)
+		mocks.cache.On("Increment").Return(1, nil)
+
+		assert.NoError(t, mocks.newService().CheckResetPasswordCode("test@test.com", "123456", "127.0.0.1"))
+	})
+
+	t.Run("should return error and count ip failure when code is incorrect", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", enums.ErrorNotFoundRecords).Once()
+		mocks.cache.On("Get").Return("123456", nil)
+		mocks.cache.On("Increment").Return(1, nil)
+
+		assert.Equal(t, accountEnums.ErrorIncorrectRetrievePasswordCode,
+			mocks.newService().CheckResetPasswordCode("test@test.com", "654321", "127.0.0.1"))
+		mocks.cache.AssertNumberOfCalls(t, "Increment", 2)
+	})
+
+	t.Run("should discard code when attempts are exceeded", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(lockoutEnums.MaxResetCodeAttempts+1, nil)
+
+		assert.Equal(t, lockoutEnums.ErrorResetCodeAttemptsExceeded,
+			mocks.newService().CheckResetPasswordCode("test@test.com", "123456", ""))
+		mocks.cache.AssertCalled(t, "Delete")
+	})
+
+	t.Run("should return error when ip is locked", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", nil)
+
+		assert.Equal(t, lockoutEnums.ErrorIPLocked,
+			mocks.newService().CheckResetPasswordCode("test@test.com", "123456", "127.0.0.1"))
+	})
+
+	t.Run("should return error when failed to count attempt", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Increment").Return(0, errors.New("test"))
+
+		assert.Error(t, mocks.newService().CheckResetPasswordCode("test@test.com", "123456", ""))
+	})
+}
+
+func TestDeleteResetPasswordCode(t *testing.T) {
+	t.Run("should delete code and attempts", func(t *testing.T) {
+		mocks := newTestMocks()
+
+		mocks.newService().DeleteResetPasswordCode("test@test.com")
+		mocks.cache.AssertNumberOfCalls(t, "Delete", 2)
+	})
+}
+
+func TestUnlockByAdmin(t *testing.T) {
+	t.Run("should success unlock account when actor is application admin", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.account.On("GetAccount").Return(&accountEntities.Account{IsApplicationAdmin: true}, nil)
+
+		assert.NoError(t, mocks.newService().UnlockByAdmin(uuid.New(), uuid.New()))
+		mocks.lockout.AssertCalled(t, "CreateEvent")
+	})
+
+	t.Run("should return error when actor is not application admin", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.account.On("GetAccount").Return(&accountEntities.Account{}, nil)
+
+		assert.Equal(t, lockoutEnums.ErrorUnlockNotAllowed, mocks.newService().UnlockByAdmin(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return error when failed to get actor", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.account.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		assert.Error(t, mocks.newService().UnlockByAdmin(uuid.New(), uuid.New()))
+	})
+}
+
+func TestUnlockWithToken(t *testing.T) {
+	t.Run("should success unlock account with token", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return(uuid.NewString(), nil)
+		mocks.account.On("GetAccount").Return(&accountEntities.Account{Email: "test@test.com"}, nil)
+
+		assert.NoError(t, mocks.newService().UnlockWithToken("test"))
+		mocks.lockout.AssertCalled(t, "CreateEvent")
+	})
+
+	t.Run("should return error when token is invalid", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return("", enums.ErrorNotFoundRecords)
+
+		assert.Equal(t, lockoutEnums.ErrorInvalidUnlockToken, mocks.newService().UnlockWithToken("test"))
+	})
+
+	t.Run("should return error when failed to get account", func(t *testing.T) {
+		mocks := newTestMocks()
+		mocks.cache.On("Get").Return(uuid.NewString(), nil)
+		mocks.account.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		assert.Error(t, mocks.newService().UnlockWithToken("test"))
+	})
+}
@@ -0,0 +1,358 @@
+package mfa
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	mfaRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/mfa"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/encryption"
+)
+
+type IService interface {
+	IsChallengeRequired(account *accountEntities.Account) (bool, error)
+	NewChallenge(account *accountEntities.Account) (*authEntities.LoginResponse, error)
+	EnrollChallenge(data *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error)
+	VerifyChallenge(data *mfaEntities.ChallengeData) (*accountEntities.Account, []string, error)
+	Enroll(accountID uuid.UUID) (*mfaEntities.EnrollmentResponse, error)
+	Enable(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error)
+	Disable(data *mfaEntities.CodeData) error
+	RegenerateRecoveryCodes(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error)
+}
+
+type Service struct {
+	mfaRepository     mfaRepository.IRepository
+	accountRepository accountRepository.IRepository
+	appConfig         app.IConfig
+	cacheRepository   cacheRepository.IRepository
+	encryption        encryption.IService
+}
+
+func NewMFAService(repositoryMFA mfaRepository.IRepository, repositoryAccount accountRepository.IRepository,
+	appConfig app.IConfig, repositoryCache cacheRepository.IRepository,
+	serviceEncryption encryption.IService) IService {
+	return &Service{
+		mfaRepository:     repositoryMFA,
+		accountRepository: repositoryAccount,
+		appConfig:         appConfig,
+		cacheRepository:   repositoryCache,
+		encryption:        serviceEncryption,
+	}
+}
+
+// IsChallengeRequired checks if the login needs a second step, because the account enabled mfa or because the
+// application or one of the account workspaces requires it, in this last case the account will need to enroll
+func (s *Service) IsChallengeRequired(account *accountEntities.Account) (bool, error) {
+	if account.IsMFAEnabled || s.appConfig.IsMFARequired() {
+		return true, nil
+	}
+
+	return s.mfaRepository.IsRequiredByWorkspace(account.AccountID)
+}
+
+func (s *Service) NewChallenge(account *accountEntities.Account) (*authEntities.LoginResponse, error) {
+	challenge := mfaEntities.NewChallenge(account.AccountID, !account.IsMFAEnabled)
+
+	if err := s.cacheRepository.Set(s.getChallengeCacheKey(challenge.MFAToken), challenge.ToString(),
+		mfaEnums.ChallengeDuration); err != nil {
+		return nil, err
+	}
+
+	return account.ToMFALoginResponse(challenge.MFAToken, challenge.IsEnrollment), nil
+}
+
+func (s *Service) EnrollChallenge(data *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error) {
+	challenge, err := s.getChallenge(data.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if !challenge.IsEnrollment {
+		return nil, mfaEnums.ErrorMFAEnrollmentNotRequired
+	}
+
+	return s.Enroll(challenge.AccountID)
+}
+
+// VerifyChallenge checks the code of a login challenge, when it is an enrollment the mfa is enabled and the
+// recovery codes are returned, they are only shown this time
+func (s *Service) VerifyChallenge(data *mfaEntities.ChallengeData) (*accountEntities.Account, []string, error) {
+	challenge, err := s.getChallenge(data.MFAToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, recoveryCodes, err := s.verifyChallengeCode(challenge, data.Code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, recoveryCodes, s.removeChallenge(challenge.MFAToken)
+}
+
+// removeChallenge pops the challenge from the database cache, when it was already removed by a concurrent
+// verification the login is rejected, so each challenge results in a single session even across replicas
+func (s *Service) removeChallenge(mfaToken string) error {
+	if _, err := s.cacheRepository.Pop(s.getChallengeCacheKey(mfaToken)); err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return mfaEnums.ErrorMFAInvalidChallenge
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) verifyChallengeCode(challenge *mfaEntities.Challenge,
+	code string) (*accountEntities.Account, []string, error) {
+	account, err := s.accountRepository.GetAccount(challenge.AccountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if challenge.IsEnrollment {
+		recoveryCodes, err := s.enable(account, code)
+		return account, recoveryCodes, err
+	}
+
+	return account, nil, s.verifyCode(account, code)
+}
+
+func (s *Service) getChallenge(mfaToken string) (*mfaEntities.Challenge, error) {
+	value, err := s.cacheRepository.Get(s.getChallengeCacheKey(mfaToken))
+	if err != nil {
+		if err == databaseEnums.ErrorNotFoundRecords {
+			return nil, mfaEnums.ErrorMFAInvalidChallenge
+		}
+
+		return nil, err
+	}
+
+	if err := s.addChallengeAttempt(mfaToken); err != nil {
+		return nil, err
+	}
+
+	return mfaEntities.ParseChallenge(value)
+}
+
+// addChallengeAttempt increments the attempts in the database cache, the increment is atomic so concurrent
+// requests to any replica can not exceed the max attempts
+func (s *Service) addChallengeAttempt(mfaToken string) error {
+	attempts, err := s.cacheRepository.Increment(s.getChallengeAttemptsCacheKey(mfaToken), mfaEnums.ChallengeDuration)
+	if err != nil {
+		return err
+	}
+
+	if attempts > mfaEnums.MaxChallengeAttempts {
+		return s.removeTooManyAttemptsChallenge(mfaToken)
+	}
+
+	return nil
+}
+
+func (s *Service) removeTooManyAttemptsChallenge(mfaToken string) error {
+	if err := s.cacheRepository.Delete(s.getChallengeCacheKey(mfaToken)); err != nil {
+		return err
+	}
+
+	return mfaEnums.ErrorMFATooManyAttempts
+}
+
+func (s *Service) getChallengeCacheKey(mfaToken string) string {
+	return fmt.Sprintf(mfaEnums.CacheKeyChallenge, mfaToken)
+}
+
+func (s *Service) getChallengeAttemptsCacheKey(mfaToken string) string {
+	return fmt.Sprintf(mfaEnums.CacheKeyChallengeAttempts, mfaToken)
+}
+
+func (s *Service) Enroll(accountID uuid.UUID) (*mfaEntities.EnrollmentResponse, error) {
+	account, err := s.accountRepository.GetAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsMFAEnabled {
+		return nil, mfaEnums.ErrorMFAAlreadyEnabled
+	}
+
+	return s.newEnrollment(account)
+}
+
+func (s *Service) newEnrollment(account *accountEntities.Account) (*mfaEntities.EnrollmentResponse, error) {
+	totp := mfaEntities.NewTOTP()
+
+	encryptedSecret, err := s.encryption.Encrypt(totp.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.mfaRepository.SetSecret(account.AccountID, encryptedSecret); err != nil {
+		return nil, err
+	}
+
+	return &mfaEntities.EnrollmentResponse{
+		Secret:          totp.Secret,
+		ProvisioningURI: totp.GetProvisioningURI(account.Email),
+	}, nil
+}
+
+func (s *Service) Enable(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	account, err := s.accountRepository.GetAccount(data.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.enable(account, data.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mfaEntities.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *Service) enable(account *accountEntities.Account, code string) ([]string, error) {
+	if account.IsMFAEnabled {
+		return nil, mfaEnums.ErrorMFAAlreadyEnabled
+	}
+
+	if account.MFASecret == "" {
+		return nil, mfaEnums.ErrorMFANotEnrolled
+	}
+
+	if err := s.validateTOTP(account, code); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, codes := mfaEntities.NewRecoveryCodes(account.AccountID)
+	return codes, s.mfaRepository.Enable(account.AccountID, recoveryCodes)
+}
+
+func (s *Service) Disable(data *mfaEntities.CodeData) error {
+	account, err := s.getAccountWithMFAEnabled(data.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.checkIfRequiredByPolicy(account); err != nil {
+		return err
+	}
+
+	if err := s.verifyCode(account, data.Code); err != nil {
+		return err
+	}
+
+	return s.mfaRepository.Disable(account.AccountID)
+}
+
+func (s *Service) checkIfRequiredByPolicy(account *accountEntities.Account) error {
+	if s.appConfig.IsMFARequired() {
+		return mfaEnums.ErrorMFARequiredByPolicy
+	}
+
+	isRequired, err := s.mfaRepository.IsRequiredByWorkspace(account.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if isRequired {
+		return mfaEnums.ErrorMFARequiredByPolicy
+	}
+
+	return nil
+}
+
+func (s *Service) RegenerateRecoveryCodes(data *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	account, err := s.getAccountWithMFAEnabled(data.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateTOTP(account, data.Code); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, codes := mfaEntities.NewRecoveryCodes(account.AccountID)
+	if err := s.mfaRepository.ReplaceRecoveryCodes(account.AccountID, recoveryCodes); err != nil {
+		return nil, err
+	}
+
+	return &mfaEntities.RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+func (s *Service) getAccountWithMFAEnabled(accountID uuid.UUID) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.GetAccount(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !account.IsMFAEnabled {
+		return nil, mfaEnums.ErrorMFANotEnabled
+	}
+
+	return account, nil
+}
+
+func (s *Service) verifyCode(account *accountEntities.Account, code string) error {
+	if mfaEntities.IsTOTPCode(code) {
+		return s.validateTOTP(account, code)
+	}
+
+	return s.useRecoveryCode(account.AccountID, code)
+}
+
+// validateTOTP rejects a code already used in its validity window, avoiding the replay of an intercepted code, the
+// used code is incremented in the database cache so only the first request of any replica accepts it
+func (s *Service) validateTOTP(account *accountEntities.Account, code string) error {
+	secret, err := s.encryption.Decrypt(account.MFASecret)
+	if err != nil {
+		return err
+	}
+
+	step, ok := (&mfaEntities.TOTP{Secret: secret}).Validate(code, time.Now())
+	if !ok {
+		return mfaEnums.ErrorMFAInvalidCode
+	}
+
+	return s.setCodeUsed(account.AccountID, step)
+}
+
+func (s *Service) setCodeUsed(accountID uuid.UUID, step int64) error {
+	uses, err := s.cacheRepository.Increment(fmt.Sprintf(mfaEnums.CacheKeyUsedCode, accountID, step),
+		mfaEnums.UsedCodeDuration)
+	if err != nil {
+		return err
+	}
+
+	if uses > 1 {
+		return mfaEnums.ErrorMFAInvalidCode
+	}
+
+	return nil
+}
+
+func (s *Service) useRecoveryCode(accountID uuid.UUID, code string) error {
+	recoveryCodes, err := s.mfaRepository.ListUnusedRecoveryCodes(accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, recoveryCode := range recoveryCodes {
+		if recoveryCode.Matches(code) {
+			return s.mfaRepository.SetRecoveryCodeUsed(recoveryCode)
+		}
+	}
+
+	return mfaEnums.ErrorMFAInvalidCode
+}
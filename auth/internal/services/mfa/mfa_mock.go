@@ -0,0 +1,56 @@
+package mfa
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) IsChallengeRequired(_ *accountEntities.Account) (bool, error) {
+	args := m.MethodCalled("IsChallengeRequired")
+	return args.Get(0).(bool), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) NewChallenge(_ *accountEntities.Account) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("NewChallenge")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) EnrollChallenge(_ *mfaEntities.ChallengeData) (*mfaEntities.EnrollmentResponse, error) {
+	args := m.MethodCalled("EnrollChallenge")
+	return args.Get(0).(*mfaEntities.EnrollmentResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) VerifyChallenge(_ *mfaEntities.ChallengeData) (*accountEntities.Account, []string, error) {
+	args := m.MethodCalled("VerifyChallenge")
+	return args.Get(0).(*accountEntities.Account), args.Get(1).([]string), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) Enroll(_ uuid.UUID) (*mfaEntities.EnrollmentResponse, error) {
+	args := m.MethodCalled("Enroll")
+	return args.Get(0).(*mfaEntities.EnrollmentResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Enable(_ *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	args := m.MethodCalled("Enable")
+	return args.Get(0).(*mfaEntities.RecoveryCodesResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Disable(_ *mfaEntities.CodeData) error {
+	args := m.MethodCalled("Disable")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RegenerateRecoveryCodes(_ *mfaEntities.CodeData) (*mfaEntities.RecoveryCodesResponse, error) {
+	args := m.MethodCalled("RegenerateRecoveryCodes")
+	return args.Get(0).(*mfaEntities.RecoveryCodesResponse), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,540 @@
+package mfa
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	mfaEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/mfa"
+	mfaEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/mfa"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	mfaRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/mfa"
+	"github.com/ZupIT/horusec-platform/auth/internal/services/encryption"
+)
+
+// testCacheRepository keeps the values in memory, allowing to assert the challenge flows across many requests
+type testCacheRepository struct {
+	values map[string]string
+}
+
+func newTestCacheRepository() *testCacheRepository {
+	return &testCacheRepository{values: map[string]string{}}
+}
+
+func (r *testCacheRepository) Get(key string) (string, error) {
+	value, ok := r.values[key]
+	if !ok {
+		return "", databaseEnums.ErrorNotFoundRecords
+	}
+
+	return value, nil
+}
+
+func (r *testCacheRepository) Set(key, value string, _ time.Duration) error {
+	r.values[key] = value
+
+	return nil
+}
+
+func (r *testCacheRepository) Delete(key string) error {
+	delete(r.values, key)
+
+	return nil
+}
+
+func (r *testCacheRepository) Pop(key string) (string, error) {
+	value, err := r.Get(key)
+	delete(r.values, key)
+
+	return value, err
+}
+
+func (r *testCacheRepository) Increment(key string, _ time.Duration) (int, error) {
+	value, _ := strconv.Atoi(r.values[key])
+	r.values[key] = strconv.Itoa(value + 1)
+
+	return value + 1, nil
+}
+
+func newTestEncryptionService(account *accountEntities.Account) *encryption.Mock {
+	encryptionMock := &encryption.Mock{}
+	encryptionMock.On("Encrypt").Return("encrypted", nil)
+
+	if account != nil {
+		encryptionMock.On("Decrypt").Return(account.MFASecret, nil)
+	}
+
+	return encryptionMock
+}
+
+func newTestAccount(isMFAEnabled bool) *accountEntities.Account {
+	return &accountEntities.Account{
+		AccountID:    uuid.New(),
+		Email:        "test@test.com",
+		Username:     "test",
+		MFASecret:    mfaEntities.NewTOTP().Secret,
+		IsMFAEnabled: isMFAEnabled,
+	}
+}
+
+func getCurrentCode(account *accountEntities.Account) string {
+	totp := &mfaEntities.TOTP{Secret: account.MFASecret}
+
+	return totp.GenerateCode(totp.GetStep(time.Now()))
+}
+
+func newTestService(mfaRepositoryMock *mfaRepository.Mock, account *accountEntities.Account,
+	appConfig *app.Config) IService {
+	accountRepositoryMock := &accountRepository.Mock{}
+	accountRepositoryMock.On("GetAccount").Return(account, nil)
+
+	return NewMFAService(mfaRepositoryMock, accountRepositoryMock, appConfig, newTestCacheRepository(),
+		newTestEncryptionService(account))
+}
+
+func newChallenge(service IService, account *accountEntities.Account) *authEntities.LoginResponse {
+	response, _ := service.NewChallenge(account)
+
+	return response
+}
+
+func TestNewMFAService(t *testing.T) {
+	t.Run("should success create a new mfa service", func(t *testing.T) {
+		assert.NotNil(t, NewMFAService(nil, nil, nil, nil, nil))
+	})
+}
+
+func TestIsChallengeRequired(t *testing.T) {
+	t.Run("should return true when account has mfa enabled", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, nil, &app.Config{})
+
+		isRequired, err := service.IsChallengeRequired(newTestAccount(true))
+		assert.NoError(t, err)
+		assert.True(t, isRequired)
+	})
+
+	t.Run("should return true when required by application", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, nil, &app.Config{RequireMFA: true})
+
+		isRequired, err := service.IsChallengeRequired(newTestAccount(false))
+		assert.NoError(t, err)
+		assert.True(t, isRequired)
+	})
+
+	t.Run("should return if required by a workspace", func(t *testing.T) {
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("IsRequiredByWorkspace").Return(true, nil)
+
+		service := newTestService(mfaRepositoryMock, nil, &app.Config{})
+
+		isRequired, err := service.IsChallengeRequired(newTestAccount(false))
+		assert.NoError(t, err)
+		assert.True(t, isRequired)
+	})
+}
+
+func TestNewChallenge(t *testing.T) {
+	t.Run("should return a challenge login response without tokens", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, nil, &app.Config{})
+
+		response, err := service.NewChallenge(newTestAccount(true))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, response.MFAToken)
+		assert.True(t, response.IsMFARequired)
+		assert.False(t, response.IsMFAEnrollment)
+		assert.Empty(t, response.AccessToken)
+	})
+
+	t.Run("should return an enrollment challenge when mfa is not enabled", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, nil, &app.Config{})
+
+		response := newChallenge(service, newTestAccount(false))
+		assert.True(t, response.IsMFAEnrollment)
+	})
+
+	t.Run("should return error when failed to cache the challenge", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(errors.New("test"))
+
+		service := NewMFAService(&mfaRepository.Mock{}, &accountRepository.Mock{}, &app.Config{}, cacheRepositoryMock,
+			&encryption.Mock{})
+
+		_, err := service.NewChallenge(newTestAccount(true))
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestEnrollChallenge(t *testing.T) {
+	t.Run("should success enroll with an enrollment challenge", func(t *testing.T) {
+		account := newTestAccount(false)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("SetSecret").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+		challenge := newChallenge(service, account)
+
+		response, err := service.EnrollChallenge(&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, response.Secret)
+		assert.Contains(t, response.ProvisioningURI, response.Secret)
+		mfaRepositoryMock.AssertCalled(t, "SetSecret")
+	})
+
+	t.Run("should return error when challenge is not an enrollment", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		service := newTestService(&mfaRepository.Mock{}, account, &app.Config{})
+		challenge := newChallenge(service, account)
+
+		_, err := service.EnrollChallenge(&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken})
+		assert.Equal(t, mfaEnums.ErrorMFAEnrollmentNotRequired, err)
+	})
+
+	t.Run("should return error when invalid challenge", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, nil, &app.Config{})
+
+		_, err := service.EnrollChallenge(&mfaEntities.ChallengeData{MFAToken: uuid.NewString()})
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidChallenge, err)
+	})
+}
+
+func TestVerifyChallenge(t *testing.T) {
+	t.Run("should success verify challenge with totp code", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		service := newTestService(&mfaRepository.Mock{}, account, &app.Config{})
+		challenge := newChallenge(service, account)
+
+		result, recoveryCodes, err := service.VerifyChallenge(
+			&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken, Code: getCurrentCode(account)})
+		assert.NoError(t, err)
+		assert.Equal(t, account, result)
+		assert.Empty(t, recoveryCodes)
+
+		_, _, err = service.VerifyChallenge(
+			&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken, Code: getCurrentCode(account)})
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidChallenge, err)
+	})
+
+	t.Run("should success verify challenge with recovery code", func(t *testing.T) {
+		account := newTestAccount(true)
+		recoveryCodes, codes := mfaEntities.NewRecoveryCodes(account.AccountID)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("ListUnusedRecoveryCodes").Return(recoveryCodes, nil)
+		mfaRepositoryMock.On("SetRecoveryCodeUsed").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+		challenge := newChallenge(service, account)
+
+		_, _, err := service.VerifyChallenge(&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken, Code: codes[3]})
+		assert.NoError(t, err)
+	})
+
+	t.Run("should enable mfa and return recovery codes when enrollment challenge", func(t *testing.T) {
+		account := newTestAccount(false)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("Enable").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+		challenge := newChallenge(service, account)
+
+		_, recoveryCodes, err := service.VerifyChallenge(
+			&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken, Code: getCurrentCode(account)})
+		assert.NoError(t, err)
+		assert.Len(t, recoveryCodes, mfaEnums.RecoveryCodesCount)
+	})
+
+	t.Run("should return error when invalid code", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("ListUnusedRecoveryCodes").Return([]*mfaEntities.RecoveryCode{}, nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+		challenge := newChallenge(service, account)
+
+		_, _, err := service.VerifyChallenge(&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken, Code: "test"})
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidCode, err)
+	})
+
+	t.Run("should return error after too many attempts", func(t *testing.T) {
+		account := newTestAccount(true)
+		account.MFASecret = ""
+
+		service := newTestService(&mfaRepository.Mock{}, account, &app.Config{})
+		challenge := newChallenge(service, account)
+		data := &mfaEntities.ChallengeData{MFAToken: challenge.MFAToken, Code: "000000"}
+
+		for index := 0; index < mfaEnums.MaxChallengeAttempts; index++ {
+			_, _, err := service.VerifyChallenge(data)
+			assert.Equal(t, mfaEnums.ErrorMFAInvalidCode, err)
+		}
+
+		_, _, err := service.VerifyChallenge(data)
+		assert.Equal(t, mfaEnums.ErrorMFATooManyAttempts, err)
+	})
+
+	t.Run("should return error when failed to get challenge from cache", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return("", errors.New("test"))
+
+		service := NewMFAService(&mfaRepository.Mock{}, &accountRepository.Mock{}, &app.Config{}, cacheRepositoryMock,
+			&encryption.Mock{})
+
+		_, _, err := service.VerifyChallenge(&mfaEntities.ChallengeData{MFAToken: uuid.NewString()})
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when failed to increment the attempts", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return(mfaEntities.NewChallenge(uuid.New(), false).ToString(), nil)
+		cacheRepositoryMock.On("Increment").Return(0, errors.New("test"))
+
+		service := NewMFAService(&mfaRepository.Mock{}, &accountRepository.Mock{}, &app.Config{}, cacheRepositoryMock,
+			&encryption.Mock{})
+
+		_, _, err := service.VerifyChallenge(&mfaEntities.ChallengeData{MFAToken: uuid.NewString()})
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when challenge was removed by a concurrent verification", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return(mfaEntities.NewChallenge(account.AccountID, false).ToString(), nil)
+		cacheRepositoryMock.On("Increment").Return(1, nil)
+		cacheRepositoryMock.On("Pop").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(account, nil)
+
+		service := NewMFAService(&mfaRepository.Mock{}, accountRepositoryMock, &app.Config{}, cacheRepositoryMock,
+			newTestEncryptionService(account))
+
+		_, _, err := service.VerifyChallenge(
+			&mfaEntities.ChallengeData{MFAToken: uuid.NewString(), Code: getCurrentCode(account)})
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidChallenge, err)
+	})
+
+	t.Run("should return error when failed to get account", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := NewMFAService(&mfaRepository.Mock{}, accountRepositoryMock, &app.Config{}, newTestCacheRepository(),
+			&encryption.Mock{})
+		challenge := newChallenge(service, newTestAccount(true))
+
+		_, _, err := service.VerifyChallenge(&mfaEntities.ChallengeData{MFAToken: challenge.MFAToken})
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestEnroll(t *testing.T) {
+	t.Run("should return error when mfa already enabled", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, newTestAccount(true), &app.Config{})
+
+		_, err := service.Enroll(uuid.New())
+		assert.Equal(t, mfaEnums.ErrorMFAAlreadyEnabled, err)
+	})
+
+	t.Run("should return error when failed to encrypt the secret", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(newTestAccount(false), nil)
+
+		encryptionMock := &encryption.Mock{}
+		encryptionMock.On("Encrypt").Return("", errors.New("test"))
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+
+		service := NewMFAService(mfaRepositoryMock, accountRepositoryMock, &app.Config{}, newTestCacheRepository(),
+			encryptionMock)
+
+		_, err := service.Enroll(uuid.New())
+		assert.Equal(t, errors.New("test"), err)
+		mfaRepositoryMock.AssertNotCalled(t, "SetSecret")
+	})
+
+	t.Run("should return error when failed to set secret", func(t *testing.T) {
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("SetSecret").Return(errors.New("test"))
+
+		service := newTestService(mfaRepositoryMock, newTestAccount(false), &app.Config{})
+
+		_, err := service.Enroll(uuid.New())
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestEnable(t *testing.T) {
+	t.Run("should success enable mfa", func(t *testing.T) {
+		account := newTestAccount(false)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("Enable").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+
+		response, err := service.Enable(&mfaEntities.CodeData{Code: getCurrentCode(account)})
+		assert.NoError(t, err)
+		assert.Len(t, response.RecoveryCodes, mfaEnums.RecoveryCodesCount)
+	})
+
+	t.Run("should return error when not enrolled", func(t *testing.T) {
+		account := newTestAccount(false)
+		account.MFASecret = ""
+
+		service := newTestService(&mfaRepository.Mock{}, account, &app.Config{})
+
+		_, err := service.Enable(&mfaEntities.CodeData{Code: "123456"})
+		assert.Equal(t, mfaEnums.ErrorMFANotEnrolled, err)
+	})
+
+	t.Run("should return error when failed to decrypt the secret", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(newTestAccount(false), nil)
+
+		encryptionMock := &encryption.Mock{}
+		encryptionMock.On("Decrypt").Return("", errors.New("test"))
+
+		service := NewMFAService(&mfaRepository.Mock{}, accountRepositoryMock, &app.Config{}, newTestCacheRepository(),
+			encryptionMock)
+
+		_, err := service.Enable(&mfaEntities.CodeData{Code: "123456"})
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when code already used", func(t *testing.T) {
+		account := newTestAccount(false)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("Enable").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+		code := getCurrentCode(account)
+
+		_, err := service.Enable(&mfaEntities.CodeData{Code: code})
+		assert.NoError(t, err)
+
+		_, err = service.Enable(&mfaEntities.CodeData{Code: code})
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidCode, err)
+	})
+
+	t.Run("should return error when failed to get account", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := NewMFAService(&mfaRepository.Mock{}, accountRepositoryMock, &app.Config{}, newTestCacheRepository(),
+			&encryption.Mock{})
+
+		_, err := service.Enable(&mfaEntities.CodeData{})
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestDisable(t *testing.T) {
+	t.Run("should success disable mfa", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("IsRequiredByWorkspace").Return(false, nil)
+		mfaRepositoryMock.On("Disable").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+
+		assert.NoError(t, service.Disable(&mfaEntities.CodeData{Code: getCurrentCode(account)}))
+	})
+
+	t.Run("should return error when mfa not enabled", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, newTestAccount(false), &app.Config{})
+
+		assert.Equal(t, mfaEnums.ErrorMFANotEnabled, service.Disable(&mfaEntities.CodeData{}))
+	})
+
+	t.Run("should return error when required by application", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, newTestAccount(true), &app.Config{RequireMFA: true})
+
+		assert.Equal(t, mfaEnums.ErrorMFARequiredByPolicy, service.Disable(&mfaEntities.CodeData{}))
+	})
+
+	t.Run("should return error when required by workspace", func(t *testing.T) {
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("IsRequiredByWorkspace").Return(true, nil)
+
+		service := newTestService(mfaRepositoryMock, newTestAccount(true), &app.Config{})
+
+		assert.Equal(t, mfaEnums.ErrorMFARequiredByPolicy, service.Disable(&mfaEntities.CodeData{}))
+	})
+
+	t.Run("should return error when failed to check workspaces", func(t *testing.T) {
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("IsRequiredByWorkspace").Return(false, errors.New("test"))
+
+		service := newTestService(mfaRepositoryMock, newTestAccount(true), &app.Config{})
+
+		assert.Equal(t, errors.New("test"), service.Disable(&mfaEntities.CodeData{}))
+	})
+
+	t.Run("should return error when invalid code", func(t *testing.T) {
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("IsRequiredByWorkspace").Return(false, nil)
+		mfaRepositoryMock.On("ListUnusedRecoveryCodes").Return([]*mfaEntities.RecoveryCode{}, nil)
+
+		service := newTestService(mfaRepositoryMock, newTestAccount(true), &app.Config{})
+
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidCode, service.Disable(&mfaEntities.CodeData{Code: "test"}))
+	})
+}
+
+func TestRegenerateRecoveryCodes(t *testing.T) {
+	t.Run("should success regenerate recovery codes", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("ReplaceRecoveryCodes").Return(nil)
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+
+		response, err := service.RegenerateRecoveryCodes(&mfaEntities.CodeData{Code: getCurrentCode(account)})
+		assert.NoError(t, err)
+		assert.Len(t, response.RecoveryCodes, mfaEnums.RecoveryCodesCount)
+	})
+
+	t.Run("should return error when failed to replace recovery codes", func(t *testing.T) {
+		account := newTestAccount(true)
+
+		mfaRepositoryMock := &mfaRepository.Mock{}
+		mfaRepositoryMock.On("ReplaceRecoveryCodes").Return(errors.New("test"))
+
+		service := newTestService(mfaRepositoryMock, account, &app.Config{})
+
+		_, err := service.RegenerateRecoveryCodes(&mfaEntities.CodeData{Code: getCurrentCode(account)})
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when invalid code", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, newTestAccount(true), &app.Config{})
+
+		_, err := service.RegenerateRecoveryCodes(&mfaEntities.CodeData{Code: "test"})
+		assert.Equal(t, mfaEnums.ErrorMFAInvalidCode, err)
+	})
+
+	t.Run("should return error when mfa not enabled", func(t *testing.T) {
+		service := newTestService(&mfaRepository.Mock{}, newTestAccount(false), &app.Config{})
+
+		_, err := service.RegenerateRecoveryCodes(&mfaEntities.CodeData{})
+		assert.Equal(t, mfaEnums.ErrorMFANotEnabled, err)
+	})
+}
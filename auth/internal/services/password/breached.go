@@ -0,0 +1,64 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // sha1 is the hash used by the breached passwords lists
+	"embed"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+)
+
+//go:embed breached/*.txt
+var bundledBreachedHashes embed.FS
+
+// newBreachedHashes uses the configured directory when present, otherwise a small bundled list of common passwords
+func newBreachedHashes(path string) fs.FS {
+	if path != "" {
+		return os.DirFS(path)
+	}
+
+	hashes, _ := fs.Sub(bundledBreachedHashes, passwordEnums.BundledBreachedHashesPath)
+	return hashes
+}
+
+// isBreached follows the format of the pwned passwords range files, each file is named by the first characters of
+// the sha1 of the passwords and contains one line for each remaining part of the hash and its breach count
+func (s *Service) isBreached(password string) (bool, error) {
+	hash := s.hashPassword(password)
+
+	file, err := s.breachedHashes.Open(hash[:passwordEnums.HashPrefixLength] + passwordEnums.HashFileExtension)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	defer file.Close()
+	return s.containsHashSuffix(file, hash[passwordEnums.HashPrefixLength:])
+}
+
+func (s *Service) hashPassword(password string) string {
+	hash := sha1.Sum([]byte(password)) //nolint:gosec // sha1 is the hash used by the breached passwords lists
+
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+func (s *Service) containsHashSuffix(file io.Reader, suffix string) (bool, error) {
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.SplitN(strings.TrimSpace(scanner.Text()), passwordEnums.HashCountSeparator, 2)
+		if strings.EqualFold(line[0], suffix) {
+			return true, nil
+		}
+	}
+
+	return false, scanner.Err()
+}
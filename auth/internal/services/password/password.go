@@ -0,0 +1,186 @@
+package password
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	passwordRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/password"
+)
+
+type IService interface {
+	Validate(accountID uuid.UUID, password string) error
+	SaveHistory(account *accountEntities.Account) error
+	IsExpired(account *accountEntities.Account) bool
+	NewExpiredChallenge(account *accountEntities.Account) (*authEntities.LoginResponse, error)
+	NewChangeToken(account *accountEntities.Account, isMFARequired bool) (string, error)
+	GetExpiredChallenge(passwordChangeToken string) (*passwordEntities.ChangeChallenge, error)
+	RemoveExpiredChallenge(passwordChangeToken string) error
+}
+
+type Service struct {
+	passwordRepository passwordRepository.IRepository
+	appConfig          app.IConfig
+	cacheRepository    cacheRepository.IRepository
+	breachedHashes     fs.FS
+}
+
+func NewPasswordService(repositoryPassword passwordRepository.IRepository, appConfig app.IConfig,
+	repositoryCache cacheRepository.IRepository) IService {
+	return &Service{
+		passwordRepository: repositoryPassword,
+		appConfig:          appConfig,
+		cacheRepository:    repositoryCache,
+		breachedHashes:     newBreachedHashes(appConfig.GetPasswordPolicy().BreachedHashesPath),
+	}
+}
+
+// Validate checks the password against the policy, the history is only checked for existing accounts
+func (s *Service) Validate(accountID uuid.UUID, password string) error {
+	policy := s.appConfig.GetPasswordPolicy()
+	violations := policy.Check(password)
+
+	violations, err := s.checkHistory(violations, policy, accountID, password)
+	if err != nil {
+		return err
+	}
+
+	return passwordEntities.NewPolicyError(s.checkBreached(violations, policy, password))
+}
+
+func (s *Service) checkHistory(violations []*passwordEntities.Violation, policy *passwordEntities.Policy,
+	accountID uuid.UUID, password string) ([]*passwordEntities.Violation, error) {
+	if !policy.HasHistory() || accountID == uuid.Nil {
+		return violations, nil
+	}
+
+	history, err := s.passwordRepository.ListHistory(accountID, policy.HistorySize)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.isReused(history, password) {
+		violations = append(violations, passwordEntities.NewViolation(passwordEnums.ViolationReused,
+			fmt.Sprintf(passwordEnums.MessageReused, policy.HistorySize)))
+	}
+
+	return violations, nil
+}
+
+func (s *Service) isReused(history []*passwordEntities.History, password string) bool {
+	for _, previous := range history {
+		if previous.Matches(password) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkBreached doesn't block the password when the list can't be read, since it is an extra protection
+func (s *Service) checkBreached(violations []*passwordEntities.Violation, policy *passwordEntities.Policy,
+	password string) []*passwordEntities.Violation {
+	if !policy.CheckBreached {
+		return violations
+	}
+
+	isBreached, err := s.isBreached(password)
+	if err != nil {
+		logger.LogError(passwordEnums.MessageFailedToReadBreached, err)
+	}
+
+	if isBreached {
+		violations = append(violations, passwordEntities.NewViolation(passwordEnums.ViolationBreached,
+			passwordEnums.MessageBreached))
+	}
+
+	return violations
+}
+
+// SaveHistory keeps the hash of the current password of the account, removing the ones out of the policy size
+func (s *Service) SaveHistory(account *accountEntities.Account) error {
+	policy := s.appConfig.GetPasswordPolicy()
+	if !policy.HasHistory() {
+		return nil
+	}
+
+	if err := s.passwordRepository.CreateHistory(passwordEntities.NewHistory(account)); err != nil {
+		return err
+	}
+
+	if err := s.passwordRepository.DeleteOldHistory(account.AccountID, policy.HistorySize); err != nil {
+		logger.LogError(passwordEnums.MessageFailedToPruneHistory, err)
+	}
+
+	return nil
+}
+
+func (s *Service) IsExpired(account *accountEntities.Account) bool {
+	return s.appConfig.GetPasswordPolicy().IsExpired(account.PasswordChangedAt)
+}
+
+// NewExpiredChallenge replaces the login tokens with a short lived token that only allows changing the password, the
+// second factor was already checked by the login
+func (s *Service) NewExpiredChallenge(account *accountEntities.Account) (*authEntities.LoginResponse, error) {
+	passwordChangeToken, err := s.NewChangeToken(account, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.ToPasswordExpiredLoginResponse(passwordChangeToken), nil
+}
+
+// NewChangeToken creates a short lived token that only allows changing the password, it is kept in the database
+// cache, so the change is accepted by any of the auth replicas
+func (s *Service) NewChangeToken(account *accountEntities.Account, isMFARequired bool) (string, error) {
+	passwordChangeToken := uuid.NewString()
+	challenge := passwordEntities.NewChangeChallenge(account.AccountID, isMFARequired)
+
+	if err := s.cacheRepository.Set(s.getChangeTokenCacheKey(passwordChangeToken), challenge.ToString(),
+		passwordEnums.ChangeTokenDuration); err != nil {
+		return "", err
+	}
+
+	return passwordChangeToken, nil
+}
+
+func (s *Service) GetExpiredChallenge(passwordChangeToken string) (*passwordEntities.ChangeChallenge, error) {
+	value, err := s.cacheRepository.Get(s.getChangeTokenCacheKey(passwordChangeToken))
+	if err != nil {
+		return nil, s.parseChallengeError(err)
+	}
+
+	return passwordEntities.ParseChangeChallenge(value)
+}
+
+// RemoveExpiredChallenge pops the token from the database cache, when it was already removed by a concurrent change
+// the session isn't created, so each token results in a single session even across replicas
+func (s *Service) RemoveExpiredChallenge(passwordChangeToken string) error {
+	if _, err := s.cacheRepository.Pop(s.getChangeTokenCacheKey(passwordChangeToken)); err != nil {
+		return s.parseChallengeError(err)
+	}
+
+	return nil
+}
+
+func (s *Service) parseChallengeError(err error) error {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return passwordEnums.ErrorInvalidChangeToken
+	}
+
+	return err
+}
+
+func (s *Service) getChangeTokenCacheKey(passwordChangeToken string) string {
+	return fmt.Sprintf(passwordEnums.CacheKeyChangeToken, passwordChangeToken)
+}
@@ -0,0 +1,51 @@
+package password
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Validate(_ uuid.UUID, _ string) error {
+	args := m.MethodCalled("Validate")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) SaveHistory(_ *accountEntities.Account) error {
+	args := m.MethodCalled("SaveHistory")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) IsExpired(_ *accountEntities.Account) bool {
+	args := m.MethodCalled("IsExpired")
+	return args.Get(0).(bool)
+}
+
+func (m *Mock) NewExpiredChallenge(_ *accountEntities.Account) (*authEntities.LoginResponse, error) {
+	args := m.MethodCalled("NewExpiredChallenge")
+	return args.Get(0).(*authEntities.LoginResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) NewChangeToken(_ *accountEntities.Account, _ bool) (string, error) {
+	args := m.MethodCalled("NewChangeToken")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetExpiredChallenge(_ string) (*passwordEntities.ChangeChallenge, error) {
+	args := m.MethodCalled("GetExpiredChallenge")
+	return args.Get(0).(*passwordEntities.ChangeChallenge), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RemoveExpiredChallenge(_ string) error {
+	args := m.MethodCalled("RemoveExpiredChallenge")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,289 @@
+package password
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	passwordEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/password"
+	passwordEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/password"
+	cacheRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/cache"
+	passwordRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/password"
+)
+
+func newTestPolicy() passwordEntities.Policy {
+	return passwordEntities.Policy{
+		MinLength:               8,
+		RequireUppercase:        true,
+		RequireLowercase:        true,
+		RequireNumber:           true,
+		RequireSpecialCharacter: true,
+		HistorySize:             3,
+		MaxAgeDays:              90,
+		CheckBreached:           true,
+	}
+}
+
+func newTestHistory(password string) *passwordEntities.History {
+	hash, _ := crypto.HashPasswordBcrypt(password)
+
+	return &passwordEntities.History{Password: hash}
+}
+
+func getPolicyViolations(err error) []passwordEnums.Violation {
+	var policyError *passwordEntities.PolicyError
+	if !errors.As(err, &policyError) {
+		return nil
+	}
+
+	var violations []passwordEnums.Violation
+	for _, violation := range policyError.Violations {
+		violations = append(violations, violation.Code)
+	}
+
+	return violations
+}
+
+func TestNewPasswordService(t *testing.T) {
+	t.Run("should success create a new password service", func(t *testing.T) {
+		assert.NotNil(t, NewPasswordService(nil, &app.Config{}, nil))
+	})
+
+	t.Run("should success create a new password service with a breached hashes directory", func(t *testing.T) {
+		policy := newTestPolicy()
+		policy.BreachedHashesPath = "./breached"
+
+		assert.NotNil(t, NewPasswordService(nil, &app.Config{PasswordPolicy: policy}, nil))
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should return nil when password satisfies the policy", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+		repositoryMock.On("ListHistory").Return([]*passwordEntities.History{newTestHistory("Other@Pass1")}, nil)
+
+		service := NewPasswordService(repositoryMock, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		assert.NoError(t, service.Validate(uuid.New(), "Str0ng@Horusec"))
+	})
+
+	t.Run("should return the violations of the length and character rules", func(t *testing.T) {
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		err := service.Validate(uuid.Nil, "test")
+		assert.ErrorIs(t, err, passwordEnums.ErrorPasswordPolicy)
+		assert.Equal(t, []passwordEnums.Violation{passwordEnums.ViolationMinLength, passwordEnums.ViolationUppercase,
+			passwordEnums.ViolationNumber, passwordEnums.ViolationSpecialCharacter}, getPolicyViolations(err))
+	})
+
+	t.Run("should return reused violation when password is in the history", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+		repositoryMock.On("ListHistory").Return([]*passwordEntities.History{newTestHistory("Str0ng@Horusec")}, nil)
+
+		service := NewPasswordService(repositoryMock, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		err := service.Validate(uuid.New(), "Str0ng@Horusec")
+		assert.ErrorIs(t, err, passwordEnums.ErrorPasswordPolicy)
+		assert.Equal(t, []passwordEnums.Violation{passwordEnums.ViolationReused}, getPolicyViolations(err))
+	})
+
+	t.Run("should return error when failed to list history", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+		repositoryMock.On("ListHistory").Return([]*passwordEntities.History{}, errors.New("test"))
+
+		service := NewPasswordService(repositoryMock, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		err := service.Validate(uuid.New(), "Str0ng@Horusec")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, passwordEnums.ErrorPasswordPolicy)
+	})
+
+	t.Run("should return breached violation when password is in the bundled list", func(t *testing.T) {
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		err := service.Validate(uuid.Nil, "P@ssw0rd1")
+		assert.ErrorIs(t, err, passwordEnums.ErrorPasswordPolicy)
+		assert.Equal(t, []passwordEnums.Violation{passwordEnums.ViolationBreached}, getPolicyViolations(err))
+	})
+
+	t.Run("should return breached violation using the configured directory", func(t *testing.T) {
+		policy := newTestPolicy()
+		policy.BreachedHashesPath = "./breached"
+
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: policy}, nil)
+
+		err := service.Validate(uuid.Nil, "Horusec@123")
+		assert.Equal(t, []passwordEnums.Violation{passwordEnums.ViolationBreached}, getPolicyViolations(err))
+	})
+
+	t.Run("should not block the password when the breached list can't be read", func(t *testing.T) {
+		policy := newTestPolicy()
+		policy.BreachedHashesPath = "./password_test.go"
+
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: policy}, nil)
+
+		assert.NoError(t, service.Validate(uuid.Nil, "P@ssw0rd1"))
+	})
+
+	t.Run("should not check history and breached when disabled", func(t *testing.T) {
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: passwordEntities.Policy{MinLength: 8}}, nil)
+
+		assert.NoError(t, service.Validate(uuid.New(), "P@ssw0rd1"))
+	})
+}
+
+func TestSaveHistory(t *testing.T) {
+	t.Run("should success save history and remove the old passwords", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+		repositoryMock.On("CreateHistory").Return(nil)
+		repositoryMock.On("DeleteOldHistory").Return(nil)
+
+		service := NewPasswordService(repositoryMock, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		assert.NoError(t, service.SaveHistory(&accountEntities.Account{}))
+		repositoryMock.AssertCalled(t, "DeleteOldHistory")
+	})
+
+	t.Run("should not return error when failed to remove the old passwords", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+		repositoryMock.On("CreateHistory").Return(nil)
+		repositoryMock.On("DeleteOldHistory").Return(errors.New("test"))
+
+		service := NewPasswordService(repositoryMock, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		assert.NoError(t, service.SaveHistory(&accountEntities.Account{}))
+	})
+
+	t.Run("should return error when failed to create history", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+		repositoryMock.On("CreateHistory").Return(errors.New("test"))
+
+		service := NewPasswordService(repositoryMock, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		assert.Error(t, service.SaveHistory(&accountEntities.Account{}))
+	})
+
+	t.Run("should not save history when disabled", func(t *testing.T) {
+		repositoryMock := &passwordRepository.Mock{}
+
+		service := NewPasswordService(repositoryMock, &app.Config{}, nil)
+
+		assert.NoError(t, service.SaveHistory(&accountEntities.Account{}))
+		repositoryMock.AssertNotCalled(t, "CreateHistory")
+	})
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Run("should return true when password is older than the max age", func(t *testing.T) {
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		assert.True(t, service.IsExpired(&accountEntities.Account{
+			PasswordChangedAt: time.Now().AddDate(0, 0, -91),
+		}))
+	})
+
+	t.Run("should return false when password is newer than the max age", func(t *testing.T) {
+		service := NewPasswordService(nil, &app.Config{PasswordPolicy: newTestPolicy()}, nil)
+
+		assert.False(t, service.IsExpired(&accountEntities.Account{PasswordChangedAt: time.Now()}))
+	})
+}
+
+func TestExpiredChallenge(t *testing.T) {
+	account := &accountEntities.Account{AccountID: uuid.New()}
+
+	t.Run("should success create an expired password challenge", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(nil)
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		response, err := service.NewExpiredChallenge(account)
+		assert.NoError(t, err)
+		assert.True(t, response.IsPasswordExpired)
+		assert.NotEmpty(t, response.PasswordChangeToken)
+		assert.Empty(t, response.AccessToken)
+	})
+
+	t.Run("should return error when failed to store the challenge", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(errors.New("test"))
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		_, err := service.NewExpiredChallenge(account)
+		assert.Error(t, err)
+	})
+
+	t.Run("should success create a password change token requiring the mfa", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Set").Return(nil)
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		token, err := service.NewChangeToken(account, true)
+		assert.NoError(t, err)
+		assert.NoError(t, validation.Validate(token, is.UUID))
+	})
+
+	t.Run("should success get the challenge of the token", func(t *testing.T) {
+		challenge := passwordEntities.NewChangeChallenge(account.AccountID, true)
+
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return(challenge.ToString(), nil)
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		result, err := service.GetExpiredChallenge(uuid.NewString())
+		assert.NoError(t, err)
+		assert.Equal(t, challenge, result)
+	})
+
+	t.Run("should return error when invalid password change token", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		_, err := service.GetExpiredChallenge(uuid.NewString())
+		assert.Equal(t, passwordEnums.ErrorInvalidChangeToken, err)
+	})
+
+	t.Run("should return error when failed to get the challenge", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Get").Return("", errors.New("test"))
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		_, err := service.GetExpiredChallenge(uuid.NewString())
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should success remove the challenge", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return(account.AccountID.String(), nil)
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		assert.NoError(t, service.RemoveExpiredChallenge(uuid.NewString()))
+	})
+
+	t.Run("should return error when the challenge was already removed", func(t *testing.T) {
+		cacheRepositoryMock := &cacheRepository.Mock{}
+		cacheRepositoryMock.On("Pop").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		service := NewPasswordService(nil, &app.Config{}, cacheRepositoryMock)
+
+		assert.Equal(t, passwordEnums.ErrorInvalidChangeToken, service.RemoveExpiredChallenge(uuid.NewString()))
+	})
+}
@@ -0,0 +1,168 @@
+package personaltoken
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	personalTokenRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+type IService interface {
+	CreateToken(data *personalTokenEntities.Data) (*personalTokenEntities.CreateResponse, error)
+	ListTokens(accountID uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error)
+	RevokeToken(accountID, tokenID uuid.UUID) error
+	NewAuthorizationToken(token string, permission permissionEnums.Permission) (string, error)
+	GetAccountData(token string) (*proto.GetAccountDataResponse, error)
+}
+
+type Service struct {
+	personalTokenRepository personalTokenRepository.IRepository
+	accountRepository       accountRepository.IRepository
+	appConfig               app.IConfig
+}
+
+func NewPersonalTokenService(repositoryPersonalToken personalTokenRepository.IRepository,
+	repositoryAccount accountRepository.IRepository, appConfig app.IConfig) IService {
+	return &Service{
+		personalTokenRepository: repositoryPersonalToken,
+		accountRepository:       repositoryAccount,
+		appConfig:               appConfig,
+	}
+}
+
+func (s *Service) CreateToken(data *personalTokenEntities.Data) (*personalTokenEntities.CreateResponse, error) {
+	if err := s.checkCanCreateToken(data.AccountID); err != nil {
+		return nil, err
+	}
+
+	personalToken, token := personalTokenEntities.NewPersonalAccessToken(data)
+
+	return personalToken.ToCreateResponse(token), s.personalTokenRepository.CreateToken(personalToken)
+}
+
+func (s *Service) checkCanCreateToken(accountID uuid.UUID) error {
+	tokens, err := s.personalTokenRepository.ListTokens(accountID)
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) >= personalTokenEnums.MaxTokensPerAccount {
+		return personalTokenEnums.ErrorTokensLimitReached
+	}
+
+	return nil
+}
+
+func (s *Service) ListTokens(accountID uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error) {
+	return s.personalTokenRepository.ListTokens(accountID)
+}
+
+func (s *Service) RevokeToken(accountID, tokenID uuid.UUID) error {
+	return s.personalTokenRepository.RevokeToken(accountID, tokenID)
+}
+
+// NewAuthorizationToken checks the permission required by the route against the token scopes and returns a short
+// lived jwt of the token owner, so the authorization continues with the account roles as if the request was made by
+// the owner
+func (s *Service) NewAuthorizationToken(token string, permission permissionEnums.Permission) (string, error) {
+	personalToken, err := s.authenticate(token)
+	if err != nil {
+		return "", err
+	}
+
+	if !personalToken.AllowsPermission(permission) {
+		return "", personalTokenEnums.ErrorScopeNotAllowed
+	}
+
+	return s.newOwnerAccessToken(personalToken)
+}
+
+func (s *Service) newOwnerAccessToken(personalToken *personalTokenEntities.PersonalAccessToken) (string, error) {
+	account, err := s.getOwnerAccount(personalToken)
+	if err != nil {
+		return "", err
+	}
+
+	accessToken, _, err := jwt.CreateToken(account.ToTokenData(), personalToken.Permissions)
+	return accessToken, err
+}
+
+func (s *Service) GetAccountData(token string) (*proto.GetAccountDataResponse, error) {
+	personalToken, err := s.authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.getOwnerAccount(personalToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return account.ToGetAccountDataResponse(personalToken.Permissions), nil
+}
+
+// getOwnerAccount the tokens of a disabled account stop working until the account is enabled again
+func (s *Service) getOwnerAccount(
+	personalToken *personalTokenEntities.PersonalAccessToken) (*accountEntities.Account, error) {
+	account, err := s.accountRepository.GetAccount(personalToken.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.IsDisabled {
+		return nil, accountEnums.ErrorAccountDisabled
+	}
+
+	return account, nil
+}
+
+// authenticate the keycloak authorization validates keycloak tokens, so it can't accept the jwt created from a
+// personal access token
+func (s *Service) authenticate(token string) (*personalTokenEntities.PersonalAccessToken, error) {
+	if s.appConfig.GetAuthenticationType() == auth.Keycloak {
+		return nil, personalTokenEnums.ErrorNotSupported
+	}
+
+	personalToken, err := s.getActiveToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	s.updateLastUsed(personalToken)
+	return personalToken, nil
+}
+
+func (s *Service) getActiveToken(token string) (*personalTokenEntities.PersonalAccessToken, error) {
+	tokenID, secret, err := personalTokenEntities.ParseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	personalToken, err := s.personalTokenRepository.GetToken(tokenID)
+	if err != nil || !personalToken.IsActive() || !personalToken.MatchesSecret(secret) {
+		return nil, personalTokenEnums.ErrorInvalidToken
+	}
+
+	return personalToken, nil
+}
+
+func (s *Service) updateLastUsed(personalToken *personalTokenEntities.PersonalAccessToken) {
+	if !personalToken.ShouldUpdateLastUsed() {
+		return
+	}
+
+	if err := s.personalTokenRepository.UpdateLastUsed(personalToken.TokenID); err != nil {
+		logger.LogError(personalTokenEnums.MessageFailedToUpdateLastUsed, err)
+	}
+}
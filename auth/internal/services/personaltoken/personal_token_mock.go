@@ -0,0 +1,41 @@
+package personaltoken
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateToken(_ *personalTokenEntities.Data) (*personalTokenEntities.CreateResponse, error) {
+	args := m.MethodCalled("CreateToken")
+	return args.Get(0).(*personalTokenEntities.CreateResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTokens(_ uuid.UUID) ([]*personalTokenEntities.PersonalAccessToken, error) {
+	args := m.MethodCalled("ListTokens")
+	return args.Get(0).([]*personalTokenEntities.PersonalAccessToken), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeToken(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) NewAuthorizationToken(_ string, _ permissionEnums.Permission) (string, error) {
+	args := m.MethodCalled("NewAuthorizationToken")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetAccountData(_ string) (*proto.GetAccountDataResponse, error) {
+	args := m.MethodCalled("GetAccountData")
+	return args.Get(0).(*proto.GetAccountDataResponse), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,280 @@
+package personaltoken
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+
+	"github.com/ZupIT/horusec-platform/auth/config/app"
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	personalTokenEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/personaltoken"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	personalTokenEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/personaltoken"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	personalTokenRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/personaltoken"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func newPersonalToken(scopes ...personalTokenEnums.Scope) (*personalTokenEntities.PersonalAccessToken, string) {
+	data := &personalTokenEntities.Data{Name: "test", Scopes: scopes}
+
+	return personalTokenEntities.NewPersonalAccessToken(data.SetAccountData(uuid.New(), []string{"group"}))
+}
+
+func newPersonalTokenMock(personalToken *personalTokenEntities.PersonalAccessToken) *personalTokenRepository.Mock {
+	repositoryMock := &personalTokenRepository.Mock{}
+	repositoryMock.On("GetToken").Return(personalToken, nil)
+	repositoryMock.On("UpdateLastUsed").Return(nil)
+
+	return repositoryMock
+}
+
+func newAccountMock() *accountRepository.Mock {
+	accountRepositoryMock := &accountRepository.Mock{}
+	accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{AccountID: uuid.New()}, nil)
+
+	return accountRepositoryMock
+}
+
+func TestNewPersonalTokenService(t *testing.T) {
+	t.Run("should success create a new personal token service", func(t *testing.T) {
+		assert.NotNil(t, NewPersonalTokenService(nil, nil, nil))
+	})
+}
+
+func TestCreateToken(t *testing.T) {
+	data := &personalTokenEntities.Data{Name: "test", Scopes: []personalTokenEnums.Scope{
+		personalTokenEnums.ScopeReadDashboards}}
+
+	t.Run("should success create token and return the plain token", func(t *testing.T) {
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("ListTokens").Return([]*personalTokenEntities.PersonalAccessToken{}, nil)
+		repositoryMock.On("CreateToken").Return(nil)
+
+		service := NewPersonalTokenService(repositoryMock, nil, nil)
+
+		result, err := service.CreateToken(data)
+		assert.NoError(t, err)
+		assert.True(t, personalTokenEntities.IsPersonalAccessToken(result.Token))
+	})
+
+	t.Run("should return error when tokens limit reached", func(t *testing.T) {
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("ListTokens").Return(make([]*personalTokenEntities.PersonalAccessToken,
+			personalTokenEnums.MaxTokensPerAccount), nil)
+
+		service := NewPersonalTokenService(repositoryMock, nil, nil)
+
+		_, err := service.CreateToken(data)
+		assert.Equal(t, personalTokenEnums.ErrorTokensLimitReached, err)
+	})
+
+	t.Run("should return error when failed to list tokens", func(t *testing.T) {
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("ListTokens").Return([]*personalTokenEntities.PersonalAccessToken{}, errors.New("test"))
+
+		service := NewPersonalTokenService(repositoryMock, nil, nil)
+
+		_, err := service.CreateToken(data)
+		assert.Error(t, err)
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	t.Run("should list tokens with repository", func(t *testing.T) {
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("ListTokens").Return([]*personalTokenEntities.PersonalAccessToken{{}}, nil)
+
+		result, err := NewPersonalTokenService(repositoryMock, nil, nil).ListTokens(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Run("should revoke token with repository", func(t *testing.T) {
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("RevokeToken").Return(personalTokenEnums.ErrorTokenNotFound)
+
+		assert.Equal(t, personalTokenEnums.ErrorTokenNotFound,
+			NewPersonalTokenService(repositoryMock, nil, nil).RevokeToken(uuid.New(), uuid.New()))
+	})
+}
+
+func TestNewAuthorizationToken(t *testing.T) {
+	appConfig := &app.Config{AuthType: auth.Horusec}
+
+	t.Run("should return jwt of the token owner when scope allows", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeTriage)
+		repositoryMock := newPersonalTokenMock(personalToken)
+
+		service := NewPersonalTokenService(repositoryMock, newAccountMock(), appConfig)
+
+		accessToken, err := service.NewAuthorizationToken(token, permissionEnums.VulnerabilityTypeUpdate)
+		assert.NoError(t, err)
+
+		claims, err := jwt.DecodeToken(accessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"group"}, claims.Permissions)
+		repositoryMock.AssertCalled(t, "UpdateLastUsed")
+	})
+
+	t.Run("should not update last used when recently used", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeTriage)
+		lastUsedAt := time.Now()
+		personalToken.LastUsedAt = &lastUsedAt
+		repositoryMock := newPersonalTokenMock(personalToken)
+
+		service := NewPersonalTokenService(repositoryMock, newAccountMock(), appConfig)
+
+		_, err := service.NewAuthorizationToken(token, permissionEnums.RepositoryRead)
+		assert.NoError(t, err)
+		repositoryMock.AssertNotCalled(t, "UpdateLastUsed")
+	})
+
+	t.Run("should not return error when failed to update last used", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeTriage)
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("GetToken").Return(personalToken, nil)
+		repositoryMock.On("UpdateLastUsed").Return(errors.New("test"))
+
+		service := NewPersonalTokenService(repositoryMock, newAccountMock(), appConfig)
+
+		_, err := service.NewAuthorizationToken(token, permissionEnums.RepositoryRead)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when scope doesn't allow", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), newAccountMock(), appConfig)
+
+		_, err := service.NewAuthorizationToken(token, permissionEnums.WorkspaceMembersManage)
+		assert.Equal(t, personalTokenEnums.ErrorScopeNotAllowed, err)
+	})
+
+	t.Run("should return error when manage repositories scope is used to manage members or tokens", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeManageRepositories)
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), newAccountMock(), appConfig)
+
+		for _, permission := range []permissionEnums.Permission{permissionEnums.WorkspaceMembersManage,
+			permissionEnums.WorkspaceTokensManage, permissionEnums.RepositoryMembersManage,
+			permissionEnums.RepositoryTokensManage} {
+			_, err := service.NewAuthorizationToken(token, permission)
+			assert.Equal(t, personalTokenEnums.ErrorScopeNotAllowed, err)
+		}
+	})
+
+	t.Run("should return error when failed to get account", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), accountRepositoryMock, appConfig)
+
+		_, err := service.NewAuthorizationToken(token, permissionEnums.RepositoryRead)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when secret doesn't match", func(t *testing.T) {
+		personalToken, _ := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), newAccountMock(), appConfig)
+
+		_, err := service.NewAuthorizationToken("hpat_"+personalToken.TokenID.String()+".test",
+			permissionEnums.RepositoryRead)
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when token is revoked", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+		revokedAt := time.Now()
+		personalToken.RevokedAt = &revokedAt
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), newAccountMock(), appConfig)
+
+		_, err := service.NewAuthorizationToken(token, permissionEnums.RepositoryRead)
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when token not found", func(t *testing.T) {
+		_, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		repositoryMock := &personalTokenRepository.Mock{}
+		repositoryMock.On("GetToken").Return(&personalTokenEntities.PersonalAccessToken{},
+			enums.ErrorNotFoundRecords)
+
+		service := NewPersonalTokenService(repositoryMock, newAccountMock(), appConfig)
+
+		_, err := service.NewAuthorizationToken(token, permissionEnums.RepositoryRead)
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		service := NewPersonalTokenService(nil, nil, appConfig)
+
+		_, err := service.NewAuthorizationToken("hpat_test", permissionEnums.RepositoryRead)
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when keycloak authentication", func(t *testing.T) {
+		service := NewPersonalTokenService(nil, nil, &app.Config{AuthType: auth.Keycloak})
+
+		_, err := service.NewAuthorizationToken("hpat_test", permissionEnums.RepositoryRead)
+		assert.Equal(t, personalTokenEnums.ErrorNotSupported, err)
+	})
+}
+
+func TestGetAccountData(t *testing.T) {
+	appConfig := &app.Config{AuthType: auth.Horusec}
+
+	t.Run("should return account data of the token owner", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), newAccountMock(), appConfig)
+
+		result, err := service.GetAccountData(token)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"group"}, result.Permissions)
+	})
+
+	t.Run("should return error when failed to get account", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), accountRepositoryMock, appConfig)
+
+		_, err := service.GetAccountData(token)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when token owner is disabled", func(t *testing.T) {
+		personalToken, token := newPersonalToken(personalTokenEnums.ScopeReadDashboards)
+
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{IsDisabled: true}, nil)
+
+		service := NewPersonalTokenService(newPersonalTokenMock(personalToken), accountRepositoryMock, appConfig)
+
+		_, err := service.GetAccountData(token)
+		assert.Equal(t, accountEnums.ErrorAccountDisabled, err)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		service := NewPersonalTokenService(nil, nil, appConfig)
+
+		_, err := service.GetAccountData("hpat_test")
+		assert.Equal(t, personalTokenEnums.ErrorInvalidToken, err)
+	})
+}
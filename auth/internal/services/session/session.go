@@ -0,0 +1,133 @@
+package session
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	sessionRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/session"
+)
+
+type IService interface {
+	CreateSession(accountID uuid.UUID, device *sessionEntities.Device) (string, error)
+	RefreshSession(refreshToken string) (*sessionEntities.Session, string, error)
+	RevokeSessionByToken(refreshToken string) error
+	ListSessions(accountID uuid.UUID) ([]*sessionEntities.Session, error)
+	RevokeSession(accountID, sessionID uuid.UUID) error
+	RevokeOtherSessions(accountID uuid.UUID, refreshToken string) error
+	RevokeAccountSessionsByAdmin(accountID, actorID uuid.UUID) error
+	RevokeAllSessions(accountID uuid.UUID) error
+}
+
+type Service struct {
+	sessionRepository sessionRepository.IRepository
+	accountRepository accountRepository.IRepository
+}
+
+func NewSessionService(repositorySession sessionRepository.IRepository,
+	repositoryAccount accountRepository.IRepository) IService {
+	return &Service{
+		sessionRepository: repositorySession,
+		accountRepository: repositoryAccount,
+	}
+}
+
+// CreateSession starts a new session for the login and returns its refresh token
+func (s *Service) CreateSession(accountID uuid.UUID, device *sessionEntities.Device) (string, error) {
+	session, refreshToken := sessionEntities.NewSession(accountID, device)
+
+	return refreshToken, s.sessionRepository.CreateSession(session)
+}
+
+// RefreshSession rotates the refresh token, a token that was already rotated means it was copied by someone else,
+// since the legitimate client and the copy can't be told apart the whole session is revoked
+func (s *Service) RefreshSession(refreshToken string) (*sessionEntities.Session, string, error) {
+	session, secret, err := s.getActiveSession(refreshToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !session.MatchesSecret(secret) {
+		return nil, "", s.revokeReusedSession(session)
+	}
+
+	previousHash := session.RefreshTokenHash
+	newRefreshToken := session.Rotate()
+
+	return session, newRefreshToken, s.sessionRepository.RotateSession(session, previousHash)
+}
+
+func (s *Service) getActiveSession(refreshToken string) (*sessionEntities.Session, string, error) {
+	sessionID, secret, err := sessionEntities.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	session, err := s.sessionRepository.GetSession(sessionID)
+	if err != nil || !session.IsActive() {
+		return nil, "", sessionEnums.ErrorInvalidRefreshToken
+	}
+
+	return session, secret, nil
+}
+
+func (s *Service) revokeReusedSession(session *sessionEntities.Session) error {
+	logger.LogWarn(sessionEnums.MessageRefreshTokenReused, session.SessionID)
+
+	if err := s.sessionRepository.RevokeSession(session.AccountID, session.SessionID); err != nil {
+		logger.LogError(sessionEnums.MessageFailedToRevokeSessions, err)
+	}
+
+	return sessionEnums.ErrorRefreshTokenReused
+}
+
+// RevokeSessionByToken ends the session of the refresh token, used when the user logs out
+func (s *Service) RevokeSessionByToken(refreshToken string) error {
+	session, secret, err := s.getActiveSession(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if !session.MatchesSecret(secret) {
+		return sessionEnums.ErrorInvalidRefreshToken
+	}
+
+	return s.sessionRepository.RevokeSession(session.AccountID, session.SessionID)
+}
+
+func (s *Service) ListSessions(accountID uuid.UUID) ([]*sessionEntities.Session, error) {
+	return s.sessionRepository.ListActiveSessions(accountID)
+}
+
+func (s *Service) RevokeSession(accountID, sessionID uuid.UUID) error {
+	return s.sessionRepository.RevokeSession(accountID, sessionID)
+}
+
+// RevokeOtherSessions revokes every session of the account except the one of the refresh token, when the token
+// is empty or invalid all the sessions are revoked
+func (s *Service) RevokeOtherSessions(accountID uuid.UUID, refreshToken string) error {
+	currentSessionID, _, _ := sessionEntities.ParseRefreshToken(refreshToken)
+
+	return s.sessionRepository.RevokeAccountSessions(accountID, currentSessionID)
+}
+
+func (s *Service) RevokeAccountSessionsByAdmin(accountID, actorID uuid.UUID) error {
+	actor, err := s.accountRepository.GetAccount(actorID)
+	if err != nil {
+		return err
+	}
+
+	if !actor.IsApplicationAdmin {
+		return sessionEnums.ErrorRevokeNotAllowed
+	}
+
+	return s.sessionRepository.RevokeAccountSessions(accountID, uuid.Nil)
+}
+
+// RevokeAllSessions ends every session of the account, used when the account is deactivated
+func (s *Service) RevokeAllSessions(accountID uuid.UUID) error {
+	return s.sessionRepository.RevokeAccountSessions(accountID, uuid.Nil)
+}
@@ -0,0 +1,54 @@
+package session
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CreateSession(_ uuid.UUID, _ *sessionEntities.Device) (string, error) {
+	args := m.MethodCalled("CreateSession")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RefreshSession(_ string) (*sessionEntities.Session, string, error) {
+	args := m.MethodCalled("RefreshSession")
+	return args.Get(0).(*sessionEntities.Session), args.Get(1).(string), mockUtils.ReturnNilOrError(args, 2)
+}
+
+func (m *Mock) RevokeSessionByToken(_ string) error {
+	args := m.MethodCalled("RevokeSessionByToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListSessions(_ uuid.UUID) ([]*sessionEntities.Session, error) {
+	args := m.MethodCalled("ListSessions")
+	return args.Get(0).([]*sessionEntities.Session), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeSession(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeSession")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeOtherSessions(_ uuid.UUID, _ string) error {
+	args := m.MethodCalled("RevokeOtherSessions")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeAccountSessionsByAdmin(_, _ uuid.UUID) error {
+	args := m.MethodCalled("RevokeAccountSessionsByAdmin")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RevokeAllSessions(_ uuid.UUID) error {
+	args := m.MethodCalled("RevokeAllSessions")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
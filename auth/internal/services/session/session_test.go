@@ -0,0 +1,214 @@
+package session
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
+	sessionEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/session"
+	sessionEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/session"
+	accountRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/account"
+	sessionRepository "github.com/ZupIT/horusec-platform/auth/internal/repositories/session"
+)
+
+func newSessionMock(session *sessionEntities.Session) *sessionRepository.Mock {
+	repositoryMock := &sessionRepository.Mock{}
+	repositoryMock.On("GetSession").Return(session, nil)
+	repositoryMock.On("RotateSession").Return(nil)
+	repositoryMock.On("RevokeSession").Return(nil)
+	repositoryMock.On("RevokeAccountSessions").Return(nil)
+
+	return repositoryMock
+}
+
+func TestNewSessionService(t *testing.T) {
+	t.Run("should success create a new session service", func(t *testing.T) {
+		assert.NotNil(t, NewSessionService(nil, nil))
+	})
+}
+
+func TestCreateSession(t *testing.T) {
+	t.Run("should success create session and return its refresh token", func(t *testing.T) {
+		repositoryMock := &sessionRepository.Mock{}
+		repositoryMock.On("CreateSession").Return(nil)
+
+		service := NewSessionService(repositoryMock, &accountRepository.Mock{})
+
+		refreshToken, err := service.CreateSession(uuid.New(), &sessionEntities.Device{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, refreshToken)
+	})
+
+	t.Run("should return error when failed to create session", func(t *testing.T) {
+		repositoryMock := &sessionRepository.Mock{}
+		repositoryMock.On("CreateSession").Return(errors.New("test"))
+
+		service := NewSessionService(repositoryMock, &accountRepository.Mock{})
+
+		_, err := service.CreateSession(uuid.New(), &sessionEntities.Device{})
+		assert.Error(t, err)
+	})
+}
+
+func TestRefreshSession(t *testing.T) {
+	t.Run("should rotate the refresh token", func(t *testing.T) {
+		session, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+		repositoryMock := newSessionMock(session)
+
+		result, newRefreshToken, err := NewSessionService(repositoryMock, nil).RefreshSession(refreshToken)
+		assert.NoError(t, err)
+		assert.Equal(t, session.AccountID, result.AccountID)
+		assert.NotEqual(t, refreshToken, newRefreshToken)
+		repositoryMock.AssertCalled(t, "RotateSession")
+	})
+
+	t.Run("should revoke session when refresh token is reused", func(t *testing.T) {
+		session, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+		_ = session.Rotate()
+		repositoryMock := newSessionMock(session)
+
+		_, _, err := NewSessionService(repositoryMock, nil).RefreshSession(refreshToken)
+		assert.Equal(t, sessionEnums.ErrorRefreshTokenReused, err)
+		repositoryMock.AssertCalled(t, "RevokeSession")
+		repositoryMock.AssertNotCalled(t, "RotateSession")
+	})
+
+	t.Run("should still return reused error when failed to revoke session", func(t *testing.T) {
+		session, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+		_ = session.Rotate()
+
+		repositoryMock := &sessionRepository.Mock{}
+		repositoryMock.On("GetSession").Return(session, nil)
+		repositoryMock.On("RevokeSession").Return(errors.New("test"))
+
+		_, _, err := NewSessionService(repositoryMock, nil).RefreshSession(refreshToken)
+		assert.Equal(t, sessionEnums.ErrorRefreshTokenReused, err)
+	})
+
+	t.Run("should return error when session is expired", func(t *testing.T) {
+		session, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+		session.ExpiresAt = time.Now().Add(-time.Minute)
+
+		_, _, err := NewSessionService(newSessionMock(session), nil).RefreshSession(refreshToken)
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken, err)
+	})
+
+	t.Run("should return error when session not found", func(t *testing.T) {
+		repositoryMock := &sessionRepository.Mock{}
+		repositoryMock.On("GetSession").Return(&sessionEntities.Session{}, enums.ErrorNotFoundRecords)
+
+		_, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+
+		_, _, err := NewSessionService(repositoryMock, nil).RefreshSession(refreshToken)
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken, err)
+	})
+
+	t.Run("should return error when invalid refresh token format", func(t *testing.T) {
+		_, _, err := NewSessionService(&sessionRepository.Mock{}, nil).RefreshSession("test")
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken, err)
+	})
+}
+
+func TestRevokeSessionByToken(t *testing.T) {
+	t.Run("should success revoke session of the token", func(t *testing.T) {
+		session, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+		repositoryMock := newSessionMock(session)
+
+		assert.NoError(t, NewSessionService(repositoryMock, nil).RevokeSessionByToken(refreshToken))
+		repositoryMock.AssertCalled(t, "RevokeSession")
+	})
+
+	t.Run("should return error when token secret is wrong", func(t *testing.T) {
+		session, _ := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+		repositoryMock := newSessionMock(session)
+
+		assert.Equal(t, sessionEnums.ErrorInvalidRefreshToken, NewSessionService(repositoryMock, nil).
+			RevokeSessionByToken(session.SessionID.String()+sessionEnums.TokenSeparator+"test"))
+		repositoryMock.AssertNotCalled(t, "RevokeSession")
+	})
+
+	t.Run("should return error when invalid refresh token", func(t *testing.T) {
+		assert.Error(t, NewSessionService(&sessionRepository.Mock{}, nil).RevokeSessionByToken("test"))
+	})
+}
+
+func TestListSessions(t *testing.T) {
+	t.Run("should success list sessions", func(t *testing.T) {
+		repositoryMock := &sessionRepository.Mock{}
+		repositoryMock.On("ListActiveSessions").Return([]*sessionEntities.Session{{}}, nil)
+
+		result, err := NewSessionService(repositoryMock, nil).ListSessions(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+	})
+}
+
+func TestRevokeSession(t *testing.T) {
+	t.Run("should success revoke session", func(t *testing.T) {
+		repositoryMock := newSessionMock(nil)
+
+		assert.NoError(t, NewSessionService(repositoryMock, nil).RevokeSession(uuid.New(), uuid.New()))
+	})
+}
+
+func TestRevokeOtherSessions(t *testing.T) {
+	t.Run("should keep the session of the refresh token", func(t *testing.T) {
+		session, refreshToken := sessionEntities.NewSession(uuid.New(), &sessionEntities.Device{})
+
+		repositoryMock := newSessionMock(session)
+
+		assert.NoError(t, NewSessionService(repositoryMock, nil).RevokeOtherSessions(session.AccountID, refreshToken))
+		repositoryMock.AssertCalled(t, "RevokeAccountSessions")
+	})
+
+	t.Run("should revoke all sessions when there is no refresh token", func(t *testing.T) {
+		repositoryMock := newSessionMock(nil)
+
+		assert.NoError(t, NewSessionService(repositoryMock, nil).RevokeOtherSessions(uuid.New(), ""))
+		repositoryMock.AssertCalled(t, "RevokeAccountSessions")
+	})
+}
+
+func TestRevokeAccountSessionsByAdmin(t *testing.T) {
+	t.Run("should revoke all sessions when actor is application admin", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{IsApplicationAdmin: true}, nil)
+
+		repositoryMock := newSessionMock(nil)
+
+		assert.NoError(t, NewSessionService(repositoryMock, accountRepositoryMock).
+			RevokeAccountSessionsByAdmin(uuid.New(), uuid.New()))
+		repositoryMock.AssertCalled(t, "RevokeAccountSessions")
+	})
+
+	t.Run("should return error when actor is not application admin", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, nil)
+
+		assert.Equal(t, sessionEnums.ErrorRevokeNotAllowed, NewSessionService(newSessionMock(nil),
+			accountRepositoryMock).RevokeAccountSessionsByAdmin(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return error when failed to get actor", func(t *testing.T) {
+		accountRepositoryMock := &accountRepository.Mock{}
+		accountRepositoryMock.On("GetAccount").Return(&accountEntities.Account{}, errors.New("test"))
+
+		assert.Error(t, NewSessionService(newSessionMock(nil), accountRepositoryMock).
+			RevokeAccountSessionsByAdmin(uuid.New(), uuid.New()))
+	})
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	t.Run("should revoke all sessions of the account", func(t *testing.T) {
+		repositoryMock := newSessionMock(nil)
+
+		assert.NoError(t, NewSessionService(repositoryMock, nil).RevokeAllSessions(uuid.New()))
+		repositoryMock.AssertCalled(t, "RevokeAccountSessions")
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
 )
 
 type IUseCases interface {
@@ -27,10 +28,11 @@ type IUseCases interface {
 	CheckCreateAccountErrors(err error) error
 	AccessTokenFromIOReadCloser(body io.ReadCloser) (*accountEntities.AccessToken, error)
 	AccountDataFromIOReadCloser(body io.ReadCloser) (*accountEntities.Data, error)
-	NewAccountValidationEmail(account *accountEntities.Account) []byte
+	NewAccountValidationEmail(account *accountEntities.Account, token string) []byte
 	EmailFromIOReadCloser(body io.ReadCloser) (*accountEntities.Email, error)
 	GenerateResetPasswordCode() string
 	NewResetPasswordCodeEmail(account *accountEntities.Account, code string) []byte
+	NewAccountUnlockEmail(account *accountEntities.Account, token string) []byte
 	ResetCodeDataFromIOReadCloser(body io.ReadCloser) (*accountEntities.ResetCodeData, error)
 	ChangePasswordDataFromIOReadCloser(body io.ReadCloser) (*accountEntities.ChangePasswordData, error)
 	RefreshTokenFromIOReadCloser(body io.ReadCloser) (*accountEntities.RefreshToken, error)
@@ -116,20 +118,21 @@ func (u *UseCases) AccountDataFromIOReadCloser(body io.ReadCloser) (*accountEnti
 	return data, data.Validate()
 }
 
-func (u *UseCases) NewAccountValidationEmail(account *accountEntities.Account) []byte {
+func (u *UseCases) NewAccountValidationEmail(account *accountEntities.Account, token string) []byte {
 	message := &emailEntities.Message{
 		To:           account.Email,
 		Subject:      "[Horusec] Account Confirmation Email",
 		TemplateName: emailEnums.AccountConfirmation,
 		Data: map[string]interface{}{"Username": account.Username,
-			"URL": u.getAccountValidationEmailURL(account.AccountID)},
+			"URL":            u.getAccountValidationEmailURL(token),
+			"ExpiresInHours": int(accountEnums.VerificationTokenDuration.Hours())},
 	}
 
 	return message.ToBytes()
 }
 
-func (u *UseCases) getAccountValidationEmailURL(accountID uuid.UUID) string {
-	return fmt.Sprintf("%s/auth/account/validate/%s", u.appConfig.GetHorusecAuthURL(), accountID)
+func (u *UseCases) getAccountValidationEmailURL(token string) string {
+	return fmt.Sprintf("%s/auth/account/validate/%s", u.appConfig.GetHorusecAuthURL(), token)
 }
 
 func (u *UseCases) EmailFromIOReadCloser(body io.ReadCloser) (*accountEntities.Email, error) {
@@ -170,6 +173,18 @@ func (u *UseCases) getResetPasswordCodeEmailURL(email, code string) string {
 		u.appConfig.GetHorusecManagerURL(), email, code)
 }
 
+func (u *UseCases) NewAccountUnlockEmail(account *accountEntities.Account, token string) []byte {
+	message := &emailEntities.Message{
+		To:           account.Email,
+		Subject:      "[Horusec] Account Temporarily Locked",
+		TemplateName: lockoutEnums.EmailTemplateAccountUnlock,
+		Data: map[string]interface{}{"Username": account.Username,
+			"URL": fmt.Sprintf("%s/auth/account/unlock/%s", u.appConfig.GetHorusecAuthURL(), token)},
+	}
+
+	return message.ToBytes()
+}
+
 func (u *UseCases) ResetCodeDataFromIOReadCloser(body io.ReadCloser) (*accountEntities.ResetCodeData, error) {
 	data := &accountEntities.ResetCodeData{}
 
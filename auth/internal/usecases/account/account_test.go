@@ -19,6 +19,7 @@ import (
 	"github.com/ZupIT/horusec-platform/auth/config/app"
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
+	lockoutEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/lockout"
 )
 
 func getAppConfig() app.IConfig {
@@ -214,7 +215,7 @@ func TestNewAccountValidationEmail(t *testing.T) {
 			Username: "test",
 		}
 
-		emailBytes := useCases.NewAccountValidationEmail(account)
+		emailBytes := useCases.NewAccountValidationEmail(account, "test-token")
 		assert.NotNil(t, emailBytes)
 		assert.NotEmpty(t, emailBytes)
 
@@ -228,9 +229,9 @@ func TestNewAccountValidationEmail(t *testing.T) {
 		assert.NotPanics(t, func() {
 			data := email.Data.(map[string]interface{})
 
-			assert.Equal(t, "http://localhost:8006/auth/account/validate/"+
-				"00000000-0000-0000-0000-000000000000", data["URL"])
+			assert.Equal(t, "http://localhost:8006/auth/account/validate/test-token", data["URL"])
 			assert.Equal(t, "test", data["Username"])
+			assert.Equal(t, float64(24), data["ExpiresInHours"])
 
 		})
 	})
@@ -307,6 +308,33 @@ func TestNewResetPasswordCodeEmail(t *testing.T) {
 	})
 }
 
+func TestNewAccountUnlockEmail(t *testing.T) {
+	t.Run("should success create a new account unlock email", func(t *testing.T) {
+		useCases := NewAccountUseCases(getAppConfig())
+
+		account := &accountEntities.Account{
+			Email:    "test@test.com",
+			Username: "test",
+		}
+
+		emailBytes := useCases.NewAccountUnlockEmail(account, "test")
+		assert.NotEmpty(t, emailBytes)
+
+		email := &emailEntities.Message{}
+		assert.NoError(t, json.Unmarshal(emailBytes, email))
+
+		assert.Equal(t, "test@test.com", email.To)
+		assert.Equal(t, lockoutEnums.EmailTemplateAccountUnlock, email.TemplateName)
+
+		assert.NotPanics(t, func() {
+			data := email.Data.(map[string]interface{})
+
+			assert.Equal(t, "http://localhost:8006/auth/account/unlock/test", data["URL"])
+			assert.Equal(t, "test", data["Username"])
+		})
+	})
+}
+
 func TestResetCodeDataFromIOReadCloser(t *testing.T) {
 	t.Run("should success get data from request body", func(t *testing.T) {
 		useCases := NewAccountUseCases(getAppConfig())
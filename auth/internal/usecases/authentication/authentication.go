@@ -11,6 +11,7 @@ import (
 
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
 )
 
@@ -43,6 +44,10 @@ func (u *UseCases) CheckLoginData(credentials *authEntities.LoginCredentials, ac
 		return horusecAuthEnums.ErrorAccountEmailNotConfirmed
 	}
 
+	if account.IsDisabled {
+		return accountEnums.ErrorAccountDisabled
+	}
+
 	return nil
 }
 
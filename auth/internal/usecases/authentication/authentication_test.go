@@ -14,6 +14,7 @@ import (
 
 	accountEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/account"
 	authEntities "github.com/ZupIT/horusec-platform/auth/internal/entities/authentication"
+	accountEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/account"
 	horusecAuthEnums "github.com/ZupIT/horusec-platform/auth/internal/enums/authentication/horusec"
 )
 
@@ -72,6 +73,27 @@ func TestCheckLoginData(t *testing.T) {
 		assert.Equal(t, horusecAuthEnums.ErrorAccountEmailNotConfirmed, err)
 	})
 
+	t.Run("should return error when account is disabled", func(t *testing.T) {
+		useCases := NewAuthenticationUseCases()
+
+		credentials := &authEntities.LoginCredentials{
+			Username: "test@test.com",
+			Password: "test",
+		}
+
+		hash, _ := crypto.HashPasswordBcrypt("test")
+		account := &accountEntities.Account{
+			AccountID:   uuid.New(),
+			Email:       "test@test.com",
+			Password:    hash,
+			Username:    "test",
+			IsConfirmed: true,
+			IsDisabled:  true,
+		}
+
+		assert.Equal(t, accountEnums.ErrorAccountDisabled, useCases.CheckLoginData(credentials, account))
+	})
+
 	t.Run("should no error when invalid email", func(t *testing.T) {
 		useCases := NewAuthenticationUseCases()
 
@@ -0,0 +1,111 @@
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	httpRouter "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
+	routerEnums "github.com/ZupIT/horusec-devkit/pkg/services/http/router/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+)
+
+const (
+	EnvTrustedProxies = "HORUSEC_TRUSTED_PROXIES"
+	HeaderForwarded   = "X-Forwarded-For"
+)
+
+type ctxKey string
+
+const connectionAddress ctxKey = "connectionAddress"
+
+// ListenAndServe replaces the listen and serve of the router keeping the address of the connection in the request
+// context, the real ip middleware of the router overwrites the remote address with the forwarded headers
+func ListenAndServe(router httpRouter.IRouter) {
+	server := &http.Server{
+		Addr:        fmt.Sprintf(":%s", router.GetPort()),
+		Handler:     router.GetMux(),
+		ConnContext: connContext,
+	}
+
+	logger.LogInfo(fmt.Sprintf(routerEnums.MessageServiceRunningOnPort, router.GetPort()))
+	logger.LogPanic(routerEnums.MessageListenAndServeError, server.ListenAndServe())
+}
+
+func connContext(ctx context.Context, conn net.Conn) context.Context {
+	return WithConnectionAddress(ctx, conn.RemoteAddr().String())
+}
+
+func WithConnectionAddress(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, connectionAddress, address)
+}
+
+// Get returns the ip of the connection, the forwarded addresses are only used when the connection comes from one of
+// the proxies configured as trusted, skipping from the right the addresses added by the trusted proxies
+func Get(r *http.Request) string {
+	connectionIP := getConnectionIP(r)
+
+	trustedProxies := getTrustedProxies()
+	if !isTrusted(connectionIP, trustedProxies) {
+		return connectionIP
+	}
+
+	return getForwardedIP(r, connectionIP, trustedProxies)
+}
+
+// getConnectionIP falls back to the remote address when the server was not started by the listen and serve above
+func getConnectionIP(r *http.Request) string {
+	address, ok := r.Context().Value(connectionAddress).(string)
+	if !ok {
+		address = r.RemoteAddr
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+
+	return host
+}
+
+func getForwardedIP(r *http.Request, connectionIP string, trustedProxies []*net.IPNet) string {
+	forwarded := strings.Split(strings.Join(r.Header.Values(HeaderForwarded), ","), ",")
+
+	for index := len(forwarded) - 1; index >= 0; index-- {
+		ip := strings.TrimSpace(forwarded[index])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+
+		if !isTrusted(ip, trustedProxies) {
+			return ip
+		}
+	}
+
+	return connectionIP
+}
+
+func getTrustedProxies() (trustedProxies []*net.IPNet) {
+	for _, cidr := range strings.Split(env.GetEnvOrDefault(EnvTrustedProxies, ""), ",") {
+		if _, network, err := net.ParseCIDR(strings.TrimSpace(cidr)); err == nil {
+			trustedProxies = append(trustedProxies, network)
+		}
+	}
+
+	return trustedProxies
+}
+
+func isTrusted(ip string, trustedProxies []*net.IPNet) bool {
+	parsedIP := net.ParseIP(ip)
+
+	for _, network := range trustedProxies {
+		if parsedIP != nil && network.Contains(parsedIP) {
+			return true
+		}
+	}
+
+	return false
+}
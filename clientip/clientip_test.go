@@ -0,0 +1,81 @@
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/go-chi/chi/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func getClientIP(remoteAddress, connectionAddress string, forwarded ...string) string {
+	clientIP := ""
+	handler := middleware.RealIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientIP = Get(r)
+	}))
+
+	req, _ := http.NewRequest(http.MethodGet, "http://test", nil)
+	req.RemoteAddr = remoteAddress
+	for _, value := range forwarded {
+		req.Header.Add(HeaderForwarded, value)
+	}
+
+	if connectionAddress != "" {
+		req = req.WithContext(WithConnectionAddress(req.Context(), connectionAddress))
+	}
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return clientIP
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should return the connection ip ignoring the forwarded header of untrusted connections", func(t *testing.T) {
+		assert.Equal(t, "192.168.0.1", getClientIP("192.168.0.1:5000", "192.168.0.1:5000", "10.0.0.1"))
+	})
+
+	t.Run("should return the remote address when there is no connection address", func(t *testing.T) {
+		assert.Equal(t, "192.168.0.1", getClientIP("192.168.0.1:5000", ""))
+	})
+
+	t.Run("should return the remote address when it has no port", func(t *testing.T) {
+		assert.Equal(t, "192.168.0.1", getClientIP("192.168.0.1", "192.168.0.1"))
+	})
+
+	t.Run("should return the last untrusted forwarded ip when connection is a trusted proxy", func(t *testing.T) {
+		_ = os.Setenv(EnvTrustedProxies, "172.16.0.0/12, invalid")
+		defer os.Unsetenv(EnvTrustedProxies)
+
+		assert.Equal(t, "10.0.0.2",
+			getClientIP("172.16.0.1:5000", "172.16.0.1:5000", "10.0.0.1, 10.0.0.2", "172.16.0.2"))
+	})
+
+	t.Run("should return the connection ip when the forwarded ips are invalid", func(t *testing.T) {
+		_ = os.Setenv(EnvTrustedProxies, "172.16.0.0/12")
+		defer os.Unsetenv(EnvTrustedProxies)
+
+		assert.Equal(t, "172.16.0.1", getClientIP("172.16.0.1:5000", "172.16.0.1:5000", "invalid"))
+	})
+
+	t.Run("should return the connection ip when all forwarded ips are trusted", func(t *testing.T) {
+		_ = os.Setenv(EnvTrustedProxies, "172.16.0.0/12")
+		defer os.Unsetenv(EnvTrustedProxies)
+
+		assert.Equal(t, "172.16.0.1", getClientIP("172.16.0.1:5000", "172.16.0.1:5000", "172.16.0.2"))
+	})
+}
+
+func TestConnContext(t *testing.T) {
+	t.Run("should keep the remote address of the connection in the context", func(t *testing.T) {
+		server, client := net.Pipe()
+		defer server.Close()
+		defer client.Close()
+
+		ctx := connContext(context.Background(), server)
+
+		assert.Equal(t, server.RemoteAddr().String(), ctx.Value(connectionAddress))
+	})
+}
@@ -0,0 +1,11 @@
+module github.com/ZupIT/horusec-platform/clientip
+
+go 1.16
+
+require (
+	github.com/ZupIT/horusec-devkit v1.0.3
+	github.com/go-chi/chi v4.1.2+incompatible
+	github.com/stretchr/testify v1.7.0
+	golang.org/x/net v0.0.0-20210504132125-bbd867fde50d // indirect
+	golang.org/x/sys v0.0.0-20210503173754-0981d6026fa6 // indirect
+)
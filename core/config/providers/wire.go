@@ -16,18 +16,56 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
 	"github.com/ZupIT/horusec-platform/core/config/cors"
+	auditController "github.com/ZupIT/horusec-platform/core/internal/controllers/audit"
+	customRoleController "github.com/ZupIT/horusec-platform/core/internal/controllers/customrole"
+	importerController "github.com/ZupIT/horusec-platform/core/internal/controllers/importer"
+	invitationController "github.com/ZupIT/horusec-platform/core/internal/controllers/invitation"
+	quotaController "github.com/ZupIT/horusec-platform/core/internal/controllers/quota"
 	repositoryController "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	teamController "github.com/ZupIT/horusec-platform/core/internal/controllers/team"
 	workspaceController "github.com/ZupIT/horusec-platform/core/internal/controllers/workspace"
+	archiveEvents "github.com/ZupIT/horusec-platform/core/internal/events/archive"
+	auditEvents "github.com/ZupIT/horusec-platform/core/internal/events/audit"
+	importerEvents "github.com/ZupIT/horusec-platform/core/internal/events/importer"
+	invitationEvents "github.com/ZupIT/horusec-platform/core/internal/events/invitation"
+	tokenEvents "github.com/ZupIT/horusec-platform/core/internal/events/token"
+	auditHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/audit"
+	customRoleHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/customrole"
 	healthHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	importerHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/importer"
+	invitationHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/invitation"
+	quotaHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/quota"
 	repositoryHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
+	teamHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/team"
 	workspaceHandler "github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	archiveRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/archive"
+	auditRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/audit"
+	customRoleRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/customrole"
+	importerRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/importer"
+	invitationRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/invitation"
+	quotaRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/quota"
 	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	teamRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/team"
+	tokenRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
 	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
 	"github.com/ZupIT/horusec-platform/core/internal/router"
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	encryptionService "github.com/ZupIT/horusec-platform/core/internal/services/encryption"
+	quotaService "github.com/ZupIT/horusec-platform/core/internal/services/quota"
+	scmService "github.com/ZupIT/horusec-platform/core/internal/services/scm"
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
 	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	roleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/role"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 var devKitProviders = wire.NewSet(
@@ -45,17 +83,30 @@ var devKitProviders = wire.NewSet(
 var configProviders = wire.NewSet(
 	cors.NewCorsConfig,
 	router.NewHTTPRouter,
+	permissionMiddleware.NewPermissionMiddleware,
 )
 
 var controllerProviders = wire.NewSet(
 	workspaceController.NewWorkspaceController,
 	repositoryController.NewRepositoryController,
+	invitationController.NewInvitationController,
+	teamController.NewTeamController,
+	importerController.NewImporterController,
+	auditController.NewAuditController,
+	customRoleController.NewCustomRoleController,
+	quotaController.NewQuotaController,
 )
 
 var handleProviders = wire.NewSet(
 	workspaceHandler.NewWorkspaceHandler,
 	repositoryHandler.NewRepositoryHandler,
 	healthHandler.NewHealthHandler,
+	invitationHandler.NewInvitationHandler,
+	teamHandler.NewTeamHandler,
+	importerHandler.NewImporterHandler,
+	auditHandler.NewAuditHandler,
+	customRoleHandler.NewCustomRoleHandler,
+	quotaHandler.NewQuotaHandler,
 )
 
 var useCasesProviders = wire.NewSet(
@@ -63,16 +114,47 @@ var useCasesProviders = wire.NewSet(
 	repositoryUseCases.NewRepositoryUseCases,
 	roleUseCases.NewRoleUseCases,
 	token.NewTokenUseCases,
+	invitationUseCases.NewInvitationUseCases,
+	teamUseCases.NewTeamUseCases,
+	importerUseCases.NewImporterUseCases,
+	auditUseCases.NewAuditUseCases,
+	customRoleUseCases.NewCustomRoleUseCases,
+	quotaUseCases.NewQuotaUseCases,
 )
 
 var repositoriesProviders = wire.NewSet(
 	workspaceRepository.NewWorkspaceRepository,
 	repositoryRepository.NewRepositoryRepository,
+	archiveRepository.NewArchiveRepository,
+	invitationRepository.NewInvitationRepository,
+	teamRepository.NewTeamRepository,
+	tokenRepository.NewTokenRepository,
+	importerRepository.NewImporterRepository,
+	auditRepository.NewAuditRepository,
+	customRoleRepository.NewCustomRoleRepository,
+	quotaRepository.NewQuotaRepository,
+)
+
+var servicesProviders = wire.NewSet(
+	archiveService.NewArchiveService,
+	tokenService.NewTokenService,
+	scmService.NewSCMService,
+	encryptionService.NewEncryptionService,
+	auditService.NewAuditService,
+	quotaService.NewQuotaService,
+)
+
+var eventsProviders = wire.NewSet(
+	archiveEvents.NewArchiveEvents,
+	tokenEvents.NewTokenEvents,
+	importerEvents.NewImporterEvents,
+	auditEvents.NewAuditEvents,
+	invitationEvents.NewInvitationEvents,
 )
 
 func Initialize(_ string) (router.IRouter, error) {
 	wire.Build(devKitProviders, configProviders, controllerProviders, handleProviders,
-		useCasesProviders, repositoriesProviders)
+		useCasesProviders, repositoriesProviders, servicesProviders, eventsProviders)
 
 	return &router.Router{}, nil
 }
@@ -18,18 +18,56 @@ import (
 	"github.com/google/wire"
 
 	"github.com/ZupIT/horusec-platform/core/config/cors"
+	audit4 "github.com/ZupIT/horusec-platform/core/internal/controllers/audit"
+	customrole3 "github.com/ZupIT/horusec-platform/core/internal/controllers/customrole"
+	importer3 "github.com/ZupIT/horusec-platform/core/internal/controllers/importer"
+	invitation3 "github.com/ZupIT/horusec-platform/core/internal/controllers/invitation"
+	quota4 "github.com/ZupIT/horusec-platform/core/internal/controllers/quota"
 	repository3 "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	team3 "github.com/ZupIT/horusec-platform/core/internal/controllers/team"
 	workspace3 "github.com/ZupIT/horusec-platform/core/internal/controllers/workspace"
+	archive3 "github.com/ZupIT/horusec-platform/core/internal/events/archive"
+	audit6 "github.com/ZupIT/horusec-platform/core/internal/events/audit"
+	importer5 "github.com/ZupIT/horusec-platform/core/internal/events/importer"
+	invitation5 "github.com/ZupIT/horusec-platform/core/internal/events/invitation"
+	token4 "github.com/ZupIT/horusec-platform/core/internal/events/token"
+	audit5 "github.com/ZupIT/horusec-platform/core/internal/handlers/audit"
+	customrole4 "github.com/ZupIT/horusec-platform/core/internal/handlers/customrole"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	importer4 "github.com/ZupIT/horusec-platform/core/internal/handlers/importer"
+	invitation4 "github.com/ZupIT/horusec-platform/core/internal/handlers/invitation"
+	quota5 "github.com/ZupIT/horusec-platform/core/internal/handlers/quota"
 	repository4 "github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
+	team4 "github.com/ZupIT/horusec-platform/core/internal/handlers/team"
 	workspace4 "github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	"github.com/ZupIT/horusec-platform/core/internal/repositories/archive"
+	audit3 "github.com/ZupIT/horusec-platform/core/internal/repositories/audit"
+	customrole2 "github.com/ZupIT/horusec-platform/core/internal/repositories/customrole"
+	importer2 "github.com/ZupIT/horusec-platform/core/internal/repositories/importer"
+	invitation2 "github.com/ZupIT/horusec-platform/core/internal/repositories/invitation"
+	quota2 "github.com/ZupIT/horusec-platform/core/internal/repositories/quota"
 	repository2 "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	team2 "github.com/ZupIT/horusec-platform/core/internal/repositories/team"
+	token2 "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
 	workspace2 "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
 	"github.com/ZupIT/horusec-platform/core/internal/router"
+	archive2 "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+	"github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	"github.com/ZupIT/horusec-platform/core/internal/services/encryption"
+	quota3 "github.com/ZupIT/horusec-platform/core/internal/services/quota"
+	"github.com/ZupIT/horusec-platform/core/internal/services/scm"
+	token3 "github.com/ZupIT/horusec-platform/core/internal/services/token"
+	audit2 "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+	"github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+	"github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+	"github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+	"github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/role"
+	"github.com/ZupIT/horusec-platform/core/internal/usecases/team"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 	"github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
+	"github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 // Injectors from wire.go:
@@ -54,15 +92,53 @@ func Initialize(string2 string) (router.IRouter, error) {
 	iUseCases := workspace.NewWorkspaceUseCases()
 	iRepository := workspace2.NewWorkspaceRepository(connection, iUseCases)
 	tokenIUseCases := token.NewTokenUseCases()
-	iController := workspace3.NewWorkspaceController(iBroker, connection, appIConfig, iUseCases, iRepository, tokenIUseCases)
-	roleIUseCases := role.NewRoleUseCases()
-	handler := workspace4.NewWorkspaceHandler(iController, iUseCases, authServiceClient, appIConfig, roleIUseCases, tokenIUseCases)
 	repositoryIUseCases := repository.NewRepositoryUseCases()
-	repositoryIRepository := repository2.NewRepositoryRepository(connection, repositoryIUseCases, iRepository)
-	repositoryIController := repository3.NewRepositoryController(iBroker, connection, appIConfig, repositoryIUseCases, repositoryIRepository, tokenIUseCases)
-	repositoryHandler := repository4.NewRepositoryHandler(repositoryIUseCases, repositoryIController, appIConfig, authServiceClient, roleIUseCases, tokenIUseCases)
+	archiveIRepository := archive.NewArchiveRepository(connection, iUseCases, repositoryIUseCases)
+	archiveIService := archive2.NewArchiveService(iBroker, archiveIRepository)
+	tokenIRepository := token2.NewTokenRepository(connection, tokenIUseCases)
+	tokenIService := token3.NewTokenService(iBroker, appIConfig, tokenIUseCases, tokenIRepository)
+	quotaIUseCases := quota.NewQuotaUseCases()
+	quotaIRepository := quota2.NewQuotaRepository(connection, quotaIUseCases)
+	quotaIService := quota3.NewQuotaService(quotaIRepository)
+	iController := workspace3.NewWorkspaceController(iBroker, connection, appIConfig, iUseCases, iRepository, tokenIUseCases, archiveIService, tokenIService, quotaIService)
+	roleIUseCases := role.NewRoleUseCases()
+	auditIService := audit.NewAuditService(iBroker, authServiceClient)
+	handler := workspace4.NewWorkspaceHandler(iController, iUseCases, authServiceClient, appIConfig, roleIUseCases, tokenIUseCases, auditIService)
+	teamIUseCases := team.NewTeamUseCases()
+	teamIRepository := team2.NewTeamRepository(connection, teamIUseCases)
+	repositoryIRepository := repository2.NewRepositoryRepository(connection, repositoryIUseCases, iRepository, teamIRepository)
+	repositoryIController := repository3.NewRepositoryController(iBroker, connection, appIConfig, repositoryIUseCases, repositoryIRepository, tokenIUseCases, archiveIService, tokenIService, quotaIService)
+	repositoryHandler := repository4.NewRepositoryHandler(repositoryIUseCases, repositoryIController, appIConfig, authServiceClient, roleIUseCases, tokenIUseCases, auditIService)
 	healthHandler := health.NewHealthHandler(connection, iBroker)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, repositoryHandler, healthHandler)
+	events := archive3.NewArchiveEvents(archiveIService)
+	tokenEvents := token4.NewTokenEvents(tokenIService)
+	invitationIUseCases := invitation.NewInvitationUseCases()
+	invitationIRepository := invitation2.NewInvitationRepository(connection, invitationIUseCases)
+	invitationIController := invitation3.NewInvitationController(iBroker, connection, appIConfig, invitationIUseCases, invitationIRepository, iRepository, repositoryIRepository)
+	invitationHandler := invitation4.NewInvitationHandler(invitationIController, invitationIUseCases, authServiceClient, auditIService)
+	teamIController := team3.NewTeamController(connection, teamIUseCases, teamIRepository, iRepository, repositoryIRepository)
+	teamHandler := team4.NewTeamHandler(teamIController, teamIUseCases, auditIService)
+	importerIUseCases := importer.NewImporterUseCases()
+	importerIRepository := importer2.NewImporterRepository(connection, importerIUseCases)
+	scmIService := scm.NewSCMService()
+	encryptionIService := encryption.NewEncryptionService()
+	importerIController := importer3.NewImporterController(connection, importerIUseCases, importerIRepository, repositoryIController, scmIService, encryptionIService)
+	importerHandler := importer4.NewImporterHandler(importerIController, importerIUseCases, authServiceClient, auditIService)
+	importerEvents := importer5.NewImporterEvents(importerIController)
+	auditIUseCases := audit2.NewAuditUseCases()
+	auditIRepository := audit3.NewAuditRepository(connection, auditIUseCases)
+	auditIController := audit4.NewAuditController(connection, auditIRepository, auditIUseCases)
+	auditHandler := audit5.NewAuditHandler(auditIController, auditIUseCases)
+	auditEvents := audit6.NewAuditEvents(iBroker, auditIController)
+	iMiddleware := middleware.NewPermissionMiddleware(authServiceClient)
+	customroleIUseCases := customrole.NewCustomRoleUseCases()
+	customroleIRepository := customrole2.NewCustomRoleRepository(connection, customroleIUseCases)
+	customroleIController := customrole3.NewCustomRoleController(connection, customroleIUseCases, customroleIRepository)
+	customroleHandler := customrole4.NewCustomRoleHandler(customroleIController, customroleIUseCases, auditIService)
+	quotaIController := quota4.NewQuotaController(connection, quotaIUseCases, quotaIRepository)
+	quotaHandler := quota5.NewQuotaHandler(quotaIController, quotaIUseCases, auditIService)
+	invitationEvents := invitation5.NewInvitationEvents(iBroker, invitationIController)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, repositoryHandler, healthHandler, events, tokenEvents, invitationHandler, teamHandler, importerHandler, importerEvents, auditHandler, auditEvents, iMiddleware, customroleHandler, quotaHandler, invitationEvents)
 	return routerIRouter, nil
 }
 
@@ -70,12 +146,16 @@ func Initialize(string2 string) (router.IRouter, error) {
 
 var devKitProviders = wire.NewSet(config.NewBrokerConfig, broker.NewBroker, config2.NewDatabaseConfig, database.NewDatabaseReadAndWrite, router2.NewHTTPRouter, auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, app.NewAppConfig, middlewares.NewAuthzMiddleware)
 
-var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter)
+var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter, middleware.NewPermissionMiddleware)
+
+var controllerProviders = wire.NewSet(workspace3.NewWorkspaceController, repository3.NewRepositoryController, invitation3.NewInvitationController, team3.NewTeamController, importer3.NewImporterController, audit4.NewAuditController, customrole3.NewCustomRoleController, quota4.NewQuotaController)
+
+var handleProviders = wire.NewSet(workspace4.NewWorkspaceHandler, repository4.NewRepositoryHandler, health.NewHealthHandler, invitation4.NewInvitationHandler, team4.NewTeamHandler, importer4.NewImporterHandler, audit5.NewAuditHandler, customrole4.NewCustomRoleHandler, quota5.NewQuotaHandler)
 
-var controllerProviders = wire.NewSet(workspace3.NewWorkspaceController, repository3.NewRepositoryController)
+var useCasesProviders = wire.NewSet(workspace.NewWorkspaceUseCases, repository.NewRepositoryUseCases, role.NewRoleUseCases, token.NewTokenUseCases, invitation.NewInvitationUseCases, team.NewTeamUseCases, importer.NewImporterUseCases, audit2.NewAuditUseCases, customrole.NewCustomRoleUseCases, quota.NewQuotaUseCases)
 
-var handleProviders = wire.NewSet(workspace4.NewWorkspaceHandler, repository4.NewRepositoryHandler, health.NewHealthHandler)
+var repositoriesProviders = wire.NewSet(workspace2.NewWorkspaceRepository, repository2.NewRepositoryRepository, archive.NewArchiveRepository, invitation2.NewInvitationRepository, team2.NewTeamRepository, token2.NewTokenRepository, importer2.NewImporterRepository, audit3.NewAuditRepository, customrole2.NewCustomRoleRepository, quota2.NewQuotaRepository)
 
-var useCasesProviders = wire.NewSet(workspace.NewWorkspaceUseCases, repository.NewRepositoryUseCases, role.NewRoleUseCases, token.NewTokenUseCases)
+var servicesProviders = wire.NewSet(archive2.NewArchiveService, token3.NewTokenService, scm.NewSCMService, encryption.NewEncryptionService, audit.NewAuditService, quota3.NewQuotaService)
 
-var repositoriesProviders = wire.NewSet(workspace2.NewWorkspaceRepository, repository2.NewRepositoryRepository)
+var eventsProviders = wire.NewSet(archive3.NewArchiveEvents, token4.NewTokenEvents, importer5.NewImporterEvents, audit6.NewAuditEvents, invitation5.NewInvitationEvents)
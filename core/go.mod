@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	github.com/ZupIT/horusec-devkit v1.0.3
+	github.com/ZupIT/horusec-platform/permission v0.0.0
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.2.0
@@ -11,6 +12,8 @@ require (
 	github.com/google/uuid v1.2.0
 	github.com/google/wire v0.5.0
 	github.com/lib/pq v1.10.1
+	github.com/pkg/errors v0.9.1
+	github.com/streadway/amqp v1.0.0
 	github.com/stretchr/testify v1.7.0
 	github.com/swaggo/swag v1.7.0
 	golang.org/x/crypto v0.0.0-20210503195802-e9a32991a82e // indirect
@@ -18,3 +21,5 @@ require (
 	golang.org/x/sys v0.0.0-20210503173754-0981d6026fa6 // indirect
 	google.golang.org/genproto v0.0.0-20210504143626-3b2ad6ccc450 // indirect
 )
+
+replace github.com/ZupIT/horusec-platform/permission => ../permission
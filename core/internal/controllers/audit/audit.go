@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	auditRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/audit"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+)
+
+type IController interface {
+	SaveEvent(event *auditEntities.Event) error
+	ListEvents(filter *auditEntities.Filter) (*auditEntities.ListResponse, error)
+	ExportEvents(filter *auditEntities.Filter, format auditEnums.Format) ([]byte, error)
+	VerifyChain() (*auditEntities.Verification, error)
+}
+
+type Controller struct {
+	databaseWrite database.IDatabaseWrite
+	repository    auditRepository.IRepository
+	useCases      auditUseCases.IUseCases
+}
+
+func NewAuditController(databaseConnection *database.Connection, repository auditRepository.IRepository,
+	useCases auditUseCases.IUseCases) IController {
+	return &Controller{
+		databaseWrite: databaseConnection.Write,
+		repository:    repository,
+		useCases:      useCases,
+	}
+}
+
+// SaveEvent appends the event to the end of the chain, when another record is appended at the same time the unique
+// previous hash constraint rejects one of them, which is retried linked to the new last record. Events already
+// saved are ignored, since the same event can be delivered again by the broker
+func (c *Controller) SaveEvent(event *auditEntities.Event) error {
+	saved, err := c.repository.IsEventSaved(event.EventID)
+	if err != nil || saved {
+		return err
+	}
+
+	record := event.ToRecord()
+	for attempt := 0; attempt < auditEnums.MaxChainAttempts; attempt++ {
+		if err = c.appendRecord(record); err == nil {
+			return nil
+		}
+
+		logger.LogError(auditEnums.MessageChainConflictRetrying, err)
+	}
+
+	return errors.Wrap(err, auditEnums.ErrorChainConflict.Error())
+}
+
+func (c *Controller) appendRecord(record *auditEntities.Record) error {
+	previousHash, err := c.repository.GetLastHash()
+	if err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Create(record.SetChain(previousHash), auditEnums.DatabaseAuditTable).GetError()
+}
+
+func (c *Controller) ListEvents(filter *auditEntities.Filter) (*auditEntities.ListResponse, error) {
+	records, err := c.repository.ListRecords(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := c.repository.CountRecords(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEntities.NewListResponse(records, total), nil
+}
+
+func (c *Controller) ExportEvents(filter *auditEntities.Filter, format auditEnums.Format) ([]byte, error) {
+	records, err := c.repository.ListRecords(filter.SetExportPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	return c.useCases.ExportRecords(format, records)
+}
+
+// VerifyChain walks the whole chain in batches, checking that every record was not changed and is linked to the
+// record before it
+func (c *Controller) VerifyChain() (*auditEntities.Verification, error) {
+	verification := &auditEntities.Verification{Valid: true}
+	lastSequence, previousHash := int64(0), auditEnums.GenesisHash
+
+	for {
+		records, err := c.repository.ListChainAfter(lastSequence, auditEnums.VerifyBatchSize)
+		if err != nil || len(*records) == 0 {
+			return verification, err
+		}
+
+		if lastSequence, previousHash = c.verifyRecords(verification, records, previousHash); !verification.Valid {
+			return verification, nil
+		}
+	}
+}
+
+func (c *Controller) verifyRecords(verification *auditEntities.Verification, records *[]auditEntities.Record,
+	previousHash string) (lastSequence int64, lastHash string) {
+	for index := range *records {
+		record := (*records)[index]
+		if !record.IsLinkedTo(previousHash) {
+			verification.Valid = false
+			verification.BrokenAtSequence = record.Sequence
+			return record.Sequence, previousHash
+		}
+
+		verification.CheckedEvents++
+		lastSequence, previousHash = record.Sequence, record.Hash
+	}
+
+	return lastSequence, previousHash
+}
@@ -0,0 +1,34 @@
+package audit
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) SaveEvent(_ *auditEntities.Event) error {
+	args := m.MethodCalled("SaveEvent")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListEvents(_ *auditEntities.Filter) (*auditEntities.ListResponse, error) {
+	args := m.MethodCalled("ListEvents")
+	return args.Get(0).(*auditEntities.ListResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ExportEvents(_ *auditEntities.Filter, _ auditEnums.Format) ([]byte, error) {
+	args := m.MethodCalled("ExportEvents")
+	return args.Get(0).([]byte), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) VerifyChain() (*auditEntities.Verification, error) {
+	args := m.MethodCalled("VerifyChain")
+	return args.Get(0).(*auditEntities.Verification), mockUtils.ReturnNilOrError(args, 1)
+}
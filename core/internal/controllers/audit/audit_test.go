@@ -0,0 +1,202 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	auditRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/audit"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+)
+
+func newTestController(repositoryMock *auditRepository.Mock, databaseMock *database.Mock) IController {
+	return NewAuditController(&database.Connection{Read: databaseMock, Write: databaseMock}, repositoryMock,
+		auditUseCases.NewAuditUseCases())
+}
+
+func newChain(size int) *[]auditEntities.Record {
+	records := &[]auditEntities.Record{}
+	previousHash := auditEnums.GenesisHash
+
+	for index := 0; index < size; index++ {
+		event := auditEntities.NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, uuid.New())
+		record := event.ToRecord().SetChain(previousHash)
+		record.Sequence = int64(index + 1)
+
+		*records = append(*records, *record)
+		previousHash = record.Hash
+	}
+
+	return records
+}
+
+func TestNewAuditController(t *testing.T) {
+	t.Run("should success create a new audit controller", func(t *testing.T) {
+		assert.NotNil(t, NewAuditController(&database.Connection{}, &auditRepository.Mock{},
+			auditUseCases.NewAuditUseCases()))
+	})
+}
+
+func TestSaveEvent(t *testing.T) {
+	event := auditEntities.NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, uuid.New())
+
+	t.Run("should success append the event to the chain", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("IsEventSaved").Return(false, nil)
+		repositoryMock.On("GetLastHash").Return(auditEnums.GenesisHash, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, newTestController(repositoryMock, databaseMock).SaveEvent(event))
+		databaseMock.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("should retry linked to the new last record when the chain changed", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("IsEventSaved").Return(false, nil)
+		repositoryMock.On("GetLastHash").Return(auditEnums.GenesisHash, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil)).Once()
+		databaseMock.On("Create").Return(&response.Response{})
+
+		assert.NoError(t, newTestController(repositoryMock, databaseMock).SaveEvent(event))
+		databaseMock.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("should return error when failed to append after all attempts", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("IsEventSaved").Return(false, nil)
+		repositoryMock.On("GetLastHash").Return(auditEnums.GenesisHash, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newTestController(repositoryMock, databaseMock).SaveEvent(event))
+		databaseMock.AssertNumberOfCalls(t, "Create", auditEnums.MaxChainAttempts)
+	})
+
+	t.Run("should return error when failed to get last hash", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("IsEventSaved").Return(false, nil)
+		repositoryMock.On("GetLastHash").Return("", errors.New("test"))
+
+		databaseMock := &database.Mock{}
+
+		assert.Error(t, newTestController(repositoryMock, databaseMock).SaveEvent(event))
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should ignore events already saved", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("IsEventSaved").Return(true, nil)
+
+		databaseMock := &database.Mock{}
+
+		assert.NoError(t, newTestController(repositoryMock, databaseMock).SaveEvent(event))
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to check if event was saved", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("IsEventSaved").Return(false, errors.New("test"))
+
+		assert.Error(t, newTestController(repositoryMock, &database.Mock{}).SaveEvent(event))
+	})
+}
+
+func TestListEvents(t *testing.T) {
+	t.Run("should success list events with total", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListRecords").Return(newChain(2), nil)
+		repositoryMock.On("CountRecords").Return(10, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).ListEvents(&auditEntities.Filter{})
+		assert.NoError(t, err)
+		assert.Len(t, result.Events, 2)
+		assert.Equal(t, 10, result.Total)
+	})
+
+	t.Run("should return error when failed to list records", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListRecords").Return(&[]auditEntities.Record{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).ListEvents(&auditEntities.Filter{})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to count records", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListRecords").Return(newChain(1), nil)
+		repositoryMock.On("CountRecords").Return(0, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).ListEvents(&auditEntities.Filter{})
+		assert.Error(t, err)
+	})
+}
+
+func TestExportEvents(t *testing.T) {
+	t.Run("should success export events as csv", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListRecords").Return(newChain(2), nil)
+
+		filter := &auditEntities.Filter{}
+		result, err := newTestController(repositoryMock, &database.Mock{}).ExportEvents(filter,
+			auditEnums.FormatCSV)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, result)
+		assert.Equal(t, auditEnums.MaxExportSize, filter.Size)
+	})
+
+	t.Run("should return error when failed to list records", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListRecords").Return(&[]auditEntities.Record{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).ExportEvents(&auditEntities.Filter{},
+			auditEnums.FormatJSON)
+		assert.Error(t, err)
+	})
+}
+
+func TestVerifyChain(t *testing.T) {
+	t.Run("should return valid when all records are linked", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListChainAfter").Return(newChain(3), nil).Once()
+		repositoryMock.On("ListChainAfter").Return(&[]auditEntities.Record{}, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).VerifyChain()
+		assert.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Equal(t, 3, result.CheckedEvents)
+	})
+
+	t.Run("should return the first changed record", func(t *testing.T) {
+		chain := newChain(3)
+		(*chain)[1].ActorEmail = "changed@test.com"
+
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListChainAfter").Return(chain, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).VerifyChain()
+		assert.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, 1, result.CheckedEvents)
+		assert.Equal(t, int64(2), result.BrokenAtSequence)
+	})
+
+	t.Run("should return error when failed to list the chain", func(t *testing.T) {
+		repositoryMock := &auditRepository.Mock{}
+		repositoryMock.On("ListChainAfter").Return(&[]auditEntities.Record{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).VerifyChain()
+		assert.Error(t, err)
+	})
+}
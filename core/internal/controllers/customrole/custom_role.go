@@ -0,0 +1,96 @@
+package customrole
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	customRoleRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/customrole"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+)
+
+type IController interface {
+	Create(data *customRoleEntities.Data) (*customRoleEntities.Response, error)
+	Get(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.Response, error)
+	Update(data *customRoleEntities.Data) (*customRoleEntities.Response, error)
+	Delete(customRoleID, workspaceID uuid.UUID) error
+	List(workspaceID uuid.UUID) (*[]customRoleEntities.Response, error)
+}
+
+type Controller struct {
+	databaseWrite database.IDatabaseWrite
+	useCases      customRoleUseCases.IUseCases
+	repository    customRoleRepository.IRepository
+}
+
+func NewCustomRoleController(databaseConnection *database.Connection, useCases customRoleUseCases.IUseCases,
+	repository customRoleRepository.IRepository) IController {
+	return &Controller{
+		databaseWrite: databaseConnection.Write,
+		useCases:      useCases,
+		repository:    repository,
+	}
+}
+
+func (c *Controller) Create(data *customRoleEntities.Data) (*customRoleEntities.Response, error) {
+	if err := c.checkNameInUse(data.WorkspaceID, data.Name); err != nil {
+		return nil, err
+	}
+
+	customRole := data.ToCustomRole()
+	return customRole.ToResponse(), c.databaseWrite.Create(customRole,
+		customRoleEnums.DatabaseCustomRoleTable).GetError()
+}
+
+func (c *Controller) checkNameInUse(workspaceID uuid.UUID, name string) error {
+	_, err := c.repository.GetCustomRoleByName(workspaceID, name)
+	if err == nil {
+		return customRoleEnums.ErrorCustomRoleNameAlreadyInUse
+	}
+
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return nil
+	}
+
+	return err
+}
+
+func (c *Controller) Get(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.Response, error) {
+	customRole, err := c.repository.GetCustomRole(customRoleID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return customRole.ToResponse(), nil
+}
+
+func (c *Controller) Update(data *customRoleEntities.Data) (*customRoleEntities.Response, error) {
+	customRole, err := c.repository.GetCustomRole(data.CustomRoleID, data.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if customRole.Name != data.Name {
+		if err := c.checkNameInUse(data.WorkspaceID, data.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return customRole.Update(data).ToResponse(), c.databaseWrite.Update(customRole,
+		c.useCases.FilterCustomRoleByID(customRole.CustomRoleID, customRole.WorkspaceID),
+		customRoleEnums.DatabaseCustomRoleTable).GetError()
+}
+
+// Delete makes the accounts with this role fall back to their member role, since the foreign keys of the account
+// workspace and account repository tables are set to null on delete
+func (c *Controller) Delete(customRoleID, workspaceID uuid.UUID) error {
+	return c.databaseWrite.Delete(c.useCases.FilterCustomRoleByID(customRoleID, workspaceID),
+		customRoleEnums.DatabaseCustomRoleTable).GetError()
+}
+
+func (c *Controller) List(workspaceID uuid.UUID) (*[]customRoleEntities.Response, error) {
+	return c.repository.ListCustomRoles(workspaceID)
+}
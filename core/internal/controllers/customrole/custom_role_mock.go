@@ -0,0 +1,39 @@
+package customrole
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Create(_ *customRoleEntities.Data) (*customRoleEntities.Response, error) {
+	args := m.MethodCalled("Create")
+	return args.Get(0).(*customRoleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Get(_, _ uuid.UUID) (*customRoleEntities.Response, error) {
+	args := m.MethodCalled("Get")
+	return args.Get(0).(*customRoleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Update(_ *customRoleEntities.Data) (*customRoleEntities.Response, error) {
+	args := m.MethodCalled("Update")
+	return args.Get(0).(*customRoleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Delete(_, _ uuid.UUID) error {
+	args := m.MethodCalled("Delete")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) List(_ uuid.UUID) (*[]customRoleEntities.Response, error) {
+	args := m.MethodCalled("List")
+	return args.Get(0).(*[]customRoleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
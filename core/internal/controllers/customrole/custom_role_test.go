@@ -0,0 +1,159 @@
+package customrole
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	customRoleRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/customrole"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func newTestController(repositoryMock *customRoleRepository.Mock, databaseMock *database.Mock) IController {
+	return NewCustomRoleController(&database.Connection{Read: databaseMock, Write: databaseMock},
+		customRoleUseCases.NewCustomRoleUseCases(), repositoryMock)
+}
+
+func TestNewCustomRoleController(t *testing.T) {
+	t.Run("should success create a new custom role controller", func(t *testing.T) {
+		assert.NotNil(t, NewCustomRoleController(&database.Connection{}, customRoleUseCases.NewCustomRoleUseCases(),
+			&customRoleRepository.Mock{}))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	data := &customRoleEntities.Data{Name: "test", WorkspaceID: uuid.New(),
+		Permissions: []permissionEnums.Permission{permissionEnums.VulnerabilityRead}}
+
+	t.Run("should success create a custom role", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRoleByName").Return(&customRoleEntities.CustomRole{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		result, err := newTestController(repositoryMock, databaseMock).Create(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.Name, result.Name)
+		assert.Equal(t, data.WorkspaceID, result.WorkspaceID)
+		assert.Equal(t, []string{"vulnerability.read"}, []string(result.Permissions))
+	})
+
+	t.Run("should return error when name already in use", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRoleByName").Return(&customRoleEntities.CustomRole{}, nil)
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Create(data)
+		assert.Equal(t, customRoleEnums.ErrorCustomRoleNameAlreadyInUse, err)
+	})
+
+	t.Run("should return error when failed to check name", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRoleByName").Return(&customRoleEntities.CustomRole{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Create(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should success get a custom role", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{Name: "test"}, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).Get(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, "test", result.Name)
+	})
+
+	t.Run("should return error when failed to get custom role", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Get(uuid.New(), uuid.New())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	data := &customRoleEntities.Data{Name: "new", Description: "test",
+		Permissions: []permissionEnums.Permission{permissionEnums.AuditRead}}
+
+	t.Run("should success update custom role when name changed", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{Name: "old"}, nil)
+		repositoryMock.On("GetCustomRoleByName").Return(&customRoleEntities.CustomRole{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		result, err := newTestController(repositoryMock, databaseMock).Update(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "new", result.Name)
+		assert.Equal(t, "test", result.Description)
+		assert.Equal(t, []string{"audit.read"}, []string(result.Permissions))
+	})
+
+	t.Run("should success update custom role without checking the name when not changed", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{Name: "new"}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		_, err := newTestController(repositoryMock, databaseMock).Update(data)
+		assert.NoError(t, err)
+		repositoryMock.AssertNotCalled(t, "GetCustomRoleByName")
+	})
+
+	t.Run("should return error when new name already in use", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{Name: "old"}, nil)
+		repositoryMock.On("GetCustomRoleByName").Return(&customRoleEntities.CustomRole{}, nil)
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Update(data)
+		assert.Equal(t, customRoleEnums.ErrorCustomRoleNameAlreadyInUse, err)
+	})
+
+	t.Run("should return error when failed to get custom role", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Update(data)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should success delete a custom role", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, newTestController(&customRoleRepository.Mock{}, databaseMock).Delete(uuid.New(),
+			uuid.New()))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should success list custom roles", func(t *testing.T) {
+		repositoryMock := &customRoleRepository.Mock{}
+		repositoryMock.On("ListCustomRoles").Return(&[]customRoleEntities.Response{{Name: "test"}}, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).List(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+}
@@ -0,0 +1,253 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	repositoryController "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	importerRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/importer"
+	"github.com/ZupIT/horusec-platform/core/internal/services/encryption"
+	scmService "github.com/ZupIT/horusec-platform/core/internal/services/scm"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+)
+
+type IController interface {
+	Import(data *importerEntities.Data) (*importerEntities.Result, error)
+	Sync(importID, workspaceID uuid.UUID) (*importerEntities.Result, error)
+	SyncPeriodic(syncedBefore time.Time)
+	List(workspaceID uuid.UUID) (*[]importerEntities.Response, error)
+	Delete(importID, workspaceID uuid.UUID) error
+}
+
+type Controller struct {
+	databaseWrite        database.IDatabaseWrite
+	useCases             importerUseCases.IUseCases
+	repository           importerRepository.IRepository
+	repositoryController repositoryController.IController
+	scmService           scmService.IService
+	encryption           encryption.IService
+}
+
+func NewImporterController(databaseConnection *database.Connection, useCases importerUseCases.IUseCases,
+	repository importerRepository.IRepository, controllerRepository repositoryController.IController,
+	serviceSCM scmService.IService, serviceEncryption encryption.IService) IController {
+	return &Controller{
+		databaseWrite:        databaseConnection.Write,
+		useCases:             useCases,
+		repository:           repository,
+		repositoryController: controllerRepository,
+		scmService:           serviceSCM,
+		encryption:           serviceEncryption,
+	}
+}
+
+// Import lists the repositories of the organization before saving the import, so invalid credentials are not saved.
+// The access token is saved encrypted, since it is used again by each sync
+func (c *Controller) Import(data *importerEntities.Data) (*importerEntities.Result, error) {
+	if err := c.checkAlreadyImported(data); err != nil {
+		return nil, err
+	}
+
+	importation := data.ToImport()
+	remotes, err := c.scmService.ListRepositories(importation)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.createImport(importation, remotes, data.GenerateTokens)
+}
+
+func (c *Controller) createImport(importation *importerEntities.Import, remotes *[]importerEntities.RemoteRepository,
+	generateTokens bool) (*importerEntities.Result, error) {
+	encryptedAccessToken, err := c.encryption.Encrypt(importation.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := importation.WithAccessToken(encryptedAccessToken)
+	if err := c.databaseWrite.Create(encrypted, importerEnums.DatabaseImportTable).GetError(); err != nil {
+		return nil, err
+	}
+
+	return c.syncRepositories(encrypted, remotes, generateTokens)
+}
+
+func (c *Controller) checkAlreadyImported(data *importerEntities.Data) error {
+	_, err := c.repository.GetImportByOrganization(data)
+	if err == nil {
+		return importerEnums.ErrorImportAlreadyExists
+	}
+
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return nil
+	}
+
+	return err
+}
+
+func (c *Controller) Sync(importID, workspaceID uuid.UUID) (*importerEntities.Result, error) {
+	importation, err := c.repository.GetImport(importID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.sync(importation, importation.GenerateTokens)
+}
+
+func (c *Controller) sync(importation *importerEntities.Import,
+	generateTokens bool) (*importerEntities.Result, error) {
+	accessToken, err := c.encryption.Decrypt(importation.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	remotes, err := c.scmService.ListRepositories(importation.WithAccessToken(accessToken))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.syncRepositories(importation, remotes, generateTokens)
+}
+
+// SyncPeriodic syncs the imports claimed by this replica that were not synced after the informed time, it does not
+// generate tokens, since there is nobody to receive them
+func (c *Controller) SyncPeriodic(syncedBefore time.Time) {
+	logger.LogInfo(importerEnums.MessageSyncingPeriodicImports)
+
+	imports, err := c.repository.ClaimPeriodicImports(syncedBefore)
+	if err != nil {
+		logger.LogError(importerEnums.MessageFailedToClaimPeriodic, err)
+		return
+	}
+
+	for index := range *imports {
+		if _, err := c.sync(&(*imports)[index], false); err != nil {
+			logger.LogError(importerEnums.MessageFailedToSyncImport, err)
+		}
+	}
+}
+
+// syncRepositories creates the remote repositories that were not imported yet and archives the imported ones that
+// no longer exist in the provider
+func (c *Controller) syncRepositories(importation *importerEntities.Import,
+	remotes *[]importerEntities.RemoteRepository, generateTokens bool) (*importerEntities.Result, error) {
+	imported, err := c.repository.ListImportedRepositories(importation.ImportID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := importation.NewResult()
+	c.createMissing(result, importation, remotes, imported, generateTokens)
+	c.archiveRemoved(result, remotes, imported)
+
+	return result, c.databaseWrite.Update(importation.SetSynced().ToSyncUpdateMap(), c.useCases.FilterImportByID(
+		importation.ImportID, importation.WorkspaceID), importerEnums.DatabaseImportTable).GetError()
+}
+
+func (c *Controller) createMissing(result *importerEntities.Result, importation *importerEntities.Import,
+	remotes *[]importerEntities.RemoteRepository, imported *[]importerEntities.ImportedRepository,
+	generateTokens bool) {
+	importedNames := map[string]bool{}
+	for index := range *imported {
+		importedNames[(*imported)[index].Name] = true
+	}
+
+	for index := range *remotes {
+		if importedNames[(*remotes)[index].Name] {
+			result.AddExisting((*remotes)[index].Name)
+			continue
+		}
+
+		c.createRepository(result, importation, &(*remotes)[index], generateTokens)
+	}
+}
+
+func (c *Controller) createRepository(result *importerEntities.Result, importation *importerEntities.Import,
+	remote *importerEntities.RemoteRepository, generateTokens bool) {
+	repository, err := c.repositoryController.Create(remote.ToRepositoryData(importation))
+	if err != nil {
+		c.addCreateFailure(result, remote.Name, err)
+		return
+	}
+
+	token, err := c.setImported(repository.RepositoryID, importation, generateTokens)
+	if err != nil {
+		c.addCreateFailure(result, remote.Name, err)
+		return
+	}
+
+	result.AddCreated(repository.RepositoryID, remote.Name, token)
+}
+
+func (c *Controller) addCreateFailure(result *importerEntities.Result, name string, err error) {
+	if err == repositoryEnums.ErrorRepositoryNameAlreadyInUse {
+		result.AddSkipped(name)
+		return
+	}
+
+	logger.LogError(importerEnums.MessageFailedToImportRepository, err)
+	result.AddFailed(name)
+}
+
+func (c *Controller) setImported(repositoryID uuid.UUID, importation *importerEntities.Import,
+	generateTokens bool) (string, error) {
+	if err := c.databaseWrite.Update(c.useCases.ImportIDToUpdateMap(importation.ImportID),
+		c.useCases.FilterRepositoryByID(repositoryID), repositoryEnums.DatabaseRepositoryTable).GetError(); err != nil {
+		return "", err
+	}
+
+	if !generateTokens {
+		return "", nil
+	}
+
+	return c.repositoryController.CreateToken(&tokenEntities.Data{WorkspaceID: importation.WorkspaceID,
+		RepositoryID: repositoryID, Description: importerEnums.ImportedTokenDescription})
+}
+
+func (c *Controller) archiveRemoved(result *importerEntities.Result, remotes *[]importerEntities.RemoteRepository,
+	imported *[]importerEntities.ImportedRepository) {
+	remoteNames := map[string]bool{}
+	for index := range *remotes {
+		remoteNames[(*remotes)[index].Name] = true
+	}
+
+	for index := range *imported {
+		if !remoteNames[(*imported)[index].Name] {
+			c.archiveRepository(result, &(*imported)[index])
+		}
+	}
+}
+
+func (c *Controller) archiveRepository(result *importerEntities.Result,
+	repository *importerEntities.ImportedRepository) {
+	if err := c.repositoryController.Archive(repository.RepositoryID); err != nil {
+		logger.LogError(importerEnums.MessageFailedToArchiveRepository, err)
+		result.AddFailed(repository.Name)
+		return
+	}
+
+	result.AddArchived(repository.Name)
+}
+
+func (c *Controller) List(workspaceID uuid.UUID) (*[]importerEntities.Response, error) {
+	return c.repository.ListImports(workspaceID)
+}
+
+// Delete stops the sync of the organization, the repositories created by the import are kept
+func (c *Controller) Delete(importID, workspaceID uuid.UUID) error {
+	if _, err := c.repository.GetImport(importID, workspaceID); err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Delete(c.useCases.FilterImportByID(importID, workspaceID),
+		importerEnums.DatabaseImportTable).GetError()
+}
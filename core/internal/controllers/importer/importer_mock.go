@@ -0,0 +1,40 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Import(_ *importerEntities.Data) (*importerEntities.Result, error) {
+	args := m.MethodCalled("Import")
+	return args.Get(0).(*importerEntities.Result), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Sync(_, _ uuid.UUID) (*importerEntities.Result, error) {
+	args := m.MethodCalled("Sync")
+	return args.Get(0).(*importerEntities.Result), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) SyncPeriodic(_ time.Time) {
+	_ = m.MethodCalled("SyncPeriodic")
+}
+
+func (m *Mock) List(_ uuid.UUID) (*[]importerEntities.Response, error) {
+	args := m.MethodCalled("List")
+	return args.Get(0).(*[]importerEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Delete(_, _ uuid.UUID) error {
+	args := m.MethodCalled("Delete")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,302 @@
+package importer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	repositoryController "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	importerRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/importer"
+	"github.com/ZupIT/horusec-platform/core/internal/services/encryption"
+	scmService "github.com/ZupIT/horusec-platform/core/internal/services/scm"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+)
+
+func newTestController(repositoryMock *importerRepository.Mock, controllerMock *repositoryController.Mock,
+	scmMock *scmService.Mock, databaseMock *database.Mock) IController {
+	return newTestControllerWithEncryption(repositoryMock, controllerMock, scmMock, databaseMock,
+		newEncryptionMock(nil))
+}
+
+func newTestControllerWithEncryption(repositoryMock *importerRepository.Mock,
+	controllerMock *repositoryController.Mock, scmMock *scmService.Mock, databaseMock *database.Mock,
+	encryptionMock *encryption.Mock) IController {
+	return NewImporterController(&database.Connection{Read: databaseMock, Write: databaseMock},
+		importerUseCases.NewImporterUseCases(), repositoryMock, controllerMock, scmMock, encryptionMock)
+}
+
+func newEncryptionMock(err error) *encryption.Mock {
+	encryptionMock := &encryption.Mock{}
+	encryptionMock.On("Encrypt").Return("encrypted", err)
+	encryptionMock.On("Decrypt").Return("token", err)
+
+	return encryptionMock
+}
+
+func newRemotes(names ...string) *[]importerEntities.RemoteRepository {
+	remotes := &[]importerEntities.RemoteRepository{}
+
+	for _, name := range names {
+		*remotes = append(*remotes, importerEntities.RemoteRepository{Name: name})
+	}
+
+	return remotes
+}
+
+func TestNewImporterController(t *testing.T) {
+	t.Run("should success create a new importer controller", func(t *testing.T) {
+		assert.NotNil(t, NewImporterController(&database.Connection{}, importerUseCases.NewImporterUseCases(),
+			&importerRepository.Mock{}, &repositoryController.Mock{}, &scmService.Mock{}, &encryption.Mock{}))
+	})
+}
+
+func TestImport(t *testing.T) {
+	data := &importerEntities.Data{Provider: importerEnums.ProviderGithub, Organization: "horusec",
+		GenerateTokens: true}
+
+	t.Run("should success import the repositories and generate tokens", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImportByOrganization").Return(&importerEntities.Import{},
+			databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("ListImportedRepositories").Return(&[]importerEntities.ImportedRepository{}, nil)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes("created", "in-use", "failed"), nil)
+
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Create").Return(&repositoryEntities.Response{RepositoryID: uuid.New()}, nil).Once()
+		controllerMock.On("Create").Return(&repositoryEntities.Response{},
+			repositoryEnums.ErrorRepositoryNameAlreadyInUse).Once()
+		controllerMock.On("Create").Return(&repositoryEntities.Response{}, errors.New("test")).Once()
+		controllerMock.On("CreateToken").Return("token", nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Update").Return(&response.Response{})
+
+		result, err := newTestController(repositoryMock, controllerMock, scmMock, databaseMock).Import(data)
+		assert.NoError(t, err)
+		assert.Len(t, result.Created, 1)
+		assert.Equal(t, "token", result.Created[0].Token)
+		assert.Equal(t, []string{"in-use"}, result.Skipped)
+		assert.Equal(t, []string{"failed"}, result.Failed)
+	})
+
+	t.Run("should return error when the organization is already imported", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImportByOrganization").Return(&importerEntities.Import{}, nil)
+
+		_, err := newTestController(repositoryMock, &repositoryController.Mock{}, &scmService.Mock{},
+			&database.Mock{}).Import(data)
+		assert.Equal(t, importerEnums.ErrorImportAlreadyExists, err)
+	})
+
+	t.Run("should return error when failed to check the organization", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImportByOrganization").Return(&importerEntities.Import{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &repositoryController.Mock{}, &scmService.Mock{},
+			&database.Mock{}).Import(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when failed to encrypt the access token", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImportByOrganization").Return(&importerEntities.Import{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes(), nil)
+
+		databaseMock := &database.Mock{}
+
+		_, err := newTestControllerWithEncryption(repositoryMock, &repositoryController.Mock{}, scmMock,
+			databaseMock, newEncryptionMock(errors.New("test"))).Import(data)
+		assert.Equal(t, errors.New("test"), err)
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to list the remote repositories", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImportByOrganization").Return(&importerEntities.Import{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes(), importerEnums.ErrorProviderRequestFailed)
+
+		_, err := newTestController(repositoryMock, &repositoryController.Mock{}, scmMock,
+			&database.Mock{}).Import(data)
+		assert.Equal(t, importerEnums.ErrorProviderRequestFailed, err)
+	})
+
+	t.Run("should return error when failed to save the import", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImportByOrganization").Return(&importerEntities.Import{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes(), nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newTestController(repositoryMock, &repositoryController.Mock{}, scmMock,
+			databaseMock).Import(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestSync(t *testing.T) {
+	importation := &importerEntities.Import{ImportID: uuid.New()}
+
+	t.Run("should success keep existing, create missing and archive removed repositories", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(importation, nil)
+		repositoryMock.On("ListImportedRepositories").Return(&[]importerEntities.ImportedRepository{
+			{RepositoryID: uuid.New(), Name: "existing"}, {RepositoryID: uuid.New(), Name: "removed"}}, nil)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes("existing", "new"), nil)
+
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Create").Return(&repositoryEntities.Response{RepositoryID: uuid.New()}, nil)
+		controllerMock.On("Archive").Return(nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		result, err := newTestController(repositoryMock, controllerMock, scmMock, databaseMock).Sync(
+			importation.ImportID, uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"existing"}, result.Existing)
+		assert.Equal(t, "new", result.Created[0].Name)
+		assert.Empty(t, result.Created[0].Token)
+		assert.Equal(t, []string{"removed"}, result.Archived)
+	})
+
+	t.Run("should add to failed when failed to archive or to set the import", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(importation, nil)
+		repositoryMock.On("ListImportedRepositories").Return(&[]importerEntities.ImportedRepository{
+			{RepositoryID: uuid.New(), Name: "removed"}}, nil)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes("new"), nil)
+
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Create").Return(&repositoryEntities.Response{RepositoryID: uuid.New()}, nil)
+		controllerMock.On("Archive").Return(errors.New("test"))
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		result, err := newTestController(repositoryMock, controllerMock, scmMock, databaseMock).Sync(
+			importation.ImportID, uuid.New())
+		assert.Error(t, err)
+		assert.Equal(t, []string{"new", "removed"}, result.Failed)
+	})
+
+	t.Run("should return error when failed to list the imported repositories", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(importation, nil)
+		repositoryMock.On("ListImportedRepositories").Return(&[]importerEntities.ImportedRepository{},
+			errors.New("test"))
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes(), nil)
+
+		_, err := newTestController(repositoryMock, &repositoryController.Mock{}, scmMock,
+			&database.Mock{}).Sync(importation.ImportID, uuid.New())
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when failed to decrypt the access token", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(importation, nil)
+
+		scmMock := &scmService.Mock{}
+
+		_, err := newTestControllerWithEncryption(repositoryMock, &repositoryController.Mock{}, scmMock,
+			&database.Mock{}, newEncryptionMock(errors.New("test"))).Sync(importation.ImportID, uuid.New())
+		assert.Equal(t, errors.New("test"), err)
+		scmMock.AssertNotCalled(t, "ListRepositories")
+	})
+
+	t.Run("should return error when failed to get the import", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(importation, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTestController(repositoryMock, &repositoryController.Mock{}, &scmService.Mock{},
+			&database.Mock{}).Sync(importation.ImportID, uuid.New())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestSyncPeriodic(t *testing.T) {
+	t.Run("should success sync the periodic imports", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("ClaimPeriodicImports").Return(&[]importerEntities.Import{{ImportID: uuid.New()}}, nil)
+
+		scmMock := &scmService.Mock{}
+		scmMock.On("ListRepositories").Return(newRemotes(), importerEnums.ErrorProviderRequestFailed)
+
+		assert.NotPanics(t, func() {
+			newTestController(repositoryMock, &repositoryController.Mock{}, scmMock, &database.Mock{}).SyncPeriodic(time.Now())
+		})
+		scmMock.AssertCalled(t, "ListRepositories")
+	})
+
+	t.Run("should log error when failed to claim the periodic imports", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("ClaimPeriodicImports").Return(&[]importerEntities.Import{}, errors.New("test"))
+
+		assert.NotPanics(t, func() {
+			newTestController(repositoryMock, &repositoryController.Mock{}, &scmService.Mock{},
+				&database.Mock{}).SyncPeriodic(time.Now())
+		})
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should success list the imports of a workspace", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("ListImports").Return(&[]importerEntities.Response{{}}, nil)
+
+		result, err := newTestController(repositoryMock, &repositoryController.Mock{}, &scmService.Mock{},
+			&database.Mock{}).List(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should success delete a import", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(&importerEntities.Import{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, newTestController(repositoryMock, &repositoryController.Mock{}, &scmService.Mock{},
+			databaseMock).Delete(uuid.New(), uuid.New()))
+	})
+
+	t.Run("should return error when the import does not exist", func(t *testing.T) {
+		repositoryMock := &importerRepository.Mock{}
+		repositoryMock.On("GetImport").Return(&importerEntities.Import{}, databaseEnums.ErrorNotFoundRecords)
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, newTestController(repositoryMock,
+			&repositoryController.Mock{}, &scmService.Mock{}, &database.Mock{}).Delete(uuid.New(), uuid.New()))
+	})
+}
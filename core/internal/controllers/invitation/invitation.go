@@ -0,0 +1,268 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+	invitationRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/invitation"
+	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+)
+
+type IController interface {
+	Create(data *invitationEntities.Data) (*invitationEntities.Response, error)
+	List(workspaceID, repositoryID uuid.UUID) (*[]invitationEntities.Response, error)
+	Resend(invitationID, workspaceID, repositoryID uuid.UUID) (*invitationEntities.Response, error)
+	Revoke(invitationID, workspaceID, repositoryID uuid.UUID) error
+	Accept(data *invitationEntities.TokenData) (*invitationEntities.Response, error)
+	Decline(data *invitationEntities.TokenData) (*invitationEntities.Response, error)
+	BindPendingInvitations(data *invitationEntities.VerifiedEmail) error
+}
+
+type Controller struct {
+	broker               brokerService.IBroker
+	databaseWrite        database.IDatabaseWrite
+	appConfig            app.IConfig
+	useCases             invitationUseCases.IUseCases
+	repository           invitationRepository.IRepository
+	workspaceRepository  workspaceRepository.IRepository
+	repositoryRepository repositoryRepository.IRepository
+	expiration           time.Duration
+}
+
+func NewInvitationController(broker brokerService.IBroker, databaseConnection *database.Connection,
+	appConfig app.IConfig, useCases invitationUseCases.IUseCases, repository invitationRepository.IRepository,
+	repositoryWorkspace workspaceRepository.IRepository,
+	repositoryRepo repositoryRepository.IRepository) IController {
+	return &Controller{
+		broker:               broker,
+		databaseWrite:        databaseConnection.Write,
+		appConfig:            appConfig,
+		useCases:             useCases,
+		repository:           repository,
+		workspaceRepository:  repositoryWorkspace,
+		repositoryRepository: repositoryRepo,
+		expiration: time.Duration(env.GetEnvOrDefaultInt(invitationEnums.EnvExpirationHours,
+			invitationEnums.DefaultExpirationHours)) * time.Hour,
+	}
+}
+
+func (c *Controller) Create(data *invitationEntities.Data) (*invitationEntities.Response, error) {
+	isPending, err := c.repository.HasPendingInvitation(data.Email, data.WorkspaceID, data.RepositoryID)
+	if err != nil || isPending {
+		return nil, c.checkPendingError(err)
+	}
+
+	invitation := data.ToInvitation(c.expiration)
+	if err := c.databaseWrite.Create(invitation, invitationEnums.DatabaseInvitationTable).GetError(); err != nil {
+		return nil, err
+	}
+
+	return invitation.ToResponse(), c.sendInvitationEmail(invitation)
+}
+
+func (c *Controller) checkPendingError(err error) error {
+	if err != nil {
+		return err
+	}
+
+	return invitationEnums.ErrorInvitationAlreadyPending
+}
+
+func (c *Controller) sendInvitationEmail(invitation *invitationEntities.Invitation) error {
+	if c.appConfig.IsEmailsDisabled() {
+		return nil
+	}
+
+	targetName, err := c.getTargetName(invitation)
+	if err != nil {
+		return err
+	}
+
+	return c.broker.Publish(queues.HorusecEmail.ToString(), "", "",
+		c.useCases.NewPendingInvitationEmail(invitation, targetName))
+}
+
+func (c *Controller) getTargetName(invitation *invitationEntities.Invitation) (string, error) {
+	if invitation.IsRepositoryInvitation() {
+		repository, err := c.repositoryRepository.GetRepository(*invitation.RepositoryID)
+		if err != nil {
+			return "", err
+		}
+
+		return repository.Name, nil
+	}
+
+	workspace, err := c.workspaceRepository.GetWorkspace(invitation.WorkspaceID)
+	if err != nil {
+		return "", err
+	}
+
+	return workspace.Name, nil
+}
+
+func (c *Controller) List(workspaceID, repositoryID uuid.UUID) (*[]invitationEntities.Response, error) {
+	return c.repository.ListInvitations(workspaceID, repositoryID)
+}
+
+// Resend renews the expiration date of a pending invitation and sends the email again with a new token
+func (c *Controller) Resend(invitationID, workspaceID,
+	repositoryID uuid.UUID) (*invitationEntities.Response, error) {
+	invitation, err := c.getPendingInvitation(invitationID, workspaceID, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.update(invitation.Renew(c.expiration)); err != nil {
+		return nil, err
+	}
+
+	return invitation.ToResponse(), c.sendInvitationEmail(invitation)
+}
+
+func (c *Controller) Revoke(invitationID, workspaceID, repositoryID uuid.UUID) error {
+	invitation, err := c.getPendingInvitation(invitationID, workspaceID, repositoryID)
+	if err != nil {
+		return err
+	}
+
+	return c.update(invitation.Revoke())
+}
+
+func (c *Controller) getPendingInvitation(invitationID, workspaceID,
+	repositoryID uuid.UUID) (*invitationEntities.Invitation, error) {
+	invitation, err := c.repository.GetInvitationByTarget(invitationID, workspaceID, repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.Status != invitationEnums.StatusPending {
+		return nil, invitationEnums.ErrorInvitationNotPending
+	}
+
+	return invitation, nil
+}
+
+// Accept only binds the invitation to the account with the invited email, so a leaked token can not be used by
+// other accounts
+func (c *Controller) Accept(data *invitationEntities.TokenData) (*invitationEntities.Response, error) {
+	invitation, err := c.getAnswerableInvitation(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := invitation.CheckEmail(data.Email); err != nil {
+		return nil, err
+	}
+
+	if err := c.bind(invitation, data.AccountID); err != nil {
+		return nil, err
+	}
+
+	return invitation.ToResponse(), nil
+}
+
+// BindPendingInvitations accepts all the pending invitations sent to an email verified by auth
+func (c *Controller) BindPendingInvitations(data *invitationEntities.VerifiedEmail) error {
+	invitations, err := c.repository.ListPendingInvitationsByEmail(data.Email)
+	if err != nil {
+		return err
+	}
+
+	for index := range *invitations {
+		if err := c.bind(&(*invitations)[index], data.AccountID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bind gives the invited role and accepts the invitation in the same transaction
+func (c *Controller) bind(invitation *invitationEntities.Invitation, accountID uuid.UUID) error {
+	transaction := c.databaseWrite.StartTransaction()
+
+	if err := c.repository.BindInvitation(transaction, invitation, accountID); err != nil {
+		logger.LogError(invitationEnums.MessageFailedToRollbackBind, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := transaction.Update(invitation.Accept(accountID), c.useCases.FilterInvitationByID(
+		invitation.InvitationID), invitationEnums.DatabaseInvitationTable).GetError(); err != nil {
+		logger.LogError(invitationEnums.MessageFailedToRollbackBind, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (c *Controller) Decline(data *invitationEntities.TokenData) (*invitationEntities.Response, error) {
+	invitation, err := c.getAnswerableInvitation(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.update(invitation.Decline()); err != nil {
+		return nil, err
+	}
+
+	return invitation.ToResponse(), nil
+}
+
+func (c *Controller) getAnswerableInvitation(
+	data *invitationEntities.TokenData) (*invitationEntities.Invitation, error) {
+	invitation, err := c.getInvitationByToken(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := invitation.CheckIsAnswerable(); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (c *Controller) getInvitationByToken(
+	data *invitationEntities.TokenData) (*invitationEntities.Invitation, error) {
+	invitationID, err := data.GetInvitationID()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := c.repository.GetInvitation(invitationID)
+	if err != nil {
+		return nil, c.checkNotFoundError(err)
+	}
+
+	if !invitation.IsValidToken(data.Token) {
+		return nil, invitationEnums.ErrorInvalidToken
+	}
+
+	return invitation, nil
+}
+
+func (c *Controller) checkNotFoundError(err error) error {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return invitationEnums.ErrorInvalidToken
+	}
+
+	return err
+}
+
+func (c *Controller) update(invitation *invitationEntities.Invitation) error {
+	return c.databaseWrite.Update(invitation, c.useCases.FilterInvitationByID(invitation.InvitationID),
+		invitationEnums.DatabaseInvitationTable).GetError()
+}
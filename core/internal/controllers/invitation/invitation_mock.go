@@ -0,0 +1,49 @@
+package invitation
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Create(_ *invitationEntities.Data) (*invitationEntities.Response, error) {
+	args := m.MethodCalled("Create")
+	return args.Get(0).(*invitationEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) List(_, _ uuid.UUID) (*[]invitationEntities.Response, error) {
+	args := m.MethodCalled("List")
+	return args.Get(0).(*[]invitationEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Resend(_, _, _ uuid.UUID) (*invitationEntities.Response, error) {
+	args := m.MethodCalled("Resend")
+	return args.Get(0).(*invitationEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Revoke(_, _, _ uuid.UUID) error {
+	args := m.MethodCalled("Revoke")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) Accept(_ *invitationEntities.TokenData) (*invitationEntities.Response, error) {
+	args := m.MethodCalled("Accept")
+	return args.Get(0).(*invitationEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Decline(_ *invitationEntities.TokenData) (*invitationEntities.Response, error) {
+	args := m.MethodCalled("Decline")
+	return args.Get(0).(*invitationEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) BindPendingInvitations(_ *invitationEntities.VerifiedEmail) error {
+	args := m.MethodCalled("BindPendingInvitations")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,443 @@
+package invitation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+	invitationRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/invitation"
+	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+)
+
+func newPendingInvitation() *invitationEntities.Invitation {
+	data := &invitationEntities.Data{Email: "test@test.com", Role: account.Member, WorkspaceID: uuid.New()}
+
+	return data.ToInvitation(time.Hour)
+}
+
+func TestNewInvitationController(t *testing.T) {
+	t.Run("should success create a new invitation controller", func(t *testing.T) {
+		assert.NotNil(t, NewInvitationController(&broker.Broker{}, &database.Connection{}, &app.Config{},
+			invitationUseCases.NewInvitationUseCases(), &invitationRepository.Mock{}, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{}))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	data := &invitationEntities.Data{Email: "test@test.com", Role: account.Member, WorkspaceID: uuid.New()}
+
+	t.Run("should success create invitation and send email", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("HasPendingInvitation").Return(false, nil)
+
+		workspaceMock := &workspaceRepository.Mock{}
+		workspaceMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{Name: "test"}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		appConfigMock := &app.Mock{}
+		appConfigMock.On("IsEmailsDisabled").Return(false)
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		controller := NewInvitationController(brokerMock, &database.Connection{Write: databaseMock}, appConfigMock,
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, workspaceMock, &repositoryRepository.Mock{})
+
+		result, err := controller.Create(data)
+		assert.NoError(t, err)
+		assert.Equal(t, invitationEnums.StatusPending, result.Status)
+		brokerMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should use repository name in email when repository invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("HasPendingInvitation").Return(false, nil)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{Name: "test"}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		appConfigMock := &app.Mock{}
+		appConfigMock.On("IsEmailsDisabled").Return(false)
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		controller := NewInvitationController(brokerMock, &database.Connection{Write: databaseMock}, appConfigMock,
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{}, repoMock)
+
+		_, err := controller.Create(&invitationEntities.Data{Email: "test@test.com", Role: account.Member,
+			WorkspaceID: uuid.New(), RepositoryID: uuid.New()})
+		assert.NoError(t, err)
+		repoMock.AssertCalled(t, "GetRepository")
+	})
+
+	t.Run("should return error when there is already a pending invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("HasPendingInvitation").Return(true, nil)
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Create(data)
+		assert.Equal(t, invitationEnums.ErrorInvitationAlreadyPending, err)
+	})
+
+	t.Run("should return error when failed to check pending invitations", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("HasPendingInvitation").Return(false, errors.New("test"))
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Create(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return error when failed to create invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("HasPendingInvitation").Return(false, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{Write: databaseMock}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Create(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should not send email when emails are disabled", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("HasPendingInvitation").Return(false, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		appConfigMock := &app.Mock{}
+		appConfigMock.On("IsEmailsDisabled").Return(true)
+
+		brokerMock := &broker.Mock{}
+
+		controller := NewInvitationController(brokerMock, &database.Connection{Write: databaseMock}, appConfigMock,
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Create(data)
+		assert.NoError(t, err)
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should success list invitations", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("ListInvitations").Return(&[]invitationEntities.Response{{}}, nil)
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		result, err := controller.List(uuid.New(), uuid.Nil)
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+}
+
+func TestResend(t *testing.T) {
+	t.Run("should renew expiration and send email again", func(t *testing.T) {
+		invitation := newPendingInvitation()
+		expiresAt := invitation.ExpiresAt
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitationByTarget").Return(invitation, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		appConfigMock := &app.Mock{}
+		appConfigMock.On("IsEmailsDisabled").Return(true)
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{Write: databaseMock},
+			appConfigMock, invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		result, err := controller.Resend(uuid.New(), uuid.New(), uuid.Nil)
+		assert.NoError(t, err)
+		assert.True(t, result.ExpiresAt.After(expiresAt))
+	})
+
+	t.Run("should return error when invitation is not pending", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitationByTarget").Return(newPendingInvitation().Revoke(), nil)
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Resend(uuid.New(), uuid.New(), uuid.Nil)
+		assert.Equal(t, invitationEnums.ErrorInvitationNotPending, err)
+	})
+
+	t.Run("should return error when failed to get invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitationByTarget").Return(&invitationEntities.Invitation{}, errors.New("test"))
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Resend(uuid.New(), uuid.New(), uuid.Nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	t.Run("should success revoke invitation", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitationByTarget").Return(invitation, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{Write: databaseMock}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		assert.NoError(t, controller.Revoke(uuid.New(), uuid.New(), uuid.Nil))
+		assert.Equal(t, invitationEnums.StatusRevoked, invitation.Status)
+	})
+
+	t.Run("should return error when failed to get invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitationByTarget").Return(&invitationEntities.Invitation{}, errors.New("test"))
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		assert.Error(t, controller.Revoke(uuid.New(), uuid.New(), uuid.Nil))
+	})
+}
+
+func newTransactionMock(updateResponse response.IResponse) *database.Mock {
+	databaseMock := &database.Mock{}
+	databaseMock.On("StartTransaction").Return(databaseMock)
+	databaseMock.On("Update").Return(updateResponse)
+	databaseMock.On("CommitTransaction").Return(&response.Response{})
+	databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+	return databaseMock
+}
+
+func newTestController(databaseMock *database.Mock, repositoryMock *invitationRepository.Mock) IController {
+	return NewInvitationController(&broker.Mock{}, &database.Connection{Write: databaseMock}, &app.Mock{},
+		invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+		&repositoryRepository.Mock{})
+}
+
+func TestAccept(t *testing.T) {
+	t.Run("should bind invitation and set as accepted in the same transaction", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+		repositoryMock.On("BindInvitation").Return(nil)
+
+		databaseMock := newTransactionMock(&response.Response{})
+
+		result, err := newTestController(databaseMock, repositoryMock).Accept(&invitationEntities.TokenData{
+			Token: invitation.GenerateToken(), AccountID: uuid.New(), Email: "TEST@test.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, invitationEnums.StatusAccepted, result.Status)
+		databaseMock.AssertCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should return error when the account email is not the invited email", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+
+		_, err := newTestController(&database.Mock{}, repositoryMock).Accept(&invitationEntities.TokenData{
+			Token: invitation.GenerateToken(), AccountID: uuid.New(), Email: "other@test.com"})
+		assert.Equal(t, invitationEnums.ErrorInvitationEmailMismatch, err)
+		repositoryMock.AssertNotCalled(t, "BindInvitation")
+	})
+
+	t.Run("should rollback when failed to bind invitation", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+		repositoryMock.On("BindInvitation").Return(errors.New("test"))
+
+		databaseMock := newTransactionMock(&response.Response{})
+
+		_, err := newTestController(databaseMock, repositoryMock).Accept(&invitationEntities.TokenData{
+			Token: invitation.GenerateToken(), Email: invitation.Email})
+		assert.Error(t, err)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should rollback when failed to update invitation", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+		repositoryMock.On("BindInvitation").Return(nil)
+
+		databaseMock := newTransactionMock(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newTestController(databaseMock, repositoryMock).Accept(&invitationEntities.TokenData{
+			Token: invitation.GenerateToken(), Email: invitation.Email})
+		assert.Error(t, err)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should return error when invitation is expired", func(t *testing.T) {
+		invitation := newPendingInvitation()
+		invitation.ExpiresAt = time.Now().Add(-time.Hour)
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+
+		_, err := newTestController(&database.Mock{}, repositoryMock).Accept(&invitationEntities.TokenData{
+			Token: invitation.GenerateToken(), Email: invitation.Email})
+		assert.Equal(t, invitationEnums.ErrorInvitationExpired, err)
+	})
+}
+
+func TestBindPendingInvitations(t *testing.T) {
+	data := &invitationEntities.VerifiedEmail{AccountID: uuid.New(), Email: "test@test.com"}
+
+	t.Run("should bind and accept each pending invitation of the email", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("ListPendingInvitationsByEmail").Return(
+			&[]invitationEntities.Invitation{*newPendingInvitation(), *newPendingInvitation()}, nil)
+		repositoryMock.On("BindInvitation").Return(nil)
+
+		databaseMock := newTransactionMock(&response.Response{})
+
+		assert.NoError(t, newTestController(databaseMock, repositoryMock).BindPendingInvitations(data))
+		repositoryMock.AssertNumberOfCalls(t, "BindInvitation", 2)
+		databaseMock.AssertNumberOfCalls(t, "CommitTransaction", 2)
+	})
+
+	t.Run("should return error when failed to list pending invitations", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("ListPendingInvitationsByEmail").Return(&[]invitationEntities.Invitation{},
+			errors.New("test"))
+
+		assert.Error(t, newTestController(&database.Mock{}, repositoryMock).BindPendingInvitations(data))
+	})
+
+	t.Run("should return error when failed to bind an invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("ListPendingInvitationsByEmail").Return(
+			&[]invitationEntities.Invitation{*newPendingInvitation()}, nil)
+		repositoryMock.On("BindInvitation").Return(errors.New("test"))
+
+		databaseMock := newTransactionMock(&response.Response{})
+
+		assert.Error(t, newTestController(databaseMock, repositoryMock).BindPendingInvitations(data))
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+}
+
+func TestDecline(t *testing.T) {
+	t.Run("should set invitation as declined", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{Write: databaseMock}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		result, err := controller.Decline(&invitationEntities.TokenData{Token: invitation.GenerateToken()})
+		assert.NoError(t, err)
+		assert.Equal(t, invitation.InvitationID, result.InvitationID)
+		assert.Equal(t, invitationEnums.StatusDeclined, invitation.Status)
+	})
+
+	t.Run("should return invalid token when signature does not match", func(t *testing.T) {
+		invitation := newPendingInvitation()
+
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(invitation, nil)
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Decline(&invitationEntities.TokenData{Token: invitation.InvitationID.String() + ".test"})
+		assert.Equal(t, invitationEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return invalid token when invitation not found", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(&invitationEntities.Invitation{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Decline(&invitationEntities.TokenData{Token: uuid.NewString() + ".test"})
+		assert.Equal(t, invitationEnums.ErrorInvalidToken, err)
+	})
+
+	t.Run("should return error when failed to get invitation", func(t *testing.T) {
+		repositoryMock := &invitationRepository.Mock{}
+		repositoryMock.On("GetInvitation").Return(&invitationEntities.Invitation{}, errors.New("test"))
+
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), repositoryMock, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Decline(&invitationEntities.TokenData{Token: uuid.NewString() + ".test"})
+		assert.Equal(t, errors.New("test"), err)
+	})
+
+	t.Run("should return invalid token when token is not a invitation id", func(t *testing.T) {
+		controller := NewInvitationController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			invitationUseCases.NewInvitationUseCases(), &invitationRepository.Mock{}, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{})
+
+		_, err := controller.Decline(&invitationEntities.TokenData{Token: "test"})
+		assert.Equal(t, invitationEnums.ErrorInvalidToken, err)
+	})
+}
@@ -0,0 +1,84 @@
+package quota
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
+	quotaRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/quota"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
+)
+
+type IController interface {
+	Get(workspaceID uuid.UUID) (*quotaEntities.Response, error)
+	List() (*[]quotaEntities.Response, error)
+	Update(data *quotaEntities.Data) (*quotaEntities.Response, error)
+}
+
+type Controller struct {
+	databaseWrite database.IDatabaseWrite
+	useCases      quotaUseCases.IUseCases
+	repository    quotaRepository.IRepository
+}
+
+func NewQuotaController(databaseConnection *database.Connection, useCases quotaUseCases.IUseCases,
+	repository quotaRepository.IRepository) IController {
+	return &Controller{
+		databaseWrite: databaseConnection.Write,
+		useCases:      useCases,
+		repository:    repository,
+	}
+}
+
+func (c *Controller) Get(workspaceID uuid.UUID) (*quotaEntities.Response, error) {
+	usage, err := c.repository.GetUsage(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return usage.ToResponse(), nil
+}
+
+func (c *Controller) List() (*[]quotaEntities.Response, error) {
+	usages, err := c.repository.ListUsages()
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]quotaEntities.Response, len(*usages))
+	for index := range *usages {
+		responses[index] = *(*usages)[index].ToResponse()
+	}
+
+	return &responses, nil
+}
+
+// Update replaces all the limits of the workspace, creating its quota when the workspace still has no limits
+func (c *Controller) Update(data *quotaEntities.Data) (*quotaEntities.Response, error) {
+	if _, err := c.repository.GetUsage(data.WorkspaceID); err != nil {
+		return nil, err
+	}
+
+	if err := c.saveQuota(data); err != nil {
+		return nil, err
+	}
+
+	return c.Get(data.WorkspaceID)
+}
+
+func (c *Controller) saveQuota(data *quotaEntities.Data) error {
+	_, err := c.repository.GetQuota(data.WorkspaceID)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return c.databaseWrite.Create(data.ToQuota(), quotaEnums.DatabaseWorkspaceQuotaTable).GetError()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Update(data.ToUpdateMap(), c.useCases.FilterQuotaByWorkspaceID(data.WorkspaceID),
+		quotaEnums.DatabaseWorkspaceQuotaTable).GetError()
+}
@@ -0,0 +1,29 @@
+package quota
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Get(_ uuid.UUID) (*quotaEntities.Response, error) {
+	args := m.MethodCalled("Get")
+	return args.Get(0).(*quotaEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) List() (*[]quotaEntities.Response, error) {
+	args := m.MethodCalled("List")
+	return args.Get(0).(*[]quotaEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Update(_ *quotaEntities.Data) (*quotaEntities.Response, error) {
+	args := m.MethodCalled("Update")
+	return args.Get(0).(*quotaEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
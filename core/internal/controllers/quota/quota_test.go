@@ -0,0 +1,134 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	quotaRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/quota"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
+)
+
+func newTestController(repositoryMock *quotaRepository.Mock, databaseMock *database.Mock) IController {
+	return NewQuotaController(&database.Connection{Read: databaseMock, Write: databaseMock},
+		quotaUseCases.NewQuotaUseCases(), repositoryMock)
+}
+
+func TestNewQuotaController(t *testing.T) {
+	t.Run("should success create a new quota controller", func(t *testing.T) {
+		assert.NotNil(t, NewQuotaController(&database.Connection{}, quotaUseCases.NewQuotaUseCases(),
+			&quotaRepository.Mock{}))
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should success get the consumption of a workspace", func(t *testing.T) {
+		limit := 10
+
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{MaxRepositories: &limit, Repositories: 2}, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).Get(uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, &limit, result.Repositories.Limit)
+		assert.Equal(t, 2, result.Repositories.Used)
+	})
+
+	t.Run("should return error when failed to get usage", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Get(uuid.New())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should success list the consumption of all workspaces", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("ListUsages").Return(&[]quotaEntities.Usage{{WorkspaceName: "test"}, {}}, nil)
+
+		result, err := newTestController(repositoryMock, &database.Mock{}).List()
+		assert.NoError(t, err)
+		assert.Len(t, *result, 2)
+		assert.Equal(t, "test", (*result)[0].WorkspaceName)
+	})
+
+	t.Run("should return error when failed to list usages", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("ListUsages").Return(&[]quotaEntities.Usage{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).List()
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	limit := 10
+	data := &quotaEntities.Data{WorkspaceID: uuid.New(), MaxTokens: &limit}
+
+	t.Run("should success create the quota when workspace still has no limits", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{MaxTokens: &limit}, nil)
+		repositoryMock.On("GetQuota").Return(&quotaEntities.Quota{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		result, err := newTestController(repositoryMock, databaseMock).Update(data)
+		assert.NoError(t, err)
+		assert.Equal(t, &limit, result.Tokens.Limit)
+		databaseMock.AssertCalled(t, "Create")
+		databaseMock.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("should success update the quota when workspace already has limits", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{MaxTokens: &limit}, nil)
+		repositoryMock.On("GetQuota").Return(&quotaEntities.Quota{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		_, err := newTestController(repositoryMock, databaseMock).Update(data)
+		assert.NoError(t, err)
+		databaseMock.AssertCalled(t, "Update")
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when workspace does not exist", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Update(data)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when failed to get quota", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{}, nil)
+		repositoryMock.On("GetQuota").Return(&quotaEntities.Quota{}, errors.New("test"))
+
+		_, err := newTestController(repositoryMock, &database.Mock{}).Update(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to save quota", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{}, nil)
+		repositoryMock.On("GetQuota").Return(&quotaEntities.Quota{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newTestController(repositoryMock, databaseMock).Update(data)
+		assert.Error(t, err)
+	})
+}
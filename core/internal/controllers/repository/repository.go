@@ -4,19 +4,30 @@ import (
 	"github.com/google/uuid"
 
 	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
-	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
 	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	authEnums "github.com/ZupIT/horusec-platform/core/internal/enums/authentication"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
 	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
 	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+	quotaService "github.com/ZupIT/horusec-platform/core/internal/services/quota"
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
 	repositoriesUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 )
@@ -25,53 +36,104 @@ type IController interface {
 	Create(data *repositoryEntities.Data) (*repositoryEntities.Response, error)
 	Get(data *repositoryEntities.Data) (*repositoryEntities.Response, error)
 	Update(data *repositoryEntities.Data) (*repositoryEntities.Response, error)
-	Delete(repositoryID uuid.UUID) error
+	Archive(repositoryID uuid.UUID) error
+	Restore(repositoryID uuid.UUID) (*repositoryEntities.Response, error)
 	List(data *repositoryEntities.Data) (*[]repositoryEntities.Response, error)
+	ListArchived(workspaceID uuid.UUID) (*[]repositoryEntities.Response, error)
+	GetRole(data *roleEntities.Data) (*roleEntities.Response, error)
 	UpdateRole(data *roleEntities.Data) (*roleEntities.Response, error)
 	InviteUser(data *roleEntities.UserData) (*roleEntities.Response, error)
 	GetUsers(repositoryID uuid.UUID) (*[]roleEntities.Response, error)
 	RemoveUser(data *roleEntities.Data) error
 	CreateToken(data *tokenEntities.Data) (string, error)
 	DeleteToken(data *tokenEntities.Data) error
+	RotateToken(data *tokenEntities.RotateData) (string, error)
 	ListTokens(data *tokenEntities.Data) (*[]tokenEntities.Response, error)
+	Transfer(data *repositoryEntities.TransferData) (*repositoryEntities.Response, error)
 }
 
 type Controller struct {
-	broker        brokerService.IBroker
-	databaseRead  database.IDatabaseRead
-	databaseWrite database.IDatabaseWrite
-	appConfig     app.IConfig
-	useCases      repositoriesUseCases.IUseCases
-	repository    repositoryRepository.IRepository
-	tokenUseCases tokenUseCases.IUseCases
+	broker         brokerService.IBroker
+	databaseRead   database.IDatabaseRead
+	databaseWrite  database.IDatabaseWrite
+	appConfig      app.IConfig
+	useCases       repositoriesUseCases.IUseCases
+	repository     repositoryRepository.IRepository
+	tokenUseCases  tokenUseCases.IUseCases
+	archiveService archiveService.IService
+	tokenService   tokenService.IService
+	quotaService   quotaService.IService
 }
 
 func NewRepositoryController(broker brokerService.IBroker, databaseConnection *database.Connection,
 	appConfig app.IConfig, useCases repositoriesUseCases.IUseCases, repository repositoryRepository.IRepository,
-	useCasesToken tokenUseCases.IUseCases) IController {
+	useCasesToken tokenUseCases.IUseCases, serviceArchive archiveService.IService,
+	serviceToken tokenService.IService, serviceQuota quotaService.IService) IController {
 	return &Controller{
-		databaseRead:  databaseConnection.Read,
-		databaseWrite: databaseConnection.Write,
-		appConfig:     appConfig,
-		useCases:      useCases,
-		repository:    repository,
-		broker:        broker,
-		tokenUseCases: useCasesToken,
+		databaseRead:   databaseConnection.Read,
+		databaseWrite:  databaseConnection.Write,
+		appConfig:      appConfig,
+		useCases:       useCases,
+		repository:     repository,
+		broker:         broker,
+		tokenUseCases:  useCasesToken,
+		archiveService: serviceArchive,
+		tokenService:   serviceToken,
+		quotaService:   serviceQuota,
 	}
 }
 
 func (c *Controller) Create(data *repositoryEntities.Data) (*repositoryEntities.Response, error) {
+	if err := c.checkCreateData(data); err != nil {
+		return nil, err
+	}
+
+	workspace, err := c.repository.GetWorkspace(data.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.createRepository(data.AccountID, c.useCases.InheritWorkspaceGroups(data.ToRepository(), workspace))
+}
+
+// checkCreateData verifies the name and the owner team of the repository and the repositories limit of the workspace
+func (c *Controller) checkCreateData(data *repositoryEntities.Data) error {
 	_, err := c.repository.GetRepositoryByName(data.WorkspaceID, data.Name)
 	if !c.useCases.IsNotFoundError(err) {
-		return nil, repositoryEnums.ErrorRepositoryNameAlreadyInUse
+		return repositoryEnums.ErrorRepositoryNameAlreadyInUse
 	}
 
-	workspace, err := c.repository.GetWorkspace(data.WorkspaceID)
+	if err := c.checkOwnerTeam(data); err != nil {
+		return err
+	}
+
+	return c.quotaService.CheckRepositoriesLimit(data.WorkspaceID)
+}
+
+func (c *Controller) createRepository(accountID uuid.UUID,
+	repository *repositoryEntities.Repository) (*repositoryEntities.Response, error) {
+	response, err := c.createTransaction(accountID, repository)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.createTransaction(data.AccountID, c.useCases.InheritWorkspaceGroups(data.ToRepository(), workspace))
+	c.publishRepositoryCriticality(repository)
+	c.publishRepositoryMetadata(repository)
+	return response, nil
+}
+
+// checkOwnerTeam verifies that the owner team, when informed, belongs to the workspace of the repository
+func (c *Controller) checkOwnerTeam(data *repositoryEntities.Data) error {
+	if !data.HasOwnerTeam() {
+		return nil
+	}
+
+	_, err := c.repository.GetTeam(*data.OwnerTeamID, data.WorkspaceID)
+	if c.useCases.IsNotFoundError(err) {
+		return repositoryEnums.ErrorOwnerTeamNotInWorkspace
+	}
+
+	return err
 }
 
 func (c *Controller) createTransaction(accountID uuid.UUID,
@@ -110,7 +172,7 @@ func (c *Controller) getRepositoryWhenAppAdmin(data *repositoryEntities.Data) (*
 }
 
 func (c *Controller) getRepository(data *repositoryEntities.Data) (*repositoryEntities.Response, error) {
-	accountRepository, err := c.repository.GetAccountRepository(data.AccountID, data.RepositoryID)
+	role, err := c.repository.GetAccountRepositoryRole(data.AccountID, data.RepositoryID)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +182,7 @@ func (c *Controller) getRepository(data *repositoryEntities.Data) (*repositoryEn
 		return nil, err
 	}
 
-	return repository.ToRepositoryResponse(accountRepository.Role), nil
+	return repository.ToRepositoryResponse(role), nil
 }
 
 func (c *Controller) Update(data *repositoryEntities.Data) (*repositoryEntities.Response, error) {
@@ -134,31 +196,116 @@ func (c *Controller) Update(data *repositoryEntities.Data) (*repositoryEntities.
 		return nil, repositoryEnums.ErrorRepositoryNameAlreadyInUse
 	}
 
+	if err := c.checkOwnerTeam(data); err != nil {
+		return nil, err
+	}
+
+	return c.updateRepository(data, repository)
+}
+
+func (c *Controller) updateRepository(data *repositoryEntities.Data,
+	repository *repositoryEntities.Repository) (*repositoryEntities.Response, error) {
 	repository.Update(data)
-	return repository.ToRepositoryResponse(accountEnums.Admin), c.databaseWrite.Update(repository,
-		c.useCases.FilterRepositoryByID(data.RepositoryID), repositoryEnums.DatabaseRepositoryTable).GetError()
+	if err := c.databaseWrite.Update(repository.ToUpdateMap(), c.useCases.FilterRepositoryByID(data.RepositoryID),
+		repositoryEnums.DatabaseRepositoryTable).GetError(); err != nil {
+		return nil, err
+	}
+
+	c.publishRepositoryCriticality(repository)
+	c.publishRepositoryMetadata(repository)
+	return repository.ToRepositoryResponse(accountEnums.Admin), nil
+}
+
+func (c *Controller) publishRepositoryCriticality(repository *repositoryEntities.Repository) {
+	logger.LogError(repositoryEnums.MessageFailedToPublishCriticality, c.broker.Publish(
+		repositoryEnums.QueueAnalyticRepositoryCriticality.ToString(), "", "", repository.ToCriticality().ToBytes()))
+}
+
+// publishRepositoryMetadata notifies the other services through a fanout exchange, so each one of them can keep
+// its own copy of the labels and metadata used to filter its data
+func (c *Controller) publishRepositoryMetadata(repository *repositoryEntities.Repository) {
+	logger.LogError(repositoryEnums.MessageFailedToPublishMetadata, c.broker.Publish("",
+		repositoryEnums.ExchangeRepositoryMetadata, exchange.Fanout, repository.ToMetadata().ToBytes()))
 }
 
-func (c *Controller) Delete(repositoryID uuid.UUID) error {
-	return c.databaseWrite.Delete(c.useCases.FilterRepositoryByID(repositoryID),
-		repositoryEnums.DatabaseRepositoryTable).GetError()
+// Archive hides the repository and rejects new analyses, keeping all its data until the retention period expires
+func (c *Controller) Archive(repositoryID uuid.UUID) error {
+	repository, err := c.repository.GetRepository(repositoryID)
+	if err != nil || repository.IsArchived() {
+		return err
+	}
+
+	repository.Archive()
+	if err := c.updateArchivedAt(repository); err != nil {
+		return err
+	}
+
+	c.archiveService.PublishEvent(archiveEntities.NewRepositoryEvent(archiveEnums.ActionArchived,
+		repository.WorkspaceID, repositoryID))
+	return nil
+}
+
+func (c *Controller) Restore(repositoryID uuid.UUID) (*repositoryEntities.Response, error) {
+	repository, err := c.repository.GetRepository(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.restoreRepository(repository); err != nil {
+		return nil, err
+	}
+
+	return repository.ToRepositoryResponse(accountEnums.Admin), nil
+}
+
+func (c *Controller) restoreRepository(repository *repositoryEntities.Repository) error {
+	if err := c.archiveService.ValidateRestore(repository.ArchivedAt); err != nil {
+		return err
+	}
+
+	repository.Restore()
+	if err := c.updateArchivedAt(repository); err != nil {
+		return err
+	}
+
+	c.archiveService.PublishEvent(archiveEntities.NewRepositoryEvent(archiveEnums.ActionRestored,
+		repository.WorkspaceID, repository.RepositoryID))
+	return nil
+}
+
+func (c *Controller) updateArchivedAt(repository *repositoryEntities.Repository) error {
+	return c.databaseWrite.Update(repository.ToArchiveUpdateMap(), c.useCases.FilterRepositoryByID(
+		repository.RepositoryID), repositoryEnums.DatabaseRepositoryTable).GetError()
 }
 
 func (c *Controller) List(data *repositoryEntities.Data) (*[]repositoryEntities.Response, error) {
 	if data.IsApplicationAdmin {
-		return c.repository.ListRepositoriesWhenApplicationAdmin()
+		return c.repository.ListRepositoriesWhenApplicationAdmin(data.GetLabels())
 	}
 
-	if c.appConfig.GetAuthenticationType() == auth.Ldap {
-		return c.repository.ListRepositoriesAuthTypeLdap(data.WorkspaceID, data.Permissions)
+	if authEnums.IsGroupBased(c.appConfig.GetAuthenticationType()) {
+		return c.repository.ListRepositoriesAuthTypeLdap(data.WorkspaceID, data.Permissions, data.GetLabels())
 	}
 
-	return c.repository.ListRepositoriesAuthTypeHorusec(data.AccountID, data.WorkspaceID)
+	return c.repository.ListRepositoriesAuthTypeHorusec(data.AccountID, data.WorkspaceID, data.GetLabels())
+}
+
+func (c *Controller) ListArchived(workspaceID uuid.UUID) (*[]repositoryEntities.Response, error) {
+	return c.repository.ListArchivedRepositories(workspaceID)
+}
+
+func (c *Controller) GetRole(data *roleEntities.Data) (*roleEntities.Response, error) {
+	accountRepository, err := c.repository.GetAccountRepository(data.AccountID, data.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountRepository.ToResponse(), nil
 }
 
 func (c *Controller) UpdateRole(data *roleEntities.Data) (*roleEntities.Response, error) {
-	if c.repository.IsNotMemberOfWorkspace(data.AccountID, data.WorkspaceID) {
-		return nil, repositoryEnums.ErrorUserDoesNotBelongToWorkspace
+	if err := c.checkCanUpdateRole(data); err != nil {
+		return nil, err
 	}
 
 	accountRepository, err := c.repository.GetAccountRepository(data.AccountID, data.RepositoryID)
@@ -166,12 +313,30 @@ func (c *Controller) UpdateRole(data *roleEntities.Data) (*roleEntities.Response
 		return nil, err
 	}
 
-	accountRepository.Update(data.Role)
-	return accountRepository.ToResponse(), c.databaseWrite.Update(accountRepository,
+	accountRepository.Update(data)
+	return accountRepository.ToResponse(), c.databaseWrite.Update(accountRepository.ToUpdateMap(),
 		c.useCases.FilterAccountRepositoryByID(data.AccountID, data.RepositoryID),
 		repositoryEnums.DatabaseAccountRepositoryTable).GetError()
 }
 
+// checkCanUpdateRole also avoids assigning a custom role defined in another workspace
+func (c *Controller) checkCanUpdateRole(data *roleEntities.Data) error {
+	if c.repository.IsNotMemberOfWorkspace(data.AccountID, data.WorkspaceID) {
+		return repositoryEnums.ErrorUserDoesNotBelongToWorkspace
+	}
+
+	if !data.HasCustomRole() {
+		return nil
+	}
+
+	_, err := c.repository.GetCustomRole(*data.CustomRoleID, data.WorkspaceID)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return customRoleEnums.ErrorCustomRoleNotInWorkspace
+	}
+
+	return err
+}
+
 func (c *Controller) InviteUser(data *roleEntities.UserData) (*roleEntities.Response, error) {
 	if c.repository.IsNotMemberOfWorkspace(data.AccountID, data.WorkspaceID) {
 		return nil, repositoryEnums.ErrorUserDoesNotBelongToWorkspace
@@ -216,6 +381,10 @@ func (c *Controller) RemoveUser(data *roleEntities.Data) error {
 }
 
 func (c *Controller) CreateToken(data *tokenEntities.Data) (string, error) {
+	if err := c.quotaService.CheckTokensLimit(data.WorkspaceID); err != nil {
+		return "", err
+	}
+
 	token, tokenString := data.ToToken()
 
 	return tokenString, c.databaseWrite.Create(token, tokenEnums.DatabaseTokens).GetError()
@@ -226,9 +395,173 @@ func (c *Controller) DeleteToken(data *tokenEntities.Data) error {
 		data.TokenID, data.WorkspaceID, data.RepositoryID), tokenEnums.DatabaseTokens).GetError()
 }
 
+func (c *Controller) RotateToken(data *tokenEntities.RotateData) (string, error) {
+	return c.tokenService.Rotate(c.tokenUseCases.FilterRepositoryTokenByID(data.TokenID, data.WorkspaceID,
+		data.RepositoryID), data.GetGracePeriod())
+}
+
 func (c *Controller) ListTokens(data *tokenEntities.Data) (*[]tokenEntities.Response, error) {
 	tokens := &[]tokenEntities.Response{}
 
 	return tokens, c.databaseRead.Find(tokens, c.tokenUseCases.FilterListRepositoryTokens(
 		data.WorkspaceID, data.RepositoryID), tokenEnums.DatabaseTokens).GetErrorExceptNotFound()
 }
+
+// Transfer moves the repository with its analyses, tokens and roles to another workspace, the roles of accounts that
+// are not members of the target workspace and the roles granted to teams of the source workspace are removed, the
+// custom roles are also removed since they belong to the source workspace
+func (c *Controller) Transfer(data *repositoryEntities.TransferData) (*repositoryEntities.Response, error) {
+	repository, err := c.getTransferRepository(data)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace, err := c.getTransferTargetWorkspace(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkTransferName(data, repository); err != nil {
+		return nil, err
+	}
+
+	return c.transferRepository(data, repository, workspace)
+}
+
+func (c *Controller) getTransferRepository(
+	data *repositoryEntities.TransferData) (*repositoryEntities.Repository, error) {
+	repository, err := c.repository.GetRepository(data.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.WorkspaceID != data.WorkspaceID {
+		return nil, databaseEnums.ErrorNotFoundRecords
+	}
+
+	return repository, c.validateTransferRepository(data, repository)
+}
+
+func (c *Controller) validateTransferRepository(data *repositoryEntities.TransferData,
+	repository *repositoryEntities.Repository) error {
+	if repository.WorkspaceID == data.TargetWorkspaceID {
+		return repositoryEnums.ErrorTransferToSameWorkspace
+	}
+
+	if repository.IsArchived() {
+		return repositoryEnums.ErrorTransferArchived
+	}
+
+	return nil
+}
+
+func (c *Controller) getTransferTargetWorkspace(
+	data *repositoryEntities.TransferData) (*workspaceEntities.Workspace, error) {
+	workspace, err := c.repository.GetWorkspace(data.TargetWorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if workspace.IsArchived() {
+		return nil, repositoryEnums.ErrorTransferArchived
+	}
+
+	return workspace, c.quotaService.CheckRepositoriesLimit(workspace.WorkspaceID)
+}
+
+func (c *Controller) checkTransferName(data *repositoryEntities.TransferData,
+	repository *repositoryEntities.Repository) error {
+	_, err := c.repository.GetRepositoryByName(data.TargetWorkspaceID, data.GetName(repository.Name))
+	if !c.useCases.IsNotFoundError(err) {
+		return repositoryEnums.ErrorRepositoryNameAlreadyInUse
+	}
+
+	return nil
+}
+
+func (c *Controller) transferRepository(data *repositoryEntities.TransferData,
+	repository *repositoryEntities.Repository,
+	workspace *workspaceEntities.Workspace) (*repositoryEntities.Response, error) {
+	accountIDs, err := c.repository.ListAccountsOutsideWorkspace(repository.RepositoryID, data.TargetWorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := repository.Transfer(data)
+	if err := c.transferTransaction(transfer, repository, workspace.Name, accountIDs); err != nil {
+		return nil, err
+	}
+
+	c.publishRepositoryTransfer(transfer)
+	c.publishRepositoryMetadata(repository)
+	return repository.ToRepositoryResponse(accountEnums.Admin), nil
+}
+
+func (c *Controller) transferTransaction(transfer *repositoryEntities.Transfer,
+	repository *repositoryEntities.Repository, workspaceName string, accountIDs []uuid.UUID) error {
+	transaction := c.databaseWrite.StartTransaction()
+
+	if err := c.moveRepositoryData(transaction, transfer, repository, workspaceName); err != nil {
+		logger.LogError(repositoryEnums.ErrorRollbackTransfer, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := c.removeTransferredRoles(transaction, transfer.RepositoryID, accountIDs); err != nil {
+		logger.LogError(repositoryEnums.ErrorRollbackTransfer, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (c *Controller) moveRepositoryData(transaction database.IDatabaseWrite, transfer *repositoryEntities.Transfer,
+	repository *repositoryEntities.Repository, workspaceName string) error {
+	filter := c.useCases.FilterRepositoryByID(transfer.RepositoryID)
+
+	if err := transaction.Update(repository.ToTransferUpdateMap(), filter,
+		repositoryEnums.DatabaseRepositoryTable).GetError(); err != nil {
+		return err
+	}
+
+	if err := transaction.Update(transfer.ToAnalysisUpdateMap(workspaceName), filter,
+		repositoryEnums.DatabaseAnalysisTable).GetError(); err != nil {
+		return err
+	}
+
+	return c.moveRepositoryRelations(transaction, transfer, filter)
+}
+
+func (c *Controller) moveRepositoryRelations(transaction database.IDatabaseWrite,
+	transfer *repositoryEntities.Transfer, filter map[string]interface{}) error {
+	for _, table := range []string{tokenEnums.DatabaseTokens, invitationEnums.DatabaseInvitationTable,
+		repositoryEnums.DatabaseScimGroupRolesTable} {
+		if err := transaction.Update(transfer.ToWorkspaceUpdateMap(), filter, table).GetError(); err != nil {
+			return err
+		}
+	}
+
+	return transaction.Update(transfer.ToAccountRepositoryUpdateMap(), filter,
+		repositoryEnums.DatabaseAccountRepositoryTable).GetError()
+}
+
+func (c *Controller) removeTransferredRoles(transaction database.IDatabaseWrite, repositoryID uuid.UUID,
+	accountIDs []uuid.UUID) error {
+	if err := transaction.Delete(c.useCases.FilterRepositoryByID(repositoryID),
+		teamEnums.DatabaseTeamRepositoryTable).GetError(); err != nil {
+		return err
+	}
+
+	if len(accountIDs) == 0 {
+		return nil
+	}
+
+	return transaction.Delete(c.useCases.FilterAccountRepositoriesByAccounts(repositoryID, accountIDs),
+		repositoryEnums.DatabaseAccountRepositoryTable).GetError()
+}
+
+// publishRepositoryTransfer notifies the other services through a fanout exchange, so each one of them can move
+// its own data of the repository to the target workspace
+func (c *Controller) publishRepositoryTransfer(transfer *repositoryEntities.Transfer) {
+	logger.LogError(repositoryEnums.MessageFailedToPublishTransfer, c.broker.Publish("",
+		repositoryEnums.ExchangeRepositoryTransfer, exchange.Fanout, transfer.ToBytes()))
+}
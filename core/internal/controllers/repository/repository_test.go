@@ -17,16 +17,32 @@ import (
 	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
 	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+	quotaService "github.com/ZupIT/horusec-platform/core/internal/services/quota"
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
 	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 )
 
+func newQuotaServiceMock() *quotaService.Mock {
+	quotaServiceMock := &quotaService.Mock{}
+	quotaServiceMock.On("CheckRepositoriesLimit").Return(nil)
+	quotaServiceMock.On("CheckTokensLimit").Return(nil)
+
+	return quotaServiceMock
+}
+
 func TestCreate(t *testing.T) {
 	data := &repositoryEntities.Data{
 		AccountID:   uuid.New(),
@@ -60,9 +76,40 @@ func TestCreate(t *testing.T) {
 		databaseMock.On("StartTransaction").Return(databaseMock)
 		databaseMock.On("CommitTransaction").Return(&response.Response{})
 
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Create(data)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, repositoryEnums.CriticalityMedium, result.Criticality)
+	})
+
+	t.Run("should success create a new repository when failed to publish criticality", func(t *testing.T) {
+		appConfig := &app.Mock{}
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Create(data)
 		assert.NoError(t, err)
@@ -91,9 +138,13 @@ func TestCreate(t *testing.T) {
 
 		appConfig := &app.Mock{}
 
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		data.AuthzAdmin = []string{}
 		data.AuthzMember = []string{}
@@ -124,7 +175,8 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Create(data)
 		assert.Error(t, err)
@@ -146,7 +198,8 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Create(data)
 		assert.Error(t, err)
@@ -163,7 +216,8 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Create(data)
 		assert.Error(t, err)
@@ -179,11 +233,50 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Create(data)
 		assert.Error(t, err)
 	})
+
+	t.Run("should return error when owner team does not belong to the workspace", func(t *testing.T) {
+		teamID := uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.Create(&repositoryEntities.Data{Name: "test", OwnerTeamID: &teamID})
+		assert.Error(t, err)
+		assert.Equal(t, repositoryEnums.ErrorOwnerTeamNotInWorkspace, err)
+	})
+
+	t.Run("should return error when the workspace reached the repositories limit", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+
+		quotaServiceMock := &quotaService.Mock{}
+		quotaServiceMock.On("CheckRepositoriesLimit").Return(quotaEnums.ErrorRepositoriesLimitReached)
+
+		databaseMock := &database.Mock{}
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, quotaServiceMock)
+
+		_, err := controller.Create(data)
+		assert.Equal(t, quotaEnums.ErrorRepositoriesLimitReached, err)
+		databaseMock.AssertNotCalled(t, "StartTransaction")
+	})
 }
 
 func TestGet(t *testing.T) {
@@ -195,14 +288,15 @@ func TestGet(t *testing.T) {
 	t.Run("should success get a repository", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
 		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, nil)
-		repositoryMock.On("GetAccountRepository").Return(&repositoryEntities.AccountRepository{}, nil)
+		repositoryMock.On("GetAccountRepositoryRole").Return(account.Member, nil)
 
 		databaseMock := &database.Mock{}
 		appConfig := &app.Mock{}
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Get(data)
 		assert.NoError(t, err)
@@ -212,30 +306,31 @@ func TestGet(t *testing.T) {
 	t.Run("should return error when failed to get repository", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
 		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, errors.New("test"))
-		repositoryMock.On("GetAccountRepository").Return(&repositoryEntities.AccountRepository{}, nil)
+		repositoryMock.On("GetAccountRepositoryRole").Return(account.Member, nil)
 
 		databaseMock := &database.Mock{}
 		appConfig := &app.Mock{}
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Get(data)
 		assert.Error(t, err)
 	})
 
-	t.Run("should return error when failed to get account repository", func(t *testing.T) {
+	t.Run("should return error when failed to get account repository role", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
-		repositoryMock.On("GetAccountRepository").Return(
-			&repositoryEntities.AccountRepository{}, errors.New("test"))
+		repositoryMock.On("GetAccountRepositoryRole").Return(account.Member, errors.New("test"))
 
 		databaseMock := &database.Mock{}
 		appConfig := &app.Mock{}
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Get(data)
 		assert.Error(t, err)
@@ -250,7 +345,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		data.IsApplicationAdmin = true
 		result, err := controller.Get(data)
@@ -267,7 +363,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		data.IsApplicationAdmin = true
 		_, err := controller.Get(data)
@@ -298,15 +395,83 @@ func TestUpdate(t *testing.T) {
 
 		appConfig := &app.Mock{}
 
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Update(data)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
 
+	t.Run("should success update repository metadata with an owner team of the workspace", func(t *testing.T) {
+		teamID := uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{Name: "test"}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(&repositoryEntities.Repository{}, nil)
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(brokerMock, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Update(&repositoryEntities.Data{Name: "test", OwnerTeamID: &teamID,
+			Labels: []string{"criticality=high"}})
+		assert.NoError(t, err)
+		assert.Equal(t, &teamID, result.OwnerTeamID)
+		assert.Equal(t, []string{"criticality=high"}, []string(result.Labels))
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should return error when failed to get owner team", func(t *testing.T) {
+		teamID := uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{Name: "test"}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(&repositoryEntities.Repository{}, nil)
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.Update(&repositoryEntities.Data{Name: "test", OwnerTeamID: &teamID})
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to update repository", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{Name: "test2"}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		appConfig := &app.Mock{}
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.Update(data)
+		assert.Error(t, err)
+	})
+
 	t.Run("should return error name already in use", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
 		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, nil)
@@ -320,7 +485,8 @@ func TestUpdate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Update(data)
 		assert.Error(t, err)
@@ -337,26 +503,168 @@ func TestUpdate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Update(data)
 		assert.Error(t, err)
 	})
 }
 
-func TestDelete(t *testing.T) {
-	t.Run("should success delete repository", func(t *testing.T) {
+func TestArchive(t *testing.T) {
+	t.Run("should success archive repository and publish event", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
-		appConfig := &app.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, nil)
 
 		databaseMock := &database.Mock{}
-		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Update").Return(&response.Response{})
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("PublishEvent")
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.NoError(t, controller.Archive(uuid.New()))
+		serviceMock.AssertCalled(t, "PublishEvent")
+	})
+
+	t.Run("should do nothing when repository is already archived", func(t *testing.T) {
+		archivedAt := time.Now()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{ArchivedAt: &archivedAt}, nil)
+
+		serviceMock := &archiveService.Mock{}
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.NoError(t, controller.Archive(uuid.New()))
+		serviceMock.AssertNotCalled(t, "PublishEvent")
+	})
+
+	t.Run("should return error when failed to update archived date", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
 
-		assert.NoError(t, controller.Delete(uuid.New()))
+		assert.Error(t, controller.Archive(uuid.New()))
+	})
+
+	t.Run("should return error when failed to get repository", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.Error(t, controller.Archive(uuid.New()))
+	})
+}
+
+func TestRestore(t *testing.T) {
+	archivedAt := time.Now()
+
+	t.Run("should success restore repository and publish event", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{ArchivedAt: &archivedAt}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("ValidateRestore").Return(nil)
+		serviceMock.On("PublishEvent")
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.NoError(t, err)
+		assert.Nil(t, result.ArchivedAt)
+		serviceMock.AssertCalled(t, "PublishEvent")
+	})
+
+	t.Run("should return error when repository is not archived", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, nil)
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("ValidateRestore").Return(archiveEnums.ErrorNotArchived)
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.Equal(t, archiveEnums.ErrorNotArchived, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to update archived date", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{ArchivedAt: &archivedAt}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("ValidateRestore").Return(nil)
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to get repository", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestListArchived(t *testing.T) {
+	t.Run("should success list archived repositories of a workspace", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("ListArchivedRepositories").Return(&[]repositoryEntities.Response{}, nil)
+
+		controller := NewRepositoryController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.ListArchived(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
 	})
 }
 
@@ -378,7 +686,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.List(data)
 		assert.NoError(t, err)
@@ -396,7 +705,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.List(data)
 		assert.NoError(t, err)
@@ -415,7 +725,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		data.IsApplicationAdmin = true
 		result, err := controller.List(data)
@@ -424,6 +735,37 @@ func TestList(t *testing.T) {
 	})
 }
 
+func TestGetRole(t *testing.T) {
+	data := &roleEntities.Data{AccountID: uuid.New(), RepositoryID: uuid.New()}
+
+	t.Run("should success get the role of the account in the repository", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetAccountRepository").Return(
+			&repositoryEntities.AccountRepository{Role: account.Supervisor}, nil)
+
+		controller := NewRepositoryController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.GetRole(data)
+		assert.NoError(t, err)
+		assert.Equal(t, account.Supervisor, result.Role)
+	})
+
+	t.Run("should return error when failed to get account repository", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetAccountRepository").Return(
+			&repositoryEntities.AccountRepository{}, errors.New("test"))
+
+		controller := NewRepositoryController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.GetRole(data)
+		assert.Error(t, err)
+	})
+}
+
 func TestUpdateRole(t *testing.T) {
 	data := &roleEntities.Data{
 		Role:         account.Member,
@@ -444,7 +786,8 @@ func TestUpdateRole(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.UpdateRole(data)
 		assert.NoError(t, err)
@@ -464,7 +807,8 @@ func TestUpdateRole(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.UpdateRole(data)
 		assert.Error(t, err)
@@ -481,13 +825,52 @@ func TestUpdateRole(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.UpdateRole(data)
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
 
+	t.Run("should success assign a custom role as member", func(t *testing.T) {
+		customRoleID := uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("IsNotMemberOfWorkspace").Return(false)
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{}, nil)
+		repositoryMock.On("GetAccountRepository").Return(&repositoryEntities.AccountRepository{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.UpdateRole(&roleEntities.Data{Role: account.Admin, CustomRoleID: &customRoleID})
+		assert.NoError(t, err)
+		assert.Equal(t, account.Member, result.Role)
+		assert.Equal(t, &customRoleID, result.CustomRoleID)
+	})
+
+	t.Run("should return error when custom role is not of the workspace", func(t *testing.T) {
+		customRoleID := uuid.New()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("IsNotMemberOfWorkspace").Return(false)
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.UpdateRole(&roleEntities.Data{CustomRoleID: &customRoleID})
+		assert.Equal(t, customRoleEnums.ErrorCustomRoleNotInWorkspace, err)
+	})
+
 	t.Run("should return error when user does not belong to workspace", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
 		repositoryMock.On("IsNotMemberOfWorkspace").Return(true)
@@ -497,7 +880,8 @@ func TestUpdateRole(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.UpdateRole(data)
 		assert.Error(t, err)
@@ -530,7 +914,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(brokerMock, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.NoError(t, err)
@@ -550,7 +935,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.NoError(t, err)
@@ -570,7 +956,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.Error(t, err)
@@ -587,7 +974,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.Error(t, err)
@@ -603,7 +991,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.Error(t, err)
@@ -622,7 +1011,8 @@ func TestGetUsers(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.GetUsers(uuid.New())
 		assert.NoError(t, err)
@@ -647,7 +1037,8 @@ func TestRemoveUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		assert.NoError(t, controller.RemoveUser(data))
 	})
@@ -665,12 +1056,28 @@ func TestCreateToken(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.CreateToken(data)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
 	})
+
+	t.Run("should return error when the workspace reached the tokens limit", func(t *testing.T) {
+		quotaServiceMock := &quotaService.Mock{}
+		quotaServiceMock.On("CheckTokensLimit").Return(quotaEnums.ErrorTokensLimitReached)
+
+		databaseMock := &database.Mock{}
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), &repositoryRepository.Mock{}, &tokenUseCases.UseCases{},
+			&archiveService.Mock{}, &tokenService.Mock{}, quotaServiceMock)
+
+		_, err := controller.CreateToken(data)
+		assert.Equal(t, quotaEnums.ErrorTokensLimitReached, err)
+		databaseMock.AssertNotCalled(t, "Create")
+	})
 }
 
 func TestDeleteToken(t *testing.T) {
@@ -683,12 +1090,28 @@ func TestDeleteToken(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		assert.NoError(t, controller.DeleteToken(&tokenEntities.Data{}))
 	})
 }
 
+func TestRotateToken(t *testing.T) {
+	t.Run("should success rotate a repository token", func(t *testing.T) {
+		serviceMock := &tokenService.Mock{}
+		serviceMock.On("Rotate").Return("token", nil)
+
+		controller := NewRepositoryController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			repositoryUseCases.NewRepositoryUseCases(), &repositoryRepository.Mock{}, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, serviceMock, newQuotaServiceMock())
+
+		result, err := controller.RotateToken(&tokenEntities.RotateData{})
+		assert.NoError(t, err)
+		assert.Equal(t, "token", result)
+	})
+}
+
 func TestListTokens(t *testing.T) {
 	t.Run("should success list repository tokens", func(t *testing.T) {
 		repositoryMock := &repositoryRepository.Mock{}
@@ -699,10 +1122,190 @@ func TestListTokens(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewRepositoryController(&broker.Mock{}, databaseConnection, appConfig,
-			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{})
+			repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{}, &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.ListTokens(&tokenEntities.Data{})
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
 }
+
+func TestTransfer(t *testing.T) {
+	workspaceID := uuid.New()
+	data := &repositoryEntities.TransferData{
+		TargetWorkspaceID: uuid.New(),
+		WorkspaceID:       workspaceID,
+		RepositoryID:      uuid.New(),
+	}
+
+	newTransferController := func(repositoryMock *repositoryRepository.Mock,
+		databaseMock *database.Mock, brokerMock *broker.Mock) IController {
+		return NewRepositoryController(brokerMock, &database.Connection{Read: databaseMock, Write: databaseMock},
+			&app.Mock{}, repositoryUseCases.NewRepositoryUseCases(), repositoryMock, &tokenUseCases.UseCases{},
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+	}
+
+	t.Run("should success transfer repository and publish event", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID,
+			Name: "test"}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("ListAccountsOutsideWorkspace").Return([]uuid.UUID{uuid.New()}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		result, err := newTransferController(repositoryMock, databaseMock, brokerMock).Transfer(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.TargetWorkspaceID, result.WorkspaceID)
+		assert.Equal(t, "test", result.Name)
+		databaseMock.AssertNumberOfCalls(t, "Delete", 2)
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should return error and rollback when failed to remove roles", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("ListAccountsOutsideWorkspace").Return([]uuid.UUID{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(&response.Response{})
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		brokerMock := &broker.Mock{}
+
+		_, err := newTransferController(repositoryMock, databaseMock, brokerMock).Transfer(data)
+		assert.Error(t, err)
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should return error and rollback when failed to move repository data", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("ListAccountsOutsideWorkspace").Return([]uuid.UUID{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		_, err := newTransferController(repositoryMock, databaseMock, &broker.Mock{}).Transfer(data)
+		assert.Error(t, err)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should return error when failed to list accounts outside workspace", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(
+			&repositoryEntities.Repository{}, databaseEnums.ErrorNotFoundRecords)
+		repositoryMock.On("ListAccountsOutsideWorkspace").Return([]uuid.UUID{}, errors.New("test"))
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when name is already in use in the target workspace", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+		repositoryMock.On("GetRepositoryByName").Return(&repositoryEntities.Repository{}, nil)
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Equal(t, repositoryEnums.ErrorRepositoryNameAlreadyInUse, err)
+	})
+
+	t.Run("should return error when target workspace is archived", func(t *testing.T) {
+		archivedAt := time.Now()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{ArchivedAt: &archivedAt}, nil)
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Equal(t, repositoryEnums.ErrorTransferArchived, err)
+	})
+
+	t.Run("should return error when the target workspace reached the repositories limit", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+
+		quotaServiceMock := &quotaService.Mock{}
+		quotaServiceMock.On("CheckRepositoriesLimit").Return(quotaEnums.ErrorRepositoriesLimitReached)
+
+		databaseMock := &database.Mock{}
+		controller := NewRepositoryController(&broker.Mock{}, &database.Connection{Read: databaseMock,
+			Write: databaseMock}, &app.Mock{}, repositoryUseCases.NewRepositoryUseCases(), repositoryMock,
+			&tokenUseCases.UseCases{}, &archiveService.Mock{}, &tokenService.Mock{}, quotaServiceMock)
+
+		_, err := controller.Transfer(data)
+		assert.Equal(t, quotaEnums.ErrorRepositoriesLimitReached, err)
+	})
+
+	t.Run("should return error when failed to get target workspace", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when repository is archived", func(t *testing.T) {
+		archivedAt := time.Now()
+
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID,
+			ArchivedAt: &archivedAt}, nil)
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Equal(t, repositoryEnums.ErrorTransferArchived, err)
+	})
+
+	t.Run("should return error when repository already belongs to the target workspace", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{
+			WorkspaceID: data.TargetWorkspaceID}, nil)
+
+		sameWorkspaceData := &repositoryEntities.TransferData{TargetWorkspaceID: data.TargetWorkspaceID,
+			WorkspaceID: data.TargetWorkspaceID}
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(sameWorkspaceData)
+		assert.Equal(t, repositoryEnums.ErrorTransferToSameWorkspace, err)
+	})
+
+	t.Run("should return not found when repository belongs to another workspace", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: uuid.New()}, nil)
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when failed to get repository", func(t *testing.T) {
+		repositoryMock := &repositoryRepository.Mock{}
+		repositoryMock.On("GetRepository").Return(&repositoryEntities.Repository{}, errors.New("test"))
+
+		_, err := newTransferController(repositoryMock, &database.Mock{}, &broker.Mock{}).Transfer(data)
+		assert.Error(t, err)
+	})
+}
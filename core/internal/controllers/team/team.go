@@ -0,0 +1,227 @@
+package team
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
+	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	teamRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/team"
+	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
+)
+
+type IController interface {
+	Create(data *teamEntities.Data) (*teamEntities.Response, error)
+	Get(teamID, workspaceID uuid.UUID) (*teamEntities.Response, error)
+	Update(data *teamEntities.Data) (*teamEntities.Response, error)
+	Delete(teamID, workspaceID uuid.UUID) error
+	List(workspaceID uuid.UUID) (*[]teamEntities.Response, error)
+	AddMember(data *teamEntities.MemberData) error
+	RemoveMember(data *teamEntities.MemberData) error
+	ListMembers(teamID, workspaceID uuid.UUID) (*[]teamEntities.MemberResponse, error)
+	GrantRepositoryRole(data *teamEntities.RepositoryRoleData) (*teamEntities.RepositoryRoleResponse, error)
+	UpdateRepositoryRole(data *teamEntities.RepositoryRoleData) (*teamEntities.RepositoryRoleResponse, error)
+	RevokeRepositoryRole(data *teamEntities.RepositoryRoleData) error
+	ListRepositoryRoles(repositoryID uuid.UUID) (*[]teamEntities.RepositoryRoleResponse, error)
+}
+
+type Controller struct {
+	databaseWrite        database.IDatabaseWrite
+	useCases             teamUseCases.IUseCases
+	repository           teamRepository.IRepository
+	workspaceRepository  workspaceRepository.IRepository
+	repositoryRepository repositoryRepository.IRepository
+}
+
+func NewTeamController(databaseConnection *database.Connection, useCases teamUseCases.IUseCases,
+	repository teamRepository.IRepository, repositoryWorkspace workspaceRepository.IRepository,
+	repositoryRepo repositoryRepository.IRepository) IController {
+	return &Controller{
+		databaseWrite:        databaseConnection.Write,
+		useCases:             useCases,
+		repository:           repository,
+		workspaceRepository:  repositoryWorkspace,
+		repositoryRepository: repositoryRepo,
+	}
+}
+
+func (c *Controller) Create(data *teamEntities.Data) (*teamEntities.Response, error) {
+	if err := c.checkNameInUse(data.WorkspaceID, data.Name); err != nil {
+		return nil, err
+	}
+
+	team := data.ToTeam()
+	return team.ToResponse(), c.databaseWrite.Create(team, teamEnums.DatabaseTeamTable).GetError()
+}
+
+func (c *Controller) checkNameInUse(workspaceID uuid.UUID, name string) error {
+	_, err := c.repository.GetTeamByName(workspaceID, name)
+	if err == nil {
+		return teamEnums.ErrorTeamNameAlreadyInUse
+	}
+
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return nil
+	}
+
+	return err
+}
+
+func (c *Controller) Get(teamID, workspaceID uuid.UUID) (*teamEntities.Response, error) {
+	team, err := c.repository.GetTeam(teamID, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return team.ToResponse(), nil
+}
+
+func (c *Controller) Update(data *teamEntities.Data) (*teamEntities.Response, error) {
+	team, err := c.repository.GetTeam(data.TeamID, data.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if team.Name != data.Name {
+		if err := c.checkNameInUse(data.WorkspaceID, data.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return team.Update(data).ToResponse(), c.databaseWrite.Update(team,
+		c.useCases.FilterTeamByID(team.TeamID, team.WorkspaceID), teamEnums.DatabaseTeamTable).GetError()
+}
+
+// Delete also removes the members and repository roles of the team, since both tables cascade on delete
+func (c *Controller) Delete(teamID, workspaceID uuid.UUID) error {
+	return c.databaseWrite.Delete(c.useCases.FilterTeamByID(teamID, workspaceID),
+		teamEnums.DatabaseTeamTable).GetError()
+}
+
+func (c *Controller) List(workspaceID uuid.UUID) (*[]teamEntities.Response, error) {
+	return c.repository.ListTeams(workspaceID)
+}
+
+func (c *Controller) AddMember(data *teamEntities.MemberData) error {
+	team, err := c.repository.GetTeam(data.TeamID, data.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.checkCanJoinTeam(data); err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Create(team.ToMember(data.AccountID), teamEnums.DatabaseTeamAccountTable).GetError()
+}
+
+func (c *Controller) checkCanJoinTeam(data *teamEntities.MemberData) error {
+	if _, err := c.workspaceRepository.GetAccountWorkspace(data.AccountID, data.WorkspaceID); err != nil {
+		return c.checkNotFoundError(err, teamEnums.ErrorAccountNotWorkspaceMember)
+	}
+
+	_, err := c.repository.GetMember(data.TeamID, data.AccountID)
+	if err == nil {
+		return teamEnums.ErrorAccountAlreadyTeamMember
+	}
+
+	return c.checkNotFoundError(err, nil)
+}
+
+func (c *Controller) checkNotFoundError(err, notFoundErr error) error {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return notFoundErr
+	}
+
+	return err
+}
+
+func (c *Controller) RemoveMember(data *teamEntities.MemberData) error {
+	if _, err := c.repository.GetTeam(data.TeamID, data.WorkspaceID); err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Delete(c.useCases.FilterMemberByID(data.TeamID, data.AccountID),
+		teamEnums.DatabaseTeamAccountTable).GetError()
+}
+
+func (c *Controller) ListMembers(teamID, workspaceID uuid.UUID) (*[]teamEntities.MemberResponse, error) {
+	if _, err := c.repository.GetTeam(teamID, workspaceID); err != nil {
+		return nil, err
+	}
+
+	return c.repository.ListMembers(teamID)
+}
+
+func (c *Controller) GrantRepositoryRole(
+	data *teamEntities.RepositoryRoleData) (*teamEntities.RepositoryRoleResponse, error) {
+	team, err := c.getRepositoryTeam(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.checkRoleNotGranted(data); err != nil {
+		return nil, err
+	}
+
+	role := team.ToRepositoryRole(data.RepositoryID, data.Role)
+	return role.ToResponse(team.Name), c.databaseWrite.Create(role, teamEnums.DatabaseTeamRepositoryTable).GetError()
+}
+
+func (c *Controller) checkRoleNotGranted(data *teamEntities.RepositoryRoleData) error {
+	_, err := c.repository.GetRepositoryRole(data.TeamID, data.RepositoryID)
+	if err == nil {
+		return teamEnums.ErrorTeamAlreadyHasRepositoryRole
+	}
+
+	return c.checkNotFoundError(err, nil)
+}
+
+// getRepositoryTeam gets the team checking that it belongs to the same workspace of the repository, since the
+// repository routes only check the role of the account in the repository
+func (c *Controller) getRepositoryTeam(data *teamEntities.RepositoryRoleData) (*teamEntities.Team, error) {
+	repository, err := c.repositoryRepository.GetRepository(data.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if repository.WorkspaceID != data.WorkspaceID {
+		return nil, teamEnums.ErrorRepositoryNotInTeamWorkspace
+	}
+
+	return c.repository.GetTeam(data.TeamID, data.WorkspaceID)
+}
+
+func (c *Controller) UpdateRepositoryRole(
+	data *teamEntities.RepositoryRoleData) (*teamEntities.RepositoryRoleResponse, error) {
+	team, err := c.getRepositoryTeam(data)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := c.repository.GetRepositoryRole(data.TeamID, data.RepositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return role.Update(data.Role).ToResponse(team.Name), c.databaseWrite.Update(role,
+		c.useCases.FilterRepositoryRoleByID(data.TeamID, data.RepositoryID),
+		teamEnums.DatabaseTeamRepositoryTable).GetError()
+}
+
+func (c *Controller) RevokeRepositoryRole(data *teamEntities.RepositoryRoleData) error {
+	if _, err := c.getRepositoryTeam(data); err != nil {
+		return err
+	}
+
+	return c.databaseWrite.Delete(c.useCases.FilterRepositoryRoleByID(data.TeamID, data.RepositoryID),
+		teamEnums.DatabaseTeamRepositoryTable).GetError()
+}
+
+func (c *Controller) ListRepositoryRoles(repositoryID uuid.UUID) (*[]teamEntities.RepositoryRoleResponse, error) {
+	return c.repository.ListRepositoryRoles(repositoryID)
+}
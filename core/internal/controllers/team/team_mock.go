@@ -0,0 +1,74 @@
+package team
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Create(_ *teamEntities.Data) (*teamEntities.Response, error) {
+	args := m.MethodCalled("Create")
+	return args.Get(0).(*teamEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Get(_, _ uuid.UUID) (*teamEntities.Response, error) {
+	args := m.MethodCalled("Get")
+	return args.Get(0).(*teamEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Update(_ *teamEntities.Data) (*teamEntities.Response, error) {
+	args := m.MethodCalled("Update")
+	return args.Get(0).(*teamEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Delete(_, _ uuid.UUID) error {
+	args := m.MethodCalled("Delete")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) List(_ uuid.UUID) (*[]teamEntities.Response, error) {
+	args := m.MethodCalled("List")
+	return args.Get(0).(*[]teamEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) AddMember(_ *teamEntities.MemberData) error {
+	args := m.MethodCalled("AddMember")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) RemoveMember(_ *teamEntities.MemberData) error {
+	args := m.MethodCalled("RemoveMember")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListMembers(_, _ uuid.UUID) (*[]teamEntities.MemberResponse, error) {
+	args := m.MethodCalled("ListMembers")
+	return args.Get(0).(*[]teamEntities.MemberResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GrantRepositoryRole(_ *teamEntities.RepositoryRoleData) (*teamEntities.RepositoryRoleResponse, error) {
+	args := m.MethodCalled("GrantRepositoryRole")
+	return args.Get(0).(*teamEntities.RepositoryRoleResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) UpdateRepositoryRole(_ *teamEntities.RepositoryRoleData) (*teamEntities.RepositoryRoleResponse, error) {
+	args := m.MethodCalled("UpdateRepositoryRole")
+	return args.Get(0).(*teamEntities.RepositoryRoleResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RevokeRepositoryRole(_ *teamEntities.RepositoryRoleData) error {
+	args := m.MethodCalled("RevokeRepositoryRole")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ListRepositoryRoles(_ uuid.UUID) (*[]teamEntities.RepositoryRoleResponse, error) {
+	args := m.MethodCalled("ListRepositoryRoles")
+	return args.Get(0).(*[]teamEntities.RepositoryRoleResponse), mockUtils.ReturnNilOrError(args, 1)
+}
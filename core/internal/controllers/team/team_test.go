@@ -0,0 +1,454 @@
+package team
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
+	repositoryRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/repository"
+	teamRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/team"
+	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
+)
+
+func newTestController(repositoryMock *teamRepository.Mock, workspaceMock *workspaceRepository.Mock,
+	repoMock *repositoryRepository.Mock, databaseMock *database.Mock) IController {
+	return NewTeamController(&database.Connection{Read: databaseMock, Write: databaseMock},
+		teamUseCases.NewTeamUseCases(), repositoryMock, workspaceMock, repoMock)
+}
+
+func TestNewTeamController(t *testing.T) {
+	t.Run("should success create a new team controller", func(t *testing.T) {
+		assert.NotNil(t, NewTeamController(&database.Connection{}, teamUseCases.NewTeamUseCases(),
+			&teamRepository.Mock{}, &workspaceRepository.Mock{}, &repositoryRepository.Mock{}))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	data := &teamEntities.Data{Name: "test", WorkspaceID: uuid.New()}
+
+	t.Run("should success create a team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeamByName").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			databaseMock)
+
+		result, err := controller.Create(data)
+		assert.NoError(t, err)
+		assert.Equal(t, data.Name, result.Name)
+		assert.Equal(t, data.WorkspaceID, result.WorkspaceID)
+	})
+
+	t.Run("should return error when name already in use", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeamByName").Return(&teamEntities.Team{}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		_, err := controller.Create(data)
+		assert.Equal(t, teamEnums.ErrorTeamNameAlreadyInUse, err)
+	})
+
+	t.Run("should return error when failed to check name", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeamByName").Return(&teamEntities.Team{}, errors.New("test"))
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		_, err := controller.Create(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should success get a team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{Name: "test"}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		result, err := controller.Get(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Equal(t, "test", result.Name)
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		_, err := controller.Get(uuid.New(), uuid.New())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("should success update team when name changed", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{Name: "old"}, nil)
+		repositoryMock.On("GetTeamByName").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			databaseMock)
+
+		result, err := controller.Update(&teamEntities.Data{Name: "new", Description: "test"})
+		assert.NoError(t, err)
+		assert.Equal(t, "new", result.Name)
+		assert.Equal(t, "test", result.Description)
+	})
+
+	t.Run("should not check name when it was not changed", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{Name: "test"}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			databaseMock)
+
+		_, err := controller.Update(&teamEntities.Data{Name: "test"})
+		assert.NoError(t, err)
+		repositoryMock.AssertNotCalled(t, "GetTeamByName")
+	})
+
+	t.Run("should return error when new name already in use", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{Name: "old"}, nil)
+		repositoryMock.On("GetTeamByName").Return(&teamEntities.Team{}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		_, err := controller.Update(&teamEntities.Data{Name: "new"})
+		assert.Equal(t, teamEnums.ErrorTeamNameAlreadyInUse, err)
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, errors.New("test"))
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		_, err := controller.Update(&teamEntities.Data{Name: "new"})
+		assert.Error(t, err)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should success delete a team", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		controller := newTestController(&teamRepository.Mock{}, &workspaceRepository.Mock{},
+			&repositoryRepository.Mock{}, databaseMock)
+
+		assert.NoError(t, controller.Delete(uuid.New(), uuid.New()))
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should success list teams", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("ListTeams").Return(&[]teamEntities.Response{{Name: "test"}}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		result, err := controller.List(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+}
+
+func TestAddMember(t *testing.T) {
+	data := &teamEntities.MemberData{AccountID: uuid.New(), TeamID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should success add a member to the team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+		repositoryMock.On("GetMember").Return(&teamEntities.Member{}, databaseEnums.ErrorNotFoundRecords)
+
+		workspaceMock := &workspaceRepository.Mock{}
+		workspaceMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, workspaceMock, &repositoryRepository.Mock{}, databaseMock)
+
+		assert.NoError(t, controller.AddMember(data))
+	})
+
+	t.Run("should return error when account is not a workspace member", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+
+		workspaceMock := &workspaceRepository.Mock{}
+		workspaceMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		controller := newTestController(repositoryMock, workspaceMock, &repositoryRepository.Mock{}, &database.Mock{})
+
+		assert.Equal(t, teamEnums.ErrorAccountNotWorkspaceMember, controller.AddMember(data))
+	})
+
+	t.Run("should return error when account is already a member", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+		repositoryMock.On("GetMember").Return(&teamEntities.Member{}, nil)
+
+		workspaceMock := &workspaceRepository.Mock{}
+		workspaceMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{}, nil)
+
+		controller := newTestController(repositoryMock, workspaceMock, &repositoryRepository.Mock{}, &database.Mock{})
+
+		assert.Equal(t, teamEnums.ErrorAccountAlreadyTeamMember, controller.AddMember(data))
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, controller.AddMember(data))
+	})
+}
+
+func TestRemoveMember(t *testing.T) {
+	t.Run("should success remove a member from the team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			databaseMock)
+
+		assert.NoError(t, controller.RemoveMember(&teamEntities.MemberData{}))
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		assert.Error(t, controller.RemoveMember(&teamEntities.MemberData{}))
+	})
+}
+
+func TestListMembers(t *testing.T) {
+	t.Run("should success list team members", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+		repositoryMock.On("ListMembers").Return(&[]teamEntities.MemberResponse{{Email: "test"}}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		result, err := controller.ListMembers(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		_, err := controller.ListMembers(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestGrantRepositoryRole(t *testing.T) {
+	workspaceID := uuid.New()
+	data := &teamEntities.RepositoryRoleData{TeamID: uuid.New(), Role: account.Supervisor,
+		WorkspaceID: workspaceID, RepositoryID: uuid.New()}
+
+	t.Run("should success grant a repository role to the team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{Name: "test"}, nil)
+		repositoryMock.On("GetRepositoryRole").Return(&teamEntities.RepositoryRole{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Create").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, databaseMock)
+
+		result, err := controller.GrantRepositoryRole(data)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", result.Name)
+		assert.Equal(t, account.Supervisor, result.Role)
+	})
+
+	t.Run("should return error when team already has a role", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+		repositoryMock.On("GetRepositoryRole").Return(&teamEntities.RepositoryRole{}, nil)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, &database.Mock{})
+
+		_, err := controller.GrantRepositoryRole(data)
+		assert.Equal(t, teamEnums.ErrorTeamAlreadyHasRepositoryRole, err)
+	})
+
+	t.Run("should return error when repository is from another workspace", func(t *testing.T) {
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: uuid.New()}, nil)
+
+		controller := newTestController(&teamRepository.Mock{}, &workspaceRepository.Mock{}, repoMock,
+			&database.Mock{})
+
+		_, err := controller.GrantRepositoryRole(data)
+		assert.Equal(t, teamEnums.ErrorRepositoryNotInTeamWorkspace, err)
+	})
+
+	t.Run("should return error when failed to get repository", func(t *testing.T) {
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{}, errors.New("test"))
+
+		controller := newTestController(&teamRepository.Mock{}, &workspaceRepository.Mock{}, repoMock,
+			&database.Mock{})
+
+		_, err := controller.GrantRepositoryRole(data)
+		assert.Equal(t, errors.New("test"), err)
+	})
+}
+
+func TestUpdateRepositoryRole(t *testing.T) {
+	workspaceID := uuid.New()
+	data := &teamEntities.RepositoryRoleData{TeamID: uuid.New(), Role: account.Admin,
+		WorkspaceID: workspaceID, RepositoryID: uuid.New()}
+
+	t.Run("should success update the repository role of the team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{Name: "test"}, nil)
+		repositoryMock.On("GetRepositoryRole").Return(&teamEntities.RepositoryRole{Role: account.Member}, nil)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, databaseMock)
+
+		result, err := controller.UpdateRepositoryRole(data)
+		assert.NoError(t, err)
+		assert.Equal(t, account.Admin, result.Role)
+	})
+
+	t.Run("should return error when team has no role in the repository", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+		repositoryMock.On("GetRepositoryRole").Return(&teamEntities.RepositoryRole{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, &database.Mock{})
+
+		_, err := controller.UpdateRepositoryRole(data)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, &database.Mock{})
+
+		_, err := controller.UpdateRepositoryRole(data)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestRevokeRepositoryRole(t *testing.T) {
+	workspaceID := uuid.New()
+	data := &teamEntities.RepositoryRoleData{TeamID: uuid.New(), WorkspaceID: workspaceID, RepositoryID: uuid.New()}
+
+	t.Run("should success revoke the repository role of the team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, databaseMock)
+
+		assert.NoError(t, controller.RevokeRepositoryRole(data))
+	})
+
+	t.Run("should return error when failed to get team", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("GetTeam").Return(&teamEntities.Team{}, databaseEnums.ErrorNotFoundRecords)
+
+		repoMock := &repositoryRepository.Mock{}
+		repoMock.On("GetRepository").Return(&repositoryEntities.Repository{WorkspaceID: workspaceID}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, repoMock, &database.Mock{})
+
+		assert.Error(t, controller.RevokeRepositoryRole(data))
+	})
+}
+
+func TestListRepositoryRoles(t *testing.T) {
+	t.Run("should success list the team roles of a repository", func(t *testing.T) {
+		repositoryMock := &teamRepository.Mock{}
+		repositoryMock.On("ListRepositoryRoles").Return(
+			&[]teamEntities.RepositoryRoleResponse{{Role: account.Member}}, nil)
+
+		controller := newTestController(repositoryMock, &workspaceRepository.Mock{}, &repositoryRepository.Mock{},
+			&database.Mock{})
+
+		result, err := controller.ListRepositoryRoles(uuid.New())
+		assert.NoError(t, err)
+		assert.Len(t, *result, 1)
+	})
+}
@@ -4,20 +4,28 @@ import (
 	"github.com/google/uuid"
 
 	accountEnums "github.com/ZupIT/horusec-devkit/pkg/enums/account"
-	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
 	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
 
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	authEnums "github.com/ZupIT/horusec-platform/core/internal/enums/authentication"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
 	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
 	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
 	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+	quotaService "github.com/ZupIT/horusec-platform/core/internal/services/quota"
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
 )
@@ -26,38 +34,49 @@ type IController interface {
 	Create(data *workspaceEntities.Data) (*workspaceEntities.Response, error)
 	Get(data *workspaceEntities.Data) (*workspaceEntities.Response, error)
 	Update(data *workspaceEntities.Data) (*workspaceEntities.Response, error)
-	Delete(workspaceID uuid.UUID) error
+	Archive(workspaceID uuid.UUID) error
+	Restore(workspaceID uuid.UUID) (*workspaceEntities.Response, error)
 	List(data *workspaceEntities.Data) (*[]workspaceEntities.Response, error)
+	ListArchived(data *workspaceEntities.Data) (*[]workspaceEntities.Response, error)
+	GetRole(data *roleEntities.Data) (*roleEntities.Response, error)
 	UpdateRole(data *roleEntities.Data) (*roleEntities.Response, error)
 	InviteUser(data *roleEntities.UserData) (*roleEntities.Response, error)
 	GetUsers(workspaceID uuid.UUID) (*[]roleEntities.Response, error)
 	RemoveUser(data *roleEntities.Data) error
 	CreateToken(data *tokenEntities.Data) (string, error)
 	DeleteToken(data *tokenEntities.Data) error
+	RotateToken(data *tokenEntities.RotateData) (string, error)
 	ListTokens(workspaceID uuid.UUID) (*[]tokenEntities.Response, error)
 }
 
 type Controller struct {
-	broker        brokerService.IBroker
-	databaseRead  database.IDatabaseRead
-	databaseWrite database.IDatabaseWrite
-	appConfig     app.IConfig
-	useCases      workspaceUseCases.IUseCases
-	repository    workspaceRepository.IRepository
-	tokenUseCases tokenUseCases.IUseCases
+	broker         brokerService.IBroker
+	databaseRead   database.IDatabaseRead
+	databaseWrite  database.IDatabaseWrite
+	appConfig      app.IConfig
+	useCases       workspaceUseCases.IUseCases
+	repository     workspaceRepository.IRepository
+	tokenUseCases  tokenUseCases.IUseCases
+	archiveService archiveService.IService
+	tokenService   tokenService.IService
+	quotaService   quotaService.IService
 }
 
 func NewWorkspaceController(broker brokerService.IBroker, databaseConnection *database.Connection,
 	appConfig app.IConfig, useCases workspaceUseCases.IUseCases, repository workspaceRepository.IRepository,
-	useCasesToken tokenUseCases.IUseCases) IController {
+	useCasesToken tokenUseCases.IUseCases, serviceArchive archiveService.IService,
+	serviceToken tokenService.IService, serviceQuota quotaService.IService) IController {
 	return &Controller{
-		broker:        broker,
-		databaseRead:  databaseConnection.Read,
-		databaseWrite: databaseConnection.Write,
-		appConfig:     appConfig,
-		useCases:      useCases,
-		repository:    repository,
-		tokenUseCases: useCasesToken,
+		broker:         broker,
+		databaseRead:   databaseConnection.Read,
+		databaseWrite:  databaseConnection.Write,
+		appConfig:      appConfig,
+		useCases:       useCases,
+		repository:     repository,
+		tokenUseCases:  useCasesToken,
+		archiveService: serviceArchive,
+		tokenService:   serviceToken,
+		quotaService:   serviceQuota,
 	}
 }
 
@@ -118,12 +137,56 @@ func (c *Controller) Update(data *workspaceEntities.Data) (*workspaceEntities.Re
 
 	workspace.Update(data)
 	return workspace.ToWorkspaceResponse(accountEnums.Admin), c.databaseWrite.Update(
-		workspace, c.useCases.FilterWorkspaceByID(data.WorkspaceID), workspaceEnums.DatabaseWorkspaceTable).GetError()
+		workspace.ToUpdateMap(), c.useCases.FilterWorkspaceByID(data.WorkspaceID), workspaceEnums.DatabaseWorkspaceTable).GetError()
 }
 
-func (c *Controller) Delete(workspaceID uuid.UUID) error {
-	return c.databaseWrite.Delete(c.useCases.FilterWorkspaceByID(workspaceID),
-		workspaceEnums.DatabaseWorkspaceTable).GetError()
+// Archive hides the workspace and its repositories, keeping all its data until the retention period expires
+func (c *Controller) Archive(workspaceID uuid.UUID) error {
+	workspace, err := c.repository.GetWorkspace(workspaceID)
+	if err != nil || workspace.IsArchived() {
+		return err
+	}
+
+	workspace.Archive()
+	if err := c.updateArchivedAt(workspace); err != nil {
+		return err
+	}
+
+	c.archiveService.PublishEvent(archiveEntities.NewWorkspaceEvent(archiveEnums.ActionArchived, workspaceID))
+	return nil
+}
+
+func (c *Controller) Restore(workspaceID uuid.UUID) (*workspaceEntities.Response, error) {
+	workspace, err := c.repository.GetWorkspace(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.restoreWorkspace(workspace); err != nil {
+		return nil, err
+	}
+
+	return workspace.ToWorkspaceResponse(accountEnums.Admin), nil
+}
+
+func (c *Controller) restoreWorkspace(workspace *workspaceEntities.Workspace) error {
+	if err := c.archiveService.ValidateRestore(workspace.ArchivedAt); err != nil {
+		return err
+	}
+
+	workspace.Restore()
+	if err := c.updateArchivedAt(workspace); err != nil {
+		return err
+	}
+
+	c.archiveService.PublishEvent(archiveEntities.NewWorkspaceEvent(archiveEnums.ActionRestored,
+		workspace.WorkspaceID))
+	return nil
+}
+
+func (c *Controller) updateArchivedAt(workspace *workspaceEntities.Workspace) error {
+	return c.databaseWrite.Update(workspace.ToArchiveUpdateMap(), c.useCases.FilterWorkspaceByID(
+		workspace.WorkspaceID), workspaceEnums.DatabaseWorkspaceTable).GetError()
 }
 
 func (c *Controller) List(data *workspaceEntities.Data) (*[]workspaceEntities.Response, error) {
@@ -131,25 +194,64 @@ func (c *Controller) List(data *workspaceEntities.Data) (*[]workspaceEntities.Re
 		return c.repository.ListWorkspacesApplicationAdmin()
 	}
 
-	if c.appConfig.GetAuthenticationType() == auth.Ldap {
+	if authEnums.IsGroupBased(c.appConfig.GetAuthenticationType()) {
 		return c.repository.ListWorkspacesAuthTypeLdap(data.Permissions)
 	}
 
 	return c.repository.ListWorkspacesAuthTypeHorusec(data.AccountID)
 }
 
+func (c *Controller) ListArchived(data *workspaceEntities.Data) (*[]workspaceEntities.Response, error) {
+	if data.IsApplicationAdmin {
+		return c.repository.ListArchivedWorkspacesApplicationAdmin()
+	}
+
+	if authEnums.IsGroupBased(c.appConfig.GetAuthenticationType()) {
+		return c.repository.ListArchivedWorkspacesAuthTypeLdap(data.Permissions)
+	}
+
+	return c.repository.ListArchivedWorkspacesAuthTypeHorusec(data.AccountID)
+}
+
+func (c *Controller) GetRole(data *roleEntities.Data) (*roleEntities.Response, error) {
+	accountWorkspace, err := c.repository.GetAccountWorkspace(data.AccountID, data.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accountWorkspace.ToResponse(), nil
+}
+
 func (c *Controller) UpdateRole(data *roleEntities.Data) (*roleEntities.Response, error) {
+	if err := c.checkCustomRole(data); err != nil {
+		return nil, err
+	}
+
 	accountWorkspace, err := c.repository.GetAccountWorkspace(data.AccountID, data.WorkspaceID)
 	if err != nil {
 		return nil, err
 	}
 
 	accountWorkspace.Update(data)
-	return accountWorkspace.ToResponse(), c.databaseWrite.Update(accountWorkspace,
+	return accountWorkspace.ToResponse(), c.databaseWrite.Update(accountWorkspace.ToUpdateMap(),
 		c.useCases.FilterAccountWorkspaceByID(data.AccountID, data.WorkspaceID),
 		workspaceEnums.DatabaseAccountWorkspaceTable).GetError()
 }
 
+// checkCustomRole avoids assigning a custom role defined in another workspace
+func (c *Controller) checkCustomRole(data *roleEntities.Data) error {
+	if !data.HasCustomRole() {
+		return nil
+	}
+
+	_, err := c.repository.GetCustomRole(*data.CustomRoleID, data.WorkspaceID)
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return customRoleEnums.ErrorCustomRoleNotInWorkspace
+	}
+
+	return err
+}
+
 func (c *Controller) InviteUser(data *roleEntities.UserData) (*roleEntities.Response, error) {
 	workspace, err := c.repository.GetWorkspace(data.WorkspaceID)
 	if err != nil {
@@ -179,17 +281,27 @@ func (c *Controller) GetUsers(workspaceID uuid.UUID) (*[]roleEntities.Response,
 	return c.repository.ListAllWorkspaceUsers(workspaceID)
 }
 
+// RemoveUser also removes the account from the teams of the workspace, so it loses the team repository roles
 func (c *Controller) RemoveUser(data *roleEntities.Data) error {
 	if err := c.databaseWrite.Delete(c.useCases.FilterAccountWorkspaceByID(data.AccountID, data.WorkspaceID),
 		repositoryEnums.DatabaseAccountRepositoryTable).GetError(); err != nil {
 		return err
 	}
 
+	if err := c.databaseWrite.Delete(c.useCases.FilterAccountWorkspaceByID(data.AccountID, data.WorkspaceID),
+		teamEnums.DatabaseTeamAccountTable).GetError(); err != nil {
+		return err
+	}
+
 	return c.databaseWrite.Delete(c.useCases.FilterAccountWorkspaceByID(data.AccountID, data.WorkspaceID),
 		workspaceEnums.DatabaseAccountWorkspaceTable).GetError()
 }
 
 func (c *Controller) CreateToken(data *tokenEntities.Data) (string, error) {
+	if err := c.quotaService.CheckTokensLimit(data.WorkspaceID); err != nil {
+		return "", err
+	}
+
 	token, tokenString := data.ToToken()
 
 	return tokenString, c.databaseWrite.Create(token, tokenEnums.DatabaseTokens).GetError()
@@ -200,6 +312,11 @@ func (c *Controller) DeleteToken(data *tokenEntities.Data) error {
 		tokenEnums.DatabaseTokens).GetError()
 }
 
+func (c *Controller) RotateToken(data *tokenEntities.RotateData) (string, error) {
+	return c.tokenService.Rotate(c.tokenUseCases.FilterWorkspaceTokenByID(data.TokenID, data.WorkspaceID),
+		data.GetGracePeriod())
+}
+
 func (c *Controller) ListTokens(workspaceID uuid.UUID) (*[]tokenEntities.Response, error) {
 	tokens := &[]tokenEntities.Response{}
 
@@ -30,16 +30,31 @@ func (m *Mock) Update(_ *workspaceEntities.Data) (*workspaceEntities.Response, e
 	return args.Get(0).(*workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) Delete(_ uuid.UUID) error {
-	args := m.MethodCalled("Delete")
+func (m *Mock) Archive(_ uuid.UUID) error {
+	args := m.MethodCalled("Archive")
 	return mockUtils.ReturnNilOrError(args, 0)
 }
 
+func (m *Mock) Restore(_ uuid.UUID) (*workspaceEntities.Response, error) {
+	args := m.MethodCalled("Restore")
+	return args.Get(0).(*workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListArchived(_ *workspaceEntities.Data) (*[]workspaceEntities.Response, error) {
+	args := m.MethodCalled("ListArchived")
+	return args.Get(0).(*[]workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
 func (m *Mock) List(_ *workspaceEntities.Data) (*[]workspaceEntities.Response, error) {
 	args := m.MethodCalled("List")
 	return args.Get(0).(*[]workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
 
+func (m *Mock) GetRole(_ *roleEntities.Data) (*roleEntities.Response, error) {
+	args := m.MethodCalled("GetRole")
+	return args.Get(0).(*roleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
 func (m *Mock) UpdateRole(_ *roleEntities.Data) (*roleEntities.Response, error) {
 	args := m.MethodCalled("UpdateRole")
 	return args.Get(0).(*roleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
@@ -70,6 +85,11 @@ func (m *Mock) DeleteToken(_ *tokenEntities.Data) error {
 	return mockUtils.ReturnNilOrError(args, 0)
 }
 
+func (m *Mock) RotateToken(_ *tokenEntities.RotateData) (string, error) {
+	args := m.MethodCalled("RotateToken")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
 func (m *Mock) ListTokens(_ uuid.UUID) (*[]tokenEntities.Response, error) {
 	args := m.MethodCalled("ListTokens")
 	return args.Get(0).(*[]tokenEntities.Response), mockUtils.ReturnNilOrError(args, 1)
@@ -13,20 +13,38 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
 	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	"github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	authEnums "github.com/ZupIT/horusec-platform/core/internal/enums/authentication"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
 	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+	quotaService "github.com/ZupIT/horusec-platform/core/internal/services/quota"
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
 )
 
+func newQuotaServiceMock() *quotaService.Mock {
+	quotaServiceMock := &quotaService.Mock{}
+	quotaServiceMock.On("CheckRepositoriesLimit").Return(nil)
+	quotaServiceMock.On("CheckTokensLimit").Return(nil)
+
+	return quotaServiceMock
+}
+
 func TestNewWorkspaceController(t *testing.T) {
 	t.Run("should success create a new workspace controller", func(t *testing.T) {
 		assert.NotNil(t, NewWorkspaceController(&broker.Broker{}, &database.Connection{}, &app.Config{},
-			workspaceUseCases.NewWorkspaceUseCases(), &workspaceRepository.Repository{}, tokenUseCases.NewTokenUseCases()))
+			workspaceUseCases.NewWorkspaceUseCases(), &workspaceRepository.Repository{}, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock()))
 	})
 }
 
@@ -52,7 +70,8 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Create(workspaceData)
 		assert.NoError(t, err)
@@ -73,7 +92,8 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Create(workspaceData)
 		assert.Error(t, err)
@@ -94,7 +114,8 @@ func TestCreate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Create(workspaceData)
 		assert.Error(t, err)
@@ -142,7 +163,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Get(workspaceData)
 		assert.NoError(t, err)
@@ -160,7 +182,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Get(workspaceData)
 		assert.Error(t, err)
@@ -177,7 +200,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Get(workspaceData)
 		assert.Error(t, err)
@@ -193,7 +217,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		workspaceData.IsApplicationAdmin = true
 		result, err := controller.Get(workspaceData)
@@ -210,7 +235,8 @@ func TestGet(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		workspaceData.IsApplicationAdmin = true
 		_, err := controller.Get(workspaceData)
@@ -250,7 +276,8 @@ func TestUpdate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.Update(workspaceData)
 		assert.NoError(t, err)
@@ -268,27 +295,202 @@ func TestUpdate(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.Update(workspaceData)
 		assert.Error(t, err)
 	})
 }
 
-func TestDelete(t *testing.T) {
-	t.Run("should success delete workspace", func(t *testing.T) {
+func TestArchive(t *testing.T) {
+	t.Run("should success archive workspace and publish event", func(t *testing.T) {
 		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
 
 		databaseMock := &database.Mock{}
-		databaseMock.On("Delete").Return(&response.Response{})
+		databaseMock.On("Update").Return(&response.Response{})
 
-		appConfig := &app.Mock{}
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("PublishEvent")
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
-		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.NoError(t, controller.Archive(uuid.New()))
+		serviceMock.AssertCalled(t, "PublishEvent")
+	})
+
+	t.Run("should do nothing when workspace is already archived", func(t *testing.T) {
+		archivedAt := time.Now()
+
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{ArchivedAt: &archivedAt}, nil)
+
+		serviceMock := &archiveService.Mock{}
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.NoError(t, controller.Archive(uuid.New()))
+		serviceMock.AssertNotCalled(t, "PublishEvent")
+	})
+
+	t.Run("should return error when failed to update archived date", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.Error(t, controller.Archive(uuid.New()))
+	})
+
+	t.Run("should return error when failed to get workspace", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.Error(t, controller.Archive(uuid.New()))
+	})
+}
+
+func TestRestore(t *testing.T) {
+	archivedAt := time.Now()
+
+	t.Run("should success restore workspace and publish event", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{ArchivedAt: &archivedAt}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("ValidateRestore").Return(nil)
+		serviceMock.On("PublishEvent")
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.NoError(t, err)
+		assert.Nil(t, result.ArchivedAt)
+		serviceMock.AssertCalled(t, "PublishEvent")
+	})
+
+	t.Run("should return error when retention period has expired", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{ArchivedAt: &archivedAt}, nil)
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("ValidateRestore").Return(archiveEnums.ErrorRetentionExpired)
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.Equal(t, archiveEnums.ErrorRetentionExpired, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to update archived date", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{ArchivedAt: &archivedAt}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		serviceMock := &archiveService.Mock{}
+		serviceMock.On("ValidateRestore").Return(nil)
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), serviceMock,
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to get workspace", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, errors.New("test"))
+
+		databaseConnection := &database.Connection{Read: &database.Mock{}, Write: &database.Mock{}}
+		controller := NewWorkspaceController(&broker.Mock{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.Restore(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestListArchived(t *testing.T) {
+	workspaceData := &workspaceEntities.Data{AccountID: uuid.New(), Permissions: []string{"test"}}
+
+	t.Run("should list archived workspaces when horusec auth type", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("ListArchivedWorkspacesAuthTypeHorusec").Return(&[]workspaceEntities.Response{}, nil)
+
+		appConfig := &app.Mock{}
+		appConfig.On("GetAuthenticationType").Return(auth.Horusec)
+
+		controller := NewWorkspaceController(&broker.Mock{}, &database.Connection{}, appConfig,
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.ListArchived(workspaceData)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should list archived workspaces when ldap auth type", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("ListArchivedWorkspacesAuthTypeLdap").Return(&[]workspaceEntities.Response{}, nil)
+
+		appConfig := &app.Mock{}
+		appConfig.On("GetAuthenticationType").Return(auth.Ldap)
+
+		controller := NewWorkspaceController(&broker.Mock{}, &database.Connection{}, appConfig,
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.ListArchived(workspaceData)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should list all archived workspaces when application admin", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("ListArchivedWorkspacesApplicationAdmin").Return(&[]workspaceEntities.Response{}, nil)
 
-		assert.NoError(t, controller.Delete(uuid.New()))
+		controller := NewWorkspaceController(&broker.Mock{}, &database.Connection{}, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.ListArchived(&workspaceEntities.Data{IsApplicationAdmin: true})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
 	})
 }
 
@@ -326,7 +528,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.List(workspaceData)
 		assert.NoError(t, err)
@@ -344,11 +547,52 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.List(workspaceData)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should list workspaces by groups when oidc auth type", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("ListWorkspacesAuthTypeLdap").Return(workspaceResponse, nil)
+
+		appConfig := &app.Mock{}
+		appConfig.On("GetAuthenticationType").Return(authEnums.AuthenticationTypeOIDC)
+
+		databaseMock := &database.Mock{}
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.List(workspaceData)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
+		repositoryMock.AssertCalled(t, "ListWorkspacesAuthTypeLdap")
+	})
+
+	t.Run("should list workspaces by groups when saml auth type", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("ListWorkspacesAuthTypeLdap").Return(workspaceResponse, nil)
+
+		appConfig := &app.Mock{}
+		appConfig.On("GetAuthenticationType").Return(authEnums.AuthenticationTypeSAML)
+
+		databaseMock := &database.Mock{}
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.List(workspaceData)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		repositoryMock.AssertCalled(t, "ListWorkspacesAuthTypeLdap")
 	})
 
 	t.Run("should return error when failed to list with horusec auth type", func(t *testing.T) {
@@ -363,7 +607,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.List(workspaceData)
 		assert.Error(t, err)
@@ -380,7 +625,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.List(workspaceData)
 		assert.Error(t, err)
@@ -395,7 +641,8 @@ func TestList(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		workspaceData.IsApplicationAdmin = true
 		result, err := controller.List(workspaceData)
@@ -404,6 +651,35 @@ func TestList(t *testing.T) {
 	})
 }
 
+func TestGetRole(t *testing.T) {
+	data := &role.Data{AccountID: uuid.New(), WorkspaceID: uuid.New()}
+
+	t.Run("should success get the role of the account in the workspace", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{Role: account.Member}, nil)
+
+		controller := NewWorkspaceController(&broker.Broker{}, &database.Connection{}, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.GetRole(data)
+		assert.NoError(t, err)
+		assert.Equal(t, account.Member, result.Role)
+	})
+
+	t.Run("should return error when failed to get account workspace", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{}, errors.New("test"))
+
+		controller := NewWorkspaceController(&broker.Broker{}, &database.Connection{}, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.GetRole(data)
+		assert.Error(t, err)
+	})
+}
+
 func TestUpdateRole(t *testing.T) {
 	data := &role.Data{
 		Role: account.Admin,
@@ -428,7 +704,8 @@ func TestUpdateRole(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.UpdateRole(data)
 		assert.NoError(t, err)
@@ -444,11 +721,49 @@ func TestUpdateRole(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.UpdateRole(data)
 		assert.Error(t, err)
 	})
+
+	t.Run("should success assign a custom role as member", func(t *testing.T) {
+		customRoleID := uuid.New()
+
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{}, nil)
+		repositoryMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{}, nil)
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Update").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		result, err := controller.UpdateRole(&role.Data{Role: account.Admin, CustomRoleID: &customRoleID})
+		assert.NoError(t, err)
+		assert.Equal(t, account.Member, result.Role)
+		assert.Equal(t, &customRoleID, result.CustomRoleID)
+	})
+
+	t.Run("should return error when custom role is not of the workspace", func(t *testing.T) {
+		customRoleID := uuid.New()
+
+		repositoryMock := &workspaceRepository.Mock{}
+		repositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{}, databaseEnums.ErrorNotFoundRecords)
+
+		databaseMock := &database.Mock{}
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		_, err := controller.UpdateRole(&role.Data{CustomRoleID: &customRoleID})
+		assert.Equal(t, customRoleEnums.ErrorCustomRoleNotInWorkspace, err)
+	})
 }
 
 func TestInviteUser(t *testing.T) {
@@ -478,7 +793,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.NoError(t, err)
@@ -500,7 +816,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(brokerMock, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.InviteUser(data)
 		assert.NoError(t, err)
@@ -519,7 +836,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.InviteUser(data)
 		assert.Error(t, err)
@@ -534,7 +852,8 @@ func TestInviteUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.InviteUser(data)
 		assert.Error(t, err)
@@ -560,7 +879,8 @@ func TestGetUsers(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.GetUsers(uuid.New())
 		assert.NoError(t, err)
@@ -583,7 +903,8 @@ func TestRemoveUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		assert.NoError(t, controller.RemoveUser(data))
 	})
@@ -592,6 +913,24 @@ func TestRemoveUser(t *testing.T) {
 		repositoryMock := &workspaceRepository.Mock{}
 		appConfig := &app.Mock{}
 
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Twice().Return(&response.Response{})
+		databaseMock.On("Delete").Return(
+			response.NewResponse(0, errors.New("test"), nil))
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
+
+		assert.Error(t, controller.RemoveUser(data))
+		databaseMock.AssertNumberOfCalls(t, "Delete", 3)
+	})
+
+	t.Run("should return error when failed to remove user from teams", func(t *testing.T) {
+		repositoryMock := &workspaceRepository.Mock{}
+		appConfig := &app.Mock{}
+
 		databaseMock := &database.Mock{}
 		databaseMock.On("Delete").Once().Return(&response.Response{})
 		databaseMock.On("Delete").Return(
@@ -599,9 +938,11 @@ func TestRemoveUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		assert.Error(t, controller.RemoveUser(data))
+		databaseMock.AssertNumberOfCalls(t, "Delete", 2)
 	})
 
 	t.Run("should return error when failed to remove user from repositories", func(t *testing.T) {
@@ -614,7 +955,8 @@ func TestRemoveUser(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		assert.Error(t, controller.RemoveUser(data))
 	})
@@ -632,13 +974,29 @@ func TestCreateToken(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.CreateToken(data)
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
 	})
 
+	t.Run("should return error when the workspace reached the tokens limit", func(t *testing.T) {
+		quotaServiceMock := &quotaService.Mock{}
+		quotaServiceMock.On("CheckTokensLimit").Return(quotaEnums.ErrorTokensLimitReached)
+
+		databaseMock := &database.Mock{}
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), &workspaceRepository.Mock{}, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, &tokenService.Mock{}, quotaServiceMock)
+
+		_, err := controller.CreateToken(data)
+		assert.Equal(t, quotaEnums.ErrorTokensLimitReached, err)
+		databaseMock.AssertNotCalled(t, "Create")
+	})
+
 	t.Run("should return error while creating a new workspace token ", func(t *testing.T) {
 		repositoryMock := &workspaceRepository.Mock{}
 		appConfig := &app.Mock{}
@@ -649,7 +1007,8 @@ func TestCreateToken(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		_, err := controller.CreateToken(data)
 		assert.Error(t, err)
@@ -666,12 +1025,28 @@ func TestDeleteToken(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		assert.NoError(t, controller.DeleteToken(&tokenEntities.Data{}))
 	})
 }
 
+func TestRotateToken(t *testing.T) {
+	t.Run("should success rotate a workspace token", func(t *testing.T) {
+		serviceMock := &tokenService.Mock{}
+		serviceMock.On("Rotate").Return("token", nil)
+
+		controller := NewWorkspaceController(&broker.Broker{}, &database.Connection{}, &app.Mock{},
+			workspaceUseCases.NewWorkspaceUseCases(), &workspaceRepository.Mock{}, tokenUseCases.NewTokenUseCases(),
+			&archiveService.Mock{}, serviceMock, newQuotaServiceMock())
+
+		result, err := controller.RotateToken(&tokenEntities.RotateData{})
+		assert.NoError(t, err)
+		assert.Equal(t, "token", result)
+	})
+}
+
 func TestListTokens(t *testing.T) {
 	t.Run("should success list workspace tokens", func(t *testing.T) {
 		repositoryMock := &workspaceRepository.Mock{}
@@ -682,7 +1057,8 @@ func TestListTokens(t *testing.T) {
 
 		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
 		controller := NewWorkspaceController(&broker.Broker{}, databaseConnection, appConfig,
-			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases())
+			workspaceUseCases.NewWorkspaceUseCases(), repositoryMock, tokenUseCases.NewTokenUseCases(), &archiveService.Mock{},
+			&tokenService.Mock{}, newQuotaServiceMock())
 
 		result, err := controller.ListTokens(uuid.New())
 		assert.NoError(t, err)
@@ -0,0 +1,42 @@
+package archive
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+)
+
+// Event is published to the archive exchange so the other services can hide, show again or purge the data
+// of a workspace or repository, the repository id is empty when the event refers to the whole workspace
+type Event struct {
+	Action       archiveEnums.Action `json:"action"`
+	WorkspaceID  uuid.UUID           `json:"workspaceID"`
+	RepositoryID uuid.UUID           `json:"repositoryID"`
+	CreatedAt    time.Time           `json:"createdAt"`
+}
+
+func NewWorkspaceEvent(action archiveEnums.Action, workspaceID uuid.UUID) *Event {
+	return &Event{
+		Action:      action,
+		WorkspaceID: workspaceID,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func NewRepositoryEvent(action archiveEnums.Action, workspaceID, repositoryID uuid.UUID) *Event {
+	return &Event{
+		Action:       action,
+		WorkspaceID:  workspaceID,
+		RepositoryID: repositoryID,
+		CreatedAt:    time.Now(),
+	}
+}
+
+func (e *Event) ToBytes() []byte {
+	bytes, _ := json.Marshal(e)
+
+	return bytes
+}
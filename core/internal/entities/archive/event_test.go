@@ -0,0 +1,42 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+)
+
+func TestNewWorkspaceEvent(t *testing.T) {
+	t.Run("should success create a workspace event without repository", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		event := NewWorkspaceEvent(archiveEnums.ActionArchived, workspaceID)
+		assert.Equal(t, archiveEnums.ActionArchived, event.Action)
+		assert.Equal(t, workspaceID, event.WorkspaceID)
+		assert.Equal(t, uuid.Nil, event.RepositoryID)
+		assert.False(t, event.CreatedAt.IsZero())
+	})
+}
+
+func TestNewRepositoryEvent(t *testing.T) {
+	t.Run("should success create a repository event", func(t *testing.T) {
+		workspaceID := uuid.New()
+		repositoryID := uuid.New()
+
+		event := NewRepositoryEvent(archiveEnums.ActionRestored, workspaceID, repositoryID)
+		assert.Equal(t, archiveEnums.ActionRestored, event.Action)
+		assert.Equal(t, workspaceID, event.WorkspaceID)
+		assert.Equal(t, repositoryID, event.RepositoryID)
+	})
+}
+
+func TestToBytesEvent(t *testing.T) {
+	t.Run("should success parse event to bytes", func(t *testing.T) {
+		event := NewWorkspaceEvent(archiveEnums.ActionPurged, uuid.New())
+
+		assert.NotEmpty(t, event.ToBytes())
+	})
+}
@@ -0,0 +1,21 @@
+package archive
+
+import (
+	"github.com/google/uuid"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+)
+
+// Expired represents a workspace or repository that was archived longer than the retention period
+type Expired struct {
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+}
+
+func (e *Expired) ToPurgedEvent() *Event {
+	if e.RepositoryID == uuid.Nil {
+		return NewWorkspaceEvent(archiveEnums.ActionPurged, e.WorkspaceID)
+	}
+
+	return NewRepositoryEvent(archiveEnums.ActionPurged, e.WorkspaceID, e.RepositoryID)
+}
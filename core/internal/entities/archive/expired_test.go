@@ -0,0 +1,29 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+)
+
+func TestToPurgedEvent(t *testing.T) {
+	t.Run("should return a workspace purged event when repository is empty", func(t *testing.T) {
+		expired := &Expired{WorkspaceID: uuid.New()}
+
+		event := expired.ToPurgedEvent()
+		assert.Equal(t, archiveEnums.ActionPurged, event.Action)
+		assert.Equal(t, expired.WorkspaceID, event.WorkspaceID)
+		assert.Equal(t, uuid.Nil, event.RepositoryID)
+	})
+
+	t.Run("should return a repository purged event", func(t *testing.T) {
+		expired := &Expired{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		event := expired.ToPurgedEvent()
+		assert.Equal(t, archiveEnums.ActionPurged, event.Action)
+		assert.Equal(t, expired.RepositoryID, event.RepositoryID)
+	})
+}
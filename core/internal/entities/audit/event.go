@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+// Event is published to the audit exchange by every service after an administrative action succeeds, the ids that
+// do not apply to the action are kept empty
+type Event struct {
+	EventID      uuid.UUID             `json:"eventID"`
+	Service      string                `json:"service"`
+	Action       auditEnums.Action     `json:"action"`
+	ActorID      uuid.UUID             `json:"actorID"`
+	ActorEmail   string                `json:"actorEmail"`
+	IP           string                `json:"ip"`
+	WorkspaceID  uuid.UUID             `json:"workspaceID"`
+	RepositoryID uuid.UUID             `json:"repositoryID"`
+	TargetType   auditEnums.TargetType `json:"targetType"`
+	TargetID     uuid.UUID             `json:"targetID"`
+	Before       json.RawMessage       `json:"before,omitempty" swaggertype:"object"`
+	After        json.RawMessage       `json:"after,omitempty" swaggertype:"object"`
+	OccurredAt   time.Time             `json:"occurredAt"`
+}
+
+func NewEvent(action auditEnums.Action, targetType auditEnums.TargetType, targetID uuid.UUID) *Event {
+	return &Event{
+		EventID:    uuid.New(),
+		Service:    auditEnums.ServiceCore,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		OccurredAt: time.Now(),
+	}
+}
+
+func (e *Event) SetScope(workspaceID, repositoryID uuid.UUID) *Event {
+	e.WorkspaceID = workspaceID
+	e.RepositoryID = repositoryID
+
+	return e
+}
+
+// SetChanges keeps the state of the target before and after the action, nil means that the state does not exist,
+// like the before of a creation
+func (e *Event) SetChanges(before, after interface{}) *Event {
+	e.Before = e.toRawMessage(before)
+	e.After = e.toRawMessage(after)
+
+	return e
+}
+
+func (e *Event) toRawMessage(value interface{}) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+
+	bytes, _ := json.Marshal(value)
+	return bytes
+}
+
+// SetActor does nothing when the account could not be found, so the action is still recorded without the actor
+func (e *Event) SetActor(accountData *proto.GetAccountDataResponse) *Event {
+	if accountData == nil {
+		return e
+	}
+
+	e.ActorID, _ = uuid.Parse(accountData.AccountID)
+	e.ActorEmail = accountData.Email
+
+	return e
+}
+
+// SetIP uses the remote address of the request, which already contains the real ip set by the router
+func (e *Event) SetIP(r *http.Request) *Event {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		e.IP = r.RemoteAddr
+		return e
+	}
+
+	e.IP = host
+	return e
+}
+
+func (e *Event) ToBytes() []byte {
+	bytes, _ := json.Marshal(e)
+
+	return bytes
+}
+
+func (e *Event) ToRecord() *Record {
+	return &Record{
+		EventID:      e.EventID,
+		Service:      e.Service,
+		Action:       string(e.Action),
+		ActorID:      e.ActorID,
+		ActorEmail:   e.ActorEmail,
+		IP:           e.IP,
+		WorkspaceID:  e.WorkspaceID,
+		RepositoryID: e.RepositoryID,
+		TargetType:   string(e.TargetType),
+		TargetID:     e.TargetID,
+		Before:       string(e.Before),
+		After:        string(e.After),
+		OccurredAt:   e.OccurredAt.UTC().Truncate(time.Microsecond),
+	}
+}
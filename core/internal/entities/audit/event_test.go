@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+func TestNewEvent(t *testing.T) {
+	t.Run("should success create a new core event", func(t *testing.T) {
+		targetID := uuid.New()
+
+		event := NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, targetID)
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, auditEnums.ServiceCore, event.Service)
+		assert.Equal(t, auditEnums.ActionWorkspaceCreate, event.Action)
+		assert.Equal(t, auditEnums.TargetWorkspace, event.TargetType)
+		assert.Equal(t, targetID, event.TargetID)
+		assert.False(t, event.OccurredAt.IsZero())
+	})
+}
+
+func TestSetScope(t *testing.T) {
+	t.Run("should success set workspace and repository", func(t *testing.T) {
+		workspaceID, repositoryID := uuid.New(), uuid.New()
+
+		event := (&Event{}).SetScope(workspaceID, repositoryID)
+		assert.Equal(t, workspaceID, event.WorkspaceID)
+		assert.Equal(t, repositoryID, event.RepositoryID)
+	})
+}
+
+func TestSetChanges(t *testing.T) {
+	t.Run("should success set before and after as json", func(t *testing.T) {
+		event := (&Event{}).SetChanges(map[string]string{"role": "member"}, map[string]string{"role": "admin"})
+		assert.JSONEq(t, `{"role": "member"}`, string(event.Before))
+		assert.JSONEq(t, `{"role": "admin"}`, string(event.After))
+	})
+
+	t.Run("should keep before empty when nil", func(t *testing.T) {
+		event := (&Event{}).SetChanges(nil, map[string]string{"name": "test"})
+		assert.Nil(t, event.Before)
+		assert.NotNil(t, event.After)
+	})
+}
+
+func TestSetActor(t *testing.T) {
+	t.Run("should success set actor from account data", func(t *testing.T) {
+		accountID := uuid.New()
+
+		event := (&Event{}).SetActor(&proto.GetAccountDataResponse{AccountID: accountID.String(),
+			Email: "test@test.com"})
+		assert.Equal(t, accountID, event.ActorID)
+		assert.Equal(t, "test@test.com", event.ActorEmail)
+	})
+
+	t.Run("should keep actor empty when account data is nil", func(t *testing.T) {
+		event := (&Event{}).SetActor(nil)
+		assert.Equal(t, uuid.Nil, event.ActorID)
+		assert.Empty(t, event.ActorEmail)
+	})
+}
+
+func TestSetIP(t *testing.T) {
+	t.Run("should remove the port of the remote address", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r.RemoteAddr = "10.0.0.1:5432"
+
+		assert.Equal(t, "10.0.0.1", (&Event{}).SetIP(r).IP)
+	})
+
+	t.Run("should keep remote address when it has no port", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r.RemoteAddr = "10.0.0.1"
+
+		assert.Equal(t, "10.0.0.1", (&Event{}).SetIP(r).IP)
+	})
+}
+
+func TestEventToBytes(t *testing.T) {
+	t.Run("should success parse event to bytes", func(t *testing.T) {
+		event := NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, uuid.New())
+
+		parsed := &Event{}
+		assert.NoError(t, json.Unmarshal(event.ToBytes(), parsed))
+		assert.Equal(t, event.EventID, parsed.EventID)
+	})
+}
+
+func TestToRecord(t *testing.T) {
+	t.Run("should success parse event to record in utc", func(t *testing.T) {
+		event := NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, uuid.New()).
+			SetChanges(nil, map[string]string{"name": "test"})
+		event.OccurredAt = time.Date(2021, 1, 1, 1, 1, 1, 1999, time.FixedZone("test", 3600))
+
+		record := event.ToRecord()
+		assert.Equal(t, event.EventID, record.EventID)
+		assert.Equal(t, string(auditEnums.ActionWorkspaceCreate), record.Action)
+		assert.Empty(t, record.Before)
+		assert.JSONEq(t, `{"name": "test"}`, record.After)
+		assert.Equal(t, time.Date(2021, 1, 1, 0, 1, 1, 1000, time.UTC), record.OccurredAt)
+	})
+}
@@ -0,0 +1,165 @@
+package audit
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+type Filter struct {
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	ActorID      uuid.UUID `json:"actorID"`
+	Action       string    `json:"action"`
+	TargetType   string    `json:"targetType"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+	Page         int       `json:"page"`
+	Size         int       `json:"size"`
+}
+
+func (f *Filter) SetFilterDataFromRequest(r *http.Request) error {
+	f.setPagination(r)
+	f.Action = r.URL.Query().Get(auditEnums.ActionQuery)
+	f.TargetType = r.URL.Query().Get(auditEnums.TargetTypeQuery)
+
+	if err := f.setIDsFromRequest(r); err != nil {
+		return err
+	}
+
+	return f.setDatesFromRequest(r)
+}
+
+// setPagination ignores invalid values, since the page and size are optional
+func (f *Filter) setPagination(r *http.Request) {
+	f.Page, _ = strconv.Atoi(r.URL.Query().Get(auditEnums.Page))
+	f.Size, _ = strconv.Atoi(r.URL.Query().Get(auditEnums.Size))
+
+	if f.Size <= 0 {
+		f.Size = auditEnums.DefaultPaginationSize
+	}
+
+	if f.Size > auditEnums.MaxPaginationSize {
+		f.Size = auditEnums.MaxPaginationSize
+	}
+}
+
+func (f *Filter) setIDsFromRequest(r *http.Request) (err error) {
+	if f.WorkspaceID, err = f.parseID(r.URL.Query().Get(auditEnums.WorkspaceIDQuery)); err != nil {
+		return err
+	}
+
+	if f.RepositoryID, err = f.parseID(r.URL.Query().Get(auditEnums.RepositoryIDQuery)); err != nil {
+		return err
+	}
+
+	f.ActorID, err = f.parseID(r.URL.Query().Get(auditEnums.ActorIDQuery))
+	return err
+}
+
+func (f *Filter) parseID(value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, nil
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, auditEnums.ErrorInvalidFilterID
+	}
+
+	return id, nil
+}
+
+func (f *Filter) setDatesFromRequest(r *http.Request) (err error) {
+	if f.From, err = f.parseDate(r.URL.Query().Get(auditEnums.FromQuery)); err != nil {
+		return err
+	}
+
+	f.To, err = f.parseDate(r.URL.Query().Get(auditEnums.ToQuery))
+	return err
+}
+
+func (f *Filter) parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, auditEnums.ErrorInvalidFilterDate
+	}
+
+	return date.UTC(), nil
+}
+
+// SetWorkspaceID limits the filter to a single workspace, overwriting the one informed in the query
+func (f *Filter) SetWorkspaceID(workspaceID uuid.UUID) *Filter {
+	f.WorkspaceID = workspaceID
+
+	return f
+}
+
+// SetExportPagination exports all the filtered events at once, up to the export limit
+func (f *Filter) SetExportPagination() *Filter {
+	f.Page = 0
+	f.Size = auditEnums.MaxExportSize
+
+	return f
+}
+
+func (f *Filter) Validate() error {
+	return validation.ValidateStruct(f,
+		validation.Field(&f.Page, validation.Min(0)),
+		validation.Field(&f.Size, validation.Min(1), validation.Max(auditEnums.MaxExportSize)),
+		validation.Field(&f.Action, validation.Length(0, 255)),
+		validation.Field(&f.TargetType, validation.Length(0, 255)),
+	)
+}
+
+func (f *Filter) GetWhereFilterQuery() (string, []interface{}) {
+	query, params := "1 = 1", []interface{}{}
+	query, params = f.appendIDQuery(query, params, "workspace_id", f.WorkspaceID)
+	query, params = f.appendIDQuery(query, params, "repository_id", f.RepositoryID)
+	query, params = f.appendIDQuery(query, params, "actor_id", f.ActorID)
+	query, params = f.appendValueQuery(query, params, "action", f.Action)
+	query, params = f.appendValueQuery(query, params, "target_type", f.TargetType)
+
+	return f.appendDatesQuery(query, params)
+}
+
+func (f *Filter) appendIDQuery(query string, params []interface{}, column string,
+	id uuid.UUID) (string, []interface{}) {
+	if id == uuid.Nil {
+		return query, params
+	}
+
+	return query + " AND " + column + " = ? ", append(params, id)
+}
+
+func (f *Filter) appendValueQuery(query string, params []interface{}, column,
+	value string) (string, []interface{}) {
+	if value == "" {
+		return query, params
+	}
+
+	return query + " AND " + column + " = ? ", append(params, value)
+}
+
+func (f *Filter) appendDatesQuery(query string, params []interface{}) (string, []interface{}) {
+	if !f.From.IsZero() {
+		query += " AND occurred_at >= ? "
+		params = append(params, f.From)
+	}
+
+	if !f.To.IsZero() {
+		query += " AND occurred_at <= ? "
+		params = append(params, f.To)
+	}
+
+	return query, params
+}
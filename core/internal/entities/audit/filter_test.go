@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+func newFilterRequest(query string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, "test?"+query, nil)
+
+	return r
+}
+
+func TestSetFilterDataFromRequest(t *testing.T) {
+	t.Run("should success set all filters", func(t *testing.T) {
+		workspaceID, repositoryID, actorID := uuid.New(), uuid.New(), uuid.New()
+		filter := &Filter{}
+
+		assert.NoError(t, filter.SetFilterDataFromRequest(newFilterRequest("page=2&size=50&workspaceID="+
+			workspaceID.String()+"&repositoryID="+repositoryID.String()+"&actorID="+actorID.String()+
+			"&action=workspace.create&targetType=workspace&from=2021-01-01T00:00:00Z&to=2021-02-01T00:00:00Z")))
+		assert.Equal(t, 2, filter.Page)
+		assert.Equal(t, 50, filter.Size)
+		assert.Equal(t, workspaceID, filter.WorkspaceID)
+		assert.Equal(t, repositoryID, filter.RepositoryID)
+		assert.Equal(t, actorID, filter.ActorID)
+		assert.Equal(t, "workspace.create", filter.Action)
+		assert.Equal(t, "workspace", filter.TargetType)
+		assert.False(t, filter.From.IsZero())
+		assert.False(t, filter.To.IsZero())
+	})
+
+	t.Run("should use default and max page size", func(t *testing.T) {
+		filter := &Filter{}
+
+		assert.NoError(t, filter.SetFilterDataFromRequest(newFilterRequest("")))
+		assert.Equal(t, auditEnums.DefaultPaginationSize, filter.Size)
+
+		assert.NoError(t, filter.SetFilterDataFromRequest(newFilterRequest("size=100000")))
+		assert.Equal(t, auditEnums.MaxPaginationSize, filter.Size)
+	})
+
+	t.Run("should return error when invalid id", func(t *testing.T) {
+		assert.Equal(t, auditEnums.ErrorInvalidFilterID,
+			(&Filter{}).SetFilterDataFromRequest(newFilterRequest("workspaceID=test")))
+		assert.Equal(t, auditEnums.ErrorInvalidFilterID,
+			(&Filter{}).SetFilterDataFromRequest(newFilterRequest("repositoryID=test")))
+		assert.Equal(t, auditEnums.ErrorInvalidFilterID,
+			(&Filter{}).SetFilterDataFromRequest(newFilterRequest("actorID=test")))
+	})
+
+	t.Run("should return error when invalid date", func(t *testing.T) {
+		assert.Equal(t, auditEnums.ErrorInvalidFilterDate,
+			(&Filter{}).SetFilterDataFromRequest(newFilterRequest("from=test")))
+		assert.Equal(t, auditEnums.ErrorInvalidFilterDate,
+			(&Filter{}).SetFilterDataFromRequest(newFilterRequest("to=test")))
+	})
+}
+
+func TestSetWorkspaceID(t *testing.T) {
+	t.Run("should overwrite the workspace of the query", func(t *testing.T) {
+		workspaceID := uuid.New()
+
+		assert.Equal(t, workspaceID, (&Filter{WorkspaceID: uuid.New()}).SetWorkspaceID(workspaceID).WorkspaceID)
+	})
+}
+
+func TestSetExportPagination(t *testing.T) {
+	t.Run("should set the first page with the export size", func(t *testing.T) {
+		filter := (&Filter{Page: 3, Size: 10}).SetExportPagination()
+		assert.Equal(t, 0, filter.Page)
+		assert.Equal(t, auditEnums.MaxExportSize, filter.Size)
+	})
+}
+
+func TestFilterValidate(t *testing.T) {
+	t.Run("should return no error when valid filter", func(t *testing.T) {
+		assert.NoError(t, (&Filter{Size: 10}).Validate())
+	})
+
+	t.Run("should return error when invalid page", func(t *testing.T) {
+		assert.Error(t, (&Filter{Page: -1, Size: 10}).Validate())
+	})
+}
+
+func TestGetWhereFilterQuery(t *testing.T) {
+	t.Run("should return query with all filters", func(t *testing.T) {
+		filter := &Filter{}
+		_ = filter.SetFilterDataFromRequest(newFilterRequest("workspaceID=" + uuid.NewString() +
+			"&repositoryID=" + uuid.NewString() + "&actorID=" + uuid.NewString() +
+			"&action=test&targetType=test&from=2021-01-01T00:00:00Z&to=2021-02-01T00:00:00Z"))
+
+		query, params := filter.GetWhereFilterQuery()
+		assert.Contains(t, query, "workspace_id = ?")
+		assert.Contains(t, query, "repository_id = ?")
+		assert.Contains(t, query, "actor_id = ?")
+		assert.Contains(t, query, "action = ?")
+		assert.Contains(t, query, "target_type = ?")
+		assert.Contains(t, query, "occurred_at >= ?")
+		assert.Contains(t, query, "occurred_at <= ?")
+		assert.Len(t, params, 7)
+	})
+
+	t.Run("should return query without filters", func(t *testing.T) {
+		query, params := (&Filter{}).GetWhereFilterQuery()
+		assert.Equal(t, "1 = 1", query)
+		assert.Empty(t, params)
+	})
+}
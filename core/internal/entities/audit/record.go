@@ -0,0 +1,100 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+// Record is an audit event persisted in the hash chain, the hash of each record covers its content and the hash
+// of the previous record, so changing or removing any record breaks all the hashes after it
+type Record struct {
+	Sequence     int64     `json:"sequence" gorm:"primary_key"`
+	EventID      uuid.UUID `json:"eventID"`
+	Service      string    `json:"service"`
+	Action       string    `json:"action"`
+	ActorID      uuid.UUID `json:"actorID"`
+	ActorEmail   string    `json:"actorEmail"`
+	IP           string    `json:"ip"`
+	WorkspaceID  uuid.UUID `json:"workspaceID"`
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	TargetType   string    `json:"targetType"`
+	TargetID     uuid.UUID `json:"targetID"`
+	Before       string    `json:"before"`
+	After        string    `json:"after"`
+	OccurredAt   time.Time `json:"occurredAt"`
+	PreviousHash string    `json:"previousHash"`
+	Hash         string    `json:"hash"`
+}
+
+// SetChain links the record to the last record of the chain, the sequence is left to the database
+func (r *Record) SetChain(previousHash string) *Record {
+	r.Sequence = 0
+	r.PreviousHash = previousHash
+	r.Hash = r.ComputeHash()
+
+	return r
+}
+
+func (r *Record) ComputeHash() string {
+	hash := sha256.Sum256(append([]byte(r.PreviousHash), r.toHashContent()...))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// toHashContent uses a fixed time layout since the database does not keep the location and nanoseconds
+func (r *Record) toHashContent() []byte {
+	bytes, _ := json.Marshal([]string{r.EventID.String(), r.Service, r.Action, r.ActorID.String(), r.ActorEmail,
+		r.IP, r.WorkspaceID.String(), r.RepositoryID.String(), r.TargetType, r.TargetID.String(), r.Before,
+		r.After, r.OccurredAt.UTC().Format(auditEnums.TimeLayout)})
+
+	return bytes
+}
+
+// IsLinkedTo checks if the record was not changed and comes right after the informed hash
+func (r *Record) IsLinkedTo(previousHash string) bool {
+	return r.PreviousHash == previousHash && r.Hash == r.ComputeHash()
+}
+
+//nolint:funlen // mapping of all the columns need to be bigger than 15
+func (r *Record) ToResponse() *Response {
+	return &Response{
+		Sequence:     r.Sequence,
+		EventID:      r.EventID,
+		Service:      r.Service,
+		Action:       r.Action,
+		ActorID:      r.ActorID,
+		ActorEmail:   r.ActorEmail,
+		IP:           r.IP,
+		WorkspaceID:  r.WorkspaceID,
+		RepositoryID: r.RepositoryID,
+		TargetType:   r.TargetType,
+		TargetID:     r.TargetID,
+		Before:       r.toRawMessage(r.Before),
+		After:        r.toRawMessage(r.After),
+		OccurredAt:   r.OccurredAt,
+		PreviousHash: r.PreviousHash,
+		Hash:         r.Hash,
+	}
+}
+
+func (r *Record) toRawMessage(value string) json.RawMessage {
+	if value == "" {
+		return nil
+	}
+
+	return json.RawMessage(value)
+}
+
+func (r *Record) ToCSVRow() []string {
+	return []string{strconv.FormatInt(r.Sequence, 10), r.EventID.String(), r.Service, r.Action,
+		r.ActorID.String(), r.ActorEmail, r.IP, r.WorkspaceID.String(), r.RepositoryID.String(), r.TargetType,
+		r.TargetID.String(), r.Before, r.After, r.OccurredAt.UTC().Format(auditEnums.TimeLayout), r.PreviousHash,
+		r.Hash}
+}
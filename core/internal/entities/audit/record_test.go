@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+func newTestRecord() *Record {
+	return NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, uuid.New()).
+		SetChanges(nil, map[string]string{"name": "test"}).ToRecord()
+}
+
+func TestSetChain(t *testing.T) {
+	t.Run("should link the record to the previous hash", func(t *testing.T) {
+		record := newTestRecord()
+		record.Sequence = 10
+
+		record.SetChain(auditEnums.GenesisHash)
+		assert.Equal(t, int64(0), record.Sequence)
+		assert.Equal(t, auditEnums.GenesisHash, record.PreviousHash)
+		assert.Len(t, record.Hash, 64)
+	})
+}
+
+func TestComputeHash(t *testing.T) {
+	t.Run("should change the hash when the content changes", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+		hash := record.Hash
+
+		record.ActorEmail = "changed@test.com"
+		assert.NotEqual(t, hash, record.ComputeHash())
+	})
+
+	t.Run("should keep the same hash when the time location changes", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+		hash := record.Hash
+
+		record.OccurredAt = record.OccurredAt.In(time.FixedZone("test", 3600))
+		assert.Equal(t, hash, record.ComputeHash())
+	})
+}
+
+func TestIsLinkedTo(t *testing.T) {
+	t.Run("should return true when linked and not changed", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+
+		assert.True(t, record.IsLinkedTo(auditEnums.GenesisHash))
+	})
+
+	t.Run("should return false when linked to another record", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+
+		assert.False(t, record.IsLinkedTo("test"))
+	})
+
+	t.Run("should return false when changed", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+		record.After = `{"name": "changed"}`
+
+		assert.False(t, record.IsLinkedTo(auditEnums.GenesisHash))
+	})
+}
+
+func TestRecordToResponse(t *testing.T) {
+	t.Run("should success parse record to response with raw changes", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+
+		response := record.ToResponse()
+		assert.Equal(t, record.EventID, response.EventID)
+		assert.Equal(t, record.Hash, response.Hash)
+		assert.Nil(t, response.Before)
+		assert.JSONEq(t, `{"name": "test"}`, string(response.After))
+	})
+}
+
+func TestToCSVRow(t *testing.T) {
+	t.Run("should return a value for each csv column", func(t *testing.T) {
+		record := newTestRecord().SetChain(auditEnums.GenesisHash)
+
+		assert.Len(t, record.ToCSVRow(), len(auditEnums.CSVHeader()))
+	})
+}
@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Response struct {
+	Sequence     int64           `json:"sequence"`
+	EventID      uuid.UUID       `json:"eventID"`
+	Service      string          `json:"service"`
+	Action       string          `json:"action"`
+	ActorID      uuid.UUID       `json:"actorID"`
+	ActorEmail   string          `json:"actorEmail"`
+	IP           string          `json:"ip"`
+	WorkspaceID  uuid.UUID       `json:"workspaceID"`
+	RepositoryID uuid.UUID       `json:"repositoryID"`
+	TargetType   string          `json:"targetType"`
+	TargetID     uuid.UUID       `json:"targetID"`
+	Before       json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After        json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+	OccurredAt   time.Time       `json:"occurredAt"`
+	PreviousHash string          `json:"previousHash"`
+	Hash         string          `json:"hash"`
+}
+
+type ListResponse struct {
+	Events []*Response `json:"events"`
+	Total  int         `json:"total"`
+}
+
+func NewListResponse(records *[]Record, total int) *ListResponse {
+	response := &ListResponse{Events: []*Response{}, Total: total}
+
+	for index := range *records {
+		response.Events = append(response.Events, (*records)[index].ToResponse())
+	}
+
+	return response
+}
+
+// Verification is the result of walking the whole hash chain, the broken sequence is the first record that was
+// changed or that is not linked to the previous one
+type Verification struct {
+	Valid            bool  `json:"valid"`
+	CheckedEvents    int   `json:"checkedEvents"`
+	BrokenAtSequence int64 `json:"brokenAtSequence,omitempty"`
+}
@@ -0,0 +1,23 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListResponse(t *testing.T) {
+	t.Run("should success create list response with total", func(t *testing.T) {
+		records := &[]Record{*newTestRecord(), *newTestRecord()}
+
+		response := NewListResponse(records, 5)
+		assert.Len(t, response.Events, 2)
+		assert.Equal(t, 5, response.Total)
+	})
+
+	t.Run("should return empty list when there are no records", func(t *testing.T) {
+		response := NewListResponse(&[]Record{}, 0)
+		assert.NotNil(t, response.Events)
+		assert.Empty(t, response.Events)
+	})
+}
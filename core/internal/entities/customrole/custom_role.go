@@ -0,0 +1,39 @@
+package customrole
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type CustomRole struct {
+	CustomRoleID uuid.UUID      `json:"customRoleID" gorm:"primary_key"`
+	WorkspaceID  uuid.UUID      `json:"workspaceID"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	Permissions  pq.StringArray `json:"permissions" gorm:"type:text[]"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+}
+
+func (c *CustomRole) Update(data *Data) *CustomRole {
+	c.Name = data.Name
+	c.Description = data.Description
+	c.Permissions = data.permissionsToStringArray()
+	c.UpdatedAt = time.Now()
+
+	return c
+}
+
+func (c *CustomRole) ToResponse() *Response {
+	return &Response{
+		CustomRoleID: c.CustomRoleID,
+		WorkspaceID:  c.WorkspaceID,
+		Name:         c.Name,
+		Description:  c.Description,
+		Permissions:  c.Permissions,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
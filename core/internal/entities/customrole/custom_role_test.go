@@ -0,0 +1,36 @@
+package customrole
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Run("should success update custom role name, description and permissions", func(t *testing.T) {
+		customRole := &CustomRole{}
+
+		_ = customRole.Update(&Data{Name: "test", Description: "test",
+			Permissions: []permissionEnums.Permission{permissionEnums.AuditRead}})
+		assert.Equal(t, "test", customRole.Name)
+		assert.Equal(t, "test", customRole.Description)
+		assert.Equal(t, []string{"audit.read"}, []string(customRole.Permissions))
+		assert.NotEmpty(t, customRole.UpdatedAt)
+	})
+}
+
+func TestToResponse(t *testing.T) {
+	t.Run("should success parse custom role to response", func(t *testing.T) {
+		customRole := &CustomRole{CustomRoleID: uuid.New(), WorkspaceID: uuid.New(), Name: "test",
+			Permissions: []string{"audit.read"}}
+
+		response := customRole.ToResponse()
+		assert.Equal(t, customRole.CustomRoleID, response.CustomRoleID)
+		assert.Equal(t, customRole.WorkspaceID, response.WorkspaceID)
+		assert.Equal(t, customRole.Name, response.Name)
+		assert.Equal(t, customRole.Permissions, response.Permissions)
+	})
+}
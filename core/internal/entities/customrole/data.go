@@ -0,0 +1,76 @@
+package customrole
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+type Data struct {
+	CustomRoleID uuid.UUID                    `json:"customRoleID" swaggerignore:"true"`
+	WorkspaceID  uuid.UUID                    `json:"workspaceID" swaggerignore:"true"`
+	Name         string                       `json:"name" example:"Triager"`
+	Description  string                       `json:"description"`
+	Permissions  []permissionEnums.Permission `json:"permissions" example:"vulnerability.read,vulnerability.type.update"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&d.Description, validation.Length(0, 255)),
+		validation.Field(&d.Permissions, validation.Required, validation.Each(validation.By(d.validatePermission))),
+		validation.Field(&d.CustomRoleID, is.UUID),
+		validation.Field(&d.WorkspaceID, is.UUID),
+	)
+}
+
+func (d *Data) validatePermission(value interface{}) error {
+	permission, _ := value.(permissionEnums.Permission)
+	if !permission.IsValid() {
+		return customRoleEnums.ErrorInvalidPermission
+	}
+
+	return nil
+}
+
+func (d *Data) SetIDs(workspaceID, customRoleID string) *Data {
+	d.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+	d.CustomRoleID = parser.ParseStringToUUID(customRoleID)
+
+	return d
+}
+
+func (d *Data) ToCustomRole() *CustomRole {
+	return &CustomRole{
+		CustomRoleID: uuid.New(),
+		WorkspaceID:  d.WorkspaceID,
+		Name:         d.Name,
+		Description:  d.Description,
+		Permissions:  d.permissionsToStringArray(),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+// permissionsToStringArray also removes the repeated permissions
+func (d *Data) permissionsToStringArray() pq.StringArray {
+	permissions := pq.StringArray{}
+	added := map[permissionEnums.Permission]bool{}
+
+	for _, permission := range d.Permissions {
+		if !added[permission] {
+			permissions = append(permissions, permission.ToString())
+			added[permission] = true
+		}
+	}
+
+	return permissions
+}
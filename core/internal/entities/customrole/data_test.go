@@ -0,0 +1,61 @@
+package customrole
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func TestValidateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &Data{Name: "test", Permissions: []permissionEnums.Permission{permissionEnums.VulnerabilityRead}}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when empty name", func(t *testing.T) {
+		data := &Data{Permissions: []permissionEnums.Permission{permissionEnums.VulnerabilityRead}}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when empty permissions", func(t *testing.T) {
+		data := &Data{Name: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid permission", func(t *testing.T) {
+		data := &Data{Name: "test", Permissions: []permissionEnums.Permission{"test"}}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsData(t *testing.T) {
+	t.Run("should success set ids", func(t *testing.T) {
+		id := uuid.New()
+		data := (&Data{}).SetIDs(id.String(), id.String())
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.CustomRoleID)
+	})
+}
+
+func TestToCustomRole(t *testing.T) {
+	t.Run("should success parse data to custom role without repeated permissions", func(t *testing.T) {
+		data := &Data{WorkspaceID: uuid.New(), Name: "test", Description: "test",
+			Permissions: []permissionEnums.Permission{permissionEnums.AuditRead, permissionEnums.AuditRead}}
+
+		customRole := data.ToCustomRole()
+		assert.NotEqual(t, uuid.Nil, customRole.CustomRoleID)
+		assert.Equal(t, data.WorkspaceID, customRole.WorkspaceID)
+		assert.Equal(t, data.Name, customRole.Name)
+		assert.Equal(t, data.Description, customRole.Description)
+		assert.Equal(t, []string{"audit.read"}, []string(customRole.Permissions))
+		assert.NotEmpty(t, customRole.CreatedAt)
+	})
+}
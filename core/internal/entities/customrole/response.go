@@ -0,0 +1,18 @@
+package customrole
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type Response struct {
+	CustomRoleID uuid.UUID      `json:"customRoleID"`
+	WorkspaceID  uuid.UUID      `json:"workspaceID"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	Permissions  pq.StringArray `json:"permissions" gorm:"type:text[]" swaggertype:"array,string"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	UpdatedAt    time.Time      `json:"updatedAt"`
+}
@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+type Data struct {
+	WorkspaceID    uuid.UUID              `json:"workspaceID" swaggerignore:"true"`
+	AccountID      uuid.UUID              `json:"accountID" swaggerignore:"true"`
+	Provider       importerEnums.Provider `json:"provider" enums:"GITHUB,GITLAB,BITBUCKET"`
+	BaseURL        string                 `json:"baseURL" example:"https://api.github.com"`
+	Organization   string                 `json:"organization"`
+	Username       string                 `json:"username"`
+	AccessToken    string                 `json:"accessToken"`
+	GenerateTokens bool                   `json:"generateTokens"`
+	PeriodicSync   bool                   `json:"periodicSync"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.WorkspaceID, is.UUID),
+		validation.Field(&d.AccountID, is.UUID),
+		validation.Field(&d.Provider, validation.Required, validation.In(importerEnums.ProviderValues()...)),
+		validation.Field(&d.BaseURL, is.URL, validation.Length(0, 255), validation.By(d.validateBaseURL)),
+		validation.Field(&d.Organization, validation.Required, validation.Length(1, 255)),
+		validation.Field(&d.Username, validation.By(d.validateUsername), validation.Length(0, 255)),
+		validation.Field(&d.AccessToken, validation.Required),
+	)
+}
+
+// validateUsername checks the username used with the app password, only bitbucket needs it for basic auth
+func (d *Data) validateUsername(_ interface{}) error {
+	if d.Provider == importerEnums.ProviderBitbucket && d.Username == "" {
+		return importerEnums.ErrorUsernameRequiredForBitbucket
+	}
+
+	return nil
+}
+
+// validateBaseURL avoids requests to internal addresses, self hosted providers must be allowed by the operator env
+func (d *Data) validateBaseURL(_ interface{}) error {
+	if !isAllowedBaseURL(d.Provider, d.GetBaseURL()) {
+		return importerEnums.ErrorBaseURLNotAllowed
+	}
+
+	return nil
+}
+
+// isAllowedBaseURL accepts the default url of the provider and the comma separated urls of the allowed base urls env
+func isAllowedBaseURL(provider importerEnums.Provider, baseURL string) bool {
+	if baseURL == provider.GetDefaultBaseURL() {
+		return true
+	}
+
+	for _, allowed := range strings.Split(env.GetEnvOrDefault(importerEnums.EnvAllowedBaseURLs, ""), ",") {
+		if allowed = strings.TrimSuffix(strings.TrimSpace(allowed), "/"); allowed != "" && allowed == baseURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Data) SetIDs(workspaceID, accountID string) *Data {
+	d.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+	d.AccountID = parser.ParseStringToUUID(accountID)
+
+	return d
+}
+
+func (d *Data) GetBaseURL() string {
+	if d.BaseURL == "" {
+		return d.Provider.GetDefaultBaseURL()
+	}
+
+	return strings.TrimSuffix(d.BaseURL, "/")
+}
+
+func (d *Data) ToImport() *Import {
+	return &Import{
+		ImportID:       uuid.New(),
+		WorkspaceID:    d.WorkspaceID,
+		AccountID:      d.AccountID,
+		Provider:       d.Provider,
+		BaseURL:        d.GetBaseURL(),
+		Organization:   d.Organization,
+		Username:       d.Username,
+		AccessToken:    d.AccessToken,
+		GenerateTokens: d.GenerateTokens,
+		PeriodicSync:   d.PeriodicSync,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
@@ -0,0 +1,107 @@
+package importer
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func TestValidateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &Data{Provider: importerEnums.ProviderGithub, Organization: "horusec", AccessToken: "token"}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid provider", func(t *testing.T) {
+		data := &Data{Provider: "test", Organization: "horusec", AccessToken: "token"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid base url", func(t *testing.T) {
+		data := &Data{Provider: importerEnums.ProviderGitlab, BaseURL: "test", Organization: "horusec",
+			AccessToken: "token"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when base url is not allowed", func(t *testing.T) {
+		data := &Data{Provider: importerEnums.ProviderGitlab, BaseURL: "http://localhost:8080", Organization: "horusec",
+			AccessToken: "token"}
+
+		err := data.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), importerEnums.ErrorBaseURLNotAllowed.Error())
+	})
+
+	t.Run("should return no error when base url is allowed by the environment", func(t *testing.T) {
+		_ = os.Setenv(importerEnums.EnvAllowedBaseURLs, "https://gitlab.company.com")
+		defer os.Unsetenv(importerEnums.EnvAllowedBaseURLs)
+
+		data := &Data{Provider: importerEnums.ProviderGitlab, BaseURL: "https://gitlab.company.com/",
+			Organization: "horusec", AccessToken: "token"}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when bitbucket without username", func(t *testing.T) {
+		data := &Data{Provider: importerEnums.ProviderBitbucket, Organization: "horusec", AccessToken: "token"}
+
+		err := data.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), importerEnums.ErrorUsernameRequiredForBitbucket.Error())
+	})
+
+	t.Run("should return error when missing access token", func(t *testing.T) {
+		data := &Data{Provider: importerEnums.ProviderGithub, Organization: "horusec"}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsData(t *testing.T) {
+	t.Run("should success set the workspace and account ids", func(t *testing.T) {
+		workspaceID := uuid.New()
+		accountID := uuid.New()
+
+		data := (&Data{}).SetIDs(workspaceID.String(), accountID.String())
+		assert.Equal(t, workspaceID, data.WorkspaceID)
+		assert.Equal(t, accountID, data.AccountID)
+	})
+}
+
+func TestGetBaseURL(t *testing.T) {
+	t.Run("should return the default base url of the provider", func(t *testing.T) {
+		assert.Equal(t, importerEnums.GithubDefaultBaseURL,
+			(&Data{Provider: importerEnums.ProviderGithub}).GetBaseURL())
+		assert.Equal(t, importerEnums.GitlabDefaultBaseURL,
+			(&Data{Provider: importerEnums.ProviderGitlab}).GetBaseURL())
+		assert.Equal(t, importerEnums.BitbucketDefaultBaseURL,
+			(&Data{Provider: importerEnums.ProviderBitbucket}).GetBaseURL())
+	})
+
+	t.Run("should return the informed base url without the trailing slash", func(t *testing.T) {
+		data := &Data{Provider: importerEnums.ProviderGitlab, BaseURL: "https://gitlab.company.com/"}
+
+		assert.Equal(t, "https://gitlab.company.com", data.GetBaseURL())
+	})
+}
+
+func TestToImport(t *testing.T) {
+	t.Run("should success parse data to import", func(t *testing.T) {
+		data := &Data{WorkspaceID: uuid.New(), Provider: importerEnums.ProviderGithub, Organization: "horusec",
+			AccessToken: "token", PeriodicSync: true}
+
+		importation := data.ToImport()
+		assert.NotEqual(t, uuid.Nil, importation.ImportID)
+		assert.Equal(t, data.WorkspaceID, importation.WorkspaceID)
+		assert.Equal(t, importerEnums.GithubDefaultBaseURL, importation.BaseURL)
+		assert.Equal(t, "token", importation.AccessToken)
+		assert.True(t, importation.PeriodicSync)
+	})
+}
@@ -0,0 +1,65 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+type Import struct {
+	ImportID       uuid.UUID              `json:"importID" gorm:"primary_key"`
+	WorkspaceID    uuid.UUID              `json:"workspaceID"`
+	AccountID      uuid.UUID              `json:"accountID"`
+	Provider       importerEnums.Provider `json:"provider"`
+	BaseURL        string                 `json:"baseURL"`
+	Organization   string                 `json:"organization"`
+	Username       string                 `json:"username"`
+	AccessToken    string                 `json:"-"`
+	GenerateTokens bool                   `json:"generateTokens"`
+	PeriodicSync   bool                   `json:"periodicSync"`
+	LastSyncAt     *time.Time             `json:"lastSyncAt"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+}
+
+func (i *Import) SetSynced() *Import {
+	now := time.Now()
+	i.LastSyncAt = &now
+	i.UpdatedAt = now
+
+	return i
+}
+
+func (i *Import) ToSyncUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"last_sync_at": i.LastSyncAt,
+		"updated_at":   i.UpdatedAt,
+	}
+}
+
+// WithAccessToken returns a copy of the import using the informed access token, so the plain and the encrypted token
+// are never mixed in the same value
+func (i *Import) WithAccessToken(accessToken string) *Import {
+	importation := *i
+	importation.AccessToken = accessToken
+
+	return &importation
+}
+
+// HasAllowedBaseURL checks the base url again before each request, since the allowed urls env may have changed
+func (i *Import) HasAllowedBaseURL() bool {
+	return isAllowedBaseURL(i.Provider, i.BaseURL)
+}
+
+func (i *Import) NewResult() *Result {
+	return &Result{
+		ImportID: i.ImportID,
+		Created:  []ImportedRepository{},
+		Existing: []string{},
+		Skipped:  []string{},
+		Archived: []string{},
+		Failed:   []string{},
+	}
+}
@@ -0,0 +1,85 @@
+package importer
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func TestSetSynced(t *testing.T) {
+	t.Run("should success set the last sync date", func(t *testing.T) {
+		importation := (&Import{}).SetSynced()
+
+		assert.NotNil(t, importation.LastSyncAt)
+		assert.Equal(t, *importation.LastSyncAt, importation.UpdatedAt)
+	})
+}
+
+func TestToSyncUpdateMap(t *testing.T) {
+	t.Run("should success create the sync update map", func(t *testing.T) {
+		importation := (&Import{}).SetSynced()
+
+		updateMap := importation.ToSyncUpdateMap()
+		assert.Equal(t, importation.LastSyncAt, updateMap["last_sync_at"])
+		assert.Equal(t, importation.UpdatedAt, updateMap["updated_at"])
+	})
+}
+
+func TestWithAccessToken(t *testing.T) {
+	t.Run("should return a copy with the access token without changing the import", func(t *testing.T) {
+		importation := &Import{ImportID: uuid.New(), AccessToken: "token"}
+
+		encrypted := importation.WithAccessToken("encrypted")
+		assert.Equal(t, "encrypted", encrypted.AccessToken)
+		assert.Equal(t, importation.ImportID, encrypted.ImportID)
+		assert.Equal(t, "token", importation.AccessToken)
+	})
+}
+
+func TestHasAllowedBaseURL(t *testing.T) {
+	t.Run("should allow the default url of the provider", func(t *testing.T) {
+		importation := &Import{Provider: importerEnums.ProviderGitlab, BaseURL: importerEnums.GitlabDefaultBaseURL}
+
+		assert.True(t, importation.HasAllowedBaseURL())
+	})
+
+	t.Run("should allow the urls of the environment", func(t *testing.T) {
+		_ = os.Setenv(importerEnums.EnvAllowedBaseURLs, "https://github.company.com/, https://gitlab.company.com")
+		defer os.Unsetenv(importerEnums.EnvAllowedBaseURLs)
+
+		importation := &Import{Provider: importerEnums.ProviderGitlab, BaseURL: "https://gitlab.company.com"}
+
+		assert.True(t, importation.HasAllowedBaseURL())
+	})
+
+	t.Run("should deny the urls that are not allowed", func(t *testing.T) {
+		importation := &Import{Provider: importerEnums.ProviderGithub, BaseURL: "http://169.254.169.254"}
+
+		assert.False(t, importation.HasAllowedBaseURL())
+	})
+}
+
+func TestNewResult(t *testing.T) {
+	t.Run("should success create a empty result of the import", func(t *testing.T) {
+		importation := &Import{ImportID: uuid.New()}
+
+		result := importation.NewResult()
+		assert.Equal(t, importation.ImportID, result.ImportID)
+		assert.NotNil(t, result.Created)
+		assert.NotNil(t, result.Failed)
+	})
+}
+
+func TestImportToJSON(t *testing.T) {
+	t.Run("should not return the access token as json", func(t *testing.T) {
+		bytes, err := json.Marshal(&Import{AccessToken: "secret"})
+
+		assert.NoError(t, err)
+		assert.NotContains(t, string(bytes), "secret")
+	})
+}
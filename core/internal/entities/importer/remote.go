@@ -0,0 +1,97 @@
+package importer
+
+import (
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+)
+
+type RemoteRepository struct {
+	Name          string
+	Description   string
+	VcsURL        string
+	DefaultBranch string
+	IsArchived    bool
+}
+
+func (r *RemoteRepository) ToRepositoryData(importation *Import) *repositoryEntities.Data {
+	return &repositoryEntities.Data{
+		WorkspaceID:   importation.WorkspaceID,
+		AccountID:     importation.AccountID,
+		Name:          r.Name,
+		Description:   r.Description,
+		VcsURL:        r.VcsURL,
+		DefaultBranch: r.DefaultBranch,
+	}
+}
+
+type GithubRepository struct {
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	HTMLURL       string `json:"html_url"`
+	DefaultBranch string `json:"default_branch"`
+	Archived      bool   `json:"archived"`
+}
+
+func (g *GithubRepository) ToRemoteRepository() RemoteRepository {
+	return RemoteRepository{
+		Name:          g.Name,
+		Description:   g.Description,
+		VcsURL:        g.HTMLURL,
+		DefaultBranch: g.DefaultBranch,
+		IsArchived:    g.Archived,
+	}
+}
+
+// GitlabRepository uses the path with namespace as name, the projects of the subgroups can share the same name
+type GitlabRepository struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+	Description       string `json:"description"`
+	WebURL            string `json:"web_url"`
+	DefaultBranch     string `json:"default_branch"`
+	Archived          bool   `json:"archived"`
+}
+
+func (g *GitlabRepository) ToRemoteRepository() RemoteRepository {
+	return RemoteRepository{
+		Name:          g.PathWithNamespace,
+		Description:   g.Description,
+		VcsURL:        g.WebURL,
+		DefaultBranch: g.DefaultBranch,
+		IsArchived:    g.Archived,
+	}
+}
+
+type BitbucketPage struct {
+	Values []BitbucketRepository `json:"values"`
+	Next   string                `json:"next"`
+}
+
+func (b *BitbucketPage) ToRemoteRepositories() []RemoteRepository {
+	remotes := make([]RemoteRepository, len(b.Values))
+	for index := range b.Values {
+		remotes[index] = b.Values[index].ToRemoteRepository()
+	}
+
+	return remotes
+}
+
+type BitbucketRepository struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Links       struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+	MainBranch struct {
+		Name string `json:"name"`
+	} `json:"mainbranch"`
+}
+
+func (b *BitbucketRepository) ToRemoteRepository() RemoteRepository {
+	return RemoteRepository{
+		Name:          b.Name,
+		Description:   b.Description,
+		VcsURL:        b.Links.HTML.Href,
+		DefaultBranch: b.MainBranch.Name,
+	}
+}
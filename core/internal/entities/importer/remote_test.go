@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRepositoryData(t *testing.T) {
+	t.Run("should success parse remote repository to repository data", func(t *testing.T) {
+		importation := &Import{WorkspaceID: uuid.New(), AccountID: uuid.New()}
+		remote := &RemoteRepository{Name: "test", VcsURL: "https://github.com/horusec/test", DefaultBranch: "main"}
+
+		data := remote.ToRepositoryData(importation)
+		assert.Equal(t, importation.WorkspaceID, data.WorkspaceID)
+		assert.Equal(t, importation.AccountID, data.AccountID)
+		assert.Equal(t, "test", data.Name)
+		assert.Equal(t, "https://github.com/horusec/test", data.VcsURL)
+		assert.Equal(t, "main", data.DefaultBranch)
+	})
+}
+
+func TestGithubToRemoteRepository(t *testing.T) {
+	t.Run("should success parse github repository to remote repository", func(t *testing.T) {
+		remote := (&GithubRepository{Name: "test", HTMLURL: "url", Archived: true}).ToRemoteRepository()
+
+		assert.Equal(t, "test", remote.Name)
+		assert.Equal(t, "url", remote.VcsURL)
+		assert.True(t, remote.IsArchived)
+	})
+}
+
+func TestGitlabToRemoteRepository(t *testing.T) {
+	t.Run("should success parse gitlab project to remote repository", func(t *testing.T) {
+		remote := (&GitlabRepository{PathWithNamespace: "group/test", WebURL: "url"}).ToRemoteRepository()
+
+		assert.Equal(t, "group/test", remote.Name)
+		assert.Equal(t, "url", remote.VcsURL)
+	})
+}
+
+func TestBitbucketToRemoteRepository(t *testing.T) {
+	t.Run("should success parse bitbucket repository to remote repository", func(t *testing.T) {
+		repository := &BitbucketRepository{Name: "test"}
+		repository.Links.HTML.Href = "url"
+		repository.MainBranch.Name = "main"
+
+		remote := repository.ToRemoteRepository()
+		assert.Equal(t, "test", remote.Name)
+		assert.Equal(t, "url", remote.VcsURL)
+		assert.Equal(t, "main", remote.DefaultBranch)
+	})
+}
+
+func TestBitbucketPageToRemoteRepositories(t *testing.T) {
+	t.Run("should success parse the repositories of a bitbucket page", func(t *testing.T) {
+		page := &BitbucketPage{Values: []BitbucketRepository{{Name: "first"}, {Name: "second"}}}
+
+		remotes := page.ToRemoteRepositories()
+		assert.Len(t, remotes, 2)
+		assert.Equal(t, "second", remotes[1].Name)
+	})
+}
@@ -0,0 +1,24 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+type Response struct {
+	ImportID       uuid.UUID              `json:"importID"`
+	WorkspaceID    uuid.UUID              `json:"workspaceID"`
+	AccountID      uuid.UUID              `json:"accountID"`
+	Provider       importerEnums.Provider `json:"provider"`
+	BaseURL        string                 `json:"baseURL"`
+	Organization   string                 `json:"organization"`
+	Username       string                 `json:"username"`
+	GenerateTokens bool                   `json:"generateTokens"`
+	PeriodicSync   bool                   `json:"periodicSync"`
+	LastSyncAt     *time.Time             `json:"lastSyncAt"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+}
@@ -0,0 +1,40 @@
+package importer
+
+import "github.com/google/uuid"
+
+type ImportedRepository struct {
+	RepositoryID uuid.UUID `json:"repositoryID"`
+	Name         string    `json:"name"`
+	Token        string    `json:"token,omitempty"`
+}
+
+// Result lists by name what happened with each remote repository, skipped are the ones with a name already used by
+// a repository that was not created by this import
+type Result struct {
+	ImportID uuid.UUID            `json:"importID"`
+	Created  []ImportedRepository `json:"created"`
+	Existing []string             `json:"existing"`
+	Skipped  []string             `json:"skipped"`
+	Archived []string             `json:"archived"`
+	Failed   []string             `json:"failed"`
+}
+
+func (r *Result) AddCreated(repositoryID uuid.UUID, name, token string) {
+	r.Created = append(r.Created, ImportedRepository{RepositoryID: repositoryID, Name: name, Token: token})
+}
+
+func (r *Result) AddExisting(name string) {
+	r.Existing = append(r.Existing, name)
+}
+
+func (r *Result) AddSkipped(name string) {
+	r.Skipped = append(r.Skipped, name)
+}
+
+func (r *Result) AddArchived(name string) {
+	r.Archived = append(r.Archived, name)
+}
+
+func (r *Result) AddFailed(name string) {
+	r.Failed = append(r.Failed, name)
+}
@@ -0,0 +1,27 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddToResult(t *testing.T) {
+	t.Run("should success add the repositories to the result", func(t *testing.T) {
+		result := (&Import{}).NewResult()
+		id := uuid.New()
+
+		result.AddCreated(id, "created", "token")
+		result.AddExisting("existing")
+		result.AddSkipped("skipped")
+		result.AddArchived("archived")
+		result.AddFailed("failed")
+
+		assert.Equal(t, []ImportedRepository{{RepositoryID: id, Name: "created", Token: "token"}}, result.Created)
+		assert.Equal(t, []string{"existing"}, result.Existing)
+		assert.Equal(t, []string{"skipped"}, result.Skipped)
+		assert.Equal(t, []string{"archived"}, result.Archived)
+		assert.Equal(t, []string{"failed"}, result.Failed)
+	})
+}
@@ -0,0 +1,64 @@
+package invitation
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+type Data struct {
+	Email        string       `json:"email"`
+	Role         account.Role `json:"role"`
+	WorkspaceID  uuid.UUID    `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID uuid.UUID    `json:"repositoryID" swaggerignore:"true"`
+	InviterID    uuid.UUID    `json:"inviterID" swaggerignore:"true"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Email, validation.Required, validation.Length(1, 255), is.EmailFormat),
+		validation.Field(&d.Role, validation.Required, validation.In(
+			account.Admin, account.Supervisor, account.Member)),
+		validation.Field(&d.WorkspaceID, is.UUID),
+		validation.Field(&d.RepositoryID, is.UUID),
+	)
+}
+
+func (d *Data) SetIDs(workspaceID, repositoryID, inviterID string) *Data {
+	d.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+	d.RepositoryID = parser.ParseStringToUUID(repositoryID)
+	d.InviterID = parser.ParseStringToUUID(inviterID)
+
+	return d
+}
+
+func (d *Data) ToInvitation(expiration time.Duration) *Invitation {
+	return &Invitation{
+		InvitationID: uuid.New(),
+		Email:        strings.ToLower(d.Email),
+		Role:         d.Role,
+		WorkspaceID:  d.WorkspaceID,
+		RepositoryID: d.getRepositoryID(),
+		InviterID:    d.InviterID,
+		Status:       invitationEnums.StatusPending,
+		ExpiresAt:    time.Now().Add(expiration),
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
+
+func (d *Data) getRepositoryID() *uuid.UUID {
+	if d.RepositoryID == uuid.Nil {
+		return nil
+	}
+
+	return &d.RepositoryID
+}
@@ -0,0 +1,63 @@
+package invitation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+func TestValidateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &Data{Email: "test@test.com", Role: account.Member}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid email", func(t *testing.T) {
+		data := &Data{Email: "test", Role: account.Member}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid role", func(t *testing.T) {
+		data := &Data{Email: "test@test.com", Role: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsData(t *testing.T) {
+	t.Run("should success set ids", func(t *testing.T) {
+		id := uuid.New()
+		data := (&Data{}).SetIDs(id.String(), id.String(), id.String())
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.RepositoryID)
+		assert.Equal(t, id, data.InviterID)
+	})
+}
+
+func TestToInvitation(t *testing.T) {
+	t.Run("should parse data to a pending workspace invitation", func(t *testing.T) {
+		data := &Data{Email: "TEST@test.com", Role: account.Admin, WorkspaceID: uuid.New()}
+
+		invitation := data.ToInvitation(time.Hour)
+		assert.Equal(t, "test@test.com", invitation.Email)
+		assert.Equal(t, invitationEnums.StatusPending, invitation.Status)
+		assert.Nil(t, invitation.RepositoryID)
+		assert.True(t, invitation.ExpiresAt.After(time.Now()))
+	})
+
+	t.Run("should parse data to a repository invitation", func(t *testing.T) {
+		data := &Data{Email: "test@test.com", Role: account.Admin, WorkspaceID: uuid.New(), RepositoryID: uuid.New()}
+
+		invitation := data.ToInvitation(time.Hour)
+		assert.Equal(t, data.RepositoryID, *invitation.RepositoryID)
+	})
+}
@@ -0,0 +1,146 @@
+package invitation
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+type Invitation struct {
+	InvitationID uuid.UUID              `json:"invitationID" gorm:"primary_key"`
+	Email        string                 `json:"email"`
+	Role         account.Role           `json:"role"`
+	WorkspaceID  uuid.UUID              `json:"workspaceID"`
+	RepositoryID *uuid.UUID             `json:"repositoryID"`
+	InviterID    uuid.UUID              `json:"inviterID"`
+	AccountID    *uuid.UUID             `json:"accountID"`
+	Status       invitationEnums.Status `json:"status"`
+	ExpiresAt    time.Time              `json:"expiresAt"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+// GenerateToken signs the invitation id with the platform secret, the expiration date is part of the signature
+// so a resent invitation invalidates the tokens sent before
+func (i *Invitation) GenerateToken() string {
+	return i.InvitationID.String() + invitationEnums.TokenSeparator + i.sign()
+}
+
+func (i *Invitation) IsValidToken(token string) bool {
+	return hmac.Equal([]byte(token), []byte(i.GenerateToken()))
+}
+
+func (i *Invitation) sign() string {
+	mac := hmac.New(sha256.New, []byte(env.GetEnvOrDefault(invitationEnums.EnvSecretKey, jwtEnums.DefaultSecretJWT)))
+	_, _ = mac.Write([]byte(fmt.Sprintf("%s:%s:%d", i.InvitationID, i.Email, i.ExpiresAt.Unix())))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (i *Invitation) CheckIsAnswerable() error {
+	if i.Status != invitationEnums.StatusPending {
+		return invitationEnums.ErrorInvitationNotPending
+	}
+
+	if i.ExpiresAt.Before(time.Now()) {
+		return invitationEnums.ErrorInvitationExpired
+	}
+
+	return nil
+}
+
+// CheckEmail compares the emails ignoring the case, since the providers may change the case of the account email
+func (i *Invitation) CheckEmail(email string) error {
+	if !strings.EqualFold(i.Email, email) {
+		return invitationEnums.ErrorInvitationEmailMismatch
+	}
+
+	return nil
+}
+
+func (i *Invitation) IsRepositoryInvitation() bool {
+	return i.RepositoryID != nil
+}
+
+func (i *Invitation) Accept(accountID uuid.UUID) *Invitation {
+	i.AccountID = &accountID
+
+	return i.setStatus(invitationEnums.StatusAccepted)
+}
+
+func (i *Invitation) Decline() *Invitation {
+	return i.setStatus(invitationEnums.StatusDeclined)
+}
+
+func (i *Invitation) Revoke() *Invitation {
+	return i.setStatus(invitationEnums.StatusRevoked)
+}
+
+func (i *Invitation) setStatus(status invitationEnums.Status) *Invitation {
+	i.Status = status
+	i.UpdatedAt = time.Now()
+
+	return i
+}
+
+func (i *Invitation) Renew(expiration time.Duration) *Invitation {
+	i.ExpiresAt = time.Now().Add(expiration)
+	i.UpdatedAt = time.Now()
+
+	return i
+}
+
+func (i *Invitation) ToResponse() *Response {
+	return &Response{
+		InvitationID: i.InvitationID,
+		Email:        i.Email,
+		Role:         i.Role,
+		WorkspaceID:  i.WorkspaceID,
+		RepositoryID: i.RepositoryID,
+		InviterID:    i.InviterID,
+		Status:       i.Status,
+		ExpiresAt:    i.ExpiresAt,
+		CreatedAt:    i.CreatedAt,
+		UpdatedAt:    i.UpdatedAt,
+	}
+}
+
+// ToAccountWorkspace gives the invited role, a repository invitation only makes the account a member of its workspace
+func (i *Invitation) ToAccountWorkspace(accountID uuid.UUID) *workspaceEntities.AccountWorkspace {
+	role := i.Role
+	if i.IsRepositoryInvitation() {
+		role = account.Member
+	}
+
+	return &workspaceEntities.AccountWorkspace{
+		WorkspaceID: i.WorkspaceID,
+		AccountID:   accountID,
+		Role:        role,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+func (i *Invitation) ToAccountRepository(accountID uuid.UUID) *repositoryEntities.AccountRepository {
+	return &repositoryEntities.AccountRepository{
+		RepositoryID: *i.RepositoryID,
+		WorkspaceID:  i.WorkspaceID,
+		AccountID:    accountID,
+		Role:         i.Role,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
@@ -0,0 +1,134 @@
+package invitation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+func TestGenerateToken(t *testing.T) {
+	t.Run("should generate a token valid only for the invitation", func(t *testing.T) {
+		invitation := &Invitation{InvitationID: uuid.New(), Email: "test@test.com", ExpiresAt: time.Now()}
+		other := &Invitation{InvitationID: uuid.New(), Email: "test@test.com", ExpiresAt: time.Now()}
+
+		token := invitation.GenerateToken()
+		assert.Contains(t, token, invitation.InvitationID.String()+invitationEnums.TokenSeparator)
+		assert.True(t, invitation.IsValidToken(token))
+		assert.False(t, other.IsValidToken(token))
+	})
+
+	t.Run("should invalidate previous token when invitation is renewed", func(t *testing.T) {
+		invitation := &Invitation{InvitationID: uuid.New(), Email: "test@test.com", ExpiresAt: time.Now()}
+
+		token := invitation.GenerateToken()
+		invitation.Renew(2 * time.Hour)
+		assert.False(t, invitation.IsValidToken(token))
+	})
+}
+
+func TestCheckIsAnswerable(t *testing.T) {
+	t.Run("should return no error when pending and not expired", func(t *testing.T) {
+		invitation := &Invitation{Status: invitationEnums.StatusPending, ExpiresAt: time.Now().Add(time.Hour)}
+
+		assert.NoError(t, invitation.CheckIsAnswerable())
+	})
+
+	t.Run("should return error when not pending", func(t *testing.T) {
+		invitation := &Invitation{Status: invitationEnums.StatusAccepted, ExpiresAt: time.Now().Add(time.Hour)}
+
+		assert.Equal(t, invitationEnums.ErrorInvitationNotPending, invitation.CheckIsAnswerable())
+	})
+
+	t.Run("should return error when expired", func(t *testing.T) {
+		invitation := &Invitation{Status: invitationEnums.StatusPending, ExpiresAt: time.Now().Add(-time.Hour)}
+
+		assert.Equal(t, invitationEnums.ErrorInvitationExpired, invitation.CheckIsAnswerable())
+	})
+}
+
+func TestCheckEmail(t *testing.T) {
+	t.Run("should return no error when the email is the same ignoring the case", func(t *testing.T) {
+		assert.NoError(t, (&Invitation{Email: "test@test.com"}).CheckEmail("TEST@test.com"))
+	})
+
+	t.Run("should return error when the email is different", func(t *testing.T) {
+		assert.Equal(t, invitationEnums.ErrorInvitationEmailMismatch,
+			(&Invitation{Email: "test@test.com"}).CheckEmail("other@test.com"))
+	})
+}
+
+func TestIsRepositoryInvitation(t *testing.T) {
+	t.Run("should return true when repository id is set", func(t *testing.T) {
+		repositoryID := uuid.New()
+
+		assert.True(t, (&Invitation{RepositoryID: &repositoryID}).IsRepositoryInvitation())
+		assert.False(t, (&Invitation{}).IsRepositoryInvitation())
+	})
+}
+
+func TestStatusChanges(t *testing.T) {
+	t.Run("should set accepted status and account id", func(t *testing.T) {
+		accountID := uuid.New()
+
+		invitation := (&Invitation{}).Accept(accountID)
+		assert.Equal(t, invitationEnums.StatusAccepted, invitation.Status)
+		assert.Equal(t, accountID, *invitation.AccountID)
+	})
+
+	t.Run("should set declined status", func(t *testing.T) {
+		assert.Equal(t, invitationEnums.StatusDeclined, (&Invitation{}).Decline().Status)
+	})
+
+	t.Run("should set revoked status", func(t *testing.T) {
+		assert.Equal(t, invitationEnums.StatusRevoked, (&Invitation{}).Revoke().Status)
+	})
+}
+
+func TestToResponse(t *testing.T) {
+	t.Run("should parse invitation to response", func(t *testing.T) {
+		invitation := &Invitation{InvitationID: uuid.New(), Email: "test@test.com"}
+
+		response := invitation.ToResponse()
+		assert.Equal(t, invitation.InvitationID, response.InvitationID)
+		assert.Equal(t, invitation.Email, response.Email)
+	})
+}
+
+func TestToAccountWorkspace(t *testing.T) {
+	t.Run("should give the invited role of a workspace invitation", func(t *testing.T) {
+		accountID := uuid.New()
+		invitation := &Invitation{WorkspaceID: uuid.New(), Role: account.Admin}
+
+		accountWorkspace := invitation.ToAccountWorkspace(accountID)
+		assert.Equal(t, invitation.WorkspaceID, accountWorkspace.WorkspaceID)
+		assert.Equal(t, accountID, accountWorkspace.AccountID)
+		assert.Equal(t, account.Admin, accountWorkspace.Role)
+	})
+
+	t.Run("should give the member role of a repository invitation", func(t *testing.T) {
+		repositoryID := uuid.New()
+		invitation := &Invitation{WorkspaceID: uuid.New(), RepositoryID: &repositoryID, Role: account.Admin}
+
+		assert.Equal(t, account.Member, invitation.ToAccountWorkspace(uuid.New()).Role)
+	})
+}
+
+func TestToAccountRepository(t *testing.T) {
+	t.Run("should give the invited role of the repository", func(t *testing.T) {
+		accountID := uuid.New()
+		repositoryID := uuid.New()
+		invitation := &Invitation{WorkspaceID: uuid.New(), RepositoryID: &repositoryID, Role: account.Supervisor}
+
+		accountRepository := invitation.ToAccountRepository(accountID)
+		assert.Equal(t, repositoryID, accountRepository.RepositoryID)
+		assert.Equal(t, invitation.WorkspaceID, accountRepository.WorkspaceID)
+		assert.Equal(t, accountID, accountRepository.AccountID)
+		assert.Equal(t, account.Supervisor, accountRepository.Role)
+	})
+}
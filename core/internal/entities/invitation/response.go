@@ -0,0 +1,33 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+type Response struct {
+	InvitationID uuid.UUID              `json:"invitationID"`
+	Email        string                 `json:"email"`
+	Role         account.Role           `json:"role"`
+	WorkspaceID  uuid.UUID              `json:"workspaceID"`
+	RepositoryID *uuid.UUID             `json:"repositoryID"`
+	InviterID    uuid.UUID              `json:"inviterID"`
+	Status       invitationEnums.Status `json:"status"`
+	ExpiresAt    time.Time              `json:"expiresAt"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+// GetRepositoryID returns an empty id for the workspace invitations
+func (r *Response) GetRepositoryID() uuid.UUID {
+	if r.RepositoryID == nil {
+		return uuid.Nil
+	}
+
+	return *r.RepositoryID
+}
@@ -0,0 +1,38 @@
+package invitation
+
+import (
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+type TokenData struct {
+	Token     string    `json:"token"`
+	AccountID uuid.UUID `json:"accountID" swaggerignore:"true"`
+	Email     string    `json:"email" swaggerignore:"true"`
+}
+
+func (t *TokenData) Validate() error {
+	return validation.ValidateStruct(t,
+		validation.Field(&t.Token, validation.Required),
+	)
+}
+
+func (t *TokenData) SetAccountData(accountID, email string) *TokenData {
+	t.AccountID, _ = uuid.Parse(accountID)
+	t.Email = email
+
+	return t
+}
+
+func (t *TokenData) GetInvitationID() (uuid.UUID, error) {
+	invitationID, err := uuid.Parse(strings.Split(t.Token, invitationEnums.TokenSeparator)[0])
+	if err != nil {
+		return uuid.Nil, invitationEnums.ErrorInvalidToken
+	}
+
+	return invitationID, nil
+}
@@ -0,0 +1,45 @@
+package invitation
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+func TestValidateTokenData(t *testing.T) {
+	t.Run("should return no error when token is set", func(t *testing.T) {
+		assert.NoError(t, (&TokenData{Token: "test"}).Validate())
+	})
+
+	t.Run("should return error when token is empty", func(t *testing.T) {
+		assert.Error(t, (&TokenData{}).Validate())
+	})
+}
+
+func TestSetAccountData(t *testing.T) {
+	t.Run("should success set account id and email", func(t *testing.T) {
+		id := uuid.New()
+
+		data := (&TokenData{}).SetAccountData(id.String(), "test@test.com")
+		assert.Equal(t, id, data.AccountID)
+		assert.Equal(t, "test@test.com", data.Email)
+	})
+}
+
+func TestGetInvitationID(t *testing.T) {
+	t.Run("should return the invitation id of the token", func(t *testing.T) {
+		id := uuid.New()
+
+		invitationID, err := (&TokenData{Token: id.String() + ".test"}).GetInvitationID()
+		assert.NoError(t, err)
+		assert.Equal(t, id, invitationID)
+	})
+
+	t.Run("should return error when invalid token", func(t *testing.T) {
+		_, err := (&TokenData{Token: "test"}).GetInvitationID()
+		assert.Equal(t, invitationEnums.ErrorInvalidToken, err)
+	})
+}
@@ -0,0 +1,11 @@
+package invitation
+
+import (
+	"github.com/google/uuid"
+)
+
+// VerifiedEmail is published by auth when the email of an account is verified
+type VerifiedEmail struct {
+	AccountID uuid.UUID `json:"accountID"`
+	Email     string    `json:"email"`
+}
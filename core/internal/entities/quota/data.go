@@ -0,0 +1,63 @@
+package quota
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+)
+
+// Data contains the limits of a workspace, a null limit means that there is no limit
+type Data struct {
+	WorkspaceID                   uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+	MaxRepositories               *int      `json:"maxRepositories" example:"100"`
+	MaxAnalysesPerDay             *int      `json:"maxAnalysesPerDay" example:"1000"`
+	MaxVulnerabilitiesPerAnalysis *int      `json:"maxVulnerabilitiesPerAnalysis" example:"5000"`
+	MaxTokens                     *int      `json:"maxTokens" example:"50"`
+	MaxWebhooks                   *int      `json:"maxWebhooks" example:"50"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.MaxRepositories, validation.Min(0)),
+		validation.Field(&d.MaxAnalysesPerDay, validation.Min(0)),
+		validation.Field(&d.MaxVulnerabilitiesPerAnalysis, validation.Min(0)),
+		validation.Field(&d.MaxTokens, validation.Min(0)),
+		validation.Field(&d.MaxWebhooks, validation.Min(0)),
+		validation.Field(&d.WorkspaceID, is.UUID),
+	)
+}
+
+func (d *Data) SetWorkspaceID(workspaceID string) *Data {
+	d.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+
+	return d
+}
+
+func (d *Data) ToQuota() *Quota {
+	return &Quota{
+		WorkspaceID:                   d.WorkspaceID,
+		MaxRepositories:               d.MaxRepositories,
+		MaxAnalysesPerDay:             d.MaxAnalysesPerDay,
+		MaxVulnerabilitiesPerAnalysis: d.MaxVulnerabilitiesPerAnalysis,
+		MaxTokens:                     d.MaxTokens,
+		MaxWebhooks:                   d.MaxWebhooks,
+		CreatedAt:                     time.Now(),
+		UpdatedAt:                     time.Now(),
+	}
+}
+
+// ToUpdateMap is used instead of the quota struct since gorm ignores the null fields, which are the removed limits
+func (d *Data) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"max_repositories":                 d.MaxRepositories,
+		"max_analyses_per_day":             d.MaxAnalysesPerDay,
+		"max_vulnerabilities_per_analysis": d.MaxVulnerabilitiesPerAnalysis,
+		"max_tokens":                       d.MaxTokens,
+		"max_webhooks":                     d.MaxWebhooks,
+		"updated_at":                       time.Now(),
+	}
+}
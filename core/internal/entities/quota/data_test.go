@@ -0,0 +1,64 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		limit := 10
+		data := &Data{MaxRepositories: &limit, MaxTokens: &limit}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return no error when all limits are null", func(t *testing.T) {
+		assert.NoError(t, (&Data{}).Validate())
+	})
+
+	t.Run("should return error when negative limit", func(t *testing.T) {
+		limit := -1
+		data := &Data{MaxAnalysesPerDay: &limit}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetWorkspaceIDData(t *testing.T) {
+	t.Run("should success set workspace id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, (&Data{}).SetWorkspaceID(id.String()).WorkspaceID)
+	})
+}
+
+func TestToQuota(t *testing.T) {
+	t.Run("should success parse data to quota", func(t *testing.T) {
+		limit := 10
+		data := &Data{WorkspaceID: uuid.New(), MaxRepositories: &limit, MaxWebhooks: &limit}
+
+		quota := data.ToQuota()
+		assert.Equal(t, data.WorkspaceID, quota.WorkspaceID)
+		assert.Equal(t, &limit, quota.MaxRepositories)
+		assert.Equal(t, &limit, quota.MaxWebhooks)
+		assert.Nil(t, quota.MaxTokens)
+		assert.NotEmpty(t, quota.CreatedAt)
+		assert.NotEmpty(t, quota.UpdatedAt)
+	})
+}
+
+func TestToUpdateMap(t *testing.T) {
+	t.Run("should success parse data to update map keeping the null limits", func(t *testing.T) {
+		limit := 10
+		data := &Data{MaxTokens: &limit}
+
+		updateMap := data.ToUpdateMap()
+		assert.Equal(t, &limit, updateMap["max_tokens"])
+		assert.Contains(t, updateMap, "max_repositories")
+		assert.Nil(t, updateMap["max_repositories"])
+		assert.NotEmpty(t, updateMap["updated_at"])
+	})
+}
@@ -0,0 +1,18 @@
+package quota
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Quota struct {
+	WorkspaceID                   uuid.UUID `json:"workspaceID" gorm:"primary_key"`
+	MaxRepositories               *int      `json:"maxRepositories"`
+	MaxAnalysesPerDay             *int      `json:"maxAnalysesPerDay"`
+	MaxVulnerabilitiesPerAnalysis *int      `json:"maxVulnerabilitiesPerAnalysis"`
+	MaxTokens                     *int      `json:"maxTokens"`
+	MaxWebhooks                   *int      `json:"maxWebhooks"`
+	CreatedAt                     time.Time `json:"createdAt"`
+	UpdatedAt                     time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,19 @@
+package quota
+
+import "github.com/google/uuid"
+
+// Consumption of a limit, the vulnerabilities per analysis are the highest amount sent in a single analysis today
+type Consumption struct {
+	Limit *int `json:"limit"`
+	Used  int  `json:"used"`
+}
+
+type Response struct {
+	WorkspaceID                uuid.UUID   `json:"workspaceID"`
+	WorkspaceName              string      `json:"workspaceName"`
+	Repositories               Consumption `json:"repositories"`
+	AnalysesPerDay             Consumption `json:"analysesPerDay"`
+	VulnerabilitiesPerAnalysis Consumption `json:"vulnerabilitiesPerAnalysis"`
+	Tokens                     Consumption `json:"tokens"`
+	Webhooks                   Consumption `json:"webhooks"`
+}
@@ -0,0 +1,44 @@
+package quota
+
+import "github.com/google/uuid"
+
+// Usage contains the limits of a workspace with its current consumption, the analyses and the vulnerabilities are
+// counted since the start of the current day
+type Usage struct {
+	WorkspaceID                   uuid.UUID `json:"workspaceID"`
+	WorkspaceName                 string    `json:"workspaceName"`
+	MaxRepositories               *int      `json:"maxRepositories"`
+	MaxAnalysesPerDay             *int      `json:"maxAnalysesPerDay"`
+	MaxVulnerabilitiesPerAnalysis *int      `json:"maxVulnerabilitiesPerAnalysis"`
+	MaxTokens                     *int      `json:"maxTokens"`
+	MaxWebhooks                   *int      `json:"maxWebhooks"`
+	Repositories                  int       `json:"repositories"`
+	AnalysesToday                 int       `json:"analysesToday"`
+	VulnerabilitiesPerAnalysis    int       `json:"vulnerabilitiesPerAnalysis"`
+	Tokens                        int       `json:"tokens"`
+	Webhooks                      int       `json:"webhooks"`
+}
+
+func (u *Usage) IsRepositoriesLimitReached() bool {
+	return isLimitReached(u.MaxRepositories, u.Repositories)
+}
+
+func (u *Usage) IsTokensLimitReached() bool {
+	return isLimitReached(u.MaxTokens, u.Tokens)
+}
+
+func isLimitReached(limit *int, used int) bool {
+	return limit != nil && used >= *limit
+}
+
+func (u *Usage) ToResponse() *Response {
+	return &Response{
+		WorkspaceID:                u.WorkspaceID,
+		WorkspaceName:              u.WorkspaceName,
+		Repositories:               Consumption{Limit: u.MaxRepositories, Used: u.Repositories},
+		AnalysesPerDay:             Consumption{Limit: u.MaxAnalysesPerDay, Used: u.AnalysesToday},
+		VulnerabilitiesPerAnalysis: Consumption{Limit: u.MaxVulnerabilitiesPerAnalysis, Used: u.VulnerabilitiesPerAnalysis},
+		Tokens:                     Consumption{Limit: u.MaxTokens, Used: u.Tokens},
+		Webhooks:                   Consumption{Limit: u.MaxWebhooks, Used: u.Webhooks},
+	}
+}
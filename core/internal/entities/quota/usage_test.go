@@ -0,0 +1,64 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRepositoriesLimitReached(t *testing.T) {
+	t.Run("should return true when repositories reached the limit", func(t *testing.T) {
+		limit := 1
+		usage := &Usage{MaxRepositories: &limit, Repositories: 1}
+
+		assert.True(t, usage.IsRepositoriesLimitReached())
+	})
+
+	t.Run("should return false when repositories are under the limit", func(t *testing.T) {
+		limit := 2
+		usage := &Usage{MaxRepositories: &limit, Repositories: 1}
+
+		assert.False(t, usage.IsRepositoriesLimitReached())
+	})
+
+	t.Run("should return false when there is no limit", func(t *testing.T) {
+		usage := &Usage{Repositories: 100}
+
+		assert.False(t, usage.IsRepositoriesLimitReached())
+	})
+}
+
+func TestIsTokensLimitReached(t *testing.T) {
+	t.Run("should return true when tokens reached the limit", func(t *testing.T) {
+		limit := 0
+		usage := &Usage{MaxTokens: &limit}
+
+		assert.True(t, usage.IsTokensLimitReached())
+	})
+
+	t.Run("should return false when there is no limit", func(t *testing.T) {
+		usage := &Usage{Tokens: 100}
+
+		assert.False(t, usage.IsTokensLimitReached())
+	})
+}
+
+func TestToResponse(t *testing.T) {
+	t.Run("should success parse usage to response", func(t *testing.T) {
+		limit := 10
+		usage := &Usage{WorkspaceID: uuid.New(), WorkspaceName: "test", MaxAnalysesPerDay: &limit,
+			Repositories: 1, AnalysesToday: 2, VulnerabilitiesPerAnalysis: 3, Tokens: 4, Webhooks: 5}
+
+		response := usage.ToResponse()
+		assert.Equal(t, usage.WorkspaceID, response.WorkspaceID)
+		assert.Equal(t, "test", response.WorkspaceName)
+		assert.Equal(t, &limit, response.AnalysesPerDay.Limit)
+		assert.Equal(t, 2, response.AnalysesPerDay.Used)
+		assert.Nil(t, response.Repositories.Limit)
+		assert.Equal(t, 1, response.Repositories.Used)
+		assert.Equal(t, 3, response.VulnerabilitiesPerAnalysis.Used)
+		assert.Equal(t, 4, response.Tokens.Used)
+		assert.Equal(t, 5, response.Webhooks.Used)
+	})
+}
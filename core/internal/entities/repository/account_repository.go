@@ -15,27 +15,40 @@ type AccountRepository struct {
 	AccountID    uuid.UUID         `json:"accountID"`
 	WorkspaceID  uuid.UUID         `json:"workspaceID"`
 	Role         accountEnums.Role `json:"role"`
+	CustomRoleID *uuid.UUID        `json:"customRoleID"`
 	CreatedAt    time.Time         `json:"createdAt"`
 	UpdatedAt    time.Time         `json:"updatedAt"`
 }
 
-func (a *AccountRepository) Update(role accountEnums.Role) {
-	a.Role = role
+func (a *AccountRepository) Update(data *roleEntities.Data) {
+	a.Role = data.GetRole()
+	a.CustomRoleID = data.GetCustomRoleID()
 	a.UpdatedAt = time.Now()
 }
 
+// ToUpdateMap is used to update the role, allowing to remove the custom role with a null that is ignored by a struct
+func (a *AccountRepository) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"role":           a.Role,
+		"custom_role_id": a.CustomRoleID,
+		"updated_at":     a.UpdatedAt,
+	}
+}
+
 func (a *AccountRepository) ToResponse() *roleEntities.Response {
 	return &roleEntities.Response{
-		AccountID: a.AccountID,
-		Role:      a.Role,
+		AccountID:    a.AccountID,
+		Role:         a.Role,
+		CustomRoleID: a.CustomRoleID,
 	}
 }
 
 func (a *AccountRepository) ToResponseWithEmailAndUsername(email, username string) *roleEntities.Response {
 	return &roleEntities.Response{
-		AccountID: a.AccountID,
-		Email:     email,
-		Username:  username,
-		Role:      a.Role,
+		AccountID:    a.AccountID,
+		Email:        email,
+		Username:     username,
+		Role:         a.Role,
+		CustomRoleID: a.CustomRoleID,
 	}
 }
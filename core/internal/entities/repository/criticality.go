@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+)
+
+type Criticality struct {
+	WorkspaceID  uuid.UUID                   `json:"workspaceID"`
+	RepositoryID uuid.UUID                   `json:"repositoryID"`
+	Criticality  repositoryEnums.Criticality `json:"criticality"`
+	UpdatedAt    time.Time                   `json:"updatedAt"`
+}
+
+func (c *Criticality) ToBytes() []byte {
+	bytes, _ := json.Marshal(c)
+
+	return bytes
+}
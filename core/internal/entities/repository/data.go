@@ -7,28 +7,37 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 	utilsValidation "github.com/ZupIT/horusec-devkit/pkg/utils/validation"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 type Data struct {
-	WorkspaceID        uuid.UUID `json:"workspaceID" swaggerignore:"true"`
-	RepositoryID       uuid.UUID `json:"repositoryID" swaggerignore:"true"`
-	AccountID          uuid.UUID `json:"accountID" swaggerignore:"true"`
-	Name               string    `json:"name"`
-	Description        string    `json:"description"`
-	AuthzMember        []string  `json:"authzMember"`
-	AuthzAdmin         []string  `json:"authzAdmin"`
-	AuthzSupervisor    []string  `json:"authzSupervisor"`
-	Permissions        []string  `json:"permissions" swaggerignore:"true"`
-	IsApplicationAdmin bool      `json:"isApplicationAdmin" swaggerignore:"true"`
+	WorkspaceID        uuid.UUID                          `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID       uuid.UUID                          `json:"repositoryID" swaggerignore:"true"`
+	AccountID          uuid.UUID                          `json:"accountID" swaggerignore:"true"`
+	Name               string                             `json:"name"`
+	Description        string                             `json:"description"`
+	AuthzMember        []string                           `json:"authzMember"`
+	AuthzAdmin         []string                           `json:"authzAdmin"`
+	AuthzSupervisor    []string                           `json:"authzSupervisor"`
+	Criticality        repositoryEnums.Criticality        `json:"criticality" enums:"LOW,MEDIUM,HIGH,CRITICAL"`
+	OwnerTeamID        *uuid.UUID                         `json:"ownerTeamID"`
+	DataClassification repositoryEnums.DataClassification `json:"dataClassification" enums:"PUBLIC,INTERNAL,CONFIDENTIAL,RESTRICTED"` //nolint:lll // notations
+	VcsURL             string                             `json:"vcsURL"`
+	DefaultBranch      string                             `json:"defaultBranch"`
+	Labels             []string                           `json:"labels" example:"criticality=high"`
+	Permissions        []string                           `json:"permissions" swaggerignore:"true"`
+	IsApplicationAdmin bool                               `json:"isApplicationAdmin" swaggerignore:"true"`
 }
 
 func (d *Data) Validate() error {
-	return validation.ValidateStruct(d,
+	if err := validation.ValidateStruct(d,
 		validation.Field(&d.Name, validation.Required, validation.Length(1, 255)),
 		validation.Field(&d.Description, validation.Length(0, 255)),
 		validation.Field(&d.AuthzAdmin, validation.Length(0, 5)),
@@ -38,9 +47,37 @@ func (d *Data) Validate() error {
 		validation.Field(&d.WorkspaceID, is.UUID),
 		validation.Field(&d.RepositoryID, is.UUID),
 		validation.Field(&d.Permissions, validation.Empty),
+	); err != nil {
+		return err
+	}
+
+	return d.validateMetadata()
+}
+
+func (d *Data) validateMetadata() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Criticality, validation.In(repositoryEnums.CriticalityValues()...)),
+		validation.Field(&d.DataClassification, validation.In(repositoryEnums.DataClassificationValues()...)),
+		validation.Field(&d.VcsURL, validation.Length(0, 255), is.URL),
+		validation.Field(&d.DefaultBranch, validation.Length(0, 255)),
+		validation.Field(&d.Labels, d.labelsRules()...),
 	)
 }
 
+// ValidateLabels is used when listing, where the labels are a filter and all the other fields are ignored
+func (d *Data) ValidateLabels() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Labels, d.labelsRules()...),
+	)
+}
+
+func (d *Data) labelsRules() []validation.Rule {
+	return []validation.Rule{
+		validation.Length(0, repositoryEnums.MaxLabels),
+		validation.Each(validation.Match(repositoryEnums.LabelRegex)),
+	}
+}
+
 func (d *Data) CheckLdapGroups(authorizationType auth.AuthenticationType) error {
 	return utilsValidation.CheckInvalidLdapGroups(authorizationType, d.AuthzAdmin, d.Permissions)
 }
@@ -55,7 +92,7 @@ func (d *Data) SetWorkspaceIDAndAccountData(workspaceID uuid.UUID, accountData *
 }
 
 func (d *Data) ToRepository() *Repository {
-	return &Repository{
+	repository := &Repository{
 		RepositoryID:    uuid.New(),
 		WorkspaceID:     d.WorkspaceID,
 		Name:            d.Name,
@@ -63,9 +100,42 @@ func (d *Data) ToRepository() *Repository {
 		AuthzMember:     d.AuthzMember,
 		AuthzAdmin:      d.AuthzAdmin,
 		AuthzSupervisor: d.AuthzSupervisor,
+		Criticality:     d.GetCriticality(),
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
+
+	repository.updateMetadata(d)
+	return repository
+}
+
+func (d *Data) GetCriticality() repositoryEnums.Criticality {
+	if d.Criticality == "" {
+		return repositoryEnums.CriticalityMedium
+	}
+
+	return d.Criticality
+}
+
+func (d *Data) GetDataClassification() repositoryEnums.DataClassification {
+	if d.DataClassification == "" {
+		return repositoryEnums.DataClassificationInternal
+	}
+
+	return d.DataClassification
+}
+
+// GetLabels never returns nil, since an empty array is needed both to save and to filter by labels
+func (d *Data) GetLabels() pq.StringArray {
+	if d.Labels == nil {
+		return pq.StringArray{}
+	}
+
+	return d.Labels
+}
+
+func (d *Data) HasOwnerTeam() bool {
+	return d.OwnerTeamID != nil
 }
 
 func (d *Data) ToBytes() []byte {
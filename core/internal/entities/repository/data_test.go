@@ -9,6 +9,8 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 const (
@@ -121,6 +123,113 @@ func TestValidate(t *testing.T) {
 
 		assert.Error(t, data.Validate())
 	})
+
+	t.Run("should return error when invalid criticality", func(t *testing.T) {
+		data := &Data{
+			Name:        "test",
+			Criticality: "test",
+		}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestValidateMetadata(t *testing.T) {
+	t.Run("should return no error when valid metadata", func(t *testing.T) {
+		data := &Data{
+			Name:               "test",
+			DataClassification: repositoryEnums.DataClassificationConfidential,
+			VcsURL:             "https://github.com/ZupIT/horusec-platform",
+			DefaultBranch:      "main",
+			Labels:             []string{"criticality=high", "business.unit=payments"},
+		}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid data classification", func(t *testing.T) {
+		data := &Data{Name: "test", DataClassification: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid vcs url", func(t *testing.T) {
+		data := &Data{Name: "test", VcsURL: "not a url"}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when label is not in the key=value format", func(t *testing.T) {
+		data := &Data{Name: "test", Labels: []string{"criticality"}}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when more labels than the limit", func(t *testing.T) {
+		data := &Data{Name: "test"}
+		for i := 0; i <= repositoryEnums.MaxLabels; i++ {
+			data.Labels = append(data.Labels, "key=value")
+		}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestValidateLabels(t *testing.T) {
+	t.Run("should return no error when valid labels and ignore the other fields", func(t *testing.T) {
+		data := &Data{Labels: []string{"criticality=high"}}
+
+		assert.NoError(t, data.ValidateLabels())
+	})
+
+	t.Run("should return error when invalid label", func(t *testing.T) {
+		data := &Data{Labels: []string{"=high"}}
+
+		assert.Error(t, data.ValidateLabels())
+	})
+}
+
+func TestGetDataClassification(t *testing.T) {
+	t.Run("should return internal data classification when empty", func(t *testing.T) {
+		data := &Data{}
+
+		assert.Equal(t, repositoryEnums.DataClassificationInternal, data.GetDataClassification())
+	})
+
+	t.Run("should return data classification when not empty", func(t *testing.T) {
+		data := &Data{DataClassification: repositoryEnums.DataClassificationPublic}
+
+		assert.Equal(t, repositoryEnums.DataClassificationPublic, data.GetDataClassification())
+	})
+}
+
+func TestGetLabels(t *testing.T) {
+	t.Run("should return empty labels when nil", func(t *testing.T) {
+		data := &Data{}
+
+		assert.NotNil(t, data.GetLabels())
+		assert.Len(t, data.GetLabels(), 0)
+	})
+
+	t.Run("should return data labels when not nil", func(t *testing.T) {
+		data := &Data{Labels: []string{"criticality=high"}}
+
+		assert.Equal(t, pq.StringArray{"criticality=high"}, data.GetLabels())
+	})
+}
+
+func TestGetCriticality(t *testing.T) {
+	t.Run("should return medium criticality when empty", func(t *testing.T) {
+		data := &Data{}
+
+		assert.Equal(t, repositoryEnums.CriticalityMedium, data.GetCriticality())
+	})
+
+	t.Run("should return data criticality when not empty", func(t *testing.T) {
+		data := &Data{Criticality: repositoryEnums.CriticalityCritical}
+
+		assert.Equal(t, repositoryEnums.CriticalityCritical, data.GetCriticality())
+	})
 }
 
 func TestCheckLdapGroups(t *testing.T) {
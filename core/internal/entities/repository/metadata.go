@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+)
+
+type Metadata struct {
+	WorkspaceID        uuid.UUID                          `json:"workspaceID"`
+	RepositoryID       uuid.UUID                          `json:"repositoryID"`
+	OwnerTeamID        *uuid.UUID                         `json:"ownerTeamID"`
+	Criticality        repositoryEnums.Criticality        `json:"criticality"`
+	DataClassification repositoryEnums.DataClassification `json:"dataClassification"`
+	Labels             pq.StringArray                     `json:"labels"`
+	UpdatedAt          time.Time                          `json:"updatedAt"`
+}
+
+func (m *Metadata) ToBytes() []byte {
+	bytes, _ := json.Marshal(m)
+
+	return bytes
+}
@@ -7,18 +7,27 @@ import (
 	"github.com/lib/pq"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 type Repository struct {
-	RepositoryID    uuid.UUID      `json:"repositoryID" gorm:"primary_key"`
-	WorkspaceID     uuid.UUID      `json:"workspaceID"`
-	Name            string         `json:"name"`
-	Description     string         `json:"description"`
-	AuthzMember     pq.StringArray `json:"authzMember" gorm:"type:text[]"`
-	AuthzAdmin      pq.StringArray `json:"authzAdmin" gorm:"type:text[]"`
-	AuthzSupervisor pq.StringArray `json:"authzSupervisor" gorm:"type:text[]"`
-	CreatedAt       time.Time      `json:"createdAt"`
-	UpdatedAt       time.Time      `json:"updatedAt"`
+	RepositoryID       uuid.UUID                          `json:"repositoryID" gorm:"primary_key"`
+	WorkspaceID        uuid.UUID                          `json:"workspaceID"`
+	Name               string                             `json:"name"`
+	Description        string                             `json:"description"`
+	AuthzMember        pq.StringArray                     `json:"authzMember" gorm:"type:text[]"`
+	AuthzAdmin         pq.StringArray                     `json:"authzAdmin" gorm:"type:text[]"`
+	AuthzSupervisor    pq.StringArray                     `json:"authzSupervisor" gorm:"type:text[]"`
+	Criticality        repositoryEnums.Criticality        `json:"criticality"`
+	OwnerTeamID        *uuid.UUID                         `json:"ownerTeamID"`
+	DataClassification repositoryEnums.DataClassification `json:"dataClassification"`
+	VcsURL             string                             `json:"vcsURL"`
+	DefaultBranch      string                             `json:"defaultBranch"`
+	Labels             pq.StringArray                     `json:"labels" gorm:"type:text[]"`
+	CreatedAt          time.Time                          `json:"createdAt"`
+	UpdatedAt          time.Time                          `json:"updatedAt"`
+	ArchivedAt         *time.Time                         `json:"archivedAt"`
 }
 
 func (r *Repository) ToAccountRepository(accountID uuid.UUID, role account.Role) *AccountRepository {
@@ -33,7 +42,7 @@ func (r *Repository) ToAccountRepository(accountID uuid.UUID, role account.Role)
 }
 
 func (r *Repository) ToRepositoryResponse(role account.Role) *Response {
-	return &Response{
+	response := &Response{
 		WorkspaceID:     r.WorkspaceID,
 		RepositoryID:    r.RepositoryID,
 		Name:            r.Name,
@@ -44,7 +53,21 @@ func (r *Repository) ToRepositoryResponse(role account.Role) *Response {
 		AuthzSupervisor: r.AuthzSupervisor,
 		CreatedAt:       r.CreatedAt,
 		UpdatedAt:       r.UpdatedAt,
+		ArchivedAt:      r.ArchivedAt,
 	}
+
+	return r.setResponseMetadata(response)
+}
+
+func (r *Repository) setResponseMetadata(response *Response) *Response {
+	response.Criticality = r.Criticality
+	response.OwnerTeamID = r.OwnerTeamID
+	response.DataClassification = r.DataClassification
+	response.VcsURL = r.VcsURL
+	response.DefaultBranch = r.DefaultBranch
+	response.Labels = r.Labels
+
+	return response
 }
 
 func (r *Repository) Update(data *Data) {
@@ -53,7 +76,35 @@ func (r *Repository) Update(data *Data) {
 	r.AuthzMember = data.AuthzMember
 	r.AuthzSupervisor = data.AuthzSupervisor
 	r.AuthzAdmin = data.AuthzAdmin
+	r.Criticality = data.GetCriticality()
 	r.UpdatedAt = time.Now()
+	r.updateMetadata(data)
+}
+
+func (r *Repository) updateMetadata(data *Data) {
+	r.OwnerTeamID = data.OwnerTeamID
+	r.DataClassification = data.GetDataClassification()
+	r.VcsURL = data.VcsURL
+	r.DefaultBranch = data.DefaultBranch
+	r.Labels = data.GetLabels()
+}
+
+// ToUpdateMap is used on update, a map is needed to also save the fields that were cleared
+func (r *Repository) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":                r.Name,
+		"description":         r.Description,
+		"authz_member":        r.AuthzMember,
+		"authz_admin":         r.AuthzAdmin,
+		"authz_supervisor":    r.AuthzSupervisor,
+		"criticality":         r.Criticality,
+		"owner_team_id":       r.OwnerTeamID,
+		"data_classification": r.DataClassification,
+		"vcs_url":             r.VcsURL,
+		"default_branch":      r.DefaultBranch,
+		"labels":              r.Labels,
+		"updated_at":          r.UpdatedAt,
+	}
 }
 
 func (r *Repository) ContainsAllAuthzGroups() bool {
@@ -63,3 +114,76 @@ func (r *Repository) ContainsAllAuthzGroups() bool {
 
 	return true
 }
+
+func (r *Repository) ToCriticality() *Criticality {
+	return &Criticality{
+		WorkspaceID:  r.WorkspaceID,
+		RepositoryID: r.RepositoryID,
+		Criticality:  r.Criticality,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
+
+func (r *Repository) ToMetadata() *Metadata {
+	return &Metadata{
+		WorkspaceID:        r.WorkspaceID,
+		RepositoryID:       r.RepositoryID,
+		OwnerTeamID:        r.OwnerTeamID,
+		Criticality:        r.Criticality,
+		DataClassification: r.DataClassification,
+		Labels:             r.Labels,
+		UpdatedAt:          r.UpdatedAt,
+	}
+}
+
+func (r *Repository) Archive() {
+	now := time.Now()
+	r.ArchivedAt = &now
+	r.UpdatedAt = now
+}
+
+func (r *Repository) Restore() {
+	r.ArchivedAt = nil
+	r.UpdatedAt = time.Now()
+}
+
+func (r *Repository) IsArchived() bool {
+	return r.ArchivedAt != nil
+}
+
+// ToArchiveUpdateMap is used to archive and restore, a map is needed to set the archived date back to null
+func (r *Repository) ToArchiveUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"archived_at": r.ArchivedAt,
+		"updated_at":  r.UpdatedAt,
+	}
+}
+
+// Transfer moves the repository to the target workspace, the owner team and the import are cleared since both
+// belong to the source workspace
+func (r *Repository) Transfer(data *TransferData) *Transfer {
+	transfer := &Transfer{
+		RepositoryID:      r.RepositoryID,
+		SourceWorkspaceID: r.WorkspaceID,
+		TargetWorkspaceID: data.TargetWorkspaceID,
+		Name:              data.GetName(r.Name),
+		TransferredAt:     time.Now(),
+	}
+
+	r.WorkspaceID = transfer.TargetWorkspaceID
+	r.Name = transfer.Name
+	r.OwnerTeamID = nil
+	r.UpdatedAt = transfer.TransferredAt
+	return transfer
+}
+
+// ToTransferUpdateMap is used on transfer, a map is needed to set the owner team and the import back to null
+func (r *Repository) ToTransferUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_id":  r.WorkspaceID,
+		"name":          r.Name,
+		"owner_team_id": nil,
+		"import_id":     nil,
+		"updated_at":    r.UpdatedAt,
+	}
+}
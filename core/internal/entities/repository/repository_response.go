@@ -7,17 +7,26 @@ import (
 	"github.com/lib/pq"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 type Response struct {
-	WorkspaceID     uuid.UUID      `json:"workspaceID"`
-	RepositoryID    uuid.UUID      `json:"repositoryID"`
-	Name            string         `json:"name"`
-	Role            account.Role   `json:"role"`
-	Description     string         `json:"description"`
-	AuthzMember     pq.StringArray `json:"authzMember" gorm:"type:text[]"`
-	AuthzAdmin      pq.StringArray `json:"authzAdmin" gorm:"type:text[]"`
-	AuthzSupervisor pq.StringArray `json:"authzSupervisor" gorm:"type:text[]"`
-	CreatedAt       time.Time      `json:"createdAt"`
-	UpdatedAt       time.Time      `json:"updatedAt"`
+	WorkspaceID        uuid.UUID                          `json:"workspaceID"`
+	RepositoryID       uuid.UUID                          `json:"repositoryID"`
+	Name               string                             `json:"name"`
+	Role               account.Role                       `json:"role"`
+	Description        string                             `json:"description"`
+	AuthzMember        pq.StringArray                     `json:"authzMember" gorm:"type:text[]"`
+	AuthzAdmin         pq.StringArray                     `json:"authzAdmin" gorm:"type:text[]"`
+	AuthzSupervisor    pq.StringArray                     `json:"authzSupervisor" gorm:"type:text[]"`
+	Criticality        repositoryEnums.Criticality        `json:"criticality"`
+	OwnerTeamID        *uuid.UUID                         `json:"ownerTeamID"`
+	DataClassification repositoryEnums.DataClassification `json:"dataClassification"`
+	VcsURL             string                             `json:"vcsURL"`
+	DefaultBranch      string                             `json:"defaultBranch"`
+	Labels             pq.StringArray                     `json:"labels" gorm:"type:text[]"`
+	CreatedAt          time.Time                          `json:"createdAt"`
+	UpdatedAt          time.Time                          `json:"updatedAt"`
+	ArchivedAt         *time.Time                         `json:"archivedAt"`
 }
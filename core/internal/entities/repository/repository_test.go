@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 )
 
 func TestToAccountRepository(t *testing.T) {
@@ -87,6 +89,46 @@ func TestUpdate(t *testing.T) {
 		assert.Equal(t, pq.StringArray(data.AuthzAdmin), repository.AuthzAdmin)
 		assert.NotEqual(t, expectedTime, repository.UpdatedAt)
 	})
+
+	t.Run("should success update repository metadata", func(t *testing.T) {
+		teamID := uuid.New()
+		repository := &Repository{OwnerTeamID: &teamID, Labels: pq.StringArray{"criticality=high"}}
+
+		repository.Update(&Data{VcsURL: "https://github.com/test", DefaultBranch: "main"})
+		assert.Nil(t, repository.OwnerTeamID)
+		assert.Equal(t, pq.StringArray{}, repository.Labels)
+		assert.Equal(t, repositoryEnums.DataClassificationInternal, repository.DataClassification)
+		assert.Equal(t, "https://github.com/test", repository.VcsURL)
+		assert.Equal(t, "main", repository.DefaultBranch)
+	})
+}
+
+func TestToUpdateMapRepository(t *testing.T) {
+	t.Run("should parse repository to update map including cleared fields", func(t *testing.T) {
+		repository := &Repository{Name: "test"}
+
+		result := repository.ToUpdateMap()
+		assert.Equal(t, "test", result["name"])
+		assert.Contains(t, result, "owner_team_id")
+		assert.Contains(t, result, "labels")
+	})
+}
+
+func TestToMetadata(t *testing.T) {
+	t.Run("should parse repository to metadata", func(t *testing.T) {
+		repository := &Repository{
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+			Criticality:  repositoryEnums.CriticalityHigh,
+			Labels:       pq.StringArray{"criticality=high"},
+		}
+
+		result := repository.ToMetadata()
+		assert.Equal(t, repository.RepositoryID, result.RepositoryID)
+		assert.Equal(t, repository.Criticality, result.Criticality)
+		assert.Equal(t, repository.Labels, result.Labels)
+		assert.NotEmpty(t, result.ToBytes())
+	})
 }
 
 func TestContainsAllAuthzGroups(t *testing.T) {
@@ -127,3 +169,84 @@ func TestContainsAllAuthzGroups(t *testing.T) {
 		assert.False(t, repository.ContainsAllAuthzGroups())
 	})
 }
+
+func TestToCriticality(t *testing.T) {
+	t.Run("should success parse repository to criticality", func(t *testing.T) {
+		repository := &Repository{
+			RepositoryID: uuid.New(),
+			WorkspaceID:  uuid.New(),
+			Criticality:  repositoryEnums.CriticalityHigh,
+			UpdatedAt:    time.Now(),
+		}
+
+		criticality := repository.ToCriticality()
+		assert.Equal(t, repository.RepositoryID, criticality.RepositoryID)
+		assert.Equal(t, repository.WorkspaceID, criticality.WorkspaceID)
+		assert.Equal(t, repository.Criticality, criticality.Criticality)
+		assert.Equal(t, repository.UpdatedAt, criticality.UpdatedAt)
+		assert.NotEmpty(t, criticality.ToBytes())
+	})
+}
+
+func TestArchiveRepository(t *testing.T) {
+	t.Run("should set archived date and mark as archived", func(t *testing.T) {
+		entity := &Repository{}
+
+		entity.Archive()
+		assert.True(t, entity.IsArchived())
+		assert.NotNil(t, entity.ArchivedAt)
+		assert.Equal(t, *entity.ArchivedAt, entity.UpdatedAt)
+	})
+}
+
+func TestRestoreRepository(t *testing.T) {
+	t.Run("should remove archived date", func(t *testing.T) {
+		entity := &Repository{}
+		entity.Archive()
+
+		entity.Restore()
+		assert.False(t, entity.IsArchived())
+		assert.Nil(t, entity.ArchivedAt)
+	})
+}
+
+func TestToArchiveUpdateMapRepository(t *testing.T) {
+	t.Run("should return map with null archived date when restored", func(t *testing.T) {
+		entity := &Repository{}
+		entity.Restore()
+
+		updateMap := entity.ToArchiveUpdateMap()
+		assert.Nil(t, updateMap["archived_at"])
+		assert.Equal(t, entity.UpdatedAt, updateMap["updated_at"])
+	})
+}
+
+func TestTransferRepository(t *testing.T) {
+	t.Run("should move repository to target workspace and clear owner team", func(t *testing.T) {
+		teamID := uuid.New()
+		repository := &Repository{RepositoryID: uuid.New(), WorkspaceID: uuid.New(), Name: "test",
+			OwnerTeamID: &teamID}
+		sourceWorkspaceID := repository.WorkspaceID
+
+		transfer := repository.Transfer(&TransferData{TargetWorkspaceID: uuid.New(), Name: "new"})
+
+		assert.Equal(t, sourceWorkspaceID, transfer.SourceWorkspaceID)
+		assert.Equal(t, transfer.TargetWorkspaceID, repository.WorkspaceID)
+		assert.Equal(t, repository.RepositoryID, transfer.RepositoryID)
+		assert.Equal(t, "new", repository.Name)
+		assert.Equal(t, "new", transfer.Name)
+		assert.Nil(t, repository.OwnerTeamID)
+	})
+}
+
+func TestToTransferUpdateMapRepository(t *testing.T) {
+	t.Run("should success create a map clearing owner team and import", func(t *testing.T) {
+		repository := &Repository{WorkspaceID: uuid.New(), Name: "test"}
+
+		result := repository.ToTransferUpdateMap()
+		assert.Equal(t, repository.WorkspaceID, result["workspace_id"])
+		assert.Equal(t, "test", result["name"])
+		assert.Nil(t, result["owner_team_id"])
+		assert.Nil(t, result["import_id"])
+	})
+}
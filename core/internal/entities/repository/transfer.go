@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"encoding/json"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+)
+
+type TransferData struct {
+	TargetWorkspaceID uuid.UUID `json:"targetWorkspaceID"`
+	Name              string    `json:"name"`
+	WorkspaceID       uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID      uuid.UUID `json:"repositoryID" swaggerignore:"true"`
+}
+
+func (t *TransferData) Validate() error {
+	return validation.ValidateStruct(t,
+		validation.Field(&t.TargetWorkspaceID, validation.Required, validation.NotIn(uuid.Nil.String()), is.UUID),
+		validation.Field(&t.Name, validation.Length(0, 255)),
+		validation.Field(&t.WorkspaceID, is.UUID),
+		validation.Field(&t.RepositoryID, is.UUID),
+	)
+}
+
+func (t *TransferData) SetIDs(workspaceID, repositoryID uuid.UUID) *TransferData {
+	t.WorkspaceID = workspaceID
+	t.RepositoryID = repositoryID
+
+	return t
+}
+
+// GetName returns the new name informed to solve a name conflict in the target workspace, keeping the current
+// name when none was informed
+func (t *TransferData) GetName(currentName string) string {
+	if t.Name == "" {
+		return currentName
+	}
+
+	return t.Name
+}
+
+// Transfer is published when a repository moves to another workspace, so the other services can re-key its data
+type Transfer struct {
+	RepositoryID      uuid.UUID `json:"repositoryID"`
+	SourceWorkspaceID uuid.UUID `json:"sourceWorkspaceID"`
+	TargetWorkspaceID uuid.UUID `json:"targetWorkspaceID"`
+	Name              string    `json:"name"`
+	TransferredAt     time.Time `json:"transferredAt"`
+}
+
+func (t *Transfer) ToBytes() []byte {
+	bytes, _ := json.Marshal(t)
+
+	return bytes
+}
+
+// ToWorkspaceUpdateMap is used to move the rows of the other tables that store the workspace of the repository
+func (t *Transfer) ToWorkspaceUpdateMap() map[string]interface{} {
+	return map[string]interface{}{"workspace_id": t.TargetWorkspaceID}
+}
+
+// ToAccountRepositoryUpdateMap also removes the custom roles of the accounts, since they belong to the source workspace
+func (t *Transfer) ToAccountRepositoryUpdateMap() map[string]interface{} {
+	return map[string]interface{}{"workspace_id": t.TargetWorkspaceID, "custom_role_id": nil}
+}
+
+// ToAnalysisUpdateMap also renames the analyses, since they keep a copy of the workspace and repository names
+func (t *Transfer) ToAnalysisUpdateMap(workspaceName string) map[string]interface{} {
+	return map[string]interface{}{
+		"workspace_id":    t.TargetWorkspaceID,
+		"workspace_name":  workspaceName,
+		"repository_name": t.Name,
+	}
+}
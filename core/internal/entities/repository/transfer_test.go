@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTransferData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &TransferData{TargetWorkspaceID: uuid.New()}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when empty target workspace id", func(t *testing.T) {
+		data := &TransferData{Name: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsTransferData(t *testing.T) {
+	t.Run("should success set ids", func(t *testing.T) {
+		id := uuid.New()
+
+		data := (&TransferData{}).SetIDs(id, id)
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.RepositoryID)
+	})
+}
+
+func TestGetNameTransferData(t *testing.T) {
+	t.Run("should return the new name when informed", func(t *testing.T) {
+		assert.Equal(t, "new", (&TransferData{Name: "new"}).GetName("current"))
+	})
+
+	t.Run("should return the current name when new name is not informed", func(t *testing.T) {
+		assert.Equal(t, "current", (&TransferData{}).GetName("current"))
+	})
+}
+
+func TestToBytesTransfer(t *testing.T) {
+	t.Run("should success parse transfer to bytes", func(t *testing.T) {
+		transfer := &Transfer{RepositoryID: uuid.New(), TargetWorkspaceID: uuid.New()}
+
+		result := &Transfer{}
+		assert.NoError(t, json.Unmarshal(transfer.ToBytes(), result))
+		assert.Equal(t, transfer.RepositoryID, result.RepositoryID)
+		assert.Equal(t, transfer.TargetWorkspaceID, result.TargetWorkspaceID)
+	})
+}
+
+func TestToWorkspaceUpdateMapTransfer(t *testing.T) {
+	t.Run("should success create a map with the target workspace", func(t *testing.T) {
+		transfer := &Transfer{TargetWorkspaceID: uuid.New()}
+
+		assert.Equal(t, transfer.TargetWorkspaceID, transfer.ToWorkspaceUpdateMap()["workspace_id"])
+	})
+}
+
+func TestToAccountRepositoryUpdateMapTransfer(t *testing.T) {
+	t.Run("should success create a map with the target workspace and without custom role", func(t *testing.T) {
+		transfer := &Transfer{TargetWorkspaceID: uuid.New()}
+
+		result := transfer.ToAccountRepositoryUpdateMap()
+		assert.Equal(t, transfer.TargetWorkspaceID, result["workspace_id"])
+		assert.Contains(t, result, "custom_role_id")
+		assert.Nil(t, result["custom_role_id"])
+	})
+}
+
+func TestToAnalysisUpdateMapTransfer(t *testing.T) {
+	t.Run("should success create a map with the target workspace and names", func(t *testing.T) {
+		transfer := &Transfer{TargetWorkspaceID: uuid.New(), Name: "test"}
+
+		result := transfer.ToAnalysisUpdateMap("workspace")
+		assert.Equal(t, transfer.TargetWorkspaceID, result["workspace_id"])
+		assert.Equal(t, "workspace", result["workspace_name"])
+		assert.Equal(t, "test", result["repository_name"])
+	})
+}
@@ -7,8 +7,9 @@ import (
 )
 
 type Response struct {
-	AccountID uuid.UUID    `json:"accountID,omitempty"`
-	Email     string       `json:"email,omitempty"`
-	Username  string       `json:"username,omitempty"`
-	Role      account.Role `json:"role,omitempty"`
+	AccountID    uuid.UUID    `json:"accountID,omitempty"`
+	Email        string       `json:"email,omitempty"`
+	Username     string       `json:"username,omitempty"`
+	Role         account.Role `json:"role,omitempty"`
+	CustomRoleID *uuid.UUID   `json:"customRoleID,omitempty"`
 }
@@ -13,6 +13,7 @@ import (
 
 type Data struct {
 	Role         account.Role `json:"role"`
+	CustomRoleID *uuid.UUID   `json:"customRoleID"`
 	AccountID    uuid.UUID    `json:"accountID" swaggerignore:"true"`
 	WorkspaceID  uuid.UUID    `json:"workspaceID" swaggerignore:"true"`
 	RepositoryID uuid.UUID    `json:"repositoryID" swaggerignore:"true"`
@@ -20,7 +21,7 @@ type Data struct {
 
 func (d *Data) Validate() error {
 	return validation.ValidateStruct(d,
-		validation.Field(&d.Role, validation.Required, validation.In(
+		validation.Field(&d.Role, validation.When(!d.HasCustomRole(), validation.Required), validation.In(
 			account.Admin, account.Supervisor, account.Member)),
 		validation.Field(&d.AccountID, is.UUID),
 		validation.Field(&d.WorkspaceID, is.UUID),
@@ -28,6 +29,29 @@ func (d *Data) Validate() error {
 	)
 }
 
+func (d *Data) HasCustomRole() bool {
+	return d.CustomRoleID != nil && *d.CustomRoleID != uuid.Nil
+}
+
+// GetRole returns member when a custom role is assigned, the permissions of the account come from the custom role
+// and the member role keeps the checks that still use the built-in roles from granting more than that
+func (d *Data) GetRole() account.Role {
+	if d.HasCustomRole() {
+		return account.Member
+	}
+
+	return d.Role
+}
+
+// GetCustomRoleID returns nil when no custom role is assigned, so the account goes back to its built-in role
+func (d *Data) GetCustomRoleID() *uuid.UUID {
+	if d.HasCustomRole() {
+		return d.CustomRoleID
+	}
+
+	return nil
+}
+
 func (d *Data) SetDataIDs(accountID uuid.UUID, workspaceID, repositoryID string) *Data {
 	d.AccountID = accountID
 	d.WorkspaceID = parser.ParseStringToUUID(workspaceID)
@@ -30,6 +30,36 @@ func TestValidateRoleData(t *testing.T) {
 
 		assert.Error(t, data.Validate())
 	})
+
+	t.Run("should return no error without role when a custom role is assigned", func(t *testing.T) {
+		customRoleID := uuid.New()
+		data := &Data{CustomRoleID: &customRoleID}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error without role and custom role", func(t *testing.T) {
+		data := &Data{CustomRoleID: &uuid.Nil}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestGetRoleAndCustomRoleID(t *testing.T) {
+	t.Run("should return member and the custom role when a custom role is assigned", func(t *testing.T) {
+		customRoleID := uuid.New()
+		data := &Data{Role: account.Admin, CustomRoleID: &customRoleID}
+
+		assert.Equal(t, account.Member, data.GetRole())
+		assert.Equal(t, &customRoleID, data.GetCustomRoleID())
+	})
+
+	t.Run("should return the role and no custom role when a custom role is not assigned", func(t *testing.T) {
+		data := &Data{Role: account.Admin, CustomRoleID: &uuid.Nil}
+
+		assert.Equal(t, account.Admin, data.GetRole())
+		assert.Nil(t, data.GetCustomRoleID())
+	})
 }
 
 func TestSetDataIDs(t *testing.T) {
@@ -0,0 +1,45 @@
+package team
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+)
+
+type Data struct {
+	TeamID      uuid.UUID `json:"teamID" swaggerignore:"true"`
+	WorkspaceID uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+}
+
+func (d *Data) Validate() error {
+	return validation.ValidateStruct(d,
+		validation.Field(&d.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&d.Description, validation.Length(0, 255)),
+		validation.Field(&d.TeamID, is.UUID),
+		validation.Field(&d.WorkspaceID, is.UUID),
+	)
+}
+
+func (d *Data) SetIDs(workspaceID, teamID string) *Data {
+	d.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+	d.TeamID = parser.ParseStringToUUID(teamID)
+
+	return d
+}
+
+func (d *Data) ToTeam() *Team {
+	return &Team{
+		TeamID:      uuid.New(),
+		WorkspaceID: d.WorkspaceID,
+		Name:        d.Name,
+		Description: d.Description,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
@@ -0,0 +1,45 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &Data{Name: "test"}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when empty name", func(t *testing.T) {
+		data := &Data{}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsData(t *testing.T) {
+	t.Run("should success set ids", func(t *testing.T) {
+		id := uuid.New()
+		data := (&Data{}).SetIDs(id.String(), id.String())
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.TeamID)
+	})
+}
+
+func TestToTeam(t *testing.T) {
+	t.Run("should success parse data to team", func(t *testing.T) {
+		data := &Data{WorkspaceID: uuid.New(), Name: "test", Description: "test"}
+
+		team := data.ToTeam()
+		assert.NotEqual(t, uuid.Nil, team.TeamID)
+		assert.Equal(t, data.WorkspaceID, team.WorkspaceID)
+		assert.Equal(t, data.Name, team.Name)
+		assert.Equal(t, data.Description, team.Description)
+		assert.NotEmpty(t, team.CreatedAt)
+	})
+}
@@ -0,0 +1,40 @@
+package team
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+)
+
+// Member has the workspace id of the team, so removing an account from a workspace also removes it from its teams
+type Member struct {
+	TeamID      uuid.UUID `json:"teamID"`
+	AccountID   uuid.UUID `json:"accountID"`
+	WorkspaceID uuid.UUID `json:"workspaceID"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type MemberData struct {
+	AccountID   uuid.UUID `json:"accountID"`
+	TeamID      uuid.UUID `json:"teamID" swaggerignore:"true"`
+	WorkspaceID uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+}
+
+func (m *MemberData) Validate() error {
+	return validation.ValidateStruct(m,
+		validation.Field(&m.AccountID, validation.Required, validation.NotIn(uuid.Nil.String()), is.UUID),
+		validation.Field(&m.TeamID, is.UUID),
+		validation.Field(&m.WorkspaceID, is.UUID),
+	)
+}
+
+func (m *MemberData) SetIDs(workspaceID, teamID string) *MemberData {
+	m.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+	m.TeamID = parser.ParseStringToUUID(teamID)
+
+	return m
+}
@@ -0,0 +1,32 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMemberData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &MemberData{AccountID: uuid.New()}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when empty account id", func(t *testing.T) {
+		data := &MemberData{}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsMemberData(t *testing.T) {
+	t.Run("should success set ids", func(t *testing.T) {
+		id := uuid.New()
+		data := (&MemberData{}).SetIDs(id.String(), id.String())
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.TeamID)
+	})
+}
@@ -0,0 +1,67 @@
+package team
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+)
+
+type RepositoryRole struct {
+	TeamID       uuid.UUID    `json:"teamID"`
+	RepositoryID uuid.UUID    `json:"repositoryID"`
+	Role         account.Role `json:"role"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
+}
+
+func (r *RepositoryRole) Update(role account.Role) *RepositoryRole {
+	r.Role = role
+	r.UpdatedAt = time.Now()
+
+	return r
+}
+
+func (r *RepositoryRole) ToResponse(teamName string) *RepositoryRoleResponse {
+	return &RepositoryRoleResponse{
+		TeamID:       r.TeamID,
+		RepositoryID: r.RepositoryID,
+		Name:         teamName,
+		Role:         r.Role,
+	}
+}
+
+type RepositoryRoleData struct {
+	TeamID       uuid.UUID    `json:"teamID"`
+	Role         account.Role `json:"role"`
+	WorkspaceID  uuid.UUID    `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID uuid.UUID    `json:"repositoryID" swaggerignore:"true"`
+}
+
+func (r *RepositoryRoleData) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Role, validation.Required, validation.In(
+			account.Admin, account.Supervisor, account.Member)),
+		validation.Field(&r.TeamID, is.UUID),
+		validation.Field(&r.WorkspaceID, is.UUID),
+		validation.Field(&r.RepositoryID, is.UUID),
+	)
+}
+
+func (r *RepositoryRoleData) SetIDs(workspaceID, repositoryID string) *RepositoryRoleData {
+	r.WorkspaceID = parser.ParseStringToUUID(workspaceID)
+	r.RepositoryID = parser.ParseStringToUUID(repositoryID)
+
+	return r
+}
+
+// SetTeamID is used when the team comes from the path instead of the body, as in the role update
+func (r *RepositoryRoleData) SetTeamID(teamID string) *RepositoryRoleData {
+	r.TeamID = parser.ParseStringToUUID(teamID)
+
+	return r
+}
@@ -0,0 +1,57 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
+func TestUpdateRepositoryRole(t *testing.T) {
+	t.Run("should success update role", func(t *testing.T) {
+		role := &RepositoryRole{Role: account.Member}
+
+		_ = role.Update(account.Admin)
+		assert.Equal(t, account.Admin, role.Role)
+		assert.NotEmpty(t, role.UpdatedAt)
+	})
+}
+
+func TestToResponseRepositoryRole(t *testing.T) {
+	t.Run("should success parse to response with team name", func(t *testing.T) {
+		role := &RepositoryRole{TeamID: uuid.New(), RepositoryID: uuid.New(), Role: account.Member}
+
+		response := role.ToResponse("test")
+		assert.Equal(t, role.TeamID, response.TeamID)
+		assert.Equal(t, role.RepositoryID, response.RepositoryID)
+		assert.Equal(t, "test", response.Name)
+		assert.Equal(t, account.Member, response.Role)
+	})
+}
+
+func TestValidateRepositoryRoleData(t *testing.T) {
+	t.Run("should return no error when valid data", func(t *testing.T) {
+		data := &RepositoryRoleData{TeamID: uuid.New(), Role: account.Supervisor}
+
+		assert.NoError(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid role", func(t *testing.T) {
+		data := &RepositoryRoleData{TeamID: uuid.New(), Role: "test"}
+
+		assert.Error(t, data.Validate())
+	})
+}
+
+func TestSetIDsRepositoryRoleData(t *testing.T) {
+	t.Run("should success set workspace, repository and team ids", func(t *testing.T) {
+		id := uuid.New()
+		data := (&RepositoryRoleData{}).SetIDs(id.String(), id.String()).SetTeamID(id.String())
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.RepositoryID)
+		assert.Equal(t, id, data.TeamID)
+	})
+}
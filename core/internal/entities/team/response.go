@@ -0,0 +1,31 @@
+package team
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
+type Response struct {
+	TeamID      uuid.UUID `json:"teamID"`
+	WorkspaceID uuid.UUID `json:"workspaceID"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type MemberResponse struct {
+	AccountID uuid.UUID `json:"accountID"`
+	Email     string    `json:"email"`
+	Username  string    `json:"username"`
+}
+
+type RepositoryRoleResponse struct {
+	TeamID       uuid.UUID    `json:"teamID"`
+	RepositoryID uuid.UUID    `json:"repositoryID"`
+	Name         string       `json:"name"`
+	Role         account.Role `json:"role"`
+}
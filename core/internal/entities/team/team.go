@@ -0,0 +1,56 @@
+package team
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
+type Team struct {
+	TeamID      uuid.UUID `json:"teamID" gorm:"primary_key"`
+	WorkspaceID uuid.UUID `json:"workspaceID"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func (t *Team) Update(data *Data) *Team {
+	t.Name = data.Name
+	t.Description = data.Description
+	t.UpdatedAt = time.Now()
+
+	return t
+}
+
+func (t *Team) ToResponse() *Response {
+	return &Response{
+		TeamID:      t.TeamID,
+		WorkspaceID: t.WorkspaceID,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func (t *Team) ToMember(accountID uuid.UUID) *Member {
+	return &Member{
+		TeamID:      t.TeamID,
+		AccountID:   accountID,
+		WorkspaceID: t.WorkspaceID,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func (t *Team) ToRepositoryRole(repositoryID uuid.UUID, role account.Role) *RepositoryRole {
+	return &RepositoryRole{
+		TeamID:       t.TeamID,
+		RepositoryID: repositoryID,
+		Role:         role,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+}
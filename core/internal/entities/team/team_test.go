@@ -0,0 +1,56 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Run("should success update team name and description", func(t *testing.T) {
+		team := &Team{}
+
+		_ = team.Update(&Data{Name: "test", Description: "test"})
+		assert.Equal(t, "test", team.Name)
+		assert.Equal(t, "test", team.Description)
+		assert.NotEmpty(t, team.UpdatedAt)
+	})
+}
+
+func TestToResponse(t *testing.T) {
+	t.Run("should success parse team to response", func(t *testing.T) {
+		team := &Team{TeamID: uuid.New(), WorkspaceID: uuid.New(), Name: "test"}
+
+		response := team.ToResponse()
+		assert.Equal(t, team.TeamID, response.TeamID)
+		assert.Equal(t, team.WorkspaceID, response.WorkspaceID)
+		assert.Equal(t, team.Name, response.Name)
+	})
+}
+
+func TestToMember(t *testing.T) {
+	t.Run("should success create a member of the team", func(t *testing.T) {
+		team := &Team{TeamID: uuid.New(), WorkspaceID: uuid.New()}
+		accountID := uuid.New()
+
+		member := team.ToMember(accountID)
+		assert.Equal(t, team.TeamID, member.TeamID)
+		assert.Equal(t, team.WorkspaceID, member.WorkspaceID)
+		assert.Equal(t, accountID, member.AccountID)
+	})
+}
+
+func TestToRepositoryRole(t *testing.T) {
+	t.Run("should success create a repository role of the team", func(t *testing.T) {
+		team := &Team{TeamID: uuid.New()}
+		repositoryID := uuid.New()
+
+		role := team.ToRepositoryRole(repositoryID, account.Supervisor)
+		assert.Equal(t, team.TeamID, role.TeamID)
+		assert.Equal(t, repositoryID, role.RepositoryID)
+		assert.Equal(t, account.Supervisor, role.Role)
+	})
+}
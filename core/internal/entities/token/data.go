@@ -2,11 +2,13 @@ package token
 
 import (
 	"encoding/json"
+	"net"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/ZupIT/horusec-devkit/pkg/utils/crypto"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
@@ -15,12 +17,14 @@ import (
 )
 
 type Data struct {
-	Description  string    `json:"description"`
-	RepositoryID uuid.UUID `json:"repositoryID" swaggerignore:"true"`
-	WorkspaceID  uuid.UUID `json:"workspaceID" swaggerignore:"true"`
-	TokenID      uuid.UUID `json:"tokenID" swaggerignore:"true"`
-	IsExpirable  bool      `json:"isExpirable"`
-	ExpiresAt    time.Time `json:"expiresAt"`
+	Description  string             `json:"description"`
+	RepositoryID uuid.UUID          `json:"repositoryID" swaggerignore:"true"`
+	WorkspaceID  uuid.UUID          `json:"workspaceID" swaggerignore:"true"`
+	TokenID      uuid.UUID          `json:"tokenID" swaggerignore:"true"`
+	IsExpirable  bool               `json:"isExpirable"`
+	ExpiresAt    time.Time          `json:"expiresAt"`
+	Scopes       []tokenEnums.Scope `json:"scopes"`
+	AllowedCIDRs []string           `json:"allowedCIDRs"`
 }
 
 func (d *Data) Validate() error {
@@ -30,6 +34,8 @@ func (d *Data) Validate() error {
 		validation.Field(&d.TokenID, is.UUID),
 		validation.Field(&d.Description, validation.Required, validation.Length(1, 255)),
 		validation.Field(&d.ExpiresAt, validation.By(d.validateExpiresAt)),
+		validation.Field(&d.Scopes, validation.Each(validation.In(tokenEnums.ScopeValues()...))),
+		validation.Field(&d.AllowedCIDRs, validation.Each(validation.By(d.validateCIDR))),
 	)
 }
 
@@ -41,6 +47,14 @@ func (d *Data) validateExpiresAt(_ interface{}) error {
 	return nil
 }
 
+func (d *Data) validateCIDR(value interface{}) error {
+	if _, _, err := net.ParseCIDR(value.(string)); err != nil {
+		return tokenEnums.ErrorInvalidAllowedCIDR
+	}
+
+	return nil
+}
+
 func (d *Data) SetWorkspaceID(workspaceID uuid.UUID) *Data {
 	d.WorkspaceID = workspaceID
 
@@ -68,9 +82,24 @@ func (d *Data) ToToken() (*Token, string) {
 		IsExpirable:  d.IsExpirable,
 		CreatedAt:    time.Now(),
 		ExpiresAt:    d.ExpiresAt,
+		Scopes:       d.scopesToStringArray(),
+		AllowedCIDRs: d.AllowedCIDRs,
 	}, token
 }
 
+func (d *Data) scopesToStringArray() pq.StringArray {
+	if len(d.Scopes) == 0 {
+		d.Scopes = tokenEnums.DefaultScopes()
+	}
+
+	scopes := pq.StringArray{}
+	for _, scope := range d.Scopes {
+		scopes = append(scopes, scope.ToString())
+	}
+
+	return scopes
+}
+
 func (d *Data) getSuffixValue(token string) string {
 	return token[31:]
 }
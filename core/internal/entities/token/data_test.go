@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 )
 
 func TestValidate(t *testing.T) {
@@ -29,6 +31,24 @@ func TestValidate(t *testing.T) {
 		assert.Error(t, data.Validate())
 	})
 
+	t.Run("should return error when invalid scope", func(t *testing.T) {
+		data := &Data{
+			Description: "test",
+			Scopes:      []tokenEnums.Scope{"invalid"},
+		}
+
+		assert.Error(t, data.Validate())
+	})
+
+	t.Run("should return error when invalid allowed cidr", func(t *testing.T) {
+		data := &Data{
+			Description:  "test",
+			AllowedCIDRs: []string{"10.0.0.0/8", "10.0.0.1"},
+		}
+
+		assert.Error(t, data.Validate())
+	})
+
 	t.Run("should return error missing description", func(t *testing.T) {
 		data := &Data{
 			IsExpirable: true,
@@ -81,6 +101,20 @@ func TestToToken(t *testing.T) {
 		assert.Equal(t, data.Description, token.Description)
 		assert.Equal(t, data.IsExpirable, token.IsExpirable)
 		assert.Equal(t, data.ExpiresAt, token.ExpiresAt)
+		assert.Len(t, token.Scopes, 3)
+	})
+
+	t.Run("should keep only the informed scopes and allowed cidrs", func(t *testing.T) {
+		data := &Data{
+			Description:  "test",
+			Scopes:       []tokenEnums.Scope{tokenEnums.ScopeUploadAnalysis},
+			AllowedCIDRs: []string{"10.0.0.0/8"},
+		}
+
+		token, _ := data.ToToken()
+
+		assert.Equal(t, []string{"analysis:upload"}, []string(token.Scopes))
+		assert.Equal(t, []string{"10.0.0.0/8"}, []string(token.AllowedCIDRs))
 	})
 
 	t.Run("should success parse token data to token with nil repository id", func(t *testing.T) {
@@ -0,0 +1,26 @@
+package token
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Expiring is a token close to expire together with one of the admins that should be notified about it
+type Expiring struct {
+	TokenID        uuid.UUID
+	Description    string
+	ExpiresAt      time.Time
+	WorkspaceName  string
+	RepositoryName string
+	Email          string
+	Username       string
+}
+
+func (e *Expiring) GetTargetName() string {
+	if e.RepositoryName != "" {
+		return e.WorkspaceName + "/" + e.RepositoryName
+	}
+
+	return e.WorkspaceName
+}
@@ -0,0 +1,21 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTargetName(t *testing.T) {
+	t.Run("should return workspace and repository name", func(t *testing.T) {
+		expiring := &Expiring{WorkspaceName: "workspace", RepositoryName: "repository"}
+
+		assert.Equal(t, "workspace/repository", expiring.GetTargetName())
+	})
+
+	t.Run("should return workspace name when workspace token", func(t *testing.T) {
+		expiring := &Expiring{WorkspaceName: "workspace"}
+
+		assert.Equal(t, "workspace", expiring.GetTargetName())
+	})
+}
@@ -4,15 +4,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Response struct {
-	TokenID      uuid.UUID `json:"tokenID"`
-	WorkspaceID  uuid.UUID `json:"workspaceID"`
-	RepositoryID uuid.UUID `json:"repositoryID"`
-	Description  string    `json:"description"`
-	SuffixValue  string    `json:"suffixValue"`
-	IsExpirable  bool      `json:"isExpirable"`
-	CreatedAt    time.Time `json:"createdAt"`
-	ExpiresAt    time.Time `json:"expiresAt"`
+	TokenID      uuid.UUID      `json:"tokenID"`
+	WorkspaceID  uuid.UUID      `json:"workspaceID"`
+	RepositoryID uuid.UUID      `json:"repositoryID"`
+	Description  string         `json:"description"`
+	SuffixValue  string         `json:"suffixValue"`
+	IsExpirable  bool           `json:"isExpirable"`
+	CreatedAt    time.Time      `json:"createdAt"`
+	ExpiresAt    time.Time      `json:"expiresAt"`
+	Scopes       pq.StringArray `json:"scopes" gorm:"type:text[]"`
+	AllowedCIDRs pq.StringArray `json:"allowedCIDRs" gorm:"type:text[]"`
+	LastUsedAt   *time.Time     `json:"lastUsedAt"`
+	LastUsedIP   string         `json:"lastUsedIP"`
+	SuccessorID  *uuid.UUID     `json:"successorID"`
 }
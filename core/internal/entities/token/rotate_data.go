@@ -0,0 +1,40 @@
+package token
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+)
+
+type RotateData struct {
+	GracePeriodHours *int      `json:"gracePeriodHours"`
+	TokenID          uuid.UUID `json:"tokenID" swaggerignore:"true"`
+	WorkspaceID      uuid.UUID `json:"workspaceID" swaggerignore:"true"`
+	RepositoryID     uuid.UUID `json:"repositoryID" swaggerignore:"true"`
+}
+
+func (r *RotateData) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.GracePeriodHours, validation.Min(0), validation.Max(tokenEnums.MaxRotationGracePeriodHours)),
+	)
+}
+
+func (r *RotateData) SetIDs(workspaceID, repositoryID, tokenID uuid.UUID) *RotateData {
+	r.WorkspaceID = workspaceID
+	r.RepositoryID = repositoryID
+	r.TokenID = tokenID
+
+	return r
+}
+
+// GetGracePeriod returns how long the rotated token still works, zero invalidates it right away
+func (r *RotateData) GetGracePeriod() time.Duration {
+	if r.GracePeriodHours == nil {
+		return tokenEnums.DefaultRotationGracePeriodHours * time.Hour
+	}
+
+	return time.Duration(*r.GracePeriodHours) * time.Hour
+}
@@ -0,0 +1,45 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotateDataValidate(t *testing.T) {
+	t.Run("should return no error when grace period is not informed", func(t *testing.T) {
+		assert.NoError(t, (&RotateData{}).Validate())
+	})
+
+	t.Run("should return error when grace period is negative", func(t *testing.T) {
+		gracePeriod := -1
+
+		assert.Error(t, (&RotateData{GracePeriodHours: &gracePeriod}).Validate())
+	})
+}
+
+func TestRotateDataSetIDs(t *testing.T) {
+	t.Run("should success set workspace, repository and token id", func(t *testing.T) {
+		id := uuid.New()
+
+		data := (&RotateData{}).SetIDs(id, id, id)
+
+		assert.Equal(t, id, data.WorkspaceID)
+		assert.Equal(t, id, data.RepositoryID)
+		assert.Equal(t, id, data.TokenID)
+	})
+}
+
+func TestGetGracePeriod(t *testing.T) {
+	t.Run("should return default grace period when not informed", func(t *testing.T) {
+		assert.Equal(t, 24*time.Hour, (&RotateData{}).GetGracePeriod())
+	})
+
+	t.Run("should return informed grace period", func(t *testing.T) {
+		gracePeriod := 0
+
+		assert.Equal(t, time.Duration(0), (&RotateData{GracePeriodHours: &gracePeriod}).GetGracePeriod())
+	})
+}
@@ -4,16 +4,86 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 )
 
 type Token struct {
-	TokenID      uuid.UUID  `json:"tokenID"`
-	WorkspaceID  uuid.UUID  `json:"workspaceID"`
-	RepositoryID *uuid.UUID `json:"repositoryID"`
-	Description  string     `json:"description"`
-	SuffixValue  string     `json:"suffixValue"`
-	Value        string     `json:"value"`
-	IsExpirable  bool       `json:"isExpirable"`
-	CreatedAt    time.Time  `json:"createdAt"`
-	ExpiresAt    time.Time  `json:"expiresAt"`
+	TokenID              uuid.UUID      `json:"tokenID"`
+	WorkspaceID          uuid.UUID      `json:"workspaceID"`
+	RepositoryID         *uuid.UUID     `json:"repositoryID"`
+	Description          string         `json:"description"`
+	SuffixValue          string         `json:"suffixValue"`
+	Value                string         `json:"value"`
+	IsExpirable          bool           `json:"isExpirable"`
+	CreatedAt            time.Time      `json:"createdAt"`
+	ExpiresAt            time.Time      `json:"expiresAt"`
+	Scopes               pq.StringArray `json:"scopes" gorm:"type:text[]"`
+	AllowedCIDRs         pq.StringArray `json:"allowedCIDRs" gorm:"type:text[]"`
+	LastUsedAt           *time.Time     `json:"lastUsedAt"`
+	LastUsedIP           string         `json:"lastUsedIP"`
+	SuccessorID          *uuid.UUID     `json:"successorID"`
+	ExpirationNotifiedAt *time.Time     `json:"-"`
+}
+
+func (t *Token) IsRotated() bool {
+	return t.SuccessorID != nil
+}
+
+// NewSuccessor issues a new token value with the same settings, an expirable token keeps the same validity period
+func (t *Token) NewSuccessor() (*Token, string) {
+	data := t.toData()
+
+	if t.IsExpirable {
+		data.ExpiresAt = time.Now().Add(t.ExpiresAt.Sub(t.CreatedAt))
+	}
+
+	return data.ToToken()
+}
+
+func (t *Token) toData() *Data {
+	data := &Data{
+		Description:  t.Description,
+		WorkspaceID:  t.WorkspaceID,
+		IsExpirable:  t.IsExpirable,
+		ExpiresAt:    t.ExpiresAt,
+		AllowedCIDRs: t.AllowedCIDRs,
+		Scopes:       t.scopesToEnums(),
+	}
+
+	if t.RepositoryID != nil {
+		data.RepositoryID = *t.RepositoryID
+	}
+
+	return data
+}
+
+func (t *Token) scopesToEnums() (scopes []tokenEnums.Scope) {
+	for _, scope := range t.Scopes {
+		scopes = append(scopes, tokenEnums.Scope(scope))
+	}
+
+	return scopes
+}
+
+// Retire links the token to its successor and keeps it valid only until the end of the grace period
+func (t *Token) Retire(successorID uuid.UUID, gracePeriod time.Duration) *Token {
+	t.SuccessorID = &successorID
+
+	expiresAt := time.Now().Add(gracePeriod)
+	if !t.IsExpirable || expiresAt.Before(t.ExpiresAt) {
+		t.ExpiresAt = expiresAt
+	}
+
+	t.IsExpirable = true
+	return t
+}
+
+func (t *Token) ToRetireMap() map[string]interface{} {
+	return map[string]interface{}{
+		"successor_id": t.SuccessorID,
+		"is_expirable": t.IsExpirable,
+		"expires_at":   t.ExpiresAt,
+	}
 }
@@ -0,0 +1,90 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRotated(t *testing.T) {
+	t.Run("should return true when token has a successor", func(t *testing.T) {
+		successorID := uuid.New()
+
+		assert.True(t, (&Token{SuccessorID: &successorID}).IsRotated())
+		assert.False(t, (&Token{}).IsRotated())
+	})
+}
+
+func TestNewSuccessor(t *testing.T) {
+	t.Run("should issue a successor with the same settings and validity period", func(t *testing.T) {
+		repositoryID := uuid.New()
+		token := &Token{
+			TokenID:      uuid.New(),
+			WorkspaceID:  uuid.New(),
+			RepositoryID: &repositoryID,
+			Description:  "test",
+			Value:        "hash",
+			IsExpirable:  true,
+			CreatedAt:    time.Now().Add(-10 * time.Hour),
+			ExpiresAt:    time.Now().Add(10 * time.Hour),
+			Scopes:       pq.StringArray{"analysis:upload"},
+			AllowedCIDRs: pq.StringArray{"10.0.0.0/8"},
+		}
+
+		successor, value := token.NewSuccessor()
+
+		assert.NotEmpty(t, value)
+		assert.NotEqual(t, token.TokenID, successor.TokenID)
+		assert.NotEqual(t, token.Value, successor.Value)
+		assert.Equal(t, token.WorkspaceID, successor.WorkspaceID)
+		assert.Equal(t, &repositoryID, successor.RepositoryID)
+		assert.Equal(t, token.Scopes, successor.Scopes)
+		assert.Equal(t, token.AllowedCIDRs, successor.AllowedCIDRs)
+		assert.WithinDuration(t, time.Now().Add(20*time.Hour), successor.ExpiresAt, time.Minute)
+	})
+
+	t.Run("should issue a workspace successor that does not expire", func(t *testing.T) {
+		token := &Token{WorkspaceID: uuid.New(), Description: "test"}
+
+		successor, _ := token.NewSuccessor()
+
+		assert.Nil(t, successor.RepositoryID)
+		assert.False(t, successor.IsExpirable)
+		assert.Len(t, successor.Scopes, 3)
+	})
+}
+
+func TestRetire(t *testing.T) {
+	t.Run("should expire a non expirable token at the end of the grace period", func(t *testing.T) {
+		successorID := uuid.New()
+
+		token := (&Token{}).Retire(successorID, time.Hour)
+
+		assert.Equal(t, &successorID, token.SuccessorID)
+		assert.True(t, token.IsExpirable)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), token.ExpiresAt, time.Minute)
+	})
+
+	t.Run("should keep expiration when it is before the end of the grace period", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Minute)
+
+		token := (&Token{IsExpirable: true, ExpiresAt: expiresAt}).Retire(uuid.New(), time.Hour)
+
+		assert.Equal(t, expiresAt, token.ExpiresAt)
+	})
+}
+
+func TestToRetireMap(t *testing.T) {
+	t.Run("should parse token to retire map", func(t *testing.T) {
+		token := (&Token{}).Retire(uuid.New(), time.Hour)
+
+		retireMap := token.ToRetireMap()
+
+		assert.Equal(t, token.SuccessorID, retireMap["successor_id"])
+		assert.Equal(t, true, retireMap["is_expirable"])
+		assert.Equal(t, token.ExpiresAt, retireMap["expires_at"])
+	})
+}
@@ -11,30 +11,43 @@ import (
 )
 
 type AccountWorkspace struct {
-	WorkspaceID uuid.UUID    `json:"workspaceID"`
-	AccountID   uuid.UUID    `json:"accountID"`
-	Role        account.Role `json:"role"`
-	CreatedAt   time.Time    `json:"createdAt"`
-	UpdatedAt   time.Time    `json:"updatedAt"`
+	WorkspaceID  uuid.UUID    `json:"workspaceID"`
+	AccountID    uuid.UUID    `json:"accountID"`
+	Role         account.Role `json:"role"`
+	CustomRoleID *uuid.UUID   `json:"customRoleID"`
+	CreatedAt    time.Time    `json:"createdAt"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
 }
 
 func (a *AccountWorkspace) Update(data *role.Data) {
-	a.Role = data.Role
+	a.Role = data.GetRole()
+	a.CustomRoleID = data.GetCustomRoleID()
 	a.UpdatedAt = time.Now()
 }
 
+// ToUpdateMap is used to update the role, allowing to remove the custom role with a null that is ignored by a struct
+func (a *AccountWorkspace) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"role":           a.Role,
+		"custom_role_id": a.CustomRoleID,
+		"updated_at":     a.UpdatedAt,
+	}
+}
+
 func (a *AccountWorkspace) ToResponse() *role.Response {
 	return &role.Response{
-		AccountID: a.AccountID,
-		Role:      a.Role,
+		AccountID:    a.AccountID,
+		Role:         a.Role,
+		CustomRoleID: a.CustomRoleID,
 	}
 }
 
 func (a *AccountWorkspace) ToResponseWithEmailAndUsername(email, username string) *role.Response {
 	return &role.Response{
-		AccountID: a.AccountID,
-		Email:     email,
-		Username:  username,
-		Role:      a.Role,
+		AccountID:    a.AccountID,
+		Email:        email,
+		Username:     username,
+		Role:         a.Role,
+		CustomRoleID: a.CustomRoleID,
 	}
 }
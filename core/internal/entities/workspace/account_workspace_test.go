@@ -28,6 +28,26 @@ func TestUpdateAccountWorkspace(t *testing.T) {
 		assert.Equal(t, account.Member, accountWorkspace.Role)
 		assert.NotEqual(t, expectedTime, accountWorkspace.UpdatedAt)
 	})
+
+	t.Run("should set member role when assigning a custom role", func(t *testing.T) {
+		customRoleID := uuid.New()
+		accountWorkspace := &AccountWorkspace{Role: account.Admin}
+
+		accountWorkspace.Update(&role.Data{Role: account.Admin, CustomRoleID: &customRoleID})
+		assert.Equal(t, account.Member, accountWorkspace.Role)
+		assert.Equal(t, &customRoleID, accountWorkspace.CustomRoleID)
+	})
+}
+
+func TestAccountWorkspaceToUpdateMap(t *testing.T) {
+	t.Run("should keep the custom role column when it is removed", func(t *testing.T) {
+		accountWorkspace := &AccountWorkspace{Role: account.Admin}
+
+		updates := accountWorkspace.ToUpdateMap()
+		assert.Equal(t, account.Admin, updates["role"])
+		assert.Contains(t, updates, "custom_role_id")
+		assert.Nil(t, updates["custom_role_id"])
+	})
 }
 
 func TestToResponse(t *testing.T) {
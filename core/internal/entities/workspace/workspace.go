@@ -15,8 +15,10 @@ type Workspace struct {
 	Description string         `json:"description"`
 	AuthzMember pq.StringArray `json:"authzMember" gorm:"type:text[]"`
 	AuthzAdmin  pq.StringArray `json:"authzAdmin" gorm:"type:text[]"`
+	RequireMFA  bool           `json:"requireMfa"`
 	CreatedAt   time.Time      `json:"createdAt"`
 	UpdatedAt   time.Time      `json:"updatedAt"`
+	ArchivedAt  *time.Time     `json:"archivedAt"`
 }
 
 func (w *Workspace) ToAccountWorkspace(accountID uuid.UUID, role account.Role) *AccountWorkspace {
@@ -37,8 +39,10 @@ func (w *Workspace) ToWorkspaceResponse(role account.Role) *Response {
 		Description: w.Description,
 		AuthzAdmin:  w.AuthzAdmin,
 		AuthzMember: w.AuthzMember,
+		RequireMFA:  w.RequireMFA,
 		CreatedAt:   w.CreatedAt,
 		UpdatedAt:   w.UpdatedAt,
+		ArchivedAt:  w.ArchivedAt,
 	}
 }
 
@@ -47,5 +51,41 @@ func (w *Workspace) Update(data *Data) {
 	w.Description = data.Description
 	w.AuthzMember = data.AuthzMember
 	w.AuthzAdmin = data.AuthzAdmin
+	w.RequireMFA = data.RequireMFA
 	w.UpdatedAt = time.Now()
 }
+
+// ToUpdateMap is used to update the workspace, allowing to set empty and false values that are ignored by a struct
+func (w *Workspace) ToUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         w.Name,
+		"description":  w.Description,
+		"authz_member": w.AuthzMember,
+		"authz_admin":  w.AuthzAdmin,
+		"require_mfa":  w.RequireMFA,
+		"updated_at":   w.UpdatedAt,
+	}
+}
+
+func (w *Workspace) Archive() {
+	now := time.Now()
+	w.ArchivedAt = &now
+	w.UpdatedAt = now
+}
+
+func (w *Workspace) Restore() {
+	w.ArchivedAt = nil
+	w.UpdatedAt = time.Now()
+}
+
+func (w *Workspace) IsArchived() bool {
+	return w.ArchivedAt != nil
+}
+
+// ToArchiveUpdateMap is used to archive and restore, a map is needed to set the archived date back to null
+func (w *Workspace) ToArchiveUpdateMap() map[string]interface{} {
+	return map[string]interface{}{
+		"archived_at": w.ArchivedAt,
+		"updated_at":  w.UpdatedAt,
+	}
+}
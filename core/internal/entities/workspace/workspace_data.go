@@ -21,6 +21,7 @@ type Data struct {
 	Description        string    `json:"description"`
 	AuthzMember        []string  `json:"authzMember"`
 	AuthzAdmin         []string  `json:"authzAdmin"`
+	RequireMFA         bool      `json:"requireMfa"`
 	Permissions        []string  `json:"permissions" swaggerignore:"true"`
 	IsApplicationAdmin bool      `json:"isApplicationAdmin" swaggerignore:"true"`
 }
@@ -44,6 +45,7 @@ func (d *Data) ToWorkspace() *Workspace {
 		Description: d.Description,
 		AuthzMember: d.AuthzMember,
 		AuthzAdmin:  d.AuthzAdmin,
+		RequireMFA:  d.RequireMFA,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
@@ -16,6 +16,8 @@ type Response struct {
 	Description string         `json:"description"`
 	AuthzMember pq.StringArray `json:"authzMember" gorm:"type:text[]"`
 	AuthzAdmin  pq.StringArray `json:"authzAdmin" gorm:"type:text[]"`
+	RequireMFA  bool           `json:"requireMfa"`
 	CreatedAt   time.Time      `json:"createdAt"`
 	UpdatedAt   time.Time      `json:"updatedAt"`
+	ArchivedAt  *time.Time     `json:"archivedAt"`
 }
@@ -77,6 +77,7 @@ func TestUpdateWorkspace(t *testing.T) {
 			Description: "test2",
 			AuthzMember: []string{"test2"},
 			AuthzAdmin:  []string{"test2"},
+			RequireMFA:  true,
 		}
 
 		workspace.Update(data)
@@ -84,6 +85,51 @@ func TestUpdateWorkspace(t *testing.T) {
 		assert.Equal(t, data.Description, workspace.Description)
 		assert.Equal(t, pq.StringArray(data.AuthzAdmin), workspace.AuthzAdmin)
 		assert.Equal(t, pq.StringArray(data.AuthzMember), workspace.AuthzMember)
+		assert.True(t, workspace.RequireMFA)
 		assert.NotEqual(t, expectedTime, workspace.UpdatedAt)
 	})
 }
+
+func TestToUpdateMap(t *testing.T) {
+	t.Run("should return update map with false and empty values", func(t *testing.T) {
+		workspace := &Workspace{Name: "test"}
+
+		updateMap := workspace.ToUpdateMap()
+		assert.Equal(t, "test", updateMap["name"])
+		assert.Equal(t, "", updateMap["description"])
+		assert.Equal(t, false, updateMap["require_mfa"])
+	})
+}
+
+func TestArchiveWorkspace(t *testing.T) {
+	t.Run("should set archived date and mark as archived", func(t *testing.T) {
+		entity := &Workspace{}
+
+		entity.Archive()
+		assert.True(t, entity.IsArchived())
+		assert.NotNil(t, entity.ArchivedAt)
+		assert.Equal(t, *entity.ArchivedAt, entity.UpdatedAt)
+	})
+}
+
+func TestRestoreWorkspace(t *testing.T) {
+	t.Run("should remove archived date", func(t *testing.T) {
+		entity := &Workspace{}
+		entity.Archive()
+
+		entity.Restore()
+		assert.False(t, entity.IsArchived())
+		assert.Nil(t, entity.ArchivedAt)
+	})
+}
+
+func TestToArchiveUpdateMapWorkspace(t *testing.T) {
+	t.Run("should return map with null archived date when restored", func(t *testing.T) {
+		entity := &Workspace{}
+		entity.Restore()
+
+		updateMap := entity.ToArchiveUpdateMap()
+		assert.Nil(t, updateMap["archived_at"])
+		assert.Equal(t, entity.UpdatedAt, updateMap["updated_at"])
+	})
+}
@@ -0,0 +1,7 @@
+package archive
+
+import "errors"
+
+var ErrorNotArchived = errors.New("{CORE_ARCHIVE} this resource is not archived")
+var ErrorRetentionExpired = errors.New("{CORE_ARCHIVE} the retention period of this resource has expired, " +
+	"it can no longer be restored")
@@ -0,0 +1,10 @@
+package archive
+
+const (
+	MessageFailedToPublishEvent        = "{CORE_ARCHIVE} failed to publish archive event"
+	MessageFailedToListExpired         = "{CORE_ARCHIVE} failed to list archived resources with expired retention"
+	MessageFailedToPurgeWorkspace      = "{CORE_ARCHIVE} failed to purge archived workspace"
+	MessageFailedToPurgeRepository     = "{CORE_ARCHIVE} failed to purge archived repository"
+	MessagePurgingExpiredArchivedItems = "{CORE_ARCHIVE} purging archived workspaces and repositories with expired " +
+		"retention"
+)
@@ -0,0 +1,19 @@
+package archive
+
+import "time"
+
+const (
+	EnvRetentionDays     = "HORUSEC_ARCHIVE_RETENTION_DAYS"
+	DefaultRetentionDays = 30
+	PurgeInterval        = time.Hour
+	ExchangeArchive      = "horusec-archive"
+	DatabaseArchivedAt   = "archived_at"
+)
+
+type Action string
+
+const (
+	ActionArchived Action = "ARCHIVED"
+	ActionRestored Action = "RESTORED"
+	ActionPurged   Action = "PURGED"
+)
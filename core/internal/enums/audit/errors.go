@@ -0,0 +1,8 @@
+package audit
+
+import "errors"
+
+var ErrorInvalidFilterDate = errors.New("{CORE_AUDIT} from and to must be valid dates, like 2021-12-30T23:59:59Z")
+var ErrorInvalidFilterID = errors.New("{CORE_AUDIT} workspace, repository and actor filters must be valid ids")
+var ErrorInvalidExportFormat = errors.New("{CORE_AUDIT} invalid export format, use json or csv")
+var ErrorChainConflict = errors.New("{CORE_AUDIT} failed to append the event to the hash chain after retries")
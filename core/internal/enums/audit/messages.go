@@ -0,0 +1,10 @@
+package audit
+
+const (
+	MessageFailedToPublishEvent  = "{CORE_AUDIT} failed to publish audit event"
+	MessageFailedToGetActor      = "{CORE_AUDIT} failed to get the account that performed the audited action"
+	MessageAuditEventReceived    = "{CORE_AUDIT} audit event received"
+	MessageFailedToParsePacket   = "{CORE_AUDIT} failed to parse audit event packet, it will be discarded"
+	MessageFailedToSaveEvent     = "{CORE_AUDIT} failed to save audit event, it will be requeued"
+	MessageChainConflictRetrying = "{CORE_AUDIT} another event was appended to the hash chain, retrying"
+)
@@ -0,0 +1,117 @@
+package audit
+
+const (
+	ExchangeAudit         = "horusec-audit"
+	QueueCoreAudit        = "horusec-core::audit"
+	DatabaseAuditTable    = "audit_events"
+	ServiceCore           = "core"
+	GenesisHash           = "0000000000000000000000000000000000000000000000000000000000000000"
+	MaxChainAttempts      = 5
+	VerifyBatchSize       = 500
+	MaxExportSize         = 10000
+	DefaultPaginationSize = 20
+	MaxPaginationSize     = 100
+	TimeLayout            = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+const (
+	Page              = "page"
+	Size              = "size"
+	WorkspaceIDQuery  = "workspaceID"
+	RepositoryIDQuery = "repositoryID"
+	ActorIDQuery      = "actorID"
+	ActionQuery       = "action"
+	TargetTypeQuery   = "targetType"
+	FromQuery         = "from"
+	ToQuery           = "to"
+	FormatQuery       = "format"
+)
+
+type Action string
+
+const (
+	ActionWorkspaceCreate          Action = "workspace.create"
+	ActionWorkspaceDelete          Action = "workspace.delete"
+	ActionWorkspaceRestore         Action = "workspace.restore"
+	ActionWorkspaceRoleUpdate      Action = "workspace.role.update"
+	ActionWorkspaceMemberAdd       Action = "workspace.member.add"
+	ActionWorkspaceMemberRemove    Action = "workspace.member.remove"
+	ActionWorkspaceTokenCreate     Action = "workspace.token.create"
+	ActionWorkspaceTokenRotate     Action = "workspace.token.rotate"
+	ActionWorkspaceTokenDelete     Action = "workspace.token.delete"
+	ActionRepositoryCreate         Action = "repository.create"
+	ActionRepositoryDelete         Action = "repository.delete"
+	ActionRepositoryRestore        Action = "repository.restore"
+	ActionRepositoryTransfer       Action = "repository.transfer"
+	ActionRepositoryRoleUpdate     Action = "repository.role.update"
+	ActionRepositoryMemberAdd      Action = "repository.member.add"
+	ActionRepositoryMemberRemove   Action = "repository.member.remove"
+	ActionRepositoryTokenCreate    Action = "repository.token.create"
+	ActionRepositoryTokenRotate    Action = "repository.token.rotate"
+	ActionRepositoryTokenDelete    Action = "repository.token.delete"
+	ActionCustomRoleCreate         Action = "workspace.custom_role.create"
+	ActionCustomRoleUpdate         Action = "workspace.custom_role.update"
+	ActionCustomRoleDelete         Action = "workspace.custom_role.delete"
+	ActionWorkspaceQuotaUpdate     Action = "workspace.quota.update"
+	ActionInvitationCreate         Action = "invitation.create"
+	ActionInvitationResend         Action = "invitation.resend"
+	ActionInvitationRevoke         Action = "invitation.revoke"
+	ActionInvitationAccept         Action = "invitation.accept"
+	ActionInvitationDecline        Action = "invitation.decline"
+	ActionTeamCreate               Action = "workspace.team.create"
+	ActionTeamUpdate               Action = "workspace.team.update"
+	ActionTeamDelete               Action = "workspace.team.delete"
+	ActionTeamMemberAdd            Action = "workspace.team.member.add"
+	ActionTeamMemberRemove         Action = "workspace.team.member.remove"
+	ActionTeamRepositoryRoleGrant  Action = "repository.team.role.grant"
+	ActionTeamRepositoryRoleUpdate Action = "repository.team.role.update"
+	ActionTeamRepositoryRoleRevoke Action = "repository.team.role.revoke"
+	ActionImportCreate             Action = "workspace.import.create"
+	ActionImportSync               Action = "workspace.import.sync"
+	ActionImportDelete             Action = "workspace.import.delete"
+)
+
+type TargetType string
+
+const (
+	TargetWorkspace  TargetType = "workspace"
+	TargetRepository TargetType = "repository"
+	TargetRole       TargetType = "role"
+	TargetToken      TargetType = "token"
+	TargetCustomRole TargetType = "custom_role"
+	TargetQuota      TargetType = "quota"
+	TargetInvitation TargetType = "invitation"
+	TargetTeam       TargetType = "team"
+	TargetImport     TargetType = "import"
+)
+
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+)
+
+const (
+	ContentTypeJSON    = "application/json"
+	ContentTypeCSV     = "text/csv"
+	ContentDisposition = "attachment; filename=\"horusec-audit-%s.%s\""
+)
+
+func (f Format) ToString() string {
+	return string(f)
+}
+
+func (f Format) ContentType() string {
+	if f == FormatCSV {
+		return ContentTypeCSV
+	}
+
+	return ContentTypeJSON
+}
+
+// CSVHeader is the first row of the csv export, the columns follow the same order of the event fields
+func CSVHeader() []string {
+	return []string{"sequence", "eventID", "service", "action", "actorID", "actorEmail", "ip", "workspaceID",
+		"repositoryID", "targetType", "targetID", "before", "after", "occurredAt", "previousHash", "hash"}
+}
@@ -0,0 +1,18 @@
+package authentication
+
+import "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
+
+const (
+	AuthenticationTypeOIDC auth.AuthenticationType = "oidc"
+	AuthenticationTypeSAML auth.AuthenticationType = "saml"
+)
+
+// IsGroupBased returns true for the authentication types that authorize by the groups in the token permissions
+func IsGroupBased(authType auth.AuthenticationType) bool {
+	switch authType {
+	case auth.Ldap, AuthenticationTypeOIDC, AuthenticationTypeSAML:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,8 @@
+package customrole
+
+import "errors"
+
+var ErrorCustomRoleNameAlreadyInUse = errors.New("{CORE_CUSTOM_ROLE} custom role name already in use")
+var ErrorInvalidPermission = errors.New("{CORE_CUSTOM_ROLE} invalid permission")
+var ErrorCustomRoleNotInWorkspace = errors.New("{CORE_CUSTOM_ROLE} the custom role does not exist in the " +
+	"workspace")
@@ -0,0 +1,6 @@
+package customrole
+
+const (
+	DatabaseCustomRoleTable = "custom_roles"
+	ID                      = "customRoleID"
+)
@@ -0,0 +1,6 @@
+package encryption
+
+import "errors"
+
+var ErrorEncryptionKeyNotSet = errors.New("{CORE_ENCRYPTION} encryption key env not set")
+var ErrorInvalidEncryptedValue = errors.New("{CORE_ENCRYPTION} invalid encrypted value")
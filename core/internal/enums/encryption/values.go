@@ -0,0 +1,5 @@
+package encryption
+
+const (
+	EnvEncryptionKey = "HORUSEC_CORE_ENCRYPTION_KEY"
+)
@@ -0,0 +1,13 @@
+package importer
+
+import "errors"
+
+var ErrorUsernameRequiredForBitbucket = errors.New("{CORE_IMPORTER} username is required to import from bitbucket")
+var ErrorProviderRequestFailed = errors.New("{CORE_IMPORTER} the scm provider rejected the request, check the " +
+	"organization and the access token")
+var ErrorImportAlreadyExists = errors.New("{CORE_IMPORTER} this organization is already imported in this " +
+	"workspace, sync it instead")
+var ErrorBaseURLNotAllowed = errors.New("{CORE_IMPORTER} the base url is not allowed, only the default url of the " +
+	"provider and the urls allowed by the operator can be used")
+var ErrorTooManyRemotePages = errors.New("{CORE_IMPORTER} the organization has more repositories than the " +
+	"import limit")
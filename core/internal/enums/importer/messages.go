@@ -0,0 +1,9 @@
+package importer
+
+const (
+	MessageSyncingPeriodicImports    = "{CORE_IMPORTER} syncing the repository imports with periodic sync enabled"
+	MessageFailedToClaimPeriodic     = "{CORE_IMPORTER} failed to claim the imports with periodic sync enabled"
+	MessageFailedToSyncImport        = "{CORE_IMPORTER} failed to sync repository import"
+	MessageFailedToImportRepository  = "{CORE_IMPORTER} failed to import repository"
+	MessageFailedToArchiveRepository = "{CORE_IMPORTER} failed to archive repository removed from the provider"
+)
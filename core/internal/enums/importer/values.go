@@ -0,0 +1,51 @@
+package importer
+
+import "time"
+
+const (
+	DatabaseImportTable      = "repository_imports"
+	ID                       = "importID"
+	ColumnImportID           = "import_id"
+	EnvSyncIntervalHours     = "HORUSEC_IMPORT_SYNC_INTERVAL_HOURS"
+	EnvAllowedBaseURLs       = "HORUSEC_IMPORT_ALLOWED_BASE_URLS"
+	DefaultSyncIntervalHours = 24
+	ProviderTimeout          = 30 * time.Second
+	PageSize                 = 100
+	MaxPages                 = 100
+	ImportedTokenDescription = "generated by repository import"
+	GithubDefaultBaseURL     = "https://api.github.com"
+	GitlabDefaultBaseURL     = "https://gitlab.com"
+	BitbucketDefaultBaseURL  = "https://api.bitbucket.org"
+	GitlabPrivateTokenHeader = "PRIVATE-TOKEN"
+)
+
+type Provider string
+
+const (
+	ProviderGithub    Provider = "GITHUB"
+	ProviderGitlab    Provider = "GITLAB"
+	ProviderBitbucket Provider = "BITBUCKET"
+)
+
+func (p Provider) ToString() string {
+	return string(p)
+}
+
+func (p Provider) GetDefaultBaseURL() string {
+	switch p {
+	case ProviderGitlab:
+		return GitlabDefaultBaseURL
+	case ProviderBitbucket:
+		return BitbucketDefaultBaseURL
+	default:
+		return GithubDefaultBaseURL
+	}
+}
+
+func ProviderValues() []interface{} {
+	return []interface{}{
+		ProviderGithub,
+		ProviderGitlab,
+		ProviderBitbucket,
+	}
+}
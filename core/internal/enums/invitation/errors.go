@@ -0,0 +1,11 @@
+package invitation
+
+import "errors"
+
+var ErrorInvalidToken = errors.New("{CORE_INVITATION} invalid invitation token")
+var ErrorInvitationExpired = errors.New("{CORE_INVITATION} this invitation has expired, ask an admin to resend it")
+var ErrorInvitationNotPending = errors.New("{CORE_INVITATION} this invitation was already accepted, declined " +
+	"or revoked")
+var ErrorInvitationAlreadyPending = errors.New("{CORE_INVITATION} there is already a pending invitation for " +
+	"this email")
+var ErrorInvitationEmailMismatch = errors.New("{CORE_INVITATION} this invitation was sent to another email")
@@ -0,0 +1,8 @@
+package invitation
+
+const (
+	MessageFailedToRollbackBind    = "{CORE_INVITATION} failed to rollback the invitation binding"
+	MessageEmailVerifiedReceived   = "{CORE_INVITATION} verified email received"
+	MessageFailedToParsePacket     = "{CORE_INVITATION} failed to parse verified email packet, it will be discarded"
+	MessageFailedToBindInvitations = "{CORE_INVITATION} failed to bind the pending invitations, it will be requeued"
+)
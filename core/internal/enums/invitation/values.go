@@ -0,0 +1,26 @@
+package invitation
+
+import emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+
+const (
+	DatabaseInvitationTable = "invitations"
+	ID                      = "invitationID"
+	EnvExpirationHours      = "HORUSEC_INVITATION_EXPIRATION_HOURS"
+	DefaultExpirationHours  = 168
+	EnvSecretKey            = "HORUSEC_JWT_SECRET_KEY"
+	TokenSeparator          = "."
+	ExchangeEmailVerified   = "horusec-account-email-verified"
+	QueueCoreEmailVerified  = "horusec-core::email-verified"
+)
+
+// PendingInvitationTemplate is not available in the devkit email templates, the same name is used by the messages
+const PendingInvitationTemplate emailEnums.Template = "pending-invitation"
+
+type Status string
+
+const (
+	StatusPending  Status = "PENDING"
+	StatusAccepted Status = "ACCEPTED"
+	StatusDeclined Status = "DECLINED"
+	StatusRevoked  Status = "REVOKED"
+)
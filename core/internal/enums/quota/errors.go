@@ -0,0 +1,6 @@
+package quota
+
+import "errors"
+
+var ErrorRepositoriesLimitReached = errors.New("{CORE_QUOTA} the workspace reached its limit of repositories")
+var ErrorTokensLimitReached = errors.New("{CORE_QUOTA} the workspace reached its limit of tokens")
@@ -0,0 +1,5 @@
+package quota
+
+const (
+	DatabaseWorkspaceQuotaTable = "workspace_quotas"
+)
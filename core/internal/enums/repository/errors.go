@@ -4,3 +4,11 @@ import "errors"
 
 var ErrorRepositoryNameAlreadyInUse = errors.New("{CORE_REPOSITORY} repository name already in use")
 var ErrorUserDoesNotBelongToWorkspace = errors.New("{CORE_REPOSITORY} this user does not belong to this workspace")
+var ErrorOwnerTeamNotInWorkspace = errors.New("{CORE_REPOSITORY} the owner team must belong to the workspace of " +
+	"the repository")
+var ErrorTransferToSameWorkspace = errors.New("{CORE_REPOSITORY} the repository already belongs to the target " +
+	"workspace")
+var ErrorTransferArchived = errors.New("{CORE_REPOSITORY} archived repositories and workspaces cannot be part of a " +
+	"transfer, restore them first")
+var ErrorNotTargetWorkspaceAdmin = errors.New("{CORE_REPOSITORY} admin rights on the target workspace are needed " +
+	"to transfer a repository")
@@ -1,5 +1,10 @@
 package repository
 
 const (
-	ErrorRollbackCreate = "{CORE_REPOSITORY} transaction rollback returned a error while creating repository"
+	ErrorRollbackCreate               = "{CORE_REPOSITORY} transaction rollback returned a error while creating repository"
+	MessageFailedToPublishCriticality = "{CORE_REPOSITORY} failed to publish repository criticality to analytic"
+	MessageFailedToPublishMetadata    = "{CORE_REPOSITORY} failed to publish repository metadata"
+	MessageFailedToPublishTransfer    = "{CORE_REPOSITORY} failed to publish repository transfer"
+	ErrorRollbackTransfer             = "{CORE_REPOSITORY} transaction rollback returned a error while transferring " +
+		"repository"
 )
@@ -1,7 +1,76 @@
 package repository
 
+import (
+	"regexp"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+)
+
 const (
 	DatabaseRepositoryTable        = "repositories"
 	DatabaseAccountRepositoryTable = "account_repository"
 	ID                             = "repositoryID"
 )
+
+const (
+	QueueAnalyticRepositoryCriticality queues.Queue = "horusec-analytic::repository-criticality"
+	ExchangeRepositoryMetadata                      = "horusec-repository-metadata"
+	ExchangeRepositoryTransfer                      = "horusec-repository-transfer"
+)
+
+const (
+	DatabaseAnalysisTable       = "analysis"
+	DatabaseScimGroupRolesTable = "scim_group_roles"
+)
+
+const (
+	LabelQuery = "label"
+	MaxLabels  = 20
+)
+
+// LabelRegex accepts labels in the key=value format, keys are limited to 63 and values to 255 characters
+var LabelRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.\-/]{0,62}=[^=\s]{1,255}$`)
+
+type Criticality string
+
+const (
+	CriticalityLow      Criticality = "LOW"
+	CriticalityMedium   Criticality = "MEDIUM"
+	CriticalityHigh     Criticality = "HIGH"
+	CriticalityCritical Criticality = "CRITICAL"
+)
+
+func (c Criticality) ToString() string {
+	return string(c)
+}
+
+func CriticalityValues() []interface{} {
+	return []interface{}{
+		CriticalityLow,
+		CriticalityMedium,
+		CriticalityHigh,
+		CriticalityCritical,
+	}
+}
+
+type DataClassification string
+
+const (
+	DataClassificationPublic       DataClassification = "PUBLIC"
+	DataClassificationInternal     DataClassification = "INTERNAL"
+	DataClassificationConfidential DataClassification = "CONFIDENTIAL"
+	DataClassificationRestricted   DataClassification = "RESTRICTED"
+)
+
+func (d DataClassification) ToString() string {
+	return string(d)
+}
+
+func DataClassificationValues() []interface{} {
+	return []interface{}{
+		DataClassificationPublic,
+		DataClassificationInternal,
+		DataClassificationConfidential,
+		DataClassificationRestricted,
+	}
+}
@@ -3,5 +3,8 @@ package routes
 const (
 	WorkspaceHandler  = "/core/workspaces"
 	RepositoryHandler = "/core/workspaces/{workspaceID}/repositories"
+	InvitationHandler = "/core/invitations"
+	AuditHandler      = "/core/audit"
+	QuotaHandler      = "/core/quotas"
 	HealthHandler     = "/core/health"
 )
@@ -0,0 +1,11 @@
+package team
+
+import "errors"
+
+var ErrorTeamNameAlreadyInUse = errors.New("{CORE_TEAM} team name already in use")
+var ErrorAccountNotWorkspaceMember = errors.New("{CORE_TEAM} the account must be a member of the workspace of " +
+	"the team")
+var ErrorAccountAlreadyTeamMember = errors.New("{CORE_TEAM} the account is already a member of this team")
+var ErrorTeamAlreadyHasRepositoryRole = errors.New("{CORE_TEAM} this team already has a role in this repository")
+var ErrorRepositoryNotInTeamWorkspace = errors.New("{CORE_TEAM} the repository does not belong to the workspace " +
+	"of the team")
@@ -0,0 +1,8 @@
+package team
+
+const (
+	DatabaseTeamTable           = "teams"
+	DatabaseTeamAccountTable    = "team_account"
+	DatabaseTeamRepositoryTable = "team_repository"
+	ID                          = "teamID"
+)
@@ -3,3 +3,5 @@ package token
 import "errors"
 
 var ErrorInvalidTokenExpiresAt = errors.New("{TOKEN} expires at cannot be a past date")
+var ErrorInvalidAllowedCIDR = errors.New("{TOKEN} allowed cidrs must be valid ip ranges, like 10.0.0.0/8")
+var ErrorTokenAlreadyRotated = errors.New("{TOKEN} this token was already rotated, rotate its successor instead")
@@ -0,0 +1,8 @@
+package token
+
+const (
+	MessageNotifyingExpiringTokens     = "{CORE_TOKEN} notifying the admins of the tokens close to expire"
+	MessageFailedToClaimExpiringTokens = "{CORE_TOKEN} failed to claim the tokens close to expire"
+	MessageFailedToSendExpirationEmail = "{CORE_TOKEN} failed to send token expiration email"
+	MessageFailedToRollbackRotate      = "{CORE_TOKEN} failed to rollback token rotation"
+)
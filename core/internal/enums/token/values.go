@@ -1,6 +1,49 @@
 package token
 
+import (
+	"time"
+
+	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
+)
+
 const (
-	DatabaseTokens = "tokens"
-	ID             = "tokenID"
+	DatabaseTokens                  = "tokens"
+	ID                              = "tokenID"
+	EnvExpirationNoticeDays         = "HORUSEC_TOKEN_EXPIRATION_NOTICE_DAYS"
+	DefaultExpirationNoticeDays     = 7
+	NotificationInterval            = time.Hour
+	DefaultRotationGracePeriodHours = 24
+	MaxRotationGracePeriodHours     = 720
 )
+
+// TokenExpirationTemplate is not available in the devkit email templates, the same name is used by the messages
+const TokenExpirationTemplate emailEnums.Template = "token-expiration"
+
+type Scope string
+
+const (
+	ScopeUploadAnalysis Scope = "analysis:upload"
+	ScopeReadAnalysis   Scope = "analysis:read"
+	ScopeReadFindings   Scope = "findings:read"
+)
+
+func (s Scope) ToString() string {
+	return string(s)
+}
+
+func ScopeValues() []interface{} {
+	return []interface{}{
+		ScopeUploadAnalysis,
+		ScopeReadAnalysis,
+		ScopeReadFindings,
+	}
+}
+
+// DefaultScopes keeps the previous behavior for the tokens created without scopes, which could do everything
+func DefaultScopes() []Scope {
+	return []Scope{
+		ScopeUploadAnalysis,
+		ScopeReadAnalysis,
+		ScopeReadFindings,
+	}
+}
@@ -0,0 +1,32 @@
+package archive
+
+import (
+	"time"
+
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+)
+
+type Events struct {
+	service archiveService.IService
+}
+
+func NewArchiveEvents(service archiveService.IService) *Events {
+	events := &Events{
+		service: service,
+	}
+
+	return events.startPurge()
+}
+
+func (e *Events) startPurge() *Events {
+	go e.purgeOnInterval(time.NewTicker(archiveEnums.PurgeInterval))
+
+	return e
+}
+
+func (e *Events) purgeOnInterval(ticker *time.Ticker) {
+	for range ticker.C {
+		e.service.PurgeExpired()
+	}
+}
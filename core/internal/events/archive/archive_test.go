@@ -0,0 +1,15 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	archiveService "github.com/ZupIT/horusec-platform/core/internal/services/archive"
+)
+
+func TestNewArchiveEvents(t *testing.T) {
+	t.Run("should success create archive events and start the purge", func(t *testing.T) {
+		assert.NotNil(t, NewArchiveEvents(&archiveService.Mock{}))
+	})
+}
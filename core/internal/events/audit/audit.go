@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	auditController "github.com/ZupIT/horusec-platform/core/internal/controllers/audit"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+type Events struct {
+	broker     brokerService.IBroker
+	controller auditController.IController
+}
+
+func NewAuditEvents(broker brokerService.IBroker, controller auditController.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(auditEnums.QueueCoreAudit, auditEnums.ExchangeAudit, exchange.Fanout, e.handleAuditEvent)
+
+	return e
+}
+
+// handleAuditEvent requeues the events that failed to be saved, so no audit event is lost while the database is
+// unavailable, the invalid ones are discarded since they would never be saved
+func (e *Events) handleAuditEvent(auditPacket packet.IPacket) {
+	logger.LogInfo(auditEnums.MessageAuditEventReceived)
+	event := &auditEntities.Event{}
+
+	if err := parser.ParsePacketToEntity(auditPacket, event); err != nil {
+		logger.LogError(auditEnums.MessageFailedToParsePacket, err)
+		_ = auditPacket.Ack()
+		return
+	}
+
+	e.saveEvent(auditPacket, event)
+}
+
+func (e *Events) saveEvent(auditPacket packet.IPacket, event *auditEntities.Event) {
+	if err := e.controller.SaveEvent(event); err != nil {
+		logger.LogError(auditEnums.MessageFailedToSaveEvent, err)
+		_ = auditPacket.Nack()
+		return
+	}
+
+	_ = auditPacket.Ack()
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+
+	auditController "github.com/ZupIT/horusec-platform/core/internal/controllers/audit"
+)
+
+func TestNewAuditEvents(t *testing.T) {
+	t.Run("should start consumers and consume without errors", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("SaveEvent").Return(nil)
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"action": "workspace.create"}`))
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		assert.NotPanics(t, func() {
+			NewAuditEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+		})
+	})
+}
+
+func TestHandleAuditEvent(t *testing.T) {
+	t.Run("should not save when failed to parse packet", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		assert.NotPanics(t, func() {
+			events.handleAuditEvent(brokerPacket.NewPacket(&amqp.Delivery{}))
+		})
+
+		controllerMock.AssertNotCalled(t, "SaveEvent")
+	})
+
+	t.Run("should save the event", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("SaveEvent").Return(nil)
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"action": "workspace.create"}`))
+
+		assert.NotPanics(t, func() {
+			events.handleAuditEvent(packet)
+		})
+
+		controllerMock.AssertCalled(t, "SaveEvent")
+	})
+
+	t.Run("should requeue when failed to save the event", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("SaveEvent").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(`{"action": "workspace.create"}`))
+
+		assert.NotPanics(t, func() {
+			events.handleAuditEvent(packet)
+		})
+
+		controllerMock.AssertCalled(t, "SaveEvent")
+	})
+}
@@ -0,0 +1,46 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	importerController "github.com/ZupIT/horusec-platform/core/internal/controllers/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+type Events struct {
+	controller importerController.IController
+}
+
+func NewImporterEvents(controller importerController.IController) *Events {
+	events := &Events{
+		controller: controller,
+	}
+
+	return events.startPeriodicSync()
+}
+
+func (e *Events) startPeriodicSync() *Events {
+	interval := e.getSyncInterval()
+	go e.syncOnInterval(time.NewTicker(interval), interval)
+
+	return e
+}
+
+func (e *Events) getSyncInterval() time.Duration {
+	hours := env.GetEnvOrDefaultInt(importerEnums.EnvSyncIntervalHours, importerEnums.DefaultSyncIntervalHours)
+	if hours <= 0 {
+		hours = importerEnums.DefaultSyncIntervalHours
+	}
+
+	return time.Duration(hours) * time.Hour
+}
+
+// syncOnInterval skips the imports synced in the last half interval, since another replica or a manual sync already
+// synced them
+func (e *Events) syncOnInterval(ticker *time.Ticker, interval time.Duration) {
+	for range ticker.C {
+		e.controller.SyncPeriodic(time.Now().Add(-interval / 2))
+	}
+}
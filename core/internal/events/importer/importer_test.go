@@ -0,0 +1,34 @@
+package importer
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	importerController "github.com/ZupIT/horusec-platform/core/internal/controllers/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func TestNewImporterEvents(t *testing.T) {
+	t.Run("should success create importer events and start the periodic sync", func(t *testing.T) {
+		assert.NotNil(t, NewImporterEvents(&importerController.Mock{}))
+	})
+}
+
+func TestGetSyncInterval(t *testing.T) {
+	t.Run("should use the interval of the environment", func(t *testing.T) {
+		_ = os.Setenv(importerEnums.EnvSyncIntervalHours, "2")
+		defer os.Unsetenv(importerEnums.EnvSyncIntervalHours)
+
+		assert.Equal(t, 2*time.Hour, (&Events{}).getSyncInterval())
+	})
+
+	t.Run("should use the default interval when the environment is invalid", func(t *testing.T) {
+		_ = os.Setenv(importerEnums.EnvSyncIntervalHours, "0")
+		defer os.Unsetenv(importerEnums.EnvSyncIntervalHours)
+
+		assert.Equal(t, importerEnums.DefaultSyncIntervalHours*time.Hour, (&Events{}).getSyncInterval())
+	})
+}
@@ -0,0 +1,60 @@
+package invitation
+
+import (
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	invitationController "github.com/ZupIT/horusec-platform/core/internal/controllers/invitation"
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+type Events struct {
+	broker     brokerService.IBroker
+	controller invitationController.IController
+}
+
+func NewInvitationEvents(broker brokerService.IBroker, controller invitationController.IController) *Events {
+	events := &Events{
+		broker:     broker,
+		controller: controller,
+	}
+
+	return events.startConsumers()
+}
+
+func (e *Events) startConsumers() *Events {
+	go e.broker.Consume(invitationEnums.QueueCoreEmailVerified, invitationEnums.ExchangeEmailVerified,
+		exchange.Fanout, e.handleEmailVerified)
+
+	return e
+}
+
+// handleEmailVerified requeues the events that failed to be bound, the invitations already bound are accepted and
+// are not listed again
+func (e *Events) handleEmailVerified(verifiedPacket packet.IPacket) {
+	logger.LogInfo(invitationEnums.MessageEmailVerifiedReceived)
+	verifiedEmail := &invitationEntities.VerifiedEmail{}
+
+	if err := parser.ParsePacketToEntity(verifiedPacket, verifiedEmail); err != nil {
+		logger.LogError(invitationEnums.MessageFailedToParsePacket, err)
+		_ = verifiedPacket.Ack()
+		return
+	}
+
+	e.bindPendingInvitations(verifiedPacket, verifiedEmail)
+}
+
+func (e *Events) bindPendingInvitations(verifiedPacket packet.IPacket,
+	verifiedEmail *invitationEntities.VerifiedEmail) {
+	if err := e.controller.BindPendingInvitations(verifiedEmail); err != nil {
+		logger.LogError(invitationEnums.MessageFailedToBindInvitations, err)
+		_ = verifiedPacket.Nack()
+		return
+	}
+
+	_ = verifiedPacket.Ack()
+}
@@ -0,0 +1,85 @@
+package invitation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	brokerPacket "github.com/ZupIT/horusec-devkit/pkg/services/broker/packet"
+
+	invitationController "github.com/ZupIT/horusec-platform/core/internal/controllers/invitation"
+)
+
+const verifiedEmailBody = `{"accountID": "2f7b2c8e-8b5a-4a43-9d5f-4f5e6c1e2a10", "email": "test@test.com"}`
+
+func TestNewInvitationEvents(t *testing.T) {
+	t.Run("should start consumers and consume without errors", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("BindPendingInvitations").Return(nil)
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(verifiedEmailBody))
+
+		brokerMock := &broker.Mock{}
+		brokerMock.On("ConsumeHandlerFunc").Return(packet)
+		brokerMock.On("Consume").Return()
+
+		assert.NotPanics(t, func() {
+			NewInvitationEvents(brokerMock, controllerMock)
+
+			time.Sleep(1 * time.Second)
+
+			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
+		})
+	})
+}
+
+func TestHandleEmailVerified(t *testing.T) {
+	t.Run("should not bind when failed to parse packet", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		assert.NotPanics(t, func() {
+			events.handleEmailVerified(brokerPacket.NewPacket(&amqp.Delivery{}))
+		})
+
+		controllerMock.AssertNotCalled(t, "BindPendingInvitations")
+	})
+
+	t.Run("should bind the pending invitations", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("BindPendingInvitations").Return(nil)
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(verifiedEmailBody))
+
+		assert.NotPanics(t, func() {
+			events.handleEmailVerified(packet)
+		})
+
+		controllerMock.AssertCalled(t, "BindPendingInvitations")
+	})
+
+	t.Run("should requeue when failed to bind the pending invitations", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("BindPendingInvitations").Return(errors.New("test"))
+
+		events := &Events{broker: &broker.Mock{}, controller: controllerMock}
+
+		packet := brokerPacket.NewPacket(&amqp.Delivery{})
+		packet.SetBody([]byte(verifiedEmailBody))
+
+		assert.NotPanics(t, func() {
+			events.handleEmailVerified(packet)
+		})
+
+		controllerMock.AssertCalled(t, "BindPendingInvitations")
+	})
+}
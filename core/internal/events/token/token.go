@@ -0,0 +1,32 @@
+package token
+
+import (
+	"time"
+
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
+)
+
+type Events struct {
+	service tokenService.IService
+}
+
+func NewTokenEvents(service tokenService.IService) *Events {
+	events := &Events{
+		service: service,
+	}
+
+	return events.startExpirationNotifications()
+}
+
+func (e *Events) startExpirationNotifications() *Events {
+	go e.notifyOnInterval(time.NewTicker(tokenEnums.NotificationInterval))
+
+	return e
+}
+
+func (e *Events) notifyOnInterval(ticker *time.Ticker) {
+	for range ticker.C {
+		e.service.NotifyExpiring()
+	}
+}
@@ -0,0 +1,15 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	tokenService "github.com/ZupIT/horusec-platform/core/internal/services/token"
+)
+
+func TestNewTokenEvents(t *testing.T) {
+	t.Run("should success create token events and start the expiration notifications", func(t *testing.T) {
+		assert.NotNil(t, NewTokenEvents(&tokenService.Mock{}))
+	})
+}
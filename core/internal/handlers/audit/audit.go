@@ -0,0 +1,222 @@
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+
+	auditController "github.com/ZupIT/horusec-platform/core/internal/controllers/audit"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+)
+
+type Handler struct {
+	controller auditController.IController
+	useCases   auditUseCases.IUseCases
+}
+
+func NewAuditHandler(controller auditController.IController, useCases auditUseCases.IUseCases) *Handler {
+	return &Handler{
+		controller: controller,
+		useCases:   useCases,
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Audit
+// @Description List the audit events of all the platform, only application admins have access
+// @ID list-audit-events
+// @Accept  json
+// @Produce  json
+// @Param workspaceID query string false "filter by workspace"
+// @Param repositoryID query string false "filter by repository"
+// @Param actorID query string false "filter by the account that performed the action"
+// @Param action query string false "filter by action, like workspace.create"
+// @Param targetType query string false "filter by target type, like token"
+// @Param from query string false "events since this date, like 2021-12-30T23:59:59Z"
+// @Param to query string false "events until this date, like 2021-12-30T23:59:59Z"
+// @Param page query string false "page, starting at 0"
+// @Param size query string false "page size"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/audit [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCases.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.listEvents(w, filter)
+}
+
+// @Tags Audit
+// @Description List the audit events of a workspace
+// @ID list-workspace-audit-events
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID query string false "filter by repository"
+// @Param actorID query string false "filter by the account that performed the action"
+// @Param action query string false "filter by action, like workspace.role.update"
+// @Param targetType query string false "filter by target type, like token"
+// @Param from query string false "events since this date, like 2021-12-30T23:59:59Z"
+// @Param to query string false "events until this date, like 2021-12-30T23:59:59Z"
+// @Param page query string false "page, starting at 0"
+// @Param size query string false "page size"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/audit [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListByWorkspace(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.getWorkspaceFilter(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.listEvents(w, filter)
+}
+
+func (h *Handler) listEvents(w http.ResponseWriter, filter *auditEntities.Filter) {
+	result, err := h.controller.ListEvents(filter)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
+
+// getWorkspaceFilter always uses the workspace of the path, so workspace admins can not see other workspaces
+func (h *Handler) getWorkspaceFilter(r *http.Request) (*auditEntities.Filter, error) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := h.useCases.FilterFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter.SetWorkspaceID(workspaceID), nil
+}
+
+// @Tags Audit
+// @Description Export the audit events of all the platform as json or csv, only application admins have access
+// @ID export-audit-events
+// @Accept  json
+// @Produce  json
+// @Param format query string false "json or csv, json by default"
+// @Param workspaceID query string false "filter by workspace"
+// @Param repositoryID query string false "filter by repository"
+// @Param actorID query string false "filter by the account that performed the action"
+// @Param action query string false "filter by action, like workspace.create"
+// @Param targetType query string false "filter by target type, like token"
+// @Param from query string false "events since this date, like 2021-12-30T23:59:59Z"
+// @Param to query string false "events until this date, like 2021-12-30T23:59:59Z"
+// @Success 200 {file} file
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/audit/export [get]
+// @Security ApiKeyAuth
+func (h *Handler) Export(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.useCases.FilterFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.exportEvents(w, r, filter)
+}
+
+// @Tags Audit
+// @Description Export the audit events of a workspace as json or csv
+// @ID export-workspace-audit-events
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param format query string false "json or csv, json by default"
+// @Param repositoryID query string false "filter by repository"
+// @Param actorID query string false "filter by the account that performed the action"
+// @Param action query string false "filter by action, like workspace.role.update"
+// @Param targetType query string false "filter by target type, like token"
+// @Param from query string false "events since this date, like 2021-12-30T23:59:59Z"
+// @Param to query string false "events until this date, like 2021-12-30T23:59:59Z"
+// @Success 200 {file} file
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/audit/export [get]
+// @Security ApiKeyAuth
+func (h *Handler) ExportByWorkspace(w http.ResponseWriter, r *http.Request) {
+	filter, err := h.getWorkspaceFilter(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.exportEvents(w, r, filter)
+}
+
+func (h *Handler) exportEvents(w http.ResponseWriter, r *http.Request, filter *auditEntities.Filter) {
+	format, err := h.useCases.ExportFormatFromRequest(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	content, err := h.controller.ExportEvents(filter, format)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	h.writeExportedFile(w, format, content)
+}
+
+func (h *Handler) writeExportedFile(w http.ResponseWriter, format auditEnums.Format, content []byte) {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(auditEnums.ContentDisposition,
+		time.Now().Format("2006-01-02"), format.ToString()))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
+
+// @Tags Audit
+// @Description Check that no audit event was changed or removed by walking the whole hash chain
+// @ID verify-audit-events
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/audit/verify [get]
+// @Security ApiKeyAuth
+func (h *Handler) Verify(w http.ResponseWriter, _ *http.Request) {
+	result, err := h.controller.VerifyChain()
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, result)
+}
@@ -0,0 +1,208 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	auditController "github.com/ZupIT/horusec-platform/core/internal/controllers/audit"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+)
+
+func newRequest(url string, params map[string]string) *http.Request {
+	r, _ := http.NewRequest(http.MethodGet, url, nil)
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func TestNewAuditHandler(t *testing.T) {
+	t.Run("should success create a new audit handler", func(t *testing.T) {
+		assert.NotNil(t, NewAuditHandler(nil, nil))
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return 204 when options", func(t *testing.T) {
+		handler := NewAuditHandler(nil, nil)
+		w := httptest.NewRecorder()
+
+		handler.Options(w, newRequest("test", nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("ListEvents").Return(&auditEntities.ListResponse{}, nil)
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest("test?action=workspace.create&from=2021-01-01T00:00:00Z", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		handler := NewAuditHandler(&auditController.Mock{}, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest("test?from=yesterday", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when failed to list events", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("ListEvents").Return(&auditEntities.ListResponse{}, errors.New("test"))
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest("test", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestListByWorkspace(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("ListEvents").Return(&auditEntities.ListResponse{}, nil)
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.ListByWorkspace(w, newRequest("test", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewAuditHandler(&auditController.Mock{}, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.ListByWorkspace(w, newRequest("test", map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		handler := NewAuditHandler(&auditController.Mock{}, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.ListByWorkspace(w, newRequest("test?actorID=test",
+			map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestExport(t *testing.T) {
+	t.Run("should return 200 with csv file", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("ExportEvents").Return([]byte("test"), nil)
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.Export(w, newRequest("test?format=csv", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("should return 400 when invalid filter", func(t *testing.T) {
+		handler := NewAuditHandler(&auditController.Mock{}, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.Export(w, newRequest("test?to=tomorrow", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid format", func(t *testing.T) {
+		handler := NewAuditHandler(&auditController.Mock{}, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.Export(w, newRequest("test?format=pdf", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when failed to export events", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("ExportEvents").Return([]byte{}, errors.New("test"))
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.Export(w, newRequest("test", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestExportByWorkspace(t *testing.T) {
+	t.Run("should return 200 with json file", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("ExportEvents").Return([]byte("[]"), nil)
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.ExportByWorkspace(w, newRequest("test", map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewAuditHandler(&auditController.Mock{}, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.ExportByWorkspace(w, newRequest("test", nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("VerifyChain").Return(&auditEntities.Verification{Valid: true}, nil)
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.Verify(w, newRequest("test", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to verify chain", func(t *testing.T) {
+		controllerMock := &auditController.Mock{}
+		controllerMock.On("VerifyChain").Return(&auditEntities.Verification{}, errors.New("test"))
+
+		handler := NewAuditHandler(controllerMock, auditUseCases.NewAuditUseCases())
+		w := httptest.NewRecorder()
+
+		handler.Verify(w, newRequest("test", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
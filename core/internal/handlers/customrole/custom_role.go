@@ -0,0 +1,255 @@
+package customrole
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+
+	customRoleController "github.com/ZupIT/horusec-platform/core/internal/controllers/customrole"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+type Handler struct {
+	controller   customRoleController.IController
+	useCases     customRoleUseCases.IUseCases
+	auditService auditService.IService
+}
+
+func NewCustomRoleHandler(controller customRoleController.IController, useCases customRoleUseCases.IUseCases,
+	serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:   controller,
+		useCases:     useCases,
+		auditService: serviceAudit,
+	}
+}
+
+func (h *Handler) checkErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case databaseEnums.ErrorNotFoundRecords:
+		httpUtil.StatusNotFound(w, err)
+	case customRoleEnums.ErrorCustomRoleNameAlreadyInUse:
+		httpUtil.StatusBadRequest(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
+
+func (h *Handler) getCustomRoleIDs(r *http.Request) (customRoleID, workspaceID uuid.UUID, err error) {
+	customRoleID, err = uuid.Parse(chi.URLParam(r, customRoleEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	workspaceID, err = uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return customRoleID, workspaceID, nil
+}
+
+func (h *Handler) publishAuditEvent(r *http.Request, action auditEnums.Action, customRoleID, workspaceID uuid.UUID,
+	before, after *customRoleEntities.Response) {
+	h.auditService.Publish(r, auditEntities.NewEvent(action, auditEnums.TargetCustomRole, customRoleID).
+		SetScope(workspaceID, uuid.Nil).SetChanges(before, after))
+}
+
+// @Tags CustomRole
+// @Description Create a new role of a workspace with a custom set of permissions
+// @ID create-custom-role
+// @Accept  json
+// @Produce  json
+// @Param CustomRole body customRoleEntities.Data true "custom role data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/custom-roles [post]
+// @Security ApiKeyAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.CustomRoleDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	customRole, err := h.controller.Create(data.SetIDs(chi.URLParam(r, workspaceEnums.ID), ""))
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionCustomRoleCreate, customRole.CustomRoleID, customRole.WorkspaceID,
+		nil, customRole)
+	httpUtil.StatusCreated(w, customRole)
+}
+
+// @Tags CustomRole
+// @Description Get a custom role of a workspace
+// @ID get-custom-role
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param customRoleID path string true "ID of the custom role"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/custom-roles/{customRoleID} [get]
+// @Security ApiKeyAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	customRoleID, workspaceID, err := h.getCustomRoleIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	customRole, err := h.controller.Get(customRoleID, workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, customRole)
+}
+
+// @Tags CustomRole
+// @Description Update the name, description and permissions of a custom role
+// @ID update-custom-role
+// @Accept  json
+// @Produce  json
+// @Param CustomRole body customRoleEntities.Data true "custom role data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Param customRoleID path string true "ID of the custom role"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/custom-roles/{customRoleID} [patch]
+// @Security ApiKeyAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.CustomRoleDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	before, err := h.controller.Get(data.SetIDs(chi.URLParam(r, workspaceEnums.ID),
+		chi.URLParam(r, customRoleEnums.ID)).CustomRoleID, data.WorkspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.update(w, r, data, before)
+}
+
+func (h *Handler) update(w http.ResponseWriter, r *http.Request, data *customRoleEntities.Data,
+	before *customRoleEntities.Response) {
+	customRole, err := h.controller.Update(data)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionCustomRoleUpdate, data.CustomRoleID, data.WorkspaceID, before, customRole)
+	httpUtil.StatusOK(w, customRole)
+}
+
+// @Tags CustomRole
+// @Description Delete a custom role, the accounts with this role keep only the member role
+// @ID delete-custom-role
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param customRoleID path string true "ID of the custom role"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/custom-roles/{customRoleID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	customRoleID, workspaceID, err := h.getCustomRoleIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	before, err := h.controller.Get(customRoleID, workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.delete(w, r, before)
+}
+
+func (h *Handler) delete(w http.ResponseWriter, r *http.Request, before *customRoleEntities.Response) {
+	if err := h.controller.Delete(before.CustomRoleID, before.WorkspaceID); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionCustomRoleDelete, before.CustomRoleID, before.WorkspaceID, before, nil)
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags CustomRole
+// @Description List the custom roles of a workspace
+// @ID list-custom-roles
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/custom-roles [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	customRoles, err := h.controller.List(workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, customRoles)
+}
+
+// @Tags CustomRole
+// @Description List all the permissions that can be added to a custom role
+// @ID list-custom-role-permissions
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/custom-roles/permissions [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListPermissions(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusOK(w, permissionEnums.Values())
+}
@@ -0,0 +1,312 @@
+package customrole
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	customRoleController "github.com/ZupIT/horusec-platform/core/internal/controllers/customrole"
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func newRequest(method string, body interface{}, params map[string]string) *http.Request {
+	bytesBody, _ := json.Marshal(body)
+	r, _ := http.NewRequest(method, "test", bytes.NewReader(bytesBody))
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newCustomRoleParams() map[string]string {
+	return map[string]string{"workspaceID": uuid.NewString(), "customRoleID": uuid.NewString()}
+}
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func newTestHandler(controllerMock *customRoleController.Mock, auditServiceMock *auditService.Mock) *Handler {
+	return NewCustomRoleHandler(controllerMock, customRoleUseCases.NewCustomRoleUseCases(), auditServiceMock)
+}
+
+func newCustomRoleData() *customRoleEntities.Data {
+	return &customRoleEntities.Data{Name: "Triager", Permissions: []permissionEnums.Permission{
+		permissionEnums.VulnerabilityRead, permissionEnums.VulnerabilityTypeUpdate}}
+}
+
+func TestNewCustomRoleHandler(t *testing.T) {
+	t.Run("should success create a new custom role handler", func(t *testing.T) {
+		assert.NotNil(t, NewCustomRoleHandler(nil, nil, nil))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString()}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Create").Return(&customRoleEntities.Response{}, nil)
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Create(w, newRequest(http.MethodPost, newCustomRoleData(),
+			params))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return 400 when name already in use", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Create").Return(&customRoleEntities.Response{},
+			customRoleEnums.ErrorCustomRoleNameAlreadyInUse)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Create(w, newRequest(http.MethodPost,
+			newCustomRoleData(), params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid permission", func(t *testing.T) {
+		data := &customRoleEntities.Data{Name: "test", Permissions: []permissionEnums.Permission{"test"}}
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).Create(w,
+			newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Create").Return(&customRoleEntities.Response{}, errors.New("test"))
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Create(w, newRequest(http.MethodPost, newCustomRoleData(),
+			params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Get(w, newRequest(http.MethodGet, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when custom role not found", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Get(w, newRequest(http.MethodGet, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid custom role id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).Get(w, newRequest(http.MethodGet, nil,
+			map[string]string{"workspaceID": uuid.NewString(), "customRoleID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).Get(w, newRequest(http.MethodGet, nil,
+			map[string]string{"workspaceID": "test", "customRoleID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, nil)
+		controllerMock.On("Update").Return(&customRoleEntities.Response{}, nil)
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Update(w, newRequest(http.MethodPatch, newCustomRoleData(),
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return 404 when custom role not found", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Update(w, newRequest(http.MethodPatch,
+			newCustomRoleData(), newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when failed to update", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, nil)
+		controllerMock.On("Update").Return(&customRoleEntities.Response{}, errors.New("test"))
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Update(w, newRequest(http.MethodPatch, newCustomRoleData(),
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should return 400 when invalid data", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).Update(w, newRequest(http.MethodPatch,
+			&customRoleEntities.Data{}, newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, nil)
+		controllerMock.On("Delete").Return(nil)
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Delete(w, newRequest(http.MethodDelete, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return 404 when custom role not found", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Delete(w, newRequest(http.MethodDelete, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when failed to delete", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("Get").Return(&customRoleEntities.Response{}, nil)
+		controllerMock.On("Delete").Return(errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Delete(w, newRequest(http.MethodDelete, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).Delete(w, newRequest(http.MethodDelete,
+			nil, map[string]string{"workspaceID": uuid.NewString(), "customRoleID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("List").Return(&[]customRoleEntities.Response{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).List(w, newRequest(http.MethodGet, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &customRoleController.Mock{}
+		controllerMock.On("List").Return(&[]customRoleEntities.Response{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).List(w, newRequest(http.MethodGet, nil,
+			newCustomRoleParams()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).List(w, newRequest(http.MethodGet, nil,
+			map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestListPermissions(t *testing.T) {
+	t.Run("should return 200 with all the permissions", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&customRoleController.Mock{}, newAuditServiceMock()).ListPermissions(w,
+			newRequest(http.MethodGet, nil, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), permissionEnums.VulnerabilitySeverityUpdate.ToString())
+	})
+}
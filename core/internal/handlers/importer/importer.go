@@ -0,0 +1,214 @@
+package importer
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	importerController "github.com/ZupIT/horusec-platform/core/internal/controllers/importer"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+)
+
+type Handler struct {
+	controller   importerController.IController
+	useCases     importerUseCases.IUseCases
+	authGRPC     proto.AuthServiceClient
+	auditService auditService.IService
+	context      context.Context
+}
+
+func NewImporterHandler(controller importerController.IController, useCases importerUseCases.IUseCases,
+	authGRPC proto.AuthServiceClient, serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:   controller,
+		useCases:     useCases,
+		authGRPC:     authGRPC,
+		auditService: serviceAudit,
+		context:      context.Background(),
+	}
+}
+
+// publishAuditEvent keeps the changes empty, the import data holds the access token and the result the tokens
+func (h *Handler) publishAuditEvent(r *http.Request, action auditEnums.Action, importID, workspaceID uuid.UUID) {
+	h.auditService.Publish(r, auditEntities.NewEvent(action, auditEnums.TargetImport, importID).
+		SetScope(workspaceID, uuid.Nil))
+}
+
+func (h *Handler) checkErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case databaseEnums.ErrorNotFoundRecords:
+		httpUtil.StatusNotFound(w, err)
+	case importerEnums.ErrorImportAlreadyExists:
+		httpUtil.StatusConflict(w, err)
+	case importerEnums.ErrorProviderRequestFailed, importerEnums.ErrorTooManyRemotePages,
+		importerEnums.ErrorBaseURLNotAllowed:
+		httpUtil.StatusUnprocessableEntity(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
+
+func (h *Handler) getImportIDs(r *http.Request) (importID, workspaceID uuid.UUID, err error) {
+	importID, err = uuid.Parse(chi.URLParam(r, importerEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	workspaceID, err = uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return importID, workspaceID, nil
+}
+
+// @Tags Import
+// @Description Import the repositories of a github organization, gitlab group or bitbucket workspace
+// @ID create-import
+// @Accept  json
+// @Produce  json
+// @Param Import body importerEntities.Data true "import data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 422 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/imports [post]
+// @Security ApiKeyAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getCreateData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.Import(data)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionImportCreate, result.ImportID, data.WorkspaceID)
+	httpUtil.StatusCreated(w, result)
+}
+
+func (h *Handler) getCreateData(r *http.Request) (*importerEntities.Data, error) {
+	accountData, err := h.authGRPC.GetAccountInfo(h.context,
+		&proto.GetAccountData{Token: r.Header.Get(enums.HorusecJWTHeader)})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.useCases.ImportDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetIDs(chi.URLParam(r, workspaceEnums.ID), accountData.AccountID), nil
+}
+
+// @Tags Import
+// @Description Create the new repositories of the organization and archive the ones removed from it
+// @ID sync-import
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param importID path string true "ID of the import"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 422 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/imports/{importID}/sync [post]
+// @Security ApiKeyAuth
+func (h *Handler) Sync(w http.ResponseWriter, r *http.Request) {
+	importID, workspaceID, err := h.getImportIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	result, err := h.controller.Sync(importID, workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionImportSync, importID, workspaceID)
+	httpUtil.StatusOK(w, result)
+}
+
+// @Tags Import
+// @Description List the imports of a workspace
+// @ID list-imports
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/imports [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	imports, err := h.controller.List(workspaceID)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, imports)
+}
+
+// @Tags Import
+// @Description Delete an import, the repositories created by it are kept
+// @ID delete-import
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param importID path string true "ID of the import"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/imports/{importID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	importID, workspaceID, err := h.getImportIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.Delete(importID, workspaceID); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionImportDelete, importID, workspaceID)
+	httpUtil.StatusNoContent(w)
+}
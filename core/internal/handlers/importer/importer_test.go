@@ -0,0 +1,252 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	importerController "github.com/ZupIT/horusec-platform/core/internal/controllers/importer"
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+)
+
+func newRequest(method string, body interface{}, params map[string]string) *http.Request {
+	bytesBody, _ := json.Marshal(body)
+	r, _ := http.NewRequest(method, "test", bytes.NewReader(bytesBody))
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAuthGRPCMock() *proto.Mock {
+	authGRPCMock := &proto.Mock{}
+	authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+	return authGRPCMock
+}
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func TestNewImporterHandler(t *testing.T) {
+	t.Run("should success create a new importer handler", func(t *testing.T) {
+		assert.NotNil(t, NewImporterHandler(nil, nil, nil, nil))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	data := &importerEntities.Data{Provider: importerEnums.ProviderGithub, Organization: "horusec",
+		AccessToken: "token"}
+	params := map[string]string{"workspaceID": uuid.NewString()}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Import").Return(&importerEntities.Result{}, nil)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), newAuthGRPCMock(),
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 409 when the organization is already imported", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Import").Return(&importerEntities.Result{}, importerEnums.ErrorImportAlreadyExists)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), newAuthGRPCMock(),
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 422 when the provider rejects the request", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Import").Return(&importerEntities.Result{}, importerEnums.ErrorProviderRequestFailed)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), newAuthGRPCMock(),
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("should return 400 when invalid request body", func(t *testing.T) {
+		handler := NewImporterHandler(&importerController.Mock{}, importerUseCases.NewImporterUseCases(),
+			newAuthGRPCMock(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, &importerEntities.Data{}, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		handler := NewImporterHandler(&importerController.Mock{}, importerUseCases.NewImporterUseCases(),
+			authGRPCMock, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestSync(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString(), "importID": uuid.NewString()}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Sync").Return(&importerEntities.Result{}, nil)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Sync(w, newRequest(http.MethodPost, nil, params))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when the import does not exist", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Sync").Return(&importerEntities.Result{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Sync(w, newRequest(http.MethodPost, nil, params))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid import id", func(t *testing.T) {
+		handler := NewImporterHandler(&importerController.Mock{}, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Sync(w, newRequest(http.MethodPost, nil, map[string]string{"importID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewImporterHandler(&importerController.Mock{}, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Sync(w, newRequest(http.MethodPost, nil, map[string]string{"importID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("List").Return(&[]importerEntities.Response{}, nil)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when failed to list", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("List").Return(&[]importerEntities.Response{}, errors.New("test"))
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewImporterHandler(&importerController.Mock{}, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, map[string]string{}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString(), "importID": uuid.NewString()}
+
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Delete").Return(nil)
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Delete(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &importerController.Mock{}
+		controllerMock.On("Delete").Return(errors.New("test"))
+
+		handler := NewImporterHandler(controllerMock, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Delete(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid import id", func(t *testing.T) {
+		handler := NewImporterHandler(&importerController.Mock{}, importerUseCases.NewImporterUseCases(), nil,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Delete(w, newRequest(http.MethodDelete, nil, map[string]string{}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,322 @@
+package invitation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	invitationController "github.com/ZupIT/horusec-platform/core/internal/controllers/invitation"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+)
+
+type Handler struct {
+	controller   invitationController.IController
+	useCases     invitationUseCases.IUseCases
+	authGRPC     proto.AuthServiceClient
+	auditService auditService.IService
+	context      context.Context
+}
+
+func NewInvitationHandler(controller invitationController.IController, useCases invitationUseCases.IUseCases,
+	authGRPC proto.AuthServiceClient, serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:   controller,
+		useCases:     useCases,
+		authGRPC:     authGRPC,
+		auditService: serviceAudit,
+		context:      context.Background(),
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) getAccountData(r *http.Request) (*proto.GetAccountDataResponse, error) {
+	return h.authGRPC.GetAccountInfo(h.context, &proto.GetAccountData{Token: r.Header.Get(enums.HorusecJWTHeader)})
+}
+
+// @Tags Invitation
+// @Description Invite an email that may not have an account yet to a workspace or repository
+// @ID create-invitation
+// @Accept  json
+// @Produce  json
+// @Param Invitation body invitationEntities.Data true "invitation data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string false "ID of the repository"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/invitations [post]
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/invitations [post]
+// @Security ApiKeyAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getCreateData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	invitation, err := h.controller.Create(data)
+	if err != nil {
+		h.checkCreateErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionInvitationCreate, invitation)
+	httpUtil.StatusCreated(w, invitation)
+}
+
+func (h *Handler) publishAuditEvent(r *http.Request, action auditEnums.Action,
+	invitation *invitationEntities.Response) {
+	h.auditService.Publish(r, auditEntities.NewEvent(action, auditEnums.TargetInvitation, invitation.InvitationID).
+		SetScope(invitation.WorkspaceID, invitation.GetRepositoryID()).SetChanges(nil, invitation))
+}
+
+func (h *Handler) getCreateData(r *http.Request) (*invitationEntities.Data, error) {
+	accountData, err := h.getAccountData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.useCases.InvitationDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetIDs(chi.URLParam(r, workspaceEnums.ID), chi.URLParam(r, repositoryEnums.ID),
+		accountData.AccountID), nil
+}
+
+func (h *Handler) checkCreateErrors(w http.ResponseWriter, err error) {
+	if err == invitationEnums.ErrorInvitationAlreadyPending {
+		httpUtil.StatusConflict(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Invitation
+// @Description List the invitations of a workspace or repository
+// @ID list-invitations
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string false "ID of the repository"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/invitations [get]
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/invitations [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	invitations, err := h.controller.List(workspaceID, parser.ParseStringToUUID(chi.URLParam(r, repositoryEnums.ID)))
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, invitations)
+}
+
+// @Tags Invitation
+// @Description Renew the expiration date of a pending invitation and send the email again
+// @ID resend-invitation
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string false "ID of the repository"
+// @Param invitationID path string true "ID of the invitation"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/invitations/{invitationID}/resend [post]
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/invitations/{invitationID}/resend [post]
+// @Security ApiKeyAuth
+func (h *Handler) Resend(w http.ResponseWriter, r *http.Request) {
+	invitationID, workspaceID, repositoryID, err := h.getInvitationIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	invitation, err := h.controller.Resend(invitationID, workspaceID, repositoryID)
+	if err != nil {
+		h.checkManageErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionInvitationResend, invitation)
+	httpUtil.StatusOK(w, invitation)
+}
+
+// @Tags Invitation
+// @Description Revoke a pending invitation
+// @ID revoke-invitation
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string false "ID of the repository"
+// @Param invitationID path string true "ID of the invitation"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/invitations/{invitationID} [delete]
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/invitations/{invitationID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) Revoke(w http.ResponseWriter, r *http.Request) {
+	invitationID, workspaceID, repositoryID, err := h.getInvitationIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.Revoke(invitationID, workspaceID, repositoryID); err != nil {
+		h.checkManageErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionInvitationRevoke, auditEnums.TargetInvitation,
+		invitationID).SetScope(workspaceID, repositoryID))
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) getInvitationIDs(r *http.Request) (invitationID, workspaceID, repositoryID uuid.UUID, err error) {
+	invitationID, err = uuid.Parse(chi.URLParam(r, invitationEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	workspaceID, err = uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return invitationID, workspaceID, parser.ParseStringToUUID(chi.URLParam(r, repositoryEnums.ID)), nil
+}
+
+func (h *Handler) checkManageErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	if err == invitationEnums.ErrorInvitationNotPending {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Invitation
+// @Description Accept an invitation with the token sent by email, binding it to the logged account
+// @ID accept-invitation
+// @Accept  json
+// @Produce  json
+// @Param Token body invitationEntities.TokenData true "invitation token"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/invitations/accept [post]
+// @Security ApiKeyAuth
+func (h *Handler) Accept(w http.ResponseWriter, r *http.Request) {
+	accountData, err := h.getAccountData(r)
+	if err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	data, err := h.useCases.TokenDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	h.accept(w, r, data.SetAccountData(accountData.AccountID, accountData.Email))
+}
+
+func (h *Handler) accept(w http.ResponseWriter, r *http.Request, data *invitationEntities.TokenData) {
+	invitation, err := h.controller.Accept(data)
+	if err != nil {
+		h.checkAnswerErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionInvitationAccept, invitation)
+	httpUtil.StatusOK(w, invitation)
+}
+
+// @Tags Invitation
+// @Description Decline an invitation with the token sent by email
+// @ID decline-invitation
+// @Accept  json
+// @Produce  json
+// @Param Token body invitationEntities.TokenData true "invitation token"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/invitations/decline [post]
+func (h *Handler) Decline(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.TokenDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	invitation, err := h.controller.Decline(data)
+	if err != nil {
+		h.checkAnswerErrors(w, err)
+		return
+	}
+
+	h.publishAuditEvent(r, auditEnums.ActionInvitationDecline, invitation)
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) checkAnswerErrors(w http.ResponseWriter, err error) {
+	if err == invitationEnums.ErrorInvitationEmailMismatch {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	if err == invitationEnums.ErrorInvalidToken || err == invitationEnums.ErrorInvitationExpired ||
+		err == invitationEnums.ErrorInvitationNotPending {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
@@ -0,0 +1,404 @@
+package invitation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	invitationController "github.com/ZupIT/horusec-platform/core/internal/controllers/invitation"
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+)
+
+func newRequest(method string, body interface{}, params map[string]string) *http.Request {
+	bytesBody, _ := json.Marshal(body)
+	r, _ := http.NewRequest(method, "test", bytes.NewReader(bytesBody))
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func TestNewInvitationHandler(t *testing.T) {
+	t.Run("should success create a new invitation handler", func(t *testing.T) {
+		assert.NotNil(t, NewInvitationHandler(nil, nil, nil, nil))
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return 204 when options", func(t *testing.T) {
+		handler := NewInvitationHandler(nil, nil, nil, nil)
+		w := httptest.NewRecorder()
+
+		handler.Options(w, nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	data := &invitationEntities.Data{Email: "test@test.com", Role: account.Member}
+	params := map[string]string{"workspaceID": uuid.NewString()}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Create").Return(&invitationEntities.Response{}, nil)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), authGRPCMock,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 409 when there is already a pending invitation", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Create").Return(&invitationEntities.Response{},
+			invitationEnums.ErrorInvitationAlreadyPending)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), authGRPCMock,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Create").Return(&invitationEntities.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), authGRPCMock,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			authGRPCMock, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, &invitationEntities.Data{}, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			authGRPCMock, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("List").Return(&[]invitationEntities.Response{}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("List").Return(&[]invitationEntities.Response{}, errors.New("test"))
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, map[string]string{"workspaceID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			&proto.Mock{}, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestResend(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString(), "invitationID": uuid.NewString()}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Resend").Return(&invitationEntities.Response{}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Resend(w, newRequest(http.MethodPost, nil, params))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when invitation not found", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Resend").Return(&invitationEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Resend(w, newRequest(http.MethodPost, nil, params))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invitation is not pending", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Resend").Return(&invitationEntities.Response{}, invitationEnums.ErrorInvitationNotPending)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Resend(w, newRequest(http.MethodPost, nil, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid invitation id", func(t *testing.T) {
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			&proto.Mock{}, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Resend(w, newRequest(http.MethodPost, nil, map[string]string{"invitationID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString(), "invitationID": uuid.NewString()}
+
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Revoke").Return(nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Revoke(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Revoke").Return(errors.New("test"))
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Revoke(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			&proto.Mock{}, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Revoke(w, newRequest(http.MethodDelete, nil, map[string]string{"invitationID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAccept(t *testing.T) {
+	data := &invitationEntities.TokenData{Token: "test"}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Accept").Return(&invitationEntities.Response{}, nil)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), authGRPCMock,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Accept(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invitation expired", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Accept").Return(&invitationEntities.Response{}, invitationEnums.ErrorInvitationExpired)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), authGRPCMock,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Accept(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when the invitation was sent to another email", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Accept").Return(&invitationEntities.Response{},
+			invitationEnums.ErrorInvitationEmailMismatch)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), authGRPCMock,
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Accept(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{AccountID: uuid.NewString()}, nil)
+
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			authGRPCMock, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Accept(w, newRequest(http.MethodPost, &invitationEntities.TokenData{}, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 401 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(&proto.GetAccountDataResponse{}, errors.New("test"))
+
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			authGRPCMock, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Accept(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestDecline(t *testing.T) {
+	data := &invitationEntities.TokenData{Token: "test"}
+
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Decline").Return(&invitationEntities.Response{}, nil)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Decline(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when invalid token", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Decline").Return(&invitationEntities.Response{}, invitationEnums.ErrorInvalidToken)
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Decline(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &invitationController.Mock{}
+		controllerMock.On("Decline").Return(&invitationEntities.Response{}, errors.New("test"))
+
+		handler := NewInvitationHandler(controllerMock, invitationUseCases.NewInvitationUseCases(), &proto.Mock{},
+			newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Decline(w, newRequest(http.MethodPost, data, nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid body", func(t *testing.T) {
+		handler := NewInvitationHandler(&invitationController.Mock{}, invitationUseCases.NewInvitationUseCases(),
+			&proto.Mock{}, newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Decline(w, newRequest(http.MethodPost, &invitationEntities.TokenData{}, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,140 @@
+package quota
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+
+	quotaController "github.com/ZupIT/horusec-platform/core/internal/controllers/quota"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
+)
+
+type Handler struct {
+	controller   quotaController.IController
+	useCases     quotaUseCases.IUseCases
+	auditService auditService.IService
+}
+
+func NewQuotaHandler(controller quotaController.IController, useCases quotaUseCases.IUseCases,
+	serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:   controller,
+		useCases:     useCases,
+		auditService: serviceAudit,
+	}
+}
+
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) checkErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Quota
+// @Description List the limits and the consumption of all workspaces, only application admins have access
+// @ID list-quotas
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/quotas [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, _ *http.Request) {
+	quotas, err := h.controller.List()
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, quotas)
+}
+
+// @Tags Quota
+// @Description Get the limits and the consumption of a workspace, only application admins have access
+// @ID get-quota
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/quotas/{workspaceID} [get]
+// @Security ApiKeyAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	quota, err := h.controller.Get(workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, quota)
+}
+
+// @Tags Quota
+// @Description Replace the limits of a workspace, a null limit removes it, only application admins have access
+// @ID update-quota
+// @Accept  json
+// @Produce  json
+// @Param Quota body quotaEntities.Data true "workspace limits"
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/quotas/{workspaceID} [put]
+// @Security ApiKeyAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.QuotaDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	before, err := h.controller.Get(data.SetWorkspaceID(chi.URLParam(r, workspaceEnums.ID)).WorkspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.update(w, r, data, before)
+}
+
+func (h *Handler) update(w http.ResponseWriter, r *http.Request, data *quotaEntities.Data,
+	before *quotaEntities.Response) {
+	quota, err := h.controller.Update(data)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceQuotaUpdate, auditEnums.TargetQuota,
+		data.WorkspaceID).SetScope(data.WorkspaceID, uuid.Nil).SetChanges(before, quota))
+	httpUtil.StatusOK(w, quota)
+}
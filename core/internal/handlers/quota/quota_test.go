@@ -0,0 +1,186 @@
+package quota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	quotaController "github.com/ZupIT/horusec-platform/core/internal/controllers/quota"
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
+)
+
+func newRequest(method string, body interface{}, params map[string]string) *http.Request {
+	bytesBody, _ := json.Marshal(body)
+	r, _ := http.NewRequest(method, "test", bytes.NewReader(bytesBody))
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func newTestHandler(controllerMock *quotaController.Mock, auditServiceMock *auditService.Mock) *Handler {
+	return NewQuotaHandler(controllerMock, quotaUseCases.NewQuotaUseCases(), auditServiceMock)
+}
+
+func TestNewQuotaHandler(t *testing.T) {
+	t.Run("should success create a new quota handler", func(t *testing.T) {
+		assert.NotNil(t, NewQuotaHandler(nil, nil, nil))
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Run("should return 204 when options", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(nil, nil).Options(w, newRequest(http.MethodOptions, nil, nil))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("List").Return(&[]quotaEntities.Response{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, nil).List(w, newRequest(http.MethodGet, nil, nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("List").Return(&[]quotaEntities.Response{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, nil).List(w, newRequest(http.MethodGet, nil, nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGet(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString()}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("Get").Return(&quotaEntities.Response{}, nil)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, nil).Get(w, newRequest(http.MethodGet, nil, params))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+
+		newTestHandler(&quotaController.Mock{}, nil).Get(w, newRequest(http.MethodGet, nil,
+			map[string]string{"workspaceID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when workspace not found", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("Get").Return(&quotaEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, nil).Get(w, newRequest(http.MethodGet, nil, params))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("Get").Return(&quotaEntities.Response{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, nil).Get(w, newRequest(http.MethodGet, nil, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString()}
+	limit := 10
+	data := &quotaEntities.Data{MaxRepositories: &limit}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("Get").Return(&quotaEntities.Response{}, nil)
+		controllerMock.On("Update").Return(&quotaEntities.Response{}, nil)
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Update(w, newRequest(http.MethodPut, data, params))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
+	})
+
+	t.Run("should return 400 when negative limit", func(t *testing.T) {
+		negative := -1
+		w := httptest.NewRecorder()
+
+		newTestHandler(&quotaController.Mock{}, newAuditServiceMock()).Update(w, newRequest(http.MethodPut,
+			&quotaEntities.Data{MaxTokens: &negative}, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when workspace not found", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("Get").Return(&quotaEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, newAuditServiceMock()).Update(w, newRequest(http.MethodPut, data, params))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &quotaController.Mock{}
+		controllerMock.On("Get").Return(&quotaEntities.Response{}, nil)
+		controllerMock.On("Update").Return(&quotaEntities.Response{}, errors.New("test"))
+
+		auditServiceMock := newAuditServiceMock()
+		w := httptest.NewRecorder()
+
+		newTestHandler(controllerMock, auditServiceMock).Update(w, newRequest(http.MethodPut, data, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
+	})
+}
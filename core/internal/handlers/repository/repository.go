@@ -7,7 +7,9 @@ import (
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 
+	authEnums "github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
@@ -15,13 +17,19 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	repositoryController "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
 	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
 	roleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/role"
 	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
 	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	roleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/role"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
@@ -35,11 +43,12 @@ type Handler struct {
 	context       context.Context
 	roleUseCases  roleUseCases.IUseCases
 	tokenUseCases tokenUseCases.IUseCases
+	auditService  auditService.IService
 }
 
 func NewRepositoryHandler(useCases repositoryUseCases.IUseCases, controller repositoryController.IController,
 	appConfig app.IConfig, authGRPC proto.AuthServiceClient, useCasesRole roleUseCases.IUseCases,
-	useCasesToken tokenUseCases.IUseCases) *Handler {
+	useCasesToken tokenUseCases.IUseCases, serviceAudit auditService.IService) *Handler {
 	return &Handler{
 		useCases:      useCases,
 		controller:    controller,
@@ -48,6 +57,7 @@ func NewRepositoryHandler(useCases repositoryUseCases.IUseCases, controller repo
 		context:       context.Background(),
 		roleUseCases:  useCasesRole,
 		tokenUseCases: useCasesToken,
+		auditService:  serviceAudit,
 	}
 }
 
@@ -69,6 +79,7 @@ func (h *Handler) getAccountData(r *http.Request) (*proto.GetAccountDataResponse
 // @Success 201 {object} entities.Response
 // @Failure 400 {object} entities.Response
 // @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
 // @Failure 500 {object} entities.Response
 // @Router /core/workspaces/{workspaceID}/repositories [post]
 // @Security ApiKeyAuth
@@ -79,13 +90,15 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	workspace, err := h.controller.Create(workspaceData)
+	repository, err := h.controller.Create(workspaceData)
 	if err != nil {
 		h.checkCreateRepositoryErrors(w, err)
 		return
 	}
 
-	httpUtil.StatusCreated(w, workspace)
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryCreate, auditEnums.TargetRepository,
+		repository.RepositoryID).SetScope(repository.WorkspaceID, repository.RepositoryID).SetChanges(nil, repository))
+	httpUtil.StatusCreated(w, repository)
 }
 
 func (h *Handler) getCreateData(r *http.Request) (*repositoryEntities.Data, error) {
@@ -104,11 +117,16 @@ func (h *Handler) getCreateData(r *http.Request) (*repositoryEntities.Data, erro
 }
 
 func (h *Handler) checkCreateRepositoryErrors(w http.ResponseWriter, err error) {
-	if err == repositoryEnums.ErrorRepositoryNameAlreadyInUse {
+	if err == repositoryEnums.ErrorRepositoryNameAlreadyInUse || err == repositoryEnums.ErrorOwnerTeamNotInWorkspace {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
 
+	if err == quotaEnums.ErrorRepositoriesLimitReached {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
 	httpUtil.StatusInternalServerError(w, err)
 }
 
@@ -204,7 +222,7 @@ func (h *Handler) getUpdateData(r *http.Request) (*repositoryEntities.Data, erro
 }
 
 func (h *Handler) checkUpdateRepositoryErrors(w http.ResponseWriter, err error) {
-	if err == repositoryEnums.ErrorRepositoryNameAlreadyInUse {
+	if err == repositoryEnums.ErrorRepositoryNameAlreadyInUse || err == repositoryEnums.ErrorOwnerTeamNotInWorkspace {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
@@ -213,7 +231,7 @@ func (h *Handler) checkUpdateRepositoryErrors(w http.ResponseWriter, err error)
 }
 
 // @Tags Repository
-// @Description Delete a repository by id
+// @Description Archive a repository by id, it can be restored until the retention period expires
 // @ID delete-repository
 // @Accept  json
 // @Produce  json
@@ -233,20 +251,91 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err = h.controller.Delete(repositoryID); err != nil {
+	if err = h.controller.Archive(repositoryID); err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryDelete, auditEnums.TargetRepository,
+		repositoryID).SetScope(parser.ParseStringToUUID(chi.URLParam(r, workspaceEnums.ID)), repositoryID))
 	httpUtil.StatusNoContent(w)
 }
 
+// @Tags Repository
+// @Description Restore an archived repository by id while it is inside the retention period
+// @ID restore-repository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/restore [post]
+// @Security ApiKeyAuth
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	repositoryID, err := uuid.Parse(chi.URLParam(r, repositoryEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	repository, err := h.controller.Restore(repositoryID)
+	if err != nil {
+		h.checkRestoreErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryRestore, auditEnums.TargetRepository,
+		repositoryID).SetScope(repository.WorkspaceID, repositoryID).SetChanges(nil, repository))
+	httpUtil.StatusOK(w, repository)
+}
+
+func (h *Handler) checkRestoreErrors(w http.ResponseWriter, err error) {
+	if err == archiveEnums.ErrorNotArchived || err == archiveEnums.ErrorRetentionExpired {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Repository
+// @Description List the archived repositories of a workspace
+// @ID list-archived-repository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/archived [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListArchived(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	repositories, err := h.controller.ListArchived(workspaceID)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, repositories)
+}
+
 // @Tags Repository
 // @Description List all repositories of an account in a workspace
 // @ID list-repositories
 // @Accept  json
 // @Produce  json
 // @Param workspaceID path string true "ID of the workspace"
+// @Param label query []string false "labels in the key=value format, only repositories with all of them are listed"
 // @Success 200 {object} entities.Response
 // @Failure 400 {object} entities.Response
 // @Failure 401 {object} entities.Response
@@ -275,12 +364,15 @@ func (h *Handler) getListData(r *http.Request) (*repositoryEntities.Data, error)
 		return nil, err
 	}
 
-	return h.useCases.NewRepositoryData(uuid.Nil, parser.ParseStringToUUID(
-		chi.URLParam(r, workspaceEnums.ID)), accountData), nil
+	data := h.useCases.NewRepositoryData(uuid.Nil, parser.ParseStringToUUID(
+		chi.URLParam(r, workspaceEnums.ID)), accountData)
+
+	data.Labels = r.URL.Query()[repositoryEnums.LabelQuery]
+	return data, data.ValidateLabels()
 }
 
 // @Tags Repository
-// @Description Update an account role of a repository
+// @Description Update an account role of a repository, a custom role replaces the built-in role
 // @ID update-repository-role
 // @Accept  json
 // @Produce  json
@@ -302,12 +394,25 @@ func (h *Handler) UpdateRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	before, err := h.controller.GetRole(data)
+	if err != nil {
+		h.checkUpdateRoleErrors(w, err)
+		return
+	}
+
+	h.updateRole(w, r, data, before)
+}
+
+func (h *Handler) updateRole(w http.ResponseWriter, r *http.Request, data *roleEntities.Data,
+	before *roleEntities.Response) {
 	role, err := h.controller.UpdateRole(data)
 	if err != nil {
 		h.checkUpdateRoleErrors(w, err)
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryRoleUpdate, auditEnums.TargetRole,
+		data.AccountID).SetScope(data.WorkspaceID, data.RepositoryID).SetChanges(before, role))
 	httpUtil.StatusOK(w, role)
 }
 
@@ -326,7 +431,7 @@ func (h *Handler) getUpdateRoleData(r *http.Request) (*roleEntities.Data, error)
 }
 
 func (h *Handler) checkUpdateRoleErrors(w http.ResponseWriter, err error) {
-	if err == repositoryEnums.ErrorUserDoesNotBelongToWorkspace {
+	if err == repositoryEnums.ErrorUserDoesNotBelongToWorkspace || err == customRoleEnums.ErrorCustomRoleNotInWorkspace {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
@@ -362,6 +467,8 @@ func (h *Handler) InviteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryMemberAdd, auditEnums.TargetRole,
+		data.AccountID).SetScope(data.WorkspaceID, data.RepositoryID).SetChanges(nil, role))
 	httpUtil.StatusOK(w, role)
 }
 
@@ -440,6 +547,8 @@ func (h *Handler) RemoveUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryMemberRemove, auditEnums.TargetRole,
+		data.AccountID).SetScope(data.WorkspaceID, data.RepositoryID))
 	httpUtil.StatusNoContent(w)
 }
 
@@ -464,6 +573,7 @@ func (h *Handler) getRemoveUserData(r *http.Request) (*roleEntities.Data, error)
 // @Success 201 {object} entities.Response
 // @Failure 400 {object} entities.Response
 // @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
 // @Failure 500 {object} entities.Response
 // @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/tokens [post]
 // @Security ApiKeyAuth
@@ -476,13 +586,24 @@ func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
 
 	token, err := h.controller.CreateToken(data)
 	if err != nil {
-		httpUtil.StatusInternalServerError(w, err)
+		h.checkCreateTokenErrors(w, err)
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryTokenCreate, auditEnums.TargetToken,
+		data.TokenID).SetScope(data.WorkspaceID, data.RepositoryID).SetChanges(nil, data))
 	httpUtil.StatusCreated(w, token)
 }
 
+func (h *Handler) checkCreateTokenErrors(w http.ResponseWriter, err error) {
+	if err == quotaEnums.ErrorTokensLimitReached {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
 func (h *Handler) getCreateTokenData(r *http.Request) (*tokenEntities.Data, error) {
 	data, err := h.tokenUseCases.TokenDataFromIOReadCloser(r.Body)
 	if err != nil {
@@ -518,6 +639,8 @@ func (h *Handler) DeleteToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryTokenDelete, auditEnums.TargetToken,
+		data.TokenID).SetScope(data.WorkspaceID, data.RepositoryID))
 	httpUtil.StatusNoContent(w)
 }
 
@@ -531,6 +654,69 @@ func (h *Handler) getDeleteTokenData(r *http.Request) (*tokenEntities.Data, erro
 		chi.URLParam(r, repositoryEnums.ID)), nil
 }
 
+// @Tags Repository
+// @Description Rotate a repository token, the previous token keeps working until the end of the grace period
+// @ID rotate-repository-token
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Param tokenID path string true "ID of the token"
+// @Param Token body tokenEntities.RotateData true "rotate repository token data"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/tokens/{tokenID}/rotate [post]
+// @Security ApiKeyAuth
+func (h *Handler) RotateToken(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getRotateTokenData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	token, err := h.controller.RotateToken(data)
+	if err != nil {
+		h.checkRotateTokenErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryTokenRotate, auditEnums.TargetToken,
+		data.TokenID).SetScope(data.WorkspaceID, data.RepositoryID).SetChanges(nil, data))
+	httpUtil.StatusCreated(w, token)
+}
+
+func (h *Handler) getRotateTokenData(r *http.Request) (*tokenEntities.RotateData, error) {
+	tokenData, err := h.getDeleteTokenData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.tokenUseCases.RotateDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetIDs(tokenData.WorkspaceID, tokenData.RepositoryID, tokenData.TokenID), nil
+}
+
+func (h *Handler) checkRotateTokenErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	if err == tokenEnums.ErrorTokenAlreadyRotated {
+		httpUtil.StatusConflict(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
 // @Tags Repository
 // @Description List all repository tokens
 // @ID list-repository-tokens
@@ -575,3 +761,110 @@ func (h *Handler) getListTokensData(r *http.Request) (*tokenEntities.Data, error
 
 	return data.SetIDs(workspaceID, repositoryID, uuid.Nil), nil
 }
+
+// @Tags Repository
+// @Description Transfer a repository with its analyses, tokens and roles to another workspace, admin rights on both
+// @Description workspaces are needed and a new name can be informed when the current one is in use on the target
+// @ID transfer-repository
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Param Transfer body repositoryEntities.TransferData true "transfer repository data"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/transfer [post]
+// @Security ApiKeyAuth
+func (h *Handler) Transfer(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getTransferData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.checkTargetWorkspaceAdmin(r, data.TargetWorkspaceID); err != nil {
+		httpUtil.StatusUnauthorized(w, err)
+		return
+	}
+
+	h.transfer(w, r, data)
+}
+
+func (h *Handler) getTransferData(r *http.Request) (*repositoryEntities.TransferData, error) {
+	repositoryID, err := uuid.Parse(chi.URLParam(r, repositoryEnums.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.useCases.TransferDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetIDs(parser.ParseStringToUUID(chi.URLParam(r, workspaceEnums.ID)), repositoryID), nil
+}
+
+// checkTargetWorkspaceAdmin verifies the admin rights on the target workspace, the rights on the source workspace
+// are already checked by the middleware of the route
+func (h *Handler) checkTargetWorkspaceAdmin(r *http.Request, targetWorkspaceID uuid.UUID) error {
+	response, err := h.authGRPC.IsAuthorized(h.context, &proto.IsAuthorizedData{
+		Token:       r.Header.Get(enums.HorusecJWTHeader),
+		Type:        authEnums.WorkspaceAdmin.ToString(),
+		WorkspaceID: targetWorkspaceID.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !response.GetIsAuthorized() {
+		return repositoryEnums.ErrorNotTargetWorkspaceAdmin
+	}
+
+	return nil
+}
+
+// transfer keeps the source workspace as the scope of the audit event, the target workspace is in the changes
+func (h *Handler) transfer(w http.ResponseWriter, r *http.Request, data *repositoryEntities.TransferData) {
+	repository, err := h.controller.Transfer(data)
+	if err != nil {
+		h.checkTransferErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionRepositoryTransfer, auditEnums.TargetRepository,
+		data.RepositoryID).SetScope(data.WorkspaceID, data.RepositoryID).SetChanges(data, repository))
+	httpUtil.StatusOK(w, repository)
+}
+
+func (h *Handler) checkTransferErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	if err == repositoryEnums.ErrorRepositoryNameAlreadyInUse {
+		httpUtil.StatusConflict(w, err)
+		return
+	}
+
+	h.checkTransferValidationErrors(w, err)
+}
+
+func (h *Handler) checkTransferValidationErrors(w http.ResponseWriter, err error) {
+	if err == repositoryEnums.ErrorTransferToSameWorkspace || err == repositoryEnums.ErrorTransferArchived {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err == quotaEnums.ErrorRepositoriesLimitReached {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
@@ -16,18 +16,31 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 
 	repositoryController "github.com/ZupIT/horusec-platform/core/internal/controllers/repository"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	"github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
 	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
 	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 	roleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/role"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 )
 
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
 func TestCreate(t *testing.T) {
 	data := &repositoryEntities.Data{
 		Name:        "test",
@@ -52,7 +65,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -77,7 +91,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -91,6 +106,32 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 
+	t.Run("should return 403 when the workspace reached the repositories limit", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Create").Return(&repositoryEntities.Response{}, quotaEnums.ErrorRepositoriesLimitReached)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		appConfigMock := &app.Mock{}
+		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Create(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("should return 400 when name already in use", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 		controllerMock.On("Create").Return(
@@ -103,7 +144,35 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Create(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when owner team is not of the workspace", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Create").Return(
+			&repositoryEntities.Response{}, repositoryEnums.ErrorOwnerTeamNotInWorkspace)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		appConfigMock := &app.Mock{}
+		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -127,7 +196,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Ldap)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		data.AuthzAdmin = []string{"test2"}
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToBytes()))
@@ -150,7 +220,8 @@ func TestCreate(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -172,7 +243,8 @@ func TestCreate(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -202,7 +274,8 @@ func TestGet(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -227,7 +300,8 @@ func TestGet(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -250,7 +324,8 @@ func TestGet(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -271,7 +346,8 @@ func TestGet(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -312,7 +388,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -338,7 +415,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -365,7 +443,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -390,7 +469,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Ldap)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		data.AuthzAdmin = []string{"test2"}
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
@@ -414,7 +494,8 @@ func TestUpdate(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -435,7 +516,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(data.ToBytes()))
 		w := httptest.NewRecorder()
@@ -454,13 +536,14 @@ func TestUpdate(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
-		controllerMock.On("Delete").Return(nil)
+		controllerMock.On("Archive").Return(nil)
 
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -477,13 +560,14 @@ func TestDelete(t *testing.T) {
 
 	t.Run("should return 500 whe something went wrong", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
-		controllerMock.On("Delete").Return(errors.New("test"))
+		controllerMock.On("Archive").Return(errors.New("test"))
 
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -504,7 +588,8 @@ func TestDelete(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -536,7 +621,8 @@ func TestList(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -560,7 +646,8 @@ func TestList(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -574,6 +661,26 @@ func TestList(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 	})
 
+	t.Run("should return 400 when invalid label filter", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), &repositoryController.Mock{},
+			&app.Mock{}, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test?label=criticality", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.New().String())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.List(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("should return 400 when failed to get account data", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 		appConfigMock := &app.Mock{}
@@ -582,7 +689,8 @@ func TestList(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -604,13 +712,15 @@ func TestUpdateRole(t *testing.T) {
 
 	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
 		controllerMock.On("UpdateRole").Return(&role.Response{}, nil)
 
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -628,13 +738,40 @@ func TestUpdateRole(t *testing.T) {
 
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
 		controllerMock.On("UpdateRole").Return(&role.Response{}, errors.New("test"))
 
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("accountID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.UpdateRole(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get the current role", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -652,6 +789,7 @@ func TestUpdateRole(t *testing.T) {
 
 	t.Run("should return 400 when user does not belong to the workspace", func(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
 		controllerMock.On("UpdateRole").Return(
 			&role.Response{}, repositoryEnums.ErrorUserDoesNotBelongToWorkspace)
 
@@ -659,7 +797,31 @@ func TestUpdateRole(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("accountID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.UpdateRole(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when custom role is not of the workspace", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
+		controllerMock.On("UpdateRole").Return(&role.Response{}, customRoleEnums.ErrorCustomRoleNotInWorkspace)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -681,7 +843,8 @@ func TestUpdateRole(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -701,7 +864,8 @@ func TestUpdateRole(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -728,7 +892,8 @@ func TestInviteUser(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -751,7 +916,8 @@ func TestInviteUser(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -775,7 +941,8 @@ func TestInviteUser(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -799,7 +966,8 @@ func TestInviteUser(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("test")))
 		w := httptest.NewRecorder()
@@ -824,7 +992,8 @@ func TestGetUsers(t *testing.T) {
 		controllerMock.On("GetUsers").Return(&[]role.Response{}, nil)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -847,7 +1016,8 @@ func TestGetUsers(t *testing.T) {
 		controllerMock.On("GetUsers").Return(&[]role.Response{}, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -870,7 +1040,8 @@ func TestGetUsers(t *testing.T) {
 		controllerMock.On("GetUsers").Return(&[]role.Response{}, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -895,7 +1066,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock.On("RemoveUser").Return(nil)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -919,7 +1091,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock.On("RemoveUser").Return(errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -941,7 +1114,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -973,7 +1147,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock.On("CreateToken").Return(uuid.NewString(), nil)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToByes()))
 		w := httptest.NewRecorder()
@@ -988,6 +1163,27 @@ func TestCreateToken(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, w.Code)
 	})
 
+	t.Run("should return 403 when the workspace reached the tokens limit", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("CreateToken").Return("", quotaEnums.ErrorTokensLimitReached)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToByes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.CreateToken(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
@@ -996,7 +1192,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock.On("CreateToken").Return(uuid.NewString(), errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToByes()))
 		w := httptest.NewRecorder()
@@ -1017,7 +1214,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -1042,7 +1240,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock.On("DeleteToken").Return(nil)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1066,7 +1265,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock.On("DeleteToken").Return(errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1088,7 +1288,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1105,6 +1306,87 @@ func TestDeleteToken(t *testing.T) {
 	})
 }
 
+func TestRotateToken(t *testing.T) {
+	newRequest := func(body []byte) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		ctx.URLParams.Add("tokenID", uuid.NewString())
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("RotateToken").Return("token", nil)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte(`{"gracePeriodHours": 1}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 404 when token was not found", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("RotateToken").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 409 when token was already rotated", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("RotateToken").Return("", tokenEnums.ErrorTokenAlreadyRotated)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("RotateToken").Return("", errors.New("test"))
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid grace period", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte(`{"gracePeriodHours": -1}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 func TestListTokens(t *testing.T) {
 	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
@@ -1114,7 +1396,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock.On("ListTokens").Return(&[]tokenEntities.Response{}, nil)
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1137,7 +1420,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock.On("ListTokens").Return(&[]tokenEntities.Response{}, errors.New("test"))
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1158,7 +1442,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1179,7 +1464,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1202,7 +1488,8 @@ func TestOptions(t *testing.T) {
 		controllerMock := &repositoryController.Mock{}
 
 		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
-			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodOptions, "test", nil)
 		w := httptest.NewRecorder()
@@ -1212,3 +1499,296 @@ func TestOptions(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
+
+func TestRestore(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Restore").Return(&repositoryEntities.Response{}, nil)
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when retention period has expired", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Restore").Return(&repositoryEntities.Response{}, archiveEnums.ErrorRetentionExpired)
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Restore").Return(&repositoryEntities.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid repository id", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			appConfigMock, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestListArchived(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("ListArchived").Return(&[]repositoryEntities.Response{}, nil)
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ListArchived(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("ListArchived").Return(&[]repositoryEntities.Response{}, errors.New("test"))
+
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ListArchived(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), &repositoryController.Mock{},
+			&app.Mock{}, &proto.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.ListArchived(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestTransfer(t *testing.T) {
+	newRequest := func(body []byte) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("repositoryID", uuid.NewString())
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	body := []byte(`{"targetWorkspaceID": "` + uuid.NewString() + `"}`)
+
+	newTransferHandler := func(controllerMock *repositoryController.Mock, authGRPCMock *proto.Mock) *Handler {
+		return NewRepositoryHandler(repositoryUseCases.NewRepositoryUseCases(), controllerMock,
+			&app.Mock{}, authGRPCMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+	}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Transfer").Return(&repositoryEntities.Response{}, nil)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when repository was not found", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Transfer").Return(&repositoryEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 409 when name is already in use in the target workspace", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Transfer").Return(&repositoryEntities.Response{},
+			repositoryEnums.ErrorRepositoryNameAlreadyInUse)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 400 when repository or workspace is archived", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Transfer").Return(&repositoryEntities.Response{}, repositoryEnums.ErrorTransferArchived)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 403 when the target workspace reached the repositories limit", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Transfer").Return(&repositoryEntities.Response{},
+			quotaEnums.ErrorRepositoriesLimitReached)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+		controllerMock.On("Transfer").Return(&repositoryEntities.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 401 when not admin of the target workspace", func(t *testing.T) {
+		controllerMock := &repositoryController.Mock{}
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: false}, nil)
+
+		w := httptest.NewRecorder()
+		newTransferHandler(controllerMock, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		controllerMock.AssertNotCalled(t, "Transfer")
+	})
+
+	t.Run("should return 401 when failed to check target workspace rights", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{}, errors.New("test"))
+
+		w := httptest.NewRecorder()
+		newTransferHandler(&repositoryController.Mock{}, authGRPCMock).Transfer(w, newRequest(body))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 400 when target workspace is not informed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		newTransferHandler(&repositoryController.Mock{}, &proto.Mock{}).Transfer(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid repository id", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+
+		w := httptest.NewRecorder()
+		newTransferHandler(&repositoryController.Mock{}, &proto.Mock{}).Transfer(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,450 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
+
+	teamController "github.com/ZupIT/horusec-platform/core/internal/controllers/team"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	roleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/role"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
+)
+
+type Handler struct {
+	controller   teamController.IController
+	useCases     teamUseCases.IUseCases
+	auditService auditService.IService
+}
+
+func NewTeamHandler(controller teamController.IController, useCases teamUseCases.IUseCases,
+	serviceAudit auditService.IService) *Handler {
+	return &Handler{
+		controller:   controller,
+		useCases:     useCases,
+		auditService: serviceAudit,
+	}
+}
+
+func (h *Handler) checkErrors(w http.ResponseWriter, err error) {
+	switch err {
+	case databaseEnums.ErrorNotFoundRecords:
+		httpUtil.StatusNotFound(w, err)
+	case teamEnums.ErrorAccountAlreadyTeamMember, teamEnums.ErrorTeamAlreadyHasRepositoryRole:
+		httpUtil.StatusConflict(w, err)
+	case teamEnums.ErrorTeamNameAlreadyInUse, teamEnums.ErrorAccountNotWorkspaceMember,
+		teamEnums.ErrorRepositoryNotInTeamWorkspace:
+		httpUtil.StatusBadRequest(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
+	}
+}
+
+func (h *Handler) getTeamIDs(r *http.Request) (teamID, workspaceID uuid.UUID, err error) {
+	teamID, err = uuid.Parse(chi.URLParam(r, teamEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	workspaceID, err = uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return teamID, workspaceID, nil
+}
+
+// @Tags Team
+// @Description Create a new team in a workspace
+// @ID create-team
+// @Accept  json
+// @Produce  json
+// @Param Team body teamEntities.Data true "team data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams [post]
+// @Security ApiKeyAuth
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.TeamDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	team, err := h.controller.Create(data.SetIDs(chi.URLParam(r, workspaceEnums.ID), ""))
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionTeamCreate, auditEnums.TargetTeam,
+		team.TeamID).SetScope(team.WorkspaceID, uuid.Nil).SetChanges(nil, team))
+	httpUtil.StatusCreated(w, team)
+}
+
+// @Tags Team
+// @Description Get a team of a workspace
+// @ID get-team
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param teamID path string true "ID of the team"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams/{teamID} [get]
+// @Security ApiKeyAuth
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	teamID, workspaceID, err := h.getTeamIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	team, err := h.controller.Get(teamID, workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, team)
+}
+
+// @Tags Team
+// @Description Update the name and description of a team
+// @ID update-team
+// @Accept  json
+// @Produce  json
+// @Param Team body teamEntities.Data true "team data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Param teamID path string true "ID of the team"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams/{teamID} [patch]
+// @Security ApiKeyAuth
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.TeamDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	team, err := h.controller.Update(data.SetIDs(chi.URLParam(r, workspaceEnums.ID), chi.URLParam(r, teamEnums.ID)))
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionTeamUpdate, auditEnums.TargetTeam,
+		team.TeamID).SetScope(team.WorkspaceID, uuid.Nil).SetChanges(nil, team))
+	httpUtil.StatusOK(w, team)
+}
+
+// @Tags Team
+// @Description Delete a team, its members and repository roles
+// @ID delete-team
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param teamID path string true "ID of the team"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams/{teamID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	teamID, workspaceID, err := h.getTeamIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.Delete(teamID, workspaceID); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionTeamDelete, auditEnums.TargetTeam,
+		teamID).SetScope(workspaceID, uuid.Nil))
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Team
+// @Description List the teams of a workspace
+// @ID list-teams
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams [get]
+// @Security ApiKeyAuth
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	teams, err := h.controller.List(workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, teams)
+}
+
+// @Tags Team
+// @Description Add a workspace member to a team
+// @ID add-team-member
+// @Accept  json
+// @Produce  json
+// @Param Member body teamEntities.MemberData true "member data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Param teamID path string true "ID of the team"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams/{teamID}/accounts [post]
+// @Security ApiKeyAuth
+func (h *Handler) AddMember(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.MemberDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	if err := h.controller.AddMember(data.SetIDs(chi.URLParam(r, workspaceEnums.ID),
+		chi.URLParam(r, teamEnums.ID))); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishMemberEvent(r, auditEnums.ActionTeamMemberAdd, data)
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) publishMemberEvent(r *http.Request, action auditEnums.Action, data *teamEntities.MemberData) {
+	h.auditService.Publish(r, auditEntities.NewEvent(action, auditEnums.TargetTeam, data.TeamID).
+		SetScope(data.WorkspaceID, uuid.Nil).SetChanges(nil, data))
+}
+
+// @Tags Team
+// @Description Remove a member from a team
+// @ID remove-team-member
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param teamID path string true "ID of the team"
+// @Param accountID path string true "ID of the account"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams/{teamID}/accounts/{accountID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	accountID, err := uuid.Parse(chi.URLParam(r, roleEnums.AccountID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	data := &teamEntities.MemberData{AccountID: accountID}
+	if err := h.controller.RemoveMember(data.SetIDs(chi.URLParam(r, workspaceEnums.ID),
+		chi.URLParam(r, teamEnums.ID))); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishMemberEvent(r, auditEnums.ActionTeamMemberRemove, data)
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Team
+// @Description List the members of a team
+// @ID list-team-members
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param teamID path string true "ID of the team"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/teams/{teamID}/accounts [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	teamID, workspaceID, err := h.getTeamIDs(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	members, err := h.controller.ListMembers(teamID, workspaceID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, members)
+}
+
+// @Tags Team
+// @Description Grant a role in a repository to all members of a team
+// @ID grant-team-repository-role
+// @Accept  json
+// @Produce  json
+// @Param Role body teamEntities.RepositoryRoleData true "team role data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/teams [post]
+// @Security ApiKeyAuth
+func (h *Handler) GrantRepositoryRole(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.RepositoryRoleDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	role, err := h.controller.GrantRepositoryRole(data.SetIDs(chi.URLParam(r, workspaceEnums.ID),
+		chi.URLParam(r, repositoryEnums.ID)))
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishRepositoryRoleEvent(r, auditEnums.ActionTeamRepositoryRoleGrant, data, role)
+	httpUtil.StatusCreated(w, role)
+}
+
+func (h *Handler) publishRepositoryRoleEvent(r *http.Request, action auditEnums.Action,
+	data *teamEntities.RepositoryRoleData, role interface{}) {
+	h.auditService.Publish(r, auditEntities.NewEvent(action, auditEnums.TargetTeam, data.TeamID).
+		SetScope(data.WorkspaceID, data.RepositoryID).SetChanges(nil, role))
+}
+
+// @Tags Team
+// @Description Update the role of a team in a repository
+// @ID update-team-repository-role
+// @Accept  json
+// @Produce  json
+// @Param Role body teamEntities.RepositoryRoleData true "team role data"
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Param teamID path string true "ID of the team"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/teams/{teamID} [patch]
+// @Security ApiKeyAuth
+func (h *Handler) UpdateRepositoryRole(w http.ResponseWriter, r *http.Request) {
+	data, err := h.useCases.RepositoryRoleDataFromIOReadCloser(r.Body)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	role, err := h.controller.UpdateRepositoryRole(data.SetIDs(chi.URLParam(r, workspaceEnums.ID),
+		chi.URLParam(r, repositoryEnums.ID)).SetTeamID(chi.URLParam(r, teamEnums.ID)))
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishRepositoryRoleEvent(r, auditEnums.ActionTeamRepositoryRoleUpdate, data, role)
+	httpUtil.StatusOK(w, role)
+}
+
+// @Tags Team
+// @Description Revoke the role of a team in a repository
+// @ID revoke-team-repository-role
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Param teamID path string true "ID of the team"
+// @Success 204 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/teams/{teamID} [delete]
+// @Security ApiKeyAuth
+func (h *Handler) RevokeRepositoryRole(w http.ResponseWriter, r *http.Request) {
+	data := &teamEntities.RepositoryRoleData{}
+
+	if err := h.controller.RevokeRepositoryRole(data.SetIDs(chi.URLParam(r, workspaceEnums.ID),
+		chi.URLParam(r, repositoryEnums.ID)).SetTeamID(chi.URLParam(r, teamEnums.ID))); err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	h.publishRepositoryRoleEvent(r, auditEnums.ActionTeamRepositoryRoleRevoke, data, nil)
+	httpUtil.StatusNoContent(w)
+}
+
+// @Tags Team
+// @Description List the teams with a role in a repository
+// @ID list-team-repository-roles
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param repositoryID path string true "ID of the repository"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/repositories/{repositoryID}/teams [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListRepositoryRoles(w http.ResponseWriter, r *http.Request) {
+	repositoryID, err := uuid.Parse(chi.URLParam(r, repositoryEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	roles, err := h.controller.ListRepositoryRoles(repositoryID)
+	if err != nil {
+		h.checkErrors(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, roles)
+}
@@ -0,0 +1,534 @@
+package team
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	teamController "github.com/ZupIT/horusec-platform/core/internal/controllers/team"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
+)
+
+func newRequest(method string, body interface{}, params map[string]string) *http.Request {
+	bytesBody, _ := json.Marshal(body)
+	r, _ := http.NewRequest(method, "test", bytes.NewReader(bytesBody))
+
+	ctx := chi.NewRouteContext()
+	for key, value := range params {
+		ctx.URLParams.Add(key, value)
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+}
+
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func newTeamParams() map[string]string {
+	return map[string]string{"workspaceID": uuid.NewString(), "teamID": uuid.NewString()}
+}
+
+func TestNewTeamHandler(t *testing.T) {
+	t.Run("should success create a new team handler", func(t *testing.T) {
+		assert.NotNil(t, NewTeamHandler(nil, nil, nil))
+	})
+}
+
+func TestCreate(t *testing.T) {
+	data := &teamEntities.Data{Name: "test"}
+	params := map[string]string{"workspaceID": uuid.NewString()}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Create").Return(&teamEntities.Response{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 400 when name already in use", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Create").Return(&teamEntities.Response{}, teamEnums.ErrorTeamNameAlreadyInUse)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid data", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, &teamEntities.Data{}, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Create").Return(&teamEntities.Response{}, errors.New("test"))
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Create(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Get").Return(&teamEntities.Response{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Get(w, newRequest(http.MethodGet, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when team not found", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Get").Return(&teamEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Get(w, newRequest(http.MethodGet, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid team id", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Get(w, newRequest(http.MethodGet, nil, map[string]string{"teamID": "test"}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Get(w, newRequest(http.MethodGet, nil, map[string]string{"teamID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Update").Return(&teamEntities.Response{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Update(w, newRequest(http.MethodPatch, &teamEntities.Data{Name: "test"}, newTeamParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid data", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Update(w, newRequest(http.MethodPatch, &teamEntities.Data{}, newTeamParams()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when team not found", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Update").Return(&teamEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Update(w, newRequest(http.MethodPatch, &teamEntities.Data{Name: "test"}, newTeamParams()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Delete").Return(nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Delete(w, newRequest(http.MethodDelete, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when invalid ids", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Delete(w, newRequest(http.MethodDelete, nil, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("Delete").Return(errors.New("test"))
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.Delete(w, newRequest(http.MethodDelete, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("List").Return(&[]teamEntities.Response{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("List").Return(&[]teamEntities.Response{}, errors.New("test"))
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.List(w, newRequest(http.MethodGet, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestAddMember(t *testing.T) {
+	data := &teamEntities.MemberData{AccountID: uuid.New()}
+
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("AddMember").Return(nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.AddMember(w, newRequest(http.MethodPost, data, newTeamParams()))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 409 when account is already a member", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("AddMember").Return(teamEnums.ErrorAccountAlreadyTeamMember)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.AddMember(w, newRequest(http.MethodPost, data, newTeamParams()))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 400 when account is not a workspace member", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("AddMember").Return(teamEnums.ErrorAccountNotWorkspaceMember)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.AddMember(w, newRequest(http.MethodPost, data, newTeamParams()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid data", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.AddMember(w, newRequest(http.MethodPost, &teamEntities.MemberData{}, newTeamParams()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRemoveMember(t *testing.T) {
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("RemoveMember").Return(nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		params := newTeamParams()
+		params["accountID"] = uuid.NewString()
+		handler.RemoveMember(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 400 when invalid account id", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RemoveMember(w, newRequest(http.MethodDelete, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when team not found", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("RemoveMember").Return(databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		params := newTeamParams()
+		params["accountID"] = uuid.NewString()
+		handler.RemoveMember(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestListMembers(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("ListMembers").Return(&[]teamEntities.MemberResponse{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListMembers(w, newRequest(http.MethodGet, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid ids", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListMembers(w, newRequest(http.MethodGet, nil, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 404 when team not found", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("ListMembers").Return(&[]teamEntities.MemberResponse{}, databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListMembers(w, newRequest(http.MethodGet, nil, newTeamParams()))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGrantRepositoryRole(t *testing.T) {
+	data := &teamEntities.RepositoryRoleData{TeamID: uuid.New(), Role: account.Member}
+	params := map[string]string{"workspaceID": uuid.NewString(), "repositoryID": uuid.NewString()}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("GrantRepositoryRole").Return(&teamEntities.RepositoryRoleResponse{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GrantRepositoryRole(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 409 when team already has a role", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("GrantRepositoryRole").Return(&teamEntities.RepositoryRoleResponse{},
+			teamEnums.ErrorTeamAlreadyHasRepositoryRole)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GrantRepositoryRole(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 400 when repository is from another workspace", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("GrantRepositoryRole").Return(&teamEntities.RepositoryRoleResponse{},
+			teamEnums.ErrorRepositoryNotInTeamWorkspace)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GrantRepositoryRole(w, newRequest(http.MethodPost, data, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 400 when invalid role", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.GrantRepositoryRole(w, newRequest(http.MethodPost, &teamEntities.RepositoryRoleData{}, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestUpdateRepositoryRole(t *testing.T) {
+	data := &teamEntities.RepositoryRoleData{Role: account.Admin}
+	params := map[string]string{"workspaceID": uuid.NewString(), "repositoryID": uuid.NewString(),
+		"teamID": uuid.NewString()}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("UpdateRepositoryRole").Return(&teamEntities.RepositoryRoleResponse{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UpdateRepositoryRole(w, newRequest(http.MethodPatch, data, params))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 404 when team has no role in the repository", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("UpdateRepositoryRole").Return(&teamEntities.RepositoryRoleResponse{},
+			databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UpdateRepositoryRole(w, newRequest(http.MethodPatch, data, params))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 400 when invalid role", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.UpdateRepositoryRole(w, newRequest(http.MethodPatch, &teamEntities.RepositoryRoleData{}, params))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestRevokeRepositoryRole(t *testing.T) {
+	params := map[string]string{"workspaceID": uuid.NewString(), "repositoryID": uuid.NewString(),
+		"teamID": uuid.NewString()}
+
+	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("RevokeRepositoryRole").Return(nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeRepositoryRole(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("RevokeRepositoryRole").Return(errors.New("test"))
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.RevokeRepositoryRole(w, newRequest(http.MethodDelete, nil, params))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestListRepositoryRoles(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("ListRepositoryRoles").Return(&[]teamEntities.RepositoryRoleResponse{}, nil)
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListRepositoryRoles(w, newRequest(http.MethodGet, nil,
+			map[string]string{"repositoryID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when invalid repository id", func(t *testing.T) {
+		handler := NewTeamHandler(&teamController.Mock{}, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListRepositoryRoles(w, newRequest(http.MethodGet, nil, nil))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &teamController.Mock{}
+		controllerMock.On("ListRepositoryRoles").Return(&[]teamEntities.RepositoryRoleResponse{},
+			errors.New("test"))
+
+		handler := NewTeamHandler(controllerMock, teamUseCases.NewTeamUseCases(), newAuditServiceMock())
+		w := httptest.NewRecorder()
+
+		handler.ListRepositoryRoles(w, newRequest(http.MethodGet, nil,
+			map[string]string{"repositoryID": uuid.NewString()}))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
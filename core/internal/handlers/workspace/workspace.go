@@ -8,18 +8,25 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // swagger import
 	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
 
 	workspaceController "github.com/ZupIT/horusec-platform/core/internal/controllers/workspace"
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
 	roleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/role"
 	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
 	roleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/role"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
@@ -33,11 +40,12 @@ type Handler struct {
 	context       context.Context
 	appConfig     app.IConfig
 	tokenUseCases tokenUseCases.IUseCases
+	auditService  auditService.IService
 }
 
 func NewWorkspaceHandler(controller workspaceController.IController, useCases workspaceUseCases.IUseCases,
 	authGRPC proto.AuthServiceClient, appConfig app.IConfig, useCasesRole roleUseCases.IUseCases,
-	useCasesToken tokenUseCases.IUseCases) *Handler {
+	useCasesToken tokenUseCases.IUseCases, serviceAudit auditService.IService) *Handler {
 	return &Handler{
 		controller:    controller,
 		useCases:      useCases,
@@ -46,6 +54,7 @@ func NewWorkspaceHandler(controller workspaceController.IController, useCases wo
 		appConfig:     appConfig,
 		roleUseCases:  useCasesRole,
 		tokenUseCases: useCasesToken,
+		auditService:  serviceAudit,
 	}
 }
 
@@ -86,6 +95,8 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace,
+		workspace.WorkspaceID).SetScope(workspace.WorkspaceID, uuid.Nil).SetChanges(nil, workspace))
 	httpUtil.StatusCreated(w, workspace)
 }
 
@@ -191,7 +202,7 @@ func (h *Handler) getUpdateData(r *http.Request) (*workspaceEntities.Data, error
 }
 
 // @Tags Workspace
-// @Description Delete a workspace by id
+// @Description Archive a workspace by id, it can be restored until the retention period expires
 // @ID delete-workspace
 // @Accept  json
 // @Produce  json
@@ -204,20 +215,108 @@ func (h *Handler) getUpdateData(r *http.Request) (*workspaceEntities.Data, error
 // @Router /core/workspaces/{workspaceID} [delete]
 // @Security ApiKeyAuth
 func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
-	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	data, err := h.getByIDData(r)
 	if err != nil {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
 
-	if err = h.controller.Delete(workspaceID); err != nil {
+	workspace, err := h.controller.Get(data)
+	if err != nil {
+		h.checkDeleteErrors(w, err)
+		return
+	}
+
+	h.archive(w, r, data, workspace)
+}
+
+func (h *Handler) archive(w http.ResponseWriter, r *http.Request, data *workspaceEntities.Data,
+	workspace *workspaceEntities.Response) {
+	if err := h.controller.Archive(data.WorkspaceID); err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceDelete, auditEnums.TargetWorkspace,
+		data.WorkspaceID).SetScope(data.WorkspaceID, uuid.Nil).SetChanges(workspace, nil))
 	httpUtil.StatusNoContent(w)
 }
 
+func (h *Handler) checkDeleteErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Workspace
+// @Description Restore an archived workspace by id while it is inside the retention period
+// @ID restore-workspace
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/restore [post]
+// @Security ApiKeyAuth
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	workspace, err := h.controller.Restore(workspaceID)
+	if err != nil {
+		h.checkRestoreErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceRestore, auditEnums.TargetWorkspace,
+		workspaceID).SetScope(workspaceID, uuid.Nil).SetChanges(nil, workspace))
+	httpUtil.StatusOK(w, workspace)
+}
+
+func (h *Handler) checkRestoreErrors(w http.ResponseWriter, err error) {
+	if err == archiveEnums.ErrorNotArchived || err == archiveEnums.ErrorRetentionExpired {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+// @Tags Workspace
+// @Description List the archived workspaces that the account can restore
+// @ID list-archived-workspace
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/archived [get]
+// @Security ApiKeyAuth
+func (h *Handler) ListArchived(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getListData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	workspaces, err := h.controller.ListArchived(data)
+	if err != nil {
+		httpUtil.StatusInternalServerError(w, err)
+		return
+	}
+
+	httpUtil.StatusOK(w, workspaces)
+}
+
 // @Tags Workspace
 // @Description List all workspaces of an account
 // @ID list-workspace
@@ -255,7 +354,7 @@ func (h *Handler) getListData(r *http.Request) (*workspaceEntities.Data, error)
 }
 
 // @Tags Workspace
-// @Description Update an account role of a workspace
+// @Description Update an account role of a workspace, a custom role replaces the built-in role
 // @ID update-workspace-role
 // @Accept  json
 // @Produce  json
@@ -276,15 +375,37 @@ func (h *Handler) UpdateRole(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	role, err := h.controller.UpdateRole(data)
+	before, err := h.controller.GetRole(data)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
 	}
 
+	h.updateRole(w, r, data, before)
+}
+
+func (h *Handler) updateRole(w http.ResponseWriter, r *http.Request, data *roleEntities.Data,
+	before *roleEntities.Response) {
+	role, err := h.controller.UpdateRole(data)
+	if err != nil {
+		h.checkUpdateRoleErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceRoleUpdate, auditEnums.TargetRole,
+		data.AccountID).SetScope(data.WorkspaceID, uuid.Nil).SetChanges(before, role))
 	httpUtil.StatusOK(w, role)
 }
 
+func (h *Handler) checkUpdateRoleErrors(w http.ResponseWriter, err error) {
+	if err == customRoleEnums.ErrorCustomRoleNotInWorkspace {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
 func (h *Handler) getUpdateRoleData(r *http.Request) (*roleEntities.Data, error) {
 	data, err := h.roleUseCases.RoleDataFromIOReadCloser(r.Body)
 	if err != nil {
@@ -326,6 +447,8 @@ func (h *Handler) InviteUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceMemberAdd, auditEnums.TargetRole,
+		data.AccountID).SetScope(data.WorkspaceID, uuid.Nil).SetChanges(nil, role))
 	httpUtil.StatusOK(w, role)
 }
 
@@ -398,6 +521,8 @@ func (h *Handler) RemoveUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceMemberRemove, auditEnums.TargetRole,
+		data.AccountID).SetScope(data.WorkspaceID, uuid.Nil))
 	httpUtil.StatusNoContent(w)
 }
 
@@ -425,6 +550,7 @@ func (h *Handler) getRemoveUserData(r *http.Request) (*roleEntities.Data, error)
 // @Success 201 {object} entities.Response
 // @Failure 400 {object} entities.Response
 // @Failure 401 {object} entities.Response
+// @Failure 403 {object} entities.Response
 // @Failure 500 {object} entities.Response
 // @Router /core/workspaces/{workspaceID}/tokens [post]
 // @Security ApiKeyAuth
@@ -437,13 +563,24 @@ func (h *Handler) CreateToken(w http.ResponseWriter, r *http.Request) {
 
 	token, err := h.controller.CreateToken(data)
 	if err != nil {
-		httpUtil.StatusInternalServerError(w, err)
+		h.checkCreateTokenErrors(w, err)
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceTokenCreate, auditEnums.TargetToken,
+		data.TokenID).SetScope(data.WorkspaceID, uuid.Nil).SetChanges(nil, data))
 	httpUtil.StatusCreated(w, token)
 }
 
+func (h *Handler) checkCreateTokenErrors(w http.ResponseWriter, err error) {
+	if err == quotaEnums.ErrorTokensLimitReached {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
 func (h *Handler) getCreateTokenData(r *http.Request) (*tokenEntities.Data, error) {
 	workspaceID, err := uuid.Parse(chi.URLParam(r, workspaceEnums.ID))
 	if err != nil {
@@ -483,6 +620,8 @@ func (h *Handler) DeleteToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceTokenDelete, auditEnums.TargetToken,
+		data.TokenID).SetScope(data.WorkspaceID, uuid.Nil))
 	httpUtil.StatusNoContent(w)
 }
 
@@ -502,6 +641,68 @@ func (h *Handler) getDeleteTokenData(r *http.Request) (*tokenEntities.Data, erro
 	return data.SetIDs(workspaceID, uuid.Nil, tokenIO), nil
 }
 
+// @Tags Workspace
+// @Description Rotate a workspace token, the previous token keeps working until the end of the grace period
+// @ID rotate-workspace-token
+// @Accept  json
+// @Produce  json
+// @Param workspaceID path string true "ID of the workspace"
+// @Param tokenID path string true "ID of the token"
+// @Param Token body tokenEntities.RotateData true "rotate workspace token data"
+// @Success 201 {object} entities.Response
+// @Failure 400 {object} entities.Response
+// @Failure 401 {object} entities.Response
+// @Failure 404 {object} entities.Response
+// @Failure 409 {object} entities.Response
+// @Failure 500 {object} entities.Response
+// @Router /core/workspaces/{workspaceID}/tokens/{tokenID}/rotate [post]
+// @Security ApiKeyAuth
+func (h *Handler) RotateToken(w http.ResponseWriter, r *http.Request) {
+	data, err := h.getRotateTokenData(r)
+	if err != nil {
+		httpUtil.StatusBadRequest(w, err)
+		return
+	}
+
+	token, err := h.controller.RotateToken(data)
+	if err != nil {
+		h.checkRotateTokenErrors(w, err)
+		return
+	}
+
+	h.auditService.Publish(r, auditEntities.NewEvent(auditEnums.ActionWorkspaceTokenRotate, auditEnums.TargetToken,
+		data.TokenID).SetScope(data.WorkspaceID, uuid.Nil).SetChanges(nil, data))
+	httpUtil.StatusCreated(w, token)
+}
+
+func (h *Handler) getRotateTokenData(r *http.Request) (*tokenEntities.RotateData, error) {
+	tokenData, err := h.getDeleteTokenData(r)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.tokenUseCases.RotateDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.SetIDs(tokenData.WorkspaceID, tokenData.RepositoryID, tokenData.TokenID), nil
+}
+
+func (h *Handler) checkRotateTokenErrors(w http.ResponseWriter, err error) {
+	if err == databaseEnums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+
+	if err == tokenEnums.ErrorTokenAlreadyRotated {
+		httpUtil.StatusConflict(w, err)
+		return
+	}
+
+	httpUtil.StatusInternalServerError(w, err)
+}
+
 // @Tags Workspace
 // @Description List all workspace tokens
 // @ID list-workspace-tokens
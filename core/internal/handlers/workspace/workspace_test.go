@@ -16,21 +16,34 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/enums/auth"
 	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 
 	workspaceController "github.com/ZupIT/horusec-platform/core/internal/controllers/workspace"
 	"github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	auditService "github.com/ZupIT/horusec-platform/core/internal/services/audit"
 	roleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/role"
 	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
 )
 
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
 func TestNewWorkspaceHandler(t *testing.T) {
 	t.Run("should success create a new workspace handler", func(t *testing.T) {
 		assert.NotNil(t, NewWorkspaceHandler(nil, nil, nil,
-			nil, nil, nil))
+			nil, nil, nil, newAuditServiceMock()))
 	})
 }
 
@@ -58,7 +71,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -79,7 +93,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -99,7 +114,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -119,7 +135,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -141,7 +158,8 @@ func TestCreate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Ldap)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -168,7 +186,8 @@ func TestGet(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -192,7 +211,8 @@ func TestGet(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -215,7 +235,8 @@ func TestGet(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -235,7 +256,8 @@ func TestGet(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -274,7 +296,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -299,7 +322,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -323,7 +347,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -347,7 +372,8 @@ func TestUpdate(t *testing.T) {
 		appConfigMock.On("GetAuthenticationType").Return(auth.Horusec)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(workspaceData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -363,15 +389,24 @@ func TestUpdate(t *testing.T) {
 }
 
 func TestDelete(t *testing.T) {
+	accountData := &proto.GetAccountDataResponse{
+		AccountID:   uuid.New().String(),
+		Permissions: []string{"test"},
+	}
+
 	t.Run("should return 204 when everything it is ok", func(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
-		controllerMock.On("Delete").Return(nil)
+		controllerMock.On("Get").Return(&workspaceEntities.Response{}, nil)
+		controllerMock.On("Archive").Return(nil)
 
 		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -385,15 +420,69 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 
-	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+	t.Run("should return 500 when something went wrong while archiving", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("Get").Return(&workspaceEntities.Response{}, nil)
+		controllerMock.On("Archive").Return(errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Delete(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 404 when workspace was not found", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("Get").Return(&workspaceEntities.Response{}, databaseEnums.ErrorNotFoundRecords)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Delete(w, r)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get workspace", func(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
-		controllerMock.On("Delete").Return(errors.New("test"))
+		controllerMock.On("Get").Return(&workspaceEntities.Response{}, errors.New("test"))
 
 		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -413,7 +502,8 @@ func TestDelete(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -444,7 +534,8 @@ func TestList(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -464,7 +555,8 @@ func TestList(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -482,7 +574,8 @@ func TestList(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -500,13 +593,15 @@ func TestUpdateRole(t *testing.T) {
 
 	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
 		controllerMock.On("UpdateRole").Return(&role.Response{}, nil)
 
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -521,15 +616,63 @@ func TestUpdateRole(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 
+	t.Run("should return 400 when custom role is not of the workspace", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
+		controllerMock.On("UpdateRole").Return(&role.Response{}, customRoleEnums.ErrorCustomRoleNotInWorkspace)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("accountID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.UpdateRole(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, nil)
 		controllerMock.On("UpdateRole").Return(&role.Response{}, errors.New("test"))
 
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("accountID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.UpdateRole(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 500 when failed to get the current role", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("GetRole").Return(&role.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -550,7 +693,8 @@ func TestUpdateRole(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -570,7 +714,8 @@ func TestUpdateRole(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPatch, "test", bytes.NewReader([]byte("test")))
 		w := httptest.NewRecorder()
@@ -610,7 +755,8 @@ func TestInviteUser(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -634,7 +780,8 @@ func TestInviteUser(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -654,7 +801,8 @@ func TestInviteUser(t *testing.T) {
 		appConfigMock := &app.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("")))
 		w := httptest.NewRecorder()
@@ -672,7 +820,8 @@ func TestInviteUser(t *testing.T) {
 		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(roleData.ToBytes()))
 		w := httptest.NewRecorder()
@@ -692,7 +841,8 @@ func TestGetUsers(t *testing.T) {
 		controllerMock.On("GetUsers").Return(&[]role.Response{}, nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -714,7 +864,8 @@ func TestGetUsers(t *testing.T) {
 		controllerMock.On("GetUsers").Return(&[]role.Response{}, errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -734,7 +885,8 @@ func TestGetUsers(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", nil)
 		w := httptest.NewRecorder()
@@ -758,7 +910,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock.On("RemoveUser").Return(nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -781,7 +934,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock.On("RemoveUser").Return(errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -802,7 +956,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -823,7 +978,8 @@ func TestRemoveUser(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", nil)
 		w := httptest.NewRecorder()
@@ -853,7 +1009,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock.On("CreateToken").Return(uuid.NewString(), nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToByes()))
 		w := httptest.NewRecorder()
@@ -867,6 +1024,26 @@ func TestCreateToken(t *testing.T) {
 		assert.Equal(t, http.StatusCreated, w.Code)
 	})
 
+	t.Run("should return 403 when the workspace reached the tokens limit", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("CreateToken").Return("", quotaEnums.ErrorTokensLimitReached)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToByes()))
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.CreateToken(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
 		appConfigMock := &app.Mock{}
@@ -875,7 +1052,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock.On("CreateToken").Return(uuid.NewString(), errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(data.ToByes()))
 		w := httptest.NewRecorder()
@@ -895,7 +1073,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader([]byte("test")))
 		w := httptest.NewRecorder()
@@ -915,7 +1094,8 @@ func TestCreateToken(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -939,7 +1119,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock.On("DeleteToken").Return(nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -962,7 +1143,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock.On("DeleteToken").Return(errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -983,7 +1165,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1004,7 +1187,8 @@ func TestDeleteToken(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodDelete, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1019,6 +1203,86 @@ func TestDeleteToken(t *testing.T) {
 	})
 }
 
+func TestRotateToken(t *testing.T) {
+	newRequest := func(body []byte) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "test", bytes.NewReader(body))
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		ctx.URLParams.Add("tokenID", uuid.NewString())
+		return r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+	}
+
+	t.Run("should return 201 when everything it is ok", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("RotateToken").Return("token", nil)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte(`{"gracePeriodHours": 1}`)))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("should return 404 when token was not found", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("RotateToken").Return("", databaseEnums.ErrorNotFoundRecords)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("should return 409 when token was already rotated", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("RotateToken").Return("", tokenEnums.ErrorTokenAlreadyRotated)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("RotateToken").Return("", errors.New("test"))
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte("{}")))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid grace period", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			&proto.Mock{}, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		w := httptest.NewRecorder()
+		handler.RotateToken(w, newRequest([]byte(`{"gracePeriodHours": -1}`)))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
 func TestListTokens(t *testing.T) {
 	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
 		authGRPCMock := &proto.Mock{}
@@ -1028,7 +1292,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock.On("ListTokens").Return(&[]tokenEntities.Response{}, nil)
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1050,7 +1315,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock.On("ListTokens").Return(&[]tokenEntities.Response{}, errors.New("test"))
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1070,7 +1336,8 @@ func TestListTokens(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodGet, "test", bytes.NewReader(nil))
 		w := httptest.NewRecorder()
@@ -1092,7 +1359,8 @@ func TestOptions(t *testing.T) {
 		controllerMock := &workspaceController.Mock{}
 
 		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
-			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases())
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
 
 		r, _ := http.NewRequest(http.MethodOptions, "test", nil)
 		w := httptest.NewRecorder()
@@ -1102,3 +1370,156 @@ func TestOptions(t *testing.T) {
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
+
+func TestRestore(t *testing.T) {
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("Restore").Return(&workspaceEntities.Response{}, nil)
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 400 when retention period has expired", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("Restore").Return(&workspaceEntities.Response{}, archiveEnums.ErrorRetentionExpired)
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("Restore").Return(&workspaceEntities.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when invalid workspace id", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		authGRPCMock := &proto.Mock{}
+		appConfigMock := &app.Mock{}
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, appConfigMock, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodPost, "test", nil)
+		w := httptest.NewRecorder()
+
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("workspaceID", "test")
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		handler.Restore(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestListArchived(t *testing.T) {
+	accountData := &proto.GetAccountDataResponse{
+		AccountID:   uuid.New().String(),
+		Permissions: []string{"test"},
+	}
+
+	t.Run("should return 200 when everything it is ok", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("ListArchived").Return(&[]workspaceEntities.Response{}, nil)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListArchived(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 500 when something went wrong", func(t *testing.T) {
+		controllerMock := &workspaceController.Mock{}
+		controllerMock.On("ListArchived").Return(&[]workspaceEntities.Response{}, errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		handler := NewWorkspaceHandler(controllerMock, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListArchived(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("should return 400 when failed to get account data", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
+
+		handler := NewWorkspaceHandler(&workspaceController.Mock{}, workspaceUseCases.NewWorkspaceUseCases(),
+			authGRPCMock, &app.Mock{}, roleUseCases.NewRoleUseCases(), tokenUseCases.NewTokenUseCases(),
+			newAuditServiceMock())
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ListArchived(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
+	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
+)
+
+type IRepository interface {
+	ListExpiredWorkspaces(archivedBefore time.Time) (*[]archiveEntities.Expired, error)
+	ListExpiredRepositories(archivedBefore time.Time) (*[]archiveEntities.Expired, error)
+	DeleteWorkspace(workspaceID uuid.UUID) error
+	DeleteRepository(repositoryID uuid.UUID) error
+}
+
+type Repository struct {
+	databaseRead       database.IDatabaseRead
+	databaseWrite      database.IDatabaseWrite
+	workspaceUseCases  workspaceUseCases.IUseCases
+	repositoryUseCases repositoryUseCases.IUseCases
+}
+
+func NewArchiveRepository(databaseConnection *database.Connection, useCasesWorkspace workspaceUseCases.IUseCases,
+	useCasesRepository repositoryUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead:       databaseConnection.Read,
+		databaseWrite:      databaseConnection.Write,
+		workspaceUseCases:  useCasesWorkspace,
+		repositoryUseCases: useCasesRepository,
+	}
+}
+
+func (r *Repository) ListExpiredWorkspaces(archivedBefore time.Time) (*[]archiveEntities.Expired, error) {
+	expired := &[]archiveEntities.Expired{}
+
+	return expired, r.databaseRead.Raw(r.queryListExpiredWorkspaces(), expired,
+		archivedBefore).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListExpiredWorkspaces() string {
+	return `
+			SELECT ws.workspace_id
+			FROM workspaces AS ws
+			WHERE ws.archived_at IS NOT NULL AND ws.archived_at < ?
+	`
+}
+
+func (r *Repository) ListExpiredRepositories(archivedBefore time.Time) (*[]archiveEntities.Expired, error) {
+	expired := &[]archiveEntities.Expired{}
+
+	return expired, r.databaseRead.Raw(r.queryListExpiredRepositories(), expired,
+		archivedBefore).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListExpiredRepositories() string {
+	return `
+			SELECT repo.workspace_id, repo.repository_id
+			FROM repositories AS repo
+			WHERE repo.archived_at IS NOT NULL AND repo.archived_at < ?
+	`
+}
+
+func (r *Repository) DeleteWorkspace(workspaceID uuid.UUID) error {
+	return r.databaseWrite.Delete(r.workspaceUseCases.FilterWorkspaceByID(workspaceID),
+		workspaceEnums.DatabaseWorkspaceTable).GetError()
+}
+
+func (r *Repository) DeleteRepository(repositoryID uuid.UUID) error {
+	return r.databaseWrite.Delete(r.repositoryUseCases.FilterRepositoryByID(repositoryID),
+		repositoryEnums.DatabaseRepositoryTable).GetError()
+}
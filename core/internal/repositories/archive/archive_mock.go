@@ -0,0 +1,36 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListExpiredWorkspaces(_ time.Time) (*[]archiveEntities.Expired, error) {
+	args := m.MethodCalled("ListExpiredWorkspaces")
+	return args.Get(0).(*[]archiveEntities.Expired), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListExpiredRepositories(_ time.Time) (*[]archiveEntities.Expired, error) {
+	args := m.MethodCalled("ListExpiredRepositories")
+	return args.Get(0).(*[]archiveEntities.Expired), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) DeleteWorkspace(_ uuid.UUID) error {
+	args := m.MethodCalled("DeleteWorkspace")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) DeleteRepository(_ uuid.UUID) error {
+	args := m.MethodCalled("DeleteRepository")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,81 @@
+package archive
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
+	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
+)
+
+func newArchiveRepository(databaseMock *database.Mock) IRepository {
+	return NewArchiveRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+		workspaceUseCases.NewWorkspaceUseCases(), repositoryUseCases.NewRepositoryUseCases())
+}
+
+func TestNewArchiveRepository(t *testing.T) {
+	t.Run("should success create a new archive repository", func(t *testing.T) {
+		assert.NotNil(t, newArchiveRepository(&database.Mock{}))
+	})
+}
+
+func TestListExpiredWorkspaces(t *testing.T) {
+	t.Run("should success list expired workspaces", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		result, err := newArchiveRepository(databaseMock).ListExpiredWorkspaces(time.Now())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListExpiredRepositories(t *testing.T) {
+	t.Run("should success list expired repositories", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		result, err := newArchiveRepository(databaseMock).ListExpiredRepositories(time.Now())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestDeleteWorkspace(t *testing.T) {
+	t.Run("should success delete workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, newArchiveRepository(databaseMock).DeleteWorkspace(uuid.New()))
+	})
+
+	t.Run("should return error when failed to delete workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newArchiveRepository(databaseMock).DeleteWorkspace(uuid.New()))
+	})
+}
+
+func TestDeleteRepository(t *testing.T) {
+	t.Run("should success delete repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(&response.Response{})
+
+		assert.NoError(t, newArchiveRepository(databaseMock).DeleteRepository(uuid.New()))
+	})
+
+	t.Run("should return error when failed to delete repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Delete").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		assert.Error(t, newArchiveRepository(databaseMock).DeleteRepository(uuid.New()))
+	})
+}
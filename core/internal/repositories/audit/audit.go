@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+)
+
+type IRepository interface {
+	GetLastHash() (string, error)
+	IsEventSaved(eventID uuid.UUID) (bool, error)
+	ListRecords(filter *auditEntities.Filter) (*[]auditEntities.Record, error)
+	CountRecords(filter *auditEntities.Filter) (int, error)
+	ListChainAfter(sequence int64, limit int) (*[]auditEntities.Record, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	useCases     auditUseCases.IUseCases
+}
+
+func NewAuditRepository(connection *database.Connection, useCases auditUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		useCases:     useCases,
+	}
+}
+
+// GetLastHash returns the genesis hash while the chain is still empty
+func (r *Repository) GetLastHash() (string, error) {
+	record := &auditEntities.Record{}
+
+	err := r.databaseRead.Raw(r.queryGetLastRecord(), record).GetError()
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return auditEnums.GenesisHash, nil
+	}
+
+	return record.Hash, err
+}
+
+func (r *Repository) queryGetLastRecord() string {
+	return `
+			SELECT *
+			FROM audit_events
+			ORDER BY sequence DESC
+			LIMIT 1
+	`
+}
+
+func (r *Repository) IsEventSaved(eventID uuid.UUID) (bool, error) {
+	record := &auditEntities.Record{}
+
+	err := r.databaseRead.First(record, r.useCases.FilterEventByID(eventID),
+		auditEnums.DatabaseAuditTable).GetError()
+	if err == databaseEnums.ErrorNotFoundRecords {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (r *Repository) ListRecords(filter *auditEntities.Filter) (*[]auditEntities.Record, error) {
+	records := &[]auditEntities.Record{}
+	where, params := filter.GetWhereFilterQuery()
+
+	return records, r.databaseRead.Raw(fmt.Sprintf(r.queryListRecords(), where), records,
+		append(params, filter.Size, filter.Page*filter.Size)...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListRecords() string {
+	return `
+			SELECT *
+			FROM audit_events
+			WHERE %s
+			ORDER BY sequence DESC
+			LIMIT ? OFFSET ?
+	`
+}
+
+func (r *Repository) CountRecords(filter *auditEntities.Filter) (count int, err error) {
+	where, params := filter.GetWhereFilterQuery()
+
+	return count, r.databaseRead.Raw(fmt.Sprintf(r.queryCountRecords(), where), &count,
+		params...).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryCountRecords() string {
+	return `
+			SELECT COUNT(*)
+			FROM audit_events
+			WHERE %s
+	`
+}
+
+// ListChainAfter returns the records in the order they were chained, used to verify the chain in batches
+func (r *Repository) ListChainAfter(sequence int64, limit int) (*[]auditEntities.Record, error) {
+	records := &[]auditEntities.Record{}
+
+	return records, r.databaseRead.Raw(r.queryListChainAfter(), records, sequence,
+		limit).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListChainAfter() string {
+	return `
+			SELECT *
+			FROM audit_events
+			WHERE sequence > ?
+			ORDER BY sequence ASC
+			LIMIT ?
+	`
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetLastHash() (string, error) {
+	args := m.MethodCalled("GetLastHash")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) IsEventSaved(_ uuid.UUID) (bool, error) {
+	args := m.MethodCalled("IsEventSaved")
+	return args.Get(0).(bool), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListRecords(_ *auditEntities.Filter) (*[]auditEntities.Record, error) {
+	args := m.MethodCalled("ListRecords")
+	return args.Get(0).(*[]auditEntities.Record), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) CountRecords(_ *auditEntities.Filter) (int, error) {
+	args := m.MethodCalled("CountRecords")
+	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListChainAfter(_ int64, _ int) (*[]auditEntities.Record, error) {
+	args := m.MethodCalled("ListChainAfter")
+	return args.Get(0).(*[]auditEntities.Record), mockUtils.ReturnNilOrError(args, 1)
+}
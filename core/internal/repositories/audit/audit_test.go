@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+	auditUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/audit"
+)
+
+func newAuditRepository(databaseMock *database.Mock) IRepository {
+	return NewAuditRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+		auditUseCases.NewAuditUseCases())
+}
+
+func TestNewAuditRepository(t *testing.T) {
+	t.Run("should success create a new audit repository", func(t *testing.T) {
+		assert.NotNil(t, newAuditRepository(&database.Mock{}))
+	})
+}
+
+func TestGetLastHash(t *testing.T) {
+	t.Run("should success get the hash of the last record", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		hash, err := newAuditRepository(databaseMock).GetLastHash()
+		assert.NoError(t, err)
+		assert.Empty(t, hash)
+	})
+
+	t.Run("should return the genesis hash when the chain is empty", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		hash, err := newAuditRepository(databaseMock).GetLastHash()
+		assert.NoError(t, err)
+		assert.Equal(t, auditEnums.GenesisHash, hash)
+	})
+
+	t.Run("should return error when failed to get the last record", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newAuditRepository(databaseMock).GetLastHash()
+		assert.Error(t, err)
+	})
+}
+
+func TestIsEventSaved(t *testing.T) {
+	t.Run("should return true when the event was already saved", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(&response.Response{})
+
+		saved, err := newAuditRepository(databaseMock).IsEventSaved(uuid.New())
+		assert.NoError(t, err)
+		assert.True(t, saved)
+	})
+
+	t.Run("should return false when the event was not saved yet", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(0, databaseEnums.ErrorNotFoundRecords, nil))
+
+		saved, err := newAuditRepository(databaseMock).IsEventSaved(uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, saved)
+	})
+
+	t.Run("should return error when failed to find the event", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("First").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		saved, err := newAuditRepository(databaseMock).IsEventSaved(uuid.New())
+		assert.Error(t, err)
+		assert.False(t, saved)
+	})
+}
+
+func TestListRecords(t *testing.T) {
+	t.Run("should success list records", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		result, err := newAuditRepository(databaseMock).ListRecords(&auditEntities.Filter{Size: 10})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestCountRecords(t *testing.T) {
+	t.Run("should success count records", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		_, err := newAuditRepository(databaseMock).CountRecords(&auditEntities.Filter{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestListChainAfter(t *testing.T) {
+	t.Run("should success list the records of the chain", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		result, err := newAuditRepository(databaseMock).ListChainAfter(0, 10)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
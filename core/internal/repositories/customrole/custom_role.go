@@ -0,0 +1,51 @@
+package customrole
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+)
+
+type IRepository interface {
+	GetCustomRole(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.CustomRole, error)
+	GetCustomRoleByName(workspaceID uuid.UUID, name string) (*customRoleEntities.CustomRole, error)
+	ListCustomRoles(workspaceID uuid.UUID) (*[]customRoleEntities.Response, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	useCases     customRoleUseCases.IUseCases
+}
+
+func NewCustomRoleRepository(connection *database.Connection, useCases customRoleUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		useCases:     useCases,
+	}
+}
+
+func (r *Repository) GetCustomRole(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.CustomRole, error) {
+	customRole := &customRoleEntities.CustomRole{}
+
+	return customRole, r.databaseRead.Find(customRole, r.useCases.FilterCustomRoleByID(customRoleID, workspaceID),
+		customRoleEnums.DatabaseCustomRoleTable).GetError()
+}
+
+func (r *Repository) GetCustomRoleByName(workspaceID uuid.UUID,
+	name string) (*customRoleEntities.CustomRole, error) {
+	customRole := &customRoleEntities.CustomRole{}
+
+	return customRole, r.databaseRead.Find(customRole, r.useCases.FilterCustomRoleByName(workspaceID, name),
+		customRoleEnums.DatabaseCustomRoleTable).GetError()
+}
+
+func (r *Repository) ListCustomRoles(workspaceID uuid.UUID) (*[]customRoleEntities.Response, error) {
+	customRoles := &[]customRoleEntities.Response{}
+
+	return customRoles, r.databaseRead.Find(customRoles, r.useCases.FilterListCustomRoles(workspaceID),
+		customRoleEnums.DatabaseCustomRoleTable).GetErrorExceptNotFound()
+}
@@ -0,0 +1,29 @@
+package customrole
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetCustomRole(_, _ uuid.UUID) (*customRoleEntities.CustomRole, error) {
+	args := m.MethodCalled("GetCustomRole")
+	return args.Get(0).(*customRoleEntities.CustomRole), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetCustomRoleByName(_ uuid.UUID, _ string) (*customRoleEntities.CustomRole, error) {
+	args := m.MethodCalled("GetCustomRoleByName")
+	return args.Get(0).(*customRoleEntities.CustomRole), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListCustomRoles(_ uuid.UUID) (*[]customRoleEntities.Response, error) {
+	args := m.MethodCalled("ListCustomRoles")
+	return args.Get(0).(*[]customRoleEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
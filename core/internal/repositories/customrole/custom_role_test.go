@@ -0,0 +1,62 @@
+package customrole
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	customRoleUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/customrole"
+)
+
+func TestNewCustomRoleRepository(t *testing.T) {
+	t.Run("should success create a custom role repository", func(t *testing.T) {
+		assert.NotNil(t, NewCustomRoleRepository(&database.Connection{}, customRoleUseCases.NewCustomRoleUseCases()))
+	})
+}
+
+func TestGetCustomRole(t *testing.T) {
+	t.Run("should success get a custom role", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &customRoleEntities.CustomRole{}))
+
+		repository := NewCustomRoleRepository(&database.Connection{Read: databaseMock},
+			customRoleUseCases.NewCustomRoleUseCases())
+
+		result, err := repository.GetCustomRole(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetCustomRoleByName(t *testing.T) {
+	t.Run("should success get a custom role by name", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &customRoleEntities.CustomRole{}))
+
+		repository := NewCustomRoleRepository(&database.Connection{Read: databaseMock},
+			customRoleUseCases.NewCustomRoleUseCases())
+
+		result, err := repository.GetCustomRoleByName(uuid.New(), "test")
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListCustomRoles(t *testing.T) {
+	t.Run("should success list custom roles", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &[]customRoleEntities.Response{}))
+
+		repository := NewCustomRoleRepository(&database.Connection{Read: databaseMock},
+			customRoleUseCases.NewCustomRoleUseCases())
+
+		result, err := repository.ListCustomRoles(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
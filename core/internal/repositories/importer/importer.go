@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+)
+
+type IRepository interface {
+	GetImport(importID, workspaceID uuid.UUID) (*importerEntities.Import, error)
+	GetImportByOrganization(data *importerEntities.Data) (*importerEntities.Import, error)
+	ListImports(workspaceID uuid.UUID) (*[]importerEntities.Response, error)
+	ClaimPeriodicImports(syncedBefore time.Time) (*[]importerEntities.Import, error)
+	ListImportedRepositories(importID uuid.UUID) (*[]importerEntities.ImportedRepository, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	useCases     importerUseCases.IUseCases
+}
+
+func NewImporterRepository(connection *database.Connection, useCases importerUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		useCases:     useCases,
+	}
+}
+
+func (r *Repository) GetImport(importID, workspaceID uuid.UUID) (*importerEntities.Import, error) {
+	importation := &importerEntities.Import{}
+
+	return importation, r.databaseRead.Find(importation, r.useCases.FilterImportByID(importID, workspaceID),
+		importerEnums.DatabaseImportTable).GetError()
+}
+
+func (r *Repository) GetImportByOrganization(data *importerEntities.Data) (*importerEntities.Import, error) {
+	importation := &importerEntities.Import{}
+
+	return importation, r.databaseRead.Find(importation, r.useCases.FilterImportByOrganization(data),
+		importerEnums.DatabaseImportTable).GetError()
+}
+
+func (r *Repository) ListImports(workspaceID uuid.UUID) (*[]importerEntities.Response, error) {
+	imports := &[]importerEntities.Response{}
+
+	return imports, r.databaseRead.Find(imports, r.useCases.FilterListImports(workspaceID),
+		importerEnums.DatabaseImportTable).GetErrorExceptNotFound()
+}
+
+// ClaimPeriodicImports sets as synced the imports with periodic sync that were not synced after the informed time and
+// returns them, so the replicas running at the same time never sync the same import twice
+func (r *Repository) ClaimPeriodicImports(syncedBefore time.Time) (*[]importerEntities.Import, error) {
+	imports := &[]importerEntities.Import{}
+
+	return imports, r.databaseRead.Raw(r.queryClaimPeriodicImports(), imports, time.Now(), time.Now(),
+		syncedBefore).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryClaimPeriodicImports() string {
+	return `
+			UPDATE repository_imports SET last_sync_at = ?, updated_at = ?
+			WHERE periodic_sync = true AND (last_sync_at IS NULL OR last_sync_at < ?)
+			RETURNING *
+	`
+}
+
+// ListImportedRepositories returns the repositories created by the import that were not archived yet
+func (r *Repository) ListImportedRepositories(importID uuid.UUID) (*[]importerEntities.ImportedRepository, error) {
+	repositories := &[]importerEntities.ImportedRepository{}
+
+	return repositories, r.databaseRead.Raw(r.queryListImportedRepositories(), repositories,
+		importID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListImportedRepositories() string {
+	return `
+			SELECT repository_id, name
+			FROM repositories
+			WHERE import_id = ? AND archived_at IS NULL
+	`
+}
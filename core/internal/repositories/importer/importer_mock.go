@@ -0,0 +1,41 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetImport(_, _ uuid.UUID) (*importerEntities.Import, error) {
+	args := m.MethodCalled("GetImport")
+	return args.Get(0).(*importerEntities.Import), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetImportByOrganization(_ *importerEntities.Data) (*importerEntities.Import, error) {
+	args := m.MethodCalled("GetImportByOrganization")
+	return args.Get(0).(*importerEntities.Import), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListImports(_ uuid.UUID) (*[]importerEntities.Response, error) {
+	args := m.MethodCalled("ListImports")
+	return args.Get(0).(*[]importerEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ClaimPeriodicImports(_ time.Time) (*[]importerEntities.Import, error) {
+	args := m.MethodCalled("ClaimPeriodicImports")
+	return args.Get(0).(*[]importerEntities.Import), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListImportedRepositories(_ uuid.UUID) (*[]importerEntities.ImportedRepository, error) {
+	args := m.MethodCalled("ListImportedRepositories")
+	return args.Get(0).(*[]importerEntities.ImportedRepository), mockUtils.ReturnNilOrError(args, 1)
+}
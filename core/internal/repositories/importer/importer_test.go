@@ -0,0 +1,91 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/importer"
+)
+
+func TestNewImporterRepository(t *testing.T) {
+	t.Run("should success create a importer repository", func(t *testing.T) {
+		assert.NotNil(t, NewImporterRepository(&database.Connection{}, importerUseCases.NewImporterUseCases()))
+	})
+}
+
+func TestGetImport(t *testing.T) {
+	t.Run("should success get a import", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &importerEntities.Import{}))
+
+		repository := NewImporterRepository(&database.Connection{Read: databaseMock},
+			importerUseCases.NewImporterUseCases())
+
+		result, err := repository.GetImport(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetImportByOrganization(t *testing.T) {
+	t.Run("should success get a import by organization", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &importerEntities.Import{}))
+
+		repository := NewImporterRepository(&database.Connection{Read: databaseMock},
+			importerUseCases.NewImporterUseCases())
+
+		result, err := repository.GetImportByOrganization(&importerEntities.Data{})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListImports(t *testing.T) {
+	t.Run("should success list the imports of a workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &[]importerEntities.Response{}))
+
+		repository := NewImporterRepository(&database.Connection{Read: databaseMock},
+			importerUseCases.NewImporterUseCases())
+
+		result, err := repository.ListImports(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestClaimPeriodicImports(t *testing.T) {
+	t.Run("should success claim the imports with periodic sync", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, &[]importerEntities.Import{}))
+
+		repository := NewImporterRepository(&database.Connection{Read: databaseMock},
+			importerUseCases.NewImporterUseCases())
+
+		result, err := repository.ClaimPeriodicImports(time.Now())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListImportedRepositories(t *testing.T) {
+	t.Run("should success list the repositories of a import", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, &[]importerEntities.ImportedRepository{}))
+
+		repository := NewImporterRepository(&database.Connection{Read: databaseMock},
+			importerUseCases.NewImporterUseCases())
+
+		result, err := repository.ListImportedRepositories(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
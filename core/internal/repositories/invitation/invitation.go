@@ -0,0 +1,156 @@
+package invitation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+)
+
+type IRepository interface {
+	GetInvitation(invitationID uuid.UUID) (*invitationEntities.Invitation, error)
+	GetInvitationByTarget(invitationID, workspaceID, repositoryID uuid.UUID) (*invitationEntities.Invitation, error)
+	ListInvitations(workspaceID, repositoryID uuid.UUID) (*[]invitationEntities.Response, error)
+	HasPendingInvitation(email string, workspaceID, repositoryID uuid.UUID) (bool, error)
+	ListPendingInvitationsByEmail(email string) (*[]invitationEntities.Invitation, error)
+	BindInvitation(transaction database.IDatabaseWrite, invitation *invitationEntities.Invitation,
+		accountID uuid.UUID) error
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	useCases     invitationUseCases.IUseCases
+}
+
+func NewInvitationRepository(connection *database.Connection, useCases invitationUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		useCases:     useCases,
+	}
+}
+
+func (r *Repository) GetInvitation(invitationID uuid.UUID) (*invitationEntities.Invitation, error) {
+	invitation := &invitationEntities.Invitation{}
+
+	return invitation, r.databaseRead.Find(invitation, r.useCases.FilterInvitationByID(invitationID),
+		invitationEnums.DatabaseInvitationTable).GetError()
+}
+
+func (r *Repository) GetInvitationByTarget(invitationID, workspaceID,
+	repositoryID uuid.UUID) (*invitationEntities.Invitation, error) {
+	invitation := &invitationEntities.Invitation{}
+
+	return invitation, r.databaseRead.Find(invitation, r.useCases.FilterInvitationByTarget(
+		invitationID, workspaceID, repositoryID), invitationEnums.DatabaseInvitationTable).GetError()
+}
+
+func (r *Repository) ListInvitations(workspaceID, repositoryID uuid.UUID) (*[]invitationEntities.Response, error) {
+	invitations := &[]invitationEntities.Response{}
+
+	return invitations, r.databaseRead.Find(invitations, r.useCases.FilterListInvitations(workspaceID, repositoryID),
+		invitationEnums.DatabaseInvitationTable).GetErrorExceptNotFound()
+}
+
+func (r *Repository) HasPendingInvitation(email string, workspaceID, repositoryID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := r.databaseRead.Raw(r.queryHasPendingInvitation(), &count, email, workspaceID, repositoryID,
+		invitationEnums.StatusPending, time.Now()).GetErrorExceptNotFound()
+
+	return count > 0, err
+}
+
+func (r *Repository) queryHasPendingInvitation() string {
+	return `
+		SELECT COUNT(*)
+		FROM invitations
+		WHERE LOWER(email) = LOWER(?) AND workspace_id = ?
+		AND COALESCE(repository_id, '00000000-0000-0000-0000-000000000000') = ?
+		AND status = ? AND expires_at > ?
+	`
+}
+
+// ListPendingInvitationsByEmail returns the pending invitations not expired sent to the email ignoring the case
+func (r *Repository) ListPendingInvitationsByEmail(email string) (*[]invitationEntities.Invitation, error) {
+	invitations := &[]invitationEntities.Invitation{}
+
+	return invitations, r.databaseRead.Raw(r.queryListPendingInvitationsByEmail(), invitations, email,
+		invitationEnums.StatusPending, time.Now()).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListPendingInvitationsByEmail() string {
+	return `
+		SELECT *
+		FROM invitations
+		WHERE LOWER(email) = LOWER(?) AND status = ? AND expires_at > ?
+		ORDER BY created_at
+	`
+}
+
+// BindInvitation gives the account the invited role in the transaction, a repository invitation also makes the
+// account a member of its workspace. The roles the account already has are kept
+func (r *Repository) BindInvitation(transaction database.IDatabaseWrite, invitation *invitationEntities.Invitation,
+	accountID uuid.UUID) error {
+	if err := r.bindWorkspace(transaction, invitation, accountID); err != nil {
+		return err
+	}
+
+	if !invitation.IsRepositoryInvitation() {
+		return nil
+	}
+
+	return r.bindRepository(transaction, invitation, accountID)
+}
+
+func (r *Repository) bindWorkspace(transaction database.IDatabaseWrite, invitation *invitationEntities.Invitation,
+	accountID uuid.UUID) error {
+	isMember, err := r.isMember(r.queryIsWorkspaceMember(), accountID, invitation.WorkspaceID)
+	if err != nil || isMember {
+		return err
+	}
+
+	return transaction.Create(invitation.ToAccountWorkspace(accountID),
+		workspaceEnums.DatabaseAccountWorkspaceTable).GetError()
+}
+
+func (r *Repository) bindRepository(transaction database.IDatabaseWrite, invitation *invitationEntities.Invitation,
+	accountID uuid.UUID) error {
+	isMember, err := r.isMember(r.queryIsRepositoryMember(), accountID, *invitation.RepositoryID)
+	if err != nil || isMember {
+		return err
+	}
+
+	return transaction.Create(invitation.ToAccountRepository(accountID),
+		repositoryEnums.DatabaseAccountRepositoryTable).GetError()
+}
+
+func (r *Repository) isMember(query string, accountID, targetID uuid.UUID) (bool, error) {
+	var count int64
+
+	err := r.databaseRead.Raw(query, &count, accountID, targetID).GetErrorExceptNotFound()
+
+	return count > 0, err
+}
+
+func (r *Repository) queryIsWorkspaceMember() string {
+	return `
+		SELECT COUNT(*)
+		FROM account_workspace
+		WHERE account_id = ? AND workspace_id = ?
+	`
+}
+
+func (r *Repository) queryIsRepositoryMember() string {
+	return `
+		SELECT COUNT(*)
+		FROM account_repository
+		WHERE account_id = ? AND repository_id = ?
+	`
+}
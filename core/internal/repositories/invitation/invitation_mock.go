@@ -0,0 +1,45 @@
+package invitation
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetInvitation(_ uuid.UUID) (*invitationEntities.Invitation, error) {
+	args := m.MethodCalled("GetInvitation")
+	return args.Get(0).(*invitationEntities.Invitation), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetInvitationByTarget(_, _, _ uuid.UUID) (*invitationEntities.Invitation, error) {
+	args := m.MethodCalled("GetInvitationByTarget")
+	return args.Get(0).(*invitationEntities.Invitation), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListInvitations(_, _ uuid.UUID) (*[]invitationEntities.Response, error) {
+	args := m.MethodCalled("ListInvitations")
+	return args.Get(0).(*[]invitationEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) HasPendingInvitation(_ string, _, _ uuid.UUID) (bool, error) {
+	args := m.MethodCalled("HasPendingInvitation")
+	return args.Get(0).(bool), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListPendingInvitationsByEmail(_ string) (*[]invitationEntities.Invitation, error) {
+	args := m.MethodCalled("ListPendingInvitationsByEmail")
+	return args.Get(0).(*[]invitationEntities.Invitation), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) BindInvitation(_ database.IDatabaseWrite, _ *invitationEntities.Invitation, _ uuid.UUID) error {
+	args := m.MethodCalled("BindInvitation")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,175 @@
+package invitation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/invitation"
+)
+
+func TestNewInvitationRepository(t *testing.T) {
+	t.Run("should success create a invitation repository", func(t *testing.T) {
+		assert.NotNil(t, NewInvitationRepository(&database.Connection{}, invitationUseCases.NewInvitationUseCases()))
+	})
+}
+
+func TestGetInvitation(t *testing.T) {
+	t.Run("should success get a invitation", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &invitationEntities.Invitation{}))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		result, err := repository.GetInvitation(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetInvitationByTarget(t *testing.T) {
+	t.Run("should success get a invitation by workspace and repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &invitationEntities.Invitation{}))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		result, err := repository.GetInvitationByTarget(uuid.New(), uuid.New(), uuid.Nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListInvitations(t *testing.T) {
+	t.Run("should success list invitations", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &[]invitationEntities.Response{}))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		result, err := repository.ListInvitations(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestHasPendingInvitation(t *testing.T) {
+	t.Run("should return false when there is no pending invitation", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		result, err := repository.HasPendingInvitation("test@test.com", uuid.New(), uuid.Nil)
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("should return error when failed to count", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		_, err := repository.HasPendingInvitation("test@test.com", uuid.New(), uuid.Nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestListPendingInvitationsByEmail(t *testing.T) {
+	t.Run("should success list pending invitations by email", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		invitations, err := repository.ListPendingInvitationsByEmail("test@test.com")
+		assert.NoError(t, err)
+		assert.NotNil(t, invitations)
+	})
+
+	t.Run("should return error when failed to list pending invitations", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		_, err := repository.ListPendingInvitationsByEmail("test@test.com")
+		assert.Error(t, err)
+	})
+}
+
+func TestBindInvitation(t *testing.T) {
+	repositoryID := uuid.New()
+
+	t.Run("should bind workspace and repository roles in the transaction", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		transactionMock := &database.Mock{}
+		transactionMock.On("Create").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		assert.NoError(t, repository.BindInvitation(transactionMock,
+			&invitationEntities.Invitation{RepositoryID: &repositoryID}, uuid.New()))
+		transactionMock.AssertNumberOfCalls(t, "Create", 2)
+	})
+
+	t.Run("should bind only the workspace role when workspace invitation", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		transactionMock := &database.Mock{}
+		transactionMock.On("Create").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		assert.NoError(t, repository.BindInvitation(transactionMock, &invitationEntities.Invitation{}, uuid.New()))
+		transactionMock.AssertNumberOfCalls(t, "Create", 1)
+	})
+
+	t.Run("should return error when failed to check the workspace membership", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		transactionMock := &database.Mock{}
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		assert.Error(t, repository.BindInvitation(transactionMock,
+			&invitationEntities.Invitation{RepositoryID: &repositoryID}, uuid.New()))
+		transactionMock.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("should return error when failed to bind workspace role", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		transactionMock := &database.Mock{}
+		transactionMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewInvitationRepository(&database.Connection{Read: databaseMock},
+			invitationUseCases.NewInvitationUseCases())
+
+		assert.Error(t, repository.BindInvitation(transactionMock,
+			&invitationEntities.Invitation{RepositoryID: &repositoryID}, uuid.New()))
+		transactionMock.AssertNumberOfCalls(t, "Create", 1)
+	})
+}
@@ -0,0 +1,95 @@
+package quota
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
+)
+
+type IRepository interface {
+	GetQuota(workspaceID uuid.UUID) (*quotaEntities.Quota, error)
+	GetUsage(workspaceID uuid.UUID) (*quotaEntities.Usage, error)
+	ListUsages() (*[]quotaEntities.Usage, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	useCases     quotaUseCases.IUseCases
+}
+
+func NewQuotaRepository(connection *database.Connection, useCases quotaUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		useCases:     useCases,
+	}
+}
+
+func (r *Repository) GetQuota(workspaceID uuid.UUID) (*quotaEntities.Quota, error) {
+	quota := &quotaEntities.Quota{}
+
+	return quota, r.databaseRead.Find(quota, r.useCases.FilterQuotaByWorkspaceID(workspaceID),
+		quotaEnums.DatabaseWorkspaceQuotaTable).GetError()
+}
+
+// GetUsage returns not found when the workspace does not exist, workspaces without quota have all limits as null
+func (r *Repository) GetUsage(workspaceID uuid.UUID) (*quotaEntities.Usage, error) {
+	usage := &quotaEntities.Usage{}
+
+	result := r.databaseRead.Raw(fmt.Sprintf(r.queryGetUsages(), "WHERE ws.workspace_id = ?"), usage, workspaceID)
+	if result.GetError() != nil {
+		return nil, result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return nil, databaseEnums.ErrorNotFoundRecords
+	}
+
+	return usage, nil
+}
+
+func (r *Repository) ListUsages() (*[]quotaEntities.Usage, error) {
+	usages := &[]quotaEntities.Usage{}
+
+	return usages, r.databaseRead.Raw(fmt.Sprintf(r.queryGetUsages(), ""), usages).GetErrorExceptNotFound()
+}
+
+//nolint:funlen // query needs more than 15 lines
+func (r *Repository) queryGetUsages() string {
+	return `
+			SELECT ws.workspace_id, ws.name AS workspace_name, quota.max_repositories, quota.max_analyses_per_day,
+				quota.max_vulnerabilities_per_analysis, quota.max_tokens, quota.max_webhooks,
+				(
+					SELECT COUNT(*) FROM repositories AS repo WHERE repo.workspace_id = ws.workspace_id
+				) AS repositories,
+				(
+					SELECT COUNT(*) FROM analysis AS an
+					WHERE an.workspace_id = ws.workspace_id AND an.created_at >= CURRENT_DATE
+				) AS analyses_today,
+				(
+					SELECT COALESCE(MAX(totals.total), 0) FROM (
+						SELECT COUNT(*) AS total FROM analysis AS an
+						INNER JOIN analysis_vulnerabilities AS av ON av.analysis_id = an.analysis_id
+						WHERE an.workspace_id = ws.workspace_id AND an.created_at >= CURRENT_DATE
+						GROUP BY an.analysis_id
+					) AS totals
+				) AS vulnerabilities_per_analysis,
+				(
+					SELECT COUNT(*) FROM tokens AS tk
+					WHERE tk.workspace_id = ws.workspace_id AND (tk.is_expirable = false OR tk.expires_at >= NOW())
+				) AS tokens,
+				(
+					SELECT COUNT(*) FROM webhooks AS wh WHERE wh.workspace_id = ws.workspace_id
+				) AS webhooks
+			FROM workspaces AS ws
+			LEFT JOIN workspace_quotas AS quota ON quota.workspace_id = ws.workspace_id
+			%s
+			ORDER BY ws.name
+	`
+}
@@ -0,0 +1,29 @@
+package quota
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetQuota(_ uuid.UUID) (*quotaEntities.Quota, error) {
+	args := m.MethodCalled("GetQuota")
+	return args.Get(0).(*quotaEntities.Quota), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetUsage(_ uuid.UUID) (*quotaEntities.Usage, error) {
+	args := m.MethodCalled("GetUsage")
+	return args.Get(0).(*quotaEntities.Usage), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListUsages() (*[]quotaEntities.Usage, error) {
+	args := m.MethodCalled("ListUsages")
+	return args.Get(0).(*[]quotaEntities.Usage), mockUtils.ReturnNilOrError(args, 1)
+}
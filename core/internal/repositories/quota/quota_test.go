@@ -0,0 +1,83 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	quotaUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/quota"
+)
+
+func TestNewQuotaRepository(t *testing.T) {
+	t.Run("should success create a quota repository", func(t *testing.T) {
+		assert.NotNil(t, NewQuotaRepository(&database.Connection{}, quotaUseCases.NewQuotaUseCases()))
+	})
+}
+
+func TestGetQuota(t *testing.T) {
+	t.Run("should success get a quota", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &quotaEntities.Quota{}))
+
+		repository := NewQuotaRepository(&database.Connection{Read: databaseMock}, quotaUseCases.NewQuotaUseCases())
+
+		result, err := repository.GetQuota(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetUsage(t *testing.T) {
+	t.Run("should success get the usage of a workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewQuotaRepository(&database.Connection{Read: databaseMock}, quotaUseCases.NewQuotaUseCases())
+
+		result, err := repository.GetUsage(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("should return not found when workspace does not exist", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, nil, nil))
+
+		repository := NewQuotaRepository(&database.Connection{Read: databaseMock}, quotaUseCases.NewQuotaUseCases())
+
+		result, err := repository.GetUsage(uuid.New())
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("should return error when failed to get usage", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewQuotaRepository(&database.Connection{Read: databaseMock}, quotaUseCases.NewQuotaUseCases())
+
+		result, err := repository.GetUsage(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestListUsages(t *testing.T) {
+	t.Run("should success list the usages of all workspaces", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, nil))
+
+		repository := NewQuotaRepository(&database.Connection{Read: databaseMock}, quotaUseCases.NewQuotaUseCases())
+
+		result, err := repository.ListUsages()
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
@@ -9,10 +9,13 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
 	repositoryEnums "github.com/ZupIT/horusec-platform/core/internal/enums/repository"
+	teamRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/team"
 	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
 	repositoriesUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 )
@@ -21,12 +24,19 @@ type IRepository interface {
 	GetRepositoryByName(workspaceID uuid.UUID, name string) (*repositoryEntities.Repository, error)
 	GetRepository(repositoryID uuid.UUID) (*repositoryEntities.Repository, error)
 	GetAccountRepository(accountID, repositoryID uuid.UUID) (*repositoryEntities.AccountRepository, error)
-	ListRepositoriesAuthTypeHorusec(accountID, workspaceID uuid.UUID) (*[]repositoryEntities.Response, error)
-	ListRepositoriesAuthTypeLdap(workspaceID uuid.UUID, permissions []string) (*[]repositoryEntities.Response, error)
+	GetAccountRepositoryRole(accountID, repositoryID uuid.UUID) (account.Role, error)
+	ListRepositoriesAuthTypeHorusec(accountID, workspaceID uuid.UUID,
+		labels pq.StringArray) (*[]repositoryEntities.Response, error)
+	ListRepositoriesAuthTypeLdap(workspaceID uuid.UUID, permissions []string,
+		labels pq.StringArray) (*[]repositoryEntities.Response, error)
 	IsNotMemberOfWorkspace(accountID, workspaceID uuid.UUID) bool
 	ListAllRepositoryUsers(repositoryID uuid.UUID) (*[]roleEntities.Response, error)
 	GetWorkspace(workspaceID uuid.UUID) (*workspaceEntities.Workspace, error)
-	ListRepositoriesWhenApplicationAdmin() (*[]repositoryEntities.Response, error)
+	ListRepositoriesWhenApplicationAdmin(labels pq.StringArray) (*[]repositoryEntities.Response, error)
+	ListArchivedRepositories(workspaceID uuid.UUID) (*[]repositoryEntities.Response, error)
+	GetTeam(teamID, workspaceID uuid.UUID) (*teamEntities.Team, error)
+	GetCustomRole(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.CustomRole, error)
+	ListAccountsOutsideWorkspace(repositoryID, workspaceID uuid.UUID) ([]uuid.UUID, error)
 }
 
 type Repository struct {
@@ -34,15 +44,17 @@ type Repository struct {
 	databaseWrite       database.IDatabaseWrite
 	useCases            repositoriesUseCases.IUseCases
 	workspaceRepository workspaceRepository.IRepository
+	teamRepository      teamRepository.IRepository
 }
 
-func NewRepositoryRepository(databaseConnection *database.Connection,
-	useCases repositoriesUseCases.IUseCases, repository workspaceRepository.IRepository) IRepository {
+func NewRepositoryRepository(databaseConnection *database.Connection, useCases repositoriesUseCases.IUseCases,
+	repository workspaceRepository.IRepository, repositoryTeam teamRepository.IRepository) IRepository {
 	return &Repository{
 		databaseRead:        databaseConnection.Read,
 		databaseWrite:       databaseConnection.Write,
 		useCases:            useCases,
 		workspaceRepository: repository,
+		teamRepository:      repositoryTeam,
 	}
 }
 
@@ -68,63 +80,102 @@ func (r *Repository) GetAccountRepository(accountID,
 		accountID, repositoryID), repositoryEnums.DatabaseAccountRepositoryTable).GetError()
 }
 
-func (r *Repository) ListRepositoriesAuthTypeHorusec(accountID,
-	workspaceID uuid.UUID) (*[]repositoryEntities.Response, error) {
+// GetAccountRepositoryRole returns the highest role of the account in the repository, considering the role granted
+// directly to the account and the roles granted to its teams
+func (r *Repository) GetAccountRepositoryRole(accountID, repositoryID uuid.UUID) (account.Role, error) {
+	accountRepository := &repositoryEntities.AccountRepository{}
+
+	return accountRepository.Role, r.databaseRead.Raw(r.queryGetAccountRepositoryRole(), accountRepository,
+		sql.Named("accountID", accountID), sql.Named("repositoryID", repositoryID)).GetError()
+}
+
+func (r *Repository) queryGetAccountRepositoryRole() string {
+	return `
+			SELECT roles.role
+			FROM (
+				SELECT ar.role FROM account_repository AS ar
+				WHERE ar.account_id = @accountID AND ar.repository_id = @repositoryID
+				UNION ALL
+				SELECT tr.role FROM team_repository AS tr
+				INNER JOIN team_account AS ta ON ta.team_id = tr.team_id
+				WHERE ta.account_id = @accountID AND tr.repository_id = @repositoryID
+			) AS roles
+			ORDER BY CASE roles.role WHEN 'admin' THEN 1 WHEN 'supervisor' THEN 2 ELSE 3 END
+			LIMIT 1
+	`
+}
+
+func (r *Repository) ListRepositoriesAuthTypeHorusec(accountID, workspaceID uuid.UUID,
+	labels pq.StringArray) (*[]repositoryEntities.Response, error) {
 	accountWorkspace, err := r.workspaceRepository.GetAccountWorkspace(accountID, workspaceID)
 	if err != nil {
 		return nil, err
 	}
 
 	if accountWorkspace.Role == account.Admin {
-		return r.listRepositoriesWhenWorkspaceAdmin(accountID, workspaceID)
+		return r.listRepositoriesWhenWorkspaceAdmin(accountID, workspaceID, labels)
 	}
 
-	return r.listRepositoriesByRoles(accountID, workspaceID)
+	return r.listRepositoriesByRoles(accountID, workspaceID, labels)
 }
 
-func (r *Repository) listRepositoriesWhenWorkspaceAdmin(accountID,
-	workspaceID uuid.UUID) (*[]repositoryEntities.Response, error) {
+func (r *Repository) listRepositoriesWhenWorkspaceAdmin(accountID, workspaceID uuid.UUID,
+	labels pq.StringArray) (*[]repositoryEntities.Response, error) {
 	repositories := &[]repositoryEntities.Response{}
 
-	return repositories, r.databaseRead.Raw(
-		r.queryListRepositoriesWhenWorkspaceAdmin(), repositories, accountID, workspaceID).GetErrorExceptNotFound()
+	return repositories, r.databaseRead.Raw(r.queryListRepositoriesWhenWorkspaceAdmin(), repositories,
+		accountID, workspaceID, labels).GetErrorExceptNotFound()
 }
 
 func (r *Repository) queryListRepositoriesWhenWorkspaceAdmin() string {
 	return `
-			SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'admin' AS role, 
-				   repo.created_at, repo.updated_at
+			SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'admin' AS role,
+				   repo.criticality, repo.owner_team_id, repo.data_classification, repo.vcs_url,
+				   repo.default_branch, repo.labels, repo.created_at, repo.updated_at
 			FROM repositories AS repo
 		    INNER JOIN account_workspace AS aw ON aw.workspace_id = repo.workspace_id AND aw.account_id = ?
-			WHERE repo.workspace_id = ?
+			WHERE repo.workspace_id = ? AND repo.archived_at IS NULL AND repo.labels @> ?
 	`
 }
 
-func (r *Repository) listRepositoriesByRoles(accountID,
-	workspaceID uuid.UUID) (*[]repositoryEntities.Response, error) {
+// listRepositoriesByRoles lists the repositories with a role granted to the account or to its teams, keeping only
+// the highest role when the account has more than one in the same repository
+func (r *Repository) listRepositoriesByRoles(accountID, workspaceID uuid.UUID,
+	labels pq.StringArray) (*[]repositoryEntities.Response, error) {
 	repositories := &[]repositoryEntities.Response{}
 
 	return repositories, r.databaseRead.Raw(r.queryListRepositoriesByRoles(), repositories,
-		sql.Named("accountID", accountID), sql.Named("workspaceID", workspaceID)).GetErrorExceptNotFound()
+		sql.Named("accountID", accountID), sql.Named("workspaceID", workspaceID),
+		sql.Named("labels", labels)).GetErrorExceptNotFound()
 }
 
+//nolint:funlen // query needs more than 15 lines
 func (r *Repository) queryListRepositoriesByRoles() string {
 	return `
-			SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, ar.role,
-			  	   repo.created_at, repo.updated_at
+			SELECT DISTINCT ON (repo.repository_id) repo.repository_id, repo.workspace_id, repo.description,
+				   repo.name, roles.role, repo.criticality, repo.owner_team_id, repo.data_classification,
+				   repo.vcs_url, repo.default_branch, repo.labels, repo.created_at, repo.updated_at
 		    FROM repositories AS repo
-			INNER JOIN account_repository AS ar ON ar.repository_id = repo.repository_id AND ar.account_id = @accountID
-			WHERE ar.workspace_id = @workspaceID AND ar.account_id = @accountID
+			INNER JOIN (
+				SELECT ar.repository_id, ar.role FROM account_repository AS ar
+				WHERE ar.workspace_id = @workspaceID AND ar.account_id = @accountID
+				UNION ALL
+				SELECT tr.repository_id, tr.role FROM team_repository AS tr
+				INNER JOIN team_account AS ta ON ta.team_id = tr.team_id
+				WHERE ta.workspace_id = @workspaceID AND ta.account_id = @accountID
+			) AS roles ON roles.repository_id = repo.repository_id
+			WHERE repo.archived_at IS NULL AND repo.labels @> @labels
+			ORDER BY repo.repository_id, CASE roles.role WHEN 'admin' THEN 1 WHEN 'supervisor' THEN 2 ELSE 3 END
 	`
 }
 
-func (r *Repository) ListRepositoriesAuthTypeLdap(workspaceID uuid.UUID,
-	permissions []string) (*[]repositoryEntities.Response, error) {
+func (r *Repository) ListRepositoriesAuthTypeLdap(workspaceID uuid.UUID, permissions []string,
+	labels pq.StringArray) (*[]repositoryEntities.Response, error) {
 	repositories := &[]repositoryEntities.Response{}
 
 	return repositories, r.databaseRead.Raw(r.queryListRepositoriesAuthTypeLdap(), repositories,
-		sql.Named("workspaceID", workspaceID),
-		sql.Named("permissions", pq.StringArray(permissions))).GetErrorExceptNotFound()
+		sql.Named("workspaceID", workspaceID), sql.Named("permissions", pq.StringArray(permissions)),
+		sql.Named("labels", labels)).GetErrorExceptNotFound()
 }
 
 //nolint:funlen // query needs more than 15 lines
@@ -132,18 +183,22 @@ func (r *Repository) queryListRepositoriesAuthTypeLdap() string {
 	return `
 			SELECT * 
 			FROM (
-				SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'admin' AS role,
-					   repo.authz_admin, repo.authz_member, repo.authz_supervisor, repo.created_at, repo.updated_at
+				SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'admin' AS role, repo.criticality,
+					   repo.authz_admin, repo.authz_member, repo.authz_supervisor, repo.owner_team_id,
+					   repo.data_classification, repo.vcs_url, repo.default_branch, repo.labels, repo.created_at, repo.updated_at
 				FROM repositories AS repo
-				WHERE repo.workspace_id = @workspaceID AND @permissions && repo.authz_admin
+				WHERE repo.workspace_id = @workspaceID AND @permissions && repo.authz_admin AND repo.archived_at IS NULL
+				AND repo.labels @> @labels
 			) AS admin
 
 			UNION ALL (
 				SELECT * FROM (
-					SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'supervisor' AS role,
-					       repo.authz_admin, repo.authz_member, repo.authz_supervisor, repo.created_at, repo.updated_at
+					SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'supervisor' AS role, repo.criticality,
+					       repo.authz_admin, repo.authz_member, repo.authz_supervisor, repo.owner_team_id,
+					       repo.data_classification, repo.vcs_url, repo.default_branch, repo.labels, repo.created_at, repo.updated_at
 					FROM repositories AS repo
 					WHERE repo.workspace_id = @workspaceID AND @permissions && repo.authz_supervisor
+					AND repo.archived_at IS NULL AND repo.labels @> @labels
 				) AS supervisor
 				WHERE supervisor.repository_id NOT IN (SELECT repo.workspace_id FROM repositories AS repo 
 					  WHERE repo.workspace_id = @workspaceID AND @permissions && repo.authz_admin) 
@@ -151,10 +206,12 @@ func (r *Repository) queryListRepositoriesAuthTypeLdap() string {
 				UNION ALL
 
 				SELECT * FROM (
-					SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'member' AS role,
-						   repo.authz_admin, repo.authz_member, repo.authz_supervisor, repo.created_at, repo.updated_at
+					SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'member' AS role, repo.criticality,
+						   repo.authz_admin, repo.authz_member, repo.authz_supervisor, repo.owner_team_id,
+						   repo.data_classification, repo.vcs_url, repo.default_branch, repo.labels, repo.created_at, repo.updated_at
 					FROM repositories AS repo
-					WHERE repo.workspace_id = @workspaceID AND @permissions && repo.authz_member	
+					WHERE repo.workspace_id = @workspaceID AND @permissions && repo.authz_member
+					AND repo.archived_at IS NULL AND repo.labels @> @labels
 				) AS member
 					WHERE member.repository_id 
 					NOT IN (
@@ -187,7 +244,7 @@ func (r *Repository) ListAllRepositoryUsers(repositoryID uuid.UUID) (*[]roleEnti
 
 func (r *Repository) queryListAllRepositoryUsers() string {
 	return `
-			SELECT ac.email, ac.username, ar.role, ac.account_id
+			SELECT ac.email, ac.username, ar.role, ar.custom_role_id, ac.account_id
 			FROM accounts AS ac
 			INNER JOIN account_repository AS ar ON ar.account_id = ac.account_id
 			WHERE ar.repository_id = ?
@@ -198,17 +255,74 @@ func (r *Repository) GetWorkspace(workspaceID uuid.UUID) (*workspaceEntities.Wor
 	return r.workspaceRepository.GetWorkspace(workspaceID)
 }
 
-func (r *Repository) ListRepositoriesWhenApplicationAdmin() (*[]repositoryEntities.Response, error) {
+func (r *Repository) GetTeam(teamID, workspaceID uuid.UUID) (*teamEntities.Team, error) {
+	return r.teamRepository.GetTeam(teamID, workspaceID)
+}
+
+func (r *Repository) GetCustomRole(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.CustomRole, error) {
+	return r.workspaceRepository.GetCustomRole(customRoleID, workspaceID)
+}
+
+func (r *Repository) ListRepositoriesWhenApplicationAdmin(
+	labels pq.StringArray) (*[]repositoryEntities.Response, error) {
 	repositories := &[]repositoryEntities.Response{}
 
 	return repositories, r.databaseRead.Raw(
-		r.queryListRepositoriesWhenApplicationAdmin(), repositories).GetErrorExceptNotFound()
+		r.queryListRepositoriesWhenApplicationAdmin(), repositories, labels).GetErrorExceptNotFound()
 }
 
 func (r *Repository) queryListRepositoriesWhenApplicationAdmin() string {
 	return `
-			SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'applicationAdmin' AS role, 
-				   repo.created_at, repo.updated_at
+			SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'applicationAdmin' AS role,
+				   repo.criticality, repo.owner_team_id, repo.data_classification, repo.vcs_url,
+				   repo.default_branch, repo.labels, repo.created_at, repo.updated_at
+			FROM repositories AS repo
+			INNER JOIN workspaces AS ws ON ws.workspace_id = repo.workspace_id
+			WHERE repo.archived_at IS NULL AND ws.archived_at IS NULL AND repo.labels @> ?
+	`
+}
+
+func (r *Repository) ListArchivedRepositories(workspaceID uuid.UUID) (*[]repositoryEntities.Response, error) {
+	repositories := &[]repositoryEntities.Response{}
+
+	return repositories, r.databaseRead.Raw(
+		r.queryListArchivedRepositories(), repositories, workspaceID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListArchivedRepositories() string {
+	return `
+			SELECT repo.repository_id, repo.workspace_id, repo.description, repo.name, 'admin' AS role,
+				   repo.criticality, repo.owner_team_id, repo.data_classification, repo.vcs_url,
+				   repo.default_branch, repo.labels, repo.created_at, repo.updated_at, repo.archived_at
 			FROM repositories AS repo
+			WHERE repo.workspace_id = ? AND repo.archived_at IS NOT NULL
+	`
+}
+
+// ListAccountsOutsideWorkspace returns the accounts with a role in the repository that are not members of the
+// workspace, used on transfer to remove the roles that would give access to a workspace the account does not belong
+func (r *Repository) ListAccountsOutsideWorkspace(repositoryID, workspaceID uuid.UUID) ([]uuid.UUID, error) {
+	accountRepositories := &[]repositoryEntities.AccountRepository{}
+
+	if err := r.databaseRead.Raw(r.queryListAccountsOutsideWorkspace(), accountRepositories, repositoryID,
+		workspaceID).GetErrorExceptNotFound(); err != nil {
+		return nil, err
+	}
+
+	var accountIDs []uuid.UUID
+	for index := range *accountRepositories {
+		accountIDs = append(accountIDs, (*accountRepositories)[index].AccountID)
+	}
+
+	return accountIDs, nil
+}
+
+func (r *Repository) queryListAccountsOutsideWorkspace() string {
+	return `
+			SELECT ar.account_id
+			FROM account_repository AS ar
+			WHERE ar.repository_id = ? AND ar.account_id NOT IN (
+				SELECT aw.account_id FROM account_workspace AS aw WHERE aw.workspace_id = ?
+			)
 	`
 }
@@ -2,12 +2,16 @@ package repository
 
 import (
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
 )
 
@@ -30,12 +34,19 @@ func (m *Mock) GetAccountRepository(_, _ uuid.UUID) (*repositoryEntities.Account
 	return args.Get(0).(*repositoryEntities.AccountRepository), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) ListRepositoriesAuthTypeHorusec(_, _ uuid.UUID) (*[]repositoryEntities.Response, error) {
+func (m *Mock) GetAccountRepositoryRole(_, _ uuid.UUID) (account.Role, error) {
+	args := m.MethodCalled("GetAccountRepositoryRole")
+	return args.Get(0).(account.Role), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListRepositoriesAuthTypeHorusec(_, _ uuid.UUID,
+	_ pq.StringArray) (*[]repositoryEntities.Response, error) {
 	args := m.MethodCalled("ListRepositoriesAuthTypeHorusec")
 	return args.Get(0).(*[]repositoryEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) ListRepositoriesAuthTypeLdap(_ uuid.UUID, _ []string) (*[]repositoryEntities.Response, error) {
+func (m *Mock) ListRepositoriesAuthTypeLdap(_ uuid.UUID, _ []string,
+	_ pq.StringArray) (*[]repositoryEntities.Response, error) {
 	args := m.MethodCalled("ListRepositoriesAuthTypeLdap")
 	return args.Get(0).(*[]repositoryEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
@@ -55,7 +66,27 @@ func (m *Mock) GetWorkspace(_ uuid.UUID) (*workspaceEntities.Workspace, error) {
 	return args.Get(0).(*workspaceEntities.Workspace), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) ListRepositoriesWhenApplicationAdmin() (*[]repositoryEntities.Response, error) {
+func (m *Mock) ListRepositoriesWhenApplicationAdmin(_ pq.StringArray) (*[]repositoryEntities.Response, error) {
 	args := m.MethodCalled("ListRepositoriesWhenApplicationAdmin")
 	return args.Get(0).(*[]repositoryEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) ListArchivedRepositories(_ uuid.UUID) (*[]repositoryEntities.Response, error) {
+	args := m.MethodCalled("ListArchivedRepositories")
+	return args.Get(0).(*[]repositoryEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetTeam(_, _ uuid.UUID) (*teamEntities.Team, error) {
+	args := m.MethodCalled("GetTeam")
+	return args.Get(0).(*teamEntities.Team), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetCustomRole(_, _ uuid.UUID) (*customRoleEntities.CustomRole, error) {
+	args := m.MethodCalled("GetCustomRole")
+	return args.Get(0).(*customRoleEntities.CustomRole), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListAccountsOutsideWorkspace(_, _ uuid.UUID) ([]uuid.UUID, error) {
+	args := m.MethodCalled("ListAccountsOutsideWorkspace")
+	return args.Get(0).([]uuid.UUID), mockUtils.ReturnNilOrError(args, 1)
+}
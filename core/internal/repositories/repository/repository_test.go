@@ -5,14 +5,19 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	repositoryEntities "github.com/ZupIT/horusec-platform/core/internal/entities/repository"
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	teamRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/team"
 	workspaceRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/workspace"
 	repositoryUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/repository"
 )
@@ -20,7 +25,7 @@ import (
 func TestNewRepositoryRepository(t *testing.T) {
 	t.Run("should success create a repository repository", func(t *testing.T) {
 		assert.NotNil(t, NewRepositoryRepository(&database.Connection{}, repositoryUseCases.NewRepositoryUseCases(),
-			&workspaceRepository.Mock{}))
+			&workspaceRepository.Mock{}, &teamRepository.Mock{}))
 	})
 }
 
@@ -31,7 +36,7 @@ func TestGetRepositoryByName(t *testing.T) {
 			Return(response.NewResponse(1, nil, &repositoryEntities.Repository{}))
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{})
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
 
 		result, err := repository.GetRepositoryByName(uuid.New(), "test")
 		assert.NoError(t, err)
@@ -46,7 +51,7 @@ func TestGetRepository(t *testing.T) {
 			Return(response.NewResponse(1, nil, &repositoryEntities.Repository{}))
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{})
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
 
 		result, err := repository.GetRepository(uuid.New())
 		assert.NoError(t, err)
@@ -61,7 +66,7 @@ func TestGetAccountRepository(t *testing.T) {
 			Return(response.NewResponse(1, nil, &repositoryEntities.Repository{}))
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{})
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
 
 		result, err := repository.GetAccountRepository(uuid.New(), uuid.New())
 		assert.NoError(t, err)
@@ -69,6 +74,30 @@ func TestGetAccountRepository(t *testing.T) {
 	})
 }
 
+func TestGetAccountRepositoryRole(t *testing.T) {
+	t.Run("should success get the highest role of the account in the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, &repositoryEntities.AccountRepository{}))
+
+		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
+
+		_, err := repository.GetAccountRepositoryRole(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when account has no role in the repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, enums.ErrorNotFoundRecords, nil))
+
+		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
+
+		_, err := repository.GetAccountRepositoryRole(uuid.New(), uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+	})
+}
+
 func TestListRepositoriesAuthTypeHorusec(t *testing.T) {
 	t.Run("should success list repositories when admin", func(t *testing.T) {
 		databaseMock := &database.Mock{}
@@ -79,9 +108,9 @@ func TestListRepositoriesAuthTypeHorusec(t *testing.T) {
 			&workspaceEntities.AccountWorkspace{Role: account.Admin}, nil)
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
-		result, err := repository.ListRepositoriesAuthTypeHorusec(uuid.New(), uuid.New())
+		result, err := repository.ListRepositoriesAuthTypeHorusec(uuid.New(), uuid.New(), pq.StringArray{})
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
@@ -95,9 +124,9 @@ func TestListRepositoriesAuthTypeHorusec(t *testing.T) {
 			&workspaceEntities.AccountWorkspace{Role: account.Member}, nil)
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
-		result, err := repository.ListRepositoriesAuthTypeHorusec(uuid.New(), uuid.New())
+		result, err := repository.ListRepositoriesAuthTypeHorusec(uuid.New(), uuid.New(), pq.StringArray{})
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
@@ -110,9 +139,9 @@ func TestListRepositoriesAuthTypeHorusec(t *testing.T) {
 			&workspaceEntities.AccountWorkspace{}, errors.New("test"))
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
-		result, err := repository.ListRepositoriesAuthTypeHorusec(uuid.New(), uuid.New())
+		result, err := repository.ListRepositoriesAuthTypeHorusec(uuid.New(), uuid.New(), pq.StringArray{})
 		assert.Error(t, err)
 		assert.Nil(t, result)
 	})
@@ -124,9 +153,9 @@ func TestListRepositoriesAuthTypeLdap(t *testing.T) {
 		databaseMock.On("Raw").Return(&response.Response{})
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{})
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
 
-		result, err := repository.ListRepositoriesAuthTypeLdap(uuid.New(), []string{"test"})
+		result, err := repository.ListRepositoriesAuthTypeLdap(uuid.New(), []string{"test"}, pq.StringArray{})
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
@@ -141,7 +170,7 @@ func TestIsNotMemberOfWorkspace(t *testing.T) {
 			&workspaceEntities.AccountWorkspace{}, errors.New("test"))
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
 		assert.True(t, repository.IsNotMemberOfWorkspace(uuid.New(), uuid.New()))
 	})
@@ -153,7 +182,7 @@ func TestIsNotMemberOfWorkspace(t *testing.T) {
 		workspaceRepositoryMock.On("GetAccountWorkspace").Return(&workspaceEntities.AccountWorkspace{}, nil)
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
 		assert.False(t, repository.IsNotMemberOfWorkspace(uuid.New(), uuid.New()))
 	})
@@ -167,7 +196,7 @@ func TestListAllRepositoryUsers(t *testing.T) {
 		databaseMock.On("Raw").Return(&response.Response{})
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
 		result, err := repository.ListAllRepositoryUsers(uuid.New())
 		assert.NoError(t, err)
@@ -183,7 +212,7 @@ func TestGetWorkspace(t *testing.T) {
 		workspaceRepositoryMock.On("GetWorkspace").Return(&workspaceEntities.Workspace{}, nil)
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
 
 		result, err := repository.GetWorkspace(uuid.New())
 		assert.NoError(t, err)
@@ -199,10 +228,79 @@ func TestListRepositoriesWhenApplicationAdmin(t *testing.T) {
 		databaseMock.On("Raw").Return(&response.Response{})
 
 		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
-			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock)
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
+
+		result, err := repository.ListRepositoriesWhenApplicationAdmin(pq.StringArray{})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListArchivedRepositories(t *testing.T) {
+	t.Run("should success list archived repositories", func(t *testing.T) {
+		workspaceRepositoryMock := &workspaceRepository.Mock{}
+
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			repositoryUseCases.NewRepositoryUseCases(), workspaceRepositoryMock, &teamRepository.Mock{})
+
+		result, err := repository.ListArchivedRepositories(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetTeam(t *testing.T) {
+	t.Run("should success get a team of the workspace", func(t *testing.T) {
+		teamRepositoryMock := &teamRepository.Mock{}
+		teamRepositoryMock.On("GetTeam").Return(&teamEntities.Team{}, nil)
+
+		repository := NewRepositoryRepository(&database.Connection{}, repositoryUseCases.NewRepositoryUseCases(),
+			&workspaceRepository.Mock{}, teamRepositoryMock)
 
-		result, err := repository.ListRepositoriesWhenApplicationAdmin()
+		result, err := repository.GetTeam(uuid.New(), uuid.New())
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
 }
+
+func TestGetCustomRole(t *testing.T) {
+	t.Run("should success get a custom role of the workspace", func(t *testing.T) {
+		workspaceRepositoryMock := &workspaceRepository.Mock{}
+		workspaceRepositoryMock.On("GetCustomRole").Return(&customRoleEntities.CustomRole{}, nil)
+
+		repository := NewRepositoryRepository(&database.Connection{}, repositoryUseCases.NewRepositoryUseCases(),
+			workspaceRepositoryMock, &teamRepository.Mock{})
+
+		result, err := repository.GetCustomRole(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListAccountsOutsideWorkspace(t *testing.T) {
+	t.Run("should success list accounts outside the workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
+
+		_, err := repository.ListAccountsOutsideWorkspace(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return error when failed to list accounts", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		repository := NewRepositoryRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			repositoryUseCases.NewRepositoryUseCases(), &workspaceRepository.Mock{}, &teamRepository.Mock{})
+
+		result, err := repository.ListAccountsOutsideWorkspace(uuid.New(), uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,98 @@
+package team
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+	teamEnums "github.com/ZupIT/horusec-platform/core/internal/enums/team"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
+)
+
+type IRepository interface {
+	GetTeam(teamID, workspaceID uuid.UUID) (*teamEntities.Team, error)
+	GetTeamByName(workspaceID uuid.UUID, name string) (*teamEntities.Team, error)
+	ListTeams(workspaceID uuid.UUID) (*[]teamEntities.Response, error)
+	GetMember(teamID, accountID uuid.UUID) (*teamEntities.Member, error)
+	ListMembers(teamID uuid.UUID) (*[]teamEntities.MemberResponse, error)
+	GetRepositoryRole(teamID, repositoryID uuid.UUID) (*teamEntities.RepositoryRole, error)
+	ListRepositoryRoles(repositoryID uuid.UUID) (*[]teamEntities.RepositoryRoleResponse, error)
+}
+
+type Repository struct {
+	databaseRead database.IDatabaseRead
+	useCases     teamUseCases.IUseCases
+}
+
+func NewTeamRepository(connection *database.Connection, useCases teamUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead: connection.Read,
+		useCases:     useCases,
+	}
+}
+
+func (r *Repository) GetTeam(teamID, workspaceID uuid.UUID) (*teamEntities.Team, error) {
+	team := &teamEntities.Team{}
+
+	return team, r.databaseRead.Find(team, r.useCases.FilterTeamByID(teamID, workspaceID),
+		teamEnums.DatabaseTeamTable).GetError()
+}
+
+func (r *Repository) GetTeamByName(workspaceID uuid.UUID, name string) (*teamEntities.Team, error) {
+	team := &teamEntities.Team{}
+
+	return team, r.databaseRead.Find(team, r.useCases.FilterTeamByName(workspaceID, name),
+		teamEnums.DatabaseTeamTable).GetError()
+}
+
+func (r *Repository) ListTeams(workspaceID uuid.UUID) (*[]teamEntities.Response, error) {
+	teams := &[]teamEntities.Response{}
+
+	return teams, r.databaseRead.Find(teams, r.useCases.FilterListTeams(workspaceID),
+		teamEnums.DatabaseTeamTable).GetErrorExceptNotFound()
+}
+
+func (r *Repository) GetMember(teamID, accountID uuid.UUID) (*teamEntities.Member, error) {
+	member := &teamEntities.Member{}
+
+	return member, r.databaseRead.Find(member, r.useCases.FilterMemberByID(teamID, accountID),
+		teamEnums.DatabaseTeamAccountTable).GetError()
+}
+
+func (r *Repository) ListMembers(teamID uuid.UUID) (*[]teamEntities.MemberResponse, error) {
+	members := &[]teamEntities.MemberResponse{}
+
+	return members, r.databaseRead.Raw(r.queryListMembers(), members, teamID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListMembers() string {
+	return `
+			SELECT ac.account_id, ac.email, ac.username
+			FROM accounts AS ac
+			INNER JOIN team_account AS ta ON ta.account_id = ac.account_id
+			WHERE ta.team_id = ?
+	`
+}
+
+func (r *Repository) GetRepositoryRole(teamID, repositoryID uuid.UUID) (*teamEntities.RepositoryRole, error) {
+	role := &teamEntities.RepositoryRole{}
+
+	return role, r.databaseRead.Find(role, r.useCases.FilterRepositoryRoleByID(teamID, repositoryID),
+		teamEnums.DatabaseTeamRepositoryTable).GetError()
+}
+
+func (r *Repository) ListRepositoryRoles(repositoryID uuid.UUID) (*[]teamEntities.RepositoryRoleResponse, error) {
+	roles := &[]teamEntities.RepositoryRoleResponse{}
+
+	return roles, r.databaseRead.Raw(r.queryListRepositoryRoles(), roles, repositoryID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListRepositoryRoles() string {
+	return `
+			SELECT tr.team_id, tr.repository_id, te.name, tr.role
+			FROM team_repository AS tr
+			INNER JOIN teams AS te ON te.team_id = tr.team_id
+			WHERE tr.repository_id = ?
+	`
+}
@@ -0,0 +1,49 @@
+package team
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetTeam(_, _ uuid.UUID) (*teamEntities.Team, error) {
+	args := m.MethodCalled("GetTeam")
+	return args.Get(0).(*teamEntities.Team), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetTeamByName(_ uuid.UUID, _ string) (*teamEntities.Team, error) {
+	args := m.MethodCalled("GetTeamByName")
+	return args.Get(0).(*teamEntities.Team), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListTeams(_ uuid.UUID) (*[]teamEntities.Response, error) {
+	args := m.MethodCalled("ListTeams")
+	return args.Get(0).(*[]teamEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetMember(_, _ uuid.UUID) (*teamEntities.Member, error) {
+	args := m.MethodCalled("GetMember")
+	return args.Get(0).(*teamEntities.Member), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListMembers(_ uuid.UUID) (*[]teamEntities.MemberResponse, error) {
+	args := m.MethodCalled("ListMembers")
+	return args.Get(0).(*[]teamEntities.MemberResponse), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetRepositoryRole(_, _ uuid.UUID) (*teamEntities.RepositoryRole, error) {
+	args := m.MethodCalled("GetRepositoryRole")
+	return args.Get(0).(*teamEntities.RepositoryRole), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListRepositoryRoles(_ uuid.UUID) (*[]teamEntities.RepositoryRoleResponse, error) {
+	args := m.MethodCalled("ListRepositoryRoles")
+	return args.Get(0).(*[]teamEntities.RepositoryRoleResponse), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,111 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+	teamUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/team"
+)
+
+func TestNewTeamRepository(t *testing.T) {
+	t.Run("should success create a team repository", func(t *testing.T) {
+		assert.NotNil(t, NewTeamRepository(&database.Connection{}, teamUseCases.NewTeamUseCases()))
+	})
+}
+
+func TestGetTeam(t *testing.T) {
+	t.Run("should success get a team", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &teamEntities.Team{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.GetTeam(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetTeamByName(t *testing.T) {
+	t.Run("should success get a team by name", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &teamEntities.Team{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.GetTeamByName(uuid.New(), "test")
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListTeams(t *testing.T) {
+	t.Run("should success list teams", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &[]teamEntities.Response{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.ListTeams(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetMember(t *testing.T) {
+	t.Run("should success get a team member", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &teamEntities.Member{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.GetMember(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListMembers(t *testing.T) {
+	t.Run("should success list team members", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, &[]teamEntities.MemberResponse{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.ListMembers(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestGetRepositoryRole(t *testing.T) {
+	t.Run("should success get a team repository role", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(response.NewResponse(1, nil, &teamEntities.RepositoryRole{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.GetRepositoryRole(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListRepositoryRoles(t *testing.T) {
+	t.Run("should success list the team roles of a repository", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(1, nil, &[]teamEntities.RepositoryRoleResponse{}))
+
+		repository := NewTeamRepository(&database.Connection{Read: databaseMock}, teamUseCases.NewTeamUseCases())
+
+		result, err := repository.ListRepositoryRoles(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
@@ -0,0 +1,104 @@
+package token
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
+)
+
+type IRepository interface {
+	GetToken(filter map[string]interface{}) (*tokenEntities.Token, error)
+	RotateToken(retired, successor *tokenEntities.Token) error
+	ClaimExpiring(expiresBefore time.Time) (*[]tokenEntities.Expiring, error)
+}
+
+type Repository struct {
+	databaseRead  database.IDatabaseRead
+	databaseWrite database.IDatabaseWrite
+	useCases      tokenUseCases.IUseCases
+}
+
+func NewTokenRepository(connection *database.Connection, useCases tokenUseCases.IUseCases) IRepository {
+	return &Repository{
+		databaseRead:  connection.Read,
+		databaseWrite: connection.Write,
+		useCases:      useCases,
+	}
+}
+
+func (r *Repository) GetToken(filter map[string]interface{}) (*tokenEntities.Token, error) {
+	token := &tokenEntities.Token{}
+
+	return token, r.databaseRead.Find(token, filter, tokenEnums.DatabaseTokens).GetError()
+}
+
+// RotateToken stores the successor and retires the previous token in the same transaction, the retire only
+// updates a token without successor, so concurrent rotations of the same token keep only one successor
+func (r *Repository) RotateToken(retired, successor *tokenEntities.Token) error {
+	transaction := r.databaseWrite.StartTransaction()
+
+	if err := transaction.Create(successor, tokenEnums.DatabaseTokens).GetError(); err != nil {
+		logger.LogError(tokenEnums.MessageFailedToRollbackRotate, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	if err := r.retireToken(transaction, retired); err != nil {
+		logger.LogError(tokenEnums.MessageFailedToRollbackRotate, transaction.RollbackTransaction().GetError())
+		return err
+	}
+
+	return transaction.CommitTransaction().GetError()
+}
+
+func (r *Repository) retireToken(transaction database.IDatabaseWrite, retired *tokenEntities.Token) error {
+	result := transaction.Update(retired.ToRetireMap(), r.useCases.FilterNotRotatedTokenByID(retired.TokenID),
+		tokenEnums.DatabaseTokens)
+	if result.GetError() != nil {
+		return result.GetError()
+	}
+
+	if result.GetRowsAffected() == 0 {
+		return tokenEnums.ErrorTokenAlreadyRotated
+	}
+
+	return nil
+}
+
+// ClaimExpiring sets the tokens close to expire as notified and returns one row for each admin of their workspace
+// or repository. The update claims the tokens, so the replicas running at the same time never notify a token twice,
+// the tokens already rotated or notified are ignored
+func (r *Repository) ClaimExpiring(expiresBefore time.Time) (*[]tokenEntities.Expiring, error) {
+	expiring := &[]tokenEntities.Expiring{}
+
+	return expiring, r.databaseRead.Raw(r.queryClaimExpiring(), expiring,
+		time.Now(), time.Now(), expiresBefore).GetErrorExceptNotFound()
+}
+
+//nolint:funlen // query needs more than 15 lines
+func (r *Repository) queryClaimExpiring() string {
+	return `
+			WITH claimed AS (
+				UPDATE tokens SET expiration_notified_at = ?
+				WHERE is_expirable = true AND successor_id IS NULL AND expiration_notified_at IS NULL
+					AND expires_at > ? AND expires_at < ?
+				RETURNING token_id, description, expires_at, workspace_id, repository_id
+			)
+			SELECT tk.token_id, tk.description, tk.expires_at, ws.name AS workspace_name,
+				repo.name AS repository_name, acc.email, acc.username
+			FROM claimed AS tk
+			INNER JOIN workspaces AS ws ON ws.workspace_id = tk.workspace_id
+			LEFT JOIN repositories AS repo ON repo.repository_id = tk.repository_id
+			INNER JOIN accounts AS acc ON acc.account_id IN (
+				SELECT aw.account_id FROM account_workspace AS aw
+				WHERE aw.workspace_id = tk.workspace_id AND aw.role = 'admin'
+				UNION
+				SELECT ar.account_id FROM account_repository AS ar
+				WHERE ar.repository_id = tk.repository_id AND ar.role = 'admin'
+			)
+	`
+}
@@ -0,0 +1,30 @@
+package token
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) GetToken(_ map[string]interface{}) (*tokenEntities.Token, error) {
+	args := m.MethodCalled("GetToken")
+	return args.Get(0).(*tokenEntities.Token), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) RotateToken(_, _ *tokenEntities.Token) error {
+	args := m.MethodCalled("RotateToken")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) ClaimExpiring(_ time.Time) (*[]tokenEntities.Expiring, error) {
+	args := m.MethodCalled("ClaimExpiring")
+	return args.Get(0).(*[]tokenEntities.Expiring), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,106 @@
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/database"
+	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
+)
+
+func newTokenRepository(databaseMock *database.Mock) IRepository {
+	return NewTokenRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+		tokenUseCases.NewTokenUseCases())
+}
+
+func TestNewTokenRepository(t *testing.T) {
+	t.Run("should success create a new token repository", func(t *testing.T) {
+		assert.NotNil(t, newTokenRepository(&database.Mock{}))
+	})
+}
+
+func TestGetToken(t *testing.T) {
+	t.Run("should success get token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").Return(&response.Response{})
+
+		token, err := newTokenRepository(databaseMock).GetToken(map[string]interface{}{})
+		assert.NoError(t, err)
+		assert.NotNil(t, token)
+	})
+}
+
+func TestRotateToken(t *testing.T) {
+	t.Run("should success rotate token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Update").Return(response.NewResponse(1, nil, nil))
+		databaseMock.On("CommitTransaction").Return(&response.Response{})
+
+		retired := (&tokenEntities.Token{}).Retire(uuid.New(), time.Hour)
+		assert.NoError(t, newTokenRepository(databaseMock).RotateToken(retired, &tokenEntities.Token{}))
+	})
+
+	t.Run("should return error and rollback when token was already rotated", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Update").Return(response.NewResponse(0, nil, nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		err := newTokenRepository(databaseMock).RotateToken(&tokenEntities.Token{}, &tokenEntities.Token{})
+		assert.Equal(t, tokenEnums.ErrorTokenAlreadyRotated, err)
+		databaseMock.AssertNotCalled(t, "CommitTransaction")
+	})
+
+	t.Run("should return error and rollback when failed to create successor", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		err := newTokenRepository(databaseMock).RotateToken(&tokenEntities.Token{}, &tokenEntities.Token{})
+		assert.Error(t, err)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+
+	t.Run("should return error and rollback when failed to retire token", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("Create").Return(&response.Response{})
+		databaseMock.On("Update").Return(response.NewResponse(0, errors.New("test"), nil))
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		err := newTokenRepository(databaseMock).RotateToken(&tokenEntities.Token{}, &tokenEntities.Token{})
+		assert.Error(t, err)
+		databaseMock.AssertCalled(t, "RollbackTransaction")
+	})
+}
+
+func TestClaimExpiring(t *testing.T) {
+	t.Run("should success claim tokens close to expire", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(&response.Response{})
+
+		expiring, err := newTokenRepository(databaseMock).ClaimExpiring(time.Now())
+		assert.NoError(t, err)
+		assert.NotNil(t, expiring)
+	})
+
+	t.Run("should return error when failed to claim tokens", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").Return(response.NewResponse(0, errors.New("test"), nil))
+
+		_, err := newTokenRepository(databaseMock).ClaimExpiring(time.Now())
+		assert.Error(t, err)
+	})
+}
@@ -8,8 +8,10 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
+	customRoleEnums "github.com/ZupIT/horusec-platform/core/internal/enums/customrole"
 	workspaceEnums "github.com/ZupIT/horusec-platform/core/internal/enums/workspace"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
 )
@@ -21,6 +23,10 @@ type IRepository interface {
 	GetAccountWorkspace(accountID, workspaceID uuid.UUID) (*workspaceEntities.AccountWorkspace, error)
 	ListAllWorkspaceUsers(workspaceID uuid.UUID) (*[]roleEntities.Response, error)
 	ListWorkspacesApplicationAdmin() (*[]workspaceEntities.Response, error)
+	ListArchivedWorkspacesAuthTypeHorusec(accountID uuid.UUID) (*[]workspaceEntities.Response, error)
+	ListArchivedWorkspacesAuthTypeLdap(permissions []string) (*[]workspaceEntities.Response, error)
+	ListArchivedWorkspacesApplicationAdmin() (*[]workspaceEntities.Response, error)
+	GetCustomRole(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.CustomRole, error)
 }
 
 type Repository struct {
@@ -52,6 +58,14 @@ func (r *Repository) GetAccountWorkspace(accountID,
 		accountID, workspaceID), workspaceEnums.DatabaseAccountWorkspaceTable).GetError()
 }
 
+// GetCustomRole is used to check that a custom role belongs to the workspace before assigning it to an account
+func (r *Repository) GetCustomRole(customRoleID, workspaceID uuid.UUID) (*customRoleEntities.CustomRole, error) {
+	customRole := &customRoleEntities.CustomRole{}
+
+	return customRole, r.databaseRead.Find(customRole, r.useCases.FilterCustomRoleByID(customRoleID, workspaceID),
+		customRoleEnums.DatabaseCustomRoleTable).GetError()
+}
+
 func (r *Repository) ListWorkspacesAuthTypeHorusec(accountID uuid.UUID) (*[]workspaceEntities.Response, error) {
 	workspaces := &[]workspaceEntities.Response{}
 
@@ -61,10 +75,10 @@ func (r *Repository) ListWorkspacesAuthTypeHorusec(accountID uuid.UUID) (*[]work
 
 func (r *Repository) queryListWorkspacesAuthTypeHorusec() string {
 	return `
-			SELECT ws.workspace_id, ws.name, ws.description, aw.role, ws.created_at, ws.updated_at
+			SELECT ws.workspace_id, ws.name, ws.description, aw.role, ws.require_mfa, ws.created_at, ws.updated_at
 			FROM workspaces AS ws
 			INNER JOIN account_workspace AS aw ON aw.workspace_id = ws.workspace_id
-			WHERE aw.account_id = ?
+			WHERE aw.account_id = ? AND ws.archived_at IS NULL
 	`
 }
 
@@ -81,9 +95,9 @@ func (r *Repository) queryListWorkspacesAuthTypeLdap() string {
 			SELECT * 
 			FROM (
 				SELECT ws.workspace_id, ws.name, ws.description, 'admin' AS role, ws.authz_admin, 
-			   	 	   ws.authz_member, ws.created_at, ws.updated_at
+			   	 	   ws.authz_member, ws.require_mfa, ws.created_at, ws.updated_at
 				FROM workspaces AS ws 
-				WHERE @permissions && ws.authz_admin
+				WHERE @permissions && ws.authz_admin AND ws.archived_at IS NULL
 			) AS admin
 
 			UNION ALL
@@ -91,9 +105,9 @@ func (r *Repository) queryListWorkspacesAuthTypeLdap() string {
 			SELECT * 
 			FROM (
 				SELECT ws.workspace_id, ws.name, ws.description, 'member' AS role, ws.authz_admin, 
-			   		   ws.authz_member, ws.created_at, ws.updated_at
+			   		   ws.authz_member, ws.require_mfa, ws.created_at, ws.updated_at
 				FROM workspaces AS ws 
-				WHERE @permissions && ws.authz_member
+				WHERE @permissions && ws.authz_member AND ws.archived_at IS NULL
 			) AS member 
 			WHERE member.workspace_id NOT IN (
 			      SELECT ws.workspace_id FROM workspaces AS ws WHERE @permissions && ws.authz_admin)
@@ -108,7 +122,7 @@ func (r *Repository) ListAllWorkspaceUsers(workspaceID uuid.UUID) (*[]roleEntiti
 
 func (r *Repository) queryListAllWorkspaceUsers() string {
 	return `
-			SELECT ac.email, ac.username, aw.role, ac.account_id
+			SELECT ac.email, ac.username, aw.role, aw.custom_role_id, ac.account_id
 			FROM accounts AS ac
 			INNER JOIN account_workspace AS aw ON aw.account_id = ac.account_id
 			WHERE aw.workspace_id = ?
@@ -123,7 +137,58 @@ func (r *Repository) ListWorkspacesApplicationAdmin() (*[]workspaceEntities.Resp
 
 func (r *Repository) queryListWorkspacesApplicationAdmin() string {
 	return `
-			SELECT ws.workspace_id, ws.name, ws.description, 'applicationAdmin' AS role, ws.created_at, ws.updated_at
+			SELECT ws.workspace_id, ws.name, ws.description, 'applicationAdmin' AS role, ws.require_mfa,
+			       ws.created_at, ws.updated_at
+			FROM workspaces as ws
+			WHERE ws.archived_at IS NULL
+	`
+}
+
+func (r *Repository) ListArchivedWorkspacesAuthTypeHorusec(accountID uuid.UUID) (*[]workspaceEntities.Response, error) {
+	workspaces := &[]workspaceEntities.Response{}
+
+	return workspaces, r.databaseRead.Raw(
+		r.queryListArchivedWorkspacesAuthTypeHorusec(), workspaces, accountID).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListArchivedWorkspacesAuthTypeHorusec() string {
+	return `
+			SELECT ws.workspace_id, ws.name, ws.description, aw.role, ws.require_mfa, ws.created_at, ws.updated_at,
+			       ws.archived_at
+			FROM workspaces AS ws
+			INNER JOIN account_workspace AS aw ON aw.workspace_id = ws.workspace_id
+			WHERE aw.account_id = ? AND aw.role = 'admin' AND ws.archived_at IS NOT NULL
+	`
+}
+
+func (r *Repository) ListArchivedWorkspacesAuthTypeLdap(permissions []string) (*[]workspaceEntities.Response, error) {
+	workspaces := &[]workspaceEntities.Response{}
+
+	return workspaces, r.databaseRead.Raw(r.queryListArchivedWorkspacesAuthTypeLdap(), workspaces,
+		sql.Named("permissions", pq.StringArray(permissions))).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListArchivedWorkspacesAuthTypeLdap() string {
+	return `
+			SELECT ws.workspace_id, ws.name, ws.description, 'admin' AS role, ws.authz_admin, ws.authz_member,
+			       ws.require_mfa, ws.created_at, ws.updated_at, ws.archived_at
+			FROM workspaces AS ws
+			WHERE @permissions && ws.authz_admin AND ws.archived_at IS NOT NULL
+	`
+}
+
+func (r *Repository) ListArchivedWorkspacesApplicationAdmin() (*[]workspaceEntities.Response, error) {
+	workspaces := &[]workspaceEntities.Response{}
+
+	return workspaces, r.databaseRead.Raw(
+		r.queryListArchivedWorkspacesApplicationAdmin(), workspaces).GetErrorExceptNotFound()
+}
+
+func (r *Repository) queryListArchivedWorkspacesApplicationAdmin() string {
+	return `
+			SELECT ws.workspace_id, ws.name, ws.description, 'applicationAdmin' AS role, ws.require_mfa,
+			       ws.created_at, ws.updated_at, ws.archived_at
 			FROM workspaces as ws
+			WHERE ws.archived_at IS NOT NULL
 	`
 }
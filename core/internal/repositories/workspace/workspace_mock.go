@@ -6,6 +6,7 @@ import (
 
 	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
 )
@@ -43,3 +44,23 @@ func (m *Mock) ListWorkspacesApplicationAdmin() (*[]workspaceEntities.Response,
 	args := m.MethodCalled("ListWorkspacesApplicationAdmin")
 	return args.Get(0).(*[]workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) ListArchivedWorkspacesAuthTypeHorusec(_ uuid.UUID) (*[]workspaceEntities.Response, error) {
+	args := m.MethodCalled("ListArchivedWorkspacesAuthTypeHorusec")
+	return args.Get(0).(*[]workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListArchivedWorkspacesAuthTypeLdap(_ []string) (*[]workspaceEntities.Response, error) {
+	args := m.MethodCalled("ListArchivedWorkspacesAuthTypeLdap")
+	return args.Get(0).(*[]workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) ListArchivedWorkspacesApplicationAdmin() (*[]workspaceEntities.Response, error) {
+	args := m.MethodCalled("ListArchivedWorkspacesApplicationAdmin")
+	return args.Get(0).(*[]workspaceEntities.Response), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) GetCustomRole(_, _ uuid.UUID) (*customRoleEntities.CustomRole, error) {
+	args := m.MethodCalled("GetCustomRole")
+	return args.Get(0).(*customRoleEntities.CustomRole), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
 	roleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/role"
 	workspaceEntities "github.com/ZupIT/horusec-platform/core/internal/entities/workspace"
 	workspaceUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/workspace"
@@ -50,6 +51,21 @@ func TestGetAccountWorkspace(t *testing.T) {
 	})
 }
 
+func TestGetCustomRole(t *testing.T) {
+	t.Run("should success get a custom role of the workspace", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Find").
+			Return(response.NewResponse(1, nil, &customRoleEntities.CustomRole{}))
+
+		repository := NewWorkspaceRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			workspaceUseCases.NewWorkspaceUseCases())
+
+		result, err := repository.GetCustomRole(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
 func TestListWorkspacesAuthTypeHorusec(t *testing.T) {
 	t.Run("should success get all user workspaces when auth type horusec", func(t *testing.T) {
 		databaseMock := &database.Mock{}
@@ -109,3 +125,48 @@ func TestListWorkspacesApplicationAdmin(t *testing.T) {
 		assert.NotNil(t, result)
 	})
 }
+
+func TestListArchivedWorkspacesAuthTypeHorusec(t *testing.T) {
+	t.Run("should success list archived workspaces", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").
+			Return(response.NewResponse(1, nil, &[]workspaceEntities.Response{}))
+
+		repository := NewWorkspaceRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			workspaceUseCases.NewWorkspaceUseCases())
+
+		result, err := repository.ListArchivedWorkspacesAuthTypeHorusec(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListArchivedWorkspacesAuthTypeLdap(t *testing.T) {
+	t.Run("should success list archived workspaces", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").
+			Return(response.NewResponse(1, nil, &[]workspaceEntities.Response{}))
+
+		repository := NewWorkspaceRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			workspaceUseCases.NewWorkspaceUseCases())
+
+		result, err := repository.ListArchivedWorkspacesAuthTypeLdap([]string{"test"})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
+
+func TestListArchivedWorkspacesApplicationAdmin(t *testing.T) {
+	t.Run("should success list archived workspaces", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("Raw").
+			Return(response.NewResponse(1, nil, &[]workspaceEntities.Response{}))
+
+		repository := NewWorkspaceRepository(&database.Connection{Read: databaseMock, Write: databaseMock},
+			workspaceUseCases.NewWorkspaceUseCases())
+
+		result, err := repository.ListArchivedWorkspacesApplicationAdmin()
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+}
@@ -9,9 +9,22 @@ import (
 
 	"github.com/ZupIT/horusec-platform/core/docs"
 	"github.com/ZupIT/horusec-platform/core/internal/enums/routes"
+	archiveEvents "github.com/ZupIT/horusec-platform/core/internal/events/archive"
+	auditEvents "github.com/ZupIT/horusec-platform/core/internal/events/audit"
+	importerEvents "github.com/ZupIT/horusec-platform/core/internal/events/importer"
+	invitationEvents "github.com/ZupIT/horusec-platform/core/internal/events/invitation"
+	tokenEvents "github.com/ZupIT/horusec-platform/core/internal/events/token"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/audit"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/customrole"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/importer"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/invitation"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/quota"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/team"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 type IRouter interface {
@@ -21,30 +34,71 @@ type IRouter interface {
 type Router struct {
 	httpRouter.IRouter
 	middlewares.IAuthzMiddleware
+	permissionMiddleware.IMiddleware
 	workspaceHandler  *workspace.Handler
 	repositoryHandler *repository.Handler
 	healthHandler     *health.Handler
+	invitationHandler *invitation.Handler
+	teamHandler       *team.Handler
+	importerHandler   *importer.Handler
+	auditHandler      *audit.Handler
+	customRoleHandler *customrole.Handler
+	quotaHandler      *quota.Handler
+	archiveEvents     *archiveEvents.Events
+	tokenEvents       *tokenEvents.Events
+	importerEvents    *importerEvents.Events
+	auditEvents       *auditEvents.Events
+	invitationEvents  *invitationEvents.Events
 	swagger.ISwagger
 }
 
+//nolint:funlen // receives all the handlers and middlewares of the service
 func NewHTTPRouter(router httpRouter.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	workspaceHandler *workspace.Handler, repositoryHandler *repository.Handler, healthHandler *health.Handler) IRouter {
+	workspaceHandler *workspace.Handler, repositoryHandler *repository.Handler, healthHandler *health.Handler,
+	eventsArchive *archiveEvents.Events, eventsToken *tokenEvents.Events, invitationHandler *invitation.Handler,
+	teamHandler *team.Handler, importerHandler *importer.Handler, eventsImporter *importerEvents.Events,
+	auditHandler *audit.Handler, eventsAudit *auditEvents.Events, middlewarePermission permissionMiddleware.IMiddleware,
+	customRoleHandler *customrole.Handler, quotaHandler *quota.Handler,
+	eventsInvitation *invitationEvents.Events) IRouter {
 	httpRoutes := &Router{
 		IRouter:           router,
 		IAuthzMiddleware:  authzMiddleware,
+		IMiddleware:       middlewarePermission,
 		ISwagger:          swagger.NewSwagger(router.GetMux(), router.GetPort()),
 		workspaceHandler:  workspaceHandler,
 		repositoryHandler: repositoryHandler,
 		healthHandler:     healthHandler,
+		invitationHandler: invitationHandler,
+		teamHandler:       teamHandler,
+		importerHandler:   importerHandler,
+		auditHandler:      auditHandler,
+		customRoleHandler: customRoleHandler,
+		quotaHandler:      quotaHandler,
 	}
 
-	return httpRoutes.setRoutes()
+	return httpRoutes.setEvents(eventsArchive, eventsToken, eventsImporter, eventsAudit, eventsInvitation).setRoutes()
+}
+
+// setEvents keeps the reference of the events started with the router, they do not have routes
+func (r *Router) setEvents(eventsArchive *archiveEvents.Events, eventsToken *tokenEvents.Events,
+	eventsImporter *importerEvents.Events, eventsAudit *auditEvents.Events,
+	eventsInvitation *invitationEvents.Events) *Router {
+	r.archiveEvents = eventsArchive
+	r.tokenEvents = eventsToken
+	r.importerEvents = eventsImporter
+	r.auditEvents = eventsAudit
+	r.invitationEvents = eventsInvitation
+
+	return r
 }
 
 func (r *Router) setRoutes() IRouter {
 	r.swaggerRoutes()
 	r.workspaceRoutes()
 	r.repositoryRoutes()
+	r.invitationRoutes()
+	r.auditRoutes()
+	r.quotaRoutes()
 	r.healthRoutes()
 
 	return r
@@ -52,37 +106,186 @@ func (r *Router) setRoutes() IRouter {
 
 func (r *Router) workspaceRoutes() {
 	r.Route(routes.WorkspaceHandler, func(router chi.Router) {
-		router.Get("/", r.workspaceHandler.List)
+		router.With(r.DenyPersonalAccessToken).Get("/", r.workspaceHandler.List)
 		router.Options("/", r.workspaceHandler.Options)
 		router.With(r.IsApplicationAdmin).Post("/", r.workspaceHandler.Create)
-		router.With(r.IsWorkspaceMember).Get("/{workspaceID}", r.workspaceHandler.Get)
-		router.With(r.IsWorkspaceAdmin).Patch("/{workspaceID}", r.workspaceHandler.Update)
-		router.With(r.IsWorkspaceAdmin).Delete("/{workspaceID}", r.workspaceHandler.Delete)
-		router.With(r.IsWorkspaceAdmin).Get("/{workspaceID}/roles", r.workspaceHandler.GetUsers)
-		router.With(r.IsWorkspaceAdmin).Patch("/{workspaceID}/roles/{accountID}", r.workspaceHandler.UpdateRole)
-		router.With(r.IsWorkspaceAdmin).Post("/{workspaceID}/roles", r.workspaceHandler.InviteUser)
-		router.With(r.IsWorkspaceAdmin).Delete("/{workspaceID}/roles/{accountID}", r.workspaceHandler.RemoveUser)
-		router.With(r.IsWorkspaceAdmin).Post("/{workspaceID}/tokens", r.workspaceHandler.CreateToken)
-		router.With(r.IsWorkspaceAdmin).Delete("/{workspaceID}/tokens/{tokenID}", r.workspaceHandler.DeleteToken)
-		router.With(r.IsWorkspaceAdmin).Get("/{workspaceID}/tokens", r.workspaceHandler.ListTokens)
+		router.With(r.HasPermission(permissionEnums.WorkspaceRead)).Get("/{workspaceID}", r.workspaceHandler.Get)
+		router.With(r.HasPermission(permissionEnums.WorkspaceUpdate)).Patch("/{workspaceID}", r.workspaceHandler.Update)
+		router.With(r.HasPermission(permissionEnums.WorkspaceDelete)).Delete("/{workspaceID}", r.workspaceHandler.Delete)
+		r.workspaceRoleRoutes(router)
+		r.workspaceTokenRoutes(router)
+		r.workspaceArchiveRoutes(router)
+		r.workspaceInvitationRoutes(router)
+		r.workspaceTeamRoutes(router)
+		r.workspaceImportRoutes(router)
+		r.workspaceAuditRoutes(router)
 	})
 }
 
+func (r *Router) workspaceRoleRoutes(router chi.Router) {
+	canManageMembers := r.HasPermission(permissionEnums.WorkspaceMembersManage)
+
+	router.With(canManageMembers).Get("/{workspaceID}/roles", r.workspaceHandler.GetUsers)
+	router.With(canManageMembers).Patch("/{workspaceID}/roles/{accountID}", r.workspaceHandler.UpdateRole)
+	router.With(canManageMembers).Post("/{workspaceID}/roles", r.workspaceHandler.InviteUser)
+	router.With(canManageMembers).Delete("/{workspaceID}/roles/{accountID}", r.workspaceHandler.RemoveUser)
+	r.workspaceCustomRoleRoutes(router)
+}
+
+func (r *Router) workspaceCustomRoleRoutes(router chi.Router) {
+	canRead := r.HasPermission(permissionEnums.WorkspaceRead)
+	canManageRoles := r.HasPermission(permissionEnums.WorkspaceRolesManage)
+
+	router.With(canRead).Get("/{workspaceID}/custom-roles/permissions", r.customRoleHandler.ListPermissions)
+	router.With(canRead).Get("/{workspaceID}/custom-roles", r.customRoleHandler.List)
+	router.With(canRead).Get("/{workspaceID}/custom-roles/{customRoleID}", r.customRoleHandler.Get)
+	router.With(canManageRoles).Post("/{workspaceID}/custom-roles", r.customRoleHandler.Create)
+	router.With(canManageRoles).Patch("/{workspaceID}/custom-roles/{customRoleID}", r.customRoleHandler.Update)
+	router.With(canManageRoles).Delete("/{workspaceID}/custom-roles/{customRoleID}", r.customRoleHandler.Delete)
+}
+
+func (r *Router) workspaceTokenRoutes(router chi.Router) {
+	canManageTokens := r.HasPermission(permissionEnums.WorkspaceTokensManage)
+
+	router.With(canManageTokens).Post("/{workspaceID}/tokens", r.workspaceHandler.CreateToken)
+	router.With(canManageTokens).Delete("/{workspaceID}/tokens/{tokenID}", r.workspaceHandler.DeleteToken)
+	router.With(canManageTokens).Post("/{workspaceID}/tokens/{tokenID}/rotate", r.workspaceHandler.RotateToken)
+	router.With(canManageTokens).Get("/{workspaceID}/tokens", r.workspaceHandler.ListTokens)
+}
+
+func (r *Router) workspaceArchiveRoutes(router chi.Router) {
+	canDelete := r.HasPermission(permissionEnums.WorkspaceDelete)
+
+	router.With(r.DenyPersonalAccessToken).Get("/archived", r.workspaceHandler.ListArchived)
+	router.With(canDelete).Post("/{workspaceID}/restore", r.workspaceHandler.Restore)
+}
+
+func (r *Router) workspaceInvitationRoutes(router chi.Router) {
+	canManageMembers := r.HasPermission(permissionEnums.WorkspaceMembersManage)
+
+	router.With(canManageMembers).Post("/{workspaceID}/invitations", r.invitationHandler.Create)
+	router.With(canManageMembers).Get("/{workspaceID}/invitations", r.invitationHandler.List)
+	router.With(canManageMembers).Post("/{workspaceID}/invitations/{invitationID}/resend",
+		r.invitationHandler.Resend)
+	router.With(canManageMembers).Delete("/{workspaceID}/invitations/{invitationID}", r.invitationHandler.Revoke)
+}
+
+func (r *Router) workspaceTeamRoutes(router chi.Router) {
+	canManageMembers := r.HasPermission(permissionEnums.WorkspaceMembersManage)
+	canRead := r.HasPermission(permissionEnums.WorkspaceRead)
+
+	router.With(canManageMembers).Post("/{workspaceID}/teams", r.teamHandler.Create)
+	router.With(canRead).Get("/{workspaceID}/teams", r.teamHandler.List)
+	router.With(canRead).Get("/{workspaceID}/teams/{teamID}", r.teamHandler.Get)
+	router.With(canManageMembers).Patch("/{workspaceID}/teams/{teamID}", r.teamHandler.Update)
+	router.With(canManageMembers).Delete("/{workspaceID}/teams/{teamID}", r.teamHandler.Delete)
+	router.With(canRead).Get("/{workspaceID}/teams/{teamID}/accounts", r.teamHandler.ListMembers)
+	router.With(canManageMembers).Post("/{workspaceID}/teams/{teamID}/accounts", r.teamHandler.AddMember)
+	router.With(canManageMembers).Delete("/{workspaceID}/teams/{teamID}/accounts/{accountID}",
+		r.teamHandler.RemoveMember)
+}
+
+func (r *Router) workspaceImportRoutes(router chi.Router) {
+	canCreate := r.HasPermission(permissionEnums.RepositoryCreate)
+
+	router.With(canCreate).Post("/{workspaceID}/imports", r.importerHandler.Create)
+	router.With(canCreate).Get("/{workspaceID}/imports", r.importerHandler.List)
+	router.With(canCreate).Post("/{workspaceID}/imports/{importID}/sync", r.importerHandler.Sync)
+	router.With(canCreate).Delete("/{workspaceID}/imports/{importID}", r.importerHandler.Delete)
+}
+
+func (r *Router) workspaceAuditRoutes(router chi.Router) {
+	canRead := r.HasPermission(permissionEnums.AuditRead)
+
+	router.With(canRead).Get("/{workspaceID}/audit", r.auditHandler.ListByWorkspace)
+	router.With(canRead).Get("/{workspaceID}/audit/export", r.auditHandler.ExportByWorkspace)
+}
+
 func (r *Router) repositoryRoutes() {
 	r.Route(routes.RepositoryHandler, func(router chi.Router) {
 		router.Options("/", r.repositoryHandler.Options)
-		router.With(r.IsWorkspaceAdmin).Post("/", r.repositoryHandler.Create)
-		router.With(r.IsWorkspaceMember).Get("/", r.repositoryHandler.List)
-		router.With(r.IsRepositoryMember).Get("/{repositoryID}", r.repositoryHandler.Get)
-		router.With(r.IsRepositoryAdmin).Patch("/{repositoryID}", r.repositoryHandler.Update)
-		router.With(r.IsRepositoryAdmin).Delete("/{repositoryID}", r.repositoryHandler.Delete)
-		router.With(r.IsRepositoryAdmin).Post("/{repositoryID}/roles", r.repositoryHandler.InviteUser)
-		router.With(r.IsRepositoryAdmin).Patch("/{repositoryID}/roles/{accountID}", r.repositoryHandler.UpdateRole)
-		router.With(r.IsRepositoryAdmin).Get("/{repositoryID}/roles", r.repositoryHandler.GetUsers)
-		router.With(r.IsRepositoryAdmin).Delete("/{repositoryID}/roles/{accountID}", r.repositoryHandler.RemoveUser)
-		router.With(r.IsRepositoryAdmin).Post("/{repositoryID}/tokens", r.repositoryHandler.CreateToken)
-		router.With(r.IsRepositoryAdmin).Delete("/{repositoryID}/tokens/{tokenID}", r.repositoryHandler.DeleteToken)
-		router.With(r.IsRepositoryAdmin).Get("/{repositoryID}/tokens", r.repositoryHandler.ListTokens)
+		router.With(r.HasPermission(permissionEnums.RepositoryCreate)).Post("/", r.repositoryHandler.Create)
+		router.With(r.HasPermission(permissionEnums.RepositoryRead)).Get("/", r.repositoryHandler.List)
+		router.With(r.HasPermission(permissionEnums.RepositoryRead)).Get("/{repositoryID}", r.repositoryHandler.Get)
+		router.With(r.HasPermission(permissionEnums.RepositoryUpdate)).Patch("/{repositoryID}", r.repositoryHandler.Update)
+		router.With(r.HasPermission(permissionEnums.RepositoryDelete)).Delete("/{repositoryID}", r.repositoryHandler.Delete)
+		r.repositoryRoleRoutes(router)
+		r.repositoryTokenRoutes(router)
+		r.repositoryArchiveRoutes(router)
+		r.repositoryInvitationRoutes(router)
+		r.repositoryTeamRoutes(router)
+	})
+}
+
+func (r *Router) repositoryRoleRoutes(router chi.Router) {
+	canManageMembers := r.HasPermission(permissionEnums.RepositoryMembersManage)
+
+	router.With(canManageMembers).Post("/{repositoryID}/roles", r.repositoryHandler.InviteUser)
+	router.With(canManageMembers).Patch("/{repositoryID}/roles/{accountID}", r.repositoryHandler.UpdateRole)
+	router.With(canManageMembers).Get("/{repositoryID}/roles", r.repositoryHandler.GetUsers)
+	router.With(canManageMembers).Delete("/{repositoryID}/roles/{accountID}", r.repositoryHandler.RemoveUser)
+}
+
+func (r *Router) repositoryTokenRoutes(router chi.Router) {
+	canManageTokens := r.HasPermission(permissionEnums.RepositoryTokensManage)
+
+	router.With(canManageTokens).Post("/{repositoryID}/tokens", r.repositoryHandler.CreateToken)
+	router.With(canManageTokens).Delete("/{repositoryID}/tokens/{tokenID}", r.repositoryHandler.DeleteToken)
+	router.With(canManageTokens).Post("/{repositoryID}/tokens/{tokenID}/rotate", r.repositoryHandler.RotateToken)
+	router.With(canManageTokens).Get("/{repositoryID}/tokens", r.repositoryHandler.ListTokens)
+}
+
+func (r *Router) repositoryArchiveRoutes(router chi.Router) {
+	canDelete := r.HasPermission(permissionEnums.RepositoryDelete)
+	canTransfer := r.HasPermission(permissionEnums.RepositoryTransfer)
+
+	router.With(canDelete).Get("/archived", r.repositoryHandler.ListArchived)
+	router.With(canDelete).Post("/{repositoryID}/restore", r.repositoryHandler.Restore)
+	router.With(canTransfer).Post("/{repositoryID}/transfer", r.repositoryHandler.Transfer)
+}
+
+func (r *Router) repositoryInvitationRoutes(router chi.Router) {
+	canManageMembers := r.HasPermission(permissionEnums.RepositoryMembersManage)
+
+	router.With(canManageMembers).Post("/{repositoryID}/invitations", r.invitationHandler.Create)
+	router.With(canManageMembers).Get("/{repositoryID}/invitations", r.invitationHandler.List)
+	router.With(canManageMembers).Post("/{repositoryID}/invitations/{invitationID}/resend",
+		r.invitationHandler.Resend)
+	router.With(canManageMembers).Delete("/{repositoryID}/invitations/{invitationID}", r.invitationHandler.Revoke)
+}
+
+func (r *Router) repositoryTeamRoutes(router chi.Router) {
+	canManageMembers := r.HasPermission(permissionEnums.RepositoryMembersManage)
+
+	router.With(canManageMembers).Post("/{repositoryID}/teams", r.teamHandler.GrantRepositoryRole)
+	router.With(canManageMembers).Get("/{repositoryID}/teams", r.teamHandler.ListRepositoryRoles)
+	router.With(canManageMembers).Patch("/{repositoryID}/teams/{teamID}", r.teamHandler.UpdateRepositoryRole)
+	router.With(canManageMembers).Delete("/{repositoryID}/teams/{teamID}", r.teamHandler.RevokeRepositoryRole)
+}
+
+func (r *Router) invitationRoutes() {
+	r.Route(routes.InvitationHandler, func(router chi.Router) {
+		router.Options("/", r.invitationHandler.Options)
+		router.With(r.DenyPersonalAccessToken).Post("/accept", r.invitationHandler.Accept)
+		router.With(r.DenyPersonalAccessToken).Post("/decline", r.invitationHandler.Decline)
+	})
+}
+
+func (r *Router) auditRoutes() {
+	r.Route(routes.AuditHandler, func(router chi.Router) {
+		router.Options("/", r.auditHandler.Options)
+		router.With(r.IsApplicationAdmin).Get("/", r.auditHandler.List)
+		router.With(r.IsApplicationAdmin).Get("/export", r.auditHandler.Export)
+		router.With(r.IsApplicationAdmin).Get("/verify", r.auditHandler.Verify)
+	})
+}
+
+func (r *Router) quotaRoutes() {
+	r.Route(routes.QuotaHandler, func(router chi.Router) {
+		router.Options("/", r.quotaHandler.Options)
+		router.With(r.IsApplicationAdmin).Get("/", r.quotaHandler.List)
+		router.With(r.IsApplicationAdmin).Get("/{workspaceID}", r.quotaHandler.Get)
+		router.With(r.IsApplicationAdmin).Put("/{workspaceID}", r.quotaHandler.Update)
 	})
 }
 
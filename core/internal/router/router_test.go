@@ -5,13 +5,26 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	httpRouter "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
 	"github.com/ZupIT/horusec-platform/core/config/cors"
+	archiveEvents "github.com/ZupIT/horusec-platform/core/internal/events/archive"
+	auditEvents "github.com/ZupIT/horusec-platform/core/internal/events/audit"
+	importerEvents "github.com/ZupIT/horusec-platform/core/internal/events/importer"
+	invitationEvents "github.com/ZupIT/horusec-platform/core/internal/events/invitation"
+	tokenEvents "github.com/ZupIT/horusec-platform/core/internal/events/token"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/audit"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/customrole"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/health"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/importer"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/invitation"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/quota"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/repository"
+	"github.com/ZupIT/horusec-platform/core/internal/handlers/team"
 	"github.com/ZupIT/horusec-platform/core/internal/handlers/workspace"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 func TestNewHTTPRouter(t *testing.T) {
@@ -21,10 +34,24 @@ func TestNewHTTPRouter(t *testing.T) {
 		workspaceHandler := &workspace.Handler{}
 		repositoryHandler := &repository.Handler{}
 		healthHandler := &health.Handler{}
+		eventsArchive := &archiveEvents.Events{}
+		eventsToken := &tokenEvents.Events{}
+		invitationHandler := &invitation.Handler{}
+		teamHandler := &team.Handler{}
+		importerHandler := &importer.Handler{}
+		eventsImporter := &importerEvents.Events{}
+		auditHandler := &audit.Handler{}
+		eventsAudit := &auditEvents.Events{}
+		middlewarePermission := permissionMiddleware.NewPermissionMiddleware(&proto.Mock{})
+		customRoleHandler := &customrole.Handler{}
+		quotaHandler := &quota.Handler{}
+		eventsInvitation := &invitationEvents.Events{}
 
 		assert.NotPanics(t, func() {
 			assert.NotNil(t, NewHTTPRouter(routerService, middlewareService, workspaceHandler,
-				repositoryHandler, healthHandler))
+				repositoryHandler, healthHandler, eventsArchive, eventsToken, invitationHandler,
+				teamHandler, importerHandler, eventsImporter, auditHandler, eventsAudit, middlewarePermission,
+				customRoleHandler, quotaHandler, eventsInvitation))
 		})
 	})
 }
@@ -0,0 +1,102 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	archiveRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/archive"
+)
+
+type IService interface {
+	ValidateRestore(archivedAt *time.Time) error
+	PublishEvent(event *archiveEntities.Event)
+	PurgeExpired()
+}
+
+type Service struct {
+	broker     brokerService.IBroker
+	repository archiveRepository.IRepository
+	retention  time.Duration
+}
+
+func NewArchiveService(broker brokerService.IBroker, repositoryArchive archiveRepository.IRepository) IService {
+	return &Service{
+		broker:     broker,
+		repository: repositoryArchive,
+		retention: time.Duration(env.GetEnvOrDefaultInt(archiveEnums.EnvRetentionDays,
+			archiveEnums.DefaultRetentionDays)) * 24 * time.Hour,
+	}
+}
+
+// ValidateRestore checks if the resource is archived and still inside the retention period
+func (s *Service) ValidateRestore(archivedAt *time.Time) error {
+	if archivedAt == nil {
+		return archiveEnums.ErrorNotArchived
+	}
+
+	if time.Now().After(archivedAt.Add(s.retention)) {
+		return archiveEnums.ErrorRetentionExpired
+	}
+
+	return nil
+}
+
+// PublishEvent notifies the other services through a fanout exchange, so each one of them can hide, show again or
+// purge its own data of the archived resource
+func (s *Service) PublishEvent(event *archiveEntities.Event) {
+	logger.LogError(archiveEnums.MessageFailedToPublishEvent,
+		s.broker.Publish("", archiveEnums.ExchangeArchive, exchange.Fanout, event.ToBytes()))
+}
+
+// PurgeExpired permanently deletes everything archived longer than the retention period, repositories are purged
+// first since deleting a workspace also removes its repositories by cascade
+func (s *Service) PurgeExpired() {
+	logger.LogInfo(archiveEnums.MessagePurgingExpiredArchivedItems)
+	archivedBefore := time.Now().Add(-s.retention)
+
+	s.purgeRepositories(archivedBefore)
+	s.purgeWorkspaces(archivedBefore)
+}
+
+func (s *Service) purgeRepositories(archivedBefore time.Time) {
+	expired, err := s.repository.ListExpiredRepositories(archivedBefore)
+	if err != nil {
+		logger.LogError(archiveEnums.MessageFailedToListExpired, err)
+		return
+	}
+
+	for index := range *expired {
+		item := (*expired)[index]
+		s.publishIfPurged(&item, s.repository.DeleteRepository(item.RepositoryID),
+			archiveEnums.MessageFailedToPurgeRepository)
+	}
+}
+
+func (s *Service) purgeWorkspaces(archivedBefore time.Time) {
+	expired, err := s.repository.ListExpiredWorkspaces(archivedBefore)
+	if err != nil {
+		logger.LogError(archiveEnums.MessageFailedToListExpired, err)
+		return
+	}
+
+	for index := range *expired {
+		item := (*expired)[index]
+		s.publishIfPurged(&item, s.repository.DeleteWorkspace(item.WorkspaceID),
+			archiveEnums.MessageFailedToPurgeWorkspace)
+	}
+}
+
+func (s *Service) publishIfPurged(expired *archiveEntities.Expired, err error, message string) {
+	if err != nil {
+		logger.LogError(message, err)
+		return
+	}
+
+	s.PublishEvent(expired.ToPurgedEvent())
+}
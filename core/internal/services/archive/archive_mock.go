@@ -0,0 +1,28 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ValidateRestore(_ *time.Time) error {
+	args := m.MethodCalled("ValidateRestore")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) PublishEvent(_ *archiveEntities.Event) {
+	_ = m.MethodCalled("PublishEvent")
+}
+
+func (m *Mock) PurgeExpired() {
+	_ = m.MethodCalled("PurgeExpired")
+}
@@ -0,0 +1,103 @@
+package archive
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+
+	archiveEntities "github.com/ZupIT/horusec-platform/core/internal/entities/archive"
+	archiveEnums "github.com/ZupIT/horusec-platform/core/internal/enums/archive"
+	archiveRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/archive"
+)
+
+func TestNewArchiveService(t *testing.T) {
+	t.Run("should success create a new archive service", func(t *testing.T) {
+		assert.NotNil(t, NewArchiveService(&broker.Mock{}, &archiveRepository.Mock{}))
+	})
+}
+
+func TestValidateRestore(t *testing.T) {
+	service := NewArchiveService(&broker.Mock{}, &archiveRepository.Mock{})
+
+	t.Run("should return no error when inside the retention period", func(t *testing.T) {
+		archivedAt := time.Now().Add(-time.Hour)
+
+		assert.NoError(t, service.ValidateRestore(&archivedAt))
+	})
+
+	t.Run("should return error when retention period has expired", func(t *testing.T) {
+		archivedAt := time.Now().AddDate(0, 0, -(archiveEnums.DefaultRetentionDays + 1))
+
+		assert.Equal(t, archiveEnums.ErrorRetentionExpired, service.ValidateRestore(&archivedAt))
+	})
+
+	t.Run("should return error when not archived", func(t *testing.T) {
+		assert.Equal(t, archiveEnums.ErrorNotArchived, service.ValidateRestore(nil))
+	})
+}
+
+func TestPublishEvent(t *testing.T) {
+	t.Run("should publish event in the archive exchange", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		service := NewArchiveService(brokerMock, &archiveRepository.Mock{})
+
+		assert.NotPanics(t, func() {
+			service.PublishEvent(archiveEntities.NewWorkspaceEvent(archiveEnums.ActionArchived, uuid.New()))
+		})
+		brokerMock.AssertCalled(t, "Publish")
+	})
+}
+
+func TestPurgeExpired(t *testing.T) {
+	expiredRepositories := &[]archiveEntities.Expired{{WorkspaceID: uuid.New(), RepositoryID: uuid.New()}}
+	expiredWorkspaces := &[]archiveEntities.Expired{{WorkspaceID: uuid.New()}}
+
+	t.Run("should delete expired repositories and workspaces and publish purged events", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		repositoryMock := &archiveRepository.Mock{}
+		repositoryMock.On("ListExpiredRepositories").Return(expiredRepositories, nil)
+		repositoryMock.On("ListExpiredWorkspaces").Return(expiredWorkspaces, nil)
+		repositoryMock.On("DeleteRepository").Return(nil)
+		repositoryMock.On("DeleteWorkspace").Return(nil)
+
+		NewArchiveService(brokerMock, repositoryMock).PurgeExpired()
+
+		repositoryMock.AssertCalled(t, "DeleteRepository")
+		repositoryMock.AssertCalled(t, "DeleteWorkspace")
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should not publish events when failed to delete", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+
+		repositoryMock := &archiveRepository.Mock{}
+		repositoryMock.On("ListExpiredRepositories").Return(expiredRepositories, nil)
+		repositoryMock.On("ListExpiredWorkspaces").Return(expiredWorkspaces, nil)
+		repositoryMock.On("DeleteRepository").Return(errors.New("test"))
+		repositoryMock.On("DeleteWorkspace").Return(errors.New("test"))
+
+		NewArchiveService(brokerMock, repositoryMock).PurgeExpired()
+
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should not delete anything when failed to list expired", func(t *testing.T) {
+		repositoryMock := &archiveRepository.Mock{}
+		repositoryMock.On("ListExpiredRepositories").Return(expiredRepositories, errors.New("test"))
+		repositoryMock.On("ListExpiredWorkspaces").Return(expiredWorkspaces, errors.New("test"))
+
+		NewArchiveService(&broker.Mock{}, repositoryMock).PurgeExpired()
+
+		repositoryMock.AssertNotCalled(t, "DeleteRepository")
+		repositoryMock.AssertNotCalled(t, "DeleteWorkspace")
+	})
+}
@@ -0,0 +1,82 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+
+	encryptionEnums "github.com/ZupIT/horusec-platform/core/internal/enums/encryption"
+)
+
+type IService interface {
+	Encrypt(value string) (string, error)
+	Decrypt(value string) (string, error)
+}
+
+type Service struct {
+	key string
+}
+
+func NewEncryptionService() IService {
+	return &Service{
+		key: env.GetEnvOrDefault(encryptionEnums.EnvEncryptionKey, ""),
+	}
+}
+
+// Encrypt seals the value with aes gcm using a key derived from the env, the random nonce is kept as the prefix of
+// the returned base64 value
+func (s *Service) Encrypt(value string) (string, error) {
+	gcm, err := s.newGCM()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(value), nil)), nil
+}
+
+func (s *Service) Decrypt(value string) (string, error) {
+	gcm, err := s.newGCM()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", encryptionEnums.ErrorInvalidEncryptedValue
+	}
+
+	return s.open(gcm, sealed)
+}
+
+func (s *Service) open(gcm cipher.AEAD, sealed []byte) (string, error) {
+	decrypted, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", encryptionEnums.ErrorInvalidEncryptedValue
+	}
+
+	return string(decrypted), nil
+}
+
+func (s *Service) newGCM() (cipher.AEAD, error) {
+	if s.key == "" {
+		return nil, encryptionEnums.ErrorEncryptionKeyNotSet
+	}
+
+	key := sha256.Sum256([]byte(s.key))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,21 @@
+package encryption
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Encrypt(_ string) (string, error) {
+	args := m.MethodCalled("Encrypt")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) Decrypt(_ string) (string, error) {
+	args := m.MethodCalled("Decrypt")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
@@ -0,0 +1,51 @@
+package quota
+
+import (
+	"github.com/google/uuid"
+
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
+	quotaRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/quota"
+)
+
+type IService interface {
+	CheckRepositoriesLimit(workspaceID uuid.UUID) error
+	CheckTokensLimit(workspaceID uuid.UUID) error
+}
+
+type Service struct {
+	repository quotaRepository.IRepository
+}
+
+func NewQuotaService(repositoryQuota quotaRepository.IRepository) IService {
+	return &Service{
+		repository: repositoryQuota,
+	}
+}
+
+// CheckRepositoriesLimit returns an error when one more repository would exceed the limit of the workspace
+func (s *Service) CheckRepositoriesLimit(workspaceID uuid.UUID) error {
+	usage, err := s.repository.GetUsage(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	if usage.IsRepositoriesLimitReached() {
+		return quotaEnums.ErrorRepositoriesLimitReached
+	}
+
+	return nil
+}
+
+// CheckTokensLimit counts the workspace and repository tokens of the workspace, the expired ones are not counted
+func (s *Service) CheckTokensLimit(workspaceID uuid.UUID) error {
+	usage, err := s.repository.GetUsage(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	if usage.IsTokensLimitReached() {
+		return quotaEnums.ErrorTokensLimitReached
+	}
+
+	return nil
+}
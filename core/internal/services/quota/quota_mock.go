@@ -0,0 +1,22 @@
+package quota
+
+import (
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) CheckRepositoriesLimit(_ uuid.UUID) error {
+	args := m.MethodCalled("CheckRepositoriesLimit")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) CheckTokensLimit(_ uuid.UUID) error {
+	args := m.MethodCalled("CheckTokensLimit")
+	return mockUtils.ReturnNilOrError(args, 0)
+}
@@ -0,0 +1,72 @@
+package quota
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+	quotaEnums "github.com/ZupIT/horusec-platform/core/internal/enums/quota"
+	quotaRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/quota"
+)
+
+func TestNewQuotaService(t *testing.T) {
+	t.Run("should success create a new quota service", func(t *testing.T) {
+		assert.NotNil(t, NewQuotaService(nil))
+	})
+}
+
+func TestCheckRepositoriesLimit(t *testing.T) {
+	t.Run("should return no error when repositories are under the limit", func(t *testing.T) {
+		limit := 2
+
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{MaxRepositories: &limit, Repositories: 1}, nil)
+
+		assert.NoError(t, NewQuotaService(repositoryMock).CheckRepositoriesLimit(uuid.New()))
+	})
+
+	t.Run("should return error when repositories reached the limit", func(t *testing.T) {
+		limit := 1
+
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{MaxRepositories: &limit, Repositories: 1}, nil)
+
+		assert.Equal(t, quotaEnums.ErrorRepositoriesLimitReached,
+			NewQuotaService(repositoryMock).CheckRepositoriesLimit(uuid.New()))
+	})
+
+	t.Run("should return error when failed to get usage", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{}, errors.New("test"))
+
+		assert.Error(t, NewQuotaService(repositoryMock).CheckRepositoriesLimit(uuid.New()))
+	})
+}
+
+func TestCheckTokensLimit(t *testing.T) {
+	t.Run("should return no error when there is no limit", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{Tokens: 100}, nil)
+
+		assert.NoError(t, NewQuotaService(repositoryMock).CheckTokensLimit(uuid.New()))
+	})
+
+	t.Run("should return error when tokens reached the limit", func(t *testing.T) {
+		limit := 1
+
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{MaxTokens: &limit, Tokens: 1}, nil)
+
+		assert.Equal(t, quotaEnums.ErrorTokensLimitReached, NewQuotaService(repositoryMock).CheckTokensLimit(uuid.New()))
+	})
+
+	t.Run("should return error when failed to get usage", func(t *testing.T) {
+		repositoryMock := &quotaRepository.Mock{}
+		repositoryMock.On("GetUsage").Return(&quotaEntities.Usage{}, errors.New("test"))
+
+		assert.Error(t, NewQuotaService(repositoryMock).CheckTokensLimit(uuid.New()))
+	})
+}
@@ -0,0 +1,54 @@
+package scm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+// listBitbucketRepositories follows the next page links returned by the api until the last page, the links must be
+// of the same base url, since the credentials are sent with each one of them
+func (s *Service) listBitbucketRepositories(
+	importation *importerEntities.Import) ([]importerEntities.RemoteRepository, error) {
+	var remotes []importerEntities.RemoteRepository
+	next := s.getBitbucketFirstPageURL(importation)
+
+	for page := 1; page <= importerEnums.MaxPages; page++ {
+		response, err := s.getBitbucketPage(importation, next)
+		if err != nil {
+			return nil, err
+		}
+
+		if remotes, next = append(remotes, response.ToRemoteRepositories()...), response.Next; next == "" {
+			return remotes, nil
+		}
+	}
+
+	return nil, importerEnums.ErrorTooManyRemotePages
+}
+
+func (s *Service) getBitbucketFirstPageURL(importation *importerEntities.Import) string {
+	return fmt.Sprintf("%s/2.0/repositories/%s?pagelen=%d", importation.BaseURL,
+		url.PathEscape(importation.Organization), importerEnums.PageSize)
+}
+
+func (s *Service) getBitbucketPage(importation *importerEntities.Import,
+	pageURL string) (*importerEntities.BitbucketPage, error) {
+	if !strings.HasPrefix(pageURL, importation.BaseURL) {
+		return nil, importerEnums.ErrorProviderRequestFailed
+	}
+
+	request, err := http.NewRequest(http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.SetBasicAuth(importation.Username, importation.AccessToken)
+
+	response := &importerEntities.BitbucketPage{}
+	return response, s.doRequest(request, response)
+}
@@ -0,0 +1,62 @@
+package scm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func newBitbucketServer(t *testing.T, next string) *httptest.Server {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, _ := r.BasicAuth()
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "app-password", password)
+
+		if r.URL.Query().Get("page") == "" {
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"values": [{"name": "first", "mainbranch": {"name": "main"},
+				"links": {"html": {"href": "https://bitbucket.org/horusec/first"}}}], "next": "%s"}`,
+				fmt.Sprintf(next, server.URL))))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"values": [{"name": "second"}]}`))
+	}))
+
+	return server
+}
+
+func TestListBitbucketRepositories(t *testing.T) {
+	t.Run("should success list the repositories following the next pages", func(t *testing.T) {
+		server := newBitbucketServer(t, "%s/2.0/repositories/horusec?pagelen=100&page=2")
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		remotes, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderBitbucket, BaseURL: server.URL, Organization: "horusec",
+			Username: "user", AccessToken: "app-password"})
+		assert.NoError(t, err)
+		assert.Len(t, *remotes, 2)
+		assert.Equal(t, "https://bitbucket.org/horusec/first", (*remotes)[0].VcsURL)
+		assert.Equal(t, "main", (*remotes)[0].DefaultBranch)
+		assert.Equal(t, "second", (*remotes)[1].Name)
+	})
+
+	t.Run("should return error when the next page is not of the same base url", func(t *testing.T) {
+		server := newBitbucketServer(t, "http://other.host/%s?page=2")
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		_, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderBitbucket, BaseURL: server.URL, Organization: "horusec",
+			Username: "user", AccessToken: "app-password"})
+		assert.Equal(t, importerEnums.ErrorProviderRequestFailed, err)
+	})
+}
@@ -0,0 +1,41 @@
+package scm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func (s *Service) listGithubRepositories(
+	importation *importerEntities.Import) ([]importerEntities.RemoteRepository, error) {
+	return s.listPages(func(page int) ([]importerEntities.RemoteRepository, error) {
+		var repositories []importerEntities.GithubRepository
+		if err := s.doRequestGithubPage(importation, page, &repositories); err != nil {
+			return nil, err
+		}
+
+		remotes := make([]importerEntities.RemoteRepository, len(repositories))
+		for index := range repositories {
+			remotes[index] = repositories[index].ToRemoteRepository()
+		}
+
+		return remotes, nil
+	})
+}
+
+func (s *Service) doRequestGithubPage(importation *importerEntities.Import, page int,
+	repositories *[]importerEntities.GithubRepository) error {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/orgs/%s/repos?per_page=%d&page=%d",
+		importation.BaseURL, url.PathEscape(importation.Organization), importerEnums.PageSize, page), nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/vnd.github.v3+json")
+	request.Header.Set("Authorization", fmt.Sprintf("token %s", importation.AccessToken))
+
+	return s.doRequest(request, repositories)
+}
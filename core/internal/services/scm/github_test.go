@@ -0,0 +1,59 @@
+package scm
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func newGithubServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orgs/horusec/repos", r.URL.Path)
+		assert.Equal(t, "token access-token", r.Header.Get("Authorization"))
+
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = w.Write([]byte(fmt.Sprintf("[%s]", strings.TrimSuffix(strings.Repeat(
+				`{"name": "first", "html_url": "https://github.com/horusec/first", "default_branch": "main"},`,
+				importerEnums.PageSize), ","))))
+			return
+		}
+
+		_, _ = w.Write([]byte(`[{"name": "second", "description": "test", "default_branch": "develop"}]`))
+	}))
+}
+
+func TestListGithubRepositories(t *testing.T) {
+	t.Run("should success list the repositories of all pages", func(t *testing.T) {
+		server := newGithubServer(t)
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		remotes, err := NewSCMService().ListRepositories(&importerEntities.Import{Provider: importerEnums.ProviderGithub,
+			BaseURL: server.URL, Organization: "horusec", AccessToken: "access-token"})
+		assert.NoError(t, err)
+		assert.Len(t, *remotes, importerEnums.PageSize+1)
+		assert.Equal(t, "https://github.com/horusec/first", (*remotes)[0].VcsURL)
+		assert.Equal(t, "main", (*remotes)[0].DefaultBranch)
+		assert.Equal(t, "second", (*remotes)[importerEnums.PageSize].Name)
+		assert.Equal(t, "develop", (*remotes)[importerEnums.PageSize].DefaultBranch)
+	})
+
+	t.Run("should return error when the response is invalid", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("invalid"))
+		}))
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		_, err := NewSCMService().ListRepositories(&importerEntities.Import{Provider: importerEnums.ProviderGithub,
+			BaseURL: server.URL, Organization: "horusec"})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,42 @@
+package scm
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func (s *Service) listGitlabRepositories(
+	importation *importerEntities.Import) ([]importerEntities.RemoteRepository, error) {
+	return s.listPages(func(page int) ([]importerEntities.RemoteRepository, error) {
+		var repositories []importerEntities.GitlabRepository
+		if err := s.doRequestGitlabPage(importation, page, &repositories); err != nil {
+			return nil, err
+		}
+
+		remotes := make([]importerEntities.RemoteRepository, len(repositories))
+		for index := range repositories {
+			remotes[index] = repositories[index].ToRemoteRepository()
+		}
+
+		return remotes, nil
+	})
+}
+
+// getGitlabPage lists the projects of the group and of all its subgroups, the group can be informed by its full path
+func (s *Service) doRequestGitlabPage(importation *importerEntities.Import, page int,
+	repositories *[]importerEntities.GitlabRepository) error {
+	request, err := http.NewRequest(http.MethodGet, fmt.Sprintf(
+		"%s/api/v4/groups/%s/projects?include_subgroups=true&per_page=%d&page=%d", importation.BaseURL,
+		url.PathEscape(importation.Organization), importerEnums.PageSize, page), nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set(importerEnums.GitlabPrivateTokenHeader, importation.AccessToken)
+
+	return s.doRequest(request, repositories)
+}
@@ -0,0 +1,46 @@
+package scm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func TestListGitlabRepositories(t *testing.T) {
+	t.Run("should success list the projects of the group and its subgroups", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v4/groups/horusec%2Fplatform/projects", r.URL.EscapedPath())
+			assert.Equal(t, "true", r.URL.Query().Get("include_subgroups"))
+			assert.Equal(t, "access-token", r.Header.Get(importerEnums.GitlabPrivateTokenHeader))
+
+			_, _ = w.Write([]byte(`[{"path_with_namespace": "horusec/platform/core", "default_branch": "main",
+				"web_url": "https://gitlab.com/horusec/platform/core"}]`))
+		}))
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		remotes, err := NewSCMService().ListRepositories(&importerEntities.Import{Provider: importerEnums.ProviderGitlab,
+			BaseURL: server.URL, Organization: "horusec/platform", AccessToken: "access-token"})
+		assert.NoError(t, err)
+		assert.Len(t, *remotes, 1)
+		assert.Equal(t, "horusec/platform/core", (*remotes)[0].Name)
+		assert.Equal(t, "https://gitlab.com/horusec/platform/core", (*remotes)[0].VcsURL)
+	})
+
+	t.Run("should return error when the group does not exist", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		_, err := NewSCMService().ListRepositories(&importerEntities.Import{Provider: importerEnums.ProviderGitlab,
+			BaseURL: server.URL, Organization: "horusec"})
+		assert.Equal(t, importerEnums.ErrorProviderRequestFailed, err)
+	})
+}
@@ -0,0 +1,101 @@
+package scm
+
+import (
+	"encoding/json"
+	"net/http"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+type IService interface {
+	ListRepositories(importation *importerEntities.Import) (*[]importerEntities.RemoteRepository, error)
+}
+
+type Service struct {
+	client *http.Client
+}
+
+func NewSCMService() IService {
+	return &Service{
+		client: &http.Client{Timeout: importerEnums.ProviderTimeout, CheckRedirect: denyRedirect},
+	}
+}
+
+// denyRedirect keeps the requests inside the allowed base url, the redirect response fails as a rejected request
+func denyRedirect(_ *http.Request, _ []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+// ListRepositories returns all the repositories of the organization, the archived ones in the provider are removed
+// so they are also archived in horusec on the next sync
+func (s *Service) ListRepositories(
+	importation *importerEntities.Import) (*[]importerEntities.RemoteRepository, error) {
+	if !importation.HasAllowedBaseURL() {
+		return &[]importerEntities.RemoteRepository{}, importerEnums.ErrorBaseURLNotAllowed
+	}
+
+	return s.listProviderRepositories(importation)
+}
+
+func (s *Service) listProviderRepositories(
+	importation *importerEntities.Import) (*[]importerEntities.RemoteRepository, error) {
+	var remotes []importerEntities.RemoteRepository
+	var err error
+
+	switch importation.Provider {
+	case importerEnums.ProviderGitlab:
+		remotes, err = s.listGitlabRepositories(importation)
+	case importerEnums.ProviderBitbucket:
+		remotes, err = s.listBitbucketRepositories(importation)
+	default:
+		remotes, err = s.listGithubRepositories(importation)
+	}
+
+	return s.removeArchived(remotes), err
+}
+
+func (s *Service) removeArchived(remotes []importerEntities.RemoteRepository) *[]importerEntities.RemoteRepository {
+	active := []importerEntities.RemoteRepository{}
+
+	for index := range remotes {
+		if !remotes[index].IsArchived {
+			active = append(active, remotes[index])
+		}
+	}
+
+	return &active
+}
+
+// listPages requests the pages until one of them has less repositories than the page size
+func (s *Service) listPages(
+	getPage func(page int) ([]importerEntities.RemoteRepository, error)) ([]importerEntities.RemoteRepository, error) {
+	var remotes []importerEntities.RemoteRepository
+
+	for page := 1; page <= importerEnums.MaxPages; page++ {
+		repositories, err := getPage(page)
+		if err != nil {
+			return nil, err
+		}
+
+		if remotes = append(remotes, repositories...); len(repositories) < importerEnums.PageSize {
+			return remotes, nil
+		}
+	}
+
+	return nil, importerEnums.ErrorTooManyRemotePages
+}
+
+func (s *Service) doRequest(request *http.Request, response interface{}) error {
+	httpResponse, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer httpResponse.Body.Close()
+	if httpResponse.StatusCode != http.StatusOK {
+		return importerEnums.ErrorProviderRequestFailed
+	}
+
+	return json.NewDecoder(httpResponse.Body).Decode(response)
+}
@@ -0,0 +1,18 @@
+package scm
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) ListRepositories(_ *importerEntities.Import) (*[]importerEntities.RemoteRepository, error) {
+	args := m.MethodCalled("ListRepositories")
+	return args.Get(0).(*[]importerEntities.RemoteRepository), mockUtils.ReturnNilOrError(args, 1)
+}
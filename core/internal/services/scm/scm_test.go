@@ -0,0 +1,84 @@
+package scm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+// allowBaseURL allows the url of the test server and returns the function that removes it
+func allowBaseURL(baseURL string) func() {
+	_ = os.Setenv(importerEnums.EnvAllowedBaseURLs, baseURL)
+
+	return func() {
+		_ = os.Unsetenv(importerEnums.EnvAllowedBaseURLs)
+	}
+}
+
+func TestNewSCMService(t *testing.T) {
+	t.Run("should success create a new scm service", func(t *testing.T) {
+		assert.NotNil(t, NewSCMService())
+	})
+}
+
+func TestListRepositories(t *testing.T) {
+	t.Run("should remove the repositories archived in the provider", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"name": "active"}, {"name": "archived", "archived": true}]`))
+		}))
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		remotes, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderGithub, BaseURL: server.URL, Organization: "org"})
+		assert.NoError(t, err)
+		assert.Len(t, *remotes, 1)
+		assert.Equal(t, "active", (*remotes)[0].Name)
+	})
+
+	t.Run("should return error when the provider rejects the request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		remotes, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderGitlab, BaseURL: server.URL, Organization: "group"})
+		assert.Error(t, err)
+		assert.Equal(t, importerEnums.ErrorProviderRequestFailed, err)
+		assert.Empty(t, *remotes)
+	})
+
+	t.Run("should return error when the base url is not allowed", func(t *testing.T) {
+		remotes, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderGithub, BaseURL: "http://127.0.0.1:0", Organization: "org"})
+		assert.Equal(t, importerEnums.ErrorBaseURLNotAllowed, err)
+		assert.Empty(t, *remotes)
+	})
+
+	t.Run("should return error when the provider redirects the request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://127.0.0.1:0", http.StatusFound)
+		}))
+		defer server.Close()
+		defer allowBaseURL(server.URL)()
+
+		_, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderGithub, BaseURL: server.URL, Organization: "org"})
+		assert.Equal(t, importerEnums.ErrorProviderRequestFailed, err)
+	})
+
+	t.Run("should return error when failed to reach the provider", func(t *testing.T) {
+		defer allowBaseURL("http://127.0.0.1:0")()
+		_, err := NewSCMService().ListRepositories(&importerEntities.Import{
+			Provider: importerEnums.ProviderBitbucket, BaseURL: "http://127.0.0.1:0", Organization: "workspace"})
+		assert.Error(t, err)
+	})
+}
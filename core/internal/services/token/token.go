@@ -0,0 +1,81 @@
+package token
+
+import (
+	"time"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/queues"
+	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	tokenRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
+	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
+)
+
+type IService interface {
+	Rotate(filter map[string]interface{}, gracePeriod time.Duration) (string, error)
+	NotifyExpiring()
+}
+
+type Service struct {
+	broker       brokerService.IBroker
+	appConfig    app.IConfig
+	useCases     tokenUseCases.IUseCases
+	repository   tokenRepository.IRepository
+	noticePeriod time.Duration
+}
+
+func NewTokenService(broker brokerService.IBroker, appConfig app.IConfig, useCases tokenUseCases.IUseCases,
+	repositoryToken tokenRepository.IRepository) IService {
+	return &Service{
+		broker:     broker,
+		appConfig:  appConfig,
+		useCases:   useCases,
+		repository: repositoryToken,
+		noticePeriod: time.Duration(env.GetEnvOrDefaultInt(tokenEnums.EnvExpirationNoticeDays,
+			tokenEnums.DefaultExpirationNoticeDays)) * 24 * time.Hour,
+	}
+}
+
+// Rotate issues a successor of the token and keeps the previous one working until the end of the grace period, so
+// the pipelines using it can be updated without downtime
+func (s *Service) Rotate(filter map[string]interface{}, gracePeriod time.Duration) (string, error) {
+	token, err := s.repository.GetToken(filter)
+	if err != nil {
+		return "", err
+	}
+
+	if token.IsRotated() {
+		return "", tokenEnums.ErrorTokenAlreadyRotated
+	}
+
+	successor, value := token.NewSuccessor()
+	return value, s.repository.RotateToken(token.Retire(successor.TokenID, gracePeriod), successor)
+}
+
+// NotifyExpiring emails the admins of each token that expires inside the notice period, the tokens are claimed as
+// notified before the emails are sent, so each token is notified once even with several replicas running
+func (s *Service) NotifyExpiring() {
+	if s.appConfig.IsEmailsDisabled() {
+		return
+	}
+
+	logger.LogInfo(tokenEnums.MessageNotifyingExpiringTokens)
+	expiring, err := s.repository.ClaimExpiring(time.Now().Add(s.noticePeriod))
+	if err != nil {
+		logger.LogError(tokenEnums.MessageFailedToClaimExpiringTokens, err)
+		return
+	}
+
+	s.sendExpirationEmails(expiring)
+}
+
+func (s *Service) sendExpirationEmails(expiring *[]tokenEntities.Expiring) {
+	for index := range *expiring {
+		logger.LogError(tokenEnums.MessageFailedToSendExpirationEmail, s.broker.Publish(
+			queues.HorusecEmail.ToString(), "", "", s.useCases.NewTokenExpirationEmail(&(*expiring)[index])))
+	}
+}
@@ -0,0 +1,22 @@
+package token
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	mockUtils "github.com/ZupIT/horusec-devkit/pkg/utils/mock"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Rotate(_ map[string]interface{}, _ time.Duration) (string, error) {
+	args := m.MethodCalled("Rotate")
+	return args.Get(0).(string), mockUtils.ReturnNilOrError(args, 1)
+}
+
+func (m *Mock) NotifyExpiring() {
+	_ = m.MethodCalled("NotifyExpiring")
+}
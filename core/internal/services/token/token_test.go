@@ -0,0 +1,96 @@
+package token
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/app"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
+	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
+	tokenRepository "github.com/ZupIT/horusec-platform/core/internal/repositories/token"
+	tokenUseCases "github.com/ZupIT/horusec-platform/core/internal/usecases/token"
+)
+
+func newTokenService(brokerMock *broker.Mock, appConfig *app.Mock, repositoryMock *tokenRepository.Mock) IService {
+	return NewTokenService(brokerMock, appConfig, tokenUseCases.NewTokenUseCases(), repositoryMock)
+}
+
+func TestNewTokenService(t *testing.T) {
+	t.Run("should success create a new token service", func(t *testing.T) {
+		assert.NotNil(t, newTokenService(&broker.Mock{}, &app.Mock{}, &tokenRepository.Mock{}))
+	})
+}
+
+func TestRotate(t *testing.T) {
+	t.Run("should success rotate token", func(t *testing.T) {
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("GetToken").Return(&tokenEntities.Token{TokenID: uuid.New()}, nil)
+		repositoryMock.On("RotateToken").Return(nil)
+
+		token, err := newTokenService(&broker.Mock{}, &app.Mock{}, repositoryMock).Rotate(nil, time.Hour)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+	})
+
+	t.Run("should return error when token was already rotated", func(t *testing.T) {
+		successorID := uuid.New()
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("GetToken").Return(&tokenEntities.Token{SuccessorID: &successorID}, nil)
+
+		_, err := newTokenService(&broker.Mock{}, &app.Mock{}, repositoryMock).Rotate(nil, time.Hour)
+		assert.Equal(t, tokenEnums.ErrorTokenAlreadyRotated, err)
+	})
+
+	t.Run("should return error when failed to get token", func(t *testing.T) {
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("GetToken").Return(&tokenEntities.Token{}, databaseEnums.ErrorNotFoundRecords)
+
+		_, err := newTokenService(&broker.Mock{}, &app.Mock{}, repositoryMock).Rotate(nil, time.Hour)
+		assert.Equal(t, databaseEnums.ErrorNotFoundRecords, err)
+	})
+}
+
+func TestNotifyExpiring(t *testing.T) {
+	tokenID := uuid.New()
+	expiring := &[]tokenEntities.Expiring{{TokenID: tokenID, Email: "admin1@test.com"},
+		{TokenID: tokenID, Email: "admin2@test.com"}}
+
+	t.Run("should send an email to each admin of the claimed tokens", func(t *testing.T) {
+		appConfig := &app.Mock{}
+		appConfig.On("IsEmailsDisabled").Return(false)
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ClaimExpiring").Return(expiring, nil)
+
+		newTokenService(brokerMock, appConfig, repositoryMock).NotifyExpiring()
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+	})
+
+	t.Run("should do nothing when emails are disabled", func(t *testing.T) {
+		appConfig := &app.Mock{}
+		appConfig.On("IsEmailsDisabled").Return(true)
+		repositoryMock := &tokenRepository.Mock{}
+
+		newTokenService(&broker.Mock{}, appConfig, repositoryMock).NotifyExpiring()
+		repositoryMock.AssertNotCalled(t, "ClaimExpiring")
+	})
+
+	t.Run("should not send emails when failed to claim tokens", func(t *testing.T) {
+		appConfig := &app.Mock{}
+		appConfig.On("IsEmailsDisabled").Return(false)
+		brokerMock := &broker.Mock{}
+		repositoryMock := &tokenRepository.Mock{}
+		repositoryMock.On("ClaimExpiring").Return(&[]tokenEntities.Expiring{}, errors.New("test"))
+
+		newTokenService(brokerMock, appConfig, repositoryMock).NotifyExpiring()
+		brokerMock.AssertNotCalled(t, "Publish")
+	})
+}
@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+type IUseCases interface {
+	FilterFromRequest(r *http.Request) (*auditEntities.Filter, error)
+	ExportFormatFromRequest(r *http.Request) (auditEnums.Format, error)
+	ExportRecords(format auditEnums.Format, records *[]auditEntities.Record) ([]byte, error)
+	FilterEventByID(eventID uuid.UUID) map[string]interface{}
+}
+
+type UseCases struct{}
+
+func NewAuditUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) FilterFromRequest(r *http.Request) (*auditEntities.Filter, error) {
+	filter := &auditEntities.Filter{}
+
+	if err := filter.SetFilterDataFromRequest(r); err != nil {
+		return nil, err
+	}
+
+	return filter, filter.Validate()
+}
+
+// ExportFormatFromRequest defaults to json when the format is not informed
+func (u *UseCases) ExportFormatFromRequest(r *http.Request) (auditEnums.Format, error) {
+	switch format := auditEnums.Format(r.URL.Query().Get(auditEnums.FormatQuery)); format {
+	case "", auditEnums.FormatJSON:
+		return auditEnums.FormatJSON, nil
+	case auditEnums.FormatCSV:
+		return auditEnums.FormatCSV, nil
+	}
+
+	return "", auditEnums.ErrorInvalidExportFormat
+}
+
+func (u *UseCases) ExportRecords(format auditEnums.Format, records *[]auditEntities.Record) ([]byte, error) {
+	if format == auditEnums.FormatCSV {
+		return u.toCSV(records)
+	}
+
+	return json.Marshal(auditEntities.NewListResponse(records, len(*records)).Events)
+}
+
+func (u *UseCases) toCSV(records *[]auditEntities.Record) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := csv.NewWriter(buffer)
+
+	if err := writer.Write(auditEnums.CSVHeader()); err != nil {
+		return nil, err
+	}
+
+	for index := range *records {
+		if err := writer.Write((*records)[index].ToCSVRow()); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+func (u *UseCases) FilterEventByID(eventID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"event_id": eventID}
+}
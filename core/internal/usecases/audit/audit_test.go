@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	auditEntities "github.com/ZupIT/horusec-platform/core/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/core/internal/enums/audit"
+)
+
+func newRecords() *[]auditEntities.Record {
+	event := auditEntities.NewEvent(auditEnums.ActionWorkspaceCreate, auditEnums.TargetWorkspace, uuid.New())
+
+	return &[]auditEntities.Record{*event.SetChanges(nil, map[string]string{"name": "test"}).ToRecord()}
+}
+
+func TestNewAuditUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewAuditUseCases())
+	})
+}
+
+func TestFilterFromRequest(t *testing.T) {
+	t.Run("should success get filter from request", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test?action=workspace.create", nil)
+
+		filter, err := NewAuditUseCases().FilterFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "workspace.create", filter.Action)
+	})
+
+	t.Run("should return error when invalid filter", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test?from=test", nil)
+
+		filter, err := NewAuditUseCases().FilterFromRequest(r)
+		assert.Error(t, err)
+		assert.Nil(t, filter)
+	})
+
+	t.Run("should return error when invalid page", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test?page=-1", nil)
+
+		_, err := NewAuditUseCases().FilterFromRequest(r)
+		assert.Error(t, err)
+	})
+}
+
+func TestExportFormatFromRequest(t *testing.T) {
+	t.Run("should return json when format is empty or json", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+
+		format, err := NewAuditUseCases().ExportFormatFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, auditEnums.FormatJSON, format)
+
+		r, _ = http.NewRequest(http.MethodGet, "test?format=json", nil)
+
+		format, err = NewAuditUseCases().ExportFormatFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, auditEnums.FormatJSON, format)
+	})
+
+	t.Run("should return csv", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test?format=csv", nil)
+
+		format, err := NewAuditUseCases().ExportFormatFromRequest(r)
+		assert.NoError(t, err)
+		assert.Equal(t, auditEnums.FormatCSV, format)
+	})
+
+	t.Run("should return error when invalid format", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "test?format=pdf", nil)
+
+		_, err := NewAuditUseCases().ExportFormatFromRequest(r)
+		assert.Equal(t, auditEnums.ErrorInvalidExportFormat, err)
+	})
+}
+
+func TestExportRecords(t *testing.T) {
+	t.Run("should success export records as csv with header", func(t *testing.T) {
+		content, err := NewAuditUseCases().ExportRecords(auditEnums.FormatCSV, newRecords())
+		assert.NoError(t, err)
+
+		rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+		assert.Equal(t, auditEnums.CSVHeader(), rows[0])
+	})
+
+	t.Run("should success export records as json", func(t *testing.T) {
+		content, err := NewAuditUseCases().ExportRecords(auditEnums.FormatJSON, newRecords())
+		assert.NoError(t, err)
+
+		var events []*auditEntities.Response
+		assert.NoError(t, json.Unmarshal(content, &events))
+		assert.Len(t, events, 1)
+		assert.JSONEq(t, `{"name": "test"}`, string(events[0].After))
+	})
+}
+
+func TestFilterEventByID(t *testing.T) {
+	t.Run("should success create filter by event id", func(t *testing.T) {
+		eventID := uuid.New()
+
+		assert.Equal(t, map[string]interface{}{"event_id": eventID}, NewAuditUseCases().FilterEventByID(eventID))
+	})
+}
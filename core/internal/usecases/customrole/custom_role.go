@@ -0,0 +1,47 @@
+package customrole
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+)
+
+type IUseCases interface {
+	CustomRoleDataFromIOReadCloser(body io.ReadCloser) (*customRoleEntities.Data, error)
+	FilterCustomRoleByID(customRoleID, workspaceID uuid.UUID) map[string]interface{}
+	FilterCustomRoleByName(workspaceID uuid.UUID, name string) map[string]interface{}
+	FilterListCustomRoles(workspaceID uuid.UUID) map[string]interface{}
+}
+
+type UseCases struct {
+}
+
+func NewCustomRoleUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) CustomRoleDataFromIOReadCloser(body io.ReadCloser) (*customRoleEntities.Data, error) {
+	data := &customRoleEntities.Data{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterCustomRoleByID(customRoleID, workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"custom_role_id": customRoleID, "workspace_id": workspaceID}
+}
+
+func (u *UseCases) FilterCustomRoleByName(workspaceID uuid.UUID, name string) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID, "name": name}
+}
+
+func (u *UseCases) FilterListCustomRoles(workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID}
+}
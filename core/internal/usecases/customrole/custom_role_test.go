@@ -0,0 +1,77 @@
+package customrole
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	customRoleEntities "github.com/ZupIT/horusec-platform/core/internal/entities/customrole"
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func TestNewCustomRoleUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewCustomRoleUseCases())
+	})
+}
+
+func TestCustomRoleDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get custom role data from request body", func(t *testing.T) {
+		readCloser, err := parser.ParseEntityToIOReadCloser(&customRoleEntities.Data{Name: "test",
+			Permissions: []permissionEnums.Permission{permissionEnums.VulnerabilityRead}})
+		assert.NoError(t, err)
+
+		response, err := NewCustomRoleUseCases().CustomRoleDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", response.Name)
+		assert.Equal(t, permissionEnums.VulnerabilityRead, response.Permissions[0])
+	})
+
+	t.Run("should return error when invalid permission", func(t *testing.T) {
+		readCloser, err := parser.ParseEntityToIOReadCloser(&customRoleEntities.Data{Name: "test",
+			Permissions: []permissionEnums.Permission{"test"}})
+		assert.NoError(t, err)
+
+		_, err = NewCustomRoleUseCases().CustomRoleDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewCustomRoleUseCases().CustomRoleDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterCustomRoleByID(t *testing.T) {
+	t.Run("should success create a filter by custom role and workspace id", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewCustomRoleUseCases().FilterCustomRoleByID(id, id)
+		assert.Equal(t, id, filter["custom_role_id"])
+		assert.Equal(t, id, filter["workspace_id"])
+	})
+}
+
+func TestFilterCustomRoleByName(t *testing.T) {
+	t.Run("should success create a filter by workspace id and name", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewCustomRoleUseCases().FilterCustomRoleByName(id, "test")
+		assert.Equal(t, id, filter["workspace_id"])
+		assert.Equal(t, "test", filter["name"])
+	})
+}
+
+func TestFilterListCustomRoles(t *testing.T) {
+	t.Run("should success create a filter by workspace id", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewCustomRoleUseCases().FilterListCustomRoles(id)
+		assert.Equal(t, id, filter["workspace_id"])
+	})
+}
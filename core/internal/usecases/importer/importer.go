@@ -0,0 +1,59 @@
+package importer
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+type IUseCases interface {
+	ImportDataFromIOReadCloser(body io.ReadCloser) (*importerEntities.Data, error)
+	FilterImportByID(importID, workspaceID uuid.UUID) map[string]interface{}
+	FilterImportByOrganization(data *importerEntities.Data) map[string]interface{}
+	FilterListImports(workspaceID uuid.UUID) map[string]interface{}
+	FilterRepositoryByID(repositoryID uuid.UUID) map[string]interface{}
+	ImportIDToUpdateMap(importID uuid.UUID) map[string]interface{}
+}
+
+type UseCases struct {
+}
+
+func NewImporterUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) ImportDataFromIOReadCloser(body io.ReadCloser) (*importerEntities.Data, error) {
+	data := &importerEntities.Data{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterImportByID(importID, workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"import_id": importID, "workspace_id": workspaceID}
+}
+
+func (u *UseCases) FilterImportByOrganization(data *importerEntities.Data) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": data.WorkspaceID, "provider": data.Provider,
+		"base_url": data.GetBaseURL(), "organization": data.Organization}
+}
+
+func (u *UseCases) FilterListImports(workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID}
+}
+
+func (u *UseCases) FilterRepositoryByID(repositoryID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"repository_id": repositoryID}
+}
+
+func (u *UseCases) ImportIDToUpdateMap(importID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{importerEnums.ColumnImportID: importID}
+}
@@ -0,0 +1,91 @@
+package importer
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	importerEntities "github.com/ZupIT/horusec-platform/core/internal/entities/importer"
+	importerEnums "github.com/ZupIT/horusec-platform/core/internal/enums/importer"
+)
+
+func TestNewImporterUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewImporterUseCases())
+	})
+}
+
+func TestImportDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get import data from request body", func(t *testing.T) {
+		readCloser, err := parser.ParseEntityToIOReadCloser(&importerEntities.Data{
+			Provider: importerEnums.ProviderGithub, Organization: "horusec", AccessToken: "token"})
+		assert.NoError(t, err)
+
+		response, err := NewImporterUseCases().ImportDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, "horusec", response.Organization)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewImporterUseCases().ImportDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when the data is invalid", func(t *testing.T) {
+		readCloser, err := parser.ParseEntityToIOReadCloser(&importerEntities.Data{
+			Provider: importerEnums.ProviderBitbucket, Organization: "horusec", AccessToken: "token"})
+		assert.NoError(t, err)
+
+		_, err = NewImporterUseCases().ImportDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterImportByID(t *testing.T) {
+	t.Run("should success create a filter by import and workspace id", func(t *testing.T) {
+		importID := uuid.New()
+		workspaceID := uuid.New()
+
+		filter := NewImporterUseCases().FilterImportByID(importID, workspaceID)
+		assert.Equal(t, importID, filter["import_id"])
+		assert.Equal(t, workspaceID, filter["workspace_id"])
+	})
+}
+
+func TestFilterImportByOrganization(t *testing.T) {
+	t.Run("should success create a filter by organization with the default base url", func(t *testing.T) {
+		filter := NewImporterUseCases().FilterImportByOrganization(&importerEntities.Data{
+			Provider: importerEnums.ProviderGitlab, Organization: "horusec"})
+		assert.Equal(t, importerEnums.GitlabDefaultBaseURL, filter["base_url"])
+		assert.Equal(t, "horusec", filter["organization"])
+	})
+}
+
+func TestFilterListImports(t *testing.T) {
+	t.Run("should success create a filter by workspace id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewImporterUseCases().FilterListImports(id)["workspace_id"])
+	})
+}
+
+func TestFilterRepositoryByID(t *testing.T) {
+	t.Run("should success create a filter by repository id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewImporterUseCases().FilterRepositoryByID(id)["repository_id"])
+	})
+}
+
+func TestImportIDToUpdateMap(t *testing.T) {
+	t.Run("should success create a map to set the import id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewImporterUseCases().ImportIDToUpdateMap(id)[importerEnums.ColumnImportID])
+	})
+}
@@ -0,0 +1,87 @@
+package invitation
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	envUtils "github.com/ZupIT/horusec-devkit/pkg/utils/env"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+type IUseCases interface {
+	InvitationDataFromIOReadCloser(body io.ReadCloser) (*invitationEntities.Data, error)
+	TokenDataFromIOReadCloser(body io.ReadCloser) (*invitationEntities.TokenData, error)
+	FilterInvitationByID(invitationID uuid.UUID) map[string]interface{}
+	FilterInvitationByTarget(invitationID, workspaceID, repositoryID uuid.UUID) map[string]interface{}
+	FilterListInvitations(workspaceID, repositoryID uuid.UUID) map[string]interface{}
+	NewPendingInvitationEmail(invitation *invitationEntities.Invitation, targetName string) []byte
+}
+
+type UseCases struct {
+}
+
+func NewInvitationUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) InvitationDataFromIOReadCloser(body io.ReadCloser) (*invitationEntities.Data, error) {
+	data := &invitationEntities.Data{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) TokenDataFromIOReadCloser(body io.ReadCloser) (*invitationEntities.TokenData, error) {
+	data := &invitationEntities.TokenData{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterInvitationByID(invitationID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"invitation_id": invitationID}
+}
+
+func (u *UseCases) FilterInvitationByTarget(invitationID, workspaceID,
+	repositoryID uuid.UUID) map[string]interface{} {
+	filter := u.FilterListInvitations(workspaceID, repositoryID)
+	filter["invitation_id"] = invitationID
+
+	return filter
+}
+
+func (u *UseCases) FilterListInvitations(workspaceID, repositoryID uuid.UUID) map[string]interface{} {
+	if repositoryID == uuid.Nil {
+		return map[string]interface{}{"workspace_id": workspaceID, "repository_id": nil}
+	}
+
+	return map[string]interface{}{"workspace_id": workspaceID, "repository_id": repositoryID}
+}
+
+func (u *UseCases) NewPendingInvitationEmail(invitation *invitationEntities.Invitation, targetName string) []byte {
+	emailMessage := &emailEntities.Message{
+		To:           invitation.Email,
+		TemplateName: invitationEnums.PendingInvitationTemplate,
+		Subject:      "[Horusec] You have been invited",
+		Data: map[string]interface{}{
+			"TargetName": targetName,
+			"Role":       invitation.Role,
+			"ExpiresAt":  invitation.ExpiresAt.Format("2006-01-02 15:04"),
+			"URL": fmt.Sprintf("%s/invitation?token=%s", envUtils.GetHorusecManagerURL(),
+				invitation.GenerateToken())},
+	}
+
+	return emailMessage.ToBytes()
+}
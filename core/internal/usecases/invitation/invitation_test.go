@@ -0,0 +1,109 @@
+package invitation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	invitationEntities "github.com/ZupIT/horusec-platform/core/internal/entities/invitation"
+	invitationEnums "github.com/ZupIT/horusec-platform/core/internal/enums/invitation"
+)
+
+func TestNewInvitationUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewInvitationUseCases())
+	})
+}
+
+func TestInvitationDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get invitation data from request body", func(t *testing.T) {
+		data := &invitationEntities.Data{Email: "test@test.com", Role: account.Member}
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		response, err := NewInvitationUseCases().InvitationDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, data.Email, response.Email)
+		assert.Equal(t, data.Role, response.Role)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewInvitationUseCases().InvitationDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestTokenDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get token data from request body", func(t *testing.T) {
+		readCloser, err := parser.ParseEntityToIOReadCloser(&invitationEntities.TokenData{Token: "test"})
+		assert.NoError(t, err)
+
+		response, err := NewInvitationUseCases().TokenDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", response.Token)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewInvitationUseCases().TokenDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterInvitationByID(t *testing.T) {
+	t.Run("should return filter by invitation id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewInvitationUseCases().FilterInvitationByID(id)["invitation_id"])
+	})
+}
+
+func TestFilterInvitationByTarget(t *testing.T) {
+	t.Run("should return filter by invitation, workspace and repository ids", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewInvitationUseCases().FilterInvitationByTarget(id, id, id)
+		assert.Equal(t, id, filter["invitation_id"])
+		assert.Equal(t, id, filter["workspace_id"])
+		assert.Equal(t, id, filter["repository_id"])
+	})
+}
+
+func TestFilterListInvitations(t *testing.T) {
+	t.Run("should filter only workspace invitations when repository id is empty", func(t *testing.T) {
+		filter := NewInvitationUseCases().FilterListInvitations(uuid.New(), uuid.Nil)
+
+		assert.Nil(t, filter["repository_id"])
+	})
+
+	t.Run("should filter by repository id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewInvitationUseCases().FilterListInvitations(uuid.New(), id)["repository_id"])
+	})
+}
+
+func TestNewPendingInvitationEmail(t *testing.T) {
+	t.Run("should create pending invitation email with token url", func(t *testing.T) {
+		invitation := (&invitationEntities.Data{Email: "test@test.com", Role: account.Member}).ToInvitation(time.Hour)
+
+		message := &emailEntities.Message{}
+		assert.NoError(t, json.Unmarshal(NewInvitationUseCases().NewPendingInvitationEmail(invitation, "test"),
+			message))
+
+		assert.Equal(t, invitation.Email, message.To)
+		assert.Equal(t, invitationEnums.PendingInvitationTemplate, message.TemplateName)
+		data := message.Data.(map[string]interface{})
+		assert.Contains(t, data["URL"], invitation.GenerateToken())
+		assert.Equal(t, "test", data["TargetName"])
+	})
+}
@@ -0,0 +1,37 @@
+package quota
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+)
+
+type IUseCases interface {
+	QuotaDataFromIOReadCloser(body io.ReadCloser) (*quotaEntities.Data, error)
+	FilterQuotaByWorkspaceID(workspaceID uuid.UUID) map[string]interface{}
+}
+
+type UseCases struct {
+}
+
+func NewQuotaUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) QuotaDataFromIOReadCloser(body io.ReadCloser) (*quotaEntities.Data, error) {
+	data := &quotaEntities.Data{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterQuotaByWorkspaceID(workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID}
+}
@@ -0,0 +1,57 @@
+package quota
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	quotaEntities "github.com/ZupIT/horusec-platform/core/internal/entities/quota"
+)
+
+func TestNewQuotaUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewQuotaUseCases())
+	})
+}
+
+func TestQuotaDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get quota data from request body", func(t *testing.T) {
+		limit := 10
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&quotaEntities.Data{MaxRepositories: &limit})
+		assert.NoError(t, err)
+
+		response, err := NewQuotaUseCases().QuotaDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, limit, *response.MaxRepositories)
+		assert.Nil(t, response.MaxTokens)
+	})
+
+	t.Run("should return error when negative limit", func(t *testing.T) {
+		limit := -1
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&quotaEntities.Data{MaxTokens: &limit})
+		assert.NoError(t, err)
+
+		_, err = NewQuotaUseCases().QuotaDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewQuotaUseCases().QuotaDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterQuotaByWorkspaceID(t *testing.T) {
+	t.Run("should success create a filter by workspace id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewQuotaUseCases().FilterQuotaByWorkspaceID(id)["workspace_id"])
+	})
+}
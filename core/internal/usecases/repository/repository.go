@@ -26,6 +26,8 @@ type IUseCases interface {
 	NewRepositoryInviteEmail(email, username, repositoryName string) []byte
 	InheritWorkspaceGroups(repository *repositoryEntities.Repository,
 		workspace *workspaceEntities.Workspace) *repositoryEntities.Repository
+	TransferDataFromIOReadCloser(body io.ReadCloser) (*repositoryEntities.TransferData, error)
+	FilterAccountRepositoriesByAccounts(repositoryID uuid.UUID, accountIDs []uuid.UUID) map[string]interface{}
 }
 
 type UseCases struct {
@@ -110,3 +112,18 @@ func (u *UseCases) replaceGroupsIfEmpty(repositoryGroups, workspaceGroups []stri
 
 	return repositoryGroups
 }
+
+func (u *UseCases) TransferDataFromIOReadCloser(body io.ReadCloser) (*repositoryEntities.TransferData, error) {
+	data := &repositoryEntities.TransferData{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterAccountRepositoriesByAccounts(repositoryID uuid.UUID,
+	accountIDs []uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"repository_id": repositoryID, "account_id": accountIDs}
+}
@@ -249,3 +249,57 @@ func TestInheritWorkspaceGroups(t *testing.T) {
 		assert.NotEqual(t, workspace.AuthzMember, repository.AuthzMember)
 	})
 }
+
+func TestTransferDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get transfer data from request body", func(t *testing.T) {
+		useCases := NewRepositoryUseCases()
+
+		data := &repositoryEntities.TransferData{
+			TargetWorkspaceID: uuid.New(),
+			Name:              "test",
+		}
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		response, err := useCases.TransferDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, data.TargetWorkspaceID, response.TargetWorkspaceID)
+		assert.Equal(t, data.Name, response.Name)
+	})
+
+	t.Run("should return error when target workspace is not informed", func(t *testing.T) {
+		useCases := NewRepositoryUseCases()
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&repositoryEntities.TransferData{})
+		assert.NoError(t, err)
+
+		_, err = useCases.TransferDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to parse body to entity", func(t *testing.T) {
+		useCases := NewRepositoryUseCases()
+
+		readCloser, err := parser.ParseEntityToIOReadCloser("")
+		assert.NoError(t, err)
+
+		response, err := useCases.TransferDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+}
+
+func TestFilterAccountRepositoriesByAccounts(t *testing.T) {
+	t.Run("should success create a filter by repository and accounts", func(t *testing.T) {
+		useCases := NewRepositoryUseCases()
+		id := uuid.New()
+
+		filter := useCases.FilterAccountRepositoriesByAccounts(id, []uuid.UUID{id})
+
+		assert.NotPanics(t, func() {
+			assert.Equal(t, id, filter["repository_id"])
+			assert.Equal(t, []uuid.UUID{id}, filter["account_id"])
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package team
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+)
+
+type IUseCases interface {
+	TeamDataFromIOReadCloser(body io.ReadCloser) (*teamEntities.Data, error)
+	MemberDataFromIOReadCloser(body io.ReadCloser) (*teamEntities.MemberData, error)
+	RepositoryRoleDataFromIOReadCloser(body io.ReadCloser) (*teamEntities.RepositoryRoleData, error)
+	FilterTeamByID(teamID, workspaceID uuid.UUID) map[string]interface{}
+	FilterTeamByName(workspaceID uuid.UUID, name string) map[string]interface{}
+	FilterListTeams(workspaceID uuid.UUID) map[string]interface{}
+	FilterMemberByID(teamID, accountID uuid.UUID) map[string]interface{}
+	FilterRepositoryRoleByID(teamID, repositoryID uuid.UUID) map[string]interface{}
+}
+
+type UseCases struct {
+}
+
+func NewTeamUseCases() IUseCases {
+	return &UseCases{}
+}
+
+func (u *UseCases) TeamDataFromIOReadCloser(body io.ReadCloser) (*teamEntities.Data, error) {
+	data := &teamEntities.Data{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) MemberDataFromIOReadCloser(body io.ReadCloser) (*teamEntities.MemberData, error) {
+	data := &teamEntities.MemberData{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) RepositoryRoleDataFromIOReadCloser(body io.ReadCloser) (*teamEntities.RepositoryRoleData, error) {
+	data := &teamEntities.RepositoryRoleData{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+func (u *UseCases) FilterTeamByID(teamID, workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"team_id": teamID, "workspace_id": workspaceID}
+}
+
+func (u *UseCases) FilterTeamByName(workspaceID uuid.UUID, name string) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID, "name": name}
+}
+
+func (u *UseCases) FilterListTeams(workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"workspace_id": workspaceID}
+}
+
+func (u *UseCases) FilterMemberByID(teamID, accountID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"team_id": teamID, "account_id": accountID}
+}
+
+func (u *UseCases) FilterRepositoryRoleByID(teamID, repositoryID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"team_id": teamID, "repository_id": repositoryID}
+}
@@ -0,0 +1,122 @@
+package team
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/account"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
+
+	teamEntities "github.com/ZupIT/horusec-platform/core/internal/entities/team"
+)
+
+func TestNewTeamUseCases(t *testing.T) {
+	t.Run("should success create a new use cases", func(t *testing.T) {
+		assert.NotNil(t, NewTeamUseCases())
+	})
+}
+
+func TestTeamDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get team data from request body", func(t *testing.T) {
+		readCloser, err := parser.ParseEntityToIOReadCloser(&teamEntities.Data{Name: "test"})
+		assert.NoError(t, err)
+
+		response, err := NewTeamUseCases().TeamDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, "test", response.Name)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewTeamUseCases().TeamDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestMemberDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get member data from request body", func(t *testing.T) {
+		id := uuid.New()
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&teamEntities.MemberData{AccountID: id})
+		assert.NoError(t, err)
+
+		response, err := NewTeamUseCases().MemberDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, id, response.AccountID)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewTeamUseCases().MemberDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestRepositoryRoleDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get repository role data from request body", func(t *testing.T) {
+		data := &teamEntities.RepositoryRoleData{TeamID: uuid.New(), Role: account.Member}
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		response, err := NewTeamUseCases().RepositoryRoleDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, data.TeamID, response.TeamID)
+		assert.Equal(t, data.Role, response.Role)
+	})
+
+	t.Run("should return error when failed to parse body", func(t *testing.T) {
+		_, err := NewTeamUseCases().RepositoryRoleDataFromIOReadCloser(ioutil.NopCloser(strings.NewReader("")))
+		assert.Error(t, err)
+	})
+}
+
+func TestFilterTeamByID(t *testing.T) {
+	t.Run("should return filter by team and workspace ids", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewTeamUseCases().FilterTeamByID(id, id)
+		assert.Equal(t, id, filter["team_id"])
+		assert.Equal(t, id, filter["workspace_id"])
+	})
+}
+
+func TestFilterTeamByName(t *testing.T) {
+	t.Run("should return filter by workspace id and name", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewTeamUseCases().FilterTeamByName(id, "test")
+		assert.Equal(t, id, filter["workspace_id"])
+		assert.Equal(t, "test", filter["name"])
+	})
+}
+
+func TestFilterListTeams(t *testing.T) {
+	t.Run("should return filter by workspace id", func(t *testing.T) {
+		id := uuid.New()
+
+		assert.Equal(t, id, NewTeamUseCases().FilterListTeams(id)["workspace_id"])
+	})
+}
+
+func TestFilterMemberByID(t *testing.T) {
+	t.Run("should return filter by team and account ids", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewTeamUseCases().FilterMemberByID(id, id)
+		assert.Equal(t, id, filter["team_id"])
+		assert.Equal(t, id, filter["account_id"])
+	})
+}
+
+func TestFilterRepositoryRoleByID(t *testing.T) {
+	t.Run("should return filter by team and repository ids", func(t *testing.T) {
+		id := uuid.New()
+
+		filter := NewTeamUseCases().FilterRepositoryRoleByID(id, id)
+		assert.Equal(t, id, filter["team_id"])
+		assert.Equal(t, id, filter["repository_id"])
+	})
+}
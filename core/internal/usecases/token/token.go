@@ -1,13 +1,16 @@
 package token
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/google/uuid"
 
+	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	tokenEntities "github.com/ZupIT/horusec-platform/core/internal/entities/token"
+	tokenEnums "github.com/ZupIT/horusec-platform/core/internal/enums/token"
 )
 
 type IUseCases interface {
@@ -17,6 +20,9 @@ type IUseCases interface {
 	FilterListWorkspaceTokens(workspaceID uuid.UUID) map[string]interface{}
 	FilterListRepositoryTokens(workspaceID, repositoryID uuid.UUID) map[string]interface{}
 	NewTokenData(tokenID uuid.UUID, workspaceID, repositoryID string) *tokenEntities.Data
+	RotateDataFromIOReadCloser(body io.ReadCloser) (*tokenEntities.RotateData, error)
+	FilterNotRotatedTokenByID(tokenID uuid.UUID) map[string]interface{}
+	NewTokenExpirationEmail(expiring *tokenEntities.Expiring) []byte
 }
 
 type UseCases struct {
@@ -59,3 +65,34 @@ func (u *UseCases) NewTokenData(tokenID uuid.UUID, workspaceID, repositoryID str
 		TokenID:      tokenID,
 	}
 }
+
+func (u *UseCases) RotateDataFromIOReadCloser(body io.ReadCloser) (*tokenEntities.RotateData, error) {
+	data := &tokenEntities.RotateData{}
+
+	if err := parser.ParseBodyToEntity(body, data); err != nil {
+		return nil, err
+	}
+
+	return data, data.Validate()
+}
+
+// FilterNotRotatedTokenByID only matches the token while it has no successor, so a token is never rotated twice
+func (u *UseCases) FilterNotRotatedTokenByID(tokenID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"token_id": tokenID, "successor_id": nil}
+}
+
+func (u *UseCases) NewTokenExpirationEmail(expiring *tokenEntities.Expiring) []byte {
+	emailMessage := &emailEntities.Message{
+		To:           expiring.Email,
+		TemplateName: tokenEnums.TokenExpirationTemplate,
+		Subject:      fmt.Sprintf("[Horusec] Token of %s is about to expire", expiring.GetTargetName()),
+		Data: map[string]interface{}{
+			"Username":    expiring.Username,
+			"Description": expiring.Description,
+			"TargetName":  expiring.GetTargetName(),
+			"ExpiresAt":   expiring.ExpiresAt.Format("2006-01-02 15:04"),
+		},
+	}
+
+	return emailMessage.ToBytes()
+}
@@ -121,3 +121,70 @@ func TestNewTokenData(t *testing.T) {
 		assert.Equal(t, id, data.WorkspaceID)
 	})
 }
+
+func TestRotateDataFromIOReadCloser(t *testing.T) {
+	t.Run("should success get rotate data from request body", func(t *testing.T) {
+		useCases := NewTokenUseCases()
+		gracePeriod := 2
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&token.RotateData{GracePeriodHours: &gracePeriod})
+		assert.NoError(t, err)
+
+		response, err := useCases.RotateDataFromIOReadCloser(readCloser)
+		assert.NoError(t, err)
+		assert.Equal(t, 2*time.Hour, response.GetGracePeriod())
+	})
+
+	t.Run("should return error when grace period is too long", func(t *testing.T) {
+		useCases := NewTokenUseCases()
+		gracePeriod := 10000
+
+		readCloser, err := parser.ParseEntityToIOReadCloser(&token.RotateData{GracePeriodHours: &gracePeriod})
+		assert.NoError(t, err)
+
+		_, err = useCases.RotateDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when failed to parse body to entity", func(t *testing.T) {
+		useCases := NewTokenUseCases()
+
+		readCloser, err := parser.ParseEntityToIOReadCloser("")
+		assert.NoError(t, err)
+
+		response, err := useCases.RotateDataFromIOReadCloser(readCloser)
+		assert.Error(t, err)
+		assert.Nil(t, response)
+	})
+}
+
+func TestFilterNotRotatedTokenByID(t *testing.T) {
+	t.Run("should success create a filter by id of the token without successor", func(t *testing.T) {
+		useCases := NewTokenUseCases()
+		id := uuid.New()
+
+		filter := useCases.FilterNotRotatedTokenByID(id)
+
+		assert.NotPanics(t, func() {
+			assert.Equal(t, id, filter["token_id"])
+			assert.Nil(t, filter["successor_id"])
+			assert.Contains(t, filter, "successor_id")
+		})
+	})
+}
+
+func TestNewTokenExpirationEmail(t *testing.T) {
+	t.Run("should success create a token expiration email", func(t *testing.T) {
+		useCases := NewTokenUseCases()
+
+		email := useCases.NewTokenExpirationEmail(&token.Expiring{
+			Email:          "test@test.com",
+			WorkspaceName:  "workspace",
+			RepositoryName: "repository",
+			ExpiresAt:      time.Now(),
+		})
+
+		assert.Contains(t, string(email), "test@test.com")
+		assert.Contains(t, string(email), "workspace/repository")
+	})
+}
@@ -18,6 +18,7 @@ type IUseCases interface {
 	WorkspaceDataFromIOReadCloser(body io.ReadCloser) (data *workspace.Data, err error)
 	FilterAccountWorkspaceByID(accountID, workspaceID uuid.UUID) map[string]interface{}
 	FilterWorkspaceByID(workspaceID uuid.UUID) map[string]interface{}
+	FilterCustomRoleByID(customRoleID, workspaceID uuid.UUID) map[string]interface{}
 	NewWorkspaceData(workspaceID uuid.UUID, accountData *proto.GetAccountDataResponse) *workspace.Data
 	NewOrganizationInviteEmail(email, username, workspaceName string) []byte
 }
@@ -47,6 +48,10 @@ func (u *UseCases) FilterWorkspaceByID(workspaceID uuid.UUID) map[string]interfa
 	return map[string]interface{}{"workspace_id": workspaceID}
 }
 
+func (u *UseCases) FilterCustomRoleByID(customRoleID, workspaceID uuid.UUID) map[string]interface{} {
+	return map[string]interface{}{"custom_role_id": customRoleID, "workspace_id": workspaceID}
+}
+
 func (u *UseCases) NewWorkspaceData(workspaceID uuid.UUID, accountData *proto.GetAccountDataResponse) *workspace.Data {
 	data := &workspace.Data{
 		WorkspaceID: workspaceID,
@@ -79,6 +79,18 @@ func TestFilterWorkspaceByID(t *testing.T) {
 	})
 }
 
+func TestFilterCustomRoleByID(t *testing.T) {
+	t.Run("should success create a custom role filter by id and workspace", func(t *testing.T) {
+		useCases := NewWorkspaceUseCases()
+		id := uuid.New()
+
+		filter := useCases.FilterCustomRoleByID(id, id)
+
+		assert.Equal(t, id, filter["custom_role_id"])
+		assert.Equal(t, id, filter["workspace_id"])
+	})
+}
+
 func TestNewWorkspaceData(t *testing.T) {
 	t.Run("should success create a new workspace data", func(t *testing.T) {
 		useCases := NewWorkspaceUseCases()
@@ -73,6 +73,7 @@ updateVersion () {
     fi
 
     declare -a StringArray=("manager" "migrations" "auth" "analytic" "api" "core" "vulnerability" "webhook" "messages" )
+    declare -a RootContextServices=("auth" "analytic" "api" "core" "vulnerability" "webhook" )
 
     for SERVICE in ${StringArray[@]}; do
         echo "Building service $SERVICE"
@@ -82,13 +83,18 @@ updateVersion () {
             set_version_packagejson
         fi
         cd $SERVICE
+        CONTEXT="."
+        if [[ " ${RootContextServices[@]} " =~ " $SERVICE " ]]
+        then
+            CONTEXT=".."
+        fi
         if [ "$IS_TO_UPDATE_LATEST" == "true" ]
         then
-            if ! docker build -t "horuszup/horusec-$SERVICE:latest" -f ./deployments/dockerfiles/Dockerfile .; then
+            if ! docker build -t "horuszup/horusec-$SERVICE:latest" -f ./deployments/dockerfiles/Dockerfile $CONTEXT; then
                 exit 1
             fi
         fi
-        if ! docker build -t "horuszup/horusec-$SERVICE:$LATEST_VERSION" -f ./deployments/dockerfiles/Dockerfile .; then
+        if ! docker build -t "horuszup/horusec-$SERVICE:$LATEST_VERSION" -f ./deployments/dockerfiles/Dockerfile $CONTEXT; then
             exit 1
         fi
         cd ..
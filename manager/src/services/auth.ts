@@ -72,16 +72,11 @@ const validateCode = (email: string, code: string) => {
   });
 };
 
-const changePassword = (token: string, password: string) => {
-  return http.post(
-    `${SERVICE_AUTH}/auth/account/change-password`,
-    { password },
-    {
-      headers: {
-        'X-Horusec-Authorization': `Bearer ${token}`,
-      },
-    }
-  );
+const changePassword = (passwordChangeToken: string, password: string) => {
+  return http.post(`${SERVICE_AUTH}/auth/account/change-expired-password`, {
+    passwordChangeToken,
+    password,
+  });
 };
 
 const updatePassword = (password: string) => {
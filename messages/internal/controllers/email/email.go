@@ -26,6 +26,9 @@ func NewEmailController(mailerService mailer.IService) IController {
 	tpl := template.Must(template.New(emailEnums.AccountConfirmation.ToString()).Parse(templates.EmailConfirmationTpl))
 	tpl = template.Must(tpl.New(emailEnums.ResetPassword.ToString()).Parse(templates.ResetPasswordTpl))
 	tpl = template.Must(tpl.New(emailEnums.OrganizationInvite.ToString()).Parse(templates.OrganizationInviteTpl))
+	tpl = template.Must(tpl.New(templates.AccountUnlock.ToString()).Parse(templates.AccountUnlockTpl))
+	tpl = template.Must(tpl.New(templates.PendingInvitation.ToString()).Parse(templates.PendingInvitationTpl))
+	tpl = template.Must(tpl.New(templates.TokenExpiration.ToString()).Parse(templates.TokenExpirationTpl))
 
 	return &Controller{
 		tpl:           tpl,
@@ -8,6 +8,7 @@ import (
 	emailEntities "github.com/ZupIT/horusec-devkit/pkg/entities/email"
 	emailEnums "github.com/ZupIT/horusec-devkit/pkg/enums/email"
 
+	"github.com/ZupIT/horusec-platform/messages/internal/enums/templates"
 	"github.com/ZupIT/horusec-platform/messages/internal/services/mailer"
 )
 
@@ -29,6 +30,43 @@ func TestSendEmail(t *testing.T) {
 		assert.NoError(t, controller.SendEmail(message))
 	})
 
+	t.Run("should success send account unlock email", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+		mailerMock.On("SendEmail").Return(nil)
+		mailerMock.On("GetFromHeader").Return("test")
+
+		controller := NewEmailController(mailerMock)
+
+		message := &emailEntities.Message{TemplateName: templates.AccountUnlock,
+			Data: map[string]interface{}{"Username": "test", "URL": "http://localhost"}}
+		assert.NoError(t, controller.SendEmail(message))
+	})
+
+	t.Run("should success send pending invitation email", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+		mailerMock.On("SendEmail").Return(nil)
+		mailerMock.On("GetFromHeader").Return("test")
+
+		controller := NewEmailController(mailerMock)
+
+		message := &emailEntities.Message{TemplateName: templates.PendingInvitation,
+			Data: map[string]interface{}{"TargetName": "test", "Role": "member", "URL": "http://localhost"}}
+		assert.NoError(t, controller.SendEmail(message))
+	})
+
+	t.Run("should success send token expiration email", func(t *testing.T) {
+		mailerMock := &mailer.Mock{}
+		mailerMock.On("SendEmail").Return(nil)
+		mailerMock.On("GetFromHeader").Return("test")
+
+		controller := NewEmailController(mailerMock)
+
+		message := &emailEntities.Message{TemplateName: templates.TokenExpiration,
+			Data: map[string]interface{}{"Username": "test", "Description": "test", "TargetName": "test",
+				"ExpiresAt": "2021-01-01 00:00"}}
+		assert.NoError(t, controller.SendEmail(message))
+	})
+
 	t.Run("should return error when failed to execute template", func(t *testing.T) {
 		mailerMock := &mailer.Mock{}
 
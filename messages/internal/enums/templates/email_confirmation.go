@@ -281,6 +281,8 @@ const EmailConfirmationTpl = `<!doctype html>
                           </tr>
                         </tbody>
                       </table>
+                      <p>This link can only be used once and expires in {{.ExpiresInHours}} hours. If it has expired,
+                        request a new confirmation email from the login page.</p>
                       <div class="footer">
                         <p class="team">Horusec Team</p>
                         <span class="copyright">© 2020 Horusec Sec. All rights reserved.</span>
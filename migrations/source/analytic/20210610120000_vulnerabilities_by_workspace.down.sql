@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS vulnerabilities_by_workspace CASCADE;
+
+COMMIT;
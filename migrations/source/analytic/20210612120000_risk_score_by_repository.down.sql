@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS risk_score_by_repository CASCADE;
+DROP TABLE IF EXISTS repository_criticality CASCADE;
+DROP TABLE IF EXISTS vulnerabilities_first_seen CASCADE;
+
+COMMIT;
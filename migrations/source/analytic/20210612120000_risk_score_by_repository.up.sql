@@ -0,0 +1,33 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "risk_score_by_repository"
+(
+    "risk_score_id" UUID NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    "workspace_id" UUID NOT NULL,
+    "repository_id" UUID NOT NULL,
+    "repository_name" VARCHAR(255) NOT NULL,
+    "criticality" VARCHAR(255) NOT NULL,
+    "score" NUMERIC NOT NULL,
+    "active_vulnerabilities" INT NOT NULL,
+    PRIMARY KEY (risk_score_id)
+);
+
+CREATE TABLE IF NOT EXISTS "repository_criticality"
+(
+    "repository_id" UUID NOT NULL,
+    "workspace_id" UUID NOT NULL,
+    "criticality" VARCHAR(255) NOT NULL,
+    "updated_at" TIMESTAMP NOT NULL,
+    PRIMARY KEY (repository_id)
+);
+
+CREATE TABLE IF NOT EXISTS "vulnerabilities_first_seen"
+(
+    "repository_id" UUID NOT NULL,
+    "vuln_hash" VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    PRIMARY KEY (repository_id, vuln_hash)
+);
+
+COMMIT;
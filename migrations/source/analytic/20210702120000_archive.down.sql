@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS archived_workspaces CASCADE;
+DROP TABLE IF EXISTS archived_repositories CASCADE;
+
+COMMIT;
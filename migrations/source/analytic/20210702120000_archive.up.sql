@@ -0,0 +1,18 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "archived_workspaces"
+(
+    "workspace_id" UUID NOT NULL,
+    "archived_at" TIMESTAMP NOT NULL,
+    PRIMARY KEY (workspace_id)
+);
+
+CREATE TABLE IF NOT EXISTS "archived_repositories"
+(
+    "repository_id" UUID NOT NULL,
+    "workspace_id" UUID NOT NULL,
+    "archived_at" TIMESTAMP NOT NULL,
+    PRIMARY KEY (repository_id)
+);
+
+COMMIT;
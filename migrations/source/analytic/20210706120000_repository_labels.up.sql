@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "repository_labels"
+(
+    "repository_id" UUID NOT NULL,
+    "workspace_id" UUID NOT NULL,
+    "labels" TEXT[],
+    "updated_at" TIMESTAMP NOT NULL,
+    PRIMARY KEY (repository_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_repository_labels_labels ON repository_labels USING GIN (labels);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE repositories
+    DROP COLUMN IF EXISTS criticality;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE repositories
+    ADD COLUMN IF NOT EXISTS criticality VARCHAR(255) NOT NULL DEFAULT 'MEDIUM';
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS account_mfa_recovery_codes;
+
+ALTER TABLE workspaces
+    DROP COLUMN IF EXISTS require_mfa;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS is_mfa_enabled,
+    DROP COLUMN IF EXISTS mfa_secret;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS mfa_secret VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS is_mfa_enabled BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE workspaces
+    ADD COLUMN IF NOT EXISTS require_mfa BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS "account_mfa_recovery_codes"
+(
+    "recovery_code_id" UUID         NOT NULL,
+    "account_id"       UUID         NOT NULL,
+    "code_hash"        VARCHAR(255) NOT NULL,
+    "used_at"          TIMESTAMP,
+    "created_at"       TIMESTAMP    NOT NULL,
+    PRIMARY KEY (recovery_code_id),
+    CONSTRAINT fk_accounts_account_mfa_recovery_codes FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_mfa_recovery_codes_account_id ON account_mfa_recovery_codes (account_id);
+
+COMMIT;
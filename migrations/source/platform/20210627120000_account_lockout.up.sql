@@ -0,0 +1,23 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "account_lockout_events"
+(
+    "event_id"     UUID         NOT NULL,
+    "account_id"   UUID,
+    "identifier"   VARCHAR(255) NOT NULL DEFAULT '',
+    "ip_address"   VARCHAR(255) NOT NULL DEFAULT '',
+    "target"       VARCHAR(255) NOT NULL,
+    "action"       VARCHAR(255) NOT NULL,
+    "method"       VARCHAR(255) NOT NULL,
+    "actor_id"     UUID,
+    "locked_until" TIMESTAMP,
+    "created_at"   TIMESTAMP    NOT NULL,
+    PRIMARY KEY (event_id),
+    CONSTRAINT fk_accounts_account_lockout_events FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_lockout_events_account_id ON account_lockout_events (account_id);
+CREATE INDEX IF NOT EXISTS idx_account_lockout_events_created_at ON account_lockout_events (created_at);
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "sessions"
+(
+    "session_id"         UUID         NOT NULL,
+    "account_id"         UUID         NOT NULL,
+    "refresh_token_hash" VARCHAR(255) NOT NULL,
+    "user_agent"         VARCHAR(255) NOT NULL DEFAULT '',
+    "ip_address"         VARCHAR(255) NOT NULL DEFAULT '',
+    "created_at"         TIMESTAMP    NOT NULL,
+    "last_used_at"       TIMESTAMP    NOT NULL,
+    "expires_at"         TIMESTAMP    NOT NULL,
+    "revoked_at"         TIMESTAMP,
+    PRIMARY KEY (session_id),
+    CONSTRAINT fk_accounts_sessions FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_account_id ON sessions (account_id);
+
+COMMIT;
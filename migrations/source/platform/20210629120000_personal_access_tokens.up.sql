@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "personal_access_tokens"
+(
+    "token_id"     UUID         NOT NULL,
+    "account_id"   UUID         NOT NULL,
+    "name"         VARCHAR(255) NOT NULL,
+    "scopes"       TEXT[]       NOT NULL,
+    "permissions"  TEXT[],
+    "secret_hash"  VARCHAR(255) NOT NULL,
+    "created_at"   TIMESTAMP    NOT NULL,
+    "expires_at"   TIMESTAMP,
+    "last_used_at" TIMESTAMP,
+    "revoked_at"   TIMESTAMP,
+    PRIMARY KEY (token_id),
+    CONSTRAINT fk_accounts_personal_access_tokens FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_account_id ON personal_access_tokens (account_id);
+
+COMMIT;
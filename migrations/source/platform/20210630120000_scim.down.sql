@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP TABLE IF EXISTS scim_group_roles;
+DROP TABLE IF EXISTS scim_group_members;
+DROP TABLE IF EXISTS scim_groups;
+DROP TABLE IF EXISTS scim_tokens;
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS is_disabled,
+    DROP COLUMN IF EXISTS external_id;
+
+COMMIT;
@@ -0,0 +1,59 @@
+BEGIN;
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS is_disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+CREATE TABLE IF NOT EXISTS "scim_tokens"
+(
+    "token_id"    UUID         NOT NULL,
+    "name"        VARCHAR(255) NOT NULL,
+    "secret_hash" VARCHAR(255) NOT NULL,
+    "created_by"  UUID         NOT NULL,
+    "created_at"  TIMESTAMP    NOT NULL,
+    "revoked_at"  TIMESTAMP,
+    PRIMARY KEY (token_id)
+);
+
+CREATE TABLE IF NOT EXISTS "scim_groups"
+(
+    "group_id"     UUID         NOT NULL,
+    "display_name" VARCHAR(255) NOT NULL,
+    "external_id"  VARCHAR(255),
+    "created_at"   TIMESTAMP    NOT NULL,
+    "updated_at"   TIMESTAMP    NOT NULL,
+    PRIMARY KEY (group_id),
+    CONSTRAINT uk_scim_groups_display_name UNIQUE (display_name)
+);
+
+CREATE TABLE IF NOT EXISTS "scim_group_members"
+(
+    "group_id"   UUID      NOT NULL,
+    "account_id" UUID      NOT NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    PRIMARY KEY (group_id, account_id),
+    CONSTRAINT fk_scim_groups_scim_group_members FOREIGN KEY (group_id)
+        REFERENCES scim_groups (group_id) ON DELETE CASCADE,
+    CONSTRAINT fk_accounts_scim_group_members FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_scim_group_members_account_id ON scim_group_members (account_id);
+
+CREATE TABLE IF NOT EXISTS "scim_group_roles"
+(
+    "group_id"      UUID         NOT NULL,
+    "workspace_id"  UUID         NOT NULL,
+    "repository_id" UUID,
+    "role"          VARCHAR(255) NOT NULL,
+    CONSTRAINT fk_scim_groups_scim_group_roles FOREIGN KEY (group_id)
+        REFERENCES scim_groups (group_id) ON DELETE CASCADE,
+    CONSTRAINT fk_workspaces_scim_group_roles FOREIGN KEY (workspace_id)
+        REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    CONSTRAINT fk_repositories_scim_group_roles FOREIGN KEY (repository_id)
+        REFERENCES repositories (repository_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_scim_group_roles_group_id ON scim_group_roles (group_id);
+
+COMMIT;
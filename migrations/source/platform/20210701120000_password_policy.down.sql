@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "account_password_history";
+
+ALTER TABLE accounts
+    DROP COLUMN IF EXISTS password_changed_at;
+
+COMMIT;
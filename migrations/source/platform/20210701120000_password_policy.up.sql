@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+CREATE TABLE IF NOT EXISTS "account_password_history"
+(
+    "password_history_id" UUID         NOT NULL,
+    "account_id"          UUID         NOT NULL,
+    "password"            VARCHAR(255) NOT NULL,
+    "created_at"          TIMESTAMP    NOT NULL,
+    PRIMARY KEY (password_history_id),
+    CONSTRAINT fk_accounts_account_password_history FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_password_history_account_id
+    ON account_password_history (account_id, created_at);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_repositories_archived_at;
+DROP INDEX IF EXISTS idx_workspaces_archived_at;
+
+ALTER TABLE repositories
+    DROP COLUMN IF EXISTS archived_at;
+
+ALTER TABLE workspaces
+    DROP COLUMN IF EXISTS archived_at;
+
+COMMIT;
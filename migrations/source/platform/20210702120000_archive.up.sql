@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE workspaces
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+ALTER TABLE repositories
+    ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_workspaces_archived_at ON workspaces (archived_at);
+CREATE INDEX IF NOT EXISTS idx_repositories_archived_at ON repositories (archived_at);
+
+COMMIT;
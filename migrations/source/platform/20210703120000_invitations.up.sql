@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "invitations"
+(
+    "invitation_id" UUID         NOT NULL,
+    "email"         VARCHAR(255) NOT NULL,
+    "role"          VARCHAR(255) NOT NULL,
+    "workspace_id"  UUID         NOT NULL,
+    "repository_id" UUID,
+    "inviter_id"    UUID         NOT NULL,
+    "account_id"    UUID,
+    "status"        VARCHAR(255) NOT NULL,
+    "expires_at"    TIMESTAMP    NOT NULL,
+    "created_at"    TIMESTAMP    NOT NULL,
+    "updated_at"    TIMESTAMP    NOT NULL,
+    PRIMARY KEY (invitation_id),
+    CONSTRAINT fk_workspaces_invitations FOREIGN KEY (workspace_id)
+        REFERENCES workspaces (workspace_id) ON DELETE CASCADE,
+    CONSTRAINT fk_repositories_invitations FOREIGN KEY (repository_id)
+        REFERENCES repositories (repository_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_invitations_email_status ON invitations (LOWER(email), status);
+CREATE INDEX IF NOT EXISTS idx_invitations_workspace_id ON invitations (workspace_id);
+
+COMMIT;
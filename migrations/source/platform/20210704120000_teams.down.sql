@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS team_repository CASCADE;
+DROP TABLE IF EXISTS team_account CASCADE;
+DROP TABLE IF EXISTS teams CASCADE;
+
+COMMIT;
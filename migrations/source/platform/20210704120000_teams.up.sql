@@ -0,0 +1,47 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "teams"
+(
+    "team_id"      UUID         NOT NULL,
+    "workspace_id" UUID         NOT NULL,
+    "name"         VARCHAR(255) NOT NULL,
+    "description"  TEXT,
+    "created_at"   TIMESTAMP    NOT NULL,
+    "updated_at"   TIMESTAMP    NOT NULL,
+    PRIMARY KEY (team_id),
+    CONSTRAINT uk_teams_workspace_id_name UNIQUE (workspace_id, name),
+    CONSTRAINT fk_workspaces_teams FOREIGN KEY (workspace_id)
+        REFERENCES workspaces (workspace_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "team_account"
+(
+    "team_id"      UUID      NOT NULL,
+    "account_id"   UUID      NOT NULL,
+    "workspace_id" UUID      NOT NULL,
+    "created_at"   TIMESTAMP NOT NULL,
+    PRIMARY KEY (team_id, account_id),
+    CONSTRAINT fk_teams_team_account FOREIGN KEY (team_id)
+        REFERENCES teams (team_id) ON DELETE CASCADE,
+    CONSTRAINT fk_accounts_team_account FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "team_repository"
+(
+    "team_id"       UUID         NOT NULL,
+    "repository_id" UUID         NOT NULL,
+    "role"          VARCHAR(255) NOT NULL,
+    "created_at"    TIMESTAMP    NOT NULL,
+    "updated_at"    TIMESTAMP    NOT NULL,
+    PRIMARY KEY (team_id, repository_id),
+    CONSTRAINT fk_teams_team_repository FOREIGN KEY (team_id)
+        REFERENCES teams (team_id) ON DELETE CASCADE,
+    CONSTRAINT fk_repositories_team_repository FOREIGN KEY (repository_id)
+        REFERENCES repositories (repository_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_account_account_id ON team_account (account_id, workspace_id);
+CREATE INDEX IF NOT EXISTS idx_team_repository_repository_id ON team_repository (repository_id);
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_tokens_expires_at;
+
+ALTER TABLE tokens
+    DROP COLUMN IF EXISTS expiration_notified_at,
+    DROP COLUMN IF EXISTS successor_id,
+    DROP COLUMN IF EXISTS last_used_ip,
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS allowed_cidrs,
+    DROP COLUMN IF EXISTS scopes;
+
+COMMIT;
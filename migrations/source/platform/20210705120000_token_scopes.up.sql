@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE tokens
+    ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{analysis:upload,analysis:read,findings:read}',
+    ADD COLUMN IF NOT EXISTS allowed_cidrs TEXT[],
+    ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS last_used_ip VARCHAR(45),
+    ADD COLUMN IF NOT EXISTS successor_id UUID,
+    ADD COLUMN IF NOT EXISTS expiration_notified_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_tokens_expires_at ON tokens (expires_at) WHERE is_expirable = true;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_repositories_labels;
+
+ALTER TABLE repositories
+    DROP CONSTRAINT IF EXISTS fk_teams_repositories_owner,
+    DROP COLUMN IF EXISTS labels,
+    DROP COLUMN IF EXISTS default_branch,
+    DROP COLUMN IF EXISTS vcs_url,
+    DROP COLUMN IF EXISTS data_classification,
+    DROP COLUMN IF EXISTS owner_team_id;
+
+COMMIT;
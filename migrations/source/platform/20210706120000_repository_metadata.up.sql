@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE repositories
+    ADD COLUMN IF NOT EXISTS owner_team_id UUID,
+    ADD COLUMN IF NOT EXISTS data_classification VARCHAR(255) NOT NULL DEFAULT 'INTERNAL',
+    ADD COLUMN IF NOT EXISTS vcs_url VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS default_branch VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS labels TEXT[] NOT NULL DEFAULT '{}',
+    ADD CONSTRAINT fk_teams_repositories_owner FOREIGN KEY (owner_team_id)
+        REFERENCES teams (team_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_repositories_labels ON repositories USING GIN (labels);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_repositories_import_id;
+
+ALTER TABLE "repositories"
+    DROP CONSTRAINT IF EXISTS fk_repository_imports_repositories,
+    DROP COLUMN IF EXISTS "import_id";
+
+DROP TABLE IF EXISTS "repository_imports";
+
+COMMIT;
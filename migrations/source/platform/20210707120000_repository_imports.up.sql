@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "repository_imports"
+(
+    "import_id"       UUID         NOT NULL,
+    "workspace_id"    UUID         NOT NULL,
+    "account_id"      UUID         NOT NULL,
+    "provider"        VARCHAR(255) NOT NULL,
+    "base_url"        VARCHAR(255) NOT NULL,
+    "organization"    VARCHAR(255) NOT NULL,
+    "username"        VARCHAR(255),
+    "access_token"    TEXT         NOT NULL,
+    "generate_tokens" BOOLEAN      NOT NULL DEFAULT FALSE,
+    "periodic_sync"   BOOLEAN      NOT NULL DEFAULT FALSE,
+    "last_sync_at"    TIMESTAMP,
+    "created_at"      TIMESTAMP    NOT NULL,
+    "updated_at"      TIMESTAMP    NOT NULL,
+    PRIMARY KEY (import_id),
+    CONSTRAINT uk_repository_imports_workspace_provider_org UNIQUE (workspace_id, provider, base_url, organization),
+    CONSTRAINT fk_workspaces_repository_imports FOREIGN KEY (workspace_id)
+        REFERENCES workspaces (workspace_id) ON DELETE CASCADE
+);
+
+ALTER TABLE "repositories"
+    ADD COLUMN IF NOT EXISTS "import_id" UUID,
+    ADD CONSTRAINT fk_repository_imports_repositories FOREIGN KEY (import_id)
+        REFERENCES repository_imports (import_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_repositories_import_id ON repositories (import_id);
+
+COMMIT;
@@ -0,0 +1,50 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "audit_events"
+(
+    "sequence"      BIGSERIAL    NOT NULL,
+    "event_id"      UUID         NOT NULL,
+    "service"       VARCHAR(255) NOT NULL,
+    "action"        VARCHAR(255) NOT NULL,
+    "actor_id"      UUID,
+    "actor_email"   VARCHAR(255),
+    "ip"            VARCHAR(255),
+    "workspace_id"  UUID,
+    "repository_id" UUID,
+    "target_type"   VARCHAR(255) NOT NULL,
+    "target_id"     UUID,
+    "before"        TEXT,
+    "after"         TEXT,
+    "occurred_at"   TIMESTAMP    NOT NULL,
+    "previous_hash" VARCHAR(64)  NOT NULL,
+    "hash"          VARCHAR(64)  NOT NULL,
+    PRIMARY KEY (sequence),
+    CONSTRAINT uk_audit_events_event_id UNIQUE (event_id),
+    CONSTRAINT uk_audit_events_previous_hash UNIQUE (previous_hash),
+    CONSTRAINT uk_audit_events_hash UNIQUE (hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_workspace_id ON audit_events (workspace_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor_id ON audit_events (actor_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_audit_events_occurred_at ON audit_events (occurred_at);
+
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only, % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_audit_events_append_only
+    BEFORE UPDATE OR DELETE
+    ON audit_events
+    FOR EACH ROW
+EXECUTE PROCEDURE audit_events_append_only();
+
+CREATE TRIGGER trg_audit_events_no_truncate
+    BEFORE TRUNCATE
+    ON audit_events
+    FOR EACH STATEMENT
+EXECUTE PROCEDURE audit_events_append_only();
+
+COMMIT;
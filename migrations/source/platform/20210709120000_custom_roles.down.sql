@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE account_repository
+    DROP CONSTRAINT IF EXISTS fk_custom_roles_account_repository,
+    DROP COLUMN IF EXISTS custom_role_id;
+
+ALTER TABLE account_workspace
+    DROP CONSTRAINT IF EXISTS fk_custom_roles_account_workspace,
+    DROP COLUMN IF EXISTS custom_role_id;
+
+DROP TABLE IF EXISTS "custom_roles";
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "custom_roles"
+(
+    "custom_role_id" UUID         NOT NULL,
+    "workspace_id"   UUID         NOT NULL,
+    "name"           VARCHAR(255) NOT NULL,
+    "description"    TEXT,
+    "permissions"    TEXT[]       NOT NULL DEFAULT '{}',
+    "created_at"     TIMESTAMP    NOT NULL,
+    "updated_at"     TIMESTAMP    NOT NULL,
+    PRIMARY KEY (custom_role_id),
+    CONSTRAINT uk_custom_roles_workspace_id_name UNIQUE (workspace_id, name),
+    CONSTRAINT fk_workspaces_custom_roles FOREIGN KEY (workspace_id)
+        REFERENCES workspaces (workspace_id) ON DELETE CASCADE
+);
+
+ALTER TABLE account_workspace
+    ADD COLUMN IF NOT EXISTS custom_role_id UUID,
+    ADD CONSTRAINT fk_custom_roles_account_workspace FOREIGN KEY (custom_role_id)
+        REFERENCES custom_roles (custom_role_id) ON DELETE SET NULL;
+
+ALTER TABLE account_repository
+    ADD COLUMN IF NOT EXISTS custom_role_id UUID,
+    ADD CONSTRAINT fk_custom_roles_account_repository FOREIGN KEY (custom_role_id)
+        REFERENCES custom_roles (custom_role_id) ON DELETE SET NULL;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_analysis_workspace_id_created_at;
+
+DROP TABLE IF EXISTS "workspace_quotas";
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "workspace_quotas"
+(
+    "workspace_id"                     UUID      NOT NULL,
+    "max_repositories"                 INTEGER,
+    "max_analyses_per_day"             INTEGER,
+    "max_vulnerabilities_per_analysis" INTEGER,
+    "max_tokens"                       INTEGER,
+    "max_webhooks"                     INTEGER,
+    "created_at"                       TIMESTAMP NOT NULL,
+    "updated_at"                       TIMESTAMP NOT NULL,
+    PRIMARY KEY (workspace_id),
+    CONSTRAINT fk_workspaces_workspace_quotas FOREIGN KEY (workspace_id)
+        REFERENCES workspaces (workspace_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_analysis_workspace_id_created_at ON analysis (workspace_id, created_at);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "account_identities"
+(
+    "issuer"     VARCHAR(255) NOT NULL,
+    "subject"    VARCHAR(255) NOT NULL,
+    "account_id" UUID         NOT NULL,
+    "created_at" TIMESTAMP    NOT NULL,
+    PRIMARY KEY (issuer, subject),
+    CONSTRAINT uk_account_identities_issuer_account UNIQUE (issuer, account_id),
+    CONSTRAINT fk_accounts_account_identities FOREIGN KEY (account_id)
+        REFERENCES accounts (account_id) ON DELETE CASCADE
+);
+
+COMMIT;
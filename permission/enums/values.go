@@ -0,0 +1,61 @@
+package enums
+
+// Permission is the action a role allows, the routes of core, vulnerability, webhook and analytic send the
+// permission they require to the auth service as the authorization type instead of a fixed role
+type Permission string
+
+const (
+	WorkspaceRead               Permission = "workspace.read"
+	WorkspaceUpdate             Permission = "workspace.update"
+	WorkspaceDelete             Permission = "workspace.delete"
+	WorkspaceMembersManage      Permission = "workspace.members.manage"
+	WorkspaceRolesManage        Permission = "workspace.roles.manage"
+	WorkspaceTokensManage       Permission = "workspace.tokens.manage"
+	RepositoryCreate            Permission = "repository.create"
+	RepositoryRead              Permission = "repository.read"
+	RepositoryUpdate            Permission = "repository.update"
+	RepositoryDelete            Permission = "repository.delete"
+	RepositoryTransfer          Permission = "repository.transfer"
+	RepositoryMembersManage     Permission = "repository.members.manage"
+	RepositoryTokensManage      Permission = "repository.tokens.manage"
+	AnalyticRead                Permission = "analytic.read"
+	VulnerabilityRead           Permission = "vulnerability.read"
+	VulnerabilityTypeUpdate     Permission = "vulnerability.type.update"
+	VulnerabilitySeverityUpdate Permission = "vulnerability.severity.update"
+	WebhookRead                 Permission = "webhook.read"
+	WebhookManage               Permission = "webhook.manage"
+	AuditRead                   Permission = "audit.read"
+)
+
+func (p Permission) ToString() string {
+	return string(p)
+}
+
+func (p Permission) IsValid() bool {
+	for _, permission := range Values() {
+		if permission == p {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Values() []Permission {
+	return []Permission{
+		WorkspaceRead, WorkspaceUpdate, WorkspaceDelete, WorkspaceMembersManage, WorkspaceRolesManage,
+		WorkspaceTokensManage, RepositoryCreate, RepositoryRead, RepositoryUpdate, RepositoryDelete,
+		RepositoryTransfer, RepositoryMembersManage, RepositoryTokensManage, AnalyticRead, VulnerabilityRead,
+		VulnerabilityTypeUpdate, VulnerabilitySeverityUpdate, WebhookRead, WebhookManage, AuditRead,
+	}
+}
+
+type CtxKey string
+
+const (
+	GrantedPermissions CtxKey = "grantedPermissions"
+)
+
+const (
+	PersonalAccessTokenPrefix = "hpat_"
+)
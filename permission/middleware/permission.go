@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares/enums"
+	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+type IMiddleware interface {
+	HasPermission(permission permissionEnums.Permission) func(next http.Handler) http.Handler
+	HasAnyPermission(permissions ...permissionEnums.Permission) func(next http.Handler) http.Handler
+	DenyPersonalAccessToken(next http.Handler) http.Handler
+}
+
+type Middleware struct {
+	authGRPC proto.AuthServiceClient
+	ctx      context.Context
+}
+
+func NewPermissionMiddleware(authGRPC proto.AuthServiceClient) IMiddleware {
+	return &Middleware{
+		authGRPC: authGRPC,
+		ctx:      context.Background(),
+	}
+}
+
+// HasPermission sends the permission as the authorization type, the auth service checks it against the custom
+// role of the account or translates it to the built-in role that grants it when there is no custom role
+func (m *Middleware) HasPermission(permission permissionEnums.Permission) func(next http.Handler) http.Handler {
+	return m.HasAnyPermission(permission)
+}
+
+// HasAnyPermission authorizes the request when any of the permissions is granted, the granted ones are kept in the
+// request context, so the handler is able to limit the changes to what the account is allowed to do
+func (m *Middleware) HasAnyPermission(permissions ...permissionEnums.Permission) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			granted, err := m.getGrantedPermissions(r, permissions)
+			if m.checkGrantedPermissions(err, granted, w, r, permissions) != nil {
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(
+				context.WithValue(r.Context(), permissionEnums.GrantedPermissions, granted)))
+		})
+	}
+}
+
+// DenyPersonalAccessToken the personal access token scopes are checked only for workspace and repository routes,
+// none of them allows the account routes, so they are restricted to the session tokens
+func (m *Middleware) DenyPersonalAccessToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.Header.Get(jwtEnums.HorusecJWTHeader), permissionEnums.PersonalAccessTokenPrefix) {
+			httpUtil.StatusUnauthorized(w, enums.ErrorUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *Middleware) getGrantedPermissions(r *http.Request,
+	permissions []permissionEnums.Permission) (granted []permissionEnums.Permission, err error) {
+	for _, permission := range permissions {
+		response, err := m.authGRPC.IsAuthorized(m.ctx, m.setAuthorizedData(r, permission))
+		if err != nil {
+			return nil, err
+		}
+
+		if response.GetIsAuthorized() {
+			granted = append(granted, permission)
+		}
+	}
+
+	return granted, nil
+}
+
+func (m *Middleware) checkGrantedPermissions(err error, granted []permissionEnums.Permission, w http.ResponseWriter,
+	r *http.Request, permissions []permissionEnums.Permission) error {
+	if err != nil {
+		logger.LogError(enums.MessageIsAuthorizedGRPCRequestError, err)
+		httpUtil.StatusInternalServerError(w, enums.ErrorFailedToVerifyRequest)
+		return enums.ErrorFailedToVerifyRequest
+	}
+
+	if len(granted) == 0 {
+		logger.LogWarn(fmt.Sprintf(enums.MessageUnauthorizedHTTPRequest, m.getAccountID(r),
+			r.URL, r.Method, permissions))
+		httpUtil.StatusUnauthorized(w, enums.ErrorUnauthorized)
+		return enums.ErrorUnauthorized
+	}
+
+	return nil
+}
+
+func (m *Middleware) getAccountID(r *http.Request) string {
+	accountID, err := jwt.GetAccountIDByJWTToken(r.Header.Get(jwtEnums.HorusecJWTHeader))
+	if err != nil {
+		return uuid.Nil.String()
+	}
+
+	return accountID.String()
+}
+
+func (m *Middleware) setAuthorizedData(r *http.Request,
+	permission permissionEnums.Permission) *proto.IsAuthorizedData {
+	return &proto.IsAuthorizedData{
+		Token:        r.Header.Get(jwtEnums.HorusecJWTHeader),
+		Type:         permission.ToString(),
+		WorkspaceID:  chi.URLParam(r, enums.WorkspaceID),
+		RepositoryID: chi.URLParam(r, enums.RepositoryID),
+	}
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	jwtEnums "github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+)
+
+func testHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestNewPermissionMiddleware(t *testing.T) {
+	t.Run("should success create a new middleware", func(t *testing.T) {
+		assert.NotNil(t, NewPermissionMiddleware(&proto.Mock{}))
+	})
+}
+
+func TestHasPermission(t *testing.T) {
+	t.Run("should return 200 when account has the permission", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil)
+
+		handler := NewPermissionMiddleware(authGRPCMock).
+			HasPermission(permissionEnums.RepositoryRead)(http.HandlerFunc(testHandler))
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 401 when account does not have the permission", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: false}, nil)
+
+		handler := NewPermissionMiddleware(authGRPCMock).
+			HasPermission(permissionEnums.RepositoryRead)(http.HandlerFunc(testHandler))
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("should return 500 when failed to verify the permission", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{}, errors.New("test"))
+
+		handler := NewPermissionMiddleware(authGRPCMock).
+			HasPermission(permissionEnums.RepositoryRead)(http.HandlerFunc(testHandler))
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestHasAnyPermission(t *testing.T) {
+	t.Run("should keep the granted permissions in the request context", func(t *testing.T) {
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: true}, nil).Once()
+		authGRPCMock.On("IsAuthorized").Return(&proto.IsAuthorizedResponse{IsAuthorized: false}, nil).Once()
+
+		var granted []permissionEnums.Permission
+		handler := NewPermissionMiddleware(authGRPCMock).HasAnyPermission(permissionEnums.VulnerabilityTypeUpdate,
+			permissionEnums.VulnerabilitySeverityUpdate)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			granted, _ = r.Context().Value(permissionEnums.GrantedPermissions).([]permissionEnums.Permission)
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, []permissionEnums.Permission{permissionEnums.VulnerabilityTypeUpdate}, granted)
+	})
+}
+
+func TestDenyPersonalAccessToken(t *testing.T) {
+	t.Run("should return 200 when request made with a session token", func(t *testing.T) {
+		handler := NewPermissionMiddleware(&proto.Mock{}).DenyPersonalAccessToken(http.HandlerFunc(testHandler))
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r.Header.Set(jwtEnums.HorusecJWTHeader, "eyJhbGciOiJIUzI1NiJ9.test.test")
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("should return 401 when request made with a personal access token", func(t *testing.T) {
+		handler := NewPermissionMiddleware(&proto.Mock{}).DenyPersonalAccessToken(http.HandlerFunc(testHandler))
+
+		r, _ := http.NewRequest(http.MethodGet, "test", nil)
+		r.Header.Set(jwtEnums.HorusecJWTHeader, "hpat_test.test")
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
@@ -14,12 +14,14 @@ import (
 	httpRouter "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 	"github.com/ZupIT/horusec-platform/vulnerability/config/cors"
 	managementController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	managementHandler "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
 	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/router"
+	auditService "github.com/ZupIT/horusec-platform/vulnerability/internal/services/audit"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
@@ -37,6 +39,7 @@ var devKitProviders = wire.NewSet(
 var configProviders = wire.NewSet(
 	cors.NewCorsConfig,
 	router.NewHTTPRouter,
+	permissionMiddleware.NewPermissionMiddleware,
 )
 
 var repositoryProviders = wire.NewSet(
@@ -56,9 +59,13 @@ var useCasesProviders = wire.NewSet(
 	managementUseCases.NewManagementUseCases,
 )
 
+var serviceProviders = wire.NewSet(
+	auditService.NewAuditService,
+)
+
 func Initialize(_ string) (router.IRouter, error) {
 	wire.Build(devKitProviders, configProviders, repositoryProviders, controllerProviders,
-		handlerProviders, useCasesProviders)
+		handlerProviders, useCasesProviders, serviceProviders)
 
 	return &router.Router{}, nil
 }
@@ -16,12 +16,14 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 	"github.com/google/wire"
 
+	"github.com/ZupIT/horusec-platform/permission/middleware"
 	"github.com/ZupIT/horusec-platform/vulnerability/config/cors"
 	management3 "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	management4 "github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
 	management2 "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/router"
+	"github.com/ZupIT/horusec-platform/vulnerability/internal/services/audit"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
@@ -46,8 +48,11 @@ func Initialize(string2 string) (router.IRouter, error) {
 		return nil, err
 	}
 	iController := management3.NewManagementController(iRepository, iBroker, connection, iUseCases)
-	managementHandler := management4.NewManagementHandler(iController, iUseCases)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, managementHandler)
+	authServiceClient := proto.NewAuthServiceClient(clientConnInterface)
+	iService := audit.NewAuditService(iBroker, authServiceClient)
+	managementHandler := management4.NewManagementHandler(iController, iUseCases, iService)
+	iMiddleware := middleware.NewPermissionMiddleware(authServiceClient)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, managementHandler, iMiddleware)
 	return routerIRouter, nil
 }
 
@@ -55,7 +60,7 @@ func Initialize(string2 string) (router.IRouter, error) {
 
 var devKitProviders = wire.NewSet(config2.NewBrokerConfig, broker.NewBroker, proto.NewAuthServiceClient, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, auth.NewAuthGRPCConnection, router2.NewHTTPRouter, middlewares.NewAuthzMiddleware)
 
-var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter)
+var configProviders = wire.NewSet(cors.NewCorsConfig, router.NewHTTPRouter, middleware.NewPermissionMiddleware)
 
 var repositoryProviders = wire.NewSet(management2.NewManagementRepository)
 
@@ -64,3 +69,5 @@ var controllerProviders = wire.NewSet(management3.NewManagementController)
 var handlerProviders = wire.NewSet(health.NewHealthHandler, management4.NewManagementHandler)
 
 var useCasesProviders = wire.NewSet(management.NewManagementUseCases)
+
+var serviceProviders = wire.NewSet(audit.NewAuditService)
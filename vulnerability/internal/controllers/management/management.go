@@ -19,7 +19,7 @@ import (
 
 type IController interface {
 	GetAllVulnerabilities(filter *managementEntities.Filter) (*managementEntities.Response, error)
-	UpdateVulnerabilities(data *managementEntities.UpdateData) error
+	UpdateVulnerabilities(data *managementEntities.UpdateData) ([]*managementEntities.TriageChange, error)
 }
 
 type Controller struct {
@@ -43,33 +43,67 @@ func (c *Controller) GetAllVulnerabilities(filter *managementEntities.Filter) (*
 	return c.repository.GetAllVulnerabilities(filter)
 }
 
-func (c *Controller) UpdateVulnerabilities(data *managementEntities.UpdateData) error {
+// UpdateVulnerabilities returns the changes once the transaction is committed, even when the analysis could not be
+// sent to analytic, since the vulnerabilities were already updated
+func (c *Controller) UpdateVulnerabilities(
+	data *managementEntities.UpdateData) ([]*managementEntities.TriageChange, error) {
+	changes, err := c.updateVulnerabilities(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, c.publishAnalysisChanges(data.AnalysisID)
+}
+
+func (c *Controller) updateVulnerabilities(
+	data *managementEntities.UpdateData) ([]*managementEntities.TriageChange, error) {
 	transaction := c.databaseWrite.StartTransaction()
 
-	for _, vulnerability := range data.Vulnerabilities {
-		if err := c.updateVulnerability(vulnerability, transaction); err != nil {
-			logger.LogError(managementEnums.MessageFailedToRollbackUpdate, transaction.RollbackTransaction().GetError())
-			return err
-		}
+	changes, err := c.updateEachVulnerability(data, transaction)
+	if err != nil {
+		logger.LogError(managementEnums.MessageFailedToRollbackUpdate, transaction.RollbackTransaction().GetError())
+		return nil, err
 	}
 
 	if err := transaction.CommitTransaction().GetError(); err != nil {
-		return errors.Wrap(err, managementEnums.MessageFailedToCommitUpdateTransaction)
+		return nil, errors.Wrap(err, managementEnums.MessageFailedToCommitUpdateTransaction)
+	}
+
+	return changes, nil
+}
+
+func (c *Controller) updateEachVulnerability(data *managementEntities.UpdateData,
+	transaction database.IDatabaseWrite) (changes []*managementEntities.TriageChange, err error) {
+	for _, vulnerability := range data.Vulnerabilities {
+		change, err := c.updateVulnerability(data, vulnerability, transaction)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, change)
 	}
 
-	return c.publishAnalysisChanges(data.AnalysisID)
+	return changes, nil
 }
 
-func (c *Controller) updateVulnerability(
-	data *managementEntities.VulnerabilityData, transaction database.IDatabaseWrite) error {
+// updateVulnerability the type and the severity have different permissions, so a change is only saved when the
+// account is allowed to make all of it
+func (c *Controller) updateVulnerability(updateData *managementEntities.UpdateData,
+	data *managementEntities.VulnerabilityData, transaction database.IDatabaseWrite) (
+	*managementEntities.TriageChange, error) {
 	vulnerability, err := c.repository.GetVulnerability(data.VulnerabilityID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	change := managementEntities.NewTriageChange(vulnerability)
 	vulnerability.SetType(data.Type)
 	vulnerability.SetSeverity(data.Severity)
-	return transaction.Update(vulnerability, c.useCases.FilterVulnerabilityByID(vulnerability.VulnerabilityID),
+	if err := updateData.CheckPermissions(change.SetAfter(vulnerability)); err != nil {
+		return nil, err
+	}
+
+	return change, transaction.Update(vulnerability, c.useCases.FilterVulnerabilityByID(vulnerability.VulnerabilityID),
 		managementEnums.VulnerabilitiesTable).GetError()
 }
 
@@ -18,8 +18,8 @@ func (m *Mock) GetAllVulnerabilities(_ *managementEntities.Filter) (*managementE
 	return args.Get(0).(*managementEntities.Response), utilsMock.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) UpdateVulnerabilities(_ *managementEntities.UpdateData) error {
+func (m *Mock) UpdateVulnerabilities(_ *managementEntities.UpdateData) ([]*managementEntities.TriageChange, error) {
 	args := m.MethodCalled("UpdateVulnerabilities")
 
-	return utilsMock.ReturnNilOrError(args, 0)
+	return args.Get(0).([]*managementEntities.TriageChange), utilsMock.ReturnNilOrError(args, 1)
 }
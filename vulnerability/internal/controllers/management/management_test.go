@@ -15,7 +15,9 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/response"
 
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 	managementRepository "github.com/ZupIT/horusec-platform/vulnerability/internal/repositories/management"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
@@ -55,6 +57,8 @@ func TestUpdateVulnerability(t *testing.T) {
 		},
 		AnalysisID: uuid.New(),
 	}
+	updateData.SetPermissions([]permissionEnums.Permission{permissionEnums.VulnerabilityTypeUpdate,
+		permissionEnums.VulnerabilitySeverityUpdate})
 
 	t.Run("should success update vulnerabilities", func(t *testing.T) {
 		databaseMock := &database.Mock{}
@@ -74,7 +78,10 @@ func TestUpdateVulnerability(t *testing.T) {
 		controller := NewManagementController(repositoryMock, brokerMock,
 			databaseConnection, managementUseCases.NewManagementUseCases())
 
-		assert.NoError(t, controller.UpdateVulnerabilities(updateData))
+		changes, err := controller.UpdateVulnerabilities(updateData)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, severities.Critical, changes[0].After.Severity)
 	})
 
 	t.Run("should return error when getting analysis", func(t *testing.T) {
@@ -94,7 +101,9 @@ func TestUpdateVulnerability(t *testing.T) {
 		controller := NewManagementController(repositoryMock, brokerMock,
 			databaseConnection, managementUseCases.NewManagementUseCases())
 
-		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		changes, err := controller.UpdateVulnerabilities(updateData)
+		assert.Error(t, err)
+		assert.Len(t, changes, 1)
 	})
 
 	t.Run("should return error when updating vulnerabilities", func(t *testing.T) {
@@ -114,7 +123,8 @@ func TestUpdateVulnerability(t *testing.T) {
 		controller := NewManagementController(repositoryMock, brokerMock,
 			databaseConnection, managementUseCases.NewManagementUseCases())
 
-		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		_, err := controller.UpdateVulnerabilities(updateData)
+		assert.Error(t, err)
 	})
 
 	t.Run("should return error when getting vulnerability", func(t *testing.T) {
@@ -133,7 +143,8 @@ func TestUpdateVulnerability(t *testing.T) {
 		controller := NewManagementController(repositoryMock, brokerMock,
 			databaseConnection, managementUseCases.NewManagementUseCases())
 
-		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		_, err := controller.UpdateVulnerabilities(updateData)
+		assert.Error(t, err)
 	})
 
 	t.Run("should return error when failed to commit transaction", func(t *testing.T) {
@@ -154,6 +165,29 @@ func TestUpdateVulnerability(t *testing.T) {
 		controller := NewManagementController(repositoryMock, brokerMock,
 			databaseConnection, managementUseCases.NewManagementUseCases())
 
-		assert.Error(t, controller.UpdateVulnerabilities(updateData))
+		_, err := controller.UpdateVulnerabilities(updateData)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when not allowed to update the severity", func(t *testing.T) {
+		databaseMock := &database.Mock{}
+		databaseMock.On("StartTransaction").Return(databaseMock)
+		databaseMock.On("RollbackTransaction").Return(&response.Response{})
+
+		databaseConnection := &database.Connection{Read: databaseMock, Write: databaseMock}
+
+		repositoryMock := &managementRepository.Mock{}
+		repositoryMock.On("GetVulnerability").Return(&vulnerabilityEntities.Vulnerability{
+			Type: vulnerabilityEnums.Vulnerability, Severity: severities.Low}, nil)
+
+		controller := NewManagementController(repositoryMock, &broker.Mock{},
+			databaseConnection, managementUseCases.NewManagementUseCases())
+
+		data := &managementEntities.UpdateData{Vulnerabilities: updateData.Vulnerabilities}
+
+		_, err := controller.UpdateVulnerabilities(data.SetPermissions(
+			[]permissionEnums.Permission{permissionEnums.VulnerabilityTypeUpdate}))
+		assert.Equal(t, managementEnums.ErrorSeverityUpdateNotAllowed, err)
+		databaseMock.AssertNotCalled(t, "Update")
 	})
 }
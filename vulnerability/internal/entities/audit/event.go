@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	auditEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/audit"
+)
+
+// Event is published to the audit exchange after a vulnerability is triaged, core persists it in the audit log
+type Event struct {
+	EventID      uuid.UUID       `json:"eventID"`
+	Service      string          `json:"service"`
+	Action       string          `json:"action"`
+	ActorID      uuid.UUID       `json:"actorID"`
+	ActorEmail   string          `json:"actorEmail"`
+	IP           string          `json:"ip"`
+	WorkspaceID  uuid.UUID       `json:"workspaceID"`
+	RepositoryID uuid.UUID       `json:"repositoryID"`
+	TargetType   string          `json:"targetType"`
+	TargetID     uuid.UUID       `json:"targetID"`
+	Before       json.RawMessage `json:"before,omitempty"`
+	After        json.RawMessage `json:"after,omitempty"`
+	OccurredAt   time.Time       `json:"occurredAt"`
+}
+
+func NewTriageEvent(workspaceID, repositoryID uuid.UUID, change *managementEntities.TriageChange) *Event {
+	before, _ := json.Marshal(change.Before)
+	after, _ := json.Marshal(change.After)
+
+	return &Event{
+		EventID:      uuid.New(),
+		Service:      auditEnums.ServiceVulnerability,
+		Action:       auditEnums.ActionVulnerabilityTriage,
+		WorkspaceID:  workspaceID,
+		RepositoryID: repositoryID,
+		TargetType:   auditEnums.TargetVulnerability,
+		TargetID:     change.VulnerabilityID,
+		Before:       before,
+		After:        after,
+		OccurredAt:   time.Now(),
+	}
+}
+
+// SetActor does nothing when the account could not be found, so the action is still recorded without the actor
+func (e *Event) SetActor(accountData *proto.GetAccountDataResponse) *Event {
+	if accountData == nil {
+		return e
+	}
+
+	e.ActorID, _ = uuid.Parse(accountData.AccountID)
+	e.ActorEmail = accountData.Email
+
+	return e
+}
+
+// SetIP uses the remote address of the request, which already contains the real ip set by the router
+func (e *Event) SetIP(r *http.Request) *Event {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		e.IP = r.RemoteAddr
+		return e
+	}
+
+	e.IP = host
+	return e
+}
+
+func (e *Event) ToBytes() []byte {
+	bytes, _ := json.Marshal(e)
+
+	return bytes
+}
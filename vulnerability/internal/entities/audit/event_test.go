@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	auditEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/audit"
+)
+
+func newTriageChange() *managementEntities.TriageChange {
+	return &managementEntities.TriageChange{
+		VulnerabilityID: uuid.New(),
+		Before:          &managementEntities.Triage{Type: vulnerabilityEnums.Vulnerability, Severity: severities.High},
+		After:           &managementEntities.Triage{Type: vulnerabilityEnums.FalsePositive, Severity: severities.High},
+	}
+}
+
+func TestNewTriageEvent(t *testing.T) {
+	t.Run("should success create a new triage event", func(t *testing.T) {
+		workspaceID, repositoryID, change := uuid.New(), uuid.New(), newTriageChange()
+
+		event := NewTriageEvent(workspaceID, repositoryID, change)
+
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, auditEnums.ServiceVulnerability, event.Service)
+		assert.Equal(t, auditEnums.ActionVulnerabilityTriage, event.Action)
+		assert.Equal(t, auditEnums.TargetVulnerability, event.TargetType)
+		assert.Equal(t, change.VulnerabilityID, event.TargetID)
+		assert.Equal(t, workspaceID, event.WorkspaceID)
+		assert.Equal(t, repositoryID, event.RepositoryID)
+		assert.Contains(t, string(event.Before), string(vulnerabilityEnums.Vulnerability))
+		assert.Contains(t, string(event.After), string(vulnerabilityEnums.FalsePositive))
+	})
+}
+
+func TestSetActor(t *testing.T) {
+	t.Run("should set the account as actor", func(t *testing.T) {
+		accountID := uuid.New()
+
+		event := NewTriageEvent(uuid.New(), uuid.New(), newTriageChange()).SetActor(
+			&proto.GetAccountDataResponse{AccountID: accountID.String(), Email: "test@test.com"})
+
+		assert.Equal(t, accountID, event.ActorID)
+		assert.Equal(t, "test@test.com", event.ActorEmail)
+	})
+
+	t.Run("should keep actor empty when account data is nil", func(t *testing.T) {
+		event := NewTriageEvent(uuid.New(), uuid.New(), newTriageChange()).SetActor(nil)
+
+		assert.Equal(t, uuid.Nil, event.ActorID)
+		assert.Empty(t, event.ActorEmail)
+	})
+}
+
+func TestSetIP(t *testing.T) {
+	t.Run("should set ip without the port", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPatch, "test", nil)
+		r.RemoteAddr = "127.0.0.1:8000"
+
+		event := NewTriageEvent(uuid.New(), uuid.New(), newTriageChange()).SetIP(r)
+
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+
+	t.Run("should set remote address when it has no port", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPatch, "test", nil)
+		r.RemoteAddr = "127.0.0.1"
+
+		event := NewTriageEvent(uuid.New(), uuid.New(), newTriageChange()).SetIP(r)
+
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+}
+
+func TestToBytes(t *testing.T) {
+	t.Run("should success parse event to bytes", func(t *testing.T) {
+		assert.NotEmpty(t, NewTriageEvent(uuid.New(), uuid.New(), newTriageChange()).ToBytes())
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-chi/chi"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
@@ -16,13 +17,14 @@ import (
 )
 
 type Filter struct {
-	WorkspaceID  uuid.UUID `json:"workspaceID"`
-	RepositoryID uuid.UUID `json:"repositoryID"`
-	Page         int       `json:"page"`
-	Size         int       `json:"size"`
-	VulnSeverity string    `json:"vulnSeverity"`
-	VulnType     string    `json:"vulnType"`
-	VulnHash     string    `json:"vulnHash"`
+	WorkspaceID  uuid.UUID      `json:"workspaceID"`
+	RepositoryID uuid.UUID      `json:"repositoryID"`
+	Page         int            `json:"page"`
+	Size         int            `json:"size"`
+	VulnSeverity string         `json:"vulnSeverity"`
+	VulnType     string         `json:"vulnType"`
+	VulnHash     string         `json:"vulnHash"`
+	Labels       pq.StringArray `json:"labels"`
 }
 
 func (f *Filter) SetFilterDataFromRequest(r *http.Request) error {
@@ -79,6 +81,7 @@ func (f *Filter) setVulnerabilityFilters(r *http.Request) {
 	f.VulnSeverity = r.URL.Query().Get(managementEnums.VulnSeverityQuery)
 	f.VulnType = r.URL.Query().Get(managementEnums.VulnTypeQuery)
 	f.VulnHash = r.URL.Query().Get(managementEnums.VulnHashQuery)
+	f.Labels = r.URL.Query()[managementEnums.LabelQuery]
 }
 
 func (f *Filter) Validate() error {
@@ -93,6 +96,7 @@ func (f *Filter) Validate() error {
 		validation.Field(&f.VulnType, validation.In(managementEnums.AllFilters,
 			vulnerabilityEnums.Vulnerability.ToString(), vulnerabilityEnums.RiskAccepted.ToString(),
 			vulnerabilityEnums.FalsePositive.ToString(), vulnerabilityEnums.Corrected.ToString())),
+		validation.Field(&f.Labels, validation.Each(validation.Match(managementEnums.LabelRegex))),
 	)
 }
 
@@ -101,6 +105,7 @@ func (f *Filter) GetWhereFilterQuery() (string, []interface{}) {
 	query, params = f.getVulnerabilityHashQuery(query, params)
 	query, params = f.getVulnerabilitySeverityQuery(query, params)
 	query, params = f.getVulnerabilityTypeQuery(query, params)
+	query, params = f.getLabelsQuery(query, params)
 
 	return query, params
 }
@@ -143,3 +148,14 @@ func (f *Filter) getVulnerabilityTypeQuery(query string, params []interface{}) (
 
 	return query, params
 }
+
+// getLabelsQuery keeps only the repositories with all the informed labels, they are read straight from the
+// repositories table since this service shares the platform database with core
+func (f *Filter) getLabelsQuery(query string, params []interface{}) (string, []interface{}) {
+	if len(f.Labels) > 0 {
+		query += " AND analysis.repository_id IN (SELECT repository_id FROM repositories WHERE labels @> ?) "
+		params = append(params, f.Labels)
+	}
+
+	return query, params
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
@@ -173,6 +174,17 @@ func TestValidate(t *testing.T) {
 
 		assert.NoError(t, filter.Validate())
 	})
+
+	t.Run("should return error when invalid label", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID:  uuid.New(),
+			RepositoryID: uuid.New(),
+			Size:         10,
+			Labels:       pq.StringArray{"criticality"},
+		}
+
+		assert.Error(t, filter.Validate())
+	})
 }
 
 func TestGetWhereFilterQuery(t *testing.T) {
@@ -194,4 +206,16 @@ func TestGetWhereFilterQuery(t *testing.T) {
 		assert.NotNil(t, params)
 		assert.Len(t, params, 5)
 	})
+
+	t.Run("should filter by repository labels", func(t *testing.T) {
+		filter := &Filter{
+			WorkspaceID: uuid.New(),
+			Labels:      pq.StringArray{"criticality=high"},
+		}
+
+		query, params := filter.GetWhereFilterQuery()
+		assert.Equal(t, "analysis.workspace_id = ? AND analysis.repository_id IN "+
+			"(SELECT repository_id FROM repositories WHERE labels @> ?) ", query)
+		assert.Len(t, params, 2)
+	})
 }
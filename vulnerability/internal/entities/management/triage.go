@@ -0,0 +1,49 @@
+package management
+
+import (
+	"github.com/google/uuid"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+type Triage struct {
+	Type     vulnerabilityEnums.Type `json:"type"`
+	Severity severities.Severity     `json:"severity"`
+}
+
+// TriageChange keeps the type and severity of a vulnerability before and after it was updated, used by the audit log
+type TriageChange struct {
+	VulnerabilityID uuid.UUID
+	Before          *Triage
+	After           *Triage
+}
+
+func NewTriageChange(vulnerability *vulnerabilityEntities.Vulnerability) *TriageChange {
+	return &TriageChange{
+		VulnerabilityID: vulnerability.VulnerabilityID,
+		Before:          newTriage(vulnerability),
+	}
+}
+
+func (t *TriageChange) SetAfter(vulnerability *vulnerabilityEntities.Vulnerability) *TriageChange {
+	t.After = newTriage(vulnerability)
+
+	return t
+}
+
+func (t *TriageChange) IsTypeChanged() bool {
+	return t.Before.Type != t.After.Type
+}
+
+func (t *TriageChange) IsSeverityChanged() bool {
+	return t.Before.Severity != t.After.Severity
+}
+
+func newTriage(vulnerability *vulnerabilityEntities.Vulnerability) *Triage {
+	return &Triage{
+		Type:     vulnerability.Type,
+		Severity: vulnerability.Severity,
+	}
+}
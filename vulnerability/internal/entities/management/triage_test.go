@@ -0,0 +1,45 @@
+package management
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	vulnerabilityEntities "github.com/ZupIT/horusec-devkit/pkg/entities/vulnerability"
+	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
+	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+)
+
+func TestNewTriageChange(t *testing.T) {
+	t.Run("should keep the type and severity before and after the update", func(t *testing.T) {
+		vulnerability := &vulnerabilityEntities.Vulnerability{
+			VulnerabilityID: uuid.New(),
+			Type:            vulnerabilityEnums.Vulnerability,
+			Severity:        severities.High,
+		}
+
+		change := NewTriageChange(vulnerability)
+		vulnerability.SetType(vulnerabilityEnums.FalsePositive)
+		vulnerability.SetSeverity(severities.Low)
+		change.SetAfter(vulnerability)
+
+		assert.Equal(t, vulnerability.VulnerabilityID, change.VulnerabilityID)
+		assert.Equal(t, vulnerabilityEnums.Vulnerability, change.Before.Type)
+		assert.Equal(t, severities.High, change.Before.Severity)
+		assert.Equal(t, vulnerabilityEnums.FalsePositive, change.After.Type)
+		assert.Equal(t, severities.Low, change.After.Severity)
+	})
+}
+
+func TestIsChangedTriageChange(t *testing.T) {
+	t.Run("should check the type and severity changes separately", func(t *testing.T) {
+		change := &TriageChange{
+			Before: &Triage{Type: vulnerabilityEnums.Vulnerability, Severity: severities.High},
+			After:  &Triage{Type: vulnerabilityEnums.Vulnerability, Severity: severities.Low},
+		}
+
+		assert.False(t, change.IsTypeChanged())
+		assert.True(t, change.IsSeverityChanged())
+	})
+}
@@ -2,11 +2,16 @@ package management
 
 import (
 	"github.com/google/uuid"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 )
 
 type UpdateData struct {
-	AnalysisID      uuid.UUID            `json:"analysisID"`
-	Vulnerabilities []*VulnerabilityData `json:"vulnerabilities"`
+	AnalysisID        uuid.UUID            `json:"analysisID"`
+	Vulnerabilities   []*VulnerabilityData `json:"vulnerabilities"`
+	canUpdateType     bool
+	canUpdateSeverity bool
 }
 
 func (u *UpdateData) Validate() error {
@@ -18,3 +23,29 @@ func (u *UpdateData) Validate() error {
 
 	return nil
 }
+
+// SetPermissions uses the permissions granted by the permission middleware, without them no change is allowed
+func (u *UpdateData) SetPermissions(permissions []permissionEnums.Permission) *UpdateData {
+	for _, permission := range permissions {
+		switch permission {
+		case permissionEnums.VulnerabilityTypeUpdate:
+			u.canUpdateType = true
+		case permissionEnums.VulnerabilitySeverityUpdate:
+			u.canUpdateSeverity = true
+		}
+	}
+
+	return u
+}
+
+func (u *UpdateData) CheckPermissions(change *TriageChange) error {
+	if change.IsTypeChanged() && !u.canUpdateType {
+		return managementEnums.ErrorTypeUpdateNotAllowed
+	}
+
+	if change.IsSeverityChanged() && !u.canUpdateSeverity {
+		return managementEnums.ErrorSeverityUpdateNotAllowed
+	}
+
+	return nil
+}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/enums/severities"
 	vulnerabilityEnums "github.com/ZupIT/horusec-devkit/pkg/enums/vulnerability"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
 )
 
 func TestValidateUpdateData(t *testing.T) {
@@ -26,3 +29,38 @@ func TestValidateUpdateData(t *testing.T) {
 		assert.NoError(t, filter.Validate())
 	})
 }
+
+func TestCheckPermissionsUpdateData(t *testing.T) {
+	change := &TriageChange{
+		Before: &Triage{Type: vulnerabilityEnums.Vulnerability, Severity: severities.High},
+		After:  &Triage{Type: vulnerabilityEnums.FalsePositive, Severity: severities.High},
+	}
+
+	t.Run("should return no error when allowed to update the type", func(t *testing.T) {
+		data := (&UpdateData{}).SetPermissions([]permissionEnums.Permission{permissionEnums.VulnerabilityTypeUpdate})
+
+		assert.NoError(t, data.CheckPermissions(change))
+	})
+
+	t.Run("should return error when not allowed to update the type", func(t *testing.T) {
+		data := (&UpdateData{}).SetPermissions(
+			[]permissionEnums.Permission{permissionEnums.VulnerabilitySeverityUpdate})
+
+		assert.Equal(t, managementEnums.ErrorTypeUpdateNotAllowed, data.CheckPermissions(change))
+	})
+
+	t.Run("should return error when not allowed to update the severity", func(t *testing.T) {
+		data := (&UpdateData{}).SetPermissions([]permissionEnums.Permission{permissionEnums.VulnerabilityTypeUpdate})
+
+		assert.Equal(t, managementEnums.ErrorSeverityUpdateNotAllowed, data.CheckPermissions(&TriageChange{
+			Before: &Triage{Type: vulnerabilityEnums.Vulnerability, Severity: severities.High},
+			After:  &Triage{Type: vulnerabilityEnums.Vulnerability, Severity: severities.Low},
+		}))
+	})
+
+	t.Run("should return no error when nothing changes without permissions", func(t *testing.T) {
+		data := &UpdateData{}
+
+		assert.NoError(t, data.CheckPermissions(&TriageChange{Before: change.Before, After: change.Before}))
+	})
+}
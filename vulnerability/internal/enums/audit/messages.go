@@ -0,0 +1,6 @@
+package audit
+
+const (
+	MessageFailedToPublishEvent = "failed to publish audit event"
+	MessageFailedToGetActor     = "failed to get the account that performed the audited action"
+)
@@ -0,0 +1,8 @@
+package audit
+
+const (
+	ExchangeAudit             = "horusec-audit"
+	ServiceVulnerability      = "vulnerability"
+	ActionVulnerabilityTriage = "vulnerability.triage"
+	TargetVulnerability       = "vulnerability"
+)
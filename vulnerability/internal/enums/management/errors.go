@@ -6,4 +6,8 @@ var (
 	ErrorInvalidWorkspaceID     = errors.New("{VULNERABILITY MANAGEMENT} invalid workspace id")
 	ErrorInvalidRepositoryID    = errors.New("{VULNERABILITY MANAGEMENT} invalid repository id")
 	ErrorInvalidVulnerabilityID = errors.New("{VULNERABILITY MANAGEMENT} invalid vulnerability id")
+	ErrorTypeUpdateNotAllowed   = errors.New(
+		"{VULNERABILITY MANAGEMENT} the account is not allowed to update the vulnerability type")
+	ErrorSeverityUpdateNotAllowed = errors.New(
+		"{VULNERABILITY MANAGEMENT} the account is not allowed to update the vulnerability severity")
 )
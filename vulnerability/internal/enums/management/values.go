@@ -1,5 +1,7 @@
 package management
 
+import "regexp"
+
 const (
 	RepositoryID          = "repositoryID"
 	WorkspaceID           = "workspaceID"
@@ -7,9 +9,13 @@ const (
 	VulnSeverityQuery     = "vulnSeverity"
 	VulnTypeQuery         = "vulnType"
 	VulnHashQuery         = "vulnHash"
+	LabelQuery            = "label"
 	AllFilters            = "ALL"
 	Page                  = "page"
 	Size                  = "size"
 	VulnerabilitiesTable  = "vulnerabilities"
 	AnalysisTable         = "analysis"
 )
+
+// LabelRegex accepts labels in the key=value format, the same format used to label the repositories in core
+var LabelRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.\-/]{0,62}=[^=\s]{1,255}$`)
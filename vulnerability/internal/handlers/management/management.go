@@ -3,25 +3,34 @@ package management
 import (
 	"net/http"
 
+	"github.com/go-chi/chi"
+	"github.com/google/uuid"
+
 	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 	_ "github.com/ZupIT/horusec-devkit/pkg/utils/http/entities" // [swagger-import]
 
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 	managementController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
-	_ "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management" // [swagger-import]
+	auditEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/audit"
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	auditService "github.com/ZupIT/horusec-platform/vulnerability/internal/services/audit"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
 type Handler struct {
-	controller managementController.IController
-	useCases   managementUseCases.IUseCases
+	controller   managementController.IController
+	useCases     managementUseCases.IUseCases
+	auditService auditService.IService
 }
 
 func NewManagementHandler(controller managementController.IController,
-	useCases managementUseCases.IUseCases) *Handler {
+	useCases managementUseCases.IUseCases, serviceAudit auditService.IService) *Handler {
 	return &Handler{
-		controller: controller,
-		useCases:   useCases,
+		controller:   controller,
+		useCases:     useCases,
+		auditService: serviceAudit,
 	}
 }
 
@@ -43,6 +52,7 @@ func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
 // @Param vulnHash query string false "vulnerability hash query string"
 // @Param vulnType query string false "vulnerability type query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
 // @Param vulnSeverity query string false "vulnerability severity query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
+// @Param label query []string false "repository labels in the key=value format query string"
 // @Success 200 {object} entities.Response{content=management.Response} "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
@@ -66,6 +76,7 @@ func (h *Handler) GetAllVulnerabilitiesByWorkspace(w http.ResponseWriter, r *htt
 // @Param vulnHash query string false "vulnerability hash query string"
 // @Param vulnType query string false "vulnerability type query string" Enums(CRITICAL, HIGH, MEDIUM, LOW, INFO)
 // @Param vulnSeverity query string false "vulnerability severity query string" Enums(Vulnerability, Risk Accepted, False Positive, Corrected)
+// @Param label query []string false "repository labels in the key=value format query string"
 // @Success 200 {object} entities.Response{content=management.Response} "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
@@ -93,7 +104,7 @@ func (h *Handler) getAllVulnerabilities(w http.ResponseWriter, r *http.Request)
 // Patch
 // @Tags Vulnerabilities
 // @Security ApiKeyAuth
-// @Description Update severity or type of many vulnerabilities
+// @Description Update severity or type of many vulnerabilities, each one requires its own permission
 // @ID update-vulnerabilities
 // @Accept  json
 // @Produce  json
@@ -102,17 +113,20 @@ func (h *Handler) getAllVulnerabilities(w http.ResponseWriter, r *http.Request)
 // @Param VulnerabilityData body management.UpdateData true "update vulnerability content info"
 // @Success 204 "OK"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 403 {object} entities.Response{content=string} "FORBIDDEN"
 // @Failure 404 {object} entities.Response{content=string} "NOT FOUND"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /vulnerability/management/workspace/{workspaceID}/repository/{repositoryID}/vulnerabilities [patch]
 func (h *Handler) UpdateVulnerabilities(w http.ResponseWriter, r *http.Request) {
-	data, err := h.useCases.UpdateDataFromIOReadCloser(r.Body)
+	data, err := h.getUpdateData(r)
 	if err != nil {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
 
-	if err := h.controller.UpdateVulnerabilities(data); err != nil {
+	changes, err := h.controller.UpdateVulnerabilities(data)
+	h.publishTriageEvents(r, changes)
+	if err != nil {
 		h.checkPatchErrors(w, err)
 		return
 	}
@@ -120,11 +134,44 @@ func (h *Handler) UpdateVulnerabilities(w http.ResponseWriter, r *http.Request)
 	httpUtil.StatusNoContent(w)
 }
 
+// getUpdateData sets the permissions granted by the permission middleware, which limit the changes allowed
+func (h *Handler) getUpdateData(r *http.Request) (*managementEntities.UpdateData, error) {
+	data, err := h.useCases.UpdateDataFromIOReadCloser(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, _ := r.Context().Value(permissionEnums.GrantedPermissions).([]permissionEnums.Permission)
+	return data.SetPermissions(permissions), nil
+}
+
+// publishTriageEvents uses the ids of the path, which were already checked by the authz middleware
+func (h *Handler) publishTriageEvents(r *http.Request, changes []*managementEntities.TriageChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	workspaceID, _ := uuid.Parse(chi.URLParam(r, managementEnums.WorkspaceID))
+	repositoryID, _ := uuid.Parse(chi.URLParam(r, managementEnums.RepositoryID))
+
+	var events []*auditEntities.Event
+	for _, change := range changes {
+		events = append(events, auditEntities.NewTriageEvent(workspaceID, repositoryID, change))
+	}
+
+	h.auditService.Publish(r, events...)
+}
+
 func (h *Handler) checkPatchErrors(w http.ResponseWriter, err error) {
 	if err == databaseEnums.ErrorNotFoundRecords {
 		httpUtil.StatusNotFound(w, err)
 		return
 	}
 
+	if err == managementEnums.ErrorTypeUpdateNotAllowed || err == managementEnums.ErrorSeverityUpdateNotAllowed {
+		httpUtil.StatusForbidden(w, err)
+		return
+	}
+
 	httpUtil.StatusInternalServerError(w, err)
 }
@@ -17,14 +17,34 @@ import (
 	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
 	managementController "github.com/ZupIT/horusec-platform/vulnerability/internal/controllers/management"
 	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+	managementEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/management"
+	auditService "github.com/ZupIT/horusec-platform/vulnerability/internal/services/audit"
 	managementUseCases "github.com/ZupIT/horusec-platform/vulnerability/internal/usecase/management"
 )
 
+func newAuditServiceMock() *auditService.Mock {
+	auditServiceMock := &auditService.Mock{}
+	auditServiceMock.On("Publish")
+
+	return auditServiceMock
+}
+
+func newTriageChanges() []*managementEntities.TriageChange {
+	return []*managementEntities.TriageChange{
+		{
+			VulnerabilityID: uuid.New(),
+			Before:          &managementEntities.Triage{Type: vulnerabilityEnums.Vulnerability},
+			After:           &managementEntities.Triage{Type: vulnerabilityEnums.FalsePositive},
+		},
+	}
+}
+
 func TestOptions(t *testing.T) {
 	t.Run("should return no content when options", func(t *testing.T) {
-		handler := NewManagementHandler(nil, nil)
+		handler := NewManagementHandler(nil, nil, nil)
 
 		r, _ := http.NewRequest(http.MethodOptions, "/test", nil)
 
@@ -39,10 +59,12 @@ func TestOptions(t *testing.T) {
 func TestGetAllVulnerabilitiesByWorkspace(t *testing.T) {
 	t.Run("should return 200 when success get vulnerabilities", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		controllerMock.On("GetAllVulnerabilities").Return(&managementEntities.Response{}, nil)
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -64,11 +86,13 @@ func TestGetAllVulnerabilitiesByWorkspace(t *testing.T) {
 
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		controllerMock.On("GetAllVulnerabilities").Return(
 			&managementEntities.Response{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -90,11 +114,13 @@ func TestGetAllVulnerabilitiesByWorkspace(t *testing.T) {
 
 	t.Run("should return 400 when invalid filter", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		controllerMock.On("GetAllVulnerabilities").Return(
 			&managementEntities.Response{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -108,10 +134,12 @@ func TestGetAllVulnerabilitiesByWorkspace(t *testing.T) {
 func TestGetAllVulnerabilitiesByRepository(t *testing.T) {
 	t.Run("should return 200 when success get vulnerabilities", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		controllerMock.On("GetAllVulnerabilities").Return(&managementEntities.Response{}, nil)
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -133,11 +161,13 @@ func TestGetAllVulnerabilitiesByRepository(t *testing.T) {
 
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		controllerMock.On("GetAllVulnerabilities").Return(
 			&managementEntities.Response{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		URL := fmt.Sprintf("/test?page=1&size=15&vulnSeverity=%s&vulnType=%s&vulnHash=%s",
 			severities.Critical.ToString(), vulnerabilityEnums.Vulnerability.ToString(), "123456")
@@ -159,11 +189,13 @@ func TestGetAllVulnerabilitiesByRepository(t *testing.T) {
 
 	t.Run("should return 400 when invalid filter", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		controllerMock.On("GetAllVulnerabilities").Return(
 			&managementEntities.Response{}, errors.New("test"))
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		r, _ := http.NewRequest(http.MethodGet, "/test", nil)
 		w := httptest.NewRecorder()
@@ -177,7 +209,8 @@ func TestGetAllVulnerabilitiesByRepository(t *testing.T) {
 func TestUpdateVulnerabilities(t *testing.T) {
 	t.Run("should return 204 when vulnerabilities were successfully updated", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
-		controllerMock.On("UpdateVulnerabilities").Return(nil)
+		auditServiceMock := newAuditServiceMock()
+		controllerMock.On("UpdateVulnerabilities").Return(newTriageChanges(), nil)
 
 		data := &managementEntities.UpdateData{
 			Vulnerabilities: []*managementEntities.VulnerabilityData{
@@ -188,7 +221,8 @@ func TestUpdateVulnerabilities(t *testing.T) {
 				},
 			},
 		}
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -205,11 +239,13 @@ func TestUpdateVulnerabilities(t *testing.T) {
 		handler.UpdateVulnerabilities(w, r)
 
 		assert.Equal(t, http.StatusNoContent, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
 	})
 
 	t.Run("should return 500 when something went wrong", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
-		controllerMock.On("UpdateVulnerabilities").Return(errors.New("test"))
+		auditServiceMock := newAuditServiceMock()
+		controllerMock.On("UpdateVulnerabilities").Return(newTriageChanges(), errors.New("test"))
 
 		data := &managementEntities.UpdateData{
 			Vulnerabilities: []*managementEntities.VulnerabilityData{
@@ -220,7 +256,8 @@ func TestUpdateVulnerabilities(t *testing.T) {
 				},
 			},
 		}
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -237,11 +274,14 @@ func TestUpdateVulnerabilities(t *testing.T) {
 		handler.UpdateVulnerabilities(w, r)
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		auditServiceMock.AssertCalled(t, "Publish")
 	})
 
 	t.Run("should return 404 when not found vulnerabilities", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
-		controllerMock.On("UpdateVulnerabilities").Return(databaseEnums.ErrorNotFoundRecords)
+		auditServiceMock := newAuditServiceMock()
+		controllerMock.On("UpdateVulnerabilities").Return(
+			[]*managementEntities.TriageChange(nil), databaseEnums.ErrorNotFoundRecords)
 
 		data := &managementEntities.UpdateData{
 			Vulnerabilities: []*managementEntities.VulnerabilityData{
@@ -253,7 +293,8 @@ func TestUpdateVulnerabilities(t *testing.T) {
 			},
 		}
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
@@ -270,10 +311,46 @@ func TestUpdateVulnerabilities(t *testing.T) {
 		handler.UpdateVulnerabilities(w, r)
 
 		assert.Equal(t, http.StatusNotFound, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
+	})
+
+	t.Run("should return 403 when not allowed to update the severity", func(t *testing.T) {
+		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
+		controllerMock.On("UpdateVulnerabilities").Return(
+			[]*managementEntities.TriageChange(nil), managementEnums.ErrorSeverityUpdateNotAllowed)
+
+		data := &managementEntities.UpdateData{
+			Vulnerabilities: []*managementEntities.VulnerabilityData{
+				{
+					Severity:        severities.Critical,
+					Type:            vulnerabilityEnums.Vulnerability,
+					VulnerabilityID: uuid.New(),
+				},
+			},
+		}
+
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
+
+		body, err := parser.ParseEntityToIOReadCloser(data)
+		assert.NoError(t, err)
+
+		w := httptest.NewRecorder()
+
+		r, _ := http.NewRequest(http.MethodPatch, "/test", body)
+		r = r.WithContext(context.WithValue(r.Context(), permissionEnums.GrantedPermissions,
+			[]permissionEnums.Permission{permissionEnums.VulnerabilityTypeUpdate}))
+
+		handler.UpdateVulnerabilities(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		auditServiceMock.AssertNotCalled(t, "Publish")
 	})
 
 	t.Run("should return 400 when invalid request body", func(t *testing.T) {
 		controllerMock := &managementController.Mock{}
+		auditServiceMock := newAuditServiceMock()
 
 		data := &managementEntities.UpdateData{
 			Vulnerabilities: []*managementEntities.VulnerabilityData{
@@ -283,7 +360,8 @@ func TestUpdateVulnerabilities(t *testing.T) {
 			},
 		}
 
-		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases())
+		handler := NewManagementHandler(controllerMock, managementUseCases.NewManagementUseCases(),
+			auditServiceMock)
 
 		body, err := parser.ParseEntityToIOReadCloser(data)
 		assert.NoError(t, err)
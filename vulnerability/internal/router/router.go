@@ -7,6 +7,8 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 	"github.com/ZupIT/horusec-devkit/pkg/services/swagger"
 
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 	"github.com/ZupIT/horusec-platform/vulnerability/docs"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/enums/routes"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
@@ -21,15 +23,18 @@ type Router struct {
 	httpRouter.IRouter
 	swagger.ISwagger
 	middlewares.IAuthzMiddleware
+	permissionMiddleware.IMiddleware
 	healthHandler     *health.Handler
 	managementHandler *management.Handler
 }
 
 func NewHTTPRouter(routerHTTP httpRouter.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	healthHandler *health.Handler, managementHandler *management.Handler) IRouter {
+	healthHandler *health.Handler, managementHandler *management.Handler,
+	middlewarePermission permissionMiddleware.IMiddleware) IRouter {
 	router := &Router{
 		IRouter:           routerHTTP,
 		IAuthzMiddleware:  authzMiddleware,
+		IMiddleware:       middlewarePermission,
 		ISwagger:          swagger.NewSwagger(routerHTTP.GetMux(), "8001"),
 		healthHandler:     healthHandler,
 		managementHandler: managementHandler,
@@ -54,13 +59,17 @@ func (r *Router) routerHealth() {
 }
 
 func (r *Router) routerVulnerabilities() {
+	canRead := r.HasPermission(permissionEnums.VulnerabilityRead)
+	canUpdate := r.HasAnyPermission(permissionEnums.VulnerabilityTypeUpdate,
+		permissionEnums.VulnerabilitySeverityUpdate)
+
 	r.Route(routes.ManagementVulnerabilitiesRouter, func(router chi.Router) {
 		router.Options("/", r.managementHandler.Options)
-		router.With(r.IsRepositoryMember).Get("/workspace/{workspaceID}/repository/{repositoryID}",
+		router.With(canRead).Get("/workspace/{workspaceID}/repository/{repositoryID}",
 			r.managementHandler.GetAllVulnerabilitiesByRepository)
-		router.With(r.IsWorkspaceAdmin).Get("/workspace/{workspaceID}",
+		router.With(canRead).Get("/workspace/{workspaceID}",
 			r.managementHandler.GetAllVulnerabilitiesByWorkspace)
-		router.With(r.IsRepositorySupervisor).Patch("/workspace/{workspaceID}/repository/{repositoryID}/"+
+		router.With(canUpdate).Patch("/workspace/{workspaceID}/repository/{repositoryID}/"+
 			"vulnerabilities", r.managementHandler.UpdateVulnerabilities)
 	})
 }
@@ -6,9 +6,11 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	httpRouter "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/vulnerability/internal/handlers/management"
 )
@@ -18,6 +20,6 @@ func TestNewHTTPRouter(t *testing.T) {
 		router := httpRouter.NewHTTPRouter(&cors.Options{}, "8009")
 
 		assert.NotEmpty(t, NewHTTPRouter(router, &middlewares.AuthzMiddleware{}, &health.Handler{},
-			&management.Handler{}))
+			&management.Handler{}, permissionMiddleware.NewPermissionMiddleware(&proto.Mock{})))
 	})
 }
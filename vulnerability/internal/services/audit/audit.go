@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	brokerService "github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	auditEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/audit"
+	auditEnums "github.com/ZupIT/horusec-platform/vulnerability/internal/enums/audit"
+)
+
+type IService interface {
+	Publish(r *http.Request, events ...*auditEntities.Event)
+}
+
+type Service struct {
+	broker   brokerService.IBroker
+	authGRPC proto.AuthServiceClient
+	context  context.Context
+}
+
+func NewAuditService(broker brokerService.IBroker, authGRPC proto.AuthServiceClient) IService {
+	return &Service{
+		broker:   broker,
+		authGRPC: authGRPC,
+		context:  context.Background(),
+	}
+}
+
+// Publish sets the account and ip of the request that performed the action, failures are only logged since the
+// vulnerabilities were already updated and must not be answered as failed
+func (s *Service) Publish(r *http.Request, events ...*auditEntities.Event) {
+	accountData := s.getAccountData(r)
+
+	for _, event := range events {
+		event.SetActor(accountData).SetIP(r)
+
+		logger.LogError(auditEnums.MessageFailedToPublishEvent,
+			s.broker.Publish("", auditEnums.ExchangeAudit, exchange.Fanout, event.ToBytes()))
+	}
+}
+
+func (s *Service) getAccountData(r *http.Request) *proto.GetAccountDataResponse {
+	accountData, err := s.authGRPC.GetAccountInfo(s.context,
+		&proto.GetAccountData{Token: r.Header.Get(enums.HorusecJWTHeader)})
+	if err != nil {
+		logger.LogError(auditEnums.MessageFailedToGetActor, err)
+		return nil
+	}
+
+	return accountData
+}
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	auditEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/audit"
+	managementEntities "github.com/ZupIT/horusec-platform/vulnerability/internal/entities/management"
+)
+
+func TestNewAuditService(t *testing.T) {
+	t.Run("should success create a new audit service", func(t *testing.T) {
+		assert.NotNil(t, NewAuditService(&broker.Mock{}, &proto.Mock{}))
+	})
+}
+
+func TestPublish(t *testing.T) {
+	accountData := &proto.GetAccountDataResponse{AccountID: uuid.NewString(), Email: "test@test.com"}
+	change := &managementEntities.TriageChange{VulnerabilityID: uuid.New()}
+
+	t.Run("should publish every event with the actor and ip of the request", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", nil)
+		r.RemoteAddr = "127.0.0.1:8000"
+
+		first := auditEntities.NewTriageEvent(uuid.New(), uuid.New(), change)
+		second := auditEntities.NewTriageEvent(uuid.New(), uuid.New(), change)
+		NewAuditService(brokerMock, authGRPCMock).Publish(r, first, second)
+
+		brokerMock.AssertNumberOfCalls(t, "Publish", 2)
+		authGRPCMock.AssertNumberOfCalls(t, "GetAccountInfo", 1)
+		assert.Equal(t, accountData.Email, second.ActorEmail)
+		assert.Equal(t, "127.0.0.1", second.IP)
+	})
+
+	t.Run("should publish event without actor when failed to get account data", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
+
+		r, _ := http.NewRequest(http.MethodPatch, "test", nil)
+
+		event := auditEntities.NewTriageEvent(uuid.New(), uuid.New(), change)
+		assert.NotPanics(t, func() {
+			NewAuditService(brokerMock, authGRPCMock).Publish(r, event)
+		})
+
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Equal(t, uuid.Nil, event.ActorID)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	routerHttp "github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 	"github.com/google/wire"
 
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
 	webhookController "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
 	webhookEvent "github.com/ZupIT/horusec-platform/webhook/internal/events/webhook"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/health"
 	"github.com/ZupIT/horusec-platform/webhook/internal/router"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/audit"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
@@ -44,6 +46,7 @@ var providers = wire.NewSet(
 	routerHttp.NewHTTPRouter,
 
 	middlewares.NewAuthzMiddleware,
+	permissionMiddleware.NewPermissionMiddleware,
 
 	webhookRepository.NewWebhookRepository,
 
@@ -52,6 +55,8 @@ var providers = wire.NewSet(
 
 	webhookEvent.NewWebhookEvent,
 
+	audit.NewAuditService,
+
 	health.NewHealthHandler,
 	webhook.NewWebhookHandler,
 
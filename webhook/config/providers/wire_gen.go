@@ -17,6 +17,7 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 	"github.com/google/wire"
 
+	"github.com/ZupIT/horusec-platform/permission/middleware"
 	"github.com/ZupIT/horusec-platform/webhook/config/cors"
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
 	webhook2 "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
@@ -25,6 +26,7 @@ import (
 	webhook3 "github.com/ZupIT/horusec-platform/webhook/internal/handlers/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/repositories/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/router"
+	"github.com/ZupIT/horusec-platform/webhook/internal/services/audit"
 )
 
 // Injectors from wire.go:
@@ -47,13 +49,16 @@ func Initialize(defaultPort string) (router.IRouter, error) {
 	handler := health.NewHealthHandler(connection, clientConnInterface, iBroker)
 	iWebhookRepository := webhook.NewWebhookRepository(connection)
 	iWebhookController := webhook2.NewWebhookController(iWebhookRepository)
-	webhookHandler := webhook3.NewWebhookHandler(iWebhookController)
+	authServiceClient := proto.NewAuthServiceClient(clientConnInterface)
+	iService := audit.NewAuditService(iBroker, authServiceClient)
+	webhookHandler := webhook3.NewWebhookHandler(iWebhookController, iService)
 	iDispatcherController := dispatcher.NewDispatcherController(iWebhookRepository)
-	iEvent := webhook4.NewWebhookEvent(iBroker, iDispatcherController)
-	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, webhookHandler, iEvent)
+	iEvent := webhook4.NewWebhookEvent(iBroker, iDispatcherController, iWebhookController)
+	iMiddleware := middleware.NewPermissionMiddleware(authServiceClient)
+	routerIRouter := router.NewHTTPRouter(iRouter, iAuthzMiddleware, handler, webhookHandler, iEvent, iMiddleware)
 	return routerIRouter, nil
 }
 
 // wire.go:
 
-var providers = wire.NewSet(auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, app.NewAppConfig, config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, cors.NewCorsConfig, router2.NewHTTPRouter, middlewares.NewAuthzMiddleware, webhook.NewWebhookRepository, webhook2.NewWebhookController, dispatcher.NewDispatcherController, webhook4.NewWebhookEvent, health.NewHealthHandler, webhook3.NewWebhookHandler, router.NewHTTPRouter)
+var providers = wire.NewSet(auth.NewAuthGRPCConnection, proto.NewAuthServiceClient, app.NewAppConfig, config2.NewBrokerConfig, broker.NewBroker, config.NewDatabaseConfig, database.NewDatabaseReadAndWrite, cors.NewCorsConfig, router2.NewHTTPRouter, middlewares.NewAuthzMiddleware, webhook.NewWebhookRepository, webhook2.NewWebhookController, dispatcher.NewDispatcherController, webhook4.NewWebhookEvent, audit.NewAuditService, health.NewHealthHandler, webhook3.NewWebhookHandler, middleware.NewPermissionMiddleware, router.NewHTTPRouter)
@@ -4,6 +4,7 @@ go 1.16
 
 require (
 	github.com/ZupIT/horusec-devkit v1.0.3
+	github.com/ZupIT/horusec-platform/permission v0.0.0
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
 	github.com/go-chi/chi v4.1.2+incompatible
 	github.com/go-chi/cors v1.2.0
@@ -21,3 +22,5 @@ require (
 	google.golang.org/grpc v1.37.0
 	gorm.io/gorm v1.21.9 // indirect
 )
+
+replace github.com/ZupIT/horusec-platform/permission => ../permission
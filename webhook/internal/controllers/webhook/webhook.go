@@ -3,6 +3,8 @@ package webhook
 import (
 	"github.com/google/uuid"
 
+	databaseEnums "github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
+
 	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
@@ -12,8 +14,10 @@ import (
 type IWebhookController interface {
 	Save(entity *webhook.Webhook) (uuid.UUID, error)
 	Update(entity *webhook.Webhook, webhookID uuid.UUID) error
+	Get(webhookID uuid.UUID) (*webhook.Webhook, error)
 	ListAll(workspaceID uuid.UUID) (*[]webhook.WithRepository, error)
 	Remove(webhookID uuid.UUID) error
+	TransferRepository(transfer *webhook.Transfer) error
 }
 
 type Controller struct {
@@ -27,13 +31,9 @@ func NewWebhookController(repository repositoryWebhook.IWebhookRepository) IWebh
 }
 
 func (c *Controller) Save(entity *webhook.Webhook) (uuid.UUID, error) {
-	existing, err := c.repository.ListOne(map[string]interface{}{"repository_id": entity.RepositoryID})
-	if err != nil {
+	if err := c.checkCanSave(entity); err != nil {
 		return uuid.Nil, err
 	}
-	if existing.WebhookID != uuid.Nil {
-		return uuid.Nil, enums.ErrorWebhookDuplicate
-	}
 	entity = entity.GenerateID().GenerateCreateAt()
 	if err := c.repository.Save(entity); err != nil {
 		return uuid.Nil, err
@@ -41,11 +41,44 @@ func (c *Controller) Save(entity *webhook.Webhook) (uuid.UUID, error) {
 	return entity.WebhookID, nil
 }
 
+func (c *Controller) checkCanSave(entity *webhook.Webhook) error {
+	existing, err := c.repository.ListOne(map[string]interface{}{"repository_id": entity.RepositoryID})
+	if err != nil {
+		return err
+	}
+	if existing.WebhookID != uuid.Nil {
+		return enums.ErrorWebhookDuplicate
+	}
+	return c.checkWebhooksLimit(entity.WorkspaceID)
+}
+
+func (c *Controller) checkWebhooksLimit(workspaceID uuid.UUID) error {
+	isReached, err := c.repository.IsWebhooksLimitReached(workspaceID)
+	if err != nil {
+		return err
+	}
+	if isReached {
+		return enums.ErrorWebhooksLimitReached
+	}
+	return nil
+}
+
 func (c *Controller) Update(entity *webhook.Webhook, webhookID uuid.UUID) error {
 	entity = entity.GenerateUpdatedAt()
 	return c.repository.Update(entity, webhookID)
 }
 
+func (c *Controller) Get(webhookID uuid.UUID) (*webhook.Webhook, error) {
+	entity, err := c.repository.ListOne(map[string]interface{}{"webhook_id": webhookID})
+	if err != nil {
+		return nil, err
+	}
+	if entity.WebhookID == uuid.Nil {
+		return nil, databaseEnums.ErrorNotFoundRecords
+	}
+	return entity, nil
+}
+
 func (c *Controller) ListAll(workspaceID uuid.UUID) (*[]webhook.WithRepository, error) {
 	return c.repository.ListAll(workspaceID)
 }
@@ -53,3 +86,7 @@ func (c *Controller) ListAll(workspaceID uuid.UUID) (*[]webhook.WithRepository,
 func (c *Controller) Remove(webhookID uuid.UUID) error {
 	return c.repository.Remove(webhookID)
 }
+
+func (c *Controller) TransferRepository(transfer *webhook.Transfer) error {
+	return c.repository.UpdateWorkspace(transfer.RepositoryID, transfer.TargetWorkspaceID)
+}
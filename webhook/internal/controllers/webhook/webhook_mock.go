@@ -22,6 +22,11 @@ func (m *Mock) Update(_ *webhook.Webhook, _ uuid.UUID) error {
 	return utilsMock.ReturnNilOrError(args, 0)
 }
 
+func (m *Mock) Get(_ uuid.UUID) (*webhook.Webhook, error) {
+	args := m.MethodCalled("Get")
+	return args.Get(0).(*webhook.Webhook), utilsMock.ReturnNilOrError(args, 1)
+}
+
 func (m *Mock) ListAll(_ uuid.UUID) (*[]webhook.WithRepository, error) {
 	args := m.MethodCalled("ListAll")
 	return args.Get(0).(*[]webhook.WithRepository), utilsMock.ReturnNilOrError(args, 1)
@@ -31,3 +36,8 @@ func (m *Mock) Remove(_ uuid.UUID) error {
 	args := m.MethodCalled("Remove")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) TransferRepository(_ *webhook.Transfer) error {
+	args := m.MethodCalled("TransferRepository")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
@@ -41,6 +41,7 @@ func TestController_Save(t *testing.T) {
 	t.Run("Should save new webhook without error", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		repoMock.On("IsWebhooksLimitReached").Return(false, nil)
 		repoMock.On("Save").Return(nil)
 		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
 		assert.NoError(t, err)
@@ -67,13 +68,30 @@ func TestController_Save(t *testing.T) {
 	t.Run("Should save new webhook with error unexpected on save", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
 		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		repoMock.On("IsWebhooksLimitReached").Return(false, nil)
 		repoMock.On("Save").Return(errors.New("unexpected error"))
 		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
 		assert.Error(t, err)
 		assert.Equal(t, uuid.Nil, webhookID)
 	})
+	t.Run("Should save new webhook with error limit of webhooks reached", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		repoMock.On("IsWebhooksLimitReached").Return(true, nil)
+		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
+		assert.Equal(t, enums2.ErrorWebhooksLimitReached, err)
+		assert.Equal(t, uuid.Nil, webhookID)
+		repoMock.AssertNotCalled(t, "Save")
+	})
+	t.Run("Should save new webhook with error unexpected on check limit of webhooks", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		repoMock.On("IsWebhooksLimitReached").Return(false, errors.New("unexpected error"))
+		webhookID, err := NewWebhookController(repoMock).Save(&webhook.Webhook{})
+		assert.Error(t, err)
+		assert.Equal(t, uuid.Nil, webhookID)
+	})
 }
-
 func TestController_Update(t *testing.T) {
 	t.Run("Should update repository without error", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
@@ -95,6 +113,30 @@ func TestController_Update(t *testing.T) {
 	})
 }
 
+func TestController_Get(t *testing.T) {
+	t.Run("Should return webhook without error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{WebhookID: uuid.New()}, nil)
+		res, err := NewWebhookController(repoMock).Get(uuid.New())
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+	})
+	t.Run("Should return error not found when webhook does not exists", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, nil)
+		res, err := NewWebhookController(repoMock).Get(uuid.New())
+		assert.Equal(t, enums.ErrorNotFoundRecords, err)
+		assert.Nil(t, res)
+	})
+	t.Run("Should return error unexpected on get webhook", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("ListOne").Return(&webhook.Webhook{}, errors.New("unexpected error"))
+		res, err := NewWebhookController(repoMock).Get(uuid.New())
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+}
+
 func TestController_Remove(t *testing.T) {
 	t.Run("Should remove repository without error", func(t *testing.T) {
 		repoMock := &repositoryWebhook.Mock{}
@@ -115,3 +157,18 @@ func TestController_Remove(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestController_TransferRepository(t *testing.T) {
+	t.Run("Should move webhook of repository to target workspace without error", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("UpdateWorkspace").Return(nil)
+		err := NewWebhookController(repoMock).TransferRepository(&webhook.Transfer{TargetWorkspaceID: uuid.New()})
+		assert.NoError(t, err)
+	})
+	t.Run("Should move webhook of repository with error unexpected", func(t *testing.T) {
+		repoMock := &repositoryWebhook.Mock{}
+		repoMock.On("UpdateWorkspace").Return(errors.New("unexpected error"))
+		err := NewWebhookController(repoMock).TransferRepository(&webhook.Transfer{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+// AuditEvent is published to the audit exchange after a webhook is changed, core persists it in the audit log
+type AuditEvent struct {
+	EventID      uuid.UUID       `json:"eventID"`
+	Service      string          `json:"service"`
+	Action       string          `json:"action"`
+	ActorID      uuid.UUID       `json:"actorID"`
+	ActorEmail   string          `json:"actorEmail"`
+	IP           string          `json:"ip"`
+	WorkspaceID  uuid.UUID       `json:"workspaceID"`
+	RepositoryID uuid.UUID       `json:"repositoryID"`
+	TargetType   string          `json:"targetType"`
+	TargetID     uuid.UUID       `json:"targetID"`
+	Before       json.RawMessage `json:"before,omitempty"`
+	After        json.RawMessage `json:"after,omitempty"`
+	OccurredAt   time.Time       `json:"occurredAt"`
+}
+
+func NewAuditEvent(action string, webhookID, workspaceID, repositoryID uuid.UUID) *AuditEvent {
+	return &AuditEvent{
+		EventID:      uuid.New(),
+		Service:      enums.ServiceAudit,
+		Action:       action,
+		WorkspaceID:  workspaceID,
+		RepositoryID: repositoryID,
+		TargetType:   enums.AuditTargetWebhook,
+		TargetID:     webhookID,
+		OccurredAt:   time.Now(),
+	}
+}
+
+// SetChanges keeps the webhook before and after the action, nil means that the webhook does not exist
+func (a *AuditEvent) SetChanges(before, after *Webhook) *AuditEvent {
+	a.Before = a.toRawMessage(before)
+	a.After = a.toRawMessage(after)
+	return a
+}
+
+func (a *AuditEvent) toRawMessage(entity *Webhook) json.RawMessage {
+	if entity == nil {
+		return nil
+	}
+	hidden := *entity
+	hidden.Headers = entity.Headers.WithHiddenValues()
+	bytes, _ := json.Marshal(hidden)
+	return bytes
+}
+
+func (a *AuditEvent) SetActor(accountData *proto.GetAccountDataResponse) *AuditEvent {
+	if accountData == nil {
+		return a
+	}
+	a.ActorID, _ = uuid.Parse(accountData.AccountID)
+	a.ActorEmail = accountData.Email
+	return a
+}
+
+func (a *AuditEvent) SetIP(r *http.Request) *AuditEvent {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		a.IP = r.RemoteAddr
+		return a
+	}
+	a.IP = host
+	return a
+}
+
+func (a *AuditEvent) ToBytes() []byte {
+	bytes, _ := json.Marshal(a)
+	return bytes
+}
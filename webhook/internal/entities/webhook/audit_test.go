@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestNewAuditEvent(t *testing.T) {
+	t.Run("Should create a new audit event of a webhook", func(t *testing.T) {
+		webhookID, workspaceID, repositoryID := uuid.New(), uuid.New(), uuid.New()
+		event := NewAuditEvent(enums.AuditActionWebhookCreate, webhookID, workspaceID, repositoryID)
+		assert.NotEqual(t, uuid.Nil, event.EventID)
+		assert.Equal(t, enums.ServiceAudit, event.Service)
+		assert.Equal(t, enums.AuditActionWebhookCreate, event.Action)
+		assert.Equal(t, enums.AuditTargetWebhook, event.TargetType)
+		assert.Equal(t, webhookID, event.TargetID)
+		assert.Equal(t, workspaceID, event.WorkspaceID)
+		assert.Equal(t, repositoryID, event.RepositoryID)
+		assert.False(t, event.OccurredAt.IsZero())
+	})
+}
+
+func TestAuditEventSetChanges(t *testing.T) {
+	t.Run("Should set changes hiding the values of the headers", func(t *testing.T) {
+		after := &Webhook{URL: "http://test.io", Headers: HeaderType{Headers{Key: "key", Value: "secret"}}}
+		event := NewAuditEvent(enums.AuditActionWebhookCreate, uuid.New(), uuid.New(), uuid.New()).
+			SetChanges(nil, after)
+		result := &Webhook{}
+		assert.NoError(t, json.Unmarshal(event.After, result))
+		assert.Nil(t, event.Before)
+		assert.Equal(t, "http://test.io", result.URL)
+		assert.Equal(t, enums.HiddenHeaderValue, result.Headers[0].Value)
+		assert.Equal(t, "secret", after.Headers[0].Value)
+	})
+}
+
+func TestAuditEventSetActor(t *testing.T) {
+	t.Run("Should set actor of the event", func(t *testing.T) {
+		accountID := uuid.New()
+		event := NewAuditEvent(enums.AuditActionWebhookDelete, uuid.New(), uuid.New(), uuid.New()).
+			SetActor(&proto.GetAccountDataResponse{AccountID: accountID.String(), Email: "test@horusec.io"})
+		assert.Equal(t, accountID, event.ActorID)
+		assert.Equal(t, "test@horusec.io", event.ActorEmail)
+	})
+	t.Run("Should keep actor empty when account data is nil", func(t *testing.T) {
+		event := NewAuditEvent(enums.AuditActionWebhookDelete, uuid.New(), uuid.New(), uuid.New()).SetActor(nil)
+		assert.Equal(t, uuid.Nil, event.ActorID)
+		assert.Empty(t, event.ActorEmail)
+	})
+}
+
+func TestAuditEventSetIP(t *testing.T) {
+	t.Run("Should set ip without the port", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		r.RemoteAddr = "127.0.0.1:8000"
+		event := NewAuditEvent(enums.AuditActionWebhookUpdate, uuid.New(), uuid.New(), uuid.New()).SetIP(r)
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+	t.Run("Should set ip when remote address has no port", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		r.RemoteAddr = "127.0.0.1"
+		event := NewAuditEvent(enums.AuditActionWebhookUpdate, uuid.New(), uuid.New(), uuid.New()).SetIP(r)
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+}
+
+func TestAuditEventToBytes(t *testing.T) {
+	t.Run("Should parse audit event to bytes", func(t *testing.T) {
+		event := NewAuditEvent(enums.AuditActionWebhookUpdate, uuid.New(), uuid.New(), uuid.New())
+		assert.NotEmpty(t, event.ToBytes())
+	})
+}
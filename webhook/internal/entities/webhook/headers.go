@@ -4,6 +4,8 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 type HeaderType []Headers
@@ -32,3 +34,12 @@ func (h HeaderType) GetMapHeaders() map[string]string {
 	}
 	return headers
 }
+
+// WithHiddenValues keeps only the keys, since headers usually carry credentials of the destination
+func (h HeaderType) WithHiddenValues() HeaderType {
+	hidden := HeaderType{}
+	for _, header := range h {
+		hidden = append(hidden, Headers{Key: header.Key, Value: enums.HiddenHeaderValue})
+	}
+	return hidden
+}
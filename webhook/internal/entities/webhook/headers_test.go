@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 func TestHeaderType(t *testing.T) {
@@ -42,4 +44,13 @@ func TestHeaderType(t *testing.T) {
 		assert.Equal(t, "value1", headers.GetMapHeaders()["key1"])
 		assert.Equal(t, "value2", headers.GetMapHeaders()["key2"])
 	})
+	t.Run("Should hide the values of the headers", func(t *testing.T) {
+		headers := HeaderType{
+			Headers{Key: "x-authorization", Value: "secret"},
+		}
+		hidden := headers.WithHiddenValues()
+		assert.Equal(t, "x-authorization", hidden[0].Key)
+		assert.Equal(t, enums.HiddenHeaderValue, hidden[0].Value)
+		assert.Equal(t, "secret", headers[0].Value)
+	})
 }
@@ -0,0 +1,16 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Transfer is received from core when a repository moves to another workspace, its webhook moves along with it
+type Transfer struct {
+	RepositoryID      uuid.UUID `json:"repositoryID"`
+	SourceWorkspaceID uuid.UUID `json:"sourceWorkspaceID"`
+	TargetWorkspaceID uuid.UUID `json:"targetWorkspaceID"`
+	Name              string    `json:"name"`
+	TransferredAt     time.Time `json:"transferredAt"`
+}
@@ -7,4 +7,11 @@ var (
 	ErrorWebhookDuplicate = errors.New("{HORUSEC} webhook already exists to repository selected")
 	ErrorWrongWorkspaceID = errors.New("{HORUSEC} workspaceID is not valid uuid")
 	ErrorWrongWebhookID   = errors.New("{HORUSEC} webhookID is not valid uuid")
+
+	ErrorWebhooksLimitReached = errors.New("{HORUSEC} the workspace reached its limit of webhooks")
+)
+
+const (
+	MessageFailedToPublishAuditEvent = "{HORUSEC} failed to publish audit event"
+	MessageFailedToGetAuditActor     = "{HORUSEC} failed to get the account that performed the audited action"
 )
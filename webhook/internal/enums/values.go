@@ -6,3 +6,21 @@ const (
 	HealthRouter  = BaseRouter + "/health"
 	WebhookRouter = BaseRouter + "/webhook/{workspaceID}"
 )
+
+const (
+	ExchangeRepositoryTransfer = "horusec-repository-transfer"
+	QueueRepositoryTransfer    = "horusec-webhook::repository-transfer"
+)
+
+const (
+	ExchangeAudit = "horusec-audit"
+	ServiceAudit  = "webhook"
+)
+
+const (
+	AuditActionWebhookCreate = "webhook.create"
+	AuditActionWebhookUpdate = "webhook.update"
+	AuditActionWebhookDelete = "webhook.delete"
+	AuditTargetWebhook       = "webhook"
+	HiddenHeaderValue        = "******"
+)
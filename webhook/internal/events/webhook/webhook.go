@@ -10,19 +10,25 @@ import (
 	"github.com/ZupIT/horusec-devkit/pkg/utils/parser"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
+	webhookController "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 )
 
 type IEvent interface{}
 
 type Event struct {
-	broker     broker.IBroker
-	controller dispatcher.IDispatcherController
+	broker            broker.IBroker
+	controller        dispatcher.IDispatcherController
+	webhookController webhookController.IWebhookController
 }
 
-func NewWebhookEvent(iBroker broker.IBroker, controller dispatcher.IDispatcherController) IEvent {
+func NewWebhookEvent(iBroker broker.IBroker, controller dispatcher.IDispatcherController,
+	controllerWebhook webhookController.IWebhookController) IEvent {
 	e := &Event{
-		broker:     iBroker,
-		controller: controller,
+		broker:            iBroker,
+		controller:        controller,
+		webhookController: controllerWebhook,
 	}
 	return e.consumeQueues()
 }
@@ -30,6 +36,8 @@ func NewWebhookEvent(iBroker broker.IBroker, controller dispatcher.IDispatcherCo
 func (e *Event) consumeQueues() IEvent {
 	go e.broker.Consume(queues.HorusecWebhook.ToString(), exchange.NewAnalysis, exchange.Fanout,
 		e.handleNewAnalysis)
+	go e.broker.Consume(enums.QueueRepositoryTransfer, enums.ExchangeRepositoryTransfer, exchange.Fanout,
+		e.handleRepositoryTransfer)
 	return e
 }
 
@@ -47,3 +55,19 @@ func (e *Event) handleNewAnalysis(brokerPacket packet.IPacket) {
 	}
 	_ = brokerPacket.Ack()
 }
+
+func (e *Event) handleRepositoryTransfer(brokerPacket packet.IPacket) {
+	logger.LogInfo("{HORUSEC} Packet received from repository transfer")
+	entity := webhook.Transfer{}
+	if err := parser.ParsePacketToEntity(brokerPacket, &entity); err != nil {
+		logger.LogError("{HORUSEC} Read packet error", err)
+		_ = brokerPacket.Ack()
+		return
+	}
+
+	if err := e.webhookController.TransferRepository(&entity); err != nil {
+		logger.LogError("{HORUSEC} Error on transfer repository webhook", err)
+		return
+	}
+	_ = brokerPacket.Ack()
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/dispatcher"
+	webhookController "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
 )
 
 func TestNewWebhookEvent(t *testing.T) {
@@ -24,8 +25,10 @@ func TestNewWebhookEvent(t *testing.T) {
 		brokerMock.On("ConsumeHandlerFunc").Return(entity)
 		controllerMock := &dispatcher.Mock{}
 		controllerMock.On("DispatchRequest").Return(nil)
+		webhookControllerMock := &webhookController.Mock{}
+		webhookControllerMock.On("TransferRepository").Return(nil)
 		assert.NotPanics(t, func() {
-			NewWebhookEvent(brokerMock, controllerMock)
+			NewWebhookEvent(brokerMock, controllerMock, webhookControllerMock)
 			time.Sleep(5 * time.Second)
 			brokerMock.AssertCalled(t, "ConsumeHandlerFunc")
 		})
@@ -49,3 +52,41 @@ func TestNewWebhookEvent(t *testing.T) {
 		})
 	})
 }
+
+func TestHandleRepositoryTransfer(t *testing.T) {
+	t.Run("Should transfer repository webhook without errors", func(t *testing.T) {
+		controllerMock := &webhookController.Mock{}
+		controllerMock.On("TransferRepository").Return(nil)
+		event := &Event{
+			webhookController: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{})
+		pkg.SetBody([]byte("{}"))
+		assert.NotPanics(t, func() {
+			event.handleRepositoryTransfer(pkg)
+		})
+		controllerMock.AssertCalled(t, "TransferRepository")
+	})
+	t.Run("Should return error on parse packet to transfer", func(t *testing.T) {
+		controllerMock := &webhookController.Mock{}
+		event := &Event{
+			webhookController: controllerMock,
+		}
+		assert.NotPanics(t, func() {
+			event.handleRepositoryTransfer(packet.NewPacket(&amqp.Delivery{}))
+		})
+		controllerMock.AssertNotCalled(t, "TransferRepository")
+	})
+	t.Run("Should return error on transfer repository webhook", func(t *testing.T) {
+		controllerMock := &webhookController.Mock{}
+		controllerMock.On("TransferRepository").Return(errors.New("unexpected error"))
+		event := &Event{
+			webhookController: controllerMock,
+		}
+		pkg := packet.NewPacket(&amqp.Delivery{})
+		pkg.SetBody([]byte("{}"))
+		assert.NotPanics(t, func() {
+			event.handleRepositoryTransfer(pkg)
+		})
+	})
+}
@@ -5,26 +5,27 @@ import (
 
 	enumsWebhook "github.com/ZupIT/horusec-platform/webhook/internal/enums"
 
-	"github.com/google/uuid"
-
 	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/database/enums"
 	httpUtil "github.com/ZupIT/horusec-devkit/pkg/utils/http"
 
 	controllerWebhook "github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
+	serviceAudit "github.com/ZupIT/horusec-platform/webhook/internal/services/audit"
 	useCase "github.com/ZupIT/horusec-platform/webhook/internal/usecases/webhook"
 )
 
 type Handler struct {
 	controller controllerWebhook.IWebhookController
 	useCase    useCase.IUseCaseWebhook
+	audit      serviceAudit.IService
 }
 
-func NewWebhookHandler(controller controllerWebhook.IWebhookController) *Handler {
+func NewWebhookHandler(controller controllerWebhook.IWebhookController, audit serviceAudit.IService) *Handler {
 	return &Handler{
 		controller: controller,
 		useCase:    useCase.NewUseCaseWebhook(),
+		audit:      audit,
 	}
 }
 
@@ -72,19 +73,44 @@ func (h *Handler) ListAll(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /webhook/webhook/{workspaceID}/{webhookID} [delete]
 func (h *Handler) Remove(w netHTTP.ResponseWriter, r *netHTTP.Request) {
-	webhookID, err := h.useCase.ExtractWebhookIDFromURL(r)
+	before, err := h.getWebhookFromURL(r)
 	if err != nil {
-		httpUtil.StatusBadRequest(w, err)
+		h.checkGetWebhookErrors(w, err)
 		return
 	}
-	if err := h.controller.Remove(webhookID); err != nil {
-		if err == enums.ErrorNotFoundRecords {
-			httpUtil.StatusNotFound(w, err)
-		} else {
-			httpUtil.StatusInternalServerError(w, err)
-		}
-	} else {
-		httpUtil.StatusNoContent(w)
+	if err := h.controller.Remove(before.WebhookID); err != nil {
+		h.checkNotFoundErrors(w, err)
+		return
+	}
+	h.audit.Publish(r, webhook.NewAuditEvent(enumsWebhook.AuditActionWebhookDelete, before.WebhookID,
+		before.WorkspaceID, before.RepositoryID).SetChanges(before, nil))
+	httpUtil.StatusNoContent(w)
+}
+
+func (h *Handler) checkNotFoundErrors(w netHTTP.ResponseWriter, err error) {
+	if err == enums.ErrorNotFoundRecords {
+		httpUtil.StatusNotFound(w, err)
+		return
+	}
+	httpUtil.StatusInternalServerError(w, err)
+}
+
+func (h *Handler) getWebhookFromURL(r *netHTTP.Request) (*webhook.Webhook, error) {
+	webhookID, err := h.useCase.ExtractWebhookIDFromURL(r)
+	if err != nil {
+		return nil, err
+	}
+	return h.controller.Get(webhookID)
+}
+
+func (h *Handler) checkGetWebhookErrors(w netHTTP.ResponseWriter, err error) {
+	switch err {
+	case enumsWebhook.ErrorWrongWebhookID:
+		httpUtil.StatusBadRequest(w, err)
+	case enums.ErrorNotFoundRecords:
+		httpUtil.StatusNotFound(w, err)
+	default:
+		httpUtil.StatusInternalServerError(w, err)
 	}
 }
 
@@ -103,9 +129,9 @@ func (h *Handler) Remove(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /webhook/webhook/{workspaceID}/{webhookID} [put]
 func (h *Handler) Update(w netHTTP.ResponseWriter, r *netHTTP.Request) {
-	webhookID, err := h.useCase.ExtractWebhookIDFromURL(r)
+	before, err := h.getWebhookFromURL(r)
 	if err != nil {
-		httpUtil.StatusBadRequest(w, err)
+		h.checkGetWebhookErrors(w, err)
 		return
 	}
 	body, err := h.useCase.DecodeWebhookFromIoRead(r)
@@ -113,18 +139,16 @@ func (h *Handler) Update(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 		httpUtil.StatusBadRequest(w, err)
 		return
 	}
-	h.updateWebhook(w, body, webhookID)
+	h.updateWebhook(w, r, body, before)
 }
 
-func (h *Handler) updateWebhook(w netHTTP.ResponseWriter, body *webhook.Webhook, webhookID uuid.UUID) {
-	if err := h.controller.Update(body, webhookID); err != nil {
-		if err == enums.ErrorNotFoundRecords {
-			httpUtil.StatusNotFound(w, err)
-			return
-		}
-		httpUtil.StatusInternalServerError(w, err)
+func (h *Handler) updateWebhook(w netHTTP.ResponseWriter, r *netHTTP.Request, body, before *webhook.Webhook) {
+	if err := h.controller.Update(body, before.WebhookID); err != nil {
+		h.checkNotFoundErrors(w, err)
 		return
 	}
+	h.audit.Publish(r, webhook.NewAuditEvent(enumsWebhook.AuditActionWebhookUpdate, before.WebhookID,
+		before.WorkspaceID, before.RepositoryID).SetChanges(before, body))
 	httpUtil.StatusNoContent(w)
 }
 
@@ -139,6 +163,7 @@ func (h *Handler) updateWebhook(w netHTTP.ResponseWriter, body *webhook.Webhook,
 // @Param webhookToSave body webhook.Webhook true "update webhook content info"
 // @Success 200 {object} entities.Response{content=string} "NO CONTENT"
 // @Failure 400 {object} entities.Response{content=string} "BAD REQUEST"
+// @Failure 403 {object} entities.Response{content=string} "FORBIDDEN"
 // @Failure 500 {object} entities.Response{content=string} "INTERNAL SERVER ERROR"
 // @Router /webhook/webhook/{workspaceID} [post]
 func (h *Handler) Save(w netHTTP.ResponseWriter, r *netHTTP.Request) {
@@ -149,12 +174,22 @@ func (h *Handler) Save(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	}
 	webhookID, err := h.controller.Save(body)
 	if err != nil {
-		if err == enumsWebhook.ErrorWebhookDuplicate {
-			httpUtil.StatusConflict(w, err)
-		} else {
-			httpUtil.StatusInternalServerError(w, err)
-		}
-	} else {
-		httpUtil.StatusOK(w, webhookID)
+		h.checkSaveErrors(w, err)
+		return
+	}
+	h.audit.Publish(r, webhook.NewAuditEvent(enumsWebhook.AuditActionWebhookCreate, webhookID,
+		body.WorkspaceID, body.RepositoryID).SetChanges(nil, body))
+	httpUtil.StatusOK(w, webhookID)
+}
+
+func (h *Handler) checkSaveErrors(w netHTTP.ResponseWriter, err error) {
+	if err == enumsWebhook.ErrorWebhookDuplicate {
+		httpUtil.StatusConflict(w, err)
+		return
+	}
+	if err == enumsWebhook.ErrorWebhooksLimitReached {
+		httpUtil.StatusForbidden(w, err)
+		return
 	}
+	httpUtil.StatusInternalServerError(w, err)
 }
@@ -16,15 +16,22 @@ import (
 	"github.com/ZupIT/horusec-platform/webhook/internal/controllers/webhook"
 	webhookEntity "github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+	serviceAudit "github.com/ZupIT/horusec-platform/webhook/internal/services/audit"
 	useCaseWebhook "github.com/ZupIT/horusec-platform/webhook/internal/usecases/webhook"
 )
 
+func newAuditServiceMock() *serviceAudit.Mock {
+	auditMock := &serviceAudit.Mock{}
+	auditMock.On("Publish")
+	return auditMock
+}
+
 func TestHandler_Options(t *testing.T) {
 	t.Run("Should return no content when call options", func(t *testing.T) {
 		controllerMock := &webhook.Mock{}
 		w := httptest.NewRecorder()
 		r, _ := http.NewRequest("", "/test", nil)
-		NewWebhookHandler(controllerMock).Options(w, r)
+		NewWebhookHandler(controllerMock, newAuditServiceMock()).Options(w, r)
 		assert.Equal(t, http.StatusNoContent, w.Code)
 	})
 }
@@ -44,6 +51,7 @@ func TestHandler_ListAll(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.ListAll(w, r)
 		assert.Equal(t, http.StatusOK, w.Code)
@@ -62,6 +70,7 @@ func TestHandler_ListAll(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.ListAll(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -80,6 +89,7 @@ func TestHandler_ListAll(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.ListAll(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -95,12 +105,14 @@ func TestHandler_Remove(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Remove").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Remove(w, r)
 		assert.Equal(t, http.StatusNoContent, w.Code)
@@ -113,12 +125,14 @@ func TestHandler_Remove(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Remove").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Remove(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -131,12 +145,14 @@ func TestHandler_Remove(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Remove").Return(enums2.ErrorNotFoundRecords)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Remove(w, r)
 		assert.Equal(t, http.StatusNotFound, w.Code)
@@ -149,12 +165,46 @@ func TestHandler_Remove(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Remove").Return(errors.New("unexpected error"))
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
+		}
+		handler.Remove(w, r)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+	t.Run("Should return status not found when call Remove and webhook was not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("", "/test", nil)
+
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{}, enums2.ErrorNotFoundRecords)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
+		}
+		handler.Remove(w, r)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+	t.Run("Should return status internal server error when call Remove and failed to get webhook", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("", "/test", nil)
+
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{}, errors.New("unexpected error"))
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Remove(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -175,6 +225,7 @@ func TestHandler_Save(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Save(w, r)
 		assert.Equal(t, http.StatusOK, w.Code)
@@ -192,6 +243,7 @@ func TestHandler_Save(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Save(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -210,10 +262,30 @@ func TestHandler_Save(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Save(w, r)
 		assert.Equal(t, http.StatusConflict, w.Code)
 	})
+	t.Run("Should return status forbidden when call Save but workspace reached the limit of webhooks", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		r, _ := http.NewRequest("", "/test", nil)
+		ctx := chi.NewRouteContext()
+		ctx.URLParams.Add("webhookID", uuid.NewString())
+		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
+
+		controllerMock := &webhook.Mock{}
+		controllerMock.On("Save").Return(uuid.Nil, enums.ErrorWebhooksLimitReached)
+		useCaseMock := &useCaseWebhook.Mock{}
+		useCaseMock.On("DecodeWebhookFromIoRead").Return(&webhookEntity.Webhook{}, nil)
+		handler := &Handler{
+			controller: controllerMock,
+			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
+		}
+		handler.Save(w, r)
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
 	t.Run("Should return no status internal server error when call ListAll in controller unexpected error", func(t *testing.T) {
 		w := httptest.NewRecorder()
 		r, _ := http.NewRequest("", "/test", nil)
@@ -228,6 +300,7 @@ func TestHandler_Save(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Save(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
@@ -242,6 +315,7 @@ func TestHandler_Update(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Update").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
@@ -249,6 +323,7 @@ func TestHandler_Update(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Update(w, r)
 		assert.Equal(t, http.StatusNoContent, w.Code)
@@ -260,6 +335,7 @@ func TestHandler_Update(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Update").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
@@ -267,6 +343,7 @@ func TestHandler_Update(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Update(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -278,6 +355,7 @@ func TestHandler_Update(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Update").Return(nil)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.Nil, enums.ErrorWrongWebhookID)
@@ -285,6 +363,7 @@ func TestHandler_Update(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Update(w, r)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
@@ -297,6 +376,7 @@ func TestHandler_Update(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Update").Return(enums2.ErrorNotFoundRecords)
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
@@ -304,6 +384,7 @@ func TestHandler_Update(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Update(w, r)
 		assert.Equal(t, http.StatusNotFound, w.Code)
@@ -316,6 +397,7 @@ func TestHandler_Update(t *testing.T) {
 		r = r.WithContext(context.WithValue(r.Context(), chi.RouteCtxKey, ctx))
 
 		controllerMock := &webhook.Mock{}
+		controllerMock.On("Get").Return(&webhookEntity.Webhook{WebhookID: uuid.New()}, nil)
 		controllerMock.On("Update").Return(errors.New("unexpected error"))
 		useCaseMock := &useCaseWebhook.Mock{}
 		useCaseMock.On("ExtractWebhookIDFromURL").Return(uuid.New(), nil)
@@ -323,6 +405,7 @@ func TestHandler_Update(t *testing.T) {
 		handler := &Handler{
 			controller: controllerMock,
 			useCase:    useCaseMock,
+			audit:      newAuditServiceMock(),
 		}
 		handler.Update(w, r)
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
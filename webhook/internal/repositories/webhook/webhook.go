@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"time"
+
 	"github.com/ZupIT/horusec-devkit/pkg/services/database"
 	"github.com/google/uuid"
 
@@ -13,6 +15,8 @@ type IWebhookRepository interface {
 	ListAll(workspaceID uuid.UUID) (entities *[]webhook.WithRepository, err error)
 	ListOne(condition map[string]interface{}) (entity *webhook.Webhook, err error)
 	Remove(webhookID uuid.UUID) error
+	UpdateWorkspace(repositoryID, workspaceID uuid.UUID) error
+	IsWebhooksLimitReached(workspaceID uuid.UUID) (bool, error)
 }
 
 type Repository struct {
@@ -66,3 +70,29 @@ func (r *Repository) Remove(webhookID uuid.UUID) error {
 	condition := map[string]interface{}{"webhook_id": webhookID}
 	return r.dbWrite.Delete(condition, (&webhook.Webhook{}).GetTable()).GetError()
 }
+
+func (r *Repository) UpdateWorkspace(repositoryID, workspaceID uuid.UUID) error {
+	condition := map[string]interface{}{"repository_id": repositoryID}
+	values := map[string]interface{}{"workspace_id": workspaceID, "updated_at": time.Now()}
+	return r.dbWrite.Update(values, condition, (&webhook.Webhook{}).GetTable()).GetError()
+}
+
+// IsWebhooksLimitReached checks the webhooks of the workspace against its quota, workspaces without quota have no limit
+func (r *Repository) IsWebhooksLimitReached(workspaceID uuid.UUID) (bool, error) {
+	var count int64
+	res := r.dbRead.Raw(r.queryIsWebhooksLimitReached(), &count, workspaceID)
+	if res.GetErrorExceptNotFound() != nil {
+		return false, res.GetErrorExceptNotFound()
+	}
+	return count > 0, nil
+}
+
+func (r *Repository) queryIsWebhooksLimitReached() string {
+	return `
+			SELECT COUNT(*)
+			FROM workspace_quotas AS quota
+			WHERE quota.workspace_id = ? AND quota.max_webhooks IS NOT NULL AND quota.max_webhooks <= (
+				SELECT COUNT(*) FROM webhooks AS wh WHERE wh.workspace_id = quota.workspace_id
+			)
+	`
+}
@@ -36,3 +36,13 @@ func (m *Mock) Remove(_ uuid.UUID) error {
 	args := m.MethodCalled("Remove")
 	return utilsMock.ReturnNilOrError(args, 0)
 }
+
+func (m *Mock) UpdateWorkspace(_, _ uuid.UUID) error {
+	args := m.MethodCalled("UpdateWorkspace")
+	return utilsMock.ReturnNilOrError(args, 0)
+}
+
+func (m *Mock) IsWebhooksLimitReached(_ uuid.UUID) (bool, error) {
+	args := m.MethodCalled("IsWebhooksLimitReached")
+	return args.Get(0).(bool), utilsMock.ReturnNilOrError(args, 1)
+}
@@ -170,3 +170,53 @@ func TestRepository_Remove(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRepository_UpdateWorkspace(t *testing.T) {
+	t.Run("Should update workspace of repository webhook without error", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(0, nil, nil))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: dbWrite,
+		}
+		err := NewWebhookRepository(connection).UpdateWorkspace(uuid.New(), uuid.New())
+		assert.NoError(t, err)
+	})
+	t.Run("Should update workspace of repository webhook with error", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbWrite := &database.Mock{}
+		dbWrite.On("Update").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: dbWrite,
+		}
+		err := NewWebhookRepository(connection).UpdateWorkspace(uuid.New(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_IsWebhooksLimitReached(t *testing.T) {
+	t.Run("Should return false when workspace is under the limit of webhooks", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, nil, nil))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: &database.Mock{},
+		}
+		isReached, err := NewWebhookRepository(connection).IsWebhooksLimitReached(uuid.New())
+		assert.NoError(t, err)
+		assert.False(t, isReached)
+	})
+	t.Run("Should return error when failed to check the limit of webhooks", func(t *testing.T) {
+		dbRead := &database.Mock{}
+		dbRead.On("Raw").Return(response.NewResponse(0, errors.New("unexpected error"), nil))
+		connection := &database.Connection{
+			Read:  dbRead,
+			Write: &database.Mock{},
+		}
+		isReached, err := NewWebhookRepository(connection).IsWebhooksLimitReached(uuid.New())
+		assert.Error(t, err)
+		assert.False(t, isReached)
+	})
+}
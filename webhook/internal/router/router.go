@@ -15,6 +15,9 @@ import (
 	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/swagger"
+
+	permissionEnums "github.com/ZupIT/horusec-platform/permission/enums"
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 )
 
 type IRouter interface {
@@ -25,16 +28,19 @@ type Router struct {
 	router.IRouter
 	swagger.ISwagger
 	middlewares.IAuthzMiddleware
+	permissionMiddleware.IMiddleware
 	healthHandler  *health.Handler
 	webhookHandler *webhook.Handler
 	webhookEvents  webhookEvent.IEvent
 }
 
 func NewHTTPRouter(routerConn router.IRouter, authzMiddleware middlewares.IAuthzMiddleware,
-	healthHandler *health.Handler, webhookHandler *webhook.Handler, webhookEvents webhookEvent.IEvent) IRouter {
+	healthHandler *health.Handler, webhookHandler *webhook.Handler, webhookEvents webhookEvent.IEvent,
+	middlewarePermission permissionMiddleware.IMiddleware) IRouter {
 	routes := &Router{
 		IRouter:          routerConn,
 		IAuthzMiddleware: authzMiddleware,
+		IMiddleware:      middlewarePermission,
 		ISwagger:         swagger.NewSwagger(routerConn.GetMux(), enums.DefaultPort),
 		healthHandler:    healthHandler,
 		webhookHandler:   webhookHandler,
@@ -63,11 +69,14 @@ func (r *Router) routerHealth() {
 }
 
 func (r *Router) routerWebhook() {
+	canRead := r.HasPermission(permissionEnums.WebhookRead)
+	canManage := r.HasPermission(permissionEnums.WebhookManage)
+
 	r.Route(enums.WebhookRouter, func(router chi.Router) {
 		router.Options("/", r.webhookHandler.Options)
-		router.With(r.IsWorkspaceAdmin).Get("/", r.webhookHandler.ListAll)
-		router.With(r.IsWorkspaceAdmin).Post("/", r.webhookHandler.Save)
-		router.With(r.IsWorkspaceAdmin).Put("/{webhookID}", r.webhookHandler.Update)
-		router.With(r.IsWorkspaceAdmin).Delete("/{webhookID}", r.webhookHandler.Remove)
+		router.With(canRead).Get("/", r.webhookHandler.ListAll)
+		router.With(canManage).Post("/", r.webhookHandler.Save)
+		router.With(canManage).Put("/{webhookID}", r.webhookHandler.Update)
+		router.With(canManage).Delete("/{webhookID}", r.webhookHandler.Remove)
 	})
 }
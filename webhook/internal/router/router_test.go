@@ -3,6 +3,7 @@ package router
 import (
 	"testing"
 
+	permissionMiddleware "github.com/ZupIT/horusec-platform/permission/middleware"
 	webhook2 "github.com/ZupIT/horusec-platform/webhook/internal/events/webhook"
 	"github.com/ZupIT/horusec-platform/webhook/internal/handlers/webhook"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/ZupIT/horusec-devkit/pkg/services/http/router"
 
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
 	"github.com/ZupIT/horusec-devkit/pkg/services/middlewares"
 
 	"github.com/go-chi/cors"
@@ -23,7 +25,9 @@ func TestNewHTTPRouter(t *testing.T) {
 		healthMock := &health.Handler{}
 		webhookHandlerMock := &webhook.Handler{}
 		webhookEventMock := &webhook2.Event{}
-		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, webhookHandlerMock, webhookEventMock)
+		middlewarePermission := permissionMiddleware.NewPermissionMiddleware(&proto.Mock{})
+		instance := NewHTTPRouter(routerConn, middlewareMock, healthMock, webhookHandlerMock, webhookEventMock,
+			middlewarePermission)
 		assert.NotEmpty(t, instance)
 	})
 }
@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/ZupIT/horusec-devkit/pkg/enums/exchange"
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/jwt/enums"
+	"github.com/ZupIT/horusec-devkit/pkg/utils/logger"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	enumsWebhook "github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+type IService interface {
+	Publish(r *http.Request, event *webhook.AuditEvent)
+}
+
+type Service struct {
+	broker   broker.IBroker
+	authGRPC proto.AuthServiceClient
+	context  context.Context
+}
+
+func NewAuditService(iBroker broker.IBroker, authGRPC proto.AuthServiceClient) IService {
+	return &Service{
+		broker:   iBroker,
+		authGRPC: authGRPC,
+		context:  context.Background(),
+	}
+}
+
+// Publish only logs the failures, since the webhook was already changed when the event is published
+func (s *Service) Publish(r *http.Request, event *webhook.AuditEvent) {
+	event.SetActor(s.getAccountData(r)).SetIP(r)
+	logger.LogError(enumsWebhook.MessageFailedToPublishAuditEvent,
+		s.broker.Publish("", enumsWebhook.ExchangeAudit, exchange.Fanout, event.ToBytes()))
+}
+
+func (s *Service) getAccountData(r *http.Request) *proto.GetAccountDataResponse {
+	accountData, err := s.authGRPC.GetAccountInfo(s.context,
+		&proto.GetAccountData{Token: r.Header.Get(enums.HorusecJWTHeader)})
+	if err != nil {
+		logger.LogError(enumsWebhook.MessageFailedToGetAuditActor, err)
+		return nil
+	}
+	return accountData
+}
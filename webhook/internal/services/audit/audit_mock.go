@@ -0,0 +1,17 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+)
+
+type Mock struct {
+	mock.Mock
+}
+
+func (m *Mock) Publish(_ *http.Request, _ *webhook.AuditEvent) {
+	_ = m.MethodCalled("Publish")
+}
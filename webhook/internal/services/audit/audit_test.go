@@ -0,0 +1,57 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ZupIT/horusec-devkit/pkg/services/broker"
+	"github.com/ZupIT/horusec-devkit/pkg/services/grpc/auth/proto"
+
+	"github.com/ZupIT/horusec-platform/webhook/internal/entities/webhook"
+	"github.com/ZupIT/horusec-platform/webhook/internal/enums"
+)
+
+func TestNewAuditService(t *testing.T) {
+	t.Run("Should create a new audit service", func(t *testing.T) {
+		assert.NotNil(t, NewAuditService(&broker.Mock{}, &proto.Mock{}))
+	})
+}
+
+func TestService_Publish(t *testing.T) {
+	accountData := &proto.GetAccountDataResponse{AccountID: uuid.NewString(), Email: "test@horusec.io"}
+
+	t.Run("Should publish event with the actor and ip of the request", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(nil)
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, nil)
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+		r.RemoteAddr = "127.0.0.1:8000"
+
+		event := webhook.NewAuditEvent(enums.AuditActionWebhookCreate, uuid.New(), uuid.New(), uuid.New())
+		NewAuditService(brokerMock, authGRPCMock).Publish(r, event)
+
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Equal(t, accountData.Email, event.ActorEmail)
+		assert.Equal(t, "127.0.0.1", event.IP)
+	})
+	t.Run("Should publish event without actor when failed to get account data", func(t *testing.T) {
+		brokerMock := &broker.Mock{}
+		brokerMock.On("Publish").Return(errors.New("test"))
+		authGRPCMock := &proto.Mock{}
+		authGRPCMock.On("GetAccountInfo").Return(accountData, errors.New("test"))
+		r, _ := http.NewRequest(http.MethodPost, "/test", nil)
+
+		event := webhook.NewAuditEvent(enums.AuditActionWebhookCreate, uuid.New(), uuid.New(), uuid.New())
+		assert.NotPanics(t, func() {
+			NewAuditService(brokerMock, authGRPCMock).Publish(r, event)
+		})
+
+		brokerMock.AssertCalled(t, "Publish")
+		assert.Equal(t, uuid.Nil, event.ActorID)
+	})
+}